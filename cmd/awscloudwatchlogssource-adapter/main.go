@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awscloudwatchlogssource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awscloudwatchlogssource", awscloudwatchlogssource.NewEnvConfig, awscloudwatchlogssource.NewAdapter)
+	adapter.Main("awscloudwatchlogssource",
+		secrets.WithResolvedEnv(awscloudwatchlogssource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awscloudwatchlogssource.NewAdapter))),
+	)
 }
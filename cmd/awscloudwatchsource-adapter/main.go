@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awscloudwatchsource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awscloudwatchsource", awscloudwatchsource.NewEnvConfig, awscloudwatchsource.NewAdapter)
+	adapter.Main("awscloudwatchsource",
+		secrets.WithResolvedEnv(awscloudwatchsource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awscloudwatchsource.NewAdapter))),
+	)
 }
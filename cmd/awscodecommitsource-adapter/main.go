@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awscodecommitsource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awscodecommitsource", awscodecommitsource.NewEnvConfig, awscodecommitsource.NewAdapter)
+	adapter.Main("awscodecommitsource",
+		secrets.WithResolvedEnv(awscodecommitsource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awscodecommitsource.NewAdapter))),
+	)
 }
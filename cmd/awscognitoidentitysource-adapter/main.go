@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awscognitoidentitysource"
 )
 
 func main() {
-	adapter.Main("awscognitoidentitysource", awscognitoidentitysource.NewEnvConfig, awscognitoidentitysource.NewAdapter)
+	adapter.Main("awscognitoidentitysource",
+		secrets.WithResolvedEnv(awscognitoidentitysource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(awscognitoidentitysource.NewAdapter)),
+	)
 }
@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awscognitouserpoolsource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awscognitouserpoolsource", awscognitouserpoolsource.NewEnvConfig, awscognitouserpoolsource.NewAdapter)
+	adapter.Main("awscognitouserpoolsource",
+		secrets.WithResolvedEnv(awscognitouserpoolsource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awscognitouserpoolsource.NewAdapter))),
+	)
 }
@@ -17,10 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/awscomphrehendtarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("awscomphrehendtarget", awscomphrehendtarget.EnvAccessorCtor, awscomphrehendtarget.NewTarget)
+	pkgadapter.Main("awscomphrehendtarget",
+		secrets.WithResolvedEnv(awscomphrehendtarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(awscomphrehendtarget.NewTarget)))),
+	)
 }
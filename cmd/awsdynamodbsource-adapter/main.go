@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awsdynamodbsource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awsdynamodbsource", awsdynamodbsource.NewEnvConfig, awsdynamodbsource.NewAdapter)
+	adapter.Main("awsdynamodbsource",
+		secrets.WithResolvedEnv(awsdynamodbsource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awsdynamodbsource.NewAdapter))),
+	)
 }
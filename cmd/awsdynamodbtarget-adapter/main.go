@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/awsdynamodbtarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 func main() {
-	pkgadapter.Main("awsdynamodbtarget", awsdynamodbtarget.NewEnvConfig, awsdynamodbtarget.NewTarget)
+	pkgadapter.Main("awsdynamodbtarget",
+		secrets.WithResolvedEnv(awsdynamodbtarget.NewEnvConfig),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(awsdynamodbtarget.NewTarget)))),
+	)
 }
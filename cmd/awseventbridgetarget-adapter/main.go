@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/awseventbridgetarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 func main() {
-	pkgadapter.Main("awseventbridgetarget", awseventbridgetarget.NewEnvConfig, awseventbridgetarget.NewTarget)
+	pkgadapter.Main("awseventbridgetarget",
+		secrets.WithResolvedEnv(awseventbridgetarget.NewEnvConfig),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(awseventbridgetarget.NewTarget)))),
+	)
 }
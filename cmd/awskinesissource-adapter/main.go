@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awskinesissource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awskinesissource", awskinesissource.NewEnvConfig, awskinesissource.NewAdapter)
+	adapter.Main("awskinesissource",
+		secrets.WithResolvedEnv(awskinesissource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awskinesissource.NewAdapter))),
+	)
 }
@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/awskinesistarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 func main() {
-	pkgadapter.Main("awskinesistarget", awskinesistarget.NewEnvConfig, awskinesistarget.NewTarget)
+	pkgadapter.Main("awskinesistarget",
+		secrets.WithResolvedEnv(awskinesistarget.NewEnvConfig),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(awskinesistarget.NewTarget)))),
+	)
 }
@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/awslambdatarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 func main() {
-	pkgadapter.Main("awslambdatarget", awslambdatarget.NewEnvConfig, awslambdatarget.NewTarget)
+	pkgadapter.Main("awslambdatarget",
+		secrets.WithResolvedEnv(awslambdatarget.NewEnvConfig),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(awslambdatarget.NewTarget)))),
+	)
 }
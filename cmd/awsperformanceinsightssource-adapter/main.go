@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awsperformanceinsightssource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awsperformanceinsightssource", awsperformanceinsightssource.NewEnvConfig, awsperformanceinsightssource.NewAdapter)
+	adapter.Main("awsperformanceinsightssource",
+		secrets.WithResolvedEnv(awsperformanceinsightssource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awsperformanceinsightssource.NewAdapter))),
+	)
 }
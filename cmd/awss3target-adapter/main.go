@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/awss3target"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 func main() {
-	pkgadapter.Main("awss3target", awss3target.NewEnvConfig, awss3target.NewTarget)
+	pkgadapter.Main("awss3target",
+		secrets.WithResolvedEnv(awss3target.NewEnvConfig),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(awss3target.NewTarget)))),
+	)
 }
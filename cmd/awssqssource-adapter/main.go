@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/awssqssource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("awssqssource", awssqssource.NewEnvConfig, awssqssource.NewAdapter)
+	adapter.Main("awssqssource",
+		secrets.WithResolvedEnv(awssqssource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(awssqssource.NewAdapter))),
+	)
 }
@@ -19,9 +19,15 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/azureeventhubssource"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 func main() {
-	adapter.Main("azureeventhubssource", azureeventhubssource.NewEnvConfig, azureeventhubssource.NewAdapter)
+	adapter.Main("azureeventhubssource",
+		secrets.WithResolvedEnv(azureeventhubssource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(azureeventhubssource.NewAdapter))),
+	)
 }
@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/azureiothubsource"
 )
 
 func main() {
-	adapter.Main("azureiothubsource", azureiothubsource.NewEnvConfig, azureiothubsource.NewAdapter)
+	adapter.Main("azureiothubsource",
+		secrets.WithResolvedEnv(azureiothubsource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(azureiothubsource.NewAdapter)),
+	)
 }
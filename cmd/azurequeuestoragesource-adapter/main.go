@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/azurequeuestoragesource"
 )
 
 func main() {
-	adapter.Main("azurequeuestoragesource", azurequeuestoragesource.NewEnvConfig, azurequeuestoragesource.NewAdapter)
+	adapter.Main("azurequeuestoragesource",
+		secrets.WithResolvedEnv(azurequeuestoragesource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(azurequeuestoragesource.NewAdapter)),
+	)
 }
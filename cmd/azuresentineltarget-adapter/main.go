@@ -17,10 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/azuresentineltarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("azuresentineltarget", azuresentineltarget.EnvAccessorCtor, azuresentineltarget.NewTarget)
+	pkgadapter.Main("azuresentineltarget",
+		secrets.WithResolvedEnv(azuresentineltarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(azuresentineltarget.NewTarget)))),
+	)
 }
@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/azureservicebussource"
 )
 
 func main() {
-	adapter.Main("azureservicebussource", azureservicebussource.NewEnvConfig, azureservicebussource.NewAdapter)
+	adapter.Main("azureservicebussource",
+		secrets.WithResolvedEnv(azureservicebussource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(azureservicebussource.NewAdapter)),
+	)
 }
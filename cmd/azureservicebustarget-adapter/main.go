@@ -17,10 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/azureservicebustarget"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("azureservicebustarget", azureservicebustarget.EnvAccessorCtor, azureservicebustarget.NewTarget)
+	pkgadapter.Main("azureservicebustarget",
+		secrets.WithResolvedEnv(azureservicebustarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(azureservicebustarget.NewTarget)))),
+	)
 }
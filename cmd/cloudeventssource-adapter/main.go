@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/cloudeventssource"
 )
 
 func main() {
-	adapter.Main("cloudevents", cloudeventssource.NewEnvConfig, cloudeventssource.NewAdapter)
+	adapter.Main("cloudevents",
+		secrets.WithResolvedEnv(cloudeventssource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(cloudeventssource.NewAdapter)),
+	)
 }
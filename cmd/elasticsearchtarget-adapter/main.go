@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/elasticsearchtarget"
 )
 
 func main() {
-	pkgadapter.Main("elasticsearchtarget", elasticsearchtarget.EnvAccessorCtor, elasticsearchtarget.NewTarget)
+	pkgadapter.Main("elasticsearchtarget",
+		secrets.WithResolvedEnv(elasticsearchtarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(elasticsearchtarget.NewTarget)))),
+	)
 }
@@ -17,10 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/googlecloudfirestoretarget"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("googlecloudfirestoretarget", googlecloudfirestoretarget.EnvAccessorCtor, googlecloudfirestoretarget.NewTarget)
+	pkgadapter.Main("googlecloudfirestoretarget",
+		secrets.WithResolvedEnv(googlecloudfirestoretarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(googlecloudfirestoretarget.NewTarget)))),
+	)
 }
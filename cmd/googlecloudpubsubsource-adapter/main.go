@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/googlecloudpubsubsource"
 )
 
 func main() {
-	adapter.Main("googlecloudpubsubsource", googlecloudpubsubsource.NewEnvConfig, googlecloudpubsubsource.NewAdapter)
+	adapter.Main("googlecloudpubsubsource",
+		secrets.WithResolvedEnv(googlecloudpubsubsource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(googlecloudpubsubsource.NewAdapter)),
+	)
 }
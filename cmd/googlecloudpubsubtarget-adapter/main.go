@@ -17,10 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/googlecloudpubsubtarget"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("googlecloudpubsubtarget", googlecloudpubsubtarget.EnvAccessorCtor, googlecloudpubsubtarget.NewTarget)
+	pkgadapter.Main("googlecloudpubsubtarget",
+		secrets.WithResolvedEnv(googlecloudpubsubtarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(googlecloudpubsubtarget.NewTarget)))),
+	)
 }
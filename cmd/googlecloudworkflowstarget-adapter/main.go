@@ -17,10 +17,17 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/googlecloudworkflowstarget"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("googlecloudworkflowstarget", googlecloudworkflowstarget.EnvAccessorCtor, googlecloudworkflowstarget.NewTarget)
+	pkgadapter.Main("googlecloudworkflowstarget",
+		secrets.WithResolvedEnv(googlecloudworkflowstarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(googlecloudworkflowstarget.NewTarget)))),
+	)
 }
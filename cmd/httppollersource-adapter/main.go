@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/httppollersource"
 )
 
 func main() {
-	adapter.Main("httppoller", httppollersource.NewEnvConfig, httppollersource.NewAdapter)
+	adapter.Main("httppoller",
+		secrets.WithResolvedEnv(httppollersource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(httppollersource.NewAdapter)),
+	)
 }
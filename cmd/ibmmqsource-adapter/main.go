@@ -21,9 +21,14 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/ibmmqsource"
 )
 
 func main() {
-	pkgadapter.Main("ibmmqsource", ibmmqsource.EnvAccessorCtor, ibmmqsource.NewAdapter)
+	pkgadapter.Main("ibmmqsource",
+		secrets.WithResolvedEnv(ibmmqsource.EnvAccessorCtor),
+		secrets.Middleware(dedup.Middleware(ibmmqsource.NewAdapter)),
+	)
 }
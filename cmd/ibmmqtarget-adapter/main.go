@@ -21,9 +21,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/ibmmqtarget"
 )
 
 func main() {
-	pkgadapter.Main("ibmmqtarget", ibmmqtarget.EnvAccessorCtor, ibmmqtarget.NewAdapter)
+	pkgadapter.Main("ibmmqtarget",
+		secrets.WithResolvedEnv(ibmmqtarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(ibmmqtarget.NewAdapter)))),
+	)
 }
@@ -19,9 +19,14 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/jqtransformation"
 )
 
 func main() {
-	pkgadapter.Main("jqtransformation", jqtransformation.EnvAccessorCtor, jqtransformation.NewAdapter)
+	pkgadapter.Main("jqtransformation",
+		secrets.WithResolvedEnv(jqtransformation.EnvAccessorCtor),
+		secrets.Middleware(dedup.Middleware(jqtransformation.NewAdapter)),
+	)
 }
@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/kafkasource"
 )
 
 func main() {
-	adapter.Main("kafkasource", kafkasource.NewEnvConfig, kafkasource.NewAdapter)
+	adapter.Main("kafkasource",
+		secrets.WithResolvedEnv(kafkasource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(kafkasource.NewAdapter)),
+	)
 }
@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/kafkatarget"
 )
 
 func main() {
-	pkgadapter.Main("kafkatarget", kafkatarget.EnvAccessorCtor, kafkatarget.NewTarget)
+	pkgadapter.Main("kafkatarget",
+		secrets.WithResolvedEnv(kafkatarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(kafkatarget.NewTarget)))),
+	)
 }
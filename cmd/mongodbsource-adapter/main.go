@@ -17,10 +17,16 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/mongodbsource"
 	"knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	adapter.Main("mongodbsource", mongodbsource.NewEnvConfig, mongodbsource.NewAdapter)
+	adapter.Main("mongodbsource",
+		secrets.WithResolvedEnv(mongodbsource.NewEnvConfig),
+		secrets.Middleware(health.Middleware(dedup.Middleware(mongodbsource.NewAdapter))),
+	)
 }
@@ -17,10 +17,15 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/ocimetricssource"
 	"knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	adapter.Main("ocimetrics", ocimetricssource.NewEnvConfig, ocimetricssource.NewAdapter)
+	adapter.Main("ocimetrics",
+		secrets.WithResolvedEnv(ocimetricssource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(ocimetricssource.NewAdapter)),
+	)
 }
@@ -21,6 +21,8 @@ import (
 
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/salesforcesource"
 )
 
@@ -30,5 +32,8 @@ func main() {
 	// library to marshal single item Audience array as a string.
 	jwt.MarshalSingleStringAsArray = false
 
-	adapter.Main("salesforce", salesforcesource.NewEnvConfig, salesforcesource.NewAdapter)
+	adapter.Main("salesforce",
+		secrets.WithResolvedEnv(salesforcesource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(salesforcesource.NewAdapter)),
+	)
 }
@@ -21,6 +21,10 @@ import (
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/salesforcetarget"
 )
 
@@ -30,5 +34,8 @@ func main() {
 	// library to marshal single item Audience array as a string.
 	jwt.MarshalSingleStringAsArray = false
 
-	pkgadapter.Main("salesforcetarget", salesforcetarget.EnvAccessor, salesforcetarget.NewTarget)
+	pkgadapter.Main("salesforcetarget",
+		secrets.WithResolvedEnv(salesforcetarget.EnvAccessor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(salesforcetarget.NewTarget)))),
+	)
 }
@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/slacksource"
 )
 
 func main() {
-	adapter.Main("slack", slacksource.NewEnvConfig, slacksource.NewAdapter)
+	adapter.Main("slack",
+		secrets.WithResolvedEnv(slacksource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(slacksource.NewAdapter)),
+	)
 }
@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/solacesource"
 )
 
 func main() {
-	adapter.Main("solacesource", solacesource.NewEnvConfig, solacesource.NewAdapter)
+	adapter.Main("solacesource",
+		secrets.WithResolvedEnv(solacesource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(solacesource.NewAdapter)),
+	)
 }
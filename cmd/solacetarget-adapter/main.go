@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/solacetarget"
 )
 
 func main() {
-	pkgadapter.Main("solacetarget", solacetarget.EnvAccessorCtor, solacetarget.NewTarget)
+	pkgadapter.Main("solacetarget",
+		secrets.WithResolvedEnv(solacetarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(solacetarget.NewTarget)))),
+	)
 }
@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/splunktarget"
 )
 
 func main() {
-	pkgadapter.Main("splunktarget", splunktarget.NewEnvConfig, splunktarget.NewTarget)
+	pkgadapter.Main("splunktarget",
+		secrets.WithResolvedEnv(splunktarget.NewEnvConfig),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(splunktarget.NewTarget)))),
+	)
 }
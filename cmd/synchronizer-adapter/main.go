@@ -17,10 +17,15 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/synchronizer"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("synchronizer", synchronizer.EnvAccessorCtor, synchronizer.NewAdapter)
+	pkgadapter.Main("synchronizer",
+		secrets.WithResolvedEnv(synchronizer.EnvAccessorCtor),
+		secrets.Middleware(dedup.Middleware(synchronizer.NewAdapter)),
+	)
 }
@@ -17,10 +17,15 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/transformation"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("transformation", transformation.NewEnvConfig, transformation.NewAdapter)
+	pkgadapter.Main("transformation",
+		secrets.WithResolvedEnv(transformation.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(transformation.NewAdapter)),
+	)
 }
@@ -24,7 +24,10 @@ import (
 	"knative.dev/pkg/signals"
 
 	"github.com/triggermesh/triggermesh/pkg/extensions/reconciler/function"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/bridge"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/deduplicator"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/jqtransformation"
+	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/schemavalidator"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/synchronizer"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/transformation"
 	"github.com/triggermesh/triggermesh/pkg/flow/reconciler/xmltojsontransformation"
@@ -188,7 +191,10 @@ func main() {
 		twiliotarget.NewController,
 		zendesktarget.NewController,
 		// flow
+		bridge.NewController,
+		deduplicator.NewController,
 		jqtransformation.NewController,
+		schemavalidator.NewController,
 		synchronizer.NewController,
 		transformation.NewController,
 		xmltojsontransformation.NewController,
@@ -18,9 +18,15 @@ package main
 
 import (
 	"context"
+	"os"
 	"reflect"
+	"strconv"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubeclient "knative.dev/pkg/client/injection/kube/client"
 	"knative.dev/pkg/configmap"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection/sharedmain"
@@ -28,23 +34,36 @@ import (
 	"knative.dev/pkg/webhook"
 	"knative.dev/pkg/webhook/certificates"
 	"knative.dev/pkg/webhook/resourcesemantics"
+	"knative.dev/pkg/webhook/resourcesemantics/conversion"
 	"knative.dev/pkg/webhook/resourcesemantics/defaulting"
 	"knative.dev/pkg/webhook/resourcesemantics/validation"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
+	extensionsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1beta1"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	"github.com/triggermesh/triggermesh/pkg/verification"
 )
 
 var validationTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{}
+var validationCallbacks = map[schema.GroupVersionKind]validation.Callback{}
 var defaultingTypes = map[schema.GroupVersionKind]resourcesemantics.GenericCRD{
 	sourcesv1alpha1.SchemeGroupVersion.WithKind("CloudEventsSource"): &sourcesv1alpha1.CloudEventsSource{},
 	routingv1alpha1.SchemeGroupVersion.WithKind("Filter"):            &routingv1alpha1.Filter{},
 	flowv1alpha1.SchemeGroupVersion.WithKind("XSLTTransformation"):   &flowv1alpha1.XSLTTransformation{},
+	sourcesv1beta1.SchemeGroupVersion.WithKind("CloudEventsSource"):  &sourcesv1beta1.CloudEventsSource{},
+	routingv1beta1.SchemeGroupVersion.WithKind("Filter"):             &routingv1beta1.Filter{},
+	flowv1beta1.SchemeGroupVersion.WithKind("XSLTTransformation"):    &flowv1beta1.XSLTTransformation{},
 }
+var conversionTypes = map[schema.GroupKind]conversion.GroupKindConversion{}
 
 // NewDefaultingAdmissionController returns defaulting webhook controller implementation.
 func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
@@ -68,8 +87,20 @@ func NewDefaultingAdmissionController(ctx context.Context, cmw configmap.Watcher
 	)
 }
 
+// envVerifyConnectivity is the name of the environment variable which enables
+// verifications of the connectivity of components with external services.
+const envVerifyConnectivity = "VERIFY_CONNECTIVITY"
+
 // NewValidationAdmissionController returns validation webhook controller implementation.
 func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	// Verifications cause the webhook to send requests to external services
+	// using credentials read from the namespace of the verified component,
+	// so they must be enabled explicitly by the cluster operator.
+	var verifier v1alpha1.Verifier
+	if enabled, _ := strconv.ParseBool(os.Getenv(envVerifyConnectivity)); enabled {
+		verifier = verification.NewVerifier(kubeclient.Get(ctx).CoreV1())
+	}
+
 	return validation.NewAdmissionController(ctx,
 		// Name of the resource webhook.
 		"validation.webhook.triggermesh.io",
@@ -81,12 +112,34 @@ func NewValidationAdmissionController(ctx context.Context, cmw configmap.Watcher
 		validationTypes,
 
 		// A function that infuses the context passed to Validate/SetDefaults with custom metadata.
+		// The verifier, if enabled, is used by components annotated with "triggermesh.io/verify".
+		// The sink capability is used to validate the wiring of Bridge components.
 		func(ctx context.Context) context.Context {
-			return ctx
+			ctx = flowv1alpha1.WithSinkCapability(ctx, flowv1alpha1.SinkCapabilityFromScheme(scheme.Scheme))
+			return v1alpha1.WithVerifier(ctx, verifier)
 		},
 
 		// Whether to disallow unknown fields.
 		true,
+
+		// Validation of the parameters shared by all components.
+		validationCallbacks,
+	)
+}
+
+// NewConversionController returns conversion webhook controller implementation.
+func NewConversionController(ctx context.Context, cmw configmap.Watcher) *controller.Impl {
+	return conversion.NewConversionController(ctx,
+		// The path on which to serve the webhook.
+		"/resource-conversion",
+
+		// The resources to convert.
+		conversionTypes,
+
+		// A function that infuses the context passed to ConvertTo/ConvertFrom/SetDefaults with custom metadata.
+		func(ctx context.Context) context.Context {
+			return ctx
+		},
 	)
 }
 
@@ -105,11 +158,23 @@ func main() {
 	registerValidationType(flowv1alpha1.SchemeGroupVersion, flowv1alpha1.AllTypes)
 	registerValidationType(extensionsv1alpha1.SchemeGroupVersion, extensionsv1alpha1.AllTypes)
 	registerValidationType(routingv1alpha1.SchemeGroupVersion, routingv1alpha1.AllTypes)
+	registerValidationType(sourcesv1beta1.SchemeGroupVersion, sourcesv1beta1.AllTypes)
+	registerValidationType(targetsv1beta1.SchemeGroupVersion, targetsv1beta1.AllTypes)
+	registerValidationType(flowv1beta1.SchemeGroupVersion, flowv1beta1.AllTypes)
+	registerValidationType(extensionsv1beta1.SchemeGroupVersion, extensionsv1beta1.AllTypes)
+	registerValidationType(routingv1beta1.SchemeGroupVersion, routingv1beta1.AllTypes)
+
+	registerConversionType(sourcesv1alpha1.SchemeGroupVersion, sourcesv1alpha1.AllTypes, sourcesv1beta1.SchemeGroupVersion, sourcesv1beta1.AllTypes)
+	registerConversionType(targetsv1alpha1.SchemeGroupVersion, targetsv1alpha1.AllTypes, targetsv1beta1.SchemeGroupVersion, targetsv1beta1.AllTypes)
+	registerConversionType(flowv1alpha1.SchemeGroupVersion, flowv1alpha1.AllTypes, flowv1beta1.SchemeGroupVersion, flowv1beta1.AllTypes)
+	registerConversionType(extensionsv1alpha1.SchemeGroupVersion, extensionsv1alpha1.AllTypes, extensionsv1beta1.SchemeGroupVersion, extensionsv1beta1.AllTypes)
+	registerConversionType(routingv1alpha1.SchemeGroupVersion, routingv1alpha1.AllTypes, routingv1beta1.SchemeGroupVersion, routingv1beta1.AllTypes)
 
 	sharedmain.MainWithContext(ctx, webhookName,
 		certificates.NewController,
 		NewDefaultingAdmissionController,
 		NewValidationAdmissionController,
+		NewConversionController,
 	)
 }
 
@@ -119,6 +184,68 @@ func registerValidationType(gv schema.GroupVersion, objects []v1alpha1.GroupObje
 		t := reflect.TypeOf(object.Single)
 		if admissible, ok := object.Single.(resourcesemantics.GenericCRD); ok {
 			validationTypes[gv.WithKind(t.Elem().Name())] = admissible
+			validationCallbacks[gv.WithKind(t.Elem().Name())] = validation.NewCallback(
+				validateAdapterOverrides, webhook.Create, webhook.Update)
+		}
+	}
+}
+
+// registerConversionType registers components in the conversion controller,
+// using the given version of the API as the hub for conversions.
+func registerConversionType(hubGV schema.GroupVersion, hubObjects []v1alpha1.GroupObject,
+	gv schema.GroupVersion, objects []v1alpha1.GroupObject) {
+
+	zygotes := make(map[string]map[string]conversion.ConvertibleObject)
+
+	for _, versionObjects := range []struct {
+		version string
+		objects []v1alpha1.GroupObject
+	}{
+		{version: hubGV.Version, objects: hubObjects},
+		{version: gv.Version, objects: objects},
+	} {
+		for _, object := range versionObjects.objects {
+			convertible, ok := object.Single.(conversion.ConvertibleObject)
+			if !ok {
+				continue
+			}
+
+			kind := reflect.TypeOf(object.Single).Elem().Name()
+			if zygotes[kind] == nil {
+				zygotes[kind] = make(map[string]conversion.ConvertibleObject)
+			}
+			zygotes[kind][versionObjects.version] = convertible
+		}
+	}
+
+	for kind, kindZygotes := range zygotes {
+		conversionTypes[hubGV.WithKind(kind).GroupKind()] = conversion.GroupKindConversion{
+			DefinitionName: strings.ToLower(kind) + "s." + hubGV.Group,
+			HubVersion:     hubGV.Version,
+			Zygotes:        kindZygotes,
 		}
 	}
 }
+
+// validateAdapterOverrides validates the adapter overrides of any component.
+// Overrides are accepted by nearly all kinds, and an invalid value would
+// prevent the component's adapter from starting.
+func validateAdapterOverrides(ctx context.Context, u *unstructured.Unstructured) error {
+	var obj struct {
+		Spec struct {
+			AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides"`
+		} `json:"spec"`
+	}
+
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &obj); err != nil {
+		return err
+	}
+
+	if o := obj.Spec.AdapterOverrides; o != nil {
+		if errs := o.Validate(ctx).ViaField("spec", "adapterOverrides"); errs != nil {
+			return errs
+		}
+	}
+
+	return nil
+}
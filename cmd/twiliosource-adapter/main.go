@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/twiliosource"
 )
 
 func main() {
-	adapter.Main("twiliosource", twiliosource.NewEnvConfig, twiliosource.NewAdapter)
+	adapter.Main("twiliosource",
+		secrets.WithResolvedEnv(twiliosource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(twiliosource.NewAdapter)),
+	)
 }
@@ -19,9 +19,16 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/twiliotarget"
 )
 
 func main() {
-	pkgadapter.Main("twiliotarget", twiliotarget.EnvAccessorCtor, twiliotarget.NewTarget)
+	pkgadapter.Main("twiliotarget",
+		secrets.WithResolvedEnv(twiliotarget.EnvAccessorCtor),
+		secrets.Middleware(dispatcher.Middleware(dedup.Middleware(health.ReceiverMiddleware(twiliotarget.NewTarget)))),
+	)
 }
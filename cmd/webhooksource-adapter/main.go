@@ -19,9 +19,14 @@ package main
 import (
 	"knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/webhooksource"
 )
 
 func main() {
-	adapter.Main("webhook", webhooksource.NewEnvConfig, webhooksource.NewAdapter)
+	adapter.Main("webhook",
+		secrets.WithResolvedEnv(webhooksource.NewEnvConfig),
+		secrets.Middleware(dedup.Middleware(webhooksource.NewAdapter)),
+	)
 }
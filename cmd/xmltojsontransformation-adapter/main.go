@@ -19,9 +19,14 @@ package main
 import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/xmltojsontransformation"
 )
 
 func main() {
-	pkgadapter.Main("xmltojsontransformation", xmltojsontransformation.EnvAccessorCtor, xmltojsontransformation.NewAdapter)
+	pkgadapter.Main("xmltojsontransformation",
+		secrets.WithResolvedEnv(xmltojsontransformation.EnvAccessorCtor),
+		secrets.Middleware(dedup.Middleware(xmltojsontransformation.NewAdapter)),
+	)
 }
@@ -19,10 +19,15 @@ limitations under the License.
 package main
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/flow/adapter/xslttransformation"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
 func main() {
-	pkgadapter.Main("xslttransformation", xslttransformation.EnvAccessorCtor, xslttransformation.NewTarget)
+	pkgadapter.Main("xslttransformation",
+		secrets.WithResolvedEnv(xslttransformation.EnvAccessorCtor),
+		secrets.Middleware(dedup.Middleware(xslttransformation.NewTarget)),
+	)
 }
@@ -87,6 +87,10 @@ func readComponents(dir string) (componentsDictionary, error) {
 			components.AddExtension(resource)
 		case crdPrefixFlow:
 			components.AddFlow(resource)
+		case crdPrefixCatalog:
+			// Catalogs are maintained by the controller on behalf of
+			// components and are not components themselves.
+			continue
 		default:
 			// This shouldn't happen.
 			// Fail loudly if we find a file with an unknown prefix.
@@ -29,6 +29,7 @@ const (
 	crdPrefixRouting    = "302-"
 	crdPrefixExtensions = "303-"
 	crdPrefixFlow       = "304-"
+	crdPrefixCatalog    = "305-"
 )
 
 // crdFilenameToResource extracts the singular resource name from the given
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package awsendpoint allows adapters to send requests to API-compatible
+// alternatives to the public AWS cloud (LocalStack, MinIO, ElasticMQ, ...)
+// instead of the endpoint which is resolved from the region of an AWS
+// resource.
+//
+// Custom endpoints are declared in the adapter's environment, either for a
+// given AWS service, designated by its endpoint ID:
+//
+//	AWS_ENDPOINT_URL_S3=http://minio:9000
+//	AWS_ENDPOINT_URL_SQS=http://elasticmq:9324
+//	AWS_S3_FORCE_PATH_STYLE=true
+//
+// or for all AWS services:
+//
+//	AWS_ENDPOINT_URL=http://localstack:4566
+package awsendpoint
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+)
+
+// Names of the environment variables which declare custom endpoints.
+const (
+	EnvEndpointURL      = "AWS_ENDPOINT_URL"
+	EnvS3ForcePathStyle = "AWS_S3_FORCE_PATH_STYLE"
+)
+
+// EnvServiceEndpointURL returns the name of the environment variable which
+// declares a custom endpoint for the AWS service with the given endpoint ID.
+// For example, AWS_ENDPOINT_URL_STREAMS_DYNAMODB for "streams.dynamodb".
+func EnvServiceEndpointURL(service string) string {
+	return EnvEndpointURL + "_" + strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, service)
+}
+
+// Resolver returns a custom endpoints.Resolver which resolves the endpoint
+// URL declared in the environment for the requested service, if any, and
+// falls back to the default endpoint of the requested service and region
+// otherwise.
+func Resolver() endpoints.Resolver {
+	return newResolver(func(service string) string {
+		if u := os.Getenv(EnvServiceEndpointURL(service)); u != "" {
+			return u
+		}
+		return os.Getenv(EnvEndpointURL)
+	})
+}
+
+// NewResolver returns a custom endpoints.Resolver which resolves the given
+// endpoint URLs, indexed by the endpoint ID of their AWS service, and falls
+// back to the default endpoint of the requested service and region for other
+// services.
+func NewResolver(urls map[string]string) endpoints.Resolver {
+	return newResolver(func(service string) string {
+		return urls[service]
+	})
+}
+
+// newResolver returns a custom endpoints.Resolver which resolves the endpoint
+// URL returned by urlForService, unless that URL is empty.
+func newResolver(urlForService func(service string) string) endpoints.Resolver {
+	rslvr := func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
+		endpointURLStr := urlForService(service)
+		if endpointURLStr == "" {
+			return endpoints.DefaultResolver().EndpointFor(service, region, opts...)
+		}
+
+		endpointURL, err := url.Parse(endpointURLStr)
+		if err != nil {
+			return endpoints.ResolvedEndpoint{}, fmt.Errorf("invalid AWS endpoint URL for service %q: %w", service, err)
+		}
+
+		partition := endpoints.AwsPartitionID
+		if p, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region); ok {
+			partition = p.ID()
+		}
+
+		return endpoints.ResolvedEndpoint{
+			URL:           endpointURL.String(),
+			PartitionID:   partition,
+			SigningRegion: region,
+		}, nil
+	}
+
+	return endpoints.ResolverFunc(rslvr)
+}
+
+// WithOverrides applies the endpoint customizations declared in the
+// environment to the given AWS client configuration.
+func WithOverrides(cfg *aws.Config) *aws.Config {
+	cfg = cfg.WithEndpointResolver(Resolver())
+
+	if forcePathStyle, _ := strconv.ParseBool(os.Getenv(EnvS3ForcePathStyle)); forcePathStyle {
+		cfg = cfg.WithS3ForcePathStyle(true)
+	}
+
+	return cfg
+}
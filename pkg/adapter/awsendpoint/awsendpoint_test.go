@@ -0,0 +1,102 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsendpoint
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/sqs"
+)
+
+func TestResolver(t *testing.T) {
+	t.Run("default endpoint", func(t *testing.T) {
+		ep, err := Resolver().EndpointFor(sqs.EndpointsID, "eu-central-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://sqs.eu-central-1.amazonaws.com", ep.URL)
+	})
+
+	t.Run("custom endpoint", func(t *testing.T) {
+		t.Setenv(EnvEndpointURL, "http://localstack:4566")
+
+		ep, err := Resolver().EndpointFor(sqs.EndpointsID, "cn-north-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "http://localstack:4566", ep.URL)
+		assert.Equal(t, "aws-cn", ep.PartitionID)
+		assert.Equal(t, "cn-north-1", ep.SigningRegion)
+	})
+
+	t.Run("custom service endpoint", func(t *testing.T) {
+		t.Setenv(EnvEndpointURL, "http://localstack:4566")
+		t.Setenv(EnvServiceEndpointURL(sqs.EndpointsID), "http://elasticmq:9324")
+
+		ep, err := Resolver().EndpointFor(sqs.EndpointsID, "eu-central-1")
+		require.NoError(t, err)
+		assert.Equal(t, "http://elasticmq:9324", ep.URL)
+
+		ep, err = Resolver().EndpointFor(s3.EndpointsID, "eu-central-1")
+		require.NoError(t, err)
+		assert.Equal(t, "http://localstack:4566", ep.URL)
+	})
+
+	t.Run("invalid custom endpoint", func(t *testing.T) {
+		t.Setenv(EnvEndpointURL, "http://local stack:4566")
+
+		_, err := Resolver().EndpointFor(sqs.EndpointsID, "eu-central-1")
+		assert.Error(t, err)
+	})
+}
+
+func TestNewResolver(t *testing.T) {
+	r := NewResolver(map[string]string{
+		s3.EndpointsID: "http://minio:9000",
+	})
+
+	ep, err := r.EndpointFor(s3.EndpointsID, "eu-central-1")
+	require.NoError(t, err)
+	assert.Equal(t, "http://minio:9000", ep.URL)
+
+	ep, err = r.EndpointFor(sqs.EndpointsID, "eu-central-1")
+	require.NoError(t, err)
+	assert.Equal(t, "https://sqs.eu-central-1.amazonaws.com", ep.URL)
+}
+
+func TestEnvServiceEndpointURL(t *testing.T) {
+	assert.Equal(t, "AWS_ENDPOINT_URL_S3", EnvServiceEndpointURL("s3"))
+	assert.Equal(t, "AWS_ENDPOINT_URL_STREAMS_DYNAMODB", EnvServiceEndpointURL("streams.dynamodb"))
+	assert.Equal(t, "AWS_ENDPOINT_URL_COGNITO_IDP", EnvServiceEndpointURL("cognito-idp"))
+}
+
+func TestWithOverrides(t *testing.T) {
+	t.Setenv(EnvServiceEndpointURL(s3.EndpointsID), "http://minio:9000")
+	t.Setenv(EnvS3ForcePathStyle, "true")
+
+	sess := session.Must(session.NewSession(WithOverrides(aws.NewConfig().
+		WithRegion("us-east-1"))))
+
+	cli := s3.New(sess)
+
+	assert.Equal(t, "http://minio:9000", cli.Endpoint)
+	assert.True(t, aws.BoolValue(cli.Config.S3ForcePathStyle))
+}
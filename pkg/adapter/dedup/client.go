@@ -0,0 +1,172 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/receiver"
+)
+
+// Middleware wraps the given adapter constructor so that the CloudEvents
+// client passed to the adapter discards duplicate events, both received and
+// sent, when deduplication is enabled via the environment.
+func Middleware(ctor pkgadapter.AdapterConstructor) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, env pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		cfg := &EnvConfig{}
+		if err := envconfig.Process("", cfg); err != nil {
+			logger.Panicw("Error processing deduplication configuration", zap.Error(err))
+		}
+
+		if !cfg.Enabled() {
+			return ctor(ctx, env, ceClient)
+		}
+
+		d, err := New(cfg)
+		if err != nil {
+			logger.Panicw("Error creating deduplicator", zap.Error(err))
+		}
+
+		logger.Infow("Deduplication of events enabled",
+			zap.String("store", cfg.Store),
+			zap.Duration("ttl", cfg.TTL),
+		)
+
+		return ctor(ctx, env, NewClient(ceClient, d, logger.Named("dedup")))
+	}
+}
+
+// client is a cloudevents.Client which discards duplicate events.
+type client struct {
+	cloudevents.Client
+
+	// Received and sent events are deduplicated independently, so that
+	// adapters which forward the events they receive unchanged don't
+	// mistake them for duplicates.
+	received *Deduplicator
+	sent     *Deduplicator
+
+	logger *zap.SugaredLogger
+}
+
+// Namespaces of the keys of received and sent events.
+const (
+	namespaceReceived = "received"
+	namespaceSent     = "sent"
+)
+
+var _ cloudevents.Client = (*client)(nil)
+
+// NewClient returns a cloudevents.Client which wraps the given client and
+// discards duplicate events.
+//
+// An event is recorded before being sent or processed, and forgotten if this
+// fails, so that its redelivery isn't mistaken for a duplicate. Events are
+// never discarded when the deduplication store is unavailable. The keys of
+// received and sent events are recorded in distinct namespaces.
+func NewClient(c cloudevents.Client, d *Deduplicator, logger *zap.SugaredLogger) cloudevents.Client {
+	return &client{
+		Client:   c,
+		received: d.WithNamespace(namespaceReceived),
+		sent:     d.WithNamespace(namespaceSent),
+		logger:   logger,
+	}
+}
+
+// Send implements cloudevents.Client.
+func (c *client) Send(ctx context.Context, e cloudevents.Event) protocol.Result {
+	if c.isDuplicate(ctx, c.sent, &e) {
+		return nil
+	}
+
+	res := c.Client.Send(ctx, e)
+	if !cloudevents.IsACK(res) {
+		c.forget(c.sent, &e)
+	}
+	return res
+}
+
+// Request implements cloudevents.Client.
+func (c *client) Request(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+	if c.isDuplicate(ctx, c.sent, &e) {
+		return nil, nil
+	}
+
+	resp, res := c.Client.Request(ctx, e)
+	if !cloudevents.IsACK(res) {
+		c.forget(c.sent, &e)
+	}
+	return resp, res
+}
+
+// StartReceiver implements cloudevents.Client.
+func (c *client) StartReceiver(ctx context.Context, fn interface{}) error {
+	receive, err := receiver.Normalize(fn)
+	if err != nil {
+		return err
+	}
+
+	return c.Client.StartReceiver(ctx, func(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+		if c.isDuplicate(ctx, c.received, &e) {
+			return nil, nil
+		}
+
+		resp, res := receive(ctx, e)
+		if !cloudevents.IsACK(res) {
+			c.forget(c.received, &e)
+		}
+		return resp, res
+	})
+}
+
+// isDuplicate returns whether the given event was already processed.
+func (c *client) isDuplicate(ctx context.Context, d *Deduplicator, e *cloudevents.Event) bool {
+	seen, err := d.Seen(ctx, e)
+	if err != nil {
+		c.logger.Errorw("Unable to determine whether the event is a duplicate", zap.Error(err))
+		return false
+	}
+
+	if seen {
+		c.logger.Debugw("Discarding duplicate event",
+			zap.String("id", e.ID()),
+			zap.String("source", e.Source()),
+		)
+	}
+
+	return seen
+}
+
+// forget ensures a subsequent delivery of the given event is processed.
+// The key is released independently of the context of the event, which may
+// already be cancelled when its processing failed.
+func (c *client) forget(d *Deduplicator, e *cloudevents.Event) {
+	if err := d.Forget(context.Background(), e); err != nil {
+		c.logger.Errorw("Unable to forget event", zap.Error(err))
+	}
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/receiver"
+)
+
+func TestClientSend(t *testing.T) {
+	ctx := context.Background()
+
+	ceClient, chEvents := cetest.NewMockSenderClient(t, 10)
+	c := NewClient(ceClient, NewDeduplicator(NewMemoryStore(10), time.Minute), logtesting.TestLogger(t))
+
+	e := newEvent("1", "test.source", nil)
+
+	require.True(t, cloudevents.IsACK(c.Send(ctx, *e)))
+	require.True(t, cloudevents.IsACK(c.Send(ctx, *e)))
+
+	assert.Len(t, chEvents, 1, "duplicate event should not be sent")
+}
+
+func TestClientSendFailure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	ceClient := &failingSenderClient{
+		send: func(context.Context, cloudevents.Event) cloudevents.Result {
+			// the context of the event is cancelled while it is being sent
+			cancel()
+			return cloudevents.NewReceipt(false, "delivery failed")
+		},
+	}
+	c := NewClient(ceClient, NewDeduplicator(NewMemoryStore(10), time.Minute), logtesting.TestLogger(t))
+
+	e := newEvent("1", "test.source", nil)
+
+	require.True(t, cloudevents.IsNACK(c.Send(ctx, *e)))
+	require.True(t, cloudevents.IsNACK(c.Send(context.Background(), *e)))
+
+	assert.Equal(t, 2, ceClient.calls, "event should be sent again after a failure")
+}
+
+func TestClientReceiveAndForward(t *testing.T) {
+	ctx := context.Background()
+
+	senderClient, chSent := cetest.NewMockSenderClient(t, 10)
+
+	e := newEvent("1", "test.source", nil)
+
+	ceClient := &receiverSenderClient{
+		Client:   senderClient,
+		received: []cloudevents.Event{*e, *e},
+	}
+	c := NewClient(ceClient, NewDeduplicator(NewMemoryStore(10), time.Minute), logtesting.TestLogger(t))
+
+	var forwarded []cloudevents.Result
+
+	err := c.StartReceiver(ctx, func(ctx context.Context, e cloudevents.Event) cloudevents.Result {
+		// the event is forwarded with its original id and source
+		res := c.Send(ctx, e)
+		forwarded = append(forwarded, res)
+		return res
+	})
+	require.NoError(t, err)
+
+	require.Len(t, forwarded, 1, "duplicate received event should not be processed")
+	assert.True(t, cloudevents.IsACK(forwarded[0]))
+
+	require.Len(t, chSent, 1, "received event should be forwarded")
+	assert.Equal(t, e.ID(), (<-chSent).ID())
+}
+
+// receiverSenderClient is a cloudevents.Client which receives the given
+// events synchronously and sends events using the embedded client.
+type receiverSenderClient struct {
+	cloudevents.Client
+	received []cloudevents.Event
+}
+
+func (c *receiverSenderClient) StartReceiver(ctx context.Context, fn interface{}) error {
+	receive, err := receiver.Normalize(fn)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range c.received {
+		_, _ = receive(ctx, e)
+	}
+	return nil
+}
+
+// failingSenderClient is a cloudevents.Client which sends events using the
+// given function.
+type failingSenderClient struct {
+	cloudevents.Client
+	send  func(context.Context, cloudevents.Event) cloudevents.Result
+	calls int
+}
+
+func (c *failingSenderClient) Send(ctx context.Context, e cloudevents.Event) cloudevents.Result {
+	c.calls++
+	return c.send(ctx, e)
+}
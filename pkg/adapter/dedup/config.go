@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"fmt"
+	"time"
+)
+
+// Supported stores.
+const (
+	storeMemory = "memory"
+	storeRedis  = "redis"
+)
+
+// EnvConfig contains the deduplication parameters. They are read from the
+// environment of any adapter wrapped with Middleware.
+type EnvConfig struct {
+	// Type of store in which keys are recorded. Deduplication is
+	// disabled when empty.
+	Store string `envconfig:"DEDUPLICATION_STORE"`
+	// CEL expression which computes the deduplication key of an event.
+	Key string `envconfig:"DEDUPLICATION_KEY"`
+	// Time window during which identical keys are considered duplicates.
+	TTL time.Duration `envconfig:"DEDUPLICATION_TTL" default:"10m"`
+
+	// Parameters of the in-memory store.
+	MemorySize int `envconfig:"DEDUPLICATION_MEMORY_SIZE" default:"10000"`
+
+	// Parameters of the Redis store.
+	RedisAddress  string `envconfig:"DEDUPLICATION_REDIS_ADDRESS"`
+	RedisPassword string `envconfig:"DEDUPLICATION_REDIS_PASSWORD"`
+	RedisDatabase int    `envconfig:"DEDUPLICATION_REDIS_DATABASE"`
+	RedisTLS      bool   `envconfig:"DEDUPLICATION_REDIS_TLS"`
+
+	// Identity of the component, used to scope keys recorded in shared
+	// stores.
+	Component string `envconfig:"K_COMPONENT"`
+	Namespace string `envconfig:"NAMESPACE"`
+	Name      string `envconfig:"NAME"`
+}
+
+// Enabled returns whether the deduplication of events is enabled.
+func (c *EnvConfig) Enabled() bool {
+	return c.Store != ""
+}
+
+// New returns a Deduplicator initialized from the given configuration.
+func New(cfg *EnvConfig) (*Deduplicator, error) {
+	if cfg.TTL <= 0 {
+		return nil, fmt.Errorf("invalid TTL %s: must be greater than zero", cfg.TTL)
+	}
+
+	key := defaultKey
+	if cfg.Key != "" {
+		var err error
+		if key, err = celKey(cfg.Key); err != nil {
+			return nil, fmt.Errorf("compiling key expression: %w", err)
+		}
+	}
+
+	var store Store
+	switch cfg.Store {
+	case storeMemory:
+		if cfg.MemorySize < 1 {
+			return nil, fmt.Errorf("invalid memory store size %d: must be greater than zero", cfg.MemorySize)
+		}
+		store = NewMemoryStore(cfg.MemorySize)
+
+	case storeRedis:
+		if cfg.RedisAddress == "" {
+			return nil, fmt.Errorf("the address of the Redis store is required")
+		}
+		prefix := fmt.Sprintf("dedup:%s:%s:%s:", cfg.Component, cfg.Namespace, cfg.Name)
+		store = NewRedisStore(cfg.RedisAddress, cfg.RedisPassword, cfg.RedisDatabase, cfg.RedisTLS, prefix)
+
+	default:
+		return nil, fmt.Errorf("unsupported store type %q", cfg.Store)
+	}
+
+	return &Deduplicator{
+		key:   key,
+		store: store,
+		ttl:   cfg.TTL,
+	}, nil
+}
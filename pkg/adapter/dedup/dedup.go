@@ -0,0 +1,170 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dedup allows adapters to discard events which were already
+// processed within a given time window.
+package dedup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"google.golang.org/protobuf/proto"
+)
+
+// Deduplicator detects events which were already processed within a time
+// window, based on a key computed for each event.
+type Deduplicator struct {
+	key   keyFunc
+	store Store
+	ttl   time.Duration
+}
+
+// NewDeduplicator returns a Deduplicator which records the keys of events in
+// the given store. Keys are computed from the "id" and "source" attributes of
+// events.
+func NewDeduplicator(store Store, ttl time.Duration) *Deduplicator {
+	return &Deduplicator{
+		key:   defaultKey,
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+// WithNamespace returns a Deduplicator which shares the store and time window
+// of d, but records the keys of events in the given namespace, so that they
+// never collide with keys recorded in other namespaces.
+func (d *Deduplicator) WithNamespace(ns string) *Deduplicator {
+	key := d.key
+
+	return &Deduplicator{
+		key: func(e *cloudevents.Event) (string, error) {
+			k, err := key(e)
+			if err != nil {
+				return "", err
+			}
+			return ns + "\x00" + k, nil
+		},
+		store: d.store,
+		ttl:   d.ttl,
+	}
+}
+
+// Seen records the key of the given event and returns whether this key was
+// already recorded within the time window.
+func (d *Deduplicator) Seen(ctx context.Context, e *cloudevents.Event) (bool, error) {
+	k, err := d.key(e)
+	if err != nil {
+		return false, fmt.Errorf("computing deduplication key: %w", err)
+	}
+
+	added, err := d.store.Add(ctx, k, d.ttl)
+	if err != nil {
+		return false, fmt.Errorf("recording deduplication key: %w", err)
+	}
+
+	return !added, nil
+}
+
+// Forget removes the key of the given event, so that a subsequent delivery
+// of the same event isn't considered a duplicate. It is typically called
+// when an event fails to be processed.
+func (d *Deduplicator) Forget(ctx context.Context, e *cloudevents.Event) error {
+	k, err := d.key(e)
+	if err != nil {
+		return fmt.Errorf("computing deduplication key: %w", err)
+	}
+
+	if err := d.store.Remove(ctx, k); err != nil {
+		return fmt.Errorf("removing deduplication key: %w", err)
+	}
+
+	return nil
+}
+
+// keyFunc computes the deduplication key of an event.
+type keyFunc func(*cloudevents.Event) (string, error)
+
+// defaultKey is a keyFunc which combines the "id" and "source" attributes of
+// the event, which uniquely identify an event as per the CloudEvents spec.
+func defaultKey(e *cloudevents.Event) (string, error) {
+	return e.Source() + "\x00" + e.ID(), nil
+}
+
+// ValidateKey returns an error if the given CEL expression can not be used to
+// compute deduplication keys.
+func ValidateKey(expr string) error {
+	_, err := celKey(expr)
+	return err
+}
+
+// celKey returns a keyFunc which evaluates the given CEL expression against
+// events. The expression accesses the event through the "event" variable,
+// which exposes the context attributes "id", "source", "type" and "subject",
+// the map of "extensions", and the event's "data" when it is encoded in JSON.
+func celKey(expr string) (keyFunc, error) {
+	env, err := cel.NewEnv(cel.Declarations(
+		decls.NewVar("event", decls.NewMapType(decls.String, decls.Dyn)),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	ast, iss := env.Compile(expr)
+	if iss.Err() != nil {
+		return nil, iss.Err()
+	}
+
+	if typ := ast.ResultType(); !proto.Equal(typ, decls.String) && !proto.Equal(typ, decls.Dyn) {
+		return nil, fmt.Errorf("expression %q must return string type, got %s", expr, typ.String())
+	}
+
+	prg, err := env.Program(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(e *cloudevents.Event) (string, error) {
+		var data interface{}
+		if err := json.Unmarshal(e.Data(), &data); err != nil {
+			data = string(e.Data())
+		}
+
+		out, _, err := prg.Eval(map[string]interface{}{
+			"event": map[string]interface{}{
+				"id":         e.ID(),
+				"source":     e.Source(),
+				"type":       e.Type(),
+				"subject":    e.Subject(),
+				"extensions": e.Extensions(),
+				"data":       data,
+			},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		if s, ok := out.Value().(string); ok {
+			return s, nil
+		}
+		return fmt.Sprint(out.Value()), nil
+	}, nil
+}
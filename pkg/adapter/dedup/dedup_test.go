@@ -0,0 +1,151 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+func TestDeduplicator(t *testing.T) {
+	ctx := context.Background()
+
+	store := NewMemoryStore(10).(*memoryStore)
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	store.now = func() time.Time { return now }
+
+	d := NewDeduplicator(store, time.Minute)
+
+	e1 := newEvent("1", "source-a", nil)
+	e2 := newEvent("1", "source-b", nil)
+
+	seen, err := d.Seen(ctx, e1)
+	require.NoError(t, err)
+	assert.False(t, seen, "first occurrence is not a duplicate")
+
+	seen, err = d.Seen(ctx, e1)
+	require.NoError(t, err)
+	assert.True(t, seen, "second occurrence is a duplicate")
+
+	seen, err = d.Seen(ctx, e2)
+	require.NoError(t, err)
+	assert.False(t, seen, "same ID with a different source is not a duplicate")
+
+	require.NoError(t, d.Forget(ctx, e2))
+	seen, err = d.Seen(ctx, e2)
+	require.NoError(t, err)
+	assert.False(t, seen, "forgotten event is not a duplicate")
+
+	now = now.Add(2 * time.Minute)
+	seen, err = d.Seen(ctx, e1)
+	require.NoError(t, err)
+	assert.False(t, seen, "expired key is not a duplicate")
+}
+
+func TestMemoryStoreEviction(t *testing.T) {
+	ctx := context.Background()
+
+	s := NewMemoryStore(2)
+
+	for _, k := range []string{"a", "b", "c"} {
+		added, err := s.Add(ctx, k, time.Hour)
+		require.NoError(t, err)
+		assert.True(t, added)
+	}
+
+	added, err := s.Add(ctx, "a", time.Hour)
+	require.NoError(t, err)
+	assert.True(t, added, "least recently used key should have been evicted")
+
+	added, err = s.Add(ctx, "c", time.Hour)
+	require.NoError(t, err)
+	assert.False(t, added)
+}
+
+func TestCELKey(t *testing.T) {
+	testCases := map[string]struct {
+		expr      string
+		data      interface{}
+		expectKey string
+		expectErr bool
+	}{
+		"attributes": {
+			expr:      `event.source + "/" + event.type`,
+			expectKey: "test.source/test.type",
+		},
+		"data field": {
+			expr:      `event.data.order.id`,
+			data:      map[string]interface{}{"order": map[string]interface{}{"id": "o-42"}},
+			expectKey: "o-42",
+		},
+		"extension": {
+			expr:      `event.extensions.tenant + ":" + event.id`,
+			expectKey: "acme:1",
+		},
+		"non-string result": {
+			expr:      `event.data.count`,
+			data:      map[string]interface{}{"count": 3},
+			expectKey: "3",
+		},
+		"missing data field": {
+			expr:      `event.data.missing`,
+			data:      map[string]interface{}{},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			key, err := celKey(tc.expr)
+			require.NoError(t, err)
+
+			k, err := key(newEvent("1", "test.source", tc.data))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectKey, k)
+		})
+	}
+}
+
+func TestCELKeyCompileErrors(t *testing.T) {
+	_, err := celKey(`event.id ==`)
+	assert.Error(t, err, "invalid syntax")
+
+	_, err = celKey(`event.id == "1"`)
+	assert.Error(t, err, "boolean result")
+}
+
+func newEvent(id, source string, data interface{}) *cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetSource(source)
+	e.SetType("test.type")
+	e.SetExtension("tenant", "acme")
+	if data != nil {
+		_ = e.SetData(cloudevents.ApplicationJSON, data)
+	}
+	return &e
+}
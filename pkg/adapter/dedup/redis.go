@@ -0,0 +1,250 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Maximum number of idle connections kept open to the Redis server.
+const redisMaxIdleConns = 8
+
+// Timeout of commands sent to the Redis server, when the context of the
+// command has no deadline.
+const redisDefaultTimeout = 5 * time.Second
+
+// redisStore is a Store backed by a server implementing the Redis protocol
+// (RESP), which allows keys to be shared between adapter replicas.
+type redisStore struct {
+	addr     string
+	password string
+	db       int
+	tls      bool
+	prefix   string
+
+	idle chan *redisConn
+}
+
+var _ Store = (*redisStore)(nil)
+
+// NewRedisStore returns a Store which records keys in the Redis server at
+// the given address. Recorded keys are prefixed with the given prefix.
+func NewRedisStore(addr, password string, db int, useTLS bool, prefix string) Store {
+	return &redisStore{
+		addr:     addr,
+		password: password,
+		db:       db,
+		tls:      useTLS,
+		prefix:   prefix,
+		idle:     make(chan *redisConn, redisMaxIdleConns),
+	}
+}
+
+// Add implements Store.
+func (s *redisStore) Add(ctx context.Context, key string, ttl time.Duration) (bool, error) {
+	ttlMillis := ttl.Milliseconds()
+	if ttlMillis < 1 {
+		ttlMillis = 1
+	}
+
+	// The reply is nil when the key already exists.
+	reply, err := s.do(ctx, "SET", s.prefix+key, "1", "NX", "PX", strconv.FormatInt(ttlMillis, 10))
+	if err != nil {
+		return false, err
+	}
+	return reply != nil, nil
+}
+
+// Remove implements Store.
+func (s *redisStore) Remove(ctx context.Context, key string) error {
+	_, err := s.do(ctx, "DEL", s.prefix+key)
+	return err
+}
+
+// do executes a command using an idle connection, or a new connection if
+// none is available.
+func (s *redisStore) do(ctx context.Context, args ...string) (interface{}, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, redisDefaultTimeout)
+		defer cancel()
+	}
+
+	var conn *redisConn
+
+	select {
+	case conn = <-s.idle:
+	default:
+		var err error
+		if conn, err = s.dial(ctx); err != nil {
+			return nil, fmt.Errorf("connecting to Redis server: %w", err)
+		}
+	}
+
+	reply, err := conn.do(ctx, args...)
+
+	// Errors returned by the server leave the connection usable.
+	if err != nil && !errors.As(err, new(redisError)) {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	select {
+	case s.idle <- conn:
+	default:
+		_ = conn.Close()
+	}
+
+	return reply, err
+}
+
+// dial opens a new connection and authenticates with the server.
+func (s *redisStore) dial(ctx context.Context) (*redisConn, error) {
+	var d interface {
+		DialContext(ctx context.Context, network, addr string) (net.Conn, error)
+	} = &net.Dialer{}
+
+	if s.tls {
+		d = &tls.Dialer{}
+	}
+
+	c, err := d.DialContext(ctx, "tcp", s.addr)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &redisConn{
+		Conn: c,
+		r:    bufio.NewReader(c),
+	}
+
+	if s.password != "" {
+		if _, err := conn.do(ctx, "AUTH", s.password); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("authenticating: %w", err)
+		}
+	}
+
+	if s.db != 0 {
+		if _, err := conn.do(ctx, "SELECT", strconv.Itoa(s.db)); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("selecting database: %w", err)
+		}
+	}
+
+	return conn, nil
+}
+
+// redisConn is a connection to a Redis server.
+type redisConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+// do sends a command to the server and reads its reply.
+func (c *redisConn) do(ctx context.Context, args ...string) (interface{}, error) {
+	deadline, _ := ctx.Deadline()
+	if err := c.SetDeadline(deadline); err != nil {
+		return nil, err
+	}
+
+	buf := make([]byte, 0, 64)
+	buf = append(buf, '*')
+	buf = strconv.AppendInt(buf, int64(len(args)), 10)
+	buf = append(buf, "\r\n"...)
+	for _, a := range args {
+		buf = append(buf, '$')
+		buf = strconv.AppendInt(buf, int64(len(a)), 10)
+		buf = append(buf, "\r\n"...)
+		buf = append(buf, a...)
+		buf = append(buf, "\r\n"...)
+	}
+
+	if _, err := c.Write(buf); err != nil {
+		return nil, err
+	}
+
+	return readRESP(c.r)
+}
+
+// redisError is an error reply returned by a Redis server.
+type redisError string
+
+// Error implements the error interface.
+func (e redisError) Error() string {
+	return string(e)
+}
+
+// readRESP reads a value encoded with the Redis serialization protocol.
+// Strings are returned as string, integers as int64, arrays as []interface{}
+// and nil values as nil.
+func readRESP(r *bufio.Reader) (interface{}, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	if len(line) < 3 || line[len(line)-2] != '\r' {
+		return nil, fmt.Errorf("malformed RESP line %q", line)
+	}
+	typ, val := line[0], line[1:len(line)-2]
+
+	switch typ {
+	case '+':
+		return val, nil
+
+	case '-':
+		return nil, redisError(val)
+
+	case ':':
+		return strconv.ParseInt(val, 10, 64)
+
+	case '$':
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		b := make([]byte, n+2)
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		return string(b[:n]), nil
+
+	case '*':
+		n, err := strconv.Atoi(val)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		arr := make([]interface{}, n)
+		for i := range arr {
+			if arr[i], err = readRESP(r); err != nil {
+				return nil, err
+			}
+		}
+		return arr, nil
+
+	default:
+		return nil, fmt.Errorf("unknown RESP type %q", typ)
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedisStore(t *testing.T) {
+	srv := newFakeRedisServer(t, "s3cr3t")
+
+	ctx := context.Background()
+
+	s := NewRedisStore(srv.addr, "s3cr3t", 3, false, "test:")
+
+	added, err := s.Add(ctx, "k1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	added, err = s.Add(ctx, "k1", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, added)
+
+	require.NoError(t, s.Remove(ctx, "k1"))
+
+	added, err = s.Add(ctx, "k1", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, added)
+
+	assert.Equal(t, []string{"test:k1"}, srv.keys())
+	assert.Equal(t, "3", srv.db)
+	assert.Equal(t, "60000", srv.lastTTL)
+
+	t.Run("wrong password", func(t *testing.T) {
+		s := NewRedisStore(srv.addr, "wrong", 0, false, "test:")
+		_, err := s.Add(ctx, "k2", time.Minute)
+		assert.Error(t, err)
+	})
+}
+
+// fakeRedisServer implements the subset of Redis commands used by redisStore.
+type fakeRedisServer struct {
+	addr     string
+	password string
+
+	mu      sync.Mutex
+	data    map[string]struct{}
+	db      string
+	lastTTL string
+}
+
+func newFakeRedisServer(t *testing.T, password string) *fakeRedisServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = ln.Close() })
+
+	srv := &fakeRedisServer{
+		addr:     ln.Addr().String(),
+		password: password,
+		data:     make(map[string]struct{}),
+	}
+
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go srv.serve(conn)
+		}
+	}()
+
+	return srv
+}
+
+func (s *fakeRedisServer) serve(conn net.Conn) {
+	defer conn.Close()
+
+	r := bufio.NewReader(conn)
+	authenticated := s.password == ""
+
+	for {
+		v, err := readRESP(r)
+		if err != nil {
+			return
+		}
+
+		var args []string
+		for _, a := range v.([]interface{}) {
+			args = append(args, a.(string))
+		}
+
+		s.mu.Lock()
+		switch cmd := strings.ToUpper(args[0]); {
+		case cmd == "AUTH":
+			if args[1] != s.password {
+				fmt.Fprint(conn, "-WRONGPASS invalid password\r\n")
+				break
+			}
+			authenticated = true
+			fmt.Fprint(conn, "+OK\r\n")
+		case !authenticated:
+			fmt.Fprint(conn, "-NOAUTH Authentication required\r\n")
+		case cmd == "SELECT":
+			s.db = args[1]
+			fmt.Fprint(conn, "+OK\r\n")
+		case cmd == "SET":
+			s.lastTTL = args[5]
+			if _, exists := s.data[args[1]]; exists {
+				fmt.Fprint(conn, "$-1\r\n")
+				break
+			}
+			s.data[args[1]] = struct{}{}
+			fmt.Fprint(conn, "+OK\r\n")
+		case cmd == "DEL":
+			delete(s.data, args[1])
+			fmt.Fprint(conn, ":1\r\n")
+		default:
+			fmt.Fprintf(conn, "-ERR unknown command %s\r\n", cmd)
+		}
+		s.mu.Unlock()
+	}
+}
+
+func (s *fakeRedisServer) keys() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	keys := make([]string, 0, len(s.data))
+	for k := range s.data {
+		keys = append(keys, k)
+	}
+	return keys
+}
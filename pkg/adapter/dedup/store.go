@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dedup
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/golang-lru/simplelru"
+)
+
+// Store records deduplication keys for a limited amount of time.
+type Store interface {
+	// Add records the given key for the given duration, unless this key
+	// is already recorded. It returns whether the key was added.
+	Add(ctx context.Context, key string, ttl time.Duration) (bool, error)
+	// Remove deletes the given key from the store.
+	Remove(ctx context.Context, key string) error
+}
+
+// memoryStore is a Store backed by an in-memory LRU cache. When the cache is
+// full, the least recently used keys are evicted regardless of their expiry.
+type memoryStore struct {
+	mu    sync.Mutex
+	cache *simplelru.LRU
+
+	// overridable in tests
+	now func() time.Time
+}
+
+var _ Store = (*memoryStore)(nil)
+
+// NewMemoryStore returns a Store which retains at most the given number of
+// keys in memory.
+func NewMemoryStore(size int) Store {
+	// error is only returned when size is not a positive number
+	cache, _ := simplelru.NewLRU(size, nil)
+
+	return &memoryStore{
+		cache: cache,
+		now:   time.Now,
+	}
+}
+
+// Add implements Store.
+func (s *memoryStore) Add(_ context.Context, key string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+
+	if exp, ok := s.cache.Get(key); ok && now.Before(exp.(time.Time)) {
+		return false, nil
+	}
+
+	s.cache.Add(key, now.Add(ttl))
+	return true, nil
+}
+
+// Remove implements Store.
+func (s *memoryStore) Remove(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cache.Remove(key)
+	return nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package receiver contains helpers for handling the functions passed to
+// cloudevents.Client.StartReceiver.
+package receiver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+// Func is the function signature used by adapters to receive events.
+type Func = func(context.Context, cloudevents.Event) (*cloudevents.Event, protocol.Result)
+
+var (
+	contextType  = reflect.TypeOf((*context.Context)(nil)).Elem()
+	eventType    = reflect.TypeOf((*cloudevents.Event)(nil)).Elem()
+	eventPtrType = reflect.TypeOf((*cloudevents.Event)(nil))
+	resultType   = reflect.TypeOf((*protocol.Result)(nil)).Elem()
+)
+
+// Normalize converts any of the function signatures accepted by
+// cloudevents.Client.StartReceiver to a Func. It allows clients which wrap
+// another cloudevents.Client to intercept received events.
+func Normalize(fn interface{}) (Func, error) {
+	if f, ok := fn.(Func); ok {
+		return f, nil
+	}
+
+	fnVal := reflect.ValueOf(fn)
+	fnType := fnVal.Type()
+	if fnType.Kind() != reflect.Func {
+		return nil, errors.New("must pass a function to handle events")
+	}
+
+	for i := 0; i < fnType.NumIn(); i++ {
+		if in := fnType.In(i); in != contextType && in != eventType {
+			return nil, fmt.Errorf("unsupported parameter type %s in receive function", in)
+		}
+	}
+	for i := 0; i < fnType.NumOut(); i++ {
+		if out := fnType.Out(i); out != eventPtrType && !out.Implements(resultType) {
+			return nil, fmt.Errorf("unsupported return type %s in receive function", out)
+		}
+	}
+
+	return func(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+		args := make([]reflect.Value, fnType.NumIn())
+		for i := range args {
+			if fnType.In(i) == contextType {
+				args[i] = reflect.ValueOf(&ctx).Elem()
+			} else {
+				args[i] = reflect.ValueOf(e)
+			}
+		}
+
+		var resp *cloudevents.Event
+		var res protocol.Result
+
+		for _, out := range fnVal.Call(args) {
+			if out.IsNil() {
+				continue
+			}
+			switch v := out.Interface().(type) {
+			case *cloudevents.Event:
+				resp = v
+			case error:
+				res = v
+			}
+		}
+
+		return resp, res
+	}, nil
+}
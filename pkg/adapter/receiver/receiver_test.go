@@ -0,0 +1,79 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package receiver
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+)
+
+func TestNormalize(t *testing.T) {
+	ctx := context.Background()
+	e := cloudevents.NewEvent()
+	e.SetID("1")
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	errTest := errors.New("test")
+
+	testCases := map[string]struct {
+		fn         interface{}
+		expectResp bool
+		expectErr  error
+	}{
+		"no argument": {
+			fn: func() {},
+		},
+		"event only, result": {
+			fn:        func(cloudevents.Event) protocol.Result { return errTest },
+			expectErr: errTest,
+		},
+		"context and event, reply": {
+			fn:         func(context.Context, cloudevents.Event) *cloudevents.Event { return &e },
+			expectResp: true,
+		},
+		"context and event, reply and result": {
+			fn: func(context.Context, cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+				return &e, errTest
+			},
+			expectResp: true,
+			expectErr:  errTest,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			fn, err := Normalize(tc.fn)
+			require.NoError(t, err)
+
+			resp, res := fn(ctx, e)
+			assert.Equal(t, tc.expectResp, resp != nil)
+			assert.Equal(t, tc.expectErr, res)
+		})
+	}
+
+	t.Run("invalid signature", func(t *testing.T) {
+		_, err := Normalize(func(string) {})
+		assert.Error(t, err)
+	})
+}
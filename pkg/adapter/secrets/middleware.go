@@ -0,0 +1,241 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+)
+
+// EnvConfig contains the parameters of the periodic resolution of values.
+type EnvConfig struct {
+	// Interval at which values are read again, unless their TTL is shorter.
+	RefreshInterval time.Duration `envconfig:"SECRETS_REFRESH_INTERVAL" default:"1m"`
+	// Maximum duration of the resolution of all values.
+	ResolveTimeout time.Duration `envconfig:"SECRETS_RESOLVE_TIMEOUT" default:"30s"`
+}
+
+// resolver is the Resolver created by WithResolvedEnv, if the environment
+// contains References.
+var (
+	resolverMu sync.Mutex
+	resolver   *Resolver
+)
+
+// WithResolvedEnv wraps the given EnvConfigAccessor constructor so that the
+// values of environment variables sourced from files or external providers
+// are resolved before the adapter's environment gets processed.
+func WithResolvedEnv(ctor pkgadapter.EnvConfigConstructor) pkgadapter.EnvConfigConstructor {
+	return func() pkgadapter.EnvConfigAccessor {
+		refs, err := ParseReferences(os.Environ())
+		if err != nil {
+			log.Fatalf("Error parsing references to secret values: %s", err)
+		}
+
+		if len(refs) > 0 {
+			cfg := &EnvConfig{}
+			if err := envconfig.Process("", cfg); err != nil {
+				log.Fatalf("Error processing secrets configuration: %s", err)
+			}
+
+			r, err := NewResolver(refs)
+			if err != nil {
+				log.Fatalf("Error creating resolver of secret values: %s", err)
+			}
+
+			ctx, cancel := context.WithTimeout(context.Background(), cfg.ResolveTimeout)
+			defer cancel()
+
+			if _, err := r.Resolve(ctx); err != nil {
+				log.Fatalf("Error resolving secret values: %s", err)
+			}
+
+			resolverMu.Lock()
+			resolver = r
+			resolverMu.Unlock()
+		}
+
+		return ctor()
+	}
+}
+
+// Reloader is implemented by adapters which can apply changes to values
+// resolved by WithResolvedEnv without being restarted.
+type Reloader interface {
+	// ReloadSecrets is called with the environment variables whose value
+	// changed. Returning an error causes the adapter to be restarted.
+	ReloadSecrets(ctx context.Context, changed map[string]string) error
+}
+
+// Middleware wraps the given adapter constructor so that the values resolved
+// by WithResolvedEnv are periodically read again. When a value changes, the
+// adapter is reloaded if it implements Reloader. Otherwise, it is stopped and
+// its Start method returns an error, so that the container of the adapter gets
+// restarted and resolves the changed values upon startup.
+//
+// Adapters aren't re-created within the same process, because the CloudEvents
+// client they were created with can't receive events anymore once stopped.
+func Middleware(ctor pkgadapter.AdapterConstructor) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, env pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+		a := ctor(ctx, env, ceClient)
+
+		resolverMu.Lock()
+		r := resolver
+		resolverMu.Unlock()
+
+		if r == nil {
+			return a
+		}
+
+		logger := logging.FromContext(ctx)
+
+		cfg := &EnvConfig{}
+		if err := envconfig.Process("", cfg); err != nil {
+			logger.Panicw("Error processing secrets configuration", zap.Error(err))
+		}
+
+		return &reloadingAdapter{
+			adapter:  a,
+			resolver: r,
+			interval: cfg.RefreshInterval,
+			timeout:  cfg.ResolveTimeout,
+			logger:   logger.Named("secrets"),
+		}
+	}
+}
+
+// errRestartRequired is returned by reloadingAdapter when the wrapped adapter
+// must be restarted to apply changed values.
+var errRestartRequired = errors.New("secret values changed, adapter must be restarted")
+
+// reloadingAdapter is a pkgadapter.Adapter which periodically resolves the
+// values of References, and reloads or stops the wrapped adapter when they
+// change.
+type reloadingAdapter struct {
+	adapter pkgadapter.Adapter
+
+	resolver *Resolver
+	interval time.Duration
+	timeout  time.Duration
+	logger   *zap.SugaredLogger
+}
+
+// Start implements pkgadapter.Adapter.
+func (a *reloadingAdapter) Start(ctx context.Context) error {
+	runCtx, stop := context.WithCancel(ctx)
+	defer stop()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- a.adapter.Start(runCtx)
+	}()
+
+	err := a.watch(ctx, errCh)
+	if !errors.Is(err, errRestartRequired) {
+		return err
+	}
+
+	stop()
+	if err := <-errCh; err != nil {
+		a.logger.Warnw("Adapter returned an error while stopping", zap.Error(err))
+	}
+
+	return err
+}
+
+// watch periodically refreshes the values of References until either the
+// running adapter returns, or it must be restarted. In the former case, the
+// error returned by the adapter is returned.
+func (a *reloadingAdapter) watch(ctx context.Context, errCh <-chan error) error {
+	t := time.NewTimer(a.nextRefresh())
+	defer t.Stop()
+
+	for {
+		select {
+		case err := <-errCh:
+			return err
+
+		case <-t.C:
+			if err := a.refresh(ctx); err != nil {
+				return err
+			}
+			t.Reset(a.nextRefresh())
+		}
+	}
+}
+
+// refresh resolves the values of References again and applies the changed
+// values to the wrapped adapter. errRestartRequired is returned when the
+// wrapped adapter must be restarted.
+func (a *reloadingAdapter) refresh(ctx context.Context) error {
+	resolveCtx, cancel := context.WithTimeout(ctx, a.timeout)
+	defer cancel()
+
+	changed, err := a.resolver.Resolve(resolveCtx)
+	if err != nil {
+		// keep running with the current values, the provider may only be
+		// temporarily unavailable
+		a.logger.Warnw("Unable to refresh secret values", zap.Error(err))
+		return nil
+	}
+
+	if len(changed) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(changed))
+	for n := range changed {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	r, ok := a.adapter.(Reloader)
+	if !ok {
+		a.logger.Infow("Stopping adapter to apply changed secret values", zap.Strings("vars", names))
+		return errRestartRequired
+	}
+
+	if err := r.ReloadSecrets(ctx, changed); err != nil {
+		a.logger.Errorw("Unable to reload changed secret values, stopping adapter",
+			zap.Strings("vars", names), zap.Error(err))
+		return errRestartRequired
+	}
+
+	a.logger.Infow("Reloaded changed secret values", zap.Strings("vars", names))
+	return nil
+}
+
+// nextRefresh returns the duration until the next refresh of values.
+func (a *reloadingAdapter) nextRefresh() time.Duration {
+	if ttl := a.resolver.TTL(); ttl > 0 && ttl < a.interval {
+		return ttl
+	}
+	return a.interval
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secrets resolves the values of adapter environment variables which
+// are sourced from files or from external secret providers, and reloads
+// adapters when those values change.
+//
+// The environment variables to resolve are listed in SECRETS_REFERENCES, and
+// the source of the value of each of them is declared in a sibling
+// environment variable:
+//
+//	SECRETS_REFERENCES=FOO,BAR
+//	FOO_FROM_FILE=/mnt/secrets/foo
+//	BAR_FROM_PROVIDER=vault:secret/data/app#bar
+//
+// Environment variables which aren't listed in SECRETS_REFERENCES are left
+// untouched, regardless of their name.
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// EnvReferences is the name of the environment variable which contains the
+// comma-separated list of environment variables to resolve.
+const EnvReferences = "SECRETS_REFERENCES"
+
+// Suffixes of the environment variables which declare the source of the
+// value of another environment variable.
+const (
+	EnvSuffixFromFile     = "_FROM_FILE"
+	EnvSuffixFromProvider = "_FROM_PROVIDER"
+)
+
+// providerFile is the name of the Provider which reads values from files.
+const providerFile = "file"
+
+// Reference designates a value stored outside of the adapter's environment.
+type Reference struct {
+	// Name of the environment variable which receives the value.
+	EnvVar string
+	// Name of the Provider which stores the value.
+	Provider string
+	// Path of the secret within the Provider.
+	Path string
+	// Key of the value within the secret. Empty for files.
+	Key string
+}
+
+// ParseReferences returns the References declared in the given environment,
+// in the "key=value" form returned by os.Environ. Only the environment
+// variables listed in EnvReferences are considered.
+func ParseReferences(environ []string) ([]Reference, error) {
+	env := make(map[string]string, len(environ))
+	for _, kv := range environ {
+		name, val, _ := strings.Cut(kv, "=")
+		env[name] = val
+	}
+
+	var refs []Reference
+
+	for _, name := range strings.Split(env[EnvReferences], ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+
+		fromFile, hasFile := env[name+EnvSuffixFromFile]
+		fromProvider, hasProvider := env[name+EnvSuffixFromProvider]
+
+		switch {
+		case hasFile && hasProvider:
+			return nil, fmt.Errorf("%s: only one of %s and %s may be set",
+				name, name+EnvSuffixFromFile, name+EnvSuffixFromProvider)
+
+		case hasFile:
+			if fromFile == "" {
+				return nil, fmt.Errorf("%s: empty file path", name+EnvSuffixFromFile)
+			}
+
+			refs = append(refs, Reference{
+				EnvVar:   name,
+				Provider: providerFile,
+				Path:     fromFile,
+			})
+
+		case hasProvider:
+			ref, err := parseProviderReference(fromProvider)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name+EnvSuffixFromProvider, err)
+			}
+			ref.EnvVar = name
+
+			refs = append(refs, *ref)
+
+		default:
+			return nil, fmt.Errorf("%s is listed in %s, but neither %s nor %s is set",
+				name, EnvReferences, name+EnvSuffixFromFile, name+EnvSuffixFromProvider)
+		}
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].EnvVar < refs[j].EnvVar
+	})
+
+	return refs, nil
+}
+
+// parseProviderReference parses a reference in the "provider:path#key" form.
+func parseProviderReference(s string) (*Reference, error) {
+	provider, pathKey, ok := strings.Cut(s, ":")
+	if !ok || provider == "" {
+		return nil, fmt.Errorf("reference %q doesn't match the format provider:path#key", s)
+	}
+
+	sep := strings.LastIndexByte(pathKey, '#')
+	if sep < 1 || sep == len(pathKey)-1 {
+		return nil, fmt.Errorf("reference %q doesn't match the format provider:path#key", s)
+	}
+
+	return &Reference{
+		Provider: provider,
+		Path:     pathKey[:sep],
+		Key:      pathKey[sep+1:],
+	}, nil
+}
+
+// Value is a value read from a Provider.
+type Value struct {
+	Data string
+	// Duration after which the value should be read again. Zero when the
+	// Provider doesn't define any.
+	TTL time.Duration
+}
+
+// Provider reads values from a secret store.
+type Provider interface {
+	// Read returns the value of the given key of the secret at the given
+	// path.
+	Read(ctx context.Context, path, key string) (*Value, error)
+}
+
+// ProviderConstructor creates a Provider, typically from parameters read from
+// the environment.
+type ProviderConstructor func() (Provider, error)
+
+var (
+	providersMu sync.RWMutex
+	providers   = map[string]ProviderConstructor{
+		providerFile:  func() (Provider, error) { return fileProvider{}, nil },
+		providerVault: NewVaultProviderFromEnv,
+	}
+)
+
+// RegisterProvider makes a Provider available under the given name.
+func RegisterProvider(name string, ctor ProviderConstructor) {
+	providersMu.Lock()
+	defer providersMu.Unlock()
+	providers[name] = ctor
+}
+
+// Resolver resolves References into environment variables.
+type Resolver struct {
+	refs      []Reference
+	providers map[string]Provider
+
+	// last resolved value of each environment variable
+	values map[string]string
+	// shortest TTL among the values read during the last resolution
+	ttl time.Duration
+}
+
+// NewResolver returns a Resolver for the given References, along with the
+// Providers they require.
+func NewResolver(refs []Reference) (*Resolver, error) {
+	providersMu.RLock()
+	defer providersMu.RUnlock()
+
+	r := &Resolver{
+		refs:      refs,
+		providers: make(map[string]Provider),
+		values:    make(map[string]string, len(refs)),
+	}
+
+	for _, ref := range refs {
+		if _, ok := r.providers[ref.Provider]; ok {
+			continue
+		}
+
+		ctor, ok := providers[ref.Provider]
+		if !ok {
+			return nil, fmt.Errorf("%s: unknown secret provider %q", ref.EnvVar, ref.Provider)
+		}
+
+		p, err := ctor()
+		if err != nil {
+			return nil, fmt.Errorf("creating secret provider %q: %w", ref.Provider, err)
+		}
+		r.providers[ref.Provider] = p
+	}
+
+	return r, nil
+}
+
+// Resolve reads the values of all References and sets them in the process'
+// environment. It returns the environment variables whose value changed since
+// the previous call, which are all of them on the first call.
+func (r *Resolver) Resolve(ctx context.Context) (map[string]string, error) {
+	changed := make(map[string]string)
+	var ttl time.Duration
+
+	for _, ref := range r.refs {
+		v, err := r.providers[ref.Provider].Read(ctx, ref.Path, ref.Key)
+		if err != nil {
+			return nil, fmt.Errorf("reading value of %s from %s: %w", ref.EnvVar, ref.Provider, err)
+		}
+
+		if v.TTL > 0 && (ttl == 0 || v.TTL < ttl) {
+			ttl = v.TTL
+		}
+
+		if prev, ok := r.values[ref.EnvVar]; ok && prev == v.Data {
+			continue
+		}
+		changed[ref.EnvVar] = v.Data
+	}
+
+	for name, val := range changed {
+		if err := os.Setenv(name, val); err != nil {
+			return nil, fmt.Errorf("setting environment variable %s: %w", name, err)
+		}
+		r.values[name] = val
+	}
+	r.ttl = ttl
+
+	return changed, nil
+}
+
+// TTL returns the shortest TTL among the values read during the last
+// resolution, or zero if none of them has a TTL.
+func (r *Resolver) TTL() time.Duration {
+	return r.ttl
+}
+
+// fileProvider is a Provider which reads values from files. The path of a
+// Reference is the path of the file, and its key is ignored.
+type fileProvider struct{}
+
+var _ Provider = fileProvider{}
+
+// Read implements Provider.
+func (fileProvider) Read(_ context.Context, path, _ string) (*Value, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	// files are often written with a trailing line break
+	return &Value{Data: strings.TrimRight(string(b), "\r\n")}, nil
+}
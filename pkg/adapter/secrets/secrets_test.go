@@ -0,0 +1,221 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestParseReferences(t *testing.T) {
+	refs, err := ParseReferences([]string{
+		"NAMESPACE=test",
+		"SECRETS_REFERENCES=PASSWORD,API_KEY",
+		"PASSWORD_FROM_PROVIDER=vault:secret/data/app#password",
+		"API_KEY_FROM_FILE=/mnt/secrets/api-key",
+		// not listed in SECRETS_REFERENCES, must be ignored
+		"CERT_FROM_FILE=/etc/ssl/cert.pem",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []Reference{
+		{EnvVar: "API_KEY", Provider: "file", Path: "/mnt/secrets/api-key"},
+		{EnvVar: "PASSWORD", Provider: "vault", Path: "secret/data/app", Key: "password"},
+	}, refs)
+
+	refs, err = ParseReferences([]string{"CERT_FROM_FILE=/etc/ssl/cert.pem"})
+	require.NoError(t, err)
+	assert.Empty(t, refs)
+
+	for _, invalid := range [][]string{
+		{"SECRETS_REFERENCES=PASSWORD", "PASSWORD_FROM_PROVIDER=secret/data/app#password"},
+		{"SECRETS_REFERENCES=PASSWORD", "PASSWORD_FROM_PROVIDER=vault:secret/data/app"},
+		{"SECRETS_REFERENCES=PASSWORD", "PASSWORD_FROM_PROVIDER=vault:secret/data/app#"},
+		{"SECRETS_REFERENCES=PASSWORD", "PASSWORD_FROM_FILE="},
+		{"SECRETS_REFERENCES=PASSWORD", "PASSWORD_FROM_FILE=/f", "PASSWORD_FROM_PROVIDER=vault:app#password"},
+		{"SECRETS_REFERENCES=PASSWORD"},
+	} {
+		_, err := ParseReferences(invalid)
+		assert.Error(t, err, "Expected %q to be rejected", invalid)
+	}
+}
+
+func TestResolver(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "api-key")
+	require.NoError(t, os.WriteFile(path, []byte("key1"), 0o600))
+
+	RegisterProvider("test", func() (Provider, error) {
+		return staticProvider{"app#password": "passw0rd"}, nil
+	})
+
+	const (
+		envAPIKey   = "TEST_RESOLVER_API_KEY"
+		envPassword = "TEST_RESOLVER_PASSWORD"
+	)
+	t.Setenv(envAPIKey, "")
+	t.Setenv(envPassword, "")
+
+	r, err := NewResolver([]Reference{
+		{EnvVar: envAPIKey, Provider: "file", Path: path},
+		{EnvVar: envPassword, Provider: "test", Path: "app", Key: "password"},
+	})
+	require.NoError(t, err)
+
+	changed, err := r.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{envAPIKey: "key1", envPassword: "passw0rd"}, changed)
+	assert.Equal(t, "key1", os.Getenv(envAPIKey))
+	assert.Equal(t, "passw0rd", os.Getenv(envPassword))
+
+	changed, err = r.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Empty(t, changed, "Expected no change")
+
+	require.NoError(t, os.WriteFile(path, []byte("key2"), 0o600))
+
+	changed, err = r.Resolve(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{envAPIKey: "key2"}, changed)
+	assert.Equal(t, "key2", os.Getenv(envAPIKey))
+
+	_, err = NewResolver([]Reference{{EnvVar: envAPIKey, Provider: "unknown"}})
+	assert.Error(t, err)
+}
+
+func TestReloadingAdapter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(path, []byte("token1"), 0o600))
+
+	const envToken = "TEST_RELOADING_ADAPTER_TOKEN"
+	t.Setenv(envToken, "")
+
+	newAdapter := func(t *testing.T, a pkgadapter.Adapter) *reloadingAdapter {
+		r, err := NewResolver([]Reference{{EnvVar: envToken, Provider: "file", Path: path}})
+		require.NoError(t, err)
+		_, err = r.Resolve(context.Background())
+		require.NoError(t, err)
+
+		return &reloadingAdapter{
+			adapter:  a,
+			resolver: r,
+			interval: 10 * time.Millisecond,
+			timeout:  time.Second,
+			logger:   logtesting.TestLogger(t),
+		}
+	}
+
+	t.Run("stops adapter on change", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("token1"), 0o600))
+
+		ba := &blockingAdapter{stopped: make(chan struct{})}
+		a := newAdapter(t, ba)
+		assert.Equal(t, "token1", os.Getenv(envToken))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- a.Start(ctx) }()
+
+		require.NoError(t, os.WriteFile(path, []byte("token2"), 0o600))
+
+		select {
+		case err := <-errCh:
+			assert.ErrorIs(t, err, errRestartRequired, "Expected adapter to require a restart")
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for the adapter to be stopped")
+		}
+
+		select {
+		case <-ba.stopped:
+		default:
+			t.Error("Expected wrapped adapter to be stopped")
+		}
+	})
+
+	t.Run("reloads adapter on change", func(t *testing.T) {
+		require.NoError(t, os.WriteFile(path, []byte("token1"), 0o600))
+		ra := &reloaderAdapter{
+			blockingAdapter: blockingAdapter{stopped: make(chan struct{})},
+			reloaded:        make(chan map[string]string, 1),
+		}
+		a := newAdapter(t, ra)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- a.Start(ctx) }()
+
+		require.NoError(t, os.WriteFile(path, []byte("token2"), 0o600))
+
+		select {
+		case changed := <-ra.reloaded:
+			assert.Equal(t, map[string]string{envToken: "token2"}, changed)
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timed out waiting for the adapter to be reloaded")
+		}
+
+		cancel()
+		assert.NoError(t, <-errCh)
+	})
+}
+
+// staticProvider is a Provider which returns values from a map indexed by
+// "path#key".
+type staticProvider map[string]string
+
+func (p staticProvider) Read(_ context.Context, path, key string) (*Value, error) {
+	v, ok := p[path+"#"+key]
+	if !ok {
+		return nil, errors.New("not found")
+	}
+	return &Value{Data: v}, nil
+}
+
+// blockingAdapter is a pkgadapter.Adapter which runs until its context is
+// cancelled.
+type blockingAdapter struct {
+	stopped chan struct{}
+}
+
+func (a *blockingAdapter) Start(ctx context.Context) error {
+	<-ctx.Done()
+	close(a.stopped)
+	return nil
+}
+
+// reloaderAdapter is a blockingAdapter which implements Reloader.
+type reloaderAdapter struct {
+	blockingAdapter
+	reloaded chan map[string]string
+}
+
+func (a *reloaderAdapter) ReloadSecrets(_ context.Context, changed map[string]string) error {
+	a.reloaded <- changed
+	return nil
+}
@@ -0,0 +1,267 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+)
+
+// providerVault is the name of the Provider which reads values from Vault.
+const providerVault = "vault"
+
+// tokenRenewThreshold is the fraction of a Vault token's TTL after which the
+// token gets renewed.
+const tokenRenewThreshold = 2.0 / 3
+
+// VaultEnvConfig contains the parameters of the Vault provider.
+type VaultEnvConfig struct {
+	// Address of the Vault server.
+	Address string `envconfig:"VAULT_ADDR" required:"true"`
+	// Vault Enterprise namespace.
+	Namespace string `envconfig:"VAULT_NAMESPACE"`
+	// Timeout of requests to the Vault API.
+	ClientTimeout time.Duration `envconfig:"VAULT_CLIENT_TIMEOUT" default:"30s"`
+
+	// Static token used to authenticate with Vault. Takes precedence over
+	// the Kubernetes auth method.
+	Token string `envconfig:"VAULT_TOKEN"`
+
+	// Parameters of the Kubernetes auth method.
+	AuthMount     string `envconfig:"VAULT_AUTH_MOUNT" default:"kubernetes"`
+	AuthRole      string `envconfig:"VAULT_AUTH_ROLE"`
+	AuthTokenPath string `envconfig:"VAULT_AUTH_TOKEN_PATH" default:"/var/run/secrets/kubernetes.io/serviceaccount/token"`
+}
+
+// NewVaultProviderFromEnv returns a Provider which reads values from the KV
+// secrets engine of Vault, using parameters read from the environment.
+func NewVaultProviderFromEnv() (Provider, error) {
+	cfg := &VaultEnvConfig{}
+	if err := envconfig.Process("", cfg); err != nil {
+		return nil, fmt.Errorf("processing Vault configuration: %w", err)
+	}
+
+	return NewVaultProvider(cfg, &http.Client{Timeout: cfg.ClientTimeout})
+}
+
+// NewVaultProvider returns a Provider which reads values from the KV secrets
+// engine of Vault.
+//
+// The provider authenticates either with a static token, or through the
+// Kubernetes auth method using the token of the adapter's ServiceAccount. In
+// the latter case, the obtained Vault token is renewed before it expires, and
+// replaced by a new one when it can not be renewed.
+func NewVaultProvider(cfg *VaultEnvConfig, cli *http.Client) (*VaultProvider, error) {
+	if cfg.Token == "" && cfg.AuthRole == "" {
+		return nil, errors.New("either a Vault token or a role for the Kubernetes auth method must be provided")
+	}
+
+	return &VaultProvider{
+		cfg:   cfg,
+		cli:   cli,
+		token: cfg.Token,
+	}, nil
+}
+
+// VaultProvider is a Provider which reads values from Vault.
+type VaultProvider struct {
+	cfg *VaultEnvConfig
+	cli *http.Client
+
+	mu             sync.Mutex
+	token          string
+	tokenRenewable bool
+	tokenRenewAt   time.Time // zero if the token doesn't expire
+}
+
+var _ Provider = (*VaultProvider)(nil)
+
+// Read implements Provider. Both versions of the KV secrets engine are
+// supported. With version 2, the path must include the "data/" segment, e.g.
+// "secret/data/app".
+func (p *VaultProvider) Read(ctx context.Context, path, key string) (*Value, error) {
+	token, err := p.authToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("authenticating with Vault: %w", err)
+	}
+
+	resp := &vaultSecret{}
+	if err := p.do(ctx, http.MethodGet, "/v1/"+strings.TrimPrefix(path, "/"), token, nil, resp); err != nil {
+		return nil, err
+	}
+
+	data := resp.Data
+	// KV version 2 nests the secret's data and metadata inside "data"
+	if nested, ok := data["data"].(map[string]interface{}); ok {
+		if _, hasMeta := data["metadata"]; hasMeta {
+			data = nested
+		}
+	}
+
+	v, ok := data[key]
+	if !ok {
+		return nil, fmt.Errorf("key %q not found in secret %q", key, path)
+	}
+
+	val := &Value{
+		TTL: time.Duration(resp.LeaseDuration) * time.Second,
+	}
+
+	switch v := v.(type) {
+	case string:
+		val.Data = v
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return nil, fmt.Errorf("serializing value of key %q: %w", key, err)
+		}
+		val.Data = string(b)
+	}
+
+	return val, nil
+}
+
+// authToken returns a valid Vault token, renewing or replacing the current
+// token when it is about to expire.
+func (p *VaultProvider) authToken(ctx context.Context) (string, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.cfg.Token != "" {
+		return p.token, nil
+	}
+
+	if p.token != "" && (p.tokenRenewAt.IsZero() || time.Now().Before(p.tokenRenewAt)) {
+		return p.token, nil
+	}
+
+	if p.token != "" && p.tokenRenewable {
+		auth := &vaultSecret{}
+		err := p.do(ctx, http.MethodPost, "/v1/auth/token/renew-self", p.token, struct{}{}, auth)
+		if err == nil && auth.Auth != nil {
+			p.setToken(auth.Auth)
+			return p.token, nil
+		}
+		// fall back to a new login, e.g. if the token reached its max TTL
+	}
+
+	jwt, err := os.ReadFile(p.cfg.AuthTokenPath)
+	if err != nil {
+		return "", fmt.Errorf("reading ServiceAccount token: %w", err)
+	}
+
+	login := struct {
+		Role string `json:"role"`
+		JWT  string `json:"jwt"`
+	}{
+		Role: p.cfg.AuthRole,
+		JWT:  strings.TrimSpace(string(jwt)),
+	}
+
+	auth := &vaultSecret{}
+	if err := p.do(ctx, http.MethodPost, "/v1/auth/"+p.cfg.AuthMount+"/login", "", login, auth); err != nil {
+		return "", err
+	}
+	if auth.Auth == nil || auth.Auth.ClientToken == "" {
+		return "", errors.New("login response doesn't contain any token")
+	}
+
+	p.setToken(auth.Auth)
+	return p.token, nil
+}
+
+// setToken records the given auth token. Must be called with p.mu held.
+func (p *VaultProvider) setToken(a *vaultAuth) {
+	p.token = a.ClientToken
+	p.tokenRenewable = a.Renewable
+
+	p.tokenRenewAt = time.Time{}
+	if a.LeaseDuration > 0 {
+		ttl := time.Duration(float64(a.LeaseDuration)*tokenRenewThreshold) * time.Second
+		p.tokenRenewAt = time.Now().Add(ttl)
+	}
+}
+
+// do sends a request to the Vault API and decodes the response into out.
+func (p *VaultProvider) do(ctx context.Context, method, path, token string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("serializing request body: %w", err)
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, strings.TrimSuffix(p.cfg.Address, "/")+path, body)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+	if token != "" {
+		req.Header.Set("X-Vault-Token", token)
+	}
+	if p.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", p.cfg.Namespace)
+	}
+
+	res, err := p.cli.Do(req)
+	if err != nil {
+		return fmt.Errorf("sending request to Vault: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 300 {
+		errResp := &struct {
+			Errors []string `json:"errors"`
+		}{}
+		_ = json.NewDecoder(res.Body).Decode(errResp)
+		return fmt.Errorf("%s %s: Vault returned status %d: %s",
+			method, path, res.StatusCode, strings.Join(errResp.Errors, "; "))
+	}
+
+	if err := json.NewDecoder(res.Body).Decode(out); err != nil {
+		return fmt.Errorf("decoding Vault response: %w", err)
+	}
+	return nil
+}
+
+// vaultSecret is the representation of a secret in responses of the Vault
+// API.
+type vaultSecret struct {
+	LeaseDuration int                    `json:"lease_duration"`
+	Data          map[string]interface{} `json:"data"`
+	Auth          *vaultAuth             `json:"auth"`
+}
+
+// vaultAuth is the authentication information returned by Vault upon login
+// or token renewal.
+type vaultAuth struct {
+	ClientToken   string `json:"client_token"`
+	LeaseDuration int    `json:"lease_duration"`
+	Renewable     bool   `json:"renewable"`
+}
@@ -0,0 +1,217 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	fakeSAToken    = "sa-jwt"
+	fakeVaultToken = "s.vaulttoken"
+)
+
+func TestVaultProviderKVv2WithKubernetesAuth(t *testing.T) {
+	v := newFakeVault(t)
+
+	jwtPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(jwtPath, []byte(fakeSAToken+"\n"), 0o600))
+
+	p, err := NewVaultProvider(&VaultEnvConfig{
+		Address:       v.URL,
+		AuthMount:     "kubernetes",
+		AuthRole:      "adapter",
+		AuthTokenPath: jwtPath,
+	}, v.Client())
+	require.NoError(t, err)
+
+	val, err := p.Read(context.Background(), "secret/data/app", "password")
+	require.NoError(t, err)
+	assert.Equal(t, "passw0rd", val.Data)
+	assert.Zero(t, val.TTL)
+
+	val, err = p.Read(context.Background(), "secret/data/app", "port")
+	require.NoError(t, err)
+	assert.Equal(t, "5432", val.Data, "Expected non-string values to be serialized to JSON")
+
+	_, err = p.Read(context.Background(), "secret/data/app", "missing")
+	assert.Error(t, err)
+
+	assert.EqualValues(t, 1, v.logins.Load(), "Expected the token to be reused")
+}
+
+func TestVaultProviderKVv1WithStaticToken(t *testing.T) {
+	v := newFakeVault(t)
+
+	p, err := NewVaultProvider(&VaultEnvConfig{
+		Address: v.URL,
+		Token:   fakeVaultToken,
+	}, v.Client())
+	require.NoError(t, err)
+
+	val, err := p.Read(context.Background(), "kv/app", "apiKey")
+	require.NoError(t, err)
+	assert.Equal(t, "k3y", val.Data)
+	assert.Equal(t, time.Hour, val.TTL)
+
+	assert.Zero(t, v.logins.Load())
+}
+
+func TestVaultProviderTokenRenewal(t *testing.T) {
+	v := newFakeVault(t)
+
+	jwtPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(jwtPath, []byte(fakeSAToken), 0o600))
+
+	p, err := NewVaultProvider(&VaultEnvConfig{
+		Address:       v.URL,
+		AuthMount:     "kubernetes",
+		AuthRole:      "adapter",
+		AuthTokenPath: jwtPath,
+	}, v.Client())
+	require.NoError(t, err)
+
+	_, err = p.Read(context.Background(), "secret/data/app", "password")
+	require.NoError(t, err)
+
+	// simulate the expiry of the renewal threshold
+	p.tokenRenewAt = time.Now().Add(-time.Second)
+
+	_, err = p.Read(context.Background(), "secret/data/app", "password")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, v.renewals.Load(), "Expected the token to be renewed")
+	assert.EqualValues(t, 1, v.logins.Load())
+
+	// simulate a token which can no longer be renewed
+	p.tokenRenewAt = time.Now().Add(-time.Second)
+	v.denyRenewal.Store(true)
+
+	_, err = p.Read(context.Background(), "secret/data/app", "password")
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, v.logins.Load(), "Expected a new login")
+}
+
+func TestVaultProviderErrors(t *testing.T) {
+	v := newFakeVault(t)
+
+	_, err := NewVaultProvider(&VaultEnvConfig{Address: v.URL}, v.Client())
+	assert.Error(t, err, "Expected missing credentials to be rejected")
+
+	p, err := NewVaultProvider(&VaultEnvConfig{
+		Address: v.URL,
+		Token:   "s.invalid",
+	}, v.Client())
+	require.NoError(t, err)
+
+	_, err = p.Read(context.Background(), "secret/data/app", "password")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "permission denied")
+}
+
+// fakeVault is a fake Vault server which serves a KV v2 secret at
+// "secret/data/app" and a KV v1 secret at "kv/app".
+type fakeVault struct {
+	*httptest.Server
+
+	logins      atomic.Int32
+	renewals    atomic.Int32
+	denyRenewal atomic.Bool
+}
+
+func newFakeVault(t *testing.T) *fakeVault {
+	v := &fakeVault{}
+
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/v1/auth/kubernetes/login", func(w http.ResponseWriter, r *http.Request) {
+		var login struct {
+			Role string `json:"role"`
+			JWT  string `json:"jwt"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&login); err != nil || login.JWT != fakeSAToken || login.Role != "adapter" {
+			writeVaultError(w, http.StatusBadRequest, "invalid login")
+			return
+		}
+		v.logins.Add(1)
+		writeVaultAuth(w)
+	})
+
+	mux.HandleFunc("/v1/auth/token/renew-self", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != fakeVaultToken || v.denyRenewal.Load() {
+			writeVaultError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		v.renewals.Add(1)
+		writeVaultAuth(w)
+	})
+
+	mux.HandleFunc("/v1/secret/data/app", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != fakeVaultToken {
+			writeVaultError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"lease_duration": 0,
+			"data": map[string]interface{}{
+				"data":     map[string]interface{}{"password": "passw0rd", "port": 5432},
+				"metadata": map[string]interface{}{"version": 3},
+			},
+		})
+	})
+
+	mux.HandleFunc("/v1/kv/app", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != fakeVaultToken {
+			writeVaultError(w, http.StatusForbidden, "permission denied")
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{
+			"lease_duration": 3600,
+			"data":           map[string]interface{}{"apiKey": "k3y"},
+		})
+	})
+
+	v.Server = httptest.NewServer(mux)
+	t.Cleanup(v.Close)
+
+	return v
+}
+
+func writeVaultAuth(w http.ResponseWriter) {
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{
+		"auth": map[string]interface{}{
+			"client_token":   fakeVaultToken,
+			"lease_duration": 3600,
+			"renewable":      true,
+		},
+	})
+}
+
+func writeVaultError(w http.ResponseWriter, code int, msg string) {
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]interface{}{"errors": []string{msg}})
+}
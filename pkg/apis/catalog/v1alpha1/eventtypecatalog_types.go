@@ -0,0 +1,78 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +genclient
+// +genclient:noStatus
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventTypeCatalog lists the types of events produced and accepted by a
+// TriggerMesh component instance. It is maintained by the TriggerMesh
+// controller and should not be edited by users.
+type EventTypeCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   EventTypeCatalogSpec   `json:"spec,omitempty"`
+	Status EventTypeCatalogStatus `json:"status,omitempty"`
+}
+
+// EventTypeCatalogSpec defines the component described by the catalog.
+type EventTypeCatalogSpec struct {
+	// Reference to the component instance.
+	Component duckv1.KReference `json:"component"`
+}
+
+// EventTypeCatalogStatus lists the event types of the component.
+type EventTypeCatalogStatus struct {
+	// Types of events produced by the component.
+	// +optional
+	Produces []EventType `json:"produces,omitempty"`
+	// Types of events accepted by the component.
+	// +optional
+	Accepts []EventType `json:"accepts,omitempty"`
+}
+
+// EventType describes a type of CloudEvent.
+type EventType struct {
+	// Value of the CloudEvent 'type' attribute. The value "*" matches any
+	// type.
+	Type string `json:"type"`
+	// Value of the CloudEvent 'source' attribute, when known in advance.
+	// +optional
+	Source string `json:"source,omitempty"`
+	// Reference to the schema of the event's data.
+	// +optional
+	Schema *apis.URL `json:"schema,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// EventTypeCatalogList is a list of EventTypeCatalog resources.
+type EventTypeCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []EventTypeCatalog `json:"items"`
+}
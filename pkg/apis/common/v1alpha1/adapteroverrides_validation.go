@@ -0,0 +1,40 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	pkgapis "knative.dev/pkg/apis"
+)
+
+// Validate the adapter overrides. Only parameters which would prevent the
+// adapter from starting are validated here, regardless of the kind of
+// component the overrides apply to.
+func (o *AdapterOverrides) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if o.Deduplication != nil {
+		errs = errs.Also(o.Deduplication.Validate(ctx).ViaField("deduplication"))
+	}
+
+	if o.Dispatch != nil && o.Dispatch.OrderingKey != nil {
+		errs = errs.Also(o.Dispatch.OrderingKey.Validate(ctx).ViaField("dispatch", "orderingKey"))
+	}
+
+	return errs
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	pkgapis "knative.dev/pkg/apis"
+)
+
+// Validate the autoscaling parameters.
+func (a *Autoscaling) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	minReplicas := int32(1)
+	if a.MinReplicas != nil {
+		minReplicas = *a.MinReplicas
+		if minReplicas < 1 {
+			errs = errs.Also(pkgapis.ErrOutOfBoundsValue(minReplicas, 1, "+Inf", "minReplicas"))
+		}
+	}
+
+	if a.MaxReplicas < minReplicas {
+		errs = errs.Also(pkgapis.ErrOutOfBoundsValue(a.MaxReplicas, minReplicas, "+Inf", "maxReplicas"))
+	}
+
+	if a.TargetLag != nil && *a.TargetLag < 1 {
+		errs = errs.Also(pkgapis.ErrOutOfBoundsValue(*a.TargetLag, 1, "+Inf", "targetLag"))
+	}
+
+	return errs
+}
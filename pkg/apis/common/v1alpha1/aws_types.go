@@ -91,8 +91,44 @@ type AWSSecurityCredentials struct {
 //
 // +k8s:deepcopy-gen=true
 type AWSEndpoint struct {
-	// URL of the endpoint.
+	// URL of the endpoint of the primary AWS service of the component,
+	// such as Amazon S3 for an AWSS3Source.
 	URL *pkgapis.URL `json:"url,omitempty"`
+
+	// URLs of the endpoints of other AWS services the component interacts
+	// with, indexed by endpoint ID (e.g. "sqs", "sts"). Takes precedence
+	// over URL for the primary AWS service.
+	// +optional
+	ServiceURLs map[string]pkgapis.URL `json:"serviceURLs,omitempty"`
+
+	// Use path-style addressing for Amazon S3 buckets
+	// (http://endpoint/bucket/key) instead of virtual-hosted-style addressing
+	// (http://bucket.endpoint/key). Required by most S3-compatible
+	// alternatives, such as MinIO. Only applies to Amazon S3.
+	// +optional
+	S3ForcePathStyle bool `json:"s3ForcePathStyle,omitempty"`
+}
+
+// URLsByService returns the URLs of the endpoints of AWS services, indexed by
+// endpoint ID, given the endpoint IDs of the primary AWS services of the
+// component.
+func (e *AWSEndpoint) URLsByService(primaryServices ...string) map[string]string {
+	if e == nil {
+		return nil
+	}
+
+	urls := make(map[string]string, len(primaryServices)+len(e.ServiceURLs))
+
+	if e.URL != nil {
+		for _, svc := range primaryServices {
+			urls[svc] = e.URL.String()
+		}
+	}
+	for svc, u := range e.ServiceURLs {
+		urls[svc] = u.String()
+	}
+
+	return urls
 }
 
 // WantsOwnServiceAccount indicates wether the object requires its own SA.
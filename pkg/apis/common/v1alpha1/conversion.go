@@ -0,0 +1,128 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"knative.dev/pkg/apis"
+)
+
+// HubConvertTo converts the given instance of the hub version of a component
+// kind to another version. Conversions between the hub and other versions are
+// implemented by the other versions, which avoids circular imports between
+// API packages.
+func HubConvertTo(ctx context.Context, hub, to apis.Convertible) error {
+	if reflect.TypeOf(hub) == reflect.TypeOf(to) {
+		return fmt.Errorf("%T is already the hub version", to)
+	}
+	return to.ConvertFrom(ctx, hub)
+}
+
+// HubConvertFrom converts an instance of a component kind to the given
+// instance of the hub version of that kind. Conversions between the hub and
+// other versions are implemented by the other versions, which avoids circular
+// imports between API packages.
+func HubConvertFrom(ctx context.Context, hub, from apis.Convertible) error {
+	if reflect.TypeOf(hub) == reflect.TypeOf(from) {
+		return fmt.Errorf("%T is already the hub version", from)
+	}
+	return from.ConvertTo(ctx, hub)
+}
+
+// ConvertViaJSON converts an instance of a component kind to another version
+// of that kind by serializing it to JSON. It is suitable for versions in which
+// the serialized shape of the kind is identical. Attributes which only exist
+// in the source version are discarded.
+//
+// The object the instance is converted to is reset before the conversion,
+// except for its type metadata.
+func ConvertViaJSON(from, to runtime.Object) error {
+	fromVal, toVal := reflect.ValueOf(from), reflect.ValueOf(to)
+	if fromVal.Kind() != reflect.Ptr || toVal.Kind() != reflect.Ptr ||
+		fromVal.Elem().Type().Name() != toVal.Elem().Type().Name() {
+
+		return fmt.Errorf("cannot convert %T to %T", from, to)
+	}
+
+	data, err := json.Marshal(from)
+	if err != nil {
+		return fmt.Errorf("serializing %T: %w", from, err)
+	}
+
+	gvk := to.GetObjectKind().GroupVersionKind()
+	toVal.Elem().Set(reflect.Zero(toVal.Elem().Type()))
+
+	if err := json.Unmarshal(data, to); err != nil {
+		return fmt.Errorf("deserializing %T: %w", to, err)
+	}
+	to.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return nil
+}
+
+// ValidateViaHub validates the given instance of a component kind using the
+// validation logic of the hub version of that kind. The hub argument is an
+// empty instance of the hub version, which is populated by the function.
+func ValidateViaHub(ctx context.Context, obj, hub apis.Convertible) *apis.FieldError {
+	if err := obj.ConvertTo(ctx, hub); err != nil {
+		return &apis.FieldError{
+			Message: "Object can not be represented in the storage version of the API",
+			Paths:   []string{apis.CurrentField},
+			Details: err.Error(),
+		}
+	}
+
+	v, ok := hub.(apis.Validatable)
+	if !ok {
+		return nil
+	}
+
+	if base, ok := apis.GetBaseline(ctx).(apis.Convertible); ok && base != nil {
+		baseHub := reflect.New(reflect.TypeOf(hub).Elem()).Interface().(apis.Convertible)
+		if err := base.ConvertTo(ctx, baseHub); err == nil {
+			ctx = apis.WithinSubResourceUpdate(ctx, baseHub, apis.GetUpdatedSubresource(ctx))
+		}
+	}
+
+	return v.Validate(ctx)
+}
+
+// SetDefaultsViaHub sets the default values of the given instance of a
+// component kind using the defaulting logic of the hub version of that kind.
+// The hub argument is an empty instance of the hub version, which is populated
+// by the function.
+func SetDefaultsViaHub(ctx context.Context, obj, hub apis.Convertible) {
+	d, ok := hub.(apis.Defaultable)
+	if !ok {
+		return
+	}
+
+	if err := obj.ConvertTo(ctx, hub); err != nil {
+		// objects which can't be converted are rejected during validation
+		return
+	}
+
+	d.SetDefaults(ctx)
+
+	_ = obj.ConvertFrom(ctx, hub)
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+)
+
+// Validate the deduplication parameters.
+func (d *Deduplication) Validate(ctx context.Context) *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if d.Key != nil {
+		if strings.TrimSpace(*d.Key) == "" {
+			errs = errs.Also(pkgapis.ErrInvalidValue(*d.Key, "key"))
+		} else if err := dedup.ValidateKey(*d.Key); err != nil {
+			errs = errs.Also(pkgapis.ErrInvalidValue(fmt.Sprintf("Cannot compile expression: %v", err), "key"))
+		}
+	}
+
+	if d.TTL != nil && *d.TTL <= 0 {
+		errs = errs.Also(pkgapis.ErrInvalidValue(d.TTL.String(), "ttl"))
+	}
+
+	if d.Store != nil {
+		errs = errs.Also(d.Store.Validate(ctx).ViaField("store"))
+	}
+
+	return errs
+}
+
+// Validate the deduplication store.
+func (s *DeduplicationStore) Validate(ctx context.Context) *pkgapis.FieldError {
+	if s.Memory != nil && s.Redis != nil {
+		return pkgapis.ErrMultipleOneOf("memory", "redis")
+	}
+
+	if m := s.Memory; m != nil && m.Size != nil && *m.Size < 1 {
+		return pkgapis.ErrOutOfBoundsValue(*m.Size, 1, "+Inf", "memory.size")
+	}
+
+	if r := s.Redis; r != nil {
+		var errs *pkgapis.FieldError
+
+		if r.Address == "" {
+			errs = errs.Also(pkgapis.ErrMissingField("redis.address"))
+		}
+		if r.Database != nil && *r.Database < 0 {
+			errs = errs.Also(pkgapis.ErrOutOfBoundsValue(*r.Database, 0, "+Inf", "redis.database"))
+		}
+
+		return errs
+	}
+
+	return nil
+}
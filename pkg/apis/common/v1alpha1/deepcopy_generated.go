@@ -66,6 +66,13 @@ func (in *AWSEndpoint) DeepCopyInto(out *AWSEndpoint) {
 		*out = new(pkgapis.URL)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceURLs != nil {
+		in, out := &in.ServiceURLs, &out.ServiceURLs
+		*out = make(map[string]pkgapis.URL, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 	return
 }
 
@@ -142,6 +149,20 @@ func (in *AdapterOverrides) DeepCopyInto(out *AdapterOverrides) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Volumes != nil {
+		in, out := &in.Volumes, &out.Volumes
+		*out = make([]v1.Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeMounts != nil {
+		in, out := &in.VolumeMounts, &out.VolumeMounts
+		*out = make([]v1.VolumeMount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Labels != nil {
 		in, out := &in.Labels, &out.Labels
 		*out = make(map[string]string, len(*in))
@@ -156,6 +177,21 @@ func (in *AdapterOverrides) DeepCopyInto(out *AdapterOverrides) {
 			(*out)[key] = val
 		}
 	}
+	if in.Deduplication != nil {
+		in, out := &in.Deduplication, &out.Deduplication
+		*out = new(Deduplication)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Dispatch != nil {
+		in, out := &in.Dispatch, &out.Dispatch
+		*out = new(Dispatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Autoscaling != nil {
+		in, out := &in.Autoscaling, &out.Autoscaling
+		*out = new(Autoscaling)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -169,6 +205,32 @@ func (in *AdapterOverrides) DeepCopy() *AdapterOverrides {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Autoscaling) DeepCopyInto(out *Autoscaling) {
+	*out = *in
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TargetLag != nil {
+		in, out := &in.TargetLag, &out.TargetLag
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Autoscaling.
+func (in *Autoscaling) DeepCopy() *Autoscaling {
+	if in == nil {
+		return nil
+	}
+	out := new(Autoscaling)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudEventStatus) DeepCopyInto(out *CloudEventStatus) {
 	*out = *in
@@ -190,6 +252,146 @@ func (in *CloudEventStatus) DeepCopy() *CloudEventStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deduplication) DeepCopyInto(out *Deduplication) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.Store != nil {
+		in, out := &in.Store, &out.Store
+		*out = new(DeduplicationStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deduplication.
+func (in *Deduplication) DeepCopy() *Deduplication {
+	if in == nil {
+		return nil
+	}
+	out := new(Deduplication)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeduplicationMemoryStore) DeepCopyInto(out *DeduplicationMemoryStore) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeduplicationMemoryStore.
+func (in *DeduplicationMemoryStore) DeepCopy() *DeduplicationMemoryStore {
+	if in == nil {
+		return nil
+	}
+	out := new(DeduplicationMemoryStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeduplicationRedisStore) DeepCopyInto(out *DeduplicationRedisStore) {
+	*out = *in
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Database != nil {
+		in, out := &in.Database, &out.Database
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeduplicationRedisStore.
+func (in *DeduplicationRedisStore) DeepCopy() *DeduplicationRedisStore {
+	if in == nil {
+		return nil
+	}
+	out := new(DeduplicationRedisStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeduplicationStore) DeepCopyInto(out *DeduplicationStore) {
+	*out = *in
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(DeduplicationMemoryStore)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Redis != nil {
+		in, out := &in.Redis, &out.Redis
+		*out = new(DeduplicationRedisStore)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeduplicationStore.
+func (in *DeduplicationStore) DeepCopy() *DeduplicationStore {
+	if in == nil {
+		return nil
+	}
+	out := new(DeduplicationStore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Dispatch) DeepCopyInto(out *Dispatch) {
+	*out = *in
+	if in.Workers != nil {
+		in, out := &in.Workers, &out.Workers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.QueueDepth != nil {
+		in, out := &in.QueueDepth, &out.QueueDepth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.OrderingKey != nil {
+		in, out := &in.OrderingKey, &out.OrderingKey
+		*out = new(EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dispatch.
+func (in *Dispatch) DeepCopy() *Dispatch {
+	if in == nil {
+		return nil
+	}
+	out := new(Dispatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EksIAM) DeepCopyInto(out *EksIAM) {
 	*out = *in
@@ -211,6 +413,32 @@ func (in *EksIAM) DeepCopy() *EksIAM {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventKeySource) DeepCopyInto(out *EventKeySource) {
+	*out = *in
+	if in.Attribute != nil {
+		in, out := &in.Attribute, &out.Attribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataPath != nil {
+		in, out := &in.DataPath, &out.DataPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventKeySource.
+func (in *EventKeySource) DeepCopy() *EventKeySource {
+	if in == nil {
+		return nil
+	}
+	out := new(EventKeySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudAuth) DeepCopyInto(out *GoogleCloudAuth) {
 	*out = *in
@@ -242,6 +470,22 @@ func (in *GoogleCloudAuth) DeepCopy() *GoogleCloudAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProviderSecretSelector) DeepCopyInto(out *ProviderSecretSelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProviderSecretSelector.
+func (in *ProviderSecretSelector) DeepCopy() *ProviderSecretSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(ProviderSecretSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Status) DeepCopyInto(out *Status) {
 	*out = *in
@@ -269,6 +513,11 @@ func (in *ValueFromField) DeepCopyInto(out *ValueFromField) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValueFromProvider != nil {
+		in, out := &in.ValueFromProvider, &out.ValueFromProvider
+		*out = new(ProviderSecretSelector)
+		**out = **in
+	}
 	return
 }
 
@@ -34,6 +34,16 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/status"
 )
 
+// AnnotationConfigHash is the annotation which holds a hash of the versions
+// of the Secrets and ConfigMaps referenced by the adapter of a component. It is
+// set on the adapter's Pod template, where a change of value triggers a
+// rollout, as well as in the component's status.
+const AnnotationConfigHash = "triggermesh.io/config-hash"
+
+// AnnotationEventTypeCatalog is the annotation which holds the name of the
+// EventTypeCatalog of a component instance, in the component's status.
+const AnnotationEventTypeCatalog = "triggermesh.io/event-type-catalog"
+
 // Status conditions
 const (
 	// ConditionReady has status True when the component is ready to receive/send events.
@@ -42,6 +52,17 @@ const (
 	ConditionSinkProvided apis.ConditionType = "SinkProvided"
 	// ConditionDeployed has status True when the component's adapter is up and running.
 	ConditionDeployed apis.ConditionType = "Deployed"
+	// ConditionSuspended has status True when the component's adapter is
+	// scaled to zero upon request. It doesn't affect the Ready condition.
+	ConditionSuspended apis.ConditionType = "Suspended"
+	// ConditionUpstreamConnected has status True when the component's adapter
+	// reports being connected to the external system it receives events
+	// from. It doesn't affect the Ready condition.
+	ConditionUpstreamConnected apis.ConditionType = "UpstreamConnected"
+	// ConditionLastEventDelivered has status True when the component's
+	// adapter reports that the last event it attempted to send was
+	// delivered. It doesn't affect the Ready condition.
+	ConditionLastEventDelivered apis.ConditionType = "LastEventDelivered"
 )
 
 // Reasons for status conditions
@@ -56,6 +77,27 @@ const (
 	ReasonSinkNotFound = "SinkNotFound"
 	// ReasonSinkEmpty is set on a SinkProvided condition when a sink URI is empty.
 	ReasonSinkEmpty = "EmptySinkURI"
+
+	// ReasonSuspendRequested is set on a Suspended condition when the
+	// suspension of a component was requested by the user.
+	ReasonSuspendRequested = "SuspendRequested"
+	// ReasonSuspendUnsupported is set on a Suspended condition when the
+	// suspension of a component was requested but can not be honored.
+	ReasonSuspendUnsupported = "SuspendUnsupported"
+
+	// ReasonEventReceived is set on an UpstreamConnected condition when the
+	// adapter reported the reception of an event.
+	ReasonEventReceived = "EventReceived"
+	// ReasonUpstreamError is set on an UpstreamConnected condition when the
+	// adapter reported an error from the external system.
+	ReasonUpstreamError = "UpstreamError"
+
+	// ReasonEventDelivered is set on a LastEventDelivered condition when the
+	// adapter reported the delivery of an event.
+	ReasonEventDelivered = "EventDelivered"
+	// ReasonDeliveryFailed is set on a LastEventDelivered condition when the
+	// adapter reported a failure to deliver an event.
+	ReasonDeliveryFailed = "DeliveryFailed"
 )
 
 // DefaultConditionSet is a generic set of status conditions used by default in
@@ -148,6 +190,84 @@ func (m *StatusManager) MarkRBACNotBound() {
 		ReasonRBACNotBound, "The adapter's ServiceAccount can not be bound")
 }
 
+// MarkSuspended sets the Suspended condition to True, indicating that the
+// adapter Deployment is scaled to zero.
+func (m *StatusManager) MarkSuspended() {
+	m.ConditionSet.Manage(m).MarkTrueWithReason(ConditionSuspended,
+		ReasonSuspendRequested, "The adapter is scaled to zero")
+}
+
+// MarkServiceSuspended sets the Suspended condition to True, indicating that
+// the adapter Service was deleted. The component becomes unaddressable until
+// it is resumed.
+func (m *StatusManager) MarkServiceSuspended() {
+	m.Address = nil
+	m.ConditionSet.Manage(m).MarkTrue(ConditionDeployed)
+	m.ConditionSet.Manage(m).MarkTrueWithReason(ConditionSuspended,
+		ReasonSuspendRequested, "The adapter Service is deleted")
+}
+
+// Reasons why the suspension of a component can not be honored.
+const (
+	SuspendUnsupportedMultiTenant = "Multi-tenant components can not be suspended individually"
+)
+
+// MarkSuspendUnsupported sets the Suspended condition to False with the given
+// message, indicating that the component can not be suspended.
+func (m *StatusManager) MarkSuspendUnsupported(msg string) {
+	m.ConditionSet.Manage(m).MarkFalse(ConditionSuspended, ReasonSuspendUnsupported, msg)
+}
+
+// MarkNotSuspended clears the Suspended condition.
+func (m *StatusManager) MarkNotSuspended() {
+	// only fails for terminal conditions, which Suspended isn't
+	_ = m.ConditionSet.Manage(m).ClearCondition(ConditionSuspended)
+}
+
+// MarkUpstreamConnected sets the UpstreamConnected condition to True. The
+// reason indicates whether the adapter received events.
+func (m *StatusManager) MarkUpstreamConnected(eventReceived bool) {
+	if !eventReceived {
+		m.ConditionSet.Manage(m).MarkTrue(ConditionUpstreamConnected)
+		return
+	}
+
+	m.ConditionSet.Manage(m).MarkTrueWithReason(ConditionUpstreamConnected,
+		ReasonEventReceived, "The adapter received events from the external system")
+}
+
+// MarkUpstreamDisconnected sets the UpstreamConnected condition to False with
+// the error reported by the adapter.
+func (m *StatusManager) MarkUpstreamDisconnected(errMsg string) {
+	m.ConditionSet.Manage(m).MarkFalse(ConditionUpstreamConnected,
+		ReasonUpstreamError, "The adapter failed to communicate with the external system: %s", errMsg)
+}
+
+// MarkLastEventDelivered sets the LastEventDelivered condition to True.
+func (m *StatusManager) MarkLastEventDelivered() {
+	m.ConditionSet.Manage(m).MarkTrueWithReason(ConditionLastEventDelivered,
+		ReasonEventDelivered, "The last event was delivered")
+}
+
+// MarkLastEventNotDelivered sets the LastEventDelivered condition to False
+// with the error reported by the adapter.
+func (m *StatusManager) MarkLastEventNotDelivered(errMsg string) {
+	m.ConditionSet.Manage(m).MarkFalse(ConditionLastEventDelivered,
+		ReasonDeliveryFailed, "The adapter failed to deliver the last event: %s", errMsg)
+}
+
+// ClearUpstreamConnected clears the UpstreamConnected condition.
+func (m *StatusManager) ClearUpstreamConnected() {
+	// only fails for terminal conditions, which UpstreamConnected isn't
+	_ = m.ConditionSet.Manage(m).ClearCondition(ConditionUpstreamConnected)
+}
+
+// ClearLastEventDelivered clears the LastEventDelivered condition.
+func (m *StatusManager) ClearLastEventDelivered() {
+	// only fails for terminal conditions, which LastEventDelivered isn't
+	_ = m.ConditionSet.Manage(m).ClearCondition(ConditionLastEventDelivered)
+}
+
 // PropagateDeploymentAvailability uses the readiness of the provided
 // Deployment to determine whether the Deployed condition should be marked as
 // True or False.
@@ -243,3 +363,25 @@ func (m *StatusManager) SetRoute(urlPath string) {
 
 	m.Address.URL.Path = path.Join(m.Address.URL.Path, urlPath)
 }
+
+// SetConfigHash records the hash of the Secrets and ConfigMaps referenced by
+// the component's adapter, or clears it if the given hash is empty.
+func (m *StatusManager) SetConfigHash(hash string) {
+	if hash == "" {
+		delete(m.Annotations, AnnotationConfigHash)
+		return
+	}
+
+	if m.Annotations == nil {
+		m.Annotations = make(map[string]string, 1)
+	}
+	m.Annotations[AnnotationConfigHash] = hash
+}
+
+// SetEventTypeCatalog records the name of the component's EventTypeCatalog.
+func (m *StatusManager) SetEventTypeCatalog(name string) {
+	if m.Annotations == nil {
+		m.Annotations = make(map[string]string, 1)
+	}
+	m.Annotations[AnnotationEventTypeCatalog] = name
+}
@@ -0,0 +1,34 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// AnnotationSuspended is the annotation which suspends a component instance
+// when its value is "true". The receive adapter of a suspended component is
+// scaled to zero, while the rest of its configuration is preserved.
+const AnnotationSuspended = "triggermesh.io/suspended"
+
+// IsSuspended returns whether the given object is suspended.
+func IsSuspended(obj metav1.Object) bool {
+	suspended, _ := strconv.ParseBool(obj.GetAnnotations()[AnnotationSuspended])
+	return suspended
+}
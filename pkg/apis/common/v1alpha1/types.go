@@ -19,6 +19,8 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
 )
 
 // ValueFromField is a struct field that can have its value either defined
@@ -34,8 +36,39 @@ type ValueFromField struct {
 	// Field value from a Kubernetes Secret.
 	// +optional
 	ValueFromSecret *corev1.SecretKeySelector `json:"valueFromSecret,omitempty"`
+	// Field value read by the adapter from a file at the given path, such as
+	// a Secret mounted by the Secrets Store CSI driver.
+	// +optional
+	ValueFromFile string `json:"valueFromFile,omitempty"`
+	// Field value read by the adapter from an external secret provider.
+	// +optional
+	ValueFromProvider *ProviderSecretSelector `json:"valueFromProvider,omitempty"`
+}
+
+// ProviderSecretSelector selects a key of a secret stored in an external
+// secret provider.
+//
+// +k8s:deepcopy-gen=true
+type ProviderSecretSelector struct {
+	// Name of the secret provider.
+	Provider SecretProvider `json:"provider"`
+	// Path of the secret within the provider, e.g. "secret/data/app" for a
+	// secret stored in the KV secrets engine of Vault.
+	Path string `json:"path"`
+	// Key of the value within the secret.
+	Key string `json:"key"`
 }
 
+// SecretProvider is the name of an external secret provider.
+type SecretProvider string
+
+// Supported secret providers.
+const (
+	// SecretProviderVault reads secrets from the KV secrets engine of
+	// HashiCorp Vault.
+	SecretProviderVault SecretProvider = "vault"
+)
+
 // AdapterOverrides are applied on top of the default adapter parameters.
 //
 // +k8s:deepcopy-gen=true
@@ -53,10 +86,135 @@ type AdapterOverrides struct {
 	Affinity *corev1.Affinity `json:"affinity,omitempty"`
 	// Environment variables applied on adapter container.
 	Env []corev1.EnvVar `json:"env,omitempty"`
+	// Volumes attached to the adapter's Pod, e.g. CSI volumes providing
+	// secret files.
+	Volumes []corev1.Volume `json:"volumes,omitempty"`
+	// Volumes mounted into the adapter container.
+	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 	// Labels applied on adapter container.
 	Labels map[string]string `json:"labels,omitempty"`
 	// Annotations applied on adapter container.
 	Annotations map[string]string `json:"annotations,omitempty"`
+	// Deduplication of the events processed by the adapter.
+	Deduplication *Deduplication `json:"deduplication,omitempty"`
+	// Dispatch of the events received by the adapter. Only supported by
+	// targets.
+	Dispatch *Dispatch `json:"dispatch,omitempty"`
+	// Autoscaling of the adapter based on the backlog of messages pending
+	// consumption. Only supported by sources which consume messages from a
+	// queue or topic.
+	Autoscaling *Autoscaling `json:"autoscaling,omitempty"`
+}
+
+// Deduplication configures the discarding of events which were already
+// processed within a given time window.
+//
+// +k8s:deepcopy-gen=true
+type Deduplication struct {
+	// CEL expression evaluated against each event to compute its
+	// deduplication key. Defaults to the combination of the event's "id"
+	// and "source" attributes.
+	// +optional
+	Key *string `json:"key,omitempty"`
+	// Time window during which events with an identical key are considered
+	// duplicates. Defaults to 10m.
+	// +optional
+	TTL *apis.Duration `json:"ttl,omitempty"`
+	// Store in which deduplication keys are recorded. Defaults to an
+	// in-memory store local to each adapter replica.
+	// +optional
+	Store *DeduplicationStore `json:"store,omitempty"`
+}
+
+// DeduplicationStore is the store in which deduplication keys are recorded.
+//
+// +k8s:deepcopy-gen=true
+type DeduplicationStore struct {
+	// Optional: no more than one of the following may be specified.
+
+	// In-memory LRU cache.
+	// +optional
+	Memory *DeduplicationMemoryStore `json:"memory,omitempty"`
+	// Server implementing the Redis protocol.
+	// +optional
+	Redis *DeduplicationRedisStore `json:"redis,omitempty"`
+}
+
+// DeduplicationMemoryStore is an in-memory LRU cache of deduplication keys.
+//
+// +k8s:deepcopy-gen=true
+type DeduplicationMemoryStore struct {
+	// Maximum number of keys retained in the cache. Defaults to 10000.
+	// +optional
+	Size *int32 `json:"size,omitempty"`
+}
+
+// DeduplicationRedisStore is a server implementing the Redis protocol, which
+// allows deduplication keys to be shared between adapter replicas.
+//
+// +k8s:deepcopy-gen=true
+type DeduplicationRedisStore struct {
+	// Address of the server, in the format "host:port".
+	Address string `json:"address"`
+	// Password used to authenticate with the server.
+	// +optional
+	Password *ValueFromField `json:"password,omitempty"`
+	// Index of the database to select.
+	// +optional
+	Database *int32 `json:"database,omitempty"`
+	// Whether to connect to the server over TLS.
+	// +optional
+	TLS *bool `json:"tls,omitempty"`
+}
+
+// Dispatch controls the concurrency and ordering with which a target
+// processes the events it receives.
+//
+// +k8s:deepcopy-gen=true
+type Dispatch struct {
+	// Number of events processed concurrently. Defaults to 10.
+	// +optional
+	Workers *int32 `json:"workers,omitempty"`
+	// Maximum number of events waiting to be processed by each worker.
+	// Events received while the queue is full are rejected with a 429 (Too
+	// Many Requests) status code. Defaults to 100.
+	// +optional
+	QueueDepth *int32 `json:"queueDepth,omitempty"`
+	// Key which identifies events that must be processed in the order they
+	// were received. Events with identical keys are processed sequentially,
+	// events with different keys are processed in parallel.
+	// +optional
+	OrderingKey *EventKeySource `json:"orderingKey,omitempty"`
+}
+
+// EventKeySource is the location of a value inside events, such as a key.
+//
+// +k8s:deepcopy-gen=true
+type EventKeySource struct {
+	// Required: exactly one of the following must be specified.
+
+	// Name of a CloudEvents context attribute or extension.
+	// +optional
+	Attribute *string `json:"attribute,omitempty"`
+	// Path of a field inside the JSON data of events, in GJSON syntax.
+	// +optional
+	DataPath *string `json:"dataPath,omitempty"`
+}
+
+// Autoscaling configures the horizontal scaling of an adapter based on the
+// number of messages pending consumption (lag).
+//
+// +k8s:deepcopy-gen=true
+type Autoscaling struct {
+	// Lower limit for the number of replicas of the adapter. Defaults to 1.
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+	// Upper limit for the number of replicas of the adapter.
+	MaxReplicas int32 `json:"maxReplicas"`
+	// Number of messages pending consumption which each replica of the
+	// adapter is expected to handle. Defaults to 100.
+	// +optional
+	TargetLag *int64 `json:"targetLag,omitempty"`
 }
 
 // GroupObject holds the API group object types.
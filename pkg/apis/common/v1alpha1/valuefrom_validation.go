@@ -0,0 +1,42 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import pkgapis "knative.dev/pkg/apis"
+
+// ValidateSecretRef ensures the value is sourced from a Kubernetes Secret.
+// It is used for fields which the adapter can only read from a mounted or
+// injected Secret key, and for which other sources would be silently ignored.
+func (v *ValueFromField) ValidateSecretRef() *pkgapis.FieldError {
+	var errs *pkgapis.FieldError
+
+	if v.Value != "" {
+		errs = errs.Also(pkgapis.ErrDisallowedFields("value"))
+	}
+	if v.ValueFromFile != "" {
+		errs = errs.Also(pkgapis.ErrDisallowedFields("valueFromFile"))
+	}
+	if v.ValueFromProvider != nil {
+		errs = errs.Also(pkgapis.ErrDisallowedFields("valueFromProvider"))
+	}
+
+	if v.ValueFromSecret == nil {
+		errs = errs.Also(pkgapis.ErrMissingField("valueFromSecret"))
+	}
+
+	return errs
+}
@@ -0,0 +1,178 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/logging"
+)
+
+// AnnotationVerify is the annotation which requests the validating webhook to
+// verify that a component instance can communicate with the external services
+// it interacts with, using the provided configuration and credentials.
+//
+// The value of the annotation determines how failed verifications are
+// reported: "warn" returns a warning to the client, "reject" rejects the
+// object.
+const AnnotationVerify = "triggermesh.io/verify"
+
+// Supported values of the AnnotationVerify annotation.
+const (
+	VerifyModeWarn   = "warn"
+	VerifyModeReject = "reject"
+)
+
+// ErrVerificationUnsupported is returned by a Verifier when a component
+// instance can't be verified with the given configuration, for instance
+// because it authenticates using an identity which is only available to its
+// receive adapter. It is always reported as a warning.
+var ErrVerificationUnsupported = errors.New("verification is not supported for this configuration")
+
+// verificationTimeout is the maximum duration of a verification, which must
+// remain below the timeout of admission webhooks.
+const verificationTimeout = 5 * time.Second
+
+// Verifier verifies that component instances can communicate with external
+// services.
+type Verifier interface {
+	// Verify performs read-only calls to the external services the given
+	// component instance interacts with.
+	Verify(context.Context, Reconcilable) error
+}
+
+type verifierKey struct{}
+
+// WithVerifier returns a copy of the parent context in which the value
+// associated with the verifierKey is the given Verifier.
+func WithVerifier(ctx context.Context, v Verifier) context.Context {
+	return context.WithValue(ctx, verifierKey{}, v)
+}
+
+// VerifierFromContext returns the Verifier stored in the context.
+func VerifierFromContext(ctx context.Context) Verifier {
+	if v, ok := ctx.Value(verifierKey{}).(Verifier); ok {
+		return v
+	}
+	return nil
+}
+
+// Verify verifies the given component instance using the Verifier stored in
+// the context, if the instance has the AnnotationVerify annotation.
+//
+// Instances are verified upon creation, and upon updates of their spec or of
+// the annotation itself. Verifications are only performed when the cluster
+// operator enabled them by providing a Verifier, otherwise a warning is
+// returned.
+//
+// The cause of a failed verification is logged but not returned to the
+// client, since it may disclose details about services and credentials which
+// the client is not entitled to.
+func Verify(ctx context.Context, r Reconcilable) *apis.FieldError {
+	mode, ok := r.GetAnnotations()[AnnotationVerify]
+	if !ok {
+		return nil
+	}
+
+	if mode != VerifyModeWarn && mode != VerifyModeReject {
+		return apis.ErrInvalidValue(mode, AnnotationVerify).ViaField("metadata", "annotations")
+	}
+
+	if r.GetDeletionTimestamp() != nil || apis.IsInStatusUpdate(ctx) || !verificationRequired(ctx, r) {
+		return nil
+	}
+
+	v := VerifierFromContext(ctx)
+	if v == nil {
+		return (&apis.FieldError{
+			Message: "Verifications of the connectivity with external services are disabled",
+			Paths:   []string{AnnotationVerify},
+		}).ViaField("metadata", "annotations").At(apis.WarningLevel)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, verificationTimeout)
+	defer cancel()
+
+	err := v.Verify(ctx, r)
+	if err == nil {
+		return nil
+	}
+
+	verifErr := &apis.FieldError{
+		Message: "Verification of the connectivity with external services failed",
+		Paths:   []string{"spec"},
+	}
+
+	if errors.Is(err, ErrVerificationUnsupported) {
+		// the reasons why a configuration can't be verified are static
+		// and don't disclose anything about external services
+		verifErr.Details = err.Error()
+		return verifErr.At(apis.WarningLevel)
+	}
+
+	logging.FromContext(ctx).Infow("Verification of the connectivity with external services failed",
+		zap.String("kind", r.GetGroupVersionKind().Kind),
+		zap.String("namespace", r.GetNamespace()),
+		zap.String("name", r.GetName()),
+		zap.Error(err))
+
+	verifErr.Details = "The cause of the failure is reported in the logs of the TriggerMesh webhook"
+
+	if mode == VerifyModeWarn {
+		return verifErr.At(apis.WarningLevel)
+	}
+	return verifErr
+}
+
+// verificationRequired returns whether the given component instance should be
+// verified in the current admission request. Updates which don't modify the
+// spec of an instance that was already verified, such as changes of
+// finalizers, are not verified again.
+func verificationRequired(ctx context.Context, r Reconcilable) bool {
+	base, ok := apis.GetBaseline(ctx).(Reconcilable)
+	if !ok || base == nil {
+		return true
+	}
+
+	if base.GetAnnotations()[AnnotationVerify] != r.GetAnnotations()[AnnotationVerify] {
+		return true
+	}
+
+	return !equality.Semantic.DeepEqual(specOf(base), specOf(r))
+}
+
+// specOf returns the spec of the given component instance.
+func specOf(r Reconcilable) interface{} {
+	v := reflect.Indirect(reflect.ValueOf(r))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	spec := v.FieldByName("Spec")
+	if !spec.IsValid() {
+		return nil
+	}
+	return spec.Interface()
+}
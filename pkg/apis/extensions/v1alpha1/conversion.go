@@ -0,0 +1,38 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// v1alpha1 is the hub version of the extensions API group. Conversions between the hub
+// and other versions are implemented by the other versions.
+
+// ConvertTo implements apis.Convertible.
+func (f *Function) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, f, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (f *Function) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, f, from)
+}
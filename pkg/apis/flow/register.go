@@ -24,12 +24,30 @@ const (
 )
 
 var (
+	// BridgeResource respresents a Bridge.
+	BridgeResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "bridges",
+	}
+
+	// DeduplicatorResource respresents a Deduplicator.
+	DeduplicatorResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "deduplicators",
+	}
+
 	// JQTransformationResource respresents a JQ transformation.
 	JQTransformationResource = schema.GroupResource{
 		Group:    GroupName,
 		Resource: "jqtransformations",
 	}
 
+	// SchemaValidatorResource respresents a SchemaValidator.
+	SchemaValidatorResource = schema.GroupResource{
+		Group:    GroupName,
+		Resource: "schemavalidators",
+	}
+
 	// SynchronizerResource respresents a Synchronizer.
 	SynchronizerResource = schema.GroupResource{
 		Group:    GroupName,
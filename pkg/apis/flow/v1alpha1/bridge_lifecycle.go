@@ -0,0 +1,104 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// GetGroupVersionKind implements kmeta.OwnerRefable.
+func (*Bridge) GetGroupVersionKind() schema.GroupVersionKind {
+	return SchemeGroupVersion.WithKind("Bridge")
+}
+
+// GetConditionSet implements duckv1.KRShaped.
+func (*Bridge) GetConditionSet() apis.ConditionSet {
+	return bridgeConditionSet
+}
+
+// GetStatus implements duckv1.KRShaped.
+func (b *Bridge) GetStatus() *duckv1.Status {
+	return &b.Status.Status
+}
+
+// SetDefaults implements apis.Defaultable
+func (b *Bridge) SetDefaults(ctx context.Context) {
+}
+
+// ObjectTemplate returns the template of the component's object.
+func (c *BridgeComponent) ObjectTemplate() (*unstructured.Unstructured, error) {
+	if len(c.Object.Raw) == 0 {
+		return nil, errors.New("object is empty")
+	}
+
+	u := &unstructured.Unstructured{}
+	if err := json.Unmarshal(c.Object.Raw, &u.Object); err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
+// Status conditions
+const (
+	// BridgeConditionComponentsReady has status True when all the
+	// components of the Bridge are ready.
+	BridgeConditionComponentsReady apis.ConditionType = "ComponentsReady"
+)
+
+// Reasons for status conditions
+const (
+	// BridgeReasonFailedSync encompasses any type of error occuring while
+	// synchronizing the objects of the Bridge's components.
+	BridgeReasonFailedSync = "FailedSync"
+	// BridgeReasonNotOwned is set when the object of a component already
+	// exists and isn't controlled by the Bridge.
+	BridgeReasonNotOwned = "NotOwned"
+	// BridgeReasonComponentsNotReady is set when some of the components of
+	// the Bridge are not ready.
+	BridgeReasonComponentsNotReady = "ComponentsNotReady"
+)
+
+// bridgeConditionSet is the set of status conditions of a Bridge.
+var bridgeConditionSet = apis.NewLivingConditionSet(
+	BridgeConditionComponentsReady,
+)
+
+// MarkComponentsReady sets the ComponentsReady condition to True.
+func (s *BridgeStatus) MarkComponentsReady() {
+	bridgeConditionSet.Manage(s).MarkTrue(BridgeConditionComponentsReady)
+}
+
+// MarkComponentsNotReady sets the ComponentsReady condition to Unknown with
+// the given reason and associated message.
+func (s *BridgeStatus) MarkComponentsNotReady(reason, msg string) {
+	bridgeConditionSet.Manage(s).MarkUnknown(BridgeConditionComponentsReady, reason, msg)
+}
+
+// MarkComponentsFailed sets the ComponentsReady condition to False with the
+// given reason and associated message.
+func (s *BridgeStatus) MarkComponentsFailed(reason, msg string) {
+	bridgeConditionSet.Manage(s).MarkFalse(BridgeConditionComponentsReady, reason, msg)
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"knative.dev/pkg/apis"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/extensions"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	"github.com/triggermesh/triggermesh/pkg/apis/routing"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets"
+)
+
+// bridgeComponentGroups are the API groups of the kinds of objects which can
+// be part of a Bridge.
+var bridgeComponentGroups = map[string]struct{}{
+	sources.GroupName:    {},
+	targets.GroupName:    {},
+	flow.GroupName:       {},
+	routing.GroupName:    {},
+	extensions.GroupName: {},
+}
+
+// SinkCapabilityFunc returns whether components of the given kind send events
+// to a sink. The second return value is false if the kind is unknown.
+type SinkCapabilityFunc func(schema.GroupVersionKind) (hasSink, known bool)
+
+// SinkCapabilityFromScheme returns a SinkCapabilityFunc which determines
+// whether the types registered with the given scheme send events to a sink.
+// The capability of a kind is read from the hub version of its API group,
+// which is v1alpha1 for all TriggerMesh APIs.
+func SinkCapabilityFromScheme(s runtime.ObjectCreater) SinkCapabilityFunc {
+	return func(gvk schema.GroupVersionKind) (bool, bool) {
+		obj, err := s.New(gvk.GroupKind().WithVersion(SchemeGroupVersion.Version))
+		if err != nil {
+			return false, false
+		}
+		_, hasSink := obj.(commonv1alpha1.EventSender)
+		return hasSink, true
+	}
+}
+
+type sinkCapabilityKey struct{}
+
+// WithSinkCapability returns a copy of the parent context in which the value
+// associated with the sinkCapabilityKey is the given SinkCapabilityFunc.
+func WithSinkCapability(ctx context.Context, f SinkCapabilityFunc) context.Context {
+	return context.WithValue(ctx, sinkCapabilityKey{}, f)
+}
+
+// SinkCapabilityFromContext returns the SinkCapabilityFunc stored in the
+// context.
+func SinkCapabilityFromContext(ctx context.Context) SinkCapabilityFunc {
+	if f, ok := ctx.Value(sinkCapabilityKey{}).(SinkCapabilityFunc); ok {
+		return f
+	}
+	return nil
+}
+
+// Validate implements apis.Validatable
+func (b *Bridge) Validate(ctx context.Context) *apis.FieldError {
+	return b.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate Bridge spec
+func (s *BridgeSpec) Validate(ctx context.Context) *apis.FieldError {
+	if len(s.Components) == 0 {
+		return apis.ErrMissingField("components")
+	}
+
+	var errs *apis.FieldError
+
+	names := make(map[string]struct{}, len(s.Components))
+	for i, c := range s.Components {
+		if _, isDuplicate := names[c.Name]; isDuplicate {
+			errs = errs.Also(apis.ErrGeneric("duplicate component name "+c.Name, "name").
+				ViaFieldIndex("components", i))
+		}
+		names[c.Name] = struct{}{}
+	}
+
+	for i := range s.Components {
+		errs = errs.Also(s.Components[i].Validate(ctx, names).ViaFieldIndex("components", i))
+	}
+
+	if path := s.findCycle(); path != nil {
+		errs = errs.Also(apis.ErrGeneric("components form a cycle: "+strings.Join(path, " -> "), "components"))
+	}
+
+	return errs
+}
+
+// Validate BridgeComponent. The given names are the names of all components
+// of the Bridge.
+func (c *BridgeComponent) Validate(ctx context.Context, names map[string]struct{}) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if c.Name == "" {
+		errs = errs.Also(apis.ErrMissingField("name"))
+	} else if msgs := validation.IsDNS1123Label(c.Name); len(msgs) != 0 {
+		errs = errs.Also(apis.ErrInvalidValue(c.Name, "name", strings.Join(msgs, ", ")))
+	}
+
+	errs = errs.Also(c.validateObject())
+
+	if c.To != nil {
+		switch _, exists := names[*c.To]; {
+		case *c.To == c.Name:
+			errs = errs.Also(apis.ErrInvalidValue(*c.To, "to", "a component can not send events to itself"))
+		case !exists:
+			errs = errs.Also(apis.ErrInvalidValue(*c.To, "to", "no component with this name"))
+		}
+
+		if hasSink := SinkCapabilityFromContext(ctx); hasSink != nil {
+			if obj, err := c.ObjectTemplate(); err == nil {
+				if ok, known := hasSink(obj.GroupVersionKind()); known && !ok {
+					errs = errs.Also(apis.ErrInvalidValue(*c.To, "to",
+						"components of kind "+obj.GetKind()+" do not send events to a sink"))
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateObject validates the template of the component's object.
+func (c *BridgeComponent) validateObject() *apis.FieldError {
+	obj, err := c.ObjectTemplate()
+	if err != nil {
+		return apis.ErrInvalidValue(err.Error(), "object")
+	}
+
+	var errs *apis.FieldError
+
+	gv, err := schema.ParseGroupVersion(obj.GetAPIVersion())
+	switch {
+	case obj.GetAPIVersion() == "":
+		errs = errs.Also(apis.ErrMissingField("apiVersion").ViaField("object"))
+	case err != nil:
+		errs = errs.Also(apis.ErrInvalidValue(obj.GetAPIVersion(), "apiVersion").ViaField("object"))
+	default:
+		if _, isComponent := bridgeComponentGroups[gv.Group]; !isComponent {
+			errs = errs.Also(apis.ErrInvalidValue(obj.GetAPIVersion(), "apiVersion",
+				"not a TriggerMesh component").ViaField("object"))
+		}
+	}
+
+	switch obj.GetKind() {
+	case "":
+		errs = errs.Also(apis.ErrMissingField("kind").ViaField("object"))
+	case "Bridge":
+		if gv.Group == flow.GroupName {
+			errs = errs.Also(apis.ErrInvalidValue(obj.GetKind(), "kind",
+				"a Bridge can not be part of another Bridge").ViaField("object"))
+		}
+	}
+
+	return errs
+}
+
+// findCycle returns the names of the components which form a cycle, if any.
+func (s *BridgeSpec) findCycle() []string {
+	to := make(map[string]string, len(s.Components))
+	for _, c := range s.Components {
+		if c.To != nil {
+			to[c.Name] = *c.To
+		}
+	}
+
+	// each component sends events to at most one other component, so
+	// following the chain of destinations from any component either ends
+	// or loops
+	for _, c := range s.Components {
+		visited := make(map[string]struct{})
+		path := []string{c.Name}
+
+		for next, ok := to[c.Name]; ok; next, ok = to[next] {
+			if _, isVisited := visited[next]; isVisited || next == c.Name {
+				return append(path, next)
+			}
+			visited[next] = struct{}{}
+			path = append(path, next)
+		}
+	}
+
+	return nil
+}
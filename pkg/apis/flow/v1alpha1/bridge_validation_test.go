@@ -0,0 +1,165 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestBridgeValidate(t *testing.T) {
+	const (
+		source = `{"apiVersion":"sources.triggermesh.io/v1alpha1","kind":"WebhookSource"}`
+		target = `{"apiVersion":"targets.triggermesh.io/v1alpha1","kind":"CloudEventsTarget"}`
+	)
+
+	testCases := map[string]struct {
+		components  []BridgeComponent
+		expectError *apis.FieldError
+	}{
+		"valid graph": {
+			components: []BridgeComponent{
+				bridgeComponent("src", source, ptr.String("tgt")),
+				bridgeComponent("tgt", target, nil),
+			},
+		},
+		"no component": {
+			expectError: apis.ErrMissingField("components").ViaField("spec"),
+		},
+		"duplicate name": {
+			components: []BridgeComponent{
+				bridgeComponent("tgt", target, nil),
+				bridgeComponent("tgt", target, nil),
+			},
+			expectError: apis.ErrGeneric("duplicate component name tgt", "name").
+				ViaFieldIndex("components", 1).ViaField("spec"),
+		},
+		"invalid name": {
+			components: []BridgeComponent{
+				bridgeComponent("My_Target", target, nil),
+			},
+			expectError: apis.ErrInvalidValue("My_Target", "name",
+				"a lowercase RFC 1123 label must consist of lower case alphanumeric characters or '-', "+
+					"and must start and end with an alphanumeric character "+
+					"(e.g. 'my-name',  or '123-abc', regex used for validation is '[a-z0-9]([-a-z0-9]*[a-z0-9])?')").
+				ViaFieldIndex("components", 0).ViaField("spec"),
+		},
+		"object without kind": {
+			components: []BridgeComponent{
+				bridgeComponent("tgt", `{"apiVersion":"targets.triggermesh.io/v1alpha1"}`, nil),
+			},
+			expectError: apis.ErrMissingField("kind").ViaField("object").
+				ViaFieldIndex("components", 0).ViaField("spec"),
+		},
+		"object of foreign group": {
+			components: []BridgeComponent{
+				bridgeComponent("tgt", `{"apiVersion":"apps/v1","kind":"Deployment"}`, nil),
+			},
+			expectError: apis.ErrInvalidValue("apps/v1", "apiVersion", "not a TriggerMesh component").
+				ViaField("object").ViaFieldIndex("components", 0).ViaField("spec"),
+		},
+		"nested bridge": {
+			components: []BridgeComponent{
+				bridgeComponent("br", `{"apiVersion":"flow.triggermesh.io/v1alpha1","kind":"Bridge"}`, nil),
+			},
+			expectError: apis.ErrInvalidValue("Bridge", "kind", "a Bridge can not be part of another Bridge").
+				ViaField("object").ViaFieldIndex("components", 0).ViaField("spec"),
+		},
+		"unknown destination": {
+			components: []BridgeComponent{
+				bridgeComponent("src", source, ptr.String("nope")),
+			},
+			expectError: apis.ErrInvalidValue("nope", "to", "no component with this name").
+				ViaFieldIndex("components", 0).ViaField("spec"),
+		},
+		"cycle": {
+			components: []BridgeComponent{
+				bridgeComponent("a", target, ptr.String("b")),
+				bridgeComponent("b", target, ptr.String("a")),
+			},
+			expectError: apis.ErrGeneric("components form a cycle: a -> b -> a", "components").
+				ViaField("spec"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			b := &Bridge{
+				Spec: BridgeSpec{
+					Components: tc.components,
+				},
+			}
+			assert.Equal(t, tc.expectError.Error(), b.Validate(context.Background()).Error())
+		})
+	}
+}
+
+func TestBridgeValidateSinkCapability(t *testing.T) {
+	const (
+		source  = `{"apiVersion":"sources.triggermesh.io/v1alpha1","kind":"WebhookSource"}`
+		target  = `{"apiVersion":"targets.triggermesh.io/v1alpha1","kind":"CloudEventsTarget"}`
+		unknown = `{"apiVersion":"targets.triggermesh.io/v1alpha1","kind":"FutureTarget"}`
+	)
+
+	hasSink := func(gvk schema.GroupVersionKind) (bool, bool) {
+		switch gvk.Kind {
+		case "WebhookSource":
+			return true, true
+		case "CloudEventsTarget":
+			return false, true
+		}
+		return false, false
+	}
+
+	ctx := WithSinkCapability(context.Background(), hasSink)
+
+	b := &Bridge{
+		Spec: BridgeSpec{
+			Components: []BridgeComponent{
+				bridgeComponent("src", source, ptr.String("tgt1")),
+				bridgeComponent("tgt1", target, ptr.String("tgt2")),
+				bridgeComponent("tgt2", unknown, nil),
+			},
+		},
+	}
+
+	expectError := apis.ErrInvalidValue("tgt2", "to",
+		"components of kind CloudEventsTarget do not send events to a sink").
+		ViaFieldIndex("components", 1).ViaField("spec")
+
+	assert.Equal(t, expectError.Error(), b.Validate(ctx).Error())
+
+	b.Spec.Components[1].To = nil
+	assert.Nil(t, b.Validate(ctx), "Unknown kinds should not be rejected")
+}
+
+func bridgeComponent(name, object string, to *string) BridgeComponent {
+	return BridgeComponent{
+		Name:   name,
+		Object: runtime.RawExtension{Raw: []byte(object)},
+		To:     to,
+	}
+}
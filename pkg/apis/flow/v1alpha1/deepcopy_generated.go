@@ -24,10 +24,162 @@ package v1alpha1
 import (
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
-	v1 "k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
+	apis "knative.dev/pkg/apis"
+	v1 "knative.dev/pkg/apis/duck/v1"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Bridge) DeepCopyInto(out *Bridge) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Bridge.
+func (in *Bridge) DeepCopy() *Bridge {
+	if in == nil {
+		return nil
+	}
+	out := new(Bridge)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Bridge) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeComponent) DeepCopyInto(out *BridgeComponent) {
+	*out = *in
+	in.Object.DeepCopyInto(&out.Object)
+	if in.To != nil {
+		in, out := &in.To, &out.To
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeComponent.
+func (in *BridgeComponent) DeepCopy() *BridgeComponent {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeComponent)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeComponentStatus) DeepCopyInto(out *BridgeComponentStatus) {
+	*out = *in
+	out.Ref = in.Ref
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeComponentStatus.
+func (in *BridgeComponentStatus) DeepCopy() *BridgeComponentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeComponentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeList) DeepCopyInto(out *BridgeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Bridge, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeList.
+func (in *BridgeList) DeepCopy() *BridgeList {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BridgeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeSpec) DeepCopyInto(out *BridgeSpec) {
+	*out = *in
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]BridgeComponent, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeSpec.
+func (in *BridgeSpec) DeepCopy() *BridgeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BridgeStatus) DeepCopyInto(out *BridgeStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]BridgeComponentStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.EventFlow != nil {
+		in, out := &in.EventFlow, &out.EventFlow
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BridgeStatus.
+func (in *BridgeStatus) DeepCopy() *BridgeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BridgeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Correlation) DeepCopyInto(out *Correlation) {
 	*out = *in
@@ -44,6 +196,90 @@ func (in *Correlation) DeepCopy() *Correlation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Deduplicator) DeepCopyInto(out *Deduplicator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Deduplicator.
+func (in *Deduplicator) DeepCopy() *Deduplicator {
+	if in == nil {
+		return nil
+	}
+	out := new(Deduplicator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Deduplicator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeduplicatorList) DeepCopyInto(out *DeduplicatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Deduplicator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeduplicatorList.
+func (in *DeduplicatorList) DeepCopy() *DeduplicatorList {
+	if in == nil {
+		return nil
+	}
+	out := new(DeduplicatorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DeduplicatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeduplicatorSpec) DeepCopyInto(out *DeduplicatorSpec) {
+	*out = *in
+	in.Deduplication.DeepCopyInto(&out.Deduplication)
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeduplicatorSpec.
+func (in *DeduplicatorSpec) DeepCopy() *DeduplicatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DeduplicatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EventOptions) DeepCopyInto(out *EventOptions) {
 	*out = *in
@@ -65,6 +301,33 @@ func (in *EventOptions) DeepCopy() *EventOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSchema) DeepCopyInto(out *EventSchema) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataSchema != nil {
+		in, out := &in.DataSchema, &out.DataSchema
+		*out = new(string)
+		**out = **in
+	}
+	in.Schema.DeepCopyInto(&out.Schema)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSchema.
+func (in *EventSchema) DeepCopy() *EventSchema {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSchema)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JQTransformation) DeepCopyInto(out *JQTransformation) {
 	*out = *in
@@ -185,6 +448,133 @@ func (in *Response) DeepCopy() *Response {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidator) DeepCopyInto(out *SchemaValidator) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidator.
+func (in *SchemaValidator) DeepCopy() *SchemaValidator {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchemaValidator) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidatorList) DeepCopyInto(out *SchemaValidatorList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchemaValidator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidatorList.
+func (in *SchemaValidatorList) DeepCopy() *SchemaValidatorList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidatorList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchemaValidatorList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidatorSpec) DeepCopyInto(out *SchemaValidatorSpec) {
+	*out = *in
+	if in.Schemas != nil {
+		in, out := &in.Schemas, &out.Schemas
+		*out = make([]EventSchema, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UseBundledSchemas != nil {
+		in, out := &in.UseBundledSchemas, &out.UseBundledSchemas
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RejectUnknown != nil {
+		in, out := &in.RejectUnknown, &out.RejectUnknown
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InvalidSink != nil {
+		in, out := &in.InvalidSink, &out.InvalidSink
+		*out = new(v1.Destination)
+		(*in).DeepCopyInto(*out)
+	}
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(commonv1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidatorSpec.
+func (in *SchemaValidatorSpec) DeepCopy() *SchemaValidatorSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidatorSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchemaValidatorStatus) DeepCopyInto(out *SchemaValidatorStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.InvalidSinkURI != nil {
+		in, out := &in.InvalidSinkURI, &out.InvalidSinkURI
+		*out = new(apis.URL)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchemaValidatorStatus.
+func (in *SchemaValidatorStatus) DeepCopy() *SchemaValidatorStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SchemaValidatorStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Synchronizer) DeepCopyInto(out *Synchronizer) {
 	*out = *in
@@ -393,12 +783,12 @@ func (in *ValueFromField) DeepCopyInto(out *ValueFromField) {
 	*out = *in
 	if in.ValueFromSecret != nil {
 		in, out := &in.ValueFromSecret, &out.ValueFromSecret
-		*out = new(v1.SecretKeySelector)
+		*out = new(corev1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ValueFromConfigMap != nil {
 		in, out := &in.ValueFromConfigMap, &out.ValueFromConfigMap
-		*out = new(v1.ConfigMapKeySelector)
+		*out = new(corev1.ConfigMapKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
 	return
@@ -47,7 +47,10 @@ var (
 
 // AllTypes is a list of all the types defined in this package.
 var AllTypes = []v1alpha1.GroupObject{
+	{Single: &Bridge{}, List: &BridgeList{}},
+	{Single: &Deduplicator{}, List: &DeduplicatorList{}},
 	{Single: &JQTransformation{}, List: &JQTransformationList{}},
+	{Single: &SchemaValidator{}, List: &SchemaValidatorList{}},
 	{Single: &Synchronizer{}, List: &SynchronizerList{}},
 	{Single: &Transformation{}, List: &TransformationList{}},
 	{Single: &XMLToJSONTransformation{}, List: &XMLToJSONTransformationList{}},
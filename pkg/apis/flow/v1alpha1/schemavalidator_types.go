@@ -0,0 +1,115 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +genreconciler
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchemaValidator validates the data of events against JSON schemas, forwards
+// valid events and sends invalid events to a separate destination.
+type SchemaValidator struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   SchemaValidatorSpec   `json:"spec"`
+	Status SchemaValidatorStatus `json:"status,omitempty"`
+}
+
+// Check the interfaces SchemaValidator should be implementing.
+var (
+	_ apis.Validatable = (*SchemaValidator)(nil)
+	_ apis.Defaultable = (*SchemaValidator)(nil)
+
+	_ v1alpha1.Reconcilable        = (*SchemaValidator)(nil)
+	_ v1alpha1.AdapterConfigurable = (*SchemaValidator)(nil)
+	_ v1alpha1.EventSender         = (*SchemaValidator)(nil)
+)
+
+// SchemaValidatorSpec defines the desired state of the component.
+type SchemaValidatorSpec struct {
+	// JSON schemas used to validate events, in order of precedence.
+	// +optional
+	Schemas []EventSchema `json:"schemas,omitempty"`
+
+	// Whether events which aren't matched by any of the given schemas are
+	// validated against the schemas bundled with TriggerMesh.
+	// Defaults to true.
+	// +optional
+	UseBundledSchemas *bool `json:"useBundledSchemas,omitempty"`
+
+	// Whether events for which no schema can be found are considered
+	// invalid. By default, such events are forwarded without validation.
+	// +optional
+	RejectUnknown *bool `json:"rejectUnknown,omitempty"`
+
+	// Destination of events which fail validation. Invalid events carry
+	// the validation errors in their "validationerrors" extension
+	// attribute. When omitted, invalid events are replied to with an error.
+	// +optional
+	InvalidSink *duckv1.Destination `json:"invalidSink,omitempty"`
+
+	// Support sending to an event sink instead of replying.
+	duckv1.SourceSpec `json:",inline"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// EventSchema is a JSON schema which applies to the data of events matching
+// either a CloudEvents type or a "dataschema" attribute.
+type EventSchema struct {
+	// CloudEvents type of the events validated by this schema.
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// Value of the "dataschema" attribute of the events validated by this
+	// schema.
+	// +optional
+	DataSchema *string `json:"dataSchema,omitempty"`
+
+	// JSON schema document.
+	Schema ValueFromField `json:"schema"`
+}
+
+// SchemaValidatorStatus defines the observed state of the component.
+type SchemaValidatorStatus struct {
+	v1alpha1.Status `json:",inline"`
+	// URI of the destination of invalid events.
+	// +optional
+	InvalidSinkURI *apis.URL `json:"invalidSinkUri,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// SchemaValidatorList is a list of component instances.
+type SchemaValidatorList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []SchemaValidator `json:"items"`
+}
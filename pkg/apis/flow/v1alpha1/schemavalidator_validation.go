@@ -0,0 +1,66 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *SchemaValidator) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate SchemaValidator spec
+func (s *SchemaValidatorSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	for i := range s.Schemas {
+		errs = errs.Also(s.Schemas[i].Validate(ctx).ViaFieldIndex("schemas", i))
+	}
+
+	if s.InvalidSink != nil {
+		errs = errs.Also(s.InvalidSink.Validate(ctx).ViaField("invalidSink"))
+	}
+
+	return errs
+}
+
+// Validate EventSchema
+func (s *EventSchema) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	typ := s.Type != nil && *s.Type != ""
+	dataSchema := s.DataSchema != nil && *s.DataSchema != ""
+
+	switch {
+	case typ && dataSchema:
+		errs = errs.Also(apis.ErrMultipleOneOf("type", "dataSchema"))
+	case !typ && !dataSchema:
+		errs = errs.Also(apis.ErrMissingOneOf("type", "dataSchema"))
+	}
+
+	if !s.Schema.IsInformed() {
+		errs = errs.Also(apis.ErrMissingField("schema"))
+	} else if err := s.Schema.Validate(ctx); err != nil {
+		errs = errs.Also(err.ViaField("schema"))
+	}
+
+	return errs
+}
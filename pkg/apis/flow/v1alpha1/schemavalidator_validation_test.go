@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+)
+
+func TestSchemaValidatorValidate(t *testing.T) {
+	testCases := map[string]struct {
+		schema      EventSchema
+		expectError *apis.FieldError
+	}{
+		"schema by type": {
+			schema: EventSchema{
+				Type:   ptr.String("com.example.order"),
+				Schema: ValueFromField{Value: tValue},
+			},
+		},
+		"schema by dataschema from ConfigMap": {
+			schema: EventSchema{
+				DataSchema: ptr.String("https://example.com/order.json"),
+				Schema:     *valueFromField(vffWithConfigMap(tName, tKey)),
+			},
+		},
+		"missing selector": {
+			schema: EventSchema{
+				Schema: ValueFromField{Value: tValue},
+			},
+			expectError: apis.ErrMissingOneOf("type", "dataSchema").
+				ViaFieldIndex("schemas", 0).ViaField("spec"),
+		},
+		"both selectors": {
+			schema: EventSchema{
+				Type:       ptr.String("com.example.order"),
+				DataSchema: ptr.String("https://example.com/order.json"),
+				Schema:     ValueFromField{Value: tValue},
+			},
+			expectError: apis.ErrMultipleOneOf("type", "dataSchema").
+				ViaFieldIndex("schemas", 0).ViaField("spec"),
+		},
+		"missing schema": {
+			schema: EventSchema{
+				Type: ptr.String("com.example.order"),
+			},
+			expectError: apis.ErrMissingField("schema").
+				ViaFieldIndex("schemas", 0).ViaField("spec"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			v := &SchemaValidator{
+				Spec: SchemaValidatorSpec{
+					Schemas: []EventSchema{tc.schema},
+				},
+			}
+			assert.Equal(t, tc.expectError.Error(), v.Validate(context.Background()).Error())
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// Bridge is a declarative graph of TriggerMesh components, such as sources,
+// flow steps, routers and targets, which are managed as a whole.
+type Bridge struct {
+	metav1.TypeMeta `json:",inline"`
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BridgeSpec   `json:"spec"`
+	Status BridgeStatus `json:"status,omitempty"`
+}
+
+// BridgeSpec defines the desired state of the Bridge.
+type BridgeSpec struct {
+	// Components of the Bridge.
+	Components []BridgeComponent `json:"components"`
+}
+
+// BridgeComponent is a component which is part of a Bridge.
+type BridgeComponent struct {
+	// Name of the component, unique within the Bridge.
+	Name string `json:"name"`
+
+	// Template of the component's object. Only its apiVersion, kind,
+	// metadata.labels, metadata.annotations and spec are taken into
+	// account.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	Object runtime.RawExtension `json:"object"`
+
+	// Name of the component of the Bridge which receives the events
+	// emitted by this component. When set, it overrides the sink of the
+	// component's object.
+	// +optional
+	To *string `json:"to,omitempty"`
+}
+
+// BridgeStatus defines the observed state of the Bridge.
+type BridgeStatus struct {
+	duckv1.Status `json:",inline"`
+
+	// Observed state of the components of the Bridge.
+	// +optional
+	Components []BridgeComponentStatus `json:"components,omitempty"`
+
+	// Paths followed by events across the components of the Bridge, from
+	// the components which don't receive events from any other component
+	// of the Bridge, e.g. "webhook -> transform -> slack".
+	// +optional
+	EventFlow []string `json:"eventFlow,omitempty"`
+}
+
+// BridgeComponentStatus is the observed state of a component of a Bridge.
+type BridgeComponentStatus struct {
+	// Name of the component within the Bridge.
+	Name string `json:"name"`
+	// Reference to the component's object.
+	Ref duckv1.KReference `json:"ref"`
+	// Readiness of the component.
+	Ready corev1.ConditionStatus `json:"ready"`
+	// Reason for the component's readiness.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BridgeList is a list of Bridge instances.
+type BridgeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Bridge `json:"items"`
+}
@@ -0,0 +1,187 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (b *Bridge) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.Bridge:
+		return v1alpha1.ConvertViaJSON(b, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", b, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (b *Bridge) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.Bridge:
+		return v1alpha1.ConvertViaJSON(hub, b)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, b)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *Deduplicator) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.Deduplicator:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *Deduplicator) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.Deduplicator:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *JQTransformation) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.JQTransformation:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *JQTransformation) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.JQTransformation:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SchemaValidator) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.SchemaValidator:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SchemaValidator) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.SchemaValidator:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *Synchronizer) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.Synchronizer:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *Synchronizer) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.Synchronizer:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *Transformation) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.Transformation:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *Transformation) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.Transformation:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *XMLToJSONTransformation) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.XMLToJSONTransformation:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *XMLToJSONTransformation) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.XMLToJSONTransformation:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *XSLTTransformation) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *flowv1alpha1.XSLTTransformation:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *XSLTTransformation) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *flowv1alpha1.XSLTTransformation:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/testing/roundtrip"
+)
+
+func TestRoundTripTypesViaHub(t *testing.T) {
+	scheme, hubs := runtime.NewScheme(), runtime.NewScheme()
+	utilruntime.Must(AddToScheme(scheme))
+	utilruntime.Must(flowv1alpha1.AddToScheme(hubs))
+
+	roundtrip.ExternalTypesViaHub(t, scheme, hubs, nil)
+}
+
+func TestRoundTripHubTypesViaExternal(t *testing.T) {
+	scheme, hubs := runtime.NewScheme(), runtime.NewScheme()
+	utilruntime.Must(AddToScheme(scheme))
+	utilruntime.Must(flowv1alpha1.AddToScheme(hubs))
+
+	roundtrip.HubTypesViaExternal(t, hubs, scheme, nil)
+}
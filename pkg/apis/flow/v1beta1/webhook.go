@@ -0,0 +1,109 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+// Objects are defaulted and validated by converting them to the hub version of
+// their kind, which implements the defaulting and validation logic.
+
+// SetDefaults implements apis.Defaultable.
+func (b *Bridge) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, b, &flowv1alpha1.Bridge{})
+}
+
+// Validate implements apis.Validatable.
+func (b *Bridge) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, b, &flowv1alpha1.Bridge{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *Deduplicator) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &flowv1alpha1.Deduplicator{})
+}
+
+// Validate implements apis.Validatable.
+func (t *Deduplicator) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &flowv1alpha1.Deduplicator{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *JQTransformation) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &flowv1alpha1.JQTransformation{})
+}
+
+// Validate implements apis.Validatable.
+func (t *JQTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &flowv1alpha1.JQTransformation{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *SchemaValidator) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &flowv1alpha1.SchemaValidator{})
+}
+
+// Validate implements apis.Validatable.
+func (t *SchemaValidator) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &flowv1alpha1.SchemaValidator{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *Synchronizer) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &flowv1alpha1.Synchronizer{})
+}
+
+// Validate implements apis.Validatable.
+func (s *Synchronizer) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &flowv1alpha1.Synchronizer{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *Transformation) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &flowv1alpha1.Transformation{})
+}
+
+// Validate implements apis.Validatable.
+func (t *Transformation) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &flowv1alpha1.Transformation{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *XMLToJSONTransformation) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &flowv1alpha1.XMLToJSONTransformation{})
+}
+
+// Validate implements apis.Validatable.
+func (t *XMLToJSONTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &flowv1alpha1.XMLToJSONTransformation{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *XSLTTransformation) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &flowv1alpha1.XSLTTransformation{})
+}
+
+// Validate implements apis.Validatable.
+func (t *XSLTTransformation) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &flowv1alpha1.XSLTTransformation{})
+}
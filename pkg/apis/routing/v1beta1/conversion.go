@@ -0,0 +1,67 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (f *Filter) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *routingv1alpha1.Filter:
+		return v1alpha1.ConvertViaJSON(f, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", f, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (f *Filter) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *routingv1alpha1.Filter:
+		return v1alpha1.ConvertViaJSON(hub, f)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, f)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *Splitter) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *routingv1alpha1.Splitter:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *Splitter) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *routingv1alpha1.Splitter:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+)
+
+// Objects are defaulted and validated by converting them to the hub version of
+// their kind, which implements the defaulting and validation logic.
+
+// SetDefaults implements apis.Defaultable.
+func (f *Filter) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, f, &routingv1alpha1.Filter{})
+}
+
+// Validate implements apis.Validatable.
+func (f *Filter) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, f, &routingv1alpha1.Filter{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *Splitter) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &routingv1alpha1.Splitter{})
+}
+
+// Validate implements apis.Validatable.
+func (s *Splitter) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &routingv1alpha1.Splitter{})
+}
@@ -65,6 +65,10 @@ type AWSCloudWatchLogsSourceSpec struct {
 	// Authentication method to interact with the Amazon CloudWatch Logs API.
 	Auth v1alpha1.AWSAuth `json:"auth"`
 
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
@@ -18,12 +18,15 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
@@ -98,5 +101,52 @@ func (s *AWSSQSSource) Validate(ctx context.Context) *apis.FieldError {
 	if s.DeletionTimestamp != nil {
 		return nil
 	}
-	return s.Spec.Auth.Validate(ctx)
+
+	errs := s.Spec.Auth.Validate(ctx)
+
+	if o := s.Spec.ReceiveOptions; o != nil {
+		errs = errs.Also(o.validate(s.Spec.ARN).ViaField("spec", "receiveOptions"))
+	}
+
+	if o := s.Spec.AdapterOverrides; o != nil && o.Autoscaling != nil {
+		errs = errs.Also(o.Autoscaling.Validate(ctx).ViaField("spec", "adapterOverrides", "autoscaling"))
+	}
+
+	return errs.Also(v1alpha1.Verify(ctx, s))
+}
+
+// SQS limits
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
+const (
+	awsSQSMaxVisibilityTimeout = 12 * time.Hour
+	awsSQSFIFOQueueNameSuffix  = ".fifo"
+)
+
+// validate validates the receive options of the source.
+func (o *AWSSQSSourceReceiveOptions) validate(arn tmapis.ARN) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if vt := o.VisibilityTimeout; vt != nil && (*vt < 0 || time.Duration(*vt) > awsSQSMaxVisibilityTimeout) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(vt.String(), 0, awsSQSMaxVisibilityTimeout, "visibilityTimeout"))
+	}
+
+	// the visibility timeout is extended by its own value, which SQS
+	// expresses in seconds, each time half of it has elapsed
+	if vt := o.VisibilityTimeout; o.ExtendVisibilityTimeout && vt != nil && time.Duration(*vt) < time.Second {
+		errs = errs.Also(apis.ErrInvalidValue(vt.String(), "visibilityTimeout",
+			"must be at least 1s when extendVisibilityTimeout is enabled"))
+	}
+
+	if o.Receivers != nil && *o.Receivers < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*o.Receivers, "receivers"))
+	}
+	if o.Processors != nil && *o.Processors < 1 {
+		errs = errs.Also(apis.ErrInvalidValue(*o.Processors, "processors"))
+	}
+
+	if o.PreserveMessageGroupOrder != nil && !strings.HasSuffix(arn.Resource, awsSQSFIFOQueueNameSuffix) {
+		errs = errs.Also(apis.ErrDisallowedFields("preserveMessageGroupOrder"))
+	}
+
+	return errs
 }
@@ -89,6 +89,33 @@ type AWSSQSSourceReceiveOptions struct {
 	//
 	// +optional
 	VisibilityTimeout *apis.Duration `json:"visibilityTimeout,omitempty"`
+
+	// Whether the visibility timeout of messages is periodically extended
+	// while they are being processed, which prevents their redelivery when
+	// the sink is slow to respond. Messages are extended by visibilityTimeout,
+	// or by the visibility timeout of the queue if it isn't set.
+	//
+	// +optional
+	ExtendVisibilityTimeout bool `json:"extendVisibilityTimeout,omitempty"`
+
+	// Number of message receivers which concurrently poll the queue for
+	// new messages. Defaults to 3 per available CPU.
+	//
+	// +optional
+	Receivers *int32 `json:"receivers,omitempty"`
+
+	// Number of messages which are processed (sent to the sink)
+	// concurrently. Defaults to 3 per available CPU.
+	//
+	// +optional
+	Processors *int32 `json:"processors,omitempty"`
+
+	// Whether messages which share a message group ID are processed
+	// sequentially, in the order they were received. Only applies to FIFO
+	// queues, for which it defaults to true.
+	//
+	// +optional
+	PreserveMessageGroupOrder *bool `json:"preserveMessageGroupOrder,omitempty"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
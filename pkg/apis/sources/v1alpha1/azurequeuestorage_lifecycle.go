@@ -87,5 +87,11 @@ func (s *AzureQueueStorageSource) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (s *AzureQueueStorageSource) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	if o := s.Spec.AdapterOverrides; o != nil && o.Autoscaling != nil {
+		errs = errs.Also(o.Autoscaling.Validate(ctx).ViaField("spec", "adapterOverrides", "autoscaling"))
+	}
+
+	return errs
 }
@@ -84,5 +84,11 @@ func (s *AzureServiceBusQueueSource) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (s *AzureServiceBusQueueSource) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	if o := s.Spec.AdapterOverrides; o != nil && o.Autoscaling != nil {
+		errs = errs.Also(o.Autoscaling.Validate(ctx).ViaField("spec", "adapterOverrides", "autoscaling"))
+	}
+
+	return errs
 }
@@ -37,9 +37,15 @@ func (s *CloudEventsSourceSpec) Validate(ctx context.Context) *apis.FieldError {
 	return s.Credentials.Validate(ctx).ViaField("credentials")
 }
 
+// Validate the credentials. Passwords are mounted into the adapter as files,
+// therefore they must be referenced from Kubernetes Secrets.
 func (c *HTTPCredentials) Validate(ctx context.Context) *apis.FieldError {
 	var errs *apis.FieldError
 
+	for i, ba := range c.BasicAuths {
+		errs = errs.Also(ba.Password.ValidateSecretRef().ViaField("password").ViaFieldIndex("basicAuths", i))
+	}
+
 	if len(c.BasicAuths) != 0 {
 		if _, err := json.Marshal(c.BasicAuths); err != nil {
 			errs = errs.Also(apis.ErrInvalidValue(
@@ -0,0 +1,408 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// v1alpha1 is the hub version of the sources API group. Conversions between the hub
+// and other versions are implemented by the other versions.
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCloudWatchSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCloudWatchSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCloudWatchLogsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCloudWatchLogsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCodeCommitSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCodeCommitSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCognitoIdentitySource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCognitoIdentitySource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCognitoUserPoolSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCognitoUserPoolSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSDynamoDBSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSDynamoDBSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSEventBridgeSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSEventBridgeSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSKinesisSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSKinesisSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSS3Source) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSS3Source) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSSNSSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSSNSSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSSQSSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSSQSSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSPerformanceInsightsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSPerformanceInsightsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureActivityLogsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureActivityLogsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureBlobStorageSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureBlobStorageSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureEventGridSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureEventGridSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureEventHubsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureEventHubsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureIOTHubSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureIOTHubSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureQueueStorageSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureQueueStorageSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusQueueSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusQueueSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusTopicSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusTopicSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *CloudEventsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *CloudEventsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *MongoDBSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *MongoDBSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *KafkaSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *KafkaSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudAuditLogsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudAuditLogsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudBillingSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudBillingSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudPubSubSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudPubSubSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudSourceRepositoriesSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudSourceRepositoriesSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudStorageSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudStorageSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *HTTPPollerSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *HTTPPollerSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *IBMMQSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *IBMMQSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *OCIMetricsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *OCIMetricsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *SalesforceSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SalesforceSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *SlackSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SlackSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *SolaceSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SolaceSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *TwilioSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *TwilioSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *WebhookSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *WebhookSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *ZendeskSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, s, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *ZendeskSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, s, from)
+}
@@ -99,6 +99,11 @@ func (in *AWSCloudWatchLogsSourceSpec) DeepCopyInto(out *AWSCloudWatchLogsSource
 		**out = **in
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -275,6 +280,11 @@ func (in *AWSCloudWatchSourceSpec) DeepCopyInto(out *AWSCloudWatchSourceSpec) {
 		}
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -365,6 +375,11 @@ func (in *AWSCodeCommitSourceSpec) DeepCopyInto(out *AWSCodeCommitSourceSpec) {
 		copy(*out, *in)
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -450,6 +465,11 @@ func (in *AWSCognitoIdentitySourceSpec) DeepCopyInto(out *AWSCognitoIdentitySour
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.ARN = in.ARN
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -535,6 +555,11 @@ func (in *AWSCognitoUserPoolSourceSpec) DeepCopyInto(out *AWSCognitoUserPoolSour
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.ARN = in.ARN
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -620,6 +645,11 @@ func (in *AWSDynamoDBSourceSpec) DeepCopyInto(out *AWSDynamoDBSourceSpec) {
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.ARN = in.ARN
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -753,6 +783,11 @@ func (in *AWSEventBridgeSourceSpec) DeepCopyInto(out *AWSEventBridgeSourceSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -865,6 +900,11 @@ func (in *AWSKinesisSourceSpec) DeepCopyInto(out *AWSKinesisSourceSpec) {
 	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
 	out.ARN = in.ARN
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -955,6 +995,11 @@ func (in *AWSPerformanceInsightsSourceSpec) DeepCopyInto(out *AWSPerformanceInsi
 		copy(*out, *in)
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1088,6 +1133,11 @@ func (in *AWSS3SourceSpec) DeepCopyInto(out *AWSS3SourceSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1210,6 +1260,11 @@ func (in *AWSSNSSourceSpec) DeepCopyInto(out *AWSSNSSourceSpec) {
 		}
 	}
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1319,6 +1374,21 @@ func (in *AWSSQSSourceReceiveOptions) DeepCopyInto(out *AWSSQSSourceReceiveOptio
 		*out = new(apis.Duration)
 		**out = **in
 	}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreserveMessageGroupOrder != nil {
+		in, out := &in.PreserveMessageGroupOrder, &out.PreserveMessageGroupOrder
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -2865,6 +2935,80 @@ func (in *GoogleCloudBillingSourceStatus) DeepCopy() *GoogleCloudBillingSourceSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubDeadLetterPolicy) DeepCopyInto(out *GoogleCloudPubSubDeadLetterPolicy) {
+	*out = *in
+	out.DeadLetterTopic = in.DeadLetterTopic
+	if in.MaxDeliveryAttempts != nil {
+		in, out := &in.MaxDeliveryAttempts, &out.MaxDeliveryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubDeadLetterPolicy.
+func (in *GoogleCloudPubSubDeadLetterPolicy) DeepCopy() *GoogleCloudPubSubDeadLetterPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubDeadLetterPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubFlowControl) DeepCopyInto(out *GoogleCloudPubSubFlowControl) {
+	*out = *in
+	if in.MaxOutstandingMessages != nil {
+		in, out := &in.MaxOutstandingMessages, &out.MaxOutstandingMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxOutstandingBytes != nil {
+		in, out := &in.MaxOutstandingBytes, &out.MaxOutstandingBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubFlowControl.
+func (in *GoogleCloudPubSubFlowControl) DeepCopy() *GoogleCloudPubSubFlowControl {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubFlowControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubRetryPolicy) DeepCopyInto(out *GoogleCloudPubSubRetryPolicy) {
+	*out = *in
+	if in.MinimumBackoff != nil {
+		in, out := &in.MinimumBackoff, &out.MinimumBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaximumBackoff != nil {
+		in, out := &in.MaximumBackoff, &out.MaximumBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubRetryPolicy.
+func (in *GoogleCloudPubSubRetryPolicy) DeepCopy() *GoogleCloudPubSubRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudPubSubSource) DeepCopyInto(out *GoogleCloudPubSubSource) {
 	*out = *in
@@ -2936,6 +3080,16 @@ func (in *GoogleCloudPubSubSourceSpec) DeepCopyInto(out *GoogleCloudPubSubSource
 		*out = new(string)
 		**out = **in
 	}
+	if in.SubscriptionSettings != nil {
+		in, out := &in.SubscriptionSettings, &out.SubscriptionSettings
+		*out = new(GoogleCloudPubSubSubscriptionSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowControl != nil {
+		in, out := &in.FlowControl, &out.FlowControl
+		*out = new(GoogleCloudPubSubFlowControl)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Auth.DeepCopyInto(&out.Auth)
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
@@ -2977,6 +3131,42 @@ func (in *GoogleCloudPubSubSourceStatus) DeepCopy() *GoogleCloudPubSubSourceStat
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubSubscriptionSettings) DeepCopyInto(out *GoogleCloudPubSubSubscriptionSettings) {
+	*out = *in
+	if in.AckDeadline != nil {
+		in, out := &in.AckDeadline, &out.AckDeadline
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeadLetterPolicy != nil {
+		in, out := &in.DeadLetterPolicy, &out.DeadLetterPolicy
+		*out = new(GoogleCloudPubSubDeadLetterPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(GoogleCloudPubSubRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubSubscriptionSettings.
+func (in *GoogleCloudPubSubSubscriptionSettings) DeepCopy() *GoogleCloudPubSubSubscriptionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubSubscriptionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudSourcePubSubSpec) DeepCopyInto(out *GoogleCloudSourcePubSubSpec) {
 	*out = *in
@@ -18,12 +18,14 @@ package v1alpha1
 
 import (
 	"context"
+	"time"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"knative.dev/pkg/apis"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	tmapis "github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
@@ -117,5 +119,143 @@ func (s *GoogleCloudPubSubSource) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (s *GoogleCloudPubSubSource) Validate(ctx context.Context) *apis.FieldError {
+	// Do not validate the spec in case of resource deletion
+	if s.DeletionTimestamp != nil {
+		return nil
+	}
+
+	errs := s.Spec.validate()
+
+	if apis.IsInUpdate(ctx) {
+		if base, ok := apis.GetBaseline(ctx).(*GoogleCloudPubSubSource); ok && base != nil {
+			errs = errs.Also(s.Spec.validateUpdate(&base.Spec))
+		}
+	}
+
+	if o := s.Spec.AdapterOverrides; o != nil && o.Autoscaling != nil {
+		errs = errs.Also(o.Autoscaling.Validate(ctx).ViaField("adapterOverrides", "autoscaling"))
+	}
+
+	return errs.ViaField("spec")
+}
+
+// Pub/Sub subscription limits
+// https://cloud.google.com/pubsub/docs/reference/rest/v1/projects.subscriptions
+const (
+	googleCloudPubSubMinAckDeadline         = 10 * time.Second
+	googleCloudPubSubMaxAckDeadline         = 600 * time.Second
+	googleCloudPubSubMaxBackoff             = 600 * time.Second
+	googleCloudPubSubMinMaxDeliveryAttempts = 5
+	googleCloudPubSubMaxMaxDeliveryAttempts = 100
+)
+
+// validate validates the delivery parameters of the spec.
+func (s *GoogleCloudPubSubSourceSpec) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if ss := s.SubscriptionSettings; ss != nil {
+		// settings only apply to subscriptions managed by the source
+		if s.SubscriptionID != nil {
+			errs = errs.Also(apis.ErrMultipleOneOf("subscriptionID", "subscriptionSettings"))
+		}
+		errs = errs.Also(ss.validate().ViaField("subscriptionSettings"))
+	}
+
+	if fc := s.FlowControl; fc != nil {
+		if fc.MaxOutstandingMessages != nil && *fc.MaxOutstandingMessages < 1 {
+			errs = errs.Also(apis.ErrInvalidValue(*fc.MaxOutstandingMessages, "flowControl.maxOutstandingMessages"))
+		}
+		if fc.MaxOutstandingBytes != nil && *fc.MaxOutstandingBytes < 1 {
+			errs = errs.Also(apis.ErrInvalidValue(*fc.MaxOutstandingBytes, "flowControl.maxOutstandingBytes"))
+		}
+	}
+
+	return errs
+}
+
+// validateUpdate ensures that the settings which can only be set upon the
+// creation of a Pub/Sub subscription remain unchanged while the source
+// manages that subscription.
+func (s *GoogleCloudPubSubSourceSpec) validateUpdate(base *GoogleCloudPubSubSourceSpec) *apis.FieldError {
+	if s.SubscriptionID != nil || base.SubscriptionID != nil {
+		return nil
+	}
+
+	var errs *apis.FieldError
+
+	if subscriptionFilter(s.SubscriptionSettings) != subscriptionFilter(base.SubscriptionSettings) {
+		errs = errs.Also(errImmutableSubscriptionSetting("subscriptionSettings.filter"))
+	}
+	if subscriptionOrdering(s.SubscriptionSettings) != subscriptionOrdering(base.SubscriptionSettings) {
+		errs = errs.Also(errImmutableSubscriptionSetting("subscriptionSettings.enableMessageOrdering"))
+	}
+
+	return errs
+}
+
+// subscriptionFilter returns the filter expression of the given settings.
+func subscriptionFilter(s *GoogleCloudPubSubSubscriptionSettings) string {
+	if s == nil || s.Filter == nil {
+		return ""
+	}
+	return *s.Filter
+}
+
+// subscriptionOrdering returns whether the given settings enable message
+// ordering.
+func subscriptionOrdering(s *GoogleCloudPubSubSubscriptionSettings) bool {
+	return s != nil && s.EnableMessageOrdering
+}
+
+// errImmutableSubscriptionSetting returns an error about the change of a
+// setting which Pub/Sub doesn't allow to update.
+func errImmutableSubscriptionSetting(field string) *apis.FieldError {
+	return &apis.FieldError{
+		Message: "Immutable field changed",
+		Paths:   []string{field},
+		Details: "Pub/Sub doesn't allow changing this setting once the subscription was created",
+	}
+}
+
+// validate validates the settings of a Pub/Sub subscription.
+func (s *GoogleCloudPubSubSubscriptionSettings) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if d := s.AckDeadline; d != nil &&
+		(time.Duration(*d) < googleCloudPubSubMinAckDeadline || time.Duration(*d) > googleCloudPubSubMaxAckDeadline) {
+
+		errs = errs.Also(apis.ErrOutOfBoundsValue(d.String(), googleCloudPubSubMinAckDeadline,
+			googleCloudPubSubMaxAckDeadline, "ackDeadline"))
+	}
+
+	if dlp := s.DeadLetterPolicy; dlp != nil {
+		if dlp.DeadLetterTopic.Collection != "topics" {
+			errs = errs.Also(apis.ErrInvalidValue(dlp.DeadLetterTopic.String(), "deadLetterPolicy.deadLetterTopic"))
+		}
+		if a := dlp.MaxDeliveryAttempts; a != nil &&
+			(*a < googleCloudPubSubMinMaxDeliveryAttempts || *a > googleCloudPubSubMaxMaxDeliveryAttempts) {
+
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*a, googleCloudPubSubMinMaxDeliveryAttempts,
+				googleCloudPubSubMaxMaxDeliveryAttempts, "deadLetterPolicy.maxDeliveryAttempts"))
+		}
+	}
+
+	if rp := s.RetryPolicy; rp != nil {
+		errs = errs.Also(validateBackoff(rp.MinimumBackoff).ViaField("retryPolicy.minimumBackoff"))
+		errs = errs.Also(validateBackoff(rp.MaximumBackoff).ViaField("retryPolicy.maximumBackoff"))
+		if rp.MinimumBackoff != nil && rp.MaximumBackoff != nil && *rp.MinimumBackoff > *rp.MaximumBackoff {
+			errs = errs.Also(apis.ErrInvalidValue(rp.MinimumBackoff.String(), "retryPolicy.minimumBackoff",
+				"minimum backoff must not exceed maximum backoff"))
+		}
+	}
+
+	return errs
+}
+
+// validateBackoff validates the backoff delay of a Pub/Sub retry policy.
+func validateBackoff(d *tmapis.Duration) *apis.FieldError {
+	if d != nil && (*d < 0 || time.Duration(*d) > googleCloudPubSubMaxBackoff) {
+		return apis.ErrOutOfBoundsValue(d.String(), 0, googleCloudPubSubMaxBackoff, apis.CurrentField)
+	}
 	return nil
 }
@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestGoogleCloudPubSubSourceValidateUpdate(t *testing.T) {
+	newSource := func(subsID, filter *string, ordering bool) *GoogleCloudPubSubSource {
+		src := &GoogleCloudPubSubSource{
+			Spec: GoogleCloudPubSubSourceSpec{
+				SubscriptionID: subsID,
+			},
+		}
+		if filter != nil || ordering {
+			src.Spec.SubscriptionSettings = &GoogleCloudPubSubSubscriptionSettings{
+				Filter:                filter,
+				EnableMessageOrdering: ordering,
+			}
+		}
+		return src
+	}
+
+	testCases := map[string]struct {
+		src  *GoogleCloudPubSubSource
+		base *GoogleCloudPubSubSource
+
+		expectPaths []string
+	}{
+		"Unchanged settings": {
+			src:  newSource(nil, ptr.String(`attributes.type = "a"`), true),
+			base: newSource(nil, ptr.String(`attributes.type = "a"`), true),
+		},
+		"Changed filter": {
+			src:         newSource(nil, ptr.String(`attributes.type = "b"`), false),
+			base:        newSource(nil, ptr.String(`attributes.type = "a"`), false),
+			expectPaths: []string{"spec.subscriptionSettings.filter"},
+		},
+		"Added filter and message ordering": {
+			src:  newSource(nil, ptr.String(`attributes.type = "a"`), true),
+			base: newSource(nil, nil, false),
+			expectPaths: []string{
+				"spec.subscriptionSettings.enableMessageOrdering",
+				"spec.subscriptionSettings.filter",
+			},
+		},
+		"Switch from a user-provided subscription": {
+			src:  newSource(nil, ptr.String(`attributes.type = "a"`), true),
+			base: newSource(ptr.String("my-subscription"), nil, false),
+		},
+		"Invalid autoscaling": {
+			src: func() *GoogleCloudPubSubSource {
+				src := newSource(nil, nil, false)
+				src.Spec.AdapterOverrides = &v1alpha1.AdapterOverrides{
+					Autoscaling: &v1alpha1.Autoscaling{
+						MinReplicas: ptr.Int32(2),
+						MaxReplicas: 1,
+					},
+				}
+				return src
+			}(),
+			base:        newSource(nil, nil, false),
+			expectPaths: []string{"spec.adapterOverrides.autoscaling.maxReplicas"},
+		},
+		"Deletion": {
+			src: func() *GoogleCloudPubSubSource {
+				src := newSource(nil, ptr.String(`attributes.type = "b"`), false)
+				src.DeletionTimestamp = &metav1.Time{}
+				return src
+			}(),
+			base: newSource(nil, ptr.String(`attributes.type = "a"`), false),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ctx := apis.WithinUpdate(context.Background(), tc.base)
+
+			errs := tc.src.Validate(ctx)
+
+			if tc.expectPaths == nil {
+				assert.Nil(t, errs)
+				return
+			}
+
+			if assert.NotNil(t, errs) {
+				for _, p := range tc.expectPaths {
+					assert.Contains(t, errs.Error(), p)
+				}
+			}
+		})
+	}
+}
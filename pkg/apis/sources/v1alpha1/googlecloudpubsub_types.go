@@ -20,6 +20,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -62,6 +63,15 @@ type GoogleCloudPubSubSourceSpec struct {
 	// +optional
 	SubscriptionID *string `json:"subscriptionID,omitempty"`
 
+	// Settings of the subscription created on behalf of the user. Cannot
+	// be combined with subscriptionID.
+	// +optional
+	SubscriptionSettings *GoogleCloudPubSubSubscriptionSettings `json:"subscriptionSettings,omitempty"`
+
+	// Flow control settings of the message receiver.
+	// +optional
+	FlowControl *GoogleCloudPubSubFlowControl `json:"flowControl,omitempty"`
+
 	// Different authentication methods available in sources on GCP.
 	Auth v1alpha1.GoogleCloudAuth `json:"auth"`
 
@@ -70,6 +80,84 @@ type GoogleCloudPubSubSourceSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// GoogleCloudPubSubSubscriptionSettings contains the delivery settings of a
+// Pub/Sub subscription.
+type GoogleCloudPubSubSubscriptionSettings struct {
+	// Amount of time Pub/Sub waits for the acknowledgement of a message
+	// before redelivering it. Must be between 10s and 600s. Defaults to 10s.
+	// +optional
+	AckDeadline *apis.Duration `json:"ackDeadline,omitempty"`
+
+	// Whether messages are guaranteed to be delivered exactly once, as
+	// long as they are acknowledged before their ack deadline expires.
+	// +optional
+	EnableExactlyOnceDelivery bool `json:"enableExactlyOnceDelivery,omitempty"`
+
+	// Whether messages published with the same ordering key are delivered
+	// in the order they were published. Cannot be changed once the
+	// subscription was created.
+	// +optional
+	EnableMessageOrdering bool `json:"enableMessageOrdering,omitempty"`
+
+	// Expression which filters messages based on their attributes. Cannot
+	// be changed once the subscription was created.
+	// https://cloud.google.com/pubsub/docs/subscription-message-filter
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+
+	// Policy for forwarding undeliverable messages to a dead-letter topic.
+	// +optional
+	DeadLetterPolicy *GoogleCloudPubSubDeadLetterPolicy `json:"deadLetterPolicy,omitempty"`
+
+	// Policy for redelivering messages which were either negatively
+	// acknowledged or not acknowledged before their ack deadline expired.
+	// Messages are redelivered immediately when not set.
+	// +optional
+	RetryPolicy *GoogleCloudPubSubRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GoogleCloudPubSubDeadLetterPolicy contains the dead-lettering settings of a
+// Pub/Sub subscription.
+type GoogleCloudPubSubDeadLetterPolicy struct {
+	// Full resource name of the Pub/Sub topic to forward undeliverable
+	// messages to, in the format "projects/{project_name}/topics/{topic_name}".
+	DeadLetterTopic GCloudResourceName `json:"deadLetterTopic"`
+
+	// Maximum number of delivery attempts of a message before it is
+	// forwarded to the dead-letter topic. Must be between 5 and 100.
+	// Defaults to 5.
+	// +optional
+	MaxDeliveryAttempts *int32 `json:"maxDeliveryAttempts,omitempty"`
+}
+
+// GoogleCloudPubSubRetryPolicy contains the redelivery settings of a Pub/Sub
+// subscription. Delays between consecutive deliveries of a message grow
+// exponentially between the minimum and maximum backoff.
+type GoogleCloudPubSubRetryPolicy struct {
+	// Minimum delay between consecutive deliveries of a message. Must be
+	// between 0s and 600s. Defaults to 10s.
+	// +optional
+	MinimumBackoff *apis.Duration `json:"minimumBackoff,omitempty"`
+
+	// Maximum delay between consecutive deliveries of a message. Must be
+	// between 0s and 600s. Defaults to 600s.
+	// +optional
+	MaximumBackoff *apis.Duration `json:"maximumBackoff,omitempty"`
+}
+
+// GoogleCloudPubSubFlowControl contains settings which limit the amount of
+// messages pulled from Pub/Sub which haven't been acknowledged yet.
+type GoogleCloudPubSubFlowControl struct {
+	// Maximum number of unacknowledged messages. Defaults to 1000.
+	// +optional
+	MaxOutstandingMessages *int32 `json:"maxOutstandingMessages,omitempty"`
+
+	// Maximum size of unacknowledged messages, in bytes. Defaults to
+	// 1000000000.
+	// +optional
+	MaxOutstandingBytes *int64 `json:"maxOutstandingBytes,omitempty"`
+}
+
 // GoogleCloudPubSubSourceStatus defines the observed state of the event source.
 type GoogleCloudPubSubSourceStatus struct {
 	v1alpha1.Status `json:",inline"`
@@ -84,5 +84,22 @@ func (s *IBMMQSource) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (s *IBMMQSource) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	if o := s.Spec.AdapterOverrides; o != nil && o.Autoscaling != nil {
+		errs = errs.Also(o.Autoscaling.Validate(ctx).ViaField("spec", "adapterOverrides", "autoscaling"))
+	}
+
+	if tls := s.Spec.Auth.TLS; tls != nil {
+		errs = errs.Also(tls.KeyRepository.Validate(ctx).ViaField("spec", "credentials", "tls", "keyRepository"))
+	}
+
+	return errs
+}
+
+// Validate ensures the components of the key repository are referenced from
+// Kubernetes Secrets, which get mounted as files into the adapter.
+func (k *Keystore) Validate(ctx context.Context) *apis.FieldError {
+	return k.KeyDatabase.ValidateSecretRef().ViaField("keyDatabase").Also(
+		k.PasswordStash.ValidateSecretRef().ViaField("passwordStash"))
 }
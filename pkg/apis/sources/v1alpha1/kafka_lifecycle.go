@@ -83,5 +83,30 @@ func (s *KafkaSource) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (s *KafkaSource) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	if o := s.Spec.AdapterOverrides; o != nil && o.Autoscaling != nil {
+		errs = errs.Also(o.Autoscaling.Validate(ctx).ViaField("spec", "adapterOverrides", "autoscaling"))
+	}
+
+	if krb := s.Spec.Auth.Kerberos; krb != nil {
+		errs = errs.Also(krb.Validate(ctx).ViaField("spec", "auth", "kerberos"))
+	}
+
+	return errs.Also(v1alpha1.Verify(ctx, s))
+}
+
+// Validate ensures the Kerberos configuration and keytab are referenced from
+// Kubernetes Secrets, which get mounted as files into the adapter.
+func (k *KafkaSourceKerberos) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if k.Config != nil {
+		errs = errs.Also(k.Config.ValidateSecretRef().ViaField("config"))
+	}
+	if k.Keytab != nil {
+		errs = errs.Also(k.Keytab.ValidateSecretRef().ViaField("keytab"))
+	}
+
+	return errs
 }
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestKafkaSourceValidateKerberos(t *testing.T) {
+	secretRef := &v1alpha1.ValueFromField{
+		ValueFromSecret: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: "krb5"},
+			Key:                  "keytab",
+		},
+	}
+
+	testCases := map[string]struct {
+		kerberos  *KafkaSourceKerberos
+		expectErr string
+	}{
+		"No Kerberos": {},
+		"Files from Secrets": {
+			kerberos: &KafkaSourceKerberos{Config: secretRef, Keytab: secretRef},
+		},
+		"Keytab from file": {
+			kerberos: &KafkaSourceKerberos{
+				Config: secretRef,
+				Keytab: &v1alpha1.ValueFromField{ValueFromFile: "/etc/krb5.keytab"},
+			},
+			expectErr: "spec.auth.kerberos.keytab.valueFromFile",
+		},
+		"Config from provider": {
+			kerberos: &KafkaSourceKerberos{
+				Config: &v1alpha1.ValueFromField{ValueFromProvider: &v1alpha1.ProviderSecretSelector{}},
+			},
+			expectErr: "spec.auth.kerberos.config.valueFromProvider",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &KafkaSource{
+				Spec: KafkaSourceSpec{
+					Auth: KafkaSourceAuth{Kerberos: tc.kerberos},
+				},
+			}
+
+			errs := src.Validate(context.Background())
+
+			if tc.expectErr == "" {
+				assert.Nil(t, errs)
+				return
+			}
+
+			if assert.NotNil(t, errs) {
+				assert.Contains(t, errs.Error(), tc.expectErr)
+			}
+		})
+	}
+}
@@ -76,5 +76,5 @@ func (s *MongoDBSource) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (s *MongoDBSource) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	return v1alpha1.Verify(ctx, s)
 }
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestMongoDBSourceValidateVerify(t *testing.T) {
+	newSource := func(verifyMode, connStr string) *MongoDBSource {
+		src := &MongoDBSource{
+			Spec: MongoDBSourceSpec{
+				ConnectionString: connStr,
+			},
+		}
+		if verifyMode != "" {
+			src.Annotations = map[string]string{v1alpha1.AnnotationVerify: verifyMode}
+		}
+		return src
+	}
+
+	errVerify := errors.New("connection refused")
+	errUnsupported := fmt.Errorf("%w: test", v1alpha1.ErrVerificationUnsupported)
+
+	testCases := map[string]struct {
+		src        *MongoDBSource
+		ctx        func(context.Context) context.Context
+		noVerifier bool
+		verifyErr  error
+
+		expectCalled bool
+		expectLevel  *apis.DiagnosticLevel
+	}{
+		"No annotation": {
+			src: newSource("", "mongodb://localhost"),
+		},
+		"Invalid mode": {
+			src:         newSource("always", "mongodb://localhost"),
+			expectLevel: diagLevel(apis.ErrorLevel),
+		},
+		"Verification disabled": {
+			src:         newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			noVerifier:  true,
+			expectLevel: diagLevel(apis.WarningLevel),
+		},
+		"Successful verification": {
+			src:          newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			expectCalled: true,
+		},
+		"Failed verification in warn mode": {
+			src:          newSource(v1alpha1.VerifyModeWarn, "mongodb://localhost"),
+			verifyErr:    errVerify,
+			expectCalled: true,
+			expectLevel:  diagLevel(apis.WarningLevel),
+		},
+		"Failed verification in reject mode": {
+			src:          newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			verifyErr:    errVerify,
+			expectCalled: true,
+			expectLevel:  diagLevel(apis.ErrorLevel),
+		},
+		"Unsupported verification in reject mode": {
+			src:          newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			verifyErr:    errUnsupported,
+			expectCalled: true,
+			expectLevel:  diagLevel(apis.WarningLevel),
+		},
+		"Status update": {
+			src: newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			ctx: func(ctx context.Context) context.Context {
+				return apis.WithinSubResourceUpdate(ctx, newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"), "status")
+			},
+		},
+		"Update without spec change": {
+			src: newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			ctx: func(ctx context.Context) context.Context {
+				return apis.WithinUpdate(ctx, newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"))
+			},
+		},
+		"Update with spec change": {
+			src: newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			ctx: func(ctx context.Context) context.Context {
+				return apis.WithinUpdate(ctx, newSource(v1alpha1.VerifyModeReject, "mongodb://remotehost"))
+			},
+			expectCalled: true,
+		},
+		"Update adding the annotation": {
+			src: newSource(v1alpha1.VerifyModeReject, "mongodb://localhost"),
+			ctx: func(ctx context.Context) context.Context {
+				return apis.WithinUpdate(ctx, newSource("", "mongodb://localhost"))
+			},
+			expectCalled: true,
+		},
+		"Deletion": {
+			src: func() *MongoDBSource {
+				src := newSource(v1alpha1.VerifyModeReject, "mongodb://localhost")
+				src.DeletionTimestamp = &metav1.Time{}
+				return src
+			}(),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			v := &fakeVerifier{err: tc.verifyErr}
+
+			ctx := context.Background()
+			if !tc.noVerifier {
+				ctx = v1alpha1.WithVerifier(ctx, v)
+			}
+			if tc.ctx != nil {
+				ctx = tc.ctx(ctx)
+			}
+
+			errs := tc.src.Validate(ctx)
+
+			assert.Equal(t, tc.expectCalled, v.called, "Unexpected verification")
+
+			if tc.expectLevel == nil {
+				assert.Nil(t, errs)
+				return
+			}
+
+			if assert.NotNil(t, errs) {
+				assert.NotNil(t, errs.Filter(*tc.expectLevel), "Expected error at level %s", tc.expectLevel)
+				assert.NotContains(t, errs.Error(), errVerify.Error(), "Cause of the failure returned to the client")
+			}
+		})
+	}
+}
+
+// fakeVerifier is a v1alpha1.Verifier which returns a predefined error.
+type fakeVerifier struct {
+	err    error
+	called bool
+}
+
+func (v *fakeVerifier) Verify(context.Context, v1alpha1.Reconcilable) error {
+	v.called = true
+	return v.err
+}
+
+func diagLevel(l apis.DiagnosticLevel) *apis.DiagnosticLevel {
+	return &l
+}
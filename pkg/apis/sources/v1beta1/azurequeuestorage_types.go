@@ -0,0 +1,60 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureQueueStorageSource is the Schema for the event source.
+type AzureQueueStorageSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AzureQueueStorageSourceSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status             `json:"status,omitempty"`
+}
+
+// AzureQueueStorageSourceSpec defines the desired state of the event source.
+type AzureQueueStorageSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	AccountName string                  `json:"accountName"`
+	QueueName   string                  `json:"queueName"`
+	AccountKey  v1alpha1.ValueFromField `json:"accountKey"`
+	// +optional
+	VisibilityTimeout *string `json:"visibilityTimeout,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AzureQueueStorageSourceList contains a list of event sources.
+type AzureQueueStorageSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AzureQueueStorageSource `json:"items"`
+}
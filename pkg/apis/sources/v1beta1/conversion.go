@@ -0,0 +1,787 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCloudWatchSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSCloudWatchSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCloudWatchSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSCloudWatchSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCloudWatchLogsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSCloudWatchLogsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCloudWatchLogsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSCloudWatchLogsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCodeCommitSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSCodeCommitSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCodeCommitSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSCodeCommitSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCognitoIdentitySource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSCognitoIdentitySource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCognitoIdentitySource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSCognitoIdentitySource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSCognitoUserPoolSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSCognitoUserPoolSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSCognitoUserPoolSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSCognitoUserPoolSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSDynamoDBSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSDynamoDBSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSDynamoDBSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSDynamoDBSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSEventBridgeSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSEventBridgeSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSEventBridgeSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSEventBridgeSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSKinesisSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSKinesisSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSKinesisSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSKinesisSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSS3Source) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSS3Source:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSS3Source) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSS3Source:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSSNSSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSSNSSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSSNSSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSSNSSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSSQSSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSSQSSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSSQSSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSSQSSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AWSPerformanceInsightsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AWSPerformanceInsightsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AWSPerformanceInsightsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AWSPerformanceInsightsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureActivityLogsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureActivityLogsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureActivityLogsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureActivityLogsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureBlobStorageSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureBlobStorageSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureBlobStorageSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureBlobStorageSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureEventGridSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureEventGridSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureEventGridSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureEventGridSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureEventHubsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureEventHubsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureEventHubsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureEventHubsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureIOTHubSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureIOTHubSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureIOTHubSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureIOTHubSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureQueueStorageSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureQueueStorageSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureQueueStorageSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureQueueStorageSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusQueueSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureServiceBusQueueSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusQueueSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureServiceBusQueueSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureServiceBusSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureServiceBusSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *AzureServiceBusTopicSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.AzureServiceBusTopicSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *AzureServiceBusTopicSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.AzureServiceBusTopicSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *CloudEventsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.CloudEventsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *CloudEventsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.CloudEventsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *MongoDBSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.MongoDBSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *MongoDBSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.MongoDBSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *KafkaSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.KafkaSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *KafkaSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.KafkaSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudAuditLogsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudAuditLogsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudAuditLogsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudAuditLogsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudBillingSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudBillingSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudBillingSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudBillingSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudPubSubSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudPubSubSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudPubSubSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudPubSubSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudSourceRepositoriesSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudSourceRepositoriesSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *GoogleCloudStorageSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.GoogleCloudStorageSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *GoogleCloudStorageSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.GoogleCloudStorageSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *HTTPPollerSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.HTTPPollerSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *HTTPPollerSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.HTTPPollerSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *IBMMQSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.IBMMQSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *IBMMQSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.IBMMQSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *OCIMetricsSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.OCIMetricsSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *OCIMetricsSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.OCIMetricsSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *SalesforceSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.SalesforceSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SalesforceSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.SalesforceSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *SlackSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.SlackSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SlackSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.SlackSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *SolaceSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.SolaceSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *SolaceSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.SolaceSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *TwilioSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.TwilioSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *TwilioSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.TwilioSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *WebhookSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.WebhookSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *WebhookSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.WebhookSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (s *ZendeskSource) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *sourcesv1alpha1.ZendeskSource:
+		return v1alpha1.ConvertViaJSON(s, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", s, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (s *ZendeskSource) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *sourcesv1alpha1.ZendeskSource:
+		return v1alpha1.ConvertViaJSON(hub, s)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, s)
+	}
+}
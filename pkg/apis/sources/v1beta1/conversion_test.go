@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestConvertRoundTrip(t *testing.T) {
+	ctx := context.Background()
+
+	hub := &sourcesv1alpha1.AWSSQSSource{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: sourcesv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "AWSSQSSource",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "test",
+			Labels:    map[string]string{"app": "test"},
+		},
+		Spec: sourcesv1alpha1.AWSSQSSourceSpec{
+			ARN: apis.ARN{
+				Partition: "aws",
+				Service:   "sqs",
+				Region:    "us-east-1",
+				AccountID: "123456789012",
+				Resource:  "my-queue",
+			},
+			Auth: v1alpha1.AWSAuth{
+				Credentials: &v1alpha1.AWSSecurityCredentials{
+					AccessKeyID:     v1alpha1.ValueFromField{Value: "access-key"},
+					SecretAccessKey: v1alpha1.ValueFromField{Value: "secret-key"},
+				},
+			},
+		},
+		Status: v1alpha1.Status{
+			SourceStatus: duckv1.SourceStatus{
+				Status: duckv1.Status{
+					ObservedGeneration: 2,
+				},
+			},
+		},
+	}
+
+	src := &AWSSQSSource{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       "AWSSQSSource",
+		},
+	}
+	require.NoError(t, hub.ConvertTo(ctx, src))
+
+	assert.Equal(t, SchemeGroupVersion.String(), src.APIVersion, "Type metadata is preserved")
+	assert.Equal(t, hub.ObjectMeta, src.ObjectMeta)
+	assert.Equal(t, hub.Spec.ARN, src.Spec.ARN)
+	assert.Equal(t, hub.Status, src.Status)
+
+	roundTrip := &sourcesv1alpha1.AWSSQSSource{
+		TypeMeta: hub.TypeMeta,
+	}
+	require.NoError(t, roundTrip.ConvertFrom(ctx, src))
+
+	assert.Equal(t, hub, roundTrip)
+}
+
+func TestValidateViaHub(t *testing.T) {
+	ctx := context.Background()
+
+	src := &AWSSQSSource{
+		Spec: AWSSQSSourceSpec{
+			ARN: apis.ARN{
+				Partition: "aws",
+				Service:   "sqs",
+				Region:    "us-east-1",
+				AccountID: "123456789012",
+				Resource:  "my-queue",
+			},
+		},
+	}
+
+	err := src.Validate(ctx)
+	assert.Nil(t, err)
+
+	src.Spec.Auth.EksIAMRole = &apis.ARN{
+		Partition: "aws",
+		Service:   "iam",
+		AccountID: "123456789012",
+		Resource:  "role/test",
+	}
+
+	err = src.Validate(ctx)
+	assert.NotNil(t, err, "Deprecated attributes are rejected by the hub version")
+}
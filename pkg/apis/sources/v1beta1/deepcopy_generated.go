@@ -0,0 +1,4877 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchLogsSource) DeepCopyInto(out *AWSCloudWatchLogsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchLogsSource.
+func (in *AWSCloudWatchLogsSource) DeepCopy() *AWSCloudWatchLogsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchLogsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCloudWatchLogsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchLogsSourceList) DeepCopyInto(out *AWSCloudWatchLogsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSCloudWatchLogsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchLogsSourceList.
+func (in *AWSCloudWatchLogsSourceList) DeepCopy() *AWSCloudWatchLogsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchLogsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCloudWatchLogsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchLogsSourceSpec) DeepCopyInto(out *AWSCloudWatchLogsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchLogsSourceSpec.
+func (in *AWSCloudWatchLogsSourceSpec) DeepCopy() *AWSCloudWatchLogsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchLogsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchMetric) DeepCopyInto(out *AWSCloudWatchMetric) {
+	*out = *in
+	if in.Dimensions != nil {
+		in, out := &in.Dimensions, &out.Dimensions
+		*out = make([]AWSCloudWatchMetricDimension, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchMetric.
+func (in *AWSCloudWatchMetric) DeepCopy() *AWSCloudWatchMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchMetricDimension) DeepCopyInto(out *AWSCloudWatchMetricDimension) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchMetricDimension.
+func (in *AWSCloudWatchMetricDimension) DeepCopy() *AWSCloudWatchMetricDimension {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchMetricDimension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchMetricQuery) DeepCopyInto(out *AWSCloudWatchMetricQuery) {
+	*out = *in
+	if in.Expression != nil {
+		in, out := &in.Expression, &out.Expression
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metric != nil {
+		in, out := &in.Metric, &out.Metric
+		*out = new(AWSCloudWatchMetricStat)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchMetricQuery.
+func (in *AWSCloudWatchMetricQuery) DeepCopy() *AWSCloudWatchMetricQuery {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchMetricQuery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchMetricStat) DeepCopyInto(out *AWSCloudWatchMetricStat) {
+	*out = *in
+	in.Metric.DeepCopyInto(&out.Metric)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchMetricStat.
+func (in *AWSCloudWatchMetricStat) DeepCopy() *AWSCloudWatchMetricStat {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchMetricStat)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchSource) DeepCopyInto(out *AWSCloudWatchSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchSource.
+func (in *AWSCloudWatchSource) DeepCopy() *AWSCloudWatchSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCloudWatchSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchSourceList) DeepCopyInto(out *AWSCloudWatchSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSCloudWatchSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchSourceList.
+func (in *AWSCloudWatchSourceList) DeepCopy() *AWSCloudWatchSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCloudWatchSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCloudWatchSourceSpec) DeepCopyInto(out *AWSCloudWatchSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.PollingInterval != nil {
+		in, out := &in.PollingInterval, &out.PollingInterval
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MetricQueries != nil {
+		in, out := &in.MetricQueries, &out.MetricQueries
+		*out = make([]AWSCloudWatchMetricQuery, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCloudWatchSourceSpec.
+func (in *AWSCloudWatchSourceSpec) DeepCopy() *AWSCloudWatchSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCloudWatchSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCodeCommitSource) DeepCopyInto(out *AWSCodeCommitSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCodeCommitSource.
+func (in *AWSCodeCommitSource) DeepCopy() *AWSCodeCommitSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCodeCommitSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCodeCommitSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCodeCommitSourceList) DeepCopyInto(out *AWSCodeCommitSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSCodeCommitSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCodeCommitSourceList.
+func (in *AWSCodeCommitSourceList) DeepCopy() *AWSCodeCommitSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCodeCommitSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCodeCommitSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCodeCommitSourceSpec) DeepCopyInto(out *AWSCodeCommitSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCodeCommitSourceSpec.
+func (in *AWSCodeCommitSourceSpec) DeepCopy() *AWSCodeCommitSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCodeCommitSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCognitoIdentitySource) DeepCopyInto(out *AWSCognitoIdentitySource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCognitoIdentitySource.
+func (in *AWSCognitoIdentitySource) DeepCopy() *AWSCognitoIdentitySource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCognitoIdentitySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCognitoIdentitySource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCognitoIdentitySourceList) DeepCopyInto(out *AWSCognitoIdentitySourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSCognitoIdentitySource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCognitoIdentitySourceList.
+func (in *AWSCognitoIdentitySourceList) DeepCopy() *AWSCognitoIdentitySourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCognitoIdentitySourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCognitoIdentitySourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCognitoIdentitySourceSpec) DeepCopyInto(out *AWSCognitoIdentitySourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCognitoIdentitySourceSpec.
+func (in *AWSCognitoIdentitySourceSpec) DeepCopy() *AWSCognitoIdentitySourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCognitoIdentitySourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCognitoUserPoolSource) DeepCopyInto(out *AWSCognitoUserPoolSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCognitoUserPoolSource.
+func (in *AWSCognitoUserPoolSource) DeepCopy() *AWSCognitoUserPoolSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCognitoUserPoolSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCognitoUserPoolSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCognitoUserPoolSourceList) DeepCopyInto(out *AWSCognitoUserPoolSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSCognitoUserPoolSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCognitoUserPoolSourceList.
+func (in *AWSCognitoUserPoolSourceList) DeepCopy() *AWSCognitoUserPoolSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCognitoUserPoolSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSCognitoUserPoolSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSCognitoUserPoolSourceSpec) DeepCopyInto(out *AWSCognitoUserPoolSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSCognitoUserPoolSourceSpec.
+func (in *AWSCognitoUserPoolSourceSpec) DeepCopy() *AWSCognitoUserPoolSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSCognitoUserPoolSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBSource) DeepCopyInto(out *AWSDynamoDBSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBSource.
+func (in *AWSDynamoDBSource) DeepCopy() *AWSDynamoDBSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSDynamoDBSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBSourceList) DeepCopyInto(out *AWSDynamoDBSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSDynamoDBSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBSourceList.
+func (in *AWSDynamoDBSourceList) DeepCopy() *AWSDynamoDBSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSDynamoDBSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBSourceSpec) DeepCopyInto(out *AWSDynamoDBSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBSourceSpec.
+func (in *AWSDynamoDBSourceSpec) DeepCopy() *AWSDynamoDBSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeSource) DeepCopyInto(out *AWSEventBridgeSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeSource.
+func (in *AWSEventBridgeSource) DeepCopy() *AWSEventBridgeSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSEventBridgeSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeSourceDestination) DeepCopyInto(out *AWSEventBridgeSourceDestination) {
+	*out = *in
+	if in.SQS != nil {
+		in, out := &in.SQS, &out.SQS
+		*out = new(AWSEventBridgeSourceDestinationSQS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeSourceDestination.
+func (in *AWSEventBridgeSourceDestination) DeepCopy() *AWSEventBridgeSourceDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeSourceDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeSourceDestinationSQS) DeepCopyInto(out *AWSEventBridgeSourceDestinationSQS) {
+	*out = *in
+	out.QueueARN = in.QueueARN
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeSourceDestinationSQS.
+func (in *AWSEventBridgeSourceDestinationSQS) DeepCopy() *AWSEventBridgeSourceDestinationSQS {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeSourceDestinationSQS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeSourceList) DeepCopyInto(out *AWSEventBridgeSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSEventBridgeSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeSourceList.
+func (in *AWSEventBridgeSourceList) DeepCopy() *AWSEventBridgeSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSEventBridgeSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeSourceSpec) DeepCopyInto(out *AWSEventBridgeSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.EventPattern != nil {
+		in, out := &in.EventPattern, &out.EventPattern
+		*out = new(string)
+		**out = **in
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(AWSEventBridgeSourceDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeSourceSpec.
+func (in *AWSEventBridgeSourceSpec) DeepCopy() *AWSEventBridgeSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeSourceStatus) DeepCopyInto(out *AWSEventBridgeSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.EventRuleARN != nil {
+		in, out := &in.EventRuleARN, &out.EventRuleARN
+		*out = new(apis.ARN)
+		**out = **in
+	}
+	if in.QueueARN != nil {
+		in, out := &in.QueueARN, &out.QueueARN
+		*out = new(apis.ARN)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeSourceStatus.
+func (in *AWSEventBridgeSourceStatus) DeepCopy() *AWSEventBridgeSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisSource) DeepCopyInto(out *AWSKinesisSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisSource.
+func (in *AWSKinesisSource) DeepCopy() *AWSKinesisSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSKinesisSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisSourceList) DeepCopyInto(out *AWSKinesisSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSKinesisSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisSourceList.
+func (in *AWSKinesisSourceList) DeepCopy() *AWSKinesisSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSKinesisSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisSourceSpec) DeepCopyInto(out *AWSKinesisSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisSourceSpec.
+func (in *AWSKinesisSourceSpec) DeepCopy() *AWSKinesisSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSPerformanceInsightsSource) DeepCopyInto(out *AWSPerformanceInsightsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPerformanceInsightsSource.
+func (in *AWSPerformanceInsightsSource) DeepCopy() *AWSPerformanceInsightsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSPerformanceInsightsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSPerformanceInsightsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSPerformanceInsightsSourceList) DeepCopyInto(out *AWSPerformanceInsightsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSPerformanceInsightsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPerformanceInsightsSourceList.
+func (in *AWSPerformanceInsightsSourceList) DeepCopy() *AWSPerformanceInsightsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSPerformanceInsightsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSPerformanceInsightsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSPerformanceInsightsSourceSpec) DeepCopyInto(out *AWSPerformanceInsightsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSPerformanceInsightsSourceSpec.
+func (in *AWSPerformanceInsightsSourceSpec) DeepCopy() *AWSPerformanceInsightsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSPerformanceInsightsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3Source) DeepCopyInto(out *AWSS3Source) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3Source.
+func (in *AWSS3Source) DeepCopy() *AWSS3Source {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3Source)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSS3Source) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3SourceDestination) DeepCopyInto(out *AWSS3SourceDestination) {
+	*out = *in
+	if in.SQS != nil {
+		in, out := &in.SQS, &out.SQS
+		*out = new(AWSS3SourceDestinationSQS)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3SourceDestination.
+func (in *AWSS3SourceDestination) DeepCopy() *AWSS3SourceDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3SourceDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3SourceDestinationSQS) DeepCopyInto(out *AWSS3SourceDestinationSQS) {
+	*out = *in
+	out.QueueARN = in.QueueARN
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3SourceDestinationSQS.
+func (in *AWSS3SourceDestinationSQS) DeepCopy() *AWSS3SourceDestinationSQS {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3SourceDestinationSQS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3SourceList) DeepCopyInto(out *AWSS3SourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSS3Source, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3SourceList.
+func (in *AWSS3SourceList) DeepCopy() *AWSS3SourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3SourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSS3SourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3SourceSpec) DeepCopyInto(out *AWSS3SourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(AWSS3SourceDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3SourceSpec.
+func (in *AWSS3SourceSpec) DeepCopy() *AWSS3SourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3SourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3SourceStatus) DeepCopyInto(out *AWSS3SourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.QueueARN != nil {
+		in, out := &in.QueueARN, &out.QueueARN
+		*out = new(apis.ARN)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3SourceStatus.
+func (in *AWSS3SourceStatus) DeepCopy() *AWSS3SourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3SourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSSource) DeepCopyInto(out *AWSSNSSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSSource.
+func (in *AWSSNSSource) DeepCopy() *AWSSNSSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSNSSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSSourceList) DeepCopyInto(out *AWSSNSSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSNSSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSSourceList.
+func (in *AWSSNSSourceList) DeepCopy() *AWSSNSSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSNSSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSSourceSpec) DeepCopyInto(out *AWSSNSSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.SubscriptionAttributes != nil {
+		in, out := &in.SubscriptionAttributes, &out.SubscriptionAttributes
+		*out = make(map[string]*string, len(*in))
+		for key, val := range *in {
+			var outVal *string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				in, out := &val, &outVal
+				*out = new(string)
+				**out = **in
+			}
+			(*out)[key] = outVal
+		}
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSSourceSpec.
+func (in *AWSSNSSourceSpec) DeepCopy() *AWSSNSSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSSourceStatus) DeepCopyInto(out *AWSSNSSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.SubscriptionARN != nil {
+		in, out := &in.SubscriptionARN, &out.SubscriptionARN
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSSourceStatus.
+func (in *AWSSNSSourceStatus) DeepCopy() *AWSSNSSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSSource) DeepCopyInto(out *AWSSQSSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSSource.
+func (in *AWSSQSSource) DeepCopy() *AWSSQSSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSQSSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSSourceList) DeepCopyInto(out *AWSSQSSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSQSSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSSourceList.
+func (in *AWSSQSSourceList) DeepCopy() *AWSSQSSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSQSSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSSourceReceiveOptions) DeepCopyInto(out *AWSSQSSourceReceiveOptions) {
+	*out = *in
+	if in.VisibilityTimeout != nil {
+		in, out := &in.VisibilityTimeout, &out.VisibilityTimeout
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.Receivers != nil {
+		in, out := &in.Receivers, &out.Receivers
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Processors != nil {
+		in, out := &in.Processors, &out.Processors
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PreserveMessageGroupOrder != nil {
+		in, out := &in.PreserveMessageGroupOrder, &out.PreserveMessageGroupOrder
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSSourceReceiveOptions.
+func (in *AWSSQSSourceReceiveOptions) DeepCopy() *AWSSQSSourceReceiveOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSSourceReceiveOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSSourceSpec) DeepCopyInto(out *AWSSQSSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.ARN = in.ARN
+	if in.ReceiveOptions != nil {
+		in, out := &in.ReceiveOptions, &out.ReceiveOptions
+		*out = new(AWSSQSSourceReceiveOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageProcessor != nil {
+		in, out := &in.MessageProcessor, &out.MessageProcessor
+		*out = new(string)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSSourceSpec.
+func (in *AWSSQSSourceSpec) DeepCopy() *AWSSQSSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureActivityLogsSource) DeepCopyInto(out *AzureActivityLogsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureActivityLogsSource.
+func (in *AzureActivityLogsSource) DeepCopy() *AzureActivityLogsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureActivityLogsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureActivityLogsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureActivityLogsSourceDestination) DeepCopyInto(out *AzureActivityLogsSourceDestination) {
+	*out = *in
+	in.EventHubs.DeepCopyInto(&out.EventHubs)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureActivityLogsSourceDestination.
+func (in *AzureActivityLogsSourceDestination) DeepCopy() *AzureActivityLogsSourceDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureActivityLogsSourceDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureActivityLogsSourceDestinationEventHubs) DeepCopyInto(out *AzureActivityLogsSourceDestinationEventHubs) {
+	*out = *in
+	out.NamespaceID = in.NamespaceID
+	if in.HubName != nil {
+		in, out := &in.HubName, &out.HubName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(string)
+		**out = **in
+	}
+	if in.SASPolicy != nil {
+		in, out := &in.SASPolicy, &out.SASPolicy
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureActivityLogsSourceDestinationEventHubs.
+func (in *AzureActivityLogsSourceDestinationEventHubs) DeepCopy() *AzureActivityLogsSourceDestinationEventHubs {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureActivityLogsSourceDestinationEventHubs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureActivityLogsSourceList) DeepCopyInto(out *AzureActivityLogsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureActivityLogsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureActivityLogsSourceList.
+func (in *AzureActivityLogsSourceList) DeepCopy() *AzureActivityLogsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureActivityLogsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureActivityLogsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureActivityLogsSourceSpec) DeepCopyInto(out *AzureActivityLogsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.Destination.DeepCopyInto(&out.Destination)
+	if in.Categories != nil {
+		in, out := &in.Categories, &out.Categories
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureActivityLogsSourceSpec.
+func (in *AzureActivityLogsSourceSpec) DeepCopy() *AzureActivityLogsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureActivityLogsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureActivityLogsSourceStatus) DeepCopyInto(out *AzureActivityLogsSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureActivityLogsSourceStatus.
+func (in *AzureActivityLogsSourceStatus) DeepCopy() *AzureActivityLogsSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureActivityLogsSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAuth) DeepCopyInto(out *AzureAuth) {
+	*out = *in
+	if in.ServicePrincipal != nil {
+		in, out := &in.ServicePrincipal, &out.ServicePrincipal
+		*out = new(AzureServicePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASToken != nil {
+		in, out := &in.SASToken, &out.SASToken
+		*out = new(AzureSASToken)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAuth.
+func (in *AzureAuth) DeepCopy() *AzureAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobStorageSource) DeepCopyInto(out *AzureBlobStorageSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobStorageSource.
+func (in *AzureBlobStorageSource) DeepCopy() *AzureBlobStorageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobStorageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureBlobStorageSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobStorageSourceList) DeepCopyInto(out *AzureBlobStorageSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureBlobStorageSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobStorageSourceList.
+func (in *AzureBlobStorageSourceList) DeepCopy() *AzureBlobStorageSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobStorageSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureBlobStorageSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobStorageSourceSpec) DeepCopyInto(out *AzureBlobStorageSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.StorageAccountID = in.StorageAccountID
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobStorageSourceSpec.
+func (in *AzureBlobStorageSourceSpec) DeepCopy() *AzureBlobStorageSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobStorageSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureBlobStorageSourceStatus) DeepCopyInto(out *AzureBlobStorageSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.EventHubID != nil {
+		in, out := &in.EventHubID, &out.EventHubID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureBlobStorageSourceStatus.
+func (in *AzureBlobStorageSourceStatus) DeepCopy() *AzureBlobStorageSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureBlobStorageSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventGridSource) DeepCopyInto(out *AzureEventGridSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventGridSource.
+func (in *AzureEventGridSource) DeepCopy() *AzureEventGridSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventGridSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureEventGridSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventGridSourceDestinationEventHubs) DeepCopyInto(out *AzureEventGridSourceDestinationEventHubs) {
+	*out = *in
+	out.NamespaceID = in.NamespaceID
+	if in.HubName != nil {
+		in, out := &in.HubName, &out.HubName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventGridSourceDestinationEventHubs.
+func (in *AzureEventGridSourceDestinationEventHubs) DeepCopy() *AzureEventGridSourceDestinationEventHubs {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventGridSourceDestinationEventHubs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventGridSourceEndpoint) DeepCopyInto(out *AzureEventGridSourceEndpoint) {
+	*out = *in
+	in.EventHubs.DeepCopyInto(&out.EventHubs)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventGridSourceEndpoint.
+func (in *AzureEventGridSourceEndpoint) DeepCopy() *AzureEventGridSourceEndpoint {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventGridSourceEndpoint)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventGridSourceList) DeepCopyInto(out *AzureEventGridSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureEventGridSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventGridSourceList.
+func (in *AzureEventGridSourceList) DeepCopy() *AzureEventGridSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventGridSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureEventGridSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventGridSourceSpec) DeepCopyInto(out *AzureEventGridSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.Scope = in.Scope
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventGridSourceSpec.
+func (in *AzureEventGridSourceSpec) DeepCopy() *AzureEventGridSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventGridSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventGridSourceStatus) DeepCopyInto(out *AzureEventGridSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.EventSubscriptionID != nil {
+		in, out := &in.EventSubscriptionID, &out.EventSubscriptionID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	if in.EventHubID != nil {
+		in, out := &in.EventHubID, &out.EventHubID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventGridSourceStatus.
+func (in *AzureEventGridSourceStatus) DeepCopy() *AzureEventGridSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventGridSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubsSource) DeepCopyInto(out *AzureEventHubsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubsSource.
+func (in *AzureEventHubsSource) DeepCopy() *AzureEventHubsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureEventHubsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubsSourceList) DeepCopyInto(out *AzureEventHubsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureEventHubsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubsSourceList.
+func (in *AzureEventHubsSourceList) DeepCopy() *AzureEventHubsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureEventHubsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubsSourceSpec) DeepCopyInto(out *AzureEventHubsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.EventHubID = in.EventHubID
+	if in.ConsumerGroup != nil {
+		in, out := &in.ConsumerGroup, &out.ConsumerGroup
+		*out = new(string)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.MessageTimeout != nil {
+		in, out := &in.MessageTimeout, &out.MessageTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.MessageCountSize != nil {
+		in, out := &in.MessageCountSize, &out.MessageCountSize
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubsSourceSpec.
+func (in *AzureEventHubsSourceSpec) DeepCopy() *AzureEventHubsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureIOTHubSource) DeepCopyInto(out *AzureIOTHubSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureIOTHubSource.
+func (in *AzureIOTHubSource) DeepCopy() *AzureIOTHubSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureIOTHubSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureIOTHubSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureIOTHubSourceList) DeepCopyInto(out *AzureIOTHubSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureIOTHubSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureIOTHubSourceList.
+func (in *AzureIOTHubSourceList) DeepCopy() *AzureIOTHubSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureIOTHubSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureIOTHubSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureIOTHubSourceSpec) DeepCopyInto(out *AzureIOTHubSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureIOTHubSourceSpec.
+func (in *AzureIOTHubSourceSpec) DeepCopy() *AzureIOTHubSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureIOTHubSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureQueueStorageSource) DeepCopyInto(out *AzureQueueStorageSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureQueueStorageSource.
+func (in *AzureQueueStorageSource) DeepCopy() *AzureQueueStorageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureQueueStorageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureQueueStorageSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureQueueStorageSourceList) DeepCopyInto(out *AzureQueueStorageSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureQueueStorageSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureQueueStorageSourceList.
+func (in *AzureQueueStorageSourceList) DeepCopy() *AzureQueueStorageSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureQueueStorageSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureQueueStorageSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureQueueStorageSourceSpec) DeepCopyInto(out *AzureQueueStorageSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.AccountKey.DeepCopyInto(&out.AccountKey)
+	if in.VisibilityTimeout != nil {
+		in, out := &in.VisibilityTimeout, &out.VisibilityTimeout
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureQueueStorageSourceSpec.
+func (in *AzureQueueStorageSourceSpec) DeepCopy() *AzureQueueStorageSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureQueueStorageSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureResourceID) DeepCopyInto(out *AzureResourceID) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureResourceID.
+func (in *AzureResourceID) DeepCopy() *AzureResourceID {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureResourceID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSASToken) DeepCopyInto(out *AzureSASToken) {
+	*out = *in
+	in.KeyName.DeepCopyInto(&out.KeyName)
+	in.KeyValue.DeepCopyInto(&out.KeyValue)
+	in.ConnectionString.DeepCopyInto(&out.ConnectionString)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSASToken.
+func (in *AzureSASToken) DeepCopy() *AzureSASToken {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSASToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusQueueSource) DeepCopyInto(out *AzureServiceBusQueueSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusQueueSource.
+func (in *AzureServiceBusQueueSource) DeepCopy() *AzureServiceBusQueueSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusQueueSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusQueueSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusQueueSourceList) DeepCopyInto(out *AzureServiceBusQueueSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureServiceBusQueueSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusQueueSourceList.
+func (in *AzureServiceBusQueueSourceList) DeepCopy() *AzureServiceBusQueueSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusQueueSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusQueueSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusQueueSourceSpec) DeepCopyInto(out *AzureServiceBusQueueSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.QueueID = in.QueueID
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusQueueSourceSpec.
+func (in *AzureServiceBusQueueSourceSpec) DeepCopy() *AzureServiceBusQueueSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusQueueSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusSource) DeepCopyInto(out *AzureServiceBusSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusSource.
+func (in *AzureServiceBusSource) DeepCopy() *AzureServiceBusSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusSourceList) DeepCopyInto(out *AzureServiceBusSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureServiceBusSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusSourceList.
+func (in *AzureServiceBusSourceList) DeepCopy() *AzureServiceBusSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusSourceSpec) DeepCopyInto(out *AzureServiceBusSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.TopicID != nil {
+		in, out := &in.TopicID, &out.TopicID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	if in.QueueID != nil {
+		in, out := &in.QueueID, &out.QueueID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.WebSocketsEnable != nil {
+		in, out := &in.WebSocketsEnable, &out.WebSocketsEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.MaxConcurrent != nil {
+		in, out := &in.MaxConcurrent, &out.MaxConcurrent
+		*out = new(int)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusSourceSpec.
+func (in *AzureServiceBusSourceSpec) DeepCopy() *AzureServiceBusSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusSourceStatus) DeepCopyInto(out *AzureServiceBusSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusSourceStatus.
+func (in *AzureServiceBusSourceStatus) DeepCopy() *AzureServiceBusSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTopicSource) DeepCopyInto(out *AzureServiceBusTopicSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTopicSource.
+func (in *AzureServiceBusTopicSource) DeepCopy() *AzureServiceBusTopicSource {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTopicSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusTopicSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTopicSourceList) DeepCopyInto(out *AzureServiceBusTopicSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureServiceBusTopicSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTopicSourceList.
+func (in *AzureServiceBusTopicSourceList) DeepCopy() *AzureServiceBusTopicSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTopicSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusTopicSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTopicSourceSpec) DeepCopyInto(out *AzureServiceBusTopicSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.TopicID = in.TopicID
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.WebSocketsEnable != nil {
+		in, out := &in.WebSocketsEnable, &out.WebSocketsEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTopicSourceSpec.
+func (in *AzureServiceBusTopicSourceSpec) DeepCopy() *AzureServiceBusTopicSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTopicSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTopicSourceStatus) DeepCopyInto(out *AzureServiceBusTopicSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTopicSourceStatus.
+func (in *AzureServiceBusTopicSourceStatus) DeepCopy() *AzureServiceBusTopicSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTopicSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServicePrincipal) DeepCopyInto(out *AzureServicePrincipal) {
+	*out = *in
+	in.TenantID.DeepCopyInto(&out.TenantID)
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServicePrincipal.
+func (in *AzureServicePrincipal) DeepCopy() *AzureServicePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServicePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsSource) DeepCopyInto(out *CloudEventsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsSource.
+func (in *CloudEventsSource) DeepCopy() *CloudEventsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudEventsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsSourceList) DeepCopyInto(out *CloudEventsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudEventsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsSourceList.
+func (in *CloudEventsSourceList) DeepCopy() *CloudEventsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudEventsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsSourceSpec) DeepCopyInto(out *CloudEventsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(HTTPCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	if in.RateLimiter != nil {
+		in, out := &in.RateLimiter, &out.RateLimiter
+		*out = new(RateLimiter)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsSourceSpec.
+func (in *CloudEventsSourceSpec) DeepCopy() *CloudEventsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Credentials) DeepCopyInto(out *Credentials) {
+	*out = *in
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credentials.
+func (in *Credentials) DeepCopy() *Credentials {
+	if in == nil {
+		return nil
+	}
+	out := new(Credentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Delivery) DeepCopyInto(out *Delivery) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Delivery.
+func (in *Delivery) DeepCopy() *Delivery {
+	if in == nil {
+		return nil
+	}
+	out := new(Delivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCloudResourceName) DeepCopyInto(out *GCloudResourceName) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCloudResourceName.
+func (in *GCloudResourceName) DeepCopy() *GCloudResourceName {
+	if in == nil {
+		return nil
+	}
+	out := new(GCloudResourceName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudAuditLogsSource) DeepCopyInto(out *GoogleCloudAuditLogsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudAuditLogsSource.
+func (in *GoogleCloudAuditLogsSource) DeepCopy() *GoogleCloudAuditLogsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudAuditLogsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudAuditLogsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudAuditLogsSourceList) DeepCopyInto(out *GoogleCloudAuditLogsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudAuditLogsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudAuditLogsSourceList.
+func (in *GoogleCloudAuditLogsSourceList) DeepCopy() *GoogleCloudAuditLogsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudAuditLogsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudAuditLogsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudAuditLogsSourceSpec) DeepCopyInto(out *GoogleCloudAuditLogsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.ResourceName != nil {
+		in, out := &in.ResourceName, &out.ResourceName
+		*out = new(string)
+		**out = **in
+	}
+	in.PubSub.DeepCopyInto(&out.PubSub)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudAuditLogsSourceSpec.
+func (in *GoogleCloudAuditLogsSourceSpec) DeepCopy() *GoogleCloudAuditLogsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudAuditLogsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudAuditLogsSourceStatus) DeepCopyInto(out *GoogleCloudAuditLogsSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.AuditLogsSink != nil {
+		in, out := &in.AuditLogsSink, &out.AuditLogsSink
+		*out = new(string)
+		**out = **in
+	}
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudAuditLogsSourceStatus.
+func (in *GoogleCloudAuditLogsSourceStatus) DeepCopy() *GoogleCloudAuditLogsSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudAuditLogsSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudBillingSource) DeepCopyInto(out *GoogleCloudBillingSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudBillingSource.
+func (in *GoogleCloudBillingSource) DeepCopy() *GoogleCloudBillingSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudBillingSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudBillingSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudBillingSourceList) DeepCopyInto(out *GoogleCloudBillingSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudBillingSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudBillingSourceList.
+func (in *GoogleCloudBillingSourceList) DeepCopy() *GoogleCloudBillingSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudBillingSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudBillingSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudBillingSourceSpec) DeepCopyInto(out *GoogleCloudBillingSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.PubSub.DeepCopyInto(&out.PubSub)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudBillingSourceSpec.
+func (in *GoogleCloudBillingSourceSpec) DeepCopy() *GoogleCloudBillingSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudBillingSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudBillingSourceStatus) DeepCopyInto(out *GoogleCloudBillingSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudBillingSourceStatus.
+func (in *GoogleCloudBillingSourceStatus) DeepCopy() *GoogleCloudBillingSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudBillingSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubDeadLetterPolicy) DeepCopyInto(out *GoogleCloudPubSubDeadLetterPolicy) {
+	*out = *in
+	out.DeadLetterTopic = in.DeadLetterTopic
+	if in.MaxDeliveryAttempts != nil {
+		in, out := &in.MaxDeliveryAttempts, &out.MaxDeliveryAttempts
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubDeadLetterPolicy.
+func (in *GoogleCloudPubSubDeadLetterPolicy) DeepCopy() *GoogleCloudPubSubDeadLetterPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubDeadLetterPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubFlowControl) DeepCopyInto(out *GoogleCloudPubSubFlowControl) {
+	*out = *in
+	if in.MaxOutstandingMessages != nil {
+		in, out := &in.MaxOutstandingMessages, &out.MaxOutstandingMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxOutstandingBytes != nil {
+		in, out := &in.MaxOutstandingBytes, &out.MaxOutstandingBytes
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubFlowControl.
+func (in *GoogleCloudPubSubFlowControl) DeepCopy() *GoogleCloudPubSubFlowControl {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubFlowControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubRetryPolicy) DeepCopyInto(out *GoogleCloudPubSubRetryPolicy) {
+	*out = *in
+	if in.MinimumBackoff != nil {
+		in, out := &in.MinimumBackoff, &out.MinimumBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaximumBackoff != nil {
+		in, out := &in.MaximumBackoff, &out.MaximumBackoff
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubRetryPolicy.
+func (in *GoogleCloudPubSubRetryPolicy) DeepCopy() *GoogleCloudPubSubRetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubRetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubSource) DeepCopyInto(out *GoogleCloudPubSubSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubSource.
+func (in *GoogleCloudPubSubSource) DeepCopy() *GoogleCloudPubSubSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudPubSubSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubSourceList) DeepCopyInto(out *GoogleCloudPubSubSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudPubSubSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubSourceList.
+func (in *GoogleCloudPubSubSourceList) DeepCopy() *GoogleCloudPubSubSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudPubSubSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubSourceSpec) DeepCopyInto(out *GoogleCloudPubSubSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.Topic = in.Topic
+	if in.SubscriptionID != nil {
+		in, out := &in.SubscriptionID, &out.SubscriptionID
+		*out = new(string)
+		**out = **in
+	}
+	if in.SubscriptionSettings != nil {
+		in, out := &in.SubscriptionSettings, &out.SubscriptionSettings
+		*out = new(GoogleCloudPubSubSubscriptionSettings)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FlowControl != nil {
+		in, out := &in.FlowControl, &out.FlowControl
+		*out = new(GoogleCloudPubSubFlowControl)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubSourceSpec.
+func (in *GoogleCloudPubSubSourceSpec) DeepCopy() *GoogleCloudPubSubSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubSourceStatus) DeepCopyInto(out *GoogleCloudPubSubSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubSourceStatus.
+func (in *GoogleCloudPubSubSourceStatus) DeepCopy() *GoogleCloudPubSubSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubSubscriptionSettings) DeepCopyInto(out *GoogleCloudPubSubSubscriptionSettings) {
+	*out = *in
+	if in.AckDeadline != nil {
+		in, out := &in.AckDeadline, &out.AckDeadline
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeadLetterPolicy != nil {
+		in, out := &in.DeadLetterPolicy, &out.DeadLetterPolicy
+		*out = new(GoogleCloudPubSubDeadLetterPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(GoogleCloudPubSubRetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubSubscriptionSettings.
+func (in *GoogleCloudPubSubSubscriptionSettings) DeepCopy() *GoogleCloudPubSubSubscriptionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubSubscriptionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudSourcePubSubSpec) DeepCopyInto(out *GoogleCloudSourcePubSubSpec) {
+	*out = *in
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	if in.Project != nil {
+		in, out := &in.Project, &out.Project
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudSourcePubSubSpec.
+func (in *GoogleCloudSourcePubSubSpec) DeepCopy() *GoogleCloudSourcePubSubSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudSourcePubSubSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudSourceRepositoriesSource) DeepCopyInto(out *GoogleCloudSourceRepositoriesSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudSourceRepositoriesSource.
+func (in *GoogleCloudSourceRepositoriesSource) DeepCopy() *GoogleCloudSourceRepositoriesSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudSourceRepositoriesSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudSourceRepositoriesSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudSourceRepositoriesSourceList) DeepCopyInto(out *GoogleCloudSourceRepositoriesSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudSourceRepositoriesSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudSourceRepositoriesSourceList.
+func (in *GoogleCloudSourceRepositoriesSourceList) DeepCopy() *GoogleCloudSourceRepositoriesSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudSourceRepositoriesSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudSourceRepositoriesSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudSourceRepositoriesSourceSpec) DeepCopyInto(out *GoogleCloudSourceRepositoriesSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.Repository = in.Repository
+	in.PubSub.DeepCopyInto(&out.PubSub)
+	if in.PublishServiceAccount != nil {
+		in, out := &in.PublishServiceAccount, &out.PublishServiceAccount
+		*out = new(string)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudSourceRepositoriesSourceSpec.
+func (in *GoogleCloudSourceRepositoriesSourceSpec) DeepCopy() *GoogleCloudSourceRepositoriesSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudSourceRepositoriesSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudSourceRepositoriesSourceStatus) DeepCopyInto(out *GoogleCloudSourceRepositoriesSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudSourceRepositoriesSourceStatus.
+func (in *GoogleCloudSourceRepositoriesSourceStatus) DeepCopy() *GoogleCloudSourceRepositoriesSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudSourceRepositoriesSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageSource) DeepCopyInto(out *GoogleCloudStorageSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageSource.
+func (in *GoogleCloudStorageSource) DeepCopy() *GoogleCloudStorageSource {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudStorageSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageSourceList) DeepCopyInto(out *GoogleCloudStorageSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudStorageSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageSourceList.
+func (in *GoogleCloudStorageSourceList) DeepCopy() *GoogleCloudStorageSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudStorageSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageSourceSpec) DeepCopyInto(out *GoogleCloudStorageSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.PubSub.DeepCopyInto(&out.PubSub)
+	if in.EventTypes != nil {
+		in, out := &in.EventTypes, &out.EventTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageSourceSpec.
+func (in *GoogleCloudStorageSourceSpec) DeepCopy() *GoogleCloudStorageSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageSourceStatus) DeepCopyInto(out *GoogleCloudStorageSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	if in.NotificationID != nil {
+		in, out := &in.NotificationID, &out.NotificationID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Topic != nil {
+		in, out := &in.Topic, &out.Topic
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	if in.Subscription != nil {
+		in, out := &in.Subscription, &out.Subscription
+		*out = new(GCloudResourceName)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageSourceStatus.
+func (in *GoogleCloudStorageSourceStatus) DeepCopy() *GoogleCloudStorageSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBasicAuth) DeepCopyInto(out *HTTPBasicAuth) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBasicAuth.
+func (in *HTTPBasicAuth) DeepCopy() *HTTPBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCredentials) DeepCopyInto(out *HTTPCredentials) {
+	*out = *in
+	if in.BasicAuths != nil {
+		in, out := &in.BasicAuths, &out.BasicAuths
+		*out = make([]HTTPBasicAuth, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCredentials.
+func (in *HTTPCredentials) DeepCopy() *HTTPCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPollerSource) DeepCopyInto(out *HTTPPollerSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPollerSource.
+func (in *HTTPPollerSource) DeepCopy() *HTTPPollerSource {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPollerSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPPollerSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPollerSourceList) DeepCopyInto(out *HTTPPollerSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTTPPollerSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPollerSourceList.
+func (in *HTTPPollerSourceList) DeepCopy() *HTTPPollerSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPollerSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPPollerSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPPollerSourceSpec) DeepCopyInto(out *HTTPPollerSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.EventSource != nil {
+		in, out := &in.EventSource, &out.EventSource
+		*out = new(string)
+		**out = **in
+	}
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuthUsername != nil {
+		in, out := &in.BasicAuthUsername, &out.BasicAuthUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuthPassword != nil {
+		in, out := &in.BasicAuthPassword, &out.BasicAuthPassword
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPPollerSourceSpec.
+func (in *HTTPPollerSourceSpec) DeepCopy() *HTTPPollerSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPPollerSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMMQSource) DeepCopyInto(out *IBMMQSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMMQSource.
+func (in *IBMMQSource) DeepCopy() *IBMMQSource {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMMQSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMMQSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMMQSourceList) DeepCopyInto(out *IBMMQSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMMQSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMMQSourceList.
+func (in *IBMMQSourceList) DeepCopy() *IBMMQSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMMQSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMMQSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMMQSourceSpec) DeepCopyInto(out *IBMMQSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	out.Delivery = in.Delivery
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMMQSourceSpec.
+func (in *IBMMQSourceSpec) DeepCopy() *IBMMQSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMMQSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSource) DeepCopyInto(out *KafkaSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSource.
+func (in *KafkaSource) DeepCopy() *KafkaSource {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceAuth) DeepCopyInto(out *KafkaSourceAuth) {
+	*out = *in
+	if in.Kerberos != nil {
+		in, out := &in.Kerberos, &out.Kerberos
+		*out = new(KafkaSourceKerberos)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(KafkaSourceTLSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSEnable != nil {
+		in, out := &in.TLSEnable, &out.TLSEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityMechanisms != nil {
+		in, out := &in.SecurityMechanisms, &out.SecurityMechanisms
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceAuth.
+func (in *KafkaSourceAuth) DeepCopy() *KafkaSourceAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceKerberos) DeepCopyInto(out *KafkaSourceKerberos) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Realm != nil {
+		in, out := &in.Realm, &out.Realm
+		*out = new(string)
+		**out = **in
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigPath != nil {
+		in, out := &in.ConfigPath, &out.ConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeytabPath != nil {
+		in, out := &in.KeytabPath, &out.KeytabPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Keytab != nil {
+		in, out := &in.Keytab, &out.Keytab
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceKerberos.
+func (in *KafkaSourceKerberos) DeepCopy() *KafkaSourceKerberos {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceKerberos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceList) DeepCopyInto(out *KafkaSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceList.
+func (in *KafkaSourceList) DeepCopy() *KafkaSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceSpec) DeepCopyInto(out *KafkaSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceSpec.
+func (in *KafkaSourceSpec) DeepCopy() *KafkaSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaSourceTLSAuth) DeepCopyInto(out *KafkaSourceTLSAuth) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCert != nil {
+		in, out := &in.ClientCert, &out.ClientCert
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKey != nil {
+		in, out := &in.ClientKey, &out.ClientKey
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaSourceTLSAuth.
+func (in *KafkaSourceTLSAuth) DeepCopy() *KafkaSourceTLSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaSourceTLSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Keystore) DeepCopyInto(out *Keystore) {
+	*out = *in
+	in.KeyDatabase.DeepCopyInto(&out.KeyDatabase)
+	in.PasswordStash.DeepCopyInto(&out.PasswordStash)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Keystore.
+func (in *Keystore) DeepCopy() *Keystore {
+	if in == nil {
+		return nil
+	}
+	out := new(Keystore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBSource) DeepCopyInto(out *MongoDBSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBSource.
+func (in *MongoDBSource) DeepCopy() *MongoDBSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBSourceList) DeepCopyInto(out *MongoDBSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MongoDBSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBSourceList.
+func (in *MongoDBSourceList) DeepCopy() *MongoDBSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBSourceSpec) DeepCopyInto(out *MongoDBSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBSourceSpec.
+func (in *MongoDBSourceSpec) DeepCopy() *MongoDBSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIMetrics) DeepCopyInto(out *OCIMetrics) {
+	*out = *in
+	if in.Compartment != nil {
+		in, out := &in.Compartment, &out.Compartment
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMetrics.
+func (in *OCIMetrics) DeepCopy() *OCIMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in OCIMetricsDecodedList) DeepCopyInto(out *OCIMetricsDecodedList) {
+	{
+		in := &in
+		*out = make(OCIMetricsDecodedList, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMetricsDecodedList.
+func (in OCIMetricsDecodedList) DeepCopy() OCIMetricsDecodedList {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIMetricsDecodedList)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIMetricsSource) DeepCopyInto(out *OCIMetricsSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMetricsSource.
+func (in *OCIMetricsSource) DeepCopy() *OCIMetricsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIMetricsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OCIMetricsSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIMetricsSourceList) DeepCopyInto(out *OCIMetricsSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OCIMetricsSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMetricsSourceList.
+func (in *OCIMetricsSourceList) DeepCopy() *OCIMetricsSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIMetricsSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OCIMetricsSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIMetricsSourceSpec) DeepCopyInto(out *OCIMetricsSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.OracleAPIPrivateKey.DeepCopyInto(&out.OracleAPIPrivateKey)
+	in.OracleAPIPrivateKeyPassphrase.DeepCopyInto(&out.OracleAPIPrivateKeyPassphrase)
+	in.OracleAPIPrivateKeyFingerprint.DeepCopyInto(&out.OracleAPIPrivateKeyFingerprint)
+	if in.PollingFrequency != nil {
+		in, out := &in.PollingFrequency, &out.PollingFrequency
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]OCIMetrics, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIMetricsSourceSpec.
+func (in *OCIMetricsSourceSpec) DeepCopy() *OCIMetricsSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIMetricsSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimiter) DeepCopyInto(out *RateLimiter) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimiter.
+func (in *RateLimiter) DeepCopy() *RateLimiter {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimiter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceAuth) DeepCopyInto(out *SalesforceAuth) {
+	*out = *in
+	in.CertKey.DeepCopyInto(&out.CertKey)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceAuth.
+func (in *SalesforceAuth) DeepCopy() *SalesforceAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceSource) DeepCopyInto(out *SalesforceSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceSource.
+func (in *SalesforceSource) DeepCopy() *SalesforceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SalesforceSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceSourceList) DeepCopyInto(out *SalesforceSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SalesforceSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceSourceList.
+func (in *SalesforceSourceList) DeepCopy() *SalesforceSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SalesforceSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceSourceSpec) DeepCopyInto(out *SalesforceSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(string)
+		**out = **in
+	}
+	in.Subscription.DeepCopyInto(&out.Subscription)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceSourceSpec.
+func (in *SalesforceSourceSpec) DeepCopy() *SalesforceSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceSubscription) DeepCopyInto(out *SalesforceSubscription) {
+	*out = *in
+	if in.ReplayID != nil {
+		in, out := &in.ReplayID, &out.ReplayID
+		*out = new(int)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceSubscription.
+func (in *SalesforceSubscription) DeepCopy() *SalesforceSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackSource) DeepCopyInto(out *SlackSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackSource.
+func (in *SlackSource) DeepCopy() *SlackSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SlackSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackSourceList) DeepCopyInto(out *SlackSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SlackSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackSourceList.
+func (in *SlackSourceList) DeepCopy() *SlackSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SlackSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackSourceSpec) DeepCopyInto(out *SlackSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.SigningSecret != nil {
+		in, out := &in.SigningSecret, &out.SigningSecret
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AppID != nil {
+		in, out := &in.AppID, &out.AppID
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackSourceSpec.
+func (in *SlackSourceSpec) DeepCopy() *SlackSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceSource) DeepCopyInto(out *SolaceSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceSource.
+func (in *SolaceSource) DeepCopy() *SolaceSource {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SolaceSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceSourceAuth) DeepCopyInto(out *SolaceSourceAuth) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(SolaceSourceTLSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASLEnable != nil {
+		in, out := &in.SASLEnable, &out.SASLEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSEnable != nil {
+		in, out := &in.TLSEnable, &out.TLSEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceSourceAuth.
+func (in *SolaceSourceAuth) DeepCopy() *SolaceSourceAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceSourceAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceSourceList) DeepCopyInto(out *SolaceSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SolaceSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceSourceList.
+func (in *SolaceSourceList) DeepCopy() *SolaceSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SolaceSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceSourceSpec) DeepCopyInto(out *SolaceSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(SolaceSourceAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceSourceSpec.
+func (in *SolaceSourceSpec) DeepCopy() *SolaceSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceSourceTLSAuth) DeepCopyInto(out *SolaceSourceTLSAuth) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCert != nil {
+		in, out := &in.ClientCert, &out.ClientCert
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKey != nil {
+		in, out := &in.ClientKey, &out.ClientKey
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceSourceTLSAuth.
+func (in *SolaceSourceTLSAuth) DeepCopy() *SolaceSourceTLSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceSourceTLSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
+	*out = *in
+	if in.CertLabel != nil {
+		in, out := &in.CertLabel, &out.CertLabel
+		*out = new(string)
+		**out = **in
+	}
+	in.KeyRepository.DeepCopyInto(&out.KeyRepository)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
+func (in *TLSSpec) DeepCopy() *TLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwilioSource) DeepCopyInto(out *TwilioSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioSource.
+func (in *TwilioSource) DeepCopy() *TwilioSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TwilioSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TwilioSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwilioSourceList) DeepCopyInto(out *TwilioSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TwilioSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioSourceList.
+func (in *TwilioSourceList) DeepCopy() *TwilioSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(TwilioSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TwilioSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwilioSourceSpec) DeepCopyInto(out *TwilioSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioSourceSpec.
+func (in *TwilioSourceSpec) DeepCopy() *TwilioSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TwilioSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookEventExtensionAttributes) DeepCopyInto(out *WebhookEventExtensionAttributes) {
+	*out = *in
+	if in.From != nil {
+		in, out := &in.From, &out.From
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookEventExtensionAttributes.
+func (in *WebhookEventExtensionAttributes) DeepCopy() *WebhookEventExtensionAttributes {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookEventExtensionAttributes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSource) DeepCopyInto(out *WebhookSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSource.
+func (in *WebhookSource) DeepCopy() *WebhookSource {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebhookSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSourceList) DeepCopyInto(out *WebhookSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]WebhookSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSourceList.
+func (in *WebhookSourceList) DeepCopy() *WebhookSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *WebhookSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WebhookSourceSpec) DeepCopyInto(out *WebhookSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	if in.EventSource != nil {
+		in, out := &in.EventSource, &out.EventSource
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventExtensionAttributes != nil {
+		in, out := &in.EventExtensionAttributes, &out.EventExtensionAttributes
+		*out = new(WebhookEventExtensionAttributes)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BasicAuthUsername != nil {
+		in, out := &in.BasicAuthUsername, &out.BasicAuthUsername
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuthPassword != nil {
+		in, out := &in.BasicAuthPassword, &out.BasicAuthPassword
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CORSAllowOrigin != nil {
+		in, out := &in.CORSAllowOrigin, &out.CORSAllowOrigin
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WebhookSourceSpec.
+func (in *WebhookSourceSpec) DeepCopy() *WebhookSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(WebhookSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskSource) DeepCopyInto(out *ZendeskSource) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskSource.
+func (in *ZendeskSource) DeepCopy() *ZendeskSource {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZendeskSource) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskSourceList) DeepCopyInto(out *ZendeskSourceList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZendeskSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskSourceList.
+func (in *ZendeskSourceList) DeepCopy() *ZendeskSourceList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskSourceList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZendeskSourceList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskSourceSpec) DeepCopyInto(out *ZendeskSourceSpec) {
+	*out = *in
+	in.SourceSpec.DeepCopyInto(&out.SourceSpec)
+	in.Token.DeepCopyInto(&out.Token)
+	in.WebhookPassword.DeepCopyInto(&out.WebhookPassword)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskSourceSpec.
+func (in *ZendeskSourceSpec) DeepCopy() *ZendeskSourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskSourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskSourceStatus) DeepCopyInto(out *ZendeskSourceStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskSourceStatus.
+func (in *ZendeskSourceStatus) DeepCopy() *ZendeskSourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskSourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
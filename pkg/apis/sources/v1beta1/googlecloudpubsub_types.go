@@ -0,0 +1,164 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudPubSubSource is the Schema for the event source.
+type GoogleCloudPubSubSource struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GoogleCloudPubSubSourceSpec   `json:"spec,omitempty"`
+	Status GoogleCloudPubSubSourceStatus `json:"status,omitempty"`
+}
+
+// GoogleCloudPubSubSourceSpec defines the desired state of the event source.
+type GoogleCloudPubSubSourceSpec struct {
+	duckv1.SourceSpec `json:",inline"`
+
+	// Full resource name of the Pub/Sub topic to subscribe to, in the
+	// format "projects/{project_name}/topics/{topic_name}".
+	Topic GCloudResourceName `json:"topic"`
+
+	// ID of the subscription to use to pull messages from the topic.
+	//
+	// If supplied, this subscription must 1) exist and 2) belong to the
+	// provided topic. Otherwise, a pull subscription to that topic is
+	// created on behalf of the user.
+	//
+	// +optional
+	SubscriptionID *string `json:"subscriptionID,omitempty"`
+
+	// Settings of the subscription created on behalf of the user. Cannot
+	// be combined with subscriptionID.
+	// +optional
+	SubscriptionSettings *GoogleCloudPubSubSubscriptionSettings `json:"subscriptionSettings,omitempty"`
+
+	// Flow control settings of the message receiver.
+	// +optional
+	FlowControl *GoogleCloudPubSubFlowControl `json:"flowControl,omitempty"`
+
+	// Different authentication methods available in sources on GCP.
+	Auth v1alpha1.GoogleCloudAuth `json:"auth"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// GoogleCloudPubSubSubscriptionSettings contains the delivery settings of a
+// Pub/Sub subscription.
+type GoogleCloudPubSubSubscriptionSettings struct {
+	// Amount of time Pub/Sub waits for the acknowledgement of a message
+	// before redelivering it. Must be between 10s and 600s. Defaults to 10s.
+	// +optional
+	AckDeadline *apis.Duration `json:"ackDeadline,omitempty"`
+
+	// Whether messages are guaranteed to be delivered exactly once, as
+	// long as they are acknowledged before their ack deadline expires.
+	// +optional
+	EnableExactlyOnceDelivery bool `json:"enableExactlyOnceDelivery,omitempty"`
+
+	// Whether messages published with the same ordering key are delivered
+	// in the order they were published. Cannot be changed once the
+	// subscription was created.
+	// +optional
+	EnableMessageOrdering bool `json:"enableMessageOrdering,omitempty"`
+
+	// Expression which filters messages based on their attributes. Cannot
+	// be changed once the subscription was created.
+	// https://cloud.google.com/pubsub/docs/subscription-message-filter
+	// +optional
+	Filter *string `json:"filter,omitempty"`
+
+	// Policy for forwarding undeliverable messages to a dead-letter topic.
+	// +optional
+	DeadLetterPolicy *GoogleCloudPubSubDeadLetterPolicy `json:"deadLetterPolicy,omitempty"`
+
+	// Policy for redelivering messages which were either negatively
+	// acknowledged or not acknowledged before their ack deadline expired.
+	// Messages are redelivered immediately when not set.
+	// +optional
+	RetryPolicy *GoogleCloudPubSubRetryPolicy `json:"retryPolicy,omitempty"`
+}
+
+// GoogleCloudPubSubDeadLetterPolicy contains the dead-lettering settings of a
+// Pub/Sub subscription.
+type GoogleCloudPubSubDeadLetterPolicy struct {
+	// Full resource name of the Pub/Sub topic to forward undeliverable
+	// messages to, in the format "projects/{project_name}/topics/{topic_name}".
+	DeadLetterTopic GCloudResourceName `json:"deadLetterTopic"`
+
+	// Maximum number of delivery attempts of a message before it is
+	// forwarded to the dead-letter topic. Must be between 5 and 100.
+	// Defaults to 5.
+	// +optional
+	MaxDeliveryAttempts *int32 `json:"maxDeliveryAttempts,omitempty"`
+}
+
+// GoogleCloudPubSubRetryPolicy contains the redelivery settings of a Pub/Sub
+// subscription. Delays between consecutive deliveries of a message grow
+// exponentially between the minimum and maximum backoff.
+type GoogleCloudPubSubRetryPolicy struct {
+	// Minimum delay between consecutive deliveries of a message. Must be
+	// between 0s and 600s. Defaults to 10s.
+	// +optional
+	MinimumBackoff *apis.Duration `json:"minimumBackoff,omitempty"`
+
+	// Maximum delay between consecutive deliveries of a message. Must be
+	// between 0s and 600s. Defaults to 600s.
+	// +optional
+	MaximumBackoff *apis.Duration `json:"maximumBackoff,omitempty"`
+}
+
+// GoogleCloudPubSubFlowControl contains settings which limit the amount of
+// messages pulled from Pub/Sub which haven't been acknowledged yet.
+type GoogleCloudPubSubFlowControl struct {
+	// Maximum number of unacknowledged messages. Defaults to 1000.
+	// +optional
+	MaxOutstandingMessages *int32 `json:"maxOutstandingMessages,omitempty"`
+
+	// Maximum size of unacknowledged messages, in bytes. Defaults to
+	// 1000000000.
+	// +optional
+	MaxOutstandingBytes *int64 `json:"maxOutstandingBytes,omitempty"`
+}
+
+// GoogleCloudPubSubSourceStatus defines the observed state of the event source.
+type GoogleCloudPubSubSourceStatus struct {
+	v1alpha1.Status `json:",inline"`
+	Subscription    *GCloudResourceName `json:"subscription,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudPubSubSourceList contains a list of event sources.
+type GoogleCloudPubSubSourceList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GoogleCloudPubSubSource `json:"items"`
+}
@@ -0,0 +1,409 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+// Objects are defaulted and validated by converting them to the hub version of
+// their kind, which implements the defaulting and validation logic.
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSCloudWatchSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSCloudWatchSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSCloudWatchSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSCloudWatchSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSCloudWatchLogsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSCloudWatchLogsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSCloudWatchLogsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSCloudWatchLogsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSCodeCommitSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSCodeCommitSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSCodeCommitSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSCodeCommitSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSCognitoIdentitySource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSCognitoIdentitySource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSCognitoIdentitySource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSCognitoIdentitySource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSCognitoUserPoolSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSCognitoUserPoolSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSCognitoUserPoolSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSCognitoUserPoolSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSDynamoDBSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSDynamoDBSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSDynamoDBSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSDynamoDBSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSEventBridgeSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSEventBridgeSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSEventBridgeSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSEventBridgeSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSKinesisSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSKinesisSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSKinesisSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSKinesisSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSS3Source) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSS3Source{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSS3Source) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSS3Source{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSSNSSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSSNSSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSSNSSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSSNSSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSSQSSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSSQSSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSSQSSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSSQSSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AWSPerformanceInsightsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AWSPerformanceInsightsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AWSPerformanceInsightsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AWSPerformanceInsightsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureActivityLogsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureActivityLogsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureActivityLogsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureActivityLogsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureBlobStorageSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureBlobStorageSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureBlobStorageSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureBlobStorageSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureEventGridSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureEventGridSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureEventGridSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureEventGridSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureEventHubsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureEventHubsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureEventHubsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureEventHubsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureIOTHubSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureIOTHubSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureIOTHubSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureIOTHubSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureQueueStorageSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureQueueStorageSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureQueueStorageSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureQueueStorageSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureServiceBusQueueSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureServiceBusQueueSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureServiceBusQueueSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureServiceBusQueueSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureServiceBusSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureServiceBusSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureServiceBusSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureServiceBusSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *AzureServiceBusTopicSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.AzureServiceBusTopicSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *AzureServiceBusTopicSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.AzureServiceBusTopicSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *CloudEventsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.CloudEventsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *CloudEventsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.CloudEventsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *MongoDBSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.MongoDBSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *MongoDBSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.MongoDBSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *KafkaSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.KafkaSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *KafkaSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.KafkaSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *GoogleCloudAuditLogsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudAuditLogsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *GoogleCloudAuditLogsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudAuditLogsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *GoogleCloudBillingSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudBillingSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *GoogleCloudBillingSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudBillingSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *GoogleCloudPubSubSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudPubSubSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *GoogleCloudPubSubSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudPubSubSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *GoogleCloudSourceRepositoriesSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *GoogleCloudSourceRepositoriesSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *GoogleCloudStorageSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudStorageSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *GoogleCloudStorageSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.GoogleCloudStorageSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *HTTPPollerSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.HTTPPollerSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *HTTPPollerSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.HTTPPollerSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *IBMMQSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.IBMMQSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *IBMMQSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.IBMMQSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *OCIMetricsSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.OCIMetricsSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *OCIMetricsSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.OCIMetricsSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *SalesforceSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.SalesforceSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *SalesforceSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.SalesforceSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *SlackSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.SlackSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *SlackSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.SlackSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *SolaceSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.SolaceSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *SolaceSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.SolaceSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *TwilioSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.TwilioSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *TwilioSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.TwilioSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *WebhookSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.WebhookSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *WebhookSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.WebhookSource{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (s *ZendeskSource) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, s, &sourcesv1alpha1.ZendeskSource{})
+}
+
+// Validate implements apis.Validatable.
+func (s *ZendeskSource) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, s, &sourcesv1alpha1.ZendeskSource{})
+}
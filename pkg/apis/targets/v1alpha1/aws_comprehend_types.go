@@ -48,6 +48,10 @@ type AWSComprehendTargetSpec struct {
 	// AWS-specific authentication methods.
 	Auth v1alpha1.AWSAuth `json:"auth"`
 
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Region to use for calling into Comprehend API.
 	Region string `json:"region"`
 
@@ -28,10 +28,28 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+// Accepted event types
+const (
+	// EventTypeAWSDynamoDBPutItem represents a task to create or replace an item.
+	EventTypeAWSDynamoDBPutItem = "io.triggermesh.awsdynamodb.item.put"
+	// EventTypeAWSDynamoDBUpdateItem represents a task to update the attributes of an item.
+	EventTypeAWSDynamoDBUpdateItem = "io.triggermesh.awsdynamodb.item.update"
+	// EventTypeAWSDynamoDBDeleteItem represents a task to delete an item.
+	EventTypeAWSDynamoDBDeleteItem = "io.triggermesh.awsdynamodb.item.delete"
+	// EventTypeAWSDynamoDBTransactWrite represents a task to write multiple items atomically.
+	EventTypeAWSDynamoDBTransactWrite = "io.triggermesh.awsdynamodb.items.transactwrite"
+	// EventTypeAWSDynamoDBBatchWrite represents a task to put or delete multiple items.
+	EventTypeAWSDynamoDBBatchWrite = "io.triggermesh.awsdynamodb.items.batchwrite"
+)
+
 // Returned event types
 const (
-	// EventTypeAWSDynamoDBResult contains the result of the processing of an S3 event.
+	// EventTypeAWSDynamoDBResult contains the output of the PutItem operation
+	// performed for an event of any type not listed above.
 	EventTypeAWSDynamoDBResult = "io.triggermesh.targets.aws.dynamodb.result"
+	// EventTypeAWSDynamoDBOperationResult contains the result of the operation
+	// performed for an event of any of the types listed above.
+	EventTypeAWSDynamoDBOperationResult = "io.triggermesh.awsdynamodb.result"
 )
 
 // GetGroupVersionKind implements kmeta.OwnerRefable.
@@ -57,10 +75,23 @@ func (t *AWSDynamoDBTarget) GetStatusManager() *v1alpha1.StatusManager {
 	}
 }
 
+// AcceptedEventTypes implements IntegrationTarget.
+func (*AWSDynamoDBTarget) AcceptedEventTypes() []string {
+	return []string{
+		EventTypeAWSDynamoDBPutItem,
+		EventTypeAWSDynamoDBUpdateItem,
+		EventTypeAWSDynamoDBDeleteItem,
+		EventTypeAWSDynamoDBTransactWrite,
+		EventTypeAWSDynamoDBBatchWrite,
+		EventTypeWildcard,
+	}
+}
+
 // GetEventTypes implements EventSource.
 func (*AWSDynamoDBTarget) GetEventTypes() []string {
 	return []string{
 		EventTypeAWSDynamoDBResult,
+		EventTypeAWSDynamoDBOperationResult,
 	}
 }
 
@@ -94,5 +125,44 @@ func (t *AWSDynamoDBTarget) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+	return t.Spec.Auth.Validate(ctx).Also(validateDynamoDBKey(t.Spec.Key).ViaField("spec", "key"))
+}
+
+// validateDynamoDBKey validates the attributes of a table's primary key.
+func validateDynamoDBKey(key []AWSDynamoDBKeyAttribute) *apis.FieldError {
+	// a DynamoDB primary key is composed of a partition key and an
+	// optional sort key
+	const maxKeyAttributes = 2
+
+	var errs *apis.FieldError
+
+	if len(key) > maxKeyAttributes {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(len(key), 1, maxKeyAttributes, apis.CurrentField))
+	}
+
+	names := make(map[string]struct{}, len(key))
+
+	for i, attr := range key {
+		if attr.Name == "" {
+			errs = errs.Also(apis.ErrMissingField("name").ViaIndex(i))
+		} else if _, dup := names[attr.Name]; dup {
+			errs = errs.Also(apis.ErrInvalidValue(attr.Name, "name").ViaIndex(i))
+		}
+		names[attr.Name] = struct{}{}
+
+		if typ := attr.Type; typ != nil &&
+			*typ != AWSDynamoDBKeyAttributeTypeString && *typ != AWSDynamoDBKeyAttributeTypeNumber {
+
+			errs = errs.Also(apis.ErrInvalidValue(*typ, "type").ViaIndex(i))
+		}
+
+		switch {
+		case attr.Attribute != nil && attr.DataPath != nil:
+			errs = errs.Also(apis.ErrMultipleOneOf("attribute", "dataPath").ViaIndex(i))
+		case attr.Attribute == nil && attr.DataPath == nil:
+			errs = errs.Also(apis.ErrMissingOneOf("attribute", "dataPath").ViaIndex(i))
+		}
+	}
+
+	return errs
 }
@@ -39,6 +39,7 @@ type AWSDynamoDBTarget struct {
 var (
 	_ v1alpha1.Reconcilable           = (*AWSDynamoDBTarget)(nil)
 	_ v1alpha1.AdapterConfigurable    = (*AWSDynamoDBTarget)(nil)
+	_ v1alpha1.EventReceiver          = (*AWSDynamoDBTarget)(nil)
 	_ v1alpha1.EventSource            = (*AWSDynamoDBTarget)(nil)
 	_ v1alpha1.ServiceAccountProvider = (*AWSDynamoDBTarget)(nil)
 )
@@ -48,15 +49,54 @@ type AWSDynamoDBTargetSpec struct {
 	// AWS-specific authentication methods.
 	Auth v1alpha1.AWSAuth `json:"auth"`
 
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Table ARN
 	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazondynamodb.html#amazondynamodb-resources-for-iam-policies
 	ARN string `json:"arn"`
 
+	// Attributes of the primary key of the table, and the location of their
+	// values inside events. Used to address the item targeted by update and
+	// delete operations when events don't specify a key, and to complete
+	// the items written by put operations.
+	// +optional
+	Key []AWSDynamoDBKeyAttribute `json:"key,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSDynamoDBKeyAttribute is an attribute of the primary key of a DynamoDB
+// table, along with the source of its value.
+type AWSDynamoDBKeyAttribute struct {
+	// Name of the attribute in the table.
+	Name string `json:"name"`
+	// Scalar type of the attribute. Defaults to "S".
+	// +optional
+	Type *AWSDynamoDBKeyAttributeType `json:"type,omitempty"`
+
+	// Required: exactly one of the following must be specified.
+
+	// Name of a CloudEvents context attribute or extension.
+	// +optional
+	Attribute *string `json:"attribute,omitempty"`
+	// Path of a field inside the JSON data of events, in GJSON syntax.
+	// +optional
+	DataPath *string `json:"dataPath,omitempty"`
+}
+
+// AWSDynamoDBKeyAttributeType is the scalar type of a key attribute.
+type AWSDynamoDBKeyAttributeType string
+
+// Supported types of key attributes.
+const (
+	AWSDynamoDBKeyAttributeTypeString AWSDynamoDBKeyAttributeType = "S"
+	AWSDynamoDBKeyAttributeTypeNumber AWSDynamoDBKeyAttributeType = "N"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSDynamoDBTargetList is a list of event target instances.
@@ -101,5 +101,26 @@ func (t *AWSEventBridgeTarget) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+	return t.Spec.Auth.Validate(ctx).Also(t.Spec.validate().ViaField("spec"))
+}
+
+// EventBridge limits
+// https://docs.aws.amazon.com/eventbridge/latest/APIReference/API_PutEvents.html
+const awsEventBridgeMaxEntriesPerRequest = 10
+
+// validate validates the batching parameters of the spec.
+func (s *AWSEventBridgeTargetSpec) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if b := s.Batching; b != nil {
+		if b.MaxEntries != nil && (*b.MaxEntries < 1 || *b.MaxEntries > awsEventBridgeMaxEntriesPerRequest) {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxEntries, 1, awsEventBridgeMaxEntriesPerRequest,
+				"batching.maxEntries"))
+		}
+		if b.MaxDelay != nil && *b.MaxDelay <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(b.MaxDelay.String(), "batching.maxDelay"))
+		}
+	}
+
+	return errs
 }
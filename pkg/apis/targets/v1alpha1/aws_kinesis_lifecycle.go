@@ -76,5 +76,33 @@ func (t *AWSKinesisTarget) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+	return t.Spec.Auth.Validate(ctx).Also(t.Spec.validate(ctx).ViaField("spec"))
+}
+
+// Kinesis limits
+// https://docs.aws.amazon.com/kinesis/latest/APIReference/API_PutRecords.html
+const awsKinesisMaxRecordsPerRequest = 500
+
+// validate validates the partitioning and batching parameters of the spec.
+func (s *AWSKinesisTargetSpec) validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.PartitionKey != nil {
+		errs = errs.Also(s.PartitionKey.Validate(ctx).ViaField("partitionKey"))
+	}
+	if s.ExplicitHashKey != nil {
+		errs = errs.Also(s.ExplicitHashKey.Validate(ctx).ViaField("explicitHashKey"))
+	}
+
+	if b := s.Batching; b != nil {
+		if b.MaxRecords != nil && (*b.MaxRecords < 1 || *b.MaxRecords > awsKinesisMaxRecordsPerRequest) {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxRecords, 1, awsKinesisMaxRecordsPerRequest,
+				"batching.maxRecords"))
+		}
+		if b.MaxDelay != nil && *b.MaxDelay <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(b.MaxDelay.String(), "batching.maxDelay"))
+		}
+	}
+
+	return errs
 }
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -47,12 +48,35 @@ type AWSKinesisTargetSpec struct {
 	// AWS-specific authentication methods.
 	Auth v1alpha1.AWSAuth `json:"auth"`
 
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Amazon Resource Name of the Kinesis stream.
 	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonkinesis.html#amazonkinesis-resources-for-iam-policies
 	ARN string `json:"arn"`
 
-	// Kinesis Partition to publish the events to
-	Partition string `json:"partition"`
+	// Partition key of the records written to Kinesis. Used for every event
+	// when partitionKey isn't set, and for events which don't contain any
+	// value for the configured partitionKey otherwise.
+	// +optional
+	Partition string `json:"partition,omitempty"`
+
+	// Location of the partition key of records inside events. The partition
+	// key determines the shard a record is written to.
+	// +optional
+	PartitionKey *v1alpha1.EventKeySource `json:"partitionKey,omitempty"`
+
+	// Location of the explicit hash key of records inside events. When set,
+	// the explicit hash key determines the shard a record is written to,
+	// instead of the hash of its partition key.
+	// +optional
+	ExplicitHashKey *v1alpha1.EventKeySource `json:"explicitHashKey,omitempty"`
+
+	// Buffering of records in PutRecords requests. Records are written one
+	// at a time when not set.
+	// +optional
+	Batching *AWSKinesisBatching `json:"batching,omitempty"`
 
 	// Whether to omit CloudEvent context attributes in records created in Kinesis.
 	// When this property is false (default), the entire CloudEvent payload is included.
@@ -64,6 +88,19 @@ type AWSKinesisTargetSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSKinesisBatching contains parameters used to group records in batches.
+type AWSKinesisBatching struct {
+	// Maximum number of records in a PutRecords request. Defaults to 500,
+	// which is also the upper limit imposed by Kinesis.
+	// +optional
+	MaxRecords *int32 `json:"maxRecords,omitempty"`
+
+	// Maximum amount of time a record waits for its batch to be sent.
+	// Defaults to 1s.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSKinesisTargetList is a list of event target instances.
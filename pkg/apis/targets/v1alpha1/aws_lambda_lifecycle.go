@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -28,6 +30,14 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+// Returned event types
+const (
+	// EventTypeAWSLambdaResult contains the response of a Lambda function.
+	EventTypeAWSLambdaResult = "io.triggermesh.targets.aws.lambda.result"
+	// EventTypeAWSLambdaError contains the error returned by a Lambda function.
+	EventTypeAWSLambdaError = "io.triggermesh.targets.aws.lambda.error"
+)
+
 // GetGroupVersionKind implements kmeta.OwnerRefable.
 func (*AWSLambdaTarget) GetGroupVersionKind() schema.GroupVersionKind {
 	return SchemeGroupVersion.WithKind("AWSLambdaTarget")
@@ -76,5 +86,40 @@ func (t *AWSLambdaTarget) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+	return t.Spec.Auth.Validate(ctx).Also(t.Spec.validate().ViaField("spec"))
+}
+
+// Lambda limits
+// https://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html
+const awsLambdaMaxClientContextBytes = 3583
+
+// validate validates the invocation parameters of the spec.
+func (s *AWSLambdaTargetSpec) validate() *apis.FieldError {
+	var errs *apis.FieldError
+
+	if it := s.InvocationType; it != nil {
+		switch *it {
+		case AWSLambdaInvocationTypeRequestResponse,
+			AWSLambdaInvocationTypeEvent,
+			AWSLambdaInvocationTypeDryRun:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*it, "invocationType"))
+		}
+	}
+
+	if s.Qualifier != nil && *s.Qualifier == "" {
+		errs = errs.Also(apis.ErrInvalidValue(*s.Qualifier, "qualifier"))
+	}
+
+	if cc := s.ClientContext; cc != nil {
+		// the client context is sent base64-encoded to Lambda
+		switch {
+		case !json.Valid([]byte(*cc)):
+			errs = errs.Also(apis.ErrInvalidValue("not a valid JSON document", "clientContext"))
+		case base64.StdEncoding.EncodedLen(len(*cc)) > awsLambdaMaxClientContextBytes:
+			errs = errs.Also(apis.ErrInvalidValue("exceeds the maximum size accepted by Lambda", "clientContext"))
+		}
+	}
+
+	return errs
 }
@@ -47,6 +47,10 @@ type AWSLambdaTargetSpec struct {
 	// AWS-specific authentication methods.
 	Auth v1alpha1.AWSAuth `json:"auth"`
 
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Amazon Resource Name of the Lambda function.
 	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_awslambda.html#awslambda-resources-for-iam-policies
 	ARN string `json:"arn"`
@@ -56,11 +60,43 @@ type AWSLambdaTargetSpec struct {
 	// When this property is true, only the CloudEvent data is included.
 	DiscardCEContext bool `json:"discardCloudEventContext"`
 
+	// Type of invocation of the function. Defaults to RequestResponse.
+	// https://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html#API_Invoke_RequestSyntax
+	// +optional
+	InvocationType *AWSLambdaInvocationType `json:"invocationType,omitempty"`
+
+	// Version or alias of the function to invoke.
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// JSON document passed to the function as client context, which is
+	// available in the context object of the function.
+	// +optional
+	ClientContext *string `json:"clientContext,omitempty"`
+
+	// Whether to attach the last 4 KB of the execution log of the function
+	// to replies. Only applies to RequestResponse invocations.
+	// +optional
+	LogTail bool `json:"logTail,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSLambdaInvocationType is the type of invocation of a Lambda function.
+type AWSLambdaInvocationType string
+
+// Supported invocation types.
+const (
+	// Invoke the function synchronously and reply with its response.
+	AWSLambdaInvocationTypeRequestResponse AWSLambdaInvocationType = "RequestResponse"
+	// Queue the event for asynchronous invocation of the function.
+	AWSLambdaInvocationTypeEvent AWSLambdaInvocationType = "Event"
+	// Validate parameter values and permissions without invoking the function.
+	AWSLambdaInvocationTypeDryRun AWSLambdaInvocationType = "DryRun"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSLambdaTargetList is a list of event target instances.
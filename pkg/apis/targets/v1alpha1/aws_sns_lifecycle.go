@@ -18,6 +18,9 @@ package v1alpha1
 
 import (
 	"context"
+	"regexp"
+	"sort"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -76,5 +79,121 @@ func (t *AWSSNSTarget) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+	return t.Spec.Auth.Validate(ctx).
+		Also(t.Spec.validate(ctx).ViaField("spec"))
+}
+
+// SNS limits
+// https://docs.aws.amazon.com/sns/latest/dg/sns-message-attributes.html
+const (
+	awsSNSMaxMessageAttributes = 10
+	awsSNSMaxSenderIDLength    = 11
+	awsSNSFIFOTopicNameSuffix  = ".fifo"
+)
+
+// Protocols which accept a protocol-specific message.
+// https://docs.aws.amazon.com/sns/latest/api/API_Publish.html
+var awsSNSMessageProtocols = map[string]struct{}{
+	"application": {},
+	"email":       {},
+	"email-json":  {},
+	"firehose":    {},
+	"http":        {},
+	"https":       {},
+	"lambda":      {},
+	"sms":         {},
+	"sqs":         {},
+}
+
+// Alphanumeric sender ID of SMS messages, with at least one letter.
+// https://docs.aws.amazon.com/sns/latest/dg/channels-sms-awssupported-countries.html
+var awsSNSSenderIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9]*[a-zA-Z][a-zA-Z0-9]*$`)
+
+// validate validates the message parameters of the spec.
+func (s *AWSSNSTargetSpec) validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.MessageGroupIDFrom != nil {
+		errs = errs.Also(s.MessageGroupIDFrom.Validate(ctx).ViaField("messageGroupIdFrom"))
+	}
+	if s.DeduplicationIDFrom != nil {
+		errs = errs.Also(s.DeduplicationIDFrom.Validate(ctx).ViaField("deduplicationIdFrom"))
+	}
+	if s.SubjectFrom != nil {
+		errs = errs.Also(s.SubjectFrom.Validate(ctx).ViaField("subjectFrom"))
+	}
+
+	// FIFO topics require a message group ID for every message
+	if strings.HasSuffix(s.ARN, awsSNSFIFOTopicNameSuffix) && s.SMS == nil &&
+		s.MessageGroupID == "" && s.MessageGroupIDFrom == nil {
+
+		errs = errs.Also(apis.ErrMissingOneOf("messageGroupId", "messageGroupIdFrom"))
+	}
+
+	if len(s.MessageAttributes) > awsSNSMaxMessageAttributes {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(len(s.MessageAttributes), 0, awsSNSMaxMessageAttributes,
+			"messageAttributes"))
+	}
+	attrs := make(map[string]struct{}, len(s.MessageAttributes))
+	for i, a := range s.MessageAttributes {
+		if _, dup := attrs[a]; dup || a == "" || isReservedAWSSNSAttribute(a) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(a, "messageAttributes", i))
+		}
+		attrs[a] = struct{}{}
+	}
+
+	// sorted for a deterministic order of errors
+	protocols := make([]string, 0, len(s.ProtocolMessages))
+	for p := range s.ProtocolMessages {
+		protocols = append(protocols, p)
+	}
+	sort.Strings(protocols)
+
+	for _, p := range protocols {
+		if _, ok := awsSNSMessageProtocols[p]; !ok {
+			errs = errs.Also(apis.ErrInvalidKeyName(p, "protocolMessages"))
+			continue
+		}
+		k := s.ProtocolMessages[p]
+		errs = errs.Also(k.Validate(ctx).ViaFieldKey("protocolMessages", p))
+	}
+
+	if s.SMS != nil {
+		errs = errs.Also(s.SMS.validate(ctx).ViaField("sms"))
+
+		// the text of SMS messages is either read from events or
+		// consists of their data, never of entire CloudEvents
+		if s.SMS.MessageFrom == nil && !s.DiscardCEContext {
+			errs = errs.Also(apis.ErrMissingOneOf("sms.messageFrom", "discardCloudEventContext"))
+		}
+	}
+
+	return errs
+}
+
+// isReservedAWSSNSAttribute returns whether the given attribute name uses a
+// prefix reserved by AWS.
+func isReservedAWSSNSAttribute(name string) bool {
+	n := strings.ToLower(name)
+	return strings.HasPrefix(n, "aws.") || strings.HasPrefix(n, "amazon.")
+}
+
+// validate validates the SMS options.
+func (o *AWSSNSSMSOptions) validate(ctx context.Context) *apis.FieldError {
+	errs := o.PhoneNumberFrom.Validate(ctx).ViaField("phoneNumberFrom")
+
+	if o.MessageFrom != nil {
+		errs = errs.Also(o.MessageFrom.Validate(ctx).ViaField("messageFrom"))
+	}
+
+	if id := o.SenderID; id != nil && (len(*id) > awsSNSMaxSenderIDLength || !awsSNSSenderIDRegexp.MatchString(*id)) {
+		errs = errs.Also(apis.ErrInvalidValue(*id, "senderId",
+			"must contain between 1 and 11 alphanumeric characters, including at least one letter"))
+	}
+
+	if t := o.Type; t != nil && *t != AWSSNSSMSTypePromotional && *t != AWSSNSSMSTypeTransactional {
+		errs = errs.Also(apis.ErrInvalidValue(*t, "type"))
+	}
+
+	return errs
 }
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestAWSSNSTargetSpecValidateSMS(t *testing.T) {
+	phoneNumberFrom := v1alpha1.EventKeySource{DataPath: ptr.String("customer.phone")}
+
+	testCases := map[string]struct {
+		spec AWSSNSTargetSpec
+
+		expectErr string
+	}{
+		"Text from event": {
+			spec: AWSSNSTargetSpec{
+				SMS: &AWSSNSSMSOptions{
+					PhoneNumberFrom: phoneNumberFrom,
+					MessageFrom:     &v1alpha1.EventKeySource{DataPath: ptr.String("text")},
+				},
+			},
+		},
+		"Data of events as text": {
+			spec: AWSSNSTargetSpec{
+				SMS: &AWSSNSSMSOptions{
+					PhoneNumberFrom: phoneNumberFrom,
+				},
+				DiscardCEContext: true,
+			},
+		},
+		"Entire CloudEvents as text": {
+			spec: AWSSNSTargetSpec{
+				SMS: &AWSSNSSMSOptions{
+					PhoneNumberFrom: phoneNumberFrom,
+				},
+			},
+			expectErr: "expected exactly one, got neither: discardCloudEventContext, sms.messageFrom",
+		},
+		"Invalid text source": {
+			spec: AWSSNSTargetSpec{
+				SMS: &AWSSNSSMSOptions{
+					PhoneNumberFrom: phoneNumberFrom,
+					MessageFrom:     &v1alpha1.EventKeySource{},
+				},
+			},
+			expectErr: "expected exactly one, got neither: sms.messageFrom.attribute, sms.messageFrom.dataPath",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			errs := tc.spec.validate(context.Background())
+
+			if tc.expectErr == "" {
+				assert.Nil(t, errs)
+				return
+			}
+			assert.EqualError(t, errs, tc.expectErr)
+		})
+	}
+}
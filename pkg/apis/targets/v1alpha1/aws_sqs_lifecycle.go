@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -76,5 +77,69 @@ func (t *AWSSQSTarget) Validate(ctx context.Context) *apis.FieldError {
 	if t.DeletionTimestamp != nil {
 		return nil
 	}
-	return t.Spec.Auth.Validate(ctx)
+	return t.Spec.Auth.Validate(ctx).
+		Also(t.Spec.validate(ctx).ViaField("spec")).
+		Also(v1alpha1.Verify(ctx, t))
+}
+
+// SQS limits
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SendMessageBatch.html
+const (
+	awsSQSMaxMessagesPerRequest = 10
+	awsSQSMaxMessageAttributes  = 10
+	awsSQSMaxDelaySeconds       = 900
+	awsSQSFIFOQueueNameSuffix   = ".fifo"
+)
+
+// validate validates the message parameters of the spec.
+func (s *AWSSQSTargetSpec) validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.MessageGroupIDFrom != nil {
+		errs = errs.Also(s.MessageGroupIDFrom.Validate(ctx).ViaField("messageGroupIdFrom"))
+	}
+	if s.DeduplicationIDFrom != nil {
+		errs = errs.Also(s.DeduplicationIDFrom.Validate(ctx).ViaField("deduplicationIdFrom"))
+	}
+	if s.DelaySecondsFrom != nil {
+		errs = errs.Also(s.DelaySecondsFrom.Validate(ctx).ViaField("delaySecondsFrom"))
+	}
+
+	if s.DelaySeconds != nil && (*s.DelaySeconds < 0 || *s.DelaySeconds > awsSQSMaxDelaySeconds) {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.DelaySeconds, 0, awsSQSMaxDelaySeconds, "delaySeconds"))
+	}
+
+	// FIFO queues only support delays at the queue level
+	if strings.HasSuffix(s.ARN, awsSQSFIFOQueueNameSuffix) {
+		if s.DelaySeconds != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("delaySeconds"))
+		}
+		if s.DelaySecondsFrom != nil {
+			errs = errs.Also(apis.ErrDisallowedFields("delaySecondsFrom"))
+		}
+	}
+
+	if len(s.MessageAttributes) > awsSQSMaxMessageAttributes {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(len(s.MessageAttributes), 0, awsSQSMaxMessageAttributes,
+			"messageAttributes"))
+	}
+	attrs := make(map[string]struct{}, len(s.MessageAttributes))
+	for i, a := range s.MessageAttributes {
+		if _, dup := attrs[a]; dup || a == "" {
+			errs = errs.Also(apis.ErrInvalidArrayValue(a, "messageAttributes", i))
+		}
+		attrs[a] = struct{}{}
+	}
+
+	if b := s.Batching; b != nil {
+		if b.MaxMessages != nil && (*b.MaxMessages < 1 || *b.MaxMessages > awsSQSMaxMessagesPerRequest) {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxMessages, 1, awsSQSMaxMessagesPerRequest,
+				"batching.maxMessages"))
+		}
+		if b.MaxDelay != nil && *b.MaxDelay <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(b.MaxDelay.String(), "batching.maxDelay"))
+		}
+	}
+
+	return errs
 }
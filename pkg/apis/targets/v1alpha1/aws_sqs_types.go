@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -47,6 +48,10 @@ type AWSSQSTargetSpec struct {
 	// AWS-specific authentication methods.
 	Auth v1alpha1.AWSAuth `json:"auth"`
 
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
 	// Amazon Resource Name of the SQS queue.
 	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonsqs.html#amazonsqs-resources-for-iam-policies
 	ARN string `json:"arn"`
@@ -56,6 +61,42 @@ type AWSSQSTargetSpec struct {
 	// +optional
 	MessageGroupID string `json:"messageGroupId,omitempty"`
 
+	// Location of the message group ID of messages inside events. Only
+	// applies to FIFO queues. Events which don't contain any value for the
+	// message group ID fall back to messageGroupId.
+	// +optional
+	MessageGroupIDFrom *v1alpha1.EventKeySource `json:"messageGroupIdFrom,omitempty"`
+
+	// Location of the deduplication ID of messages inside events. Only
+	// applies to FIFO queues. Defaults to the combination of the ID and
+	// source of events.
+	// +optional
+	DeduplicationIDFrom *v1alpha1.EventKeySource `json:"deduplicationIdFrom,omitempty"`
+
+	// Names of CloudEvents context attributes and extensions to set as
+	// attributes of messages, which allows consumers to filter messages
+	// without parsing their body. SQS accepts at most 10 attributes per
+	// message.
+	// +optional
+	MessageAttributes []string `json:"messageAttributes,omitempty"`
+
+	// Number of seconds messages are delayed before becoming available to
+	// consumers, between 0 and 900. Overrides the delay of the queue. Not
+	// supported by FIFO queues.
+	// +optional
+	DelaySeconds *int32 `json:"delaySeconds,omitempty"`
+
+	// Location of the delay of messages, in seconds, inside events. Events
+	// which don't contain any value for the delay fall back to delaySeconds.
+	// Not supported by FIFO queues.
+	// +optional
+	DelaySecondsFrom *v1alpha1.EventKeySource `json:"delaySecondsFrom,omitempty"`
+
+	// Buffering of messages in SendMessageBatch requests. Messages are sent
+	// one at a time when not set.
+	// +optional
+	Batching *AWSSQSBatching `json:"batching,omitempty"`
+
 	// Whether to omit CloudEvent context attributes in messages sent to SQS.
 	// When this property is false (default), the entire CloudEvent payload is included.
 	// When this property is true, only the CloudEvent data is included.
@@ -66,6 +107,19 @@ type AWSSQSTargetSpec struct {
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// AWSSQSBatching contains parameters used to group messages in batches.
+type AWSSQSBatching struct {
+	// Maximum number of messages in a SendMessageBatch request. Defaults to
+	// 10, which is also the upper limit imposed by SQS.
+	// +optional
+	MaxMessages *int32 `json:"maxMessages,omitempty"`
+
+	// Maximum amount of time a message waits for its batch to be sent.
+	// Defaults to 1s.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // AWSSQSTargetList is a list of event target instances.
@@ -58,8 +58,3 @@ func (t *CloudEventsTarget) GetAdapterOverrides() *v1alpha1.AdapterOverrides {
 // SetDefaults implements apis.Defaultable
 func (t *CloudEventsTarget) SetDefaults(ctx context.Context) {
 }
-
-// Validate implements apis.Validatable
-func (t *CloudEventsTarget) Validate(ctx context.Context) *apis.FieldError {
-	return nil
-}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	"knative.dev/pkg/apis"
+)
+
+// Validate implements apis.Validatable
+func (t *CloudEventsTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate CloudEventsTarget spec
+func (s *CloudEventsTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.Mode != nil {
+		switch *s.Mode {
+		case CloudEventsModeBinary, CloudEventsModeStructured, CloudEventsModeBatch:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*s.Mode, "mode"))
+		}
+	}
+
+	if s.Batch != nil && s.Batch.MaxSize != nil && *s.Batch.MaxSize < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.Batch.MaxSize, 1, "+Inf", "batch.maxSize"))
+	}
+
+	if s.Retry != nil {
+		errs = errs.Also(s.Retry.Validate(ctx).ViaField("retry"))
+	}
+
+	if s.CircuitBreaker != nil && s.CircuitBreaker.FailureThreshold < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(s.CircuitBreaker.FailureThreshold, 1, "+Inf",
+			"circuitBreaker.failureThreshold"))
+	}
+
+	if s.Credentials != nil {
+		errs = errs.Also(s.Credentials.Validate(ctx).ViaField("credentials"))
+	}
+
+	return errs
+}
+
+// Validate the retry policy.
+func (r *CloudEventsRetry) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if r.Count < 0 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(r.Count, 0, "+Inf", "count"))
+	}
+
+	if r.BackoffPolicy != nil {
+		switch *r.BackoffPolicy {
+		case BackoffPolicyLinear, BackoffPolicyExponential:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*r.BackoffPolicy, "backoffPolicy"))
+		}
+	}
+
+	for i, c := range r.RetryableStatusCodes {
+		if c < 100 || c > 599 {
+			errs = errs.Also(apis.ErrInvalidArrayValue(fmt.Sprint(c), "retryableStatusCodes", i))
+		}
+	}
+
+	return errs
+}
+
+// Validate the credentials. Only one authentication scheme can be set in the
+// Authorization header, TLS client authentication can be combined with any of them.
+// Secret values are mounted into the adapter as files, therefore they must be
+// referenced from Kubernetes Secrets.
+func (c *CloudEventsCredentials) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+	var set []string
+
+	if c.BasicAuth.Username != "" {
+		set = append(set, "basicAuth")
+		errs = errs.Also(c.BasicAuth.Password.ValidateSecretRef().ViaField("basicAuth", "password"))
+	}
+	if c.BearerToken != nil {
+		set = append(set, "bearerToken")
+		errs = errs.Also(c.BearerToken.ValidateSecretRef().ViaField("bearerToken"))
+	}
+	if c.OAuth2 != nil {
+		set = append(set, "oauth2")
+		errs = errs.Also(c.OAuth2.ClientSecret.ValidateSecretRef().ViaField("oauth2", "clientSecret"))
+	}
+
+	if len(set) > 1 {
+		errs = errs.Also(apis.ErrMultipleOneOf(set...))
+	}
+
+	if c.TLS != nil {
+		errs = errs.Also(c.TLS.ClientCertificate.ValidateSecretRef().ViaField("tls", "clientCertificate"))
+		errs = errs.Also(c.TLS.ClientKey.ValidateSecretRef().ViaField("tls", "clientKey"))
+		if c.TLS.CACertificate != nil {
+			errs = errs.Also(c.TLS.CACertificate.ValidateSecretRef().ViaField("tls", "caCertificate"))
+		}
+	}
+
+	return errs
+}
@@ -19,6 +19,7 @@ package v1alpha1
 import (
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
@@ -26,8 +27,35 @@ import (
 
 // SecretValueFromSource represents the source of a secret value
 type SecretValueFromSource struct {
+	// Optional: no more than one of the following may be specified.
+
 	// The Secret key to select from.
+	// +optional
 	SecretKeyRef *corev1.SecretKeySelector `json:"secretKeyRef,omitempty"`
+	// Literal value.
+	// +optional
+	Value string `json:"value,omitempty"`
+	// Value read by the adapter from a file at the given path, such as a
+	// Secret mounted by the Secrets Store CSI driver.
+	// +optional
+	ValueFromFile string `json:"valueFromFile,omitempty"`
+	// Value read by the adapter from an external secret provider.
+	// +optional
+	ValueFromProvider *v1alpha1.ProviderSecretSelector `json:"valueFromProvider,omitempty"`
+}
+
+// ValueFromField returns the source of the secret value as a ValueFromField.
+func (s *SecretValueFromSource) ValueFromField() v1alpha1.ValueFromField {
+	if s == nil {
+		return v1alpha1.ValueFromField{}
+	}
+
+	return v1alpha1.ValueFromField{
+		Value:             s.Value,
+		ValueFromSecret:   s.SecretKeyRef,
+		ValueFromFile:     s.ValueFromFile,
+		ValueFromProvider: s.ValueFromProvider,
+	}
 }
 
 // EventOptions modifies CloudEvents management at Targets.
@@ -0,0 +1,368 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// v1alpha1 is the hub version of the targets API group. Conversions between the hub
+// and other versions are implemented by the other versions.
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSComprehendTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSComprehendTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSDynamoDBTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSDynamoDBTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSEventBridgeTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSEventBridgeTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSKinesisTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSKinesisTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSLambdaTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSLambdaTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSS3Target) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSS3Target) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSSNSTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSSNSTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSSQSTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSSQSTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureEventHubsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureEventHubsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureSentinelTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureSentinelTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureServiceBusTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureServiceBusTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *CloudEventsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *CloudEventsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *DatadogTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *DatadogTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *ElasticsearchTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *ElasticsearchTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudFirestoreTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudFirestoreTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudStorageTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudStorageTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudWorkflowsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudWorkflowsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudPubSubTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudPubSubTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleSheetTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleSheetTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *HTTPTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *HTTPTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *IBMMQTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *IBMMQTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *JiraTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *JiraTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *KafkaTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *KafkaTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *LogzMetricsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *LogzMetricsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *LogzTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *LogzTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *MongoDBTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *MongoDBTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *OracleTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *OracleTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SalesforceTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SalesforceTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SendGridTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SendGridTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SlackTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SlackTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SolaceTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SolaceTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SplunkTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SplunkTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *TwilioTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *TwilioTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *ZendeskTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	return v1alpha1.HubConvertTo(ctx, t, to)
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *ZendeskTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	return v1alpha1.HubConvertFrom(ctx, t, from)
+}
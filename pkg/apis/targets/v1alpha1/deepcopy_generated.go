@@ -22,6 +22,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 	v1 "k8s.io/api/core/v1"
@@ -93,6 +94,11 @@ func (in *AWSComprehendTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSComprehendTargetSpec) DeepCopyInto(out *AWSComprehendTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.EventOptions != nil {
 		in, out := &in.EventOptions, &out.EventOptions
 		*out = new(EventOptions)
@@ -116,6 +122,37 @@ func (in *AWSComprehendTargetSpec) DeepCopy() *AWSComprehendTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBKeyAttribute) DeepCopyInto(out *AWSDynamoDBKeyAttribute) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(AWSDynamoDBKeyAttributeType)
+		**out = **in
+	}
+	if in.Attribute != nil {
+		in, out := &in.Attribute, &out.Attribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataPath != nil {
+		in, out := &in.DataPath, &out.DataPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBKeyAttribute.
+func (in *AWSDynamoDBKeyAttribute) DeepCopy() *AWSDynamoDBKeyAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBKeyAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSDynamoDBTarget) DeepCopyInto(out *AWSDynamoDBTarget) {
 	*out = *in
@@ -181,6 +218,18 @@ func (in *AWSDynamoDBTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSDynamoDBTargetSpec) DeepCopyInto(out *AWSDynamoDBTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = make([]AWSDynamoDBKeyAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -199,6 +248,68 @@ func (in *AWSDynamoDBTargetSpec) DeepCopy() *AWSDynamoDBTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeBatching) DeepCopyInto(out *AWSEventBridgeBatching) {
+	*out = *in
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeBatching.
+func (in *AWSEventBridgeBatching) DeepCopy() *AWSEventBridgeBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeMapping) DeepCopyInto(out *AWSEventBridgeMapping) {
+	*out = *in
+	if in.DetailType != nil {
+		in, out := &in.DetailType, &out.DetailType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EventBusName != nil {
+		in, out := &in.EventBusName, &out.EventBusName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeMapping.
+func (in *AWSEventBridgeMapping) DeepCopy() *AWSEventBridgeMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSEventBridgeTarget) DeepCopyInto(out *AWSEventBridgeTarget) {
 	*out = *in
@@ -264,6 +375,21 @@ func (in *AWSEventBridgeTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSEventBridgeTargetSpec) DeepCopyInto(out *AWSEventBridgeTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(AWSEventBridgeMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSEventBridgeBatching)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -282,6 +408,32 @@ func (in *AWSEventBridgeTargetSpec) DeepCopy() *AWSEventBridgeTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisBatching) DeepCopyInto(out *AWSKinesisBatching) {
+	*out = *in
+	if in.MaxRecords != nil {
+		in, out := &in.MaxRecords, &out.MaxRecords
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisBatching.
+func (in *AWSKinesisBatching) DeepCopy() *AWSKinesisBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSKinesisTarget) DeepCopyInto(out *AWSKinesisTarget) {
 	*out = *in
@@ -347,6 +499,26 @@ func (in *AWSKinesisTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSKinesisTargetSpec) DeepCopyInto(out *AWSKinesisTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PartitionKey != nil {
+		in, out := &in.PartitionKey, &out.PartitionKey
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExplicitHashKey != nil {
+		in, out := &in.ExplicitHashKey, &out.ExplicitHashKey
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSKinesisBatching)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -430,6 +602,26 @@ func (in *AWSLambdaTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSLambdaTargetSpec) DeepCopyInto(out *AWSLambdaTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InvocationType != nil {
+		in, out := &in.InvocationType, &out.InvocationType
+		*out = new(AWSLambdaInvocationType)
+		**out = **in
+	}
+	if in.Qualifier != nil {
+		in, out := &in.Qualifier, &out.Qualifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientContext != nil {
+		in, out := &in.ClientContext, &out.ClientContext
+		*out = new(string)
+		**out = **in
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -513,6 +705,11 @@ func (in *AWSS3TargetList) DeepCopyObject() runtime.Object {
 func (in *AWSS3TargetSpec) DeepCopyInto(out *AWSS3TargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -531,6 +728,38 @@ func (in *AWSS3TargetSpec) DeepCopy() *AWSS3TargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSSMSOptions) DeepCopyInto(out *AWSSNSSMSOptions) {
+	*out = *in
+	in.PhoneNumberFrom.DeepCopyInto(&out.PhoneNumberFrom)
+	if in.MessageFrom != nil {
+		in, out := &in.MessageFrom, &out.MessageFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SenderID != nil {
+		in, out := &in.SenderID, &out.SenderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(AWSSNSSMSType)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSSMSOptions.
+func (in *AWSSNSSMSOptions) DeepCopy() *AWSSNSSMSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSSMSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSSNSTarget) DeepCopyInto(out *AWSSNSTarget) {
 	*out = *in
@@ -596,6 +825,43 @@ func (in *AWSSNSTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSSNSTargetSpec) DeepCopyInto(out *AWSSNSTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageGroupIDFrom != nil {
+		in, out := &in.MessageGroupIDFrom, &out.MessageGroupIDFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeduplicationIDFrom != nil {
+		in, out := &in.DeduplicationIDFrom, &out.DeduplicationIDFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubjectFrom != nil {
+		in, out := &in.SubjectFrom, &out.SubjectFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtocolMessages != nil {
+		in, out := &in.ProtocolMessages, &out.ProtocolMessages
+		*out = make(map[string]commonv1alpha1.EventKeySource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.SMS != nil {
+		in, out := &in.SMS, &out.SMS
+		*out = new(AWSSNSSMSOptions)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -614,6 +880,32 @@ func (in *AWSSNSTargetSpec) DeepCopy() *AWSSNSTargetSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSBatching) DeepCopyInto(out *AWSSQSBatching) {
+	*out = *in
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSBatching.
+func (in *AWSSQSBatching) DeepCopy() *AWSSQSBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AWSSQSTarget) DeepCopyInto(out *AWSSQSTarget) {
 	*out = *in
@@ -679,6 +971,41 @@ func (in *AWSSQSTargetList) DeepCopyObject() runtime.Object {
 func (in *AWSSQSTargetSpec) DeepCopyInto(out *AWSSQSTargetSpec) {
 	*out = *in
 	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(commonv1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageGroupIDFrom != nil {
+		in, out := &in.MessageGroupIDFrom, &out.MessageGroupIDFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeduplicationIDFrom != nil {
+		in, out := &in.DeduplicationIDFrom, &out.DeduplicationIDFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DelaySeconds != nil {
+		in, out := &in.DelaySeconds, &out.DelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DelaySecondsFrom != nil {
+		in, out := &in.DelaySecondsFrom, &out.DelaySecondsFrom
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSSQSBatching)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1057,10 +1384,72 @@ func (in *AzureServicePrincipal) DeepCopy() *AzureServicePrincipal {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsBatch) DeepCopyInto(out *CloudEventsBatch) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsBatch.
+func (in *CloudEventsBatch) DeepCopy() *CloudEventsBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsCircuitBreaker) DeepCopyInto(out *CloudEventsCircuitBreaker) {
+	*out = *in
+	if in.OpenDuration != nil {
+		in, out := &in.OpenDuration, &out.OpenDuration
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsCircuitBreaker.
+func (in *CloudEventsCircuitBreaker) DeepCopy() *CloudEventsCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudEventsCredentials) DeepCopyInto(out *CloudEventsCredentials) {
 	*out = *in
 	in.BasicAuth.DeepCopyInto(&out.BasicAuth)
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(CloudEventsOAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(CloudEventsTLS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1074,6 +1463,83 @@ func (in *CloudEventsCredentials) DeepCopy() *CloudEventsCredentials {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsOAuth2) DeepCopyInto(out *CloudEventsOAuth2) {
+	*out = *in
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	in.TokenURL.DeepCopyInto(&out.TokenURL)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsOAuth2.
+func (in *CloudEventsOAuth2) DeepCopy() *CloudEventsOAuth2 {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsOAuth2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsRetry) DeepCopyInto(out *CloudEventsRetry) {
+	*out = *in
+	if in.BackoffPolicy != nil {
+		in, out := &in.BackoffPolicy, &out.BackoffPolicy
+		*out = new(BackoffPolicyType)
+		**out = **in
+	}
+	if in.BackoffDelay != nil {
+		in, out := &in.BackoffDelay, &out.BackoffDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.RetryableStatusCodes != nil {
+		in, out := &in.RetryableStatusCodes, &out.RetryableStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsRetry.
+func (in *CloudEventsRetry) DeepCopy() *CloudEventsRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsTLS) DeepCopyInto(out *CloudEventsTLS) {
+	*out = *in
+	in.ClientCertificate.DeepCopyInto(&out.ClientCertificate)
+	in.ClientKey.DeepCopyInto(&out.ClientKey)
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(commonv1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsTLS.
+func (in *CloudEventsTLS) DeepCopy() *CloudEventsTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudEventsTarget) DeepCopyInto(out *CloudEventsTarget) {
 	*out = *in
@@ -1149,6 +1615,26 @@ func (in *CloudEventsTargetSpec) DeepCopyInto(out *CloudEventsTargetSpec) {
 		**out = **in
 	}
 	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(CloudEventsMode)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(CloudEventsBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(CloudEventsRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CloudEventsCircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -1556,6 +2042,37 @@ func (in *GoogleCloudFirestoreTargetSpec) DeepCopy() *GoogleCloudFirestoreTarget
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubBatching) DeepCopyInto(out *GoogleCloudPubSubBatching) {
+	*out = *in
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubBatching.
+func (in *GoogleCloudPubSubBatching) DeepCopy() *GoogleCloudPubSubBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GoogleCloudPubSubTarget) DeepCopyInto(out *GoogleCloudPubSubTarget) {
 	*out = *in
@@ -1641,6 +2158,26 @@ func (in *GoogleCloudPubSubTargetSpec) DeepCopyInto(out *GoogleCloudPubSubTarget
 		*out = new(EventOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OrderingKey != nil {
+		in, out := &in.OrderingKey, &out.OrderingKey
+		*out = new(commonv1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(GoogleCloudPubSubBindingMode)
+		**out = **in
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(GoogleCloudPubSubBatching)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1978,6 +2515,11 @@ func (in *HTTPBasicAuth) DeepCopy() *HTTPBasicAuth {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPEventResponse) DeepCopyInto(out *HTTPEventResponse) {
 	*out = *in
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(HTTPResponseMapping)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1991,6 +2533,143 @@ func (in *HTTPEventResponse) DeepCopy() *HTTPEventResponse {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRateLimit) DeepCopyInto(out *HTTPRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRateLimit.
+func (in *HTTPRateLimit) DeepCopy() *HTTPRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestTemplate) DeepCopyInto(out *HTTPRequestTemplate) {
+	*out = *in
+	if in.Language != nil {
+		in, out := &in.Language, &out.Language
+		*out = new(HTTPTemplateLanguage)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestTemplate.
+func (in *HTTPRequestTemplate) DeepCopy() *HTTPRequestTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseMapping) DeepCopyInto(out *HTTPResponseMapping) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCodeTypes != nil {
+		in, out := &in.StatusCodeTypes, &out.StatusCodeTypes
+		*out = make([]HTTPStatusCodeEventType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseMapping.
+func (in *HTTPResponseMapping) DeepCopy() *HTTPResponseMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
+	*out = *in
+	if in.BackoffDelay != nil {
+		in, out := &in.BackoffDelay, &out.BackoffDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaxBackoffDelay != nil {
+		in, out := &in.MaxBackoffDelay, &out.MaxBackoffDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.RetryableStatusCodes != nil {
+		in, out := &in.RetryableStatusCodes, &out.RetryableStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetry.
+func (in *HTTPRetry) DeepCopy() *HTTPRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStatusCodeEventType) DeepCopyInto(out *HTTPStatusCodeEventType) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusCodeEventType.
+func (in *HTTPStatusCodeEventType) DeepCopy() *HTTPStatusCodeEventType {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStatusCodeEventType)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTarget) DeepCopyInto(out *HTTPTarget) {
 	*out = *in
@@ -2055,7 +2734,7 @@ func (in *HTTPTargetList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPTargetSpec) DeepCopyInto(out *HTTPTargetSpec) {
 	*out = *in
-	out.Response = in.Response
+	in.Response.DeepCopyInto(&out.Response)
 	in.Endpoint.DeepCopyInto(&out.Endpoint)
 	if in.Headers != nil {
 		in, out := &in.Headers, &out.Headers
@@ -2064,6 +2743,11 @@ func (in *HTTPTargetSpec) DeepCopyInto(out *HTTPTargetSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(HTTPRequestTemplate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SkipVerify != nil {
 		in, out := &in.SkipVerify, &out.SkipVerify
 		*out = new(bool)
@@ -2100,6 +2784,26 @@ func (in *HTTPTargetSpec) DeepCopyInto(out *HTTPTargetSpec) {
 			copy(*out, *in)
 		}
 	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HTTPRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(HTTPRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrentRequests != nil {
+		in, out := &in.MaxConcurrentRequests, &out.MaxConcurrentRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdempotencyKeyHeader != nil {
+		in, out := &in.IdempotencyKeyHeader, &out.IdempotencyKeyHeader
+		*out = new(string)
+		**out = **in
+	}
 	if in.AdapterOverrides != nil {
 		in, out := &in.AdapterOverrides, &out.AdapterOverrides
 		*out = new(commonv1alpha1.AdapterOverrides)
@@ -3118,6 +3822,11 @@ func (in *SecretValueFromSource) DeepCopyInto(out *SecretValueFromSource) {
 		*out = new(v1.SecretKeySelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ValueFromProvider != nil {
+		in, out := &in.ValueFromProvider, &out.ValueFromProvider
+		*out = new(commonv1alpha1.ProviderSecretSelector)
+		**out = **in
+	}
 	return
 }
 
@@ -18,6 +18,7 @@ package v1alpha1
 
 import (
 	"context"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -99,5 +100,63 @@ func (t *GoogleCloudPubSubTarget) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *GoogleCloudPubSubTarget) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	return t.Spec.validate(ctx).ViaField("spec")
+}
+
+// Pub/Sub limits
+// https://cloud.google.com/pubsub/quotas#resource_limits
+const (
+	googleCloudPubSubMaxMessagesPerRequest = 1000
+	googleCloudPubSubMaxBytesPerRequest    = 10000000
+	googleCloudPubSubMaxMessageAttributes  = 100
+	googleCloudPubSubReservedAttrPrefix    = "goog"
+)
+
+// validate validates the message parameters of the spec.
+func (s *GoogleCloudPubSubTargetSpec) validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if s.OrderingKey != nil {
+		errs = errs.Also(s.OrderingKey.Validate(ctx).ViaField("orderingKey"))
+	}
+
+	if len(s.MessageAttributes) > googleCloudPubSubMaxMessageAttributes {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(len(s.MessageAttributes), 0, googleCloudPubSubMaxMessageAttributes,
+			"messageAttributes"))
+	}
+	attrs := make(map[string]struct{}, len(s.MessageAttributes))
+	for i, a := range s.MessageAttributes {
+		if _, dup := attrs[a]; dup || a == "" || strings.HasPrefix(a, googleCloudPubSubReservedAttrPrefix) {
+			errs = errs.Also(apis.ErrInvalidArrayValue(a, "messageAttributes", i))
+		}
+		attrs[a] = struct{}{}
+	}
+
+	if b := s.Binding; b != nil {
+		switch *b {
+		case GoogleCloudPubSubBindingModeBinary, GoogleCloudPubSubBindingModeStructured:
+		default:
+			errs = errs.Also(apis.ErrInvalidValue(*b, "binding"))
+		}
+		// the binding defines how the CloudEvent context is transmitted
+		if s.DiscardCloudEventContext {
+			errs = errs.Also(apis.ErrMultipleOneOf("binding", "discardCloudEventContext"))
+		}
+	}
+
+	if b := s.Batching; b != nil {
+		if b.MaxMessages != nil && (*b.MaxMessages < 1 || *b.MaxMessages > googleCloudPubSubMaxMessagesPerRequest) {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxMessages, 1, googleCloudPubSubMaxMessagesPerRequest,
+				"batching.maxMessages"))
+		}
+		if b.MaxBytes != nil && (*b.MaxBytes < 1 || *b.MaxBytes > googleCloudPubSubMaxBytesPerRequest) {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*b.MaxBytes, 1, googleCloudPubSubMaxBytesPerRequest,
+				"batching.maxBytes"))
+		}
+		if b.MaxDelay != nil && *b.MaxDelay <= 0 {
+			errs = errs.Also(apis.ErrInvalidValue(b.MaxDelay.String(), "batching.maxDelay"))
+		}
+	}
+
+	return errs
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -70,6 +71,60 @@ type GoogleCloudPubSubTargetSpec struct {
 	// DiscardCloudEventContext is the policy for how to handle the payload of
 	// the CloudEvent.
 	DiscardCloudEventContext bool `json:"discardCloudEventContext,omitempty"`
+
+	// Location of the ordering key of messages inside events. Messages
+	// which share an ordering key are delivered in the order they were
+	// published to subscriptions which have message ordering enabled.
+	// +optional
+	OrderingKey *v1alpha1.EventKeySource `json:"orderingKey,omitempty"`
+
+	// Names of CloudEvents context attributes and extensions to set as
+	// attributes of messages, which allows subscribers to filter messages
+	// without parsing their data.
+	// +optional
+	MessageAttributes []string `json:"messageAttributes,omitempty"`
+
+	// Mode of the CloudEvents Pub/Sub protocol binding used to encode
+	// events in messages. In binary mode, the data of messages is the data
+	// of events, and context attributes are set as "ce-" prefixed message
+	// attributes. In structured mode, the data of messages is the JSON
+	// representation of events. Cannot be combined with
+	// discardCloudEventContext.
+	// https://github.com/google/knative-gcp/blob/main/docs/spec/pubsub-protocol-binding.md
+	// +optional
+	Binding *GoogleCloudPubSubBindingMode `json:"binding,omitempty"`
+
+	// Batching of messages in publish requests. Defaults to the settings
+	// of the Pub/Sub client library.
+	// +optional
+	Batching *GoogleCloudPubSubBatching `json:"batching,omitempty"`
+}
+
+// GoogleCloudPubSubBindingMode is a mode of the CloudEvents Pub/Sub protocol binding.
+type GoogleCloudPubSubBindingMode string
+
+// Supported binding modes.
+const (
+	GoogleCloudPubSubBindingModeBinary     GoogleCloudPubSubBindingMode = "binary"
+	GoogleCloudPubSubBindingModeStructured GoogleCloudPubSubBindingMode = "structured"
+)
+
+// GoogleCloudPubSubBatching contains parameters used to group messages in
+// publish requests. A batch is published as soon as it reaches any of these
+// thresholds.
+type GoogleCloudPubSubBatching struct {
+	// Maximum number of messages in a publish request. Defaults to 100.
+	// +optional
+	MaxMessages *int32 `json:"maxMessages,omitempty"`
+
+	// Maximum size of a publish request, in bytes. Defaults to 1000000.
+	// +optional
+	MaxBytes *int32 `json:"maxBytes,omitempty"`
+
+	// Maximum amount of time a message waits for its batch to be
+	// published. Defaults to 10ms.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
 }
 
 // GoogleCloudPubSubTargetStatus communicates the observed state of the event target.
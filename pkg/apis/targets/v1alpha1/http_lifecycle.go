@@ -18,6 +18,8 @@ package v1alpha1
 
 import (
 	"context"
+	"fmt"
+	"sort"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -26,6 +28,7 @@ import (
 	duckv1 "knative.dev/pkg/apis/duck/v1"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/httptarget/render"
 )
 
 // EventTypeHTTPTargetRequest is the event type for HTTP target request.
@@ -65,6 +68,106 @@ func (t *HTTPTarget) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *HTTPTarget) Validate(ctx context.Context) *apis.FieldError {
+	return t.Spec.Validate(ctx).ViaField("spec")
+}
+
+// Validate HTTPTarget spec
+func (s *HTTPTargetSpec) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	lang := HTTPTemplateLanguageGo
+	if s.Template != nil && s.Template.Language != nil {
+		lang = *s.Template.Language
+	}
+
+	validLang := true
+	switch lang {
+	case HTTPTemplateLanguageGo, HTTPTemplateLanguageJQ:
+	default:
+		validLang = false
+		errs = errs.Also(apis.ErrInvalidValue(lang, "template.language"))
+	}
+
+	if s.Template != nil && validLang {
+		errs = errs.Also(s.Template.validateTemplates(lang).ViaField("template"))
+	}
+
+	if s.Retry != nil {
+		if s.Retry.Count < 0 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(s.Retry.Count, 0, "+Inf", "retry.count"))
+		}
+		for i, c := range s.Retry.RetryableStatusCodes {
+			if c < 100 || c > 599 {
+				errs = errs.Also(apis.ErrInvalidArrayValue(c, "retry.retryableStatusCodes", i))
+			}
+		}
+	}
+
+	if s.RateLimit != nil {
+		if s.RateLimit.RequestsPerSecond < 1 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(s.RateLimit.RequestsPerSecond, 1, "+Inf",
+				"rateLimit.requestsPerSecond"))
+		}
+		if s.RateLimit.Burst != nil && *s.RateLimit.Burst < 1 {
+			errs = errs.Also(apis.ErrOutOfBoundsValue(*s.RateLimit.Burst, 1, "+Inf", "rateLimit.burst"))
+		}
+	}
+
+	if s.MaxConcurrentRequests != nil && *s.MaxConcurrentRequests < 1 {
+		errs = errs.Also(apis.ErrOutOfBoundsValue(*s.MaxConcurrentRequests, 1, "+Inf", "maxConcurrentRequests"))
+	}
+
+	if m := s.Response.Mapping; m != nil {
+		if m.Data != nil && validLang {
+			errs = errs.Also(validateHTTPTemplate(lang, *m.Data, "response.mapping.data"))
+		}
+		for i, sct := range m.StatusCodeTypes {
+			if sct.From > sct.To {
+				errs = errs.Also(apis.ErrInvalidArrayValue(sct.From, "response.mapping.statusCodeTypes.from", i))
+			}
+			if sct.EventType == "" {
+				errs = errs.Also(apis.ErrMissingField("eventType").
+					ViaFieldIndex("response.mapping.statusCodeTypes", i))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateTemplates ensures all templates of the request compile, so that
+// the adapter does not fail to start.
+func (t *HTTPRequestTemplate) validateTemplates(lang HTTPTemplateLanguage) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if t.URL != nil {
+		errs = errs.Also(validateHTTPTemplate(lang, *t.URL, "url"))
+	}
+	if t.Method != nil {
+		errs = errs.Also(validateHTTPTemplate(lang, *t.Method, "method"))
+	}
+	if t.Body != nil {
+		errs = errs.Also(validateHTTPTemplate(lang, *t.Body, "body"))
+	}
+
+	headers := make([]string, 0, len(t.Headers))
+	for k := range t.Headers {
+		headers = append(headers, k)
+	}
+	sort.Strings(headers)
+
+	for _, k := range headers {
+		errs = errs.Also(validateHTTPTemplate(lang, t.Headers[k], "").ViaFieldKey("headers", k))
+	}
+
+	return errs
+}
+
+// validateHTTPTemplate returns an error if the given template can not be compiled.
+func validateHTTPTemplate(lang HTTPTemplateLanguage, tpl, field string) *apis.FieldError {
+	if _, err := render.New(string(lang), tpl); err != nil {
+		return apis.ErrInvalidValue(fmt.Sprintf("Cannot compile template: %v", err), field)
+	}
 	return nil
 }
 
@@ -82,9 +185,17 @@ func (t *HTTPTarget) GetEventTypes() []string {
 	if t.Spec.Response.EventType != "" {
 		eventType = t.Spec.Response.EventType
 	}
-	return []string{
+	types := []string{
 		eventType,
 	}
+
+	if m := t.Spec.Response.Mapping; m != nil {
+		for _, sct := range m.StatusCodeTypes {
+			types = append(types, sct.EventType)
+		}
+	}
+
+	return types
 }
 
 // AsEventSource implements EventSource.
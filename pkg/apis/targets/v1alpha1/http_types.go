@@ -18,8 +18,10 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"knative.dev/pkg/apis"
 
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
@@ -50,7 +52,7 @@ type HTTPTargetSpec struct {
 	Response HTTPEventResponse `json:"response"`
 
 	// Endpoint to connect to.
-	Endpoint apis.URL `json:"endpoint"`
+	Endpoint pkgapis.URL `json:"endpoint"`
 
 	// Method to use at requests.
 	Method string `json:"method"`
@@ -59,6 +61,11 @@ type HTTPTargetSpec struct {
 	// +optional
 	Headers map[string]string `json:"headers,omitempty"`
 
+	// Template used to build HTTP requests from incoming events.
+	// When set, the RequestData contract is not used to parametrize requests.
+	// +optional
+	Template *HTTPRequestTemplate `json:"template,omitempty"`
+
 	// SkipVerify disables server certificate validation.
 	// +optional
 	SkipVerify *bool `json:"skipVerify"`
@@ -91,11 +98,60 @@ type HTTPTargetSpec struct {
 	// +optional
 	OAuthScopes *[]string `json:"oauthScopes,omitempty"`
 
+	// Retry policy applied to requests that fail to be delivered.
+	// +optional
+	Retry *HTTPRetry `json:"retry,omitempty"`
+
+	// RateLimit applied to requests sent by each adapter instance.
+	// +optional
+	RateLimit *HTTPRateLimit `json:"rateLimit,omitempty"`
+
+	// MaxConcurrentRequests is the maximum number of requests each adapter
+	// instance sends concurrently.
+	// +optional
+	MaxConcurrentRequests *int32 `json:"maxConcurrentRequests,omitempty"`
+
+	// IdempotencyKeyHeader is the name of a header set on requests with the
+	// ID of the event, to let the remote endpoint detect duplicate requests.
+	// +optional
+	IdempotencyKeyHeader *string `json:"idempotencyKeyHeader,omitempty"`
+
 	// Adapter spec overrides parameters.
 	// +optional
 	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
 }
 
+// HTTPRetry contains the retry policy for failed requests. Delays between
+// retries grow exponentially, unless the response contains a Retry-After header.
+type HTTPRetry struct {
+	// Number of retries before a request is considered failed.
+	Count int32 `json:"count"`
+
+	// Delay before the first retry. Defaults to 1s.
+	// +optional
+	BackoffDelay *apis.Duration `json:"backoffDelay,omitempty"`
+
+	// Maximum delay between retries, including delays requested by the
+	// remote endpoint through the Retry-After header. Defaults to 30s.
+	// +optional
+	MaxBackoffDelay *apis.Duration `json:"maxBackoffDelay,omitempty"`
+
+	// HTTP status codes that trigger a retry. Defaults to 429, 502, 503 and
+	// 504. Connection errors are always retried.
+	// +optional
+	RetryableStatusCodes []int `json:"retryableStatusCodes,omitempty"`
+}
+
+// HTTPRateLimit contains the parameters of a token bucket rate limiter.
+type HTTPRateLimit struct {
+	// Rate at which requests are allowed.
+	RequestsPerSecond int32 `json:"requestsPerSecond"`
+
+	// Maximum number of requests allowed in a burst. Defaults to 1.
+	// +optional
+	Burst *int32 `json:"burst,omitempty"`
+}
+
 // HTTPEventResponse for reply events context.
 type HTTPEventResponse struct {
 	// EventType for the reply.
@@ -103,6 +159,69 @@ type HTTPEventResponse struct {
 
 	// EventSource for the reply.
 	EventSource string `json:"eventSource"`
+
+	// Mapping applied to HTTP responses to produce replies.
+	// +optional
+	Mapping *HTTPResponseMapping `json:"mapping,omitempty"`
+}
+
+// HTTPTemplateLanguage is the language templates are written in.
+type HTTPTemplateLanguage string
+
+// Supported template languages.
+const (
+	HTTPTemplateLanguageGo HTTPTemplateLanguage = "go"
+	HTTPTemplateLanguageJQ HTTPTemplateLanguage = "jq"
+)
+
+// HTTPRequestTemplate contains templates evaluated against incoming events
+// to build HTTP requests. Templates have access to the event's context
+// attributes and extensions as well as its data.
+type HTTPRequestTemplate struct {
+	// Language of the request and response templates. Defaults to go.
+	// +optional
+	Language *HTTPTemplateLanguage `json:"language,omitempty"`
+
+	// URL of the request. Relative URLs are resolved against the endpoint.
+	// +optional
+	URL *string `json:"url,omitempty"`
+
+	// Method of the request.
+	// +optional
+	Method *string `json:"method,omitempty"`
+
+	// Headers of the request. Values are templates.
+	// +optional
+	Headers map[string]string `json:"headers,omitempty"`
+
+	// Body of the request.
+	// +optional
+	Body *string `json:"body,omitempty"`
+}
+
+// HTTPResponseMapping contains parameters used to produce replies from HTTP
+// responses. Templates have access to the response's status code, headers and
+// body, as well as the event that originated the request.
+type HTTPResponseMapping struct {
+	// Template used to produce the data of the reply.
+	// +optional
+	Data *string `json:"data,omitempty"`
+
+	// Types of the reply selected by response status code. Responses with
+	// an error status code that match one of the ranges produce a reply
+	// instead of an error.
+	// +optional
+	StatusCodeTypes []HTTPStatusCodeEventType `json:"statusCodeTypes,omitempty"`
+}
+
+// HTTPStatusCodeEventType associates a range of status codes with an event type.
+type HTTPStatusCodeEventType struct {
+	// Lower bound of the range, inclusive.
+	From int `json:"from"`
+	// Upper bound of the range, inclusive.
+	To int `json:"to"`
+	// Type of the reply.
+	EventType string `json:"eventType"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -88,5 +88,15 @@ func (t *IBMMQTarget) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *IBMMQTarget) Validate(ctx context.Context) *apis.FieldError {
+	if tls := t.Spec.Auth.TLS; tls != nil {
+		return tls.KeyRepository.Validate(ctx).ViaField("spec", "credentials", "tls", "keyRepository")
+	}
 	return nil
 }
+
+// Validate ensures the components of the key repository are referenced from
+// Kubernetes Secrets, which get mounted as files into the adapter.
+func (k *Keystore) Validate(ctx context.Context) *apis.FieldError {
+	return k.KeyDatabase.ValidateSecretRef().ViaField("keyDatabase").Also(
+		k.PasswordStash.ValidateSecretRef().ViaField("passwordStash"))
+}
@@ -61,5 +61,26 @@ func (t *KafkaTarget) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *KafkaTarget) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	var errs *apis.FieldError
+
+	if a := t.Spec.Auth; a != nil && a.Kerberos != nil {
+		errs = errs.Also(a.Kerberos.Validate(ctx).ViaField("spec", "auth", "kerberos"))
+	}
+
+	return errs.Also(v1alpha1.Verify(ctx, t))
+}
+
+// Validate ensures the Kerberos configuration and keytab are referenced from
+// Kubernetes Secrets, which get mounted as files into the adapter.
+func (k *KafkaTargetKerberos) Validate(ctx context.Context) *apis.FieldError {
+	var errs *apis.FieldError
+
+	if k.Config != nil {
+		errs = errs.Also(k.Config.ValidateSecretRef().ViaField("config"))
+	}
+	if k.Keytab != nil {
+		errs = errs.Also(k.Keytab.ValidateSecretRef().ViaField("keytab"))
+	}
+
+	return errs
 }
@@ -91,5 +91,5 @@ func (t *MongoDBTarget) SetDefaults(ctx context.Context) {
 
 // Validate implements apis.Validatable
 func (t *MongoDBTarget) Validate(ctx context.Context) *apis.FieldError {
-	return nil
+	return v1alpha1.Verify(ctx, t)
 }
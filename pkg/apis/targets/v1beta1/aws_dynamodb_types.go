@@ -0,0 +1,98 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSDynamoDBTarget is the Schema for an AWS DynamoDB Target.
+type AWSDynamoDBTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSDynamoDBTargetSpec `json:"spec"`
+	Status v1alpha1.Status       `json:"status,omitempty"`
+}
+
+// AWSDynamoDBTargetSpec defines the desired state of the event target.
+type AWSDynamoDBTargetSpec struct {
+	// AWS-specific authentication methods.
+	Auth v1alpha1.AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Table ARN
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazondynamodb.html#amazondynamodb-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Attributes of the primary key of the table, and the location of their
+	// values inside events. Used to address the item targeted by update and
+	// delete operations when events don't specify a key, and to complete
+	// the items written by put operations.
+	// +optional
+	Key []AWSDynamoDBKeyAttribute `json:"key,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSDynamoDBKeyAttribute is an attribute of the primary key of a DynamoDB
+// table, along with the source of its value.
+type AWSDynamoDBKeyAttribute struct {
+	// Name of the attribute in the table.
+	Name string `json:"name"`
+	// Scalar type of the attribute. Defaults to "S".
+	// +optional
+	Type *AWSDynamoDBKeyAttributeType `json:"type,omitempty"`
+
+	// Required: exactly one of the following must be specified.
+
+	// Name of a CloudEvents context attribute or extension.
+	// +optional
+	Attribute *string `json:"attribute,omitempty"`
+	// Path of a field inside the JSON data of events, in GJSON syntax.
+	// +optional
+	DataPath *string `json:"dataPath,omitempty"`
+}
+
+// AWSDynamoDBKeyAttributeType is the scalar type of a key attribute.
+type AWSDynamoDBKeyAttributeType string
+
+// Supported types of key attributes.
+const (
+	AWSDynamoDBKeyAttributeTypeString AWSDynamoDBKeyAttributeType = "S"
+	AWSDynamoDBKeyAttributeTypeNumber AWSDynamoDBKeyAttributeType = "N"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSDynamoDBTargetList is a list of event target instances.
+type AWSDynamoDBTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSDynamoDBTarget `json:"items"`
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSEventBridgeTarget is the Schema for the event target.
+type AWSEventBridgeTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSEventBridgeTargetSpec `json:"spec"`
+	Status v1alpha1.Status          `json:"status,omitempty"`
+}
+
+// AWSEventBridgeTargetSpec defines the desired state of the event target.
+type AWSEventBridgeTargetSpec struct {
+	// AWS-specific authentication methods.
+	Auth v1alpha1.AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Amazon Resource Name of the EventBridge Event Bus.
+	// https://docs.aws.amazon.com/service-authorization/latest/reference/list_amazoneventbridge.html
+	ARN string `json:"arn"`
+
+	// Whether to omit CloudEvent context attributes in notifications sent to EventBridge.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCEContext bool `json:"discardCloudEventContext"`
+
+	// Templates used to build the entries sent to EventBridge from events.
+	// +optional
+	Mapping *AWSEventBridgeMapping `json:"mapping,omitempty"`
+
+	// Buffering of entries in PutEvents requests. Entries are sent one at
+	// a time when not set.
+	// +optional
+	Batching *AWSEventBridgeBatching `json:"batching,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSEventBridgeMapping contains Go templates used to build the entries sent
+// to EventBridge. Templates are evaluated against the JSON representation of
+// events, which exposes their context attributes, extensions and data (e.g.
+// {{ .type }}, {{ .data.region }}). Templates which render an empty string
+// are ignored.
+type AWSEventBridgeMapping struct {
+	// Detail type of entries. Defaults to the type of the event.
+	// +optional
+	DetailType *string `json:"detailType,omitempty"`
+
+	// Source of entries. Defaults to the source of the event.
+	// +optional
+	Source *string `json:"source,omitempty"`
+
+	// ARNs of the AWS resources the entries relate to.
+	// +optional
+	Resources []string `json:"resources,omitempty"`
+
+	// Name or ARN of the event bus entries are sent to. Defaults to the
+	// event bus of the target.
+	// +optional
+	EventBusName *string `json:"eventBusName,omitempty"`
+}
+
+// AWSEventBridgeBatching contains parameters used to group entries in batches.
+type AWSEventBridgeBatching struct {
+	// Maximum number of entries in a PutEvents request. Defaults to 10,
+	// which is also the upper limit imposed by EventBridge.
+	// +optional
+	MaxEntries *int32 `json:"maxEntries,omitempty"`
+
+	// Maximum amount of time an entry waits for its batch to be sent.
+	// Defaults to 1s.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSEventBridgeTargetList is a list of event target instances.
+type AWSEventBridgeTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSEventBridgeTarget `json:"items"`
+}
@@ -0,0 +1,104 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSKinesisTarget is the Schema for an AWS Kinesis Target.
+type AWSKinesisTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSKinesisTargetSpec `json:"spec"`
+	Status v1alpha1.Status      `json:"status,omitempty"`
+}
+
+// AWSKinesisTargetSpec defines the desired state of the event target.
+type AWSKinesisTargetSpec struct {
+	// AWS-specific authentication methods.
+	Auth v1alpha1.AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Amazon Resource Name of the Kinesis stream.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonkinesis.html#amazonkinesis-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Partition key of the records written to Kinesis. Used for every event
+	// when partitionKey isn't set, and for events which don't contain any
+	// value for the configured partitionKey otherwise.
+	// +optional
+	Partition string `json:"partition,omitempty"`
+
+	// Location of the partition key of records inside events. The partition
+	// key determines the shard a record is written to.
+	// +optional
+	PartitionKey *v1alpha1.EventKeySource `json:"partitionKey,omitempty"`
+
+	// Location of the explicit hash key of records inside events. When set,
+	// the explicit hash key determines the shard a record is written to,
+	// instead of the hash of its partition key.
+	// +optional
+	ExplicitHashKey *v1alpha1.EventKeySource `json:"explicitHashKey,omitempty"`
+
+	// Buffering of records in PutRecords requests. Records are written one
+	// at a time when not set.
+	// +optional
+	Batching *AWSKinesisBatching `json:"batching,omitempty"`
+
+	// Whether to omit CloudEvent context attributes in records created in Kinesis.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCEContext bool `json:"discardCloudEventContext"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSKinesisBatching contains parameters used to group records in batches.
+type AWSKinesisBatching struct {
+	// Maximum number of records in a PutRecords request. Defaults to 500,
+	// which is also the upper limit imposed by Kinesis.
+	// +optional
+	MaxRecords *int32 `json:"maxRecords,omitempty"`
+
+	// Maximum amount of time a record waits for its batch to be sent.
+	// Defaults to 1s.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSKinesisTargetList is a list of event target instances.
+type AWSKinesisTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSKinesisTarget `json:"items"`
+}
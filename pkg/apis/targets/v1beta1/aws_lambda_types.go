@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSLambdaTarget is the Schema for an AWS Lambda Target.
+type AWSLambdaTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSLambdaTargetSpec `json:"spec"`
+	Status v1alpha1.Status     `json:"status,omitempty"`
+}
+
+// AWSLambdaTargetSpec defines the desired state of the event target.
+type AWSLambdaTargetSpec struct {
+	// AWS-specific authentication methods.
+	Auth v1alpha1.AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Amazon Resource Name of the Lambda function.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_awslambda.html#awslambda-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Whether to omit CloudEvent context attributes in Lambda function calls.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCEContext bool `json:"discardCloudEventContext"`
+
+	// Type of invocation of the function. Defaults to RequestResponse.
+	// https://docs.aws.amazon.com/lambda/latest/dg/API_Invoke.html#API_Invoke_RequestSyntax
+	// +optional
+	InvocationType *AWSLambdaInvocationType `json:"invocationType,omitempty"`
+
+	// Version or alias of the function to invoke.
+	// +optional
+	Qualifier *string `json:"qualifier,omitempty"`
+
+	// JSON document passed to the function as client context, which is
+	// available in the context object of the function.
+	// +optional
+	ClientContext *string `json:"clientContext,omitempty"`
+
+	// Whether to attach the last 4 KB of the execution log of the function
+	// to replies. Only applies to RequestResponse invocations.
+	// +optional
+	LogTail bool `json:"logTail,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSLambdaInvocationType is the type of invocation of a Lambda function.
+type AWSLambdaInvocationType string
+
+// Supported invocation types.
+const (
+	// Invoke the function synchronously and reply with its response.
+	AWSLambdaInvocationTypeRequestResponse AWSLambdaInvocationType = "RequestResponse"
+	// Queue the event for asynchronous invocation of the function.
+	AWSLambdaInvocationTypeEvent AWSLambdaInvocationType = "Event"
+	// Validate parameter values and permissions without invoking the function.
+	AWSLambdaInvocationTypeDryRun AWSLambdaInvocationType = "DryRun"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSLambdaTargetList is a list of event target instances.
+type AWSLambdaTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSLambdaTarget `json:"items"`
+}
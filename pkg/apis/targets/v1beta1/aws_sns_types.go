@@ -0,0 +1,145 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSSNSTarget is the Schema for the event target.
+type AWSSNSTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSSNSTargetSpec `json:"spec"`
+	Status v1alpha1.Status  `json:"status,omitempty"`
+}
+
+// AWSSNSTargetSpec defines the desired state of the event target.
+type AWSSNSTargetSpec struct {
+	// AWS-specific authentication methods.
+	Auth v1alpha1.AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Amazon Resource Name of the SNS topic.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonsns.html#amazonsns-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Message group ID of messages published to FIFO topics.
+	// https://docs.aws.amazon.com/sns/latest/dg/fifo-message-grouping.html
+	// +optional
+	MessageGroupID string `json:"messageGroupId,omitempty"`
+
+	// Location of the message group ID of messages inside events. Only
+	// applies to FIFO topics. Events which don't contain any value for the
+	// message group ID fall back to messageGroupId.
+	// +optional
+	MessageGroupIDFrom *v1alpha1.EventKeySource `json:"messageGroupIdFrom,omitempty"`
+
+	// Location of the deduplication ID of messages inside events. Only
+	// applies to FIFO topics. Defaults to the combination of the ID and
+	// source of events.
+	// +optional
+	DeduplicationIDFrom *v1alpha1.EventKeySource `json:"deduplicationIdFrom,omitempty"`
+
+	// Names of CloudEvents context attributes and extensions to set as
+	// attributes of messages, which allows subscriptions to filter messages
+	// using filter policies. SNS accepts at most 10 attributes per message.
+	// +optional
+	MessageAttributes []string `json:"messageAttributes,omitempty"`
+
+	// Location of the subject of messages inside events. The subject is
+	// used as the subject line of emails.
+	// +optional
+	SubjectFrom *v1alpha1.EventKeySource `json:"subjectFrom,omitempty"`
+
+	// Locations of protocol-specific messages inside events, indexed by
+	// protocol (e.g. "email", "sms", "sqs"). When an event contains a
+	// message for any of these protocols, it is published with the "json"
+	// message structure, and the default message is delivered to other
+	// protocols.
+	// https://docs.aws.amazon.com/sns/latest/dg/sns-send-custom-platform-specific-payloads-mobile-devices.html
+	// +optional
+	ProtocolMessages map[string]v1alpha1.EventKeySource `json:"protocolMessages,omitempty"`
+
+	// Publish messages as SMS directly to phone numbers read from events,
+	// instead of publishing them to the topic.
+	// +optional
+	SMS *AWSSNSSMSOptions `json:"sms,omitempty"`
+
+	// Whether to omit CloudEvent context attributes in notifications sent to SNS.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCEContext bool `json:"discardCloudEventContext"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSSNSSMSOptions contains parameters used to publish SMS messages.
+// https://docs.aws.amazon.com/sns/latest/dg/sms_publish-to-phone.html
+type AWSSNSSMSOptions struct {
+	// Location of the phone number of messages inside events, in E.164
+	// format. Events which don't contain any phone number are rejected.
+	PhoneNumberFrom v1alpha1.EventKeySource `json:"phoneNumberFrom"`
+
+	// Location of the text of messages inside events. Events which don't
+	// contain any text are rejected. Required unless
+	// discardCloudEventContext is enabled, in which case the data of events
+	// is used as the text of messages.
+	// +optional
+	MessageFrom *v1alpha1.EventKeySource `json:"messageFrom,omitempty"`
+
+	// Name displayed as the sender on the receiving device. Must contain
+	// between 1 and 11 alphanumeric characters, including at least one
+	// letter. Not supported in all countries.
+	// +optional
+	SenderID *string `json:"senderId,omitempty"`
+
+	// Type of SMS messages, which determines how their delivery is
+	// optimized.
+	// +optional
+	Type *AWSSNSSMSType `json:"type,omitempty"`
+}
+
+// AWSSNSSMSType is the type of SMS messages.
+type AWSSNSSMSType string
+
+// Supported types of SMS messages.
+const (
+	AWSSNSSMSTypePromotional   AWSSNSSMSType = "Promotional"
+	AWSSNSSMSTypeTransactional AWSSNSSMSType = "Transactional"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSSNSTargetList is a list of event target instances.
+type AWSSNSTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSSNSTarget `json:"items"`
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSSQSTarget is the Schema for an AWS SQS Target.
+type AWSSQSTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AWSSQSTargetSpec `json:"spec"`
+	Status v1alpha1.Status  `json:"status,omitempty"`
+}
+
+// AWSSQSTargetSpec defines the desired state of the event target.
+type AWSSQSTargetSpec struct {
+	// AWS-specific authentication methods.
+	Auth v1alpha1.AWSAuth `json:"auth"`
+
+	// Customizations of the AWS REST API endpoint.
+	// +optional
+	Endpoint *v1alpha1.AWSEndpoint `json:"endpoint,omitempty"`
+
+	// Amazon Resource Name of the SQS queue.
+	// https://docs.aws.amazon.com/IAM/latest/UserGuide/list_amazonsqs.html#amazonsqs-resources-for-iam-policies
+	ARN string `json:"arn"`
+
+	// Message Group ID is required for FIFO based queues, and is used to uniquely identify the event producer
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-understanding-logic.html
+	// +optional
+	MessageGroupID string `json:"messageGroupId,omitempty"`
+
+	// Location of the message group ID of messages inside events. Only
+	// applies to FIFO queues. Events which don't contain any value for the
+	// message group ID fall back to messageGroupId.
+	// +optional
+	MessageGroupIDFrom *v1alpha1.EventKeySource `json:"messageGroupIdFrom,omitempty"`
+
+	// Location of the deduplication ID of messages inside events. Only
+	// applies to FIFO queues. Defaults to the combination of the ID and
+	// source of events.
+	// +optional
+	DeduplicationIDFrom *v1alpha1.EventKeySource `json:"deduplicationIdFrom,omitempty"`
+
+	// Names of CloudEvents context attributes and extensions to set as
+	// attributes of messages, which allows consumers to filter messages
+	// without parsing their body. SQS accepts at most 10 attributes per
+	// message.
+	// +optional
+	MessageAttributes []string `json:"messageAttributes,omitempty"`
+
+	// Number of seconds messages are delayed before becoming available to
+	// consumers, between 0 and 900. Overrides the delay of the queue. Not
+	// supported by FIFO queues.
+	// +optional
+	DelaySeconds *int32 `json:"delaySeconds,omitempty"`
+
+	// Location of the delay of messages, in seconds, inside events. Events
+	// which don't contain any value for the delay fall back to delaySeconds.
+	// Not supported by FIFO queues.
+	// +optional
+	DelaySecondsFrom *v1alpha1.EventKeySource `json:"delaySecondsFrom,omitempty"`
+
+	// Buffering of messages in SendMessageBatch requests. Messages are sent
+	// one at a time when not set.
+	// +optional
+	Batching *AWSSQSBatching `json:"batching,omitempty"`
+
+	// Whether to omit CloudEvent context attributes in messages sent to SQS.
+	// When this property is false (default), the entire CloudEvent payload is included.
+	// When this property is true, only the CloudEvent data is included.
+	DiscardCEContext bool `json:"discardCloudEventContext"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// AWSSQSBatching contains parameters used to group messages in batches.
+type AWSSQSBatching struct {
+	// Maximum number of messages in a SendMessageBatch request. Defaults to
+	// 10, which is also the upper limit imposed by SQS.
+	// +optional
+	MaxMessages *int32 `json:"maxMessages,omitempty"`
+
+	// Maximum amount of time a message waits for its batch to be sent.
+	// Defaults to 1s.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// AWSSQSTargetList is a list of event target instances.
+type AWSSQSTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []AWSSQSTarget `json:"items"`
+}
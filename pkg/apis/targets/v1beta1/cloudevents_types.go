@@ -0,0 +1,188 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pkgapis "knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CloudEventsTarget is a gateway that produces received CloudEvents to a destination.
+type CloudEventsTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CloudEventsTargetSpec `json:"spec,omitempty"`
+	Status v1alpha1.Status       `json:"status,omitempty"`
+}
+
+// CloudEventsTargetSpec defines the desired state of the event target.
+type CloudEventsTargetSpec struct {
+	// Credentials to connect to the remote endpoint.
+	// +optional
+	Credentials *CloudEventsCredentials `json:"credentials,omitempty"`
+
+	// Path at the remote endpoint under which requests are accepted.
+	// +optional
+	Path *string `json:"path,omitempty"`
+
+	// Endpoint that accept CloudEvents.
+	Endpoint pkgapis.URL `json:"endpoint"`
+
+	// Mode used to encode CloudEvents sent to the remote endpoint.
+	// Defaults to binary.
+	// +optional
+	Mode *CloudEventsMode `json:"mode,omitempty"`
+
+	// Batch settings applied when the batch mode is used.
+	// +optional
+	Batch *CloudEventsBatch `json:"batch,omitempty"`
+
+	// Retry policy applied to requests that fail to be delivered.
+	// +optional
+	Retry *CloudEventsRetry `json:"retry,omitempty"`
+
+	// CircuitBreaker stops sending requests to an unavailable endpoint.
+	// +optional
+	CircuitBreaker *CloudEventsCircuitBreaker `json:"circuitBreaker,omitempty"`
+
+	// AdapterOverrides sets runtime parameters to the adapter instance.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+}
+
+// CloudEventsMode is the encoding used to send CloudEvents over HTTP.
+type CloudEventsMode string
+
+// Supported CloudEvents encoding modes.
+const (
+	CloudEventsModeBinary     CloudEventsMode = "binary"
+	CloudEventsModeStructured CloudEventsMode = "structured"
+	CloudEventsModeBatch      CloudEventsMode = "batch"
+)
+
+// CloudEventsBatch contains parameters used to group events in batches.
+type CloudEventsBatch struct {
+	// Maximum number of events in a batch. Defaults to 10.
+	// +optional
+	MaxSize *int32 `json:"maxSize,omitempty"`
+
+	// Maximum amount of time an event waits for its batch to be sent.
+	// Defaults to 1s.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
+// BackoffPolicyType is the type of backoff applied between retries.
+type BackoffPolicyType string
+
+// Supported backoff policies.
+const (
+	BackoffPolicyLinear      BackoffPolicyType = "linear"
+	BackoffPolicyExponential BackoffPolicyType = "exponential"
+)
+
+// CloudEventsRetry contains the retry policy for failed requests.
+type CloudEventsRetry struct {
+	// Number of retries before a request is considered failed.
+	Count int32 `json:"count"`
+
+	// Backoff policy applied between retries. Defaults to exponential.
+	// +optional
+	BackoffPolicy *BackoffPolicyType `json:"backoffPolicy,omitempty"`
+
+	// Delay used to compute the backoff between retries. Defaults to 1s.
+	// +optional
+	BackoffDelay *apis.Duration `json:"backoffDelay,omitempty"`
+
+	// HTTP status codes that trigger a retry. Defaults to 404, 413, 425,
+	// 429, 502, 503 and 504. Connection errors are always retried.
+	// +optional
+	RetryableStatusCodes []int `json:"retryableStatusCodes,omitempty"`
+}
+
+// CloudEventsCircuitBreaker contains parameters of the circuit breaker
+// protecting the remote endpoint.
+type CloudEventsCircuitBreaker struct {
+	// Number of consecutive failures that opens the circuit.
+	FailureThreshold int32 `json:"failureThreshold"`
+
+	// Amount of time requests are rejected once the circuit is open,
+	// before a new request is allowed to probe the endpoint. Defaults to 30s.
+	// +optional
+	OpenDuration *apis.Duration `json:"openDuration,omitempty"`
+}
+
+// CloudEventsCredentials to be used when sending requests.
+type CloudEventsCredentials struct {
+	// +optional
+	BasicAuth HTTPBasicAuth `json:"basicAuth,omitempty"`
+
+	// Token sent using the Bearer authentication scheme.
+	// +optional
+	BearerToken *v1alpha1.ValueFromField `json:"bearerToken,omitempty"`
+
+	// OAuth2 client credentials flow parameters.
+	// +optional
+	OAuth2 *CloudEventsOAuth2 `json:"oauth2,omitempty"`
+
+	// TLS client authentication parameters.
+	// +optional
+	TLS *CloudEventsTLS `json:"tls,omitempty"`
+}
+
+// HTTPBasicAuth credentials.
+type HTTPBasicAuth struct {
+	Username string                  `json:"username"`
+	Password v1alpha1.ValueFromField `json:"password"`
+}
+
+// CloudEventsOAuth2 contains parameters of the OAuth2 client credentials flow.
+type CloudEventsOAuth2 struct {
+	ClientID     string                  `json:"clientID"`
+	ClientSecret v1alpha1.ValueFromField `json:"clientSecret"`
+	TokenURL     pkgapis.URL             `json:"tokenURL"`
+	// +optional
+	Scopes []string `json:"scopes,omitempty"`
+}
+
+// CloudEventsTLS contains the certificates used for mutual TLS authentication.
+type CloudEventsTLS struct {
+	// PEM encoded client certificate.
+	ClientCertificate v1alpha1.ValueFromField `json:"clientCertificate"`
+	// PEM encoded client private key.
+	ClientKey v1alpha1.ValueFromField `json:"clientKey"`
+	// PEM encoded certificate of the CA used to verify the remote server.
+	// +optional
+	CACertificate *v1alpha1.ValueFromField `json:"caCertificate,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CloudEventsTargetList is a list of event target instances.
+type CloudEventsTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []CloudEventsTarget `json:"items"`
+}
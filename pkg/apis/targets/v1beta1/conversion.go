@@ -0,0 +1,1024 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSComprehendTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSComprehendTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSComprehendTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSComprehendTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSDynamoDBTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSDynamoDBTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSDynamoDBTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSDynamoDBTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSEventBridgeTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSEventBridgeTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSEventBridgeTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSEventBridgeTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSKinesisTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSKinesisTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSKinesisTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSKinesisTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSLambdaTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSLambdaTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSLambdaTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSLambdaTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSS3Target) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSS3Target:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSS3Target) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSS3Target:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSSNSTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSSNSTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSSNSTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSSNSTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AWSSQSTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AWSSQSTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AWSSQSTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AWSSQSTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureEventHubsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AzureEventHubsTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureEventHubsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AzureEventHubsTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureSentinelTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AzureSentinelTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureSentinelTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AzureSentinelTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *AzureServiceBusTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.AzureServiceBusTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *AzureServiceBusTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.AzureServiceBusTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *CloudEventsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.CloudEventsTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *CloudEventsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.CloudEventsTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *DatadogTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.DatadogTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.DatadogAPIKey = toSecretValueFromSource(t.Spec.DatadogAPIKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *DatadogTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.DatadogTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.DatadogAPIKey = fromSecretValueFromSource(hub.Spec.DatadogAPIKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *ElasticsearchTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.ElasticsearchTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.Connection.Password = toSecretValueFromSourcePtr(t.Spec.Connection.Password)
+		hub.Spec.Connection.APIKey = toSecretValueFromSourcePtr(t.Spec.Connection.APIKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *ElasticsearchTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.ElasticsearchTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.Connection.Password = fromSecretValueFromSourcePtr(hub.Spec.Connection.Password)
+		t.Spec.Connection.APIKey = fromSecretValueFromSourcePtr(hub.Spec.Connection.APIKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudFirestoreTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.GoogleCloudFirestoreTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		return toDeprecatedGoogleCredentials(&hub.ObjectMeta, &hub.Spec.Credentials, &hub.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudFirestoreTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.GoogleCloudFirestoreTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		// The deprecated credentials attribute was superseded by the
+		// "auth" attribute.
+		return fromDeprecatedGoogleCredentials(&t.ObjectMeta, hub.Spec.Credentials, &t.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudStorageTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.GoogleCloudStorageTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		return toDeprecatedGoogleCredentials(&hub.ObjectMeta, &hub.Spec.Credentials, &hub.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudStorageTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.GoogleCloudStorageTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		// The deprecated credentials attribute was superseded by the
+		// "auth" attribute.
+		return fromDeprecatedGoogleCredentials(&t.ObjectMeta, hub.Spec.Credentials, &t.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudWorkflowsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.GoogleCloudWorkflowsTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		return toDeprecatedGoogleCredentials(&hub.ObjectMeta, &hub.Spec.Credentials, &hub.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudWorkflowsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.GoogleCloudWorkflowsTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		// The deprecated credentials attribute was superseded by the
+		// "auth" attribute.
+		return fromDeprecatedGoogleCredentials(&t.ObjectMeta, hub.Spec.Credentials, &t.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleCloudPubSubTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.GoogleCloudPubSubTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		return toDeprecatedGoogleCredentials(&hub.ObjectMeta, &hub.Spec.ServiceAccountKey, &hub.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleCloudPubSubTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.GoogleCloudPubSubTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		// The deprecated credentials attribute was superseded by the
+		// "auth" attribute.
+		return fromDeprecatedGoogleCredentials(&t.ObjectMeta, hub.Spec.ServiceAccountKey, &t.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *GoogleSheetTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.GoogleSheetTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		return toDeprecatedGoogleCredentials(&hub.ObjectMeta, &hub.Spec.GoogleServiceAccount, &hub.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *GoogleSheetTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.GoogleSheetTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		// The deprecated credentials attribute was superseded by the
+		// "auth" attribute.
+		return fromDeprecatedGoogleCredentials(&t.ObjectMeta, hub.Spec.GoogleServiceAccount, &t.Spec.Auth)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *HTTPTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.HTTPTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.BasicAuthPassword = toSecretValueFromSource(t.Spec.BasicAuthPassword)
+		hub.Spec.OAuthClientSecret = toSecretValueFromSource(t.Spec.OAuthClientSecret)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *HTTPTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.HTTPTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.BasicAuthPassword = fromSecretValueFromSource(hub.Spec.BasicAuthPassword)
+		t.Spec.OAuthClientSecret = fromSecretValueFromSource(hub.Spec.OAuthClientSecret)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *IBMMQTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.IBMMQTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *IBMMQTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.IBMMQTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *JiraTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.JiraTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.Auth.Token = toSecretValueFromSource(t.Spec.Auth.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *JiraTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.JiraTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.Auth.Token = fromSecretValueFromSource(hub.Spec.Auth.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *KafkaTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.KafkaTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *KafkaTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.KafkaTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *LogzMetricsTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.LogzMetricsTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.Connection.Token = toSecretValueFromSource(t.Spec.Connection.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *LogzMetricsTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.LogzMetricsTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.Connection.Token = fromSecretValueFromSource(hub.Spec.Connection.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *LogzTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.LogzTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.ShippingToken = toSecretValueFromSource(t.Spec.ShippingToken)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *LogzTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.LogzTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.ShippingToken = fromSecretValueFromSource(hub.Spec.ShippingToken)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *MongoDBTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.MongoDBTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *MongoDBTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.MongoDBTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *OracleTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.OracleTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.OracleAPIPrivateKey = toSecretValueFromSource(t.Spec.OracleAPIPrivateKey)
+		hub.Spec.OracleAPIPrivateKeyPassphrase = toSecretValueFromSource(t.Spec.OracleAPIPrivateKeyPassphrase)
+		hub.Spec.OracleAPIPrivateKeyFingerprint = toSecretValueFromSource(t.Spec.OracleAPIPrivateKeyFingerprint)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *OracleTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.OracleTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.OracleAPIPrivateKey = fromSecretValueFromSource(hub.Spec.OracleAPIPrivateKey)
+		t.Spec.OracleAPIPrivateKeyPassphrase = fromSecretValueFromSource(hub.Spec.OracleAPIPrivateKeyPassphrase)
+		t.Spec.OracleAPIPrivateKeyFingerprint = fromSecretValueFromSource(hub.Spec.OracleAPIPrivateKeyFingerprint)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SalesforceTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.SalesforceTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.Auth.CertKey = toSecretValueFromSource(t.Spec.Auth.CertKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SalesforceTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.SalesforceTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.Auth.CertKey = fromSecretValueFromSource(hub.Spec.Auth.CertKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SendGridTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.SendGridTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.APIKey = toSecretValueFromSource(t.Spec.APIKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SendGridTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.SendGridTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.APIKey = fromSecretValueFromSource(hub.Spec.APIKey)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SlackTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.SlackTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.Token = toSecretValueFromSource(t.Spec.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SlackTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.SlackTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.Token = fromSecretValueFromSource(hub.Spec.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SolaceTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.SolaceTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SolaceTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.SolaceTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *SplunkTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.SplunkTarget:
+		return v1alpha1.ConvertViaJSON(t, hub)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *SplunkTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.SplunkTarget:
+		return v1alpha1.ConvertViaJSON(hub, t)
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *TwilioTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.TwilioTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.AccountSID = toSecretValueFromSource(t.Spec.AccountSID)
+		hub.Spec.Token = toSecretValueFromSource(t.Spec.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *TwilioTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.TwilioTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.AccountSID = fromSecretValueFromSource(hub.Spec.AccountSID)
+		t.Spec.Token = fromSecretValueFromSource(hub.Spec.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// ConvertTo implements apis.Convertible.
+func (t *ZendeskTarget) ConvertTo(ctx context.Context, to apis.Convertible) error {
+	switch hub := to.(type) {
+	case *targetsv1alpha1.ZendeskTarget:
+		if err := v1alpha1.ConvertViaJSON(t, hub); err != nil {
+			return err
+		}
+
+		hub.Spec.Token = toSecretValueFromSource(t.Spec.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", t, to)
+	}
+}
+
+// ConvertFrom implements apis.Convertible.
+func (t *ZendeskTarget) ConvertFrom(ctx context.Context, from apis.Convertible) error {
+	switch hub := from.(type) {
+	case *targetsv1alpha1.ZendeskTarget:
+		if err := v1alpha1.ConvertViaJSON(hub, t); err != nil {
+			return err
+		}
+
+		t.Spec.Token = fromSecretValueFromSource(hub.Spec.Token)
+		return nil
+	default:
+		return fmt.Errorf("unsupported conversion from %T to %T", from, t)
+	}
+}
+
+// toSecretValueFromSource converts a ValueFromField to the SecretValueFromSource
+// type of the hub version.
+func toSecretValueFromSource(vf v1alpha1.ValueFromField) targetsv1alpha1.SecretValueFromSource {
+	return targetsv1alpha1.SecretValueFromSource{
+		SecretKeyRef:      vf.ValueFromSecret,
+		Value:             vf.Value,
+		ValueFromFile:     vf.ValueFromFile,
+		ValueFromProvider: vf.ValueFromProvider,
+	}
+}
+
+// toSecretValueFromSourcePtr is like toSecretValueFromSource, for optional
+// attributes.
+func toSecretValueFromSourcePtr(vf *v1alpha1.ValueFromField) *targetsv1alpha1.SecretValueFromSource {
+	if vf == nil {
+		return nil
+	}
+
+	s := toSecretValueFromSource(*vf)
+	return &s
+}
+
+// fromSecretValueFromSource converts a SecretValueFromSource of the hub
+// version to a ValueFromField.
+func fromSecretValueFromSource(s targetsv1alpha1.SecretValueFromSource) v1alpha1.ValueFromField {
+	return s.ValueFromField()
+}
+
+// fromSecretValueFromSourcePtr is like fromSecretValueFromSource, for
+// optional attributes.
+func fromSecretValueFromSourcePtr(s *targetsv1alpha1.SecretValueFromSource) *v1alpha1.ValueFromField {
+	if s == nil {
+		return nil
+	}
+
+	vf := fromSecretValueFromSource(*s)
+	return &vf
+}
+
+// annotationDeprecatedGoogleCredentials is the annotation which preserves the
+// deprecated Google Cloud credentials attribute of the hub version, which has
+// no equivalent in this version, so that conversions back to the hub are
+// lossless.
+const annotationDeprecatedGoogleCredentials = "targets.triggermesh.io/v1alpha1-credentials"
+
+// deprecatedGoogleCredentials is the value of the
+// annotationDeprecatedGoogleCredentials annotation.
+type deprecatedGoogleCredentials struct {
+	Credentials *targetsv1alpha1.SecretValueFromSource `json:"credentials"`
+	// The "auth" attribute was created during the conversion.
+	AuthCreated bool `json:"authCreated,omitempty"`
+	// The service account key of the "auth" attribute was populated from
+	// the deprecated credentials during the conversion.
+	AuthDerived bool `json:"authDerived,omitempty"`
+}
+
+// fromDeprecatedGoogleCredentials populates the given "auth" attribute from
+// the deprecated credentials of a hub object, unless a service account key is
+// already set, and records these credentials inside the given object's
+// annotations.
+func fromDeprecatedGoogleCredentials(meta *metav1.ObjectMeta,
+	creds *targetsv1alpha1.SecretValueFromSource, auth **v1alpha1.GoogleCloudAuth) error {
+
+	if creds == nil {
+		return nil
+	}
+
+	dc := deprecatedGoogleCredentials{
+		Credentials: creds,
+	}
+
+	if creds.ValueFromField() != (v1alpha1.ValueFromField{}) {
+		if *auth == nil {
+			*auth = &v1alpha1.GoogleCloudAuth{}
+			dc.AuthCreated = true
+		}
+		if (*auth).ServiceAccountKey == nil {
+			(*auth).ServiceAccountKey = fromSecretValueFromSourcePtr(creds)
+			dc.AuthDerived = true
+		}
+	}
+
+	b, err := json.Marshal(dc)
+	if err != nil {
+		return fmt.Errorf("serializing deprecated credentials: %w", err)
+	}
+	metav1.SetMetaDataAnnotation(meta, annotationDeprecatedGoogleCredentials, string(b))
+
+	return nil
+}
+
+// toDeprecatedGoogleCredentials restores the deprecated credentials of a hub
+// object from the annotations recorded by fromDeprecatedGoogleCredentials, and
+// removes these annotations.
+//
+// If the "auth" attribute was populated from the deprecated credentials and
+// has been modified since, the deprecated credentials are discarded in favour
+// of the new "auth" attribute.
+func toDeprecatedGoogleCredentials(meta *metav1.ObjectMeta,
+	creds **targetsv1alpha1.SecretValueFromSource, auth **v1alpha1.GoogleCloudAuth) error {
+
+	val, ok := meta.Annotations[annotationDeprecatedGoogleCredentials]
+	if !ok {
+		return nil
+	}
+
+	delete(meta.Annotations, annotationDeprecatedGoogleCredentials)
+	if len(meta.Annotations) == 0 {
+		meta.Annotations = nil
+	}
+
+	var dc deprecatedGoogleCredentials
+	if err := json.Unmarshal([]byte(val), &dc); err != nil {
+		return fmt.Errorf("deserializing deprecated credentials from annotation %q: %w",
+			annotationDeprecatedGoogleCredentials, err)
+	}
+
+	if dc.AuthDerived {
+		if *auth == nil || !equality.Semantic.DeepEqual((*auth).ServiceAccountKey, fromSecretValueFromSourcePtr(dc.Credentials)) {
+			return nil
+		}
+
+		(*auth).ServiceAccountKey = nil
+		if dc.AuthCreated && equality.Semantic.DeepEqual(**auth, v1alpha1.GoogleCloudAuth{}) {
+			*auth = nil
+		}
+	}
+
+	*creds = dc.Credentials
+
+	return nil
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+func TestConvertSecretValueFromSource(t *testing.T) {
+	ctx := context.Background()
+
+	hub := &targetsv1alpha1.SlackTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "test",
+		},
+		Spec: targetsv1alpha1.SlackTargetSpec{
+			Token: targetsv1alpha1.SecretValueFromSource{
+				SecretKeyRef: secretKeySelector("slack", "token"),
+			},
+		},
+	}
+
+	var trg SlackTarget
+	require.NoError(t, hub.ConvertTo(ctx, &trg))
+
+	assert.Equal(t, "test", trg.Name)
+	assert.Equal(t, v1alpha1.ValueFromField{ValueFromSecret: secretKeySelector("slack", "token")}, trg.Spec.Token)
+
+	var roundTrip targetsv1alpha1.SlackTarget
+	require.NoError(t, roundTrip.ConvertFrom(ctx, &trg))
+
+	assert.Equal(t, hub.Spec, roundTrip.Spec)
+}
+
+func TestConvertOptionalSecretValueFromSource(t *testing.T) {
+	ctx := context.Background()
+
+	trg := &ElasticsearchTarget{
+		Spec: ElasticsearchTargetSpec{
+			Connection: Connection{
+				Addresses: []string{"https://elasticsearch:9200"},
+				Password: &v1alpha1.ValueFromField{
+					ValueFromSecret: secretKeySelector("elasticsearch", "password"),
+				},
+			},
+		},
+	}
+
+	var hub targetsv1alpha1.ElasticsearchTarget
+	require.NoError(t, hub.ConvertFrom(ctx, trg))
+
+	assert.Nil(t, hub.Spec.Connection.APIKey)
+	require.NotNil(t, hub.Spec.Connection.Password)
+	assert.Equal(t, secretKeySelector("elasticsearch", "password"), hub.Spec.Connection.Password.SecretKeyRef)
+}
+
+func TestConvertValueSources(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := map[string]v1alpha1.ValueFromField{
+		"literal value": {
+			Value: "xoxb-token",
+		},
+		"value from file": {
+			ValueFromFile: "/mnt/secrets/token",
+		},
+		"value from provider": {
+			ValueFromProvider: &v1alpha1.ProviderSecretSelector{
+				Provider: v1alpha1.SecretProviderVault,
+				Path:     "secret/data/slack",
+				Key:      "token",
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			trg := &SlackTarget{
+				Spec: SlackTargetSpec{
+					Token: tc,
+				},
+			}
+
+			var hub targetsv1alpha1.SlackTarget
+			require.NoError(t, hub.ConvertFrom(ctx, trg))
+
+			assert.Equal(t, tc, hub.Spec.Token.ValueFromField())
+
+			var roundTrip SlackTarget
+			require.NoError(t, hub.ConvertTo(ctx, &roundTrip))
+
+			assert.Equal(t, trg.Spec, roundTrip.Spec)
+
+			if ferr := trg.Validate(ctx); ferr != nil {
+				assert.NotContains(t, ferr.Error(), "Object can not be represented in the storage version of the API")
+			}
+		})
+	}
+}
+
+func TestConvertGoogleCloudCredentials(t *testing.T) {
+	ctx := context.Background()
+
+	hub := &targetsv1alpha1.GoogleCloudStorageTarget{
+		Spec: targetsv1alpha1.GoogleCloudStorageTargetSpec{
+			BucketName: "test",
+			Credentials: &targetsv1alpha1.SecretValueFromSource{
+				SecretKeyRef: secretKeySelector("gcloud", "key.json"),
+			},
+		},
+	}
+
+	var trg GoogleCloudStorageTarget
+	require.NoError(t, hub.ConvertTo(ctx, &trg))
+
+	require.NotNil(t, trg.Spec.Auth)
+	assert.Equal(t, &v1alpha1.ValueFromField{ValueFromSecret: secretKeySelector("gcloud", "key.json")},
+		trg.Spec.Auth.ServiceAccountKey)
+
+	t.Run("auth takes precedence", func(t *testing.T) {
+		hub := hub.DeepCopy()
+		hub.Spec.Auth = &v1alpha1.GoogleCloudAuth{
+			ServiceAccountKey: &v1alpha1.ValueFromField{
+				Value: "{}",
+			},
+		}
+
+		var trg GoogleCloudStorageTarget
+		require.NoError(t, hub.ConvertTo(ctx, &trg))
+
+		assert.Equal(t, &v1alpha1.ValueFromField{Value: "{}"}, trg.Spec.Auth.ServiceAccountKey)
+
+		var roundTrip targetsv1alpha1.GoogleCloudStorageTarget
+		require.NoError(t, roundTrip.ConvertFrom(ctx, &trg))
+
+		assert.Equal(t, hub.Spec, roundTrip.Spec)
+	})
+
+	t.Run("deprecated credentials are preserved", func(t *testing.T) {
+		var roundTrip targetsv1alpha1.GoogleCloudStorageTarget
+		require.NoError(t, roundTrip.ConvertFrom(ctx, &trg))
+
+		assert.Equal(t, hub.Spec, roundTrip.Spec)
+		assert.Empty(t, roundTrip.Annotations)
+	})
+
+	t.Run("modified auth supersedes deprecated credentials", func(t *testing.T) {
+		trg := trg.DeepCopy()
+		trg.Spec.Auth.ServiceAccountKey = &v1alpha1.ValueFromField{
+			ValueFromSecret: secretKeySelector("gcloud-new", "key.json"),
+		}
+
+		var roundTrip targetsv1alpha1.GoogleCloudStorageTarget
+		require.NoError(t, roundTrip.ConvertFrom(ctx, trg))
+
+		assert.Nil(t, roundTrip.Spec.Credentials)
+		assert.Equal(t, trg.Spec.Auth, roundTrip.Spec.Auth)
+	})
+}
+
+func TestConvertUnsupportedType(t *testing.T) {
+	ctx := context.Background()
+
+	trg := &SlackTarget{}
+
+	assert.Error(t, trg.ConvertTo(ctx, &targetsv1alpha1.ZendeskTarget{}))
+	assert.Error(t, trg.ConvertFrom(ctx, &targetsv1alpha1.ZendeskTarget{}))
+	assert.Error(t, (&targetsv1alpha1.SlackTarget{}).ConvertTo(ctx, &targetsv1alpha1.SlackTarget{}))
+}
+
+func secretKeySelector(name, key string) *corev1.SecretKeySelector {
+	return &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{
+			Name: name,
+		},
+		Key: key,
+	}
+}
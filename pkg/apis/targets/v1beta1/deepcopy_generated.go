@@ -0,0 +1,4452 @@
+//go:build !ignore_autogenerated
+// +build !ignore_autogenerated
+
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by deepcopy-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	apis "github.com/triggermesh/triggermesh/pkg/apis"
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	cloudevents "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+)
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSComprehendTarget) DeepCopyInto(out *AWSComprehendTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSComprehendTarget.
+func (in *AWSComprehendTarget) DeepCopy() *AWSComprehendTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSComprehendTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSComprehendTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSComprehendTargetList) DeepCopyInto(out *AWSComprehendTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSComprehendTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSComprehendTargetList.
+func (in *AWSComprehendTargetList) DeepCopy() *AWSComprehendTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSComprehendTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSComprehendTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSComprehendTargetSpec) DeepCopyInto(out *AWSComprehendTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSComprehendTargetSpec.
+func (in *AWSComprehendTargetSpec) DeepCopy() *AWSComprehendTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSComprehendTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBKeyAttribute) DeepCopyInto(out *AWSDynamoDBKeyAttribute) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(AWSDynamoDBKeyAttributeType)
+		**out = **in
+	}
+	if in.Attribute != nil {
+		in, out := &in.Attribute, &out.Attribute
+		*out = new(string)
+		**out = **in
+	}
+	if in.DataPath != nil {
+		in, out := &in.DataPath, &out.DataPath
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBKeyAttribute.
+func (in *AWSDynamoDBKeyAttribute) DeepCopy() *AWSDynamoDBKeyAttribute {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBKeyAttribute)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBTarget) DeepCopyInto(out *AWSDynamoDBTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBTarget.
+func (in *AWSDynamoDBTarget) DeepCopy() *AWSDynamoDBTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSDynamoDBTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBTargetList) DeepCopyInto(out *AWSDynamoDBTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSDynamoDBTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBTargetList.
+func (in *AWSDynamoDBTargetList) DeepCopy() *AWSDynamoDBTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSDynamoDBTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSDynamoDBTargetSpec) DeepCopyInto(out *AWSDynamoDBTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = make([]AWSDynamoDBKeyAttribute, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSDynamoDBTargetSpec.
+func (in *AWSDynamoDBTargetSpec) DeepCopy() *AWSDynamoDBTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSDynamoDBTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeBatching) DeepCopyInto(out *AWSEventBridgeBatching) {
+	*out = *in
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeBatching.
+func (in *AWSEventBridgeBatching) DeepCopy() *AWSEventBridgeBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeMapping) DeepCopyInto(out *AWSEventBridgeMapping) {
+	*out = *in
+	if in.DetailType != nil {
+		in, out := &in.DetailType, &out.DetailType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Source != nil {
+		in, out := &in.Source, &out.Source
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EventBusName != nil {
+		in, out := &in.EventBusName, &out.EventBusName
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeMapping.
+func (in *AWSEventBridgeMapping) DeepCopy() *AWSEventBridgeMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeTarget) DeepCopyInto(out *AWSEventBridgeTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeTarget.
+func (in *AWSEventBridgeTarget) DeepCopy() *AWSEventBridgeTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSEventBridgeTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeTargetList) DeepCopyInto(out *AWSEventBridgeTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSEventBridgeTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeTargetList.
+func (in *AWSEventBridgeTargetList) DeepCopy() *AWSEventBridgeTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSEventBridgeTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSEventBridgeTargetSpec) DeepCopyInto(out *AWSEventBridgeTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(AWSEventBridgeMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSEventBridgeBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSEventBridgeTargetSpec.
+func (in *AWSEventBridgeTargetSpec) DeepCopy() *AWSEventBridgeTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSEventBridgeTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisBatching) DeepCopyInto(out *AWSKinesisBatching) {
+	*out = *in
+	if in.MaxRecords != nil {
+		in, out := &in.MaxRecords, &out.MaxRecords
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisBatching.
+func (in *AWSKinesisBatching) DeepCopy() *AWSKinesisBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisTarget) DeepCopyInto(out *AWSKinesisTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisTarget.
+func (in *AWSKinesisTarget) DeepCopy() *AWSKinesisTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSKinesisTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisTargetList) DeepCopyInto(out *AWSKinesisTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSKinesisTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisTargetList.
+func (in *AWSKinesisTargetList) DeepCopy() *AWSKinesisTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSKinesisTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSKinesisTargetSpec) DeepCopyInto(out *AWSKinesisTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PartitionKey != nil {
+		in, out := &in.PartitionKey, &out.PartitionKey
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExplicitHashKey != nil {
+		in, out := &in.ExplicitHashKey, &out.ExplicitHashKey
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSKinesisBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSKinesisTargetSpec.
+func (in *AWSKinesisTargetSpec) DeepCopy() *AWSKinesisTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSKinesisTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLambdaTarget) DeepCopyInto(out *AWSLambdaTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLambdaTarget.
+func (in *AWSLambdaTarget) DeepCopy() *AWSLambdaTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLambdaTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSLambdaTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLambdaTargetList) DeepCopyInto(out *AWSLambdaTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSLambdaTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLambdaTargetList.
+func (in *AWSLambdaTargetList) DeepCopy() *AWSLambdaTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLambdaTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSLambdaTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSLambdaTargetSpec) DeepCopyInto(out *AWSLambdaTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.InvocationType != nil {
+		in, out := &in.InvocationType, &out.InvocationType
+		*out = new(AWSLambdaInvocationType)
+		**out = **in
+	}
+	if in.Qualifier != nil {
+		in, out := &in.Qualifier, &out.Qualifier
+		*out = new(string)
+		**out = **in
+	}
+	if in.ClientContext != nil {
+		in, out := &in.ClientContext, &out.ClientContext
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSLambdaTargetSpec.
+func (in *AWSLambdaTargetSpec) DeepCopy() *AWSLambdaTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSLambdaTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3Target) DeepCopyInto(out *AWSS3Target) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3Target.
+func (in *AWSS3Target) DeepCopy() *AWSS3Target {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3Target)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSS3Target) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3TargetList) DeepCopyInto(out *AWSS3TargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSS3Target, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3TargetList.
+func (in *AWSS3TargetList) DeepCopy() *AWSS3TargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3TargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSS3TargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSS3TargetSpec) DeepCopyInto(out *AWSS3TargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSS3TargetSpec.
+func (in *AWSS3TargetSpec) DeepCopy() *AWSS3TargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSS3TargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSSMSOptions) DeepCopyInto(out *AWSSNSSMSOptions) {
+	*out = *in
+	in.PhoneNumberFrom.DeepCopyInto(&out.PhoneNumberFrom)
+	if in.MessageFrom != nil {
+		in, out := &in.MessageFrom, &out.MessageFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SenderID != nil {
+		in, out := &in.SenderID, &out.SenderID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(AWSSNSSMSType)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSSMSOptions.
+func (in *AWSSNSSMSOptions) DeepCopy() *AWSSNSSMSOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSSMSOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSTarget) DeepCopyInto(out *AWSSNSTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSTarget.
+func (in *AWSSNSTarget) DeepCopy() *AWSSNSTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSNSTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSTargetList) DeepCopyInto(out *AWSSNSTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSNSTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSTargetList.
+func (in *AWSSNSTargetList) DeepCopy() *AWSSNSTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSNSTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSNSTargetSpec) DeepCopyInto(out *AWSSNSTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageGroupIDFrom != nil {
+		in, out := &in.MessageGroupIDFrom, &out.MessageGroupIDFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeduplicationIDFrom != nil {
+		in, out := &in.DeduplicationIDFrom, &out.DeduplicationIDFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SubjectFrom != nil {
+		in, out := &in.SubjectFrom, &out.SubjectFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ProtocolMessages != nil {
+		in, out := &in.ProtocolMessages, &out.ProtocolMessages
+		*out = make(map[string]v1alpha1.EventKeySource, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
+	if in.SMS != nil {
+		in, out := &in.SMS, &out.SMS
+		*out = new(AWSSNSSMSOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSNSTargetSpec.
+func (in *AWSSNSTargetSpec) DeepCopy() *AWSSNSTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSNSTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSBatching) DeepCopyInto(out *AWSSQSBatching) {
+	*out = *in
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSBatching.
+func (in *AWSSQSBatching) DeepCopy() *AWSSQSBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSTarget) DeepCopyInto(out *AWSSQSTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSTarget.
+func (in *AWSSQSTarget) DeepCopy() *AWSSQSTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSQSTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSTargetList) DeepCopyInto(out *AWSSQSTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AWSSQSTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSTargetList.
+func (in *AWSSQSTargetList) DeepCopy() *AWSSQSTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AWSSQSTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AWSSQSTargetSpec) DeepCopyInto(out *AWSSQSTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.Endpoint != nil {
+		in, out := &in.Endpoint, &out.Endpoint
+		*out = new(v1alpha1.AWSEndpoint)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageGroupIDFrom != nil {
+		in, out := &in.MessageGroupIDFrom, &out.MessageGroupIDFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DeduplicationIDFrom != nil {
+		in, out := &in.DeduplicationIDFrom, &out.DeduplicationIDFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DelaySeconds != nil {
+		in, out := &in.DelaySeconds, &out.DelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DelaySecondsFrom != nil {
+		in, out := &in.DelaySecondsFrom, &out.DelaySecondsFrom
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(AWSSQSBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AWSSQSTargetSpec.
+func (in *AWSSQSTargetSpec) DeepCopy() *AWSSQSTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AWSSQSTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureAuth) DeepCopyInto(out *AzureAuth) {
+	*out = *in
+	if in.ServicePrincipal != nil {
+		in, out := &in.ServicePrincipal, &out.ServicePrincipal
+		*out = new(AzureServicePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASToken != nil {
+		in, out := &in.SASToken, &out.SASToken
+		*out = new(AzureSASToken)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureAuth.
+func (in *AzureAuth) DeepCopy() *AzureAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubsTarget) DeepCopyInto(out *AzureEventHubsTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubsTarget.
+func (in *AzureEventHubsTarget) DeepCopy() *AzureEventHubsTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubsTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureEventHubsTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubsTargetList) DeepCopyInto(out *AzureEventHubsTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureEventHubsTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubsTargetList.
+func (in *AzureEventHubsTargetList) DeepCopy() *AzureEventHubsTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubsTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureEventHubsTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureEventHubsTargetSpec) DeepCopyInto(out *AzureEventHubsTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	out.EventHubID = in.EventHubID
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureEventHubsTargetSpec.
+func (in *AzureEventHubsTargetSpec) DeepCopy() *AzureEventHubsTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureEventHubsTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureResourceID) DeepCopyInto(out *AzureResourceID) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureResourceID.
+func (in *AzureResourceID) DeepCopy() *AzureResourceID {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureResourceID)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSASToken) DeepCopyInto(out *AzureSASToken) {
+	*out = *in
+	in.KeyName.DeepCopyInto(&out.KeyName)
+	in.KeyValue.DeepCopyInto(&out.KeyValue)
+	in.ConnectionString.DeepCopyInto(&out.ConnectionString)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSASToken.
+func (in *AzureSASToken) DeepCopy() *AzureSASToken {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSASToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSentinelTarget) DeepCopyInto(out *AzureSentinelTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSentinelTarget.
+func (in *AzureSentinelTarget) DeepCopy() *AzureSentinelTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSentinelTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureSentinelTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSentinelTargetList) DeepCopyInto(out *AzureSentinelTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureSentinelTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSentinelTargetList.
+func (in *AzureSentinelTargetList) DeepCopy() *AzureSentinelTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSentinelTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureSentinelTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureSentinelTargetSpec) DeepCopyInto(out *AzureSentinelTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureSentinelTargetSpec.
+func (in *AzureSentinelTargetSpec) DeepCopy() *AzureSentinelTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureSentinelTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTarget) DeepCopyInto(out *AzureServiceBusTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTarget.
+func (in *AzureServiceBusTarget) DeepCopy() *AzureServiceBusTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTargetList) DeepCopyInto(out *AzureServiceBusTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AzureServiceBusTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTargetList.
+func (in *AzureServiceBusTargetList) DeepCopy() *AzureServiceBusTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AzureServiceBusTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServiceBusTargetSpec) DeepCopyInto(out *AzureServiceBusTargetSpec) {
+	*out = *in
+	if in.TopicID != nil {
+		in, out := &in.TopicID, &out.TopicID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	if in.QueueID != nil {
+		in, out := &in.QueueID, &out.QueueID
+		*out = new(AzureResourceID)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.WebSocketsEnable != nil {
+		in, out := &in.WebSocketsEnable, &out.WebSocketsEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServiceBusTargetSpec.
+func (in *AzureServiceBusTargetSpec) DeepCopy() *AzureServiceBusTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServiceBusTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AzureServicePrincipal) DeepCopyInto(out *AzureServicePrincipal) {
+	*out = *in
+	in.TenantID.DeepCopyInto(&out.TenantID)
+	in.ClientID.DeepCopyInto(&out.ClientID)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureServicePrincipal.
+func (in *AzureServicePrincipal) DeepCopy() *AzureServicePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(AzureServicePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsBatch) DeepCopyInto(out *CloudEventsBatch) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsBatch.
+func (in *CloudEventsBatch) DeepCopy() *CloudEventsBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsCircuitBreaker) DeepCopyInto(out *CloudEventsCircuitBreaker) {
+	*out = *in
+	if in.OpenDuration != nil {
+		in, out := &in.OpenDuration, &out.OpenDuration
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsCircuitBreaker.
+func (in *CloudEventsCircuitBreaker) DeepCopy() *CloudEventsCircuitBreaker {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsCircuitBreaker)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsCredentials) DeepCopyInto(out *CloudEventsCredentials) {
+	*out = *in
+	in.BasicAuth.DeepCopyInto(&out.BasicAuth)
+	if in.BearerToken != nil {
+		in, out := &in.BearerToken, &out.BearerToken
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OAuth2 != nil {
+		in, out := &in.OAuth2, &out.OAuth2
+		*out = new(CloudEventsOAuth2)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(CloudEventsTLS)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsCredentials.
+func (in *CloudEventsCredentials) DeepCopy() *CloudEventsCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsOAuth2) DeepCopyInto(out *CloudEventsOAuth2) {
+	*out = *in
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	in.TokenURL.DeepCopyInto(&out.TokenURL)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsOAuth2.
+func (in *CloudEventsOAuth2) DeepCopy() *CloudEventsOAuth2 {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsOAuth2)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsRetry) DeepCopyInto(out *CloudEventsRetry) {
+	*out = *in
+	if in.BackoffPolicy != nil {
+		in, out := &in.BackoffPolicy, &out.BackoffPolicy
+		*out = new(BackoffPolicyType)
+		**out = **in
+	}
+	if in.BackoffDelay != nil {
+		in, out := &in.BackoffDelay, &out.BackoffDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.RetryableStatusCodes != nil {
+		in, out := &in.RetryableStatusCodes, &out.RetryableStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsRetry.
+func (in *CloudEventsRetry) DeepCopy() *CloudEventsRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsTLS) DeepCopyInto(out *CloudEventsTLS) {
+	*out = *in
+	in.ClientCertificate.DeepCopyInto(&out.ClientCertificate)
+	in.ClientKey.DeepCopyInto(&out.ClientKey)
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsTLS.
+func (in *CloudEventsTLS) DeepCopy() *CloudEventsTLS {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsTLS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsTarget) DeepCopyInto(out *CloudEventsTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsTarget.
+func (in *CloudEventsTarget) DeepCopy() *CloudEventsTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudEventsTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsTargetList) DeepCopyInto(out *CloudEventsTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CloudEventsTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsTargetList.
+func (in *CloudEventsTargetList) DeepCopy() *CloudEventsTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CloudEventsTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloudEventsTargetSpec) DeepCopyInto(out *CloudEventsTargetSpec) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = new(CloudEventsCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(string)
+		**out = **in
+	}
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Mode != nil {
+		in, out := &in.Mode, &out.Mode
+		*out = new(CloudEventsMode)
+		**out = **in
+	}
+	if in.Batch != nil {
+		in, out := &in.Batch, &out.Batch
+		*out = new(CloudEventsBatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(CloudEventsRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CircuitBreaker != nil {
+		in, out := &in.CircuitBreaker, &out.CircuitBreaker
+		*out = new(CloudEventsCircuitBreaker)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloudEventsTargetSpec.
+func (in *CloudEventsTargetSpec) DeepCopy() *CloudEventsTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloudEventsTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Connection) DeepCopyInto(out *Connection) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.CACert != nil {
+		in, out := &in.CACert, &out.CACert
+		*out = new(string)
+		**out = **in
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Connection.
+func (in *Connection) DeepCopy() *Connection {
+	if in == nil {
+		return nil
+	}
+	out := new(Connection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Credentials) DeepCopyInto(out *Credentials) {
+	*out = *in
+	in.User.DeepCopyInto(&out.User)
+	in.Password.DeepCopyInto(&out.Password)
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(TLSSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Credentials.
+func (in *Credentials) DeepCopy() *Credentials {
+	if in == nil {
+		return nil
+	}
+	out := new(Credentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTarget) DeepCopyInto(out *DatadogTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTarget.
+func (in *DatadogTarget) DeepCopy() *DatadogTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTargetList) DeepCopyInto(out *DatadogTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatadogTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTargetList.
+func (in *DatadogTargetList) DeepCopy() *DatadogTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatadogTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatadogTargetSpec) DeepCopyInto(out *DatadogTargetSpec) {
+	*out = *in
+	in.DatadogAPIKey.DeepCopyInto(&out.DatadogAPIKey)
+	if in.DatadogSite != nil {
+		in, out := &in.DatadogSite, &out.DatadogSite
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricPrefix != nil {
+		in, out := &in.MetricPrefix, &out.MetricPrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatadogTargetSpec.
+func (in *DatadogTargetSpec) DeepCopy() *DatadogTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatadogTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTarget) DeepCopyInto(out *ElasticsearchTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTarget.
+func (in *ElasticsearchTarget) DeepCopy() *ElasticsearchTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElasticsearchTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTargetList) DeepCopyInto(out *ElasticsearchTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ElasticsearchTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTargetList.
+func (in *ElasticsearchTargetList) DeepCopy() *ElasticsearchTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ElasticsearchTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ElasticsearchTargetSpec) DeepCopyInto(out *ElasticsearchTargetSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ElasticsearchTargetSpec.
+func (in *ElasticsearchTargetSpec) DeepCopy() *ElasticsearchTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ElasticsearchTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventOptions) DeepCopyInto(out *EventOptions) {
+	*out = *in
+	if in.PayloadPolicy != nil {
+		in, out := &in.PayloadPolicy, &out.PayloadPolicy
+		*out = new(cloudevents.PayloadPolicy)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventOptions.
+func (in *EventOptions) DeepCopy() *EventOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(EventOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GCloudResourceName) DeepCopyInto(out *GCloudResourceName) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GCloudResourceName.
+func (in *GCloudResourceName) DeepCopy() *GCloudResourceName {
+	if in == nil {
+		return nil
+	}
+	out := new(GCloudResourceName)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudFirestoreTarget) DeepCopyInto(out *GoogleCloudFirestoreTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudFirestoreTarget.
+func (in *GoogleCloudFirestoreTarget) DeepCopy() *GoogleCloudFirestoreTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudFirestoreTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudFirestoreTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudFirestoreTargetList) DeepCopyInto(out *GoogleCloudFirestoreTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudFirestoreTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudFirestoreTargetList.
+func (in *GoogleCloudFirestoreTargetList) DeepCopy() *GoogleCloudFirestoreTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudFirestoreTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudFirestoreTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudFirestoreTargetSpec) DeepCopyInto(out *GoogleCloudFirestoreTargetSpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1alpha1.GoogleCloudAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudFirestoreTargetSpec.
+func (in *GoogleCloudFirestoreTargetSpec) DeepCopy() *GoogleCloudFirestoreTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudFirestoreTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubBatching) DeepCopyInto(out *GoogleCloudPubSubBatching) {
+	*out = *in
+	if in.MaxMessages != nil {
+		in, out := &in.MaxMessages, &out.MaxMessages
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxBytes != nil {
+		in, out := &in.MaxBytes, &out.MaxBytes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelay != nil {
+		in, out := &in.MaxDelay, &out.MaxDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubBatching.
+func (in *GoogleCloudPubSubBatching) DeepCopy() *GoogleCloudPubSubBatching {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubBatching)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubTarget) DeepCopyInto(out *GoogleCloudPubSubTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubTarget.
+func (in *GoogleCloudPubSubTarget) DeepCopy() *GoogleCloudPubSubTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudPubSubTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubTargetList) DeepCopyInto(out *GoogleCloudPubSubTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudPubSubTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubTargetList.
+func (in *GoogleCloudPubSubTargetList) DeepCopy() *GoogleCloudPubSubTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudPubSubTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubTargetSpec) DeepCopyInto(out *GoogleCloudPubSubTargetSpec) {
+	*out = *in
+	out.Topic = in.Topic
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1alpha1.GoogleCloudAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.OrderingKey != nil {
+		in, out := &in.OrderingKey, &out.OrderingKey
+		*out = new(v1alpha1.EventKeySource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MessageAttributes != nil {
+		in, out := &in.MessageAttributes, &out.MessageAttributes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Binding != nil {
+		in, out := &in.Binding, &out.Binding
+		*out = new(GoogleCloudPubSubBindingMode)
+		**out = **in
+	}
+	if in.Batching != nil {
+		in, out := &in.Batching, &out.Batching
+		*out = new(GoogleCloudPubSubBatching)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubTargetSpec.
+func (in *GoogleCloudPubSubTargetSpec) DeepCopy() *GoogleCloudPubSubTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudPubSubTargetStatus) DeepCopyInto(out *GoogleCloudPubSubTargetStatus) {
+	*out = *in
+	in.Status.DeepCopyInto(&out.Status)
+	in.AddressStatus.DeepCopyInto(&out.AddressStatus)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudPubSubTargetStatus.
+func (in *GoogleCloudPubSubTargetStatus) DeepCopy() *GoogleCloudPubSubTargetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudPubSubTargetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageTarget) DeepCopyInto(out *GoogleCloudStorageTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageTarget.
+func (in *GoogleCloudStorageTarget) DeepCopy() *GoogleCloudStorageTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudStorageTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageTargetList) DeepCopyInto(out *GoogleCloudStorageTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudStorageTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageTargetList.
+func (in *GoogleCloudStorageTargetList) DeepCopy() *GoogleCloudStorageTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudStorageTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudStorageTargetSpec) DeepCopyInto(out *GoogleCloudStorageTargetSpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1alpha1.GoogleCloudAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudStorageTargetSpec.
+func (in *GoogleCloudStorageTargetSpec) DeepCopy() *GoogleCloudStorageTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudStorageTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudWorkflowsTarget) DeepCopyInto(out *GoogleCloudWorkflowsTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudWorkflowsTarget.
+func (in *GoogleCloudWorkflowsTarget) DeepCopy() *GoogleCloudWorkflowsTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudWorkflowsTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudWorkflowsTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudWorkflowsTargetList) DeepCopyInto(out *GoogleCloudWorkflowsTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleCloudWorkflowsTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudWorkflowsTargetList.
+func (in *GoogleCloudWorkflowsTargetList) DeepCopy() *GoogleCloudWorkflowsTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudWorkflowsTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleCloudWorkflowsTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleCloudWorkflowsTargetSpec) DeepCopyInto(out *GoogleCloudWorkflowsTargetSpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1alpha1.GoogleCloudAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleCloudWorkflowsTargetSpec.
+func (in *GoogleCloudWorkflowsTargetSpec) DeepCopy() *GoogleCloudWorkflowsTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleCloudWorkflowsTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleSheetTarget) DeepCopyInto(out *GoogleSheetTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleSheetTarget.
+func (in *GoogleSheetTarget) DeepCopy() *GoogleSheetTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleSheetTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleSheetTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleSheetTargetList) DeepCopyInto(out *GoogleSheetTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleSheetTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleSheetTargetList.
+func (in *GoogleSheetTargetList) DeepCopy() *GoogleSheetTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleSheetTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleSheetTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleSheetTargetSpec) DeepCopyInto(out *GoogleSheetTargetSpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1alpha1.GoogleCloudAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleSheetTargetSpec.
+func (in *GoogleSheetTargetSpec) DeepCopy() *GoogleSheetTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleSheetTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPBasicAuth) DeepCopyInto(out *HTTPBasicAuth) {
+	*out = *in
+	in.Password.DeepCopyInto(&out.Password)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPBasicAuth.
+func (in *HTTPBasicAuth) DeepCopy() *HTTPBasicAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPBasicAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPEventResponse) DeepCopyInto(out *HTTPEventResponse) {
+	*out = *in
+	if in.Mapping != nil {
+		in, out := &in.Mapping, &out.Mapping
+		*out = new(HTTPResponseMapping)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPEventResponse.
+func (in *HTTPEventResponse) DeepCopy() *HTTPEventResponse {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPEventResponse)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRateLimit) DeepCopyInto(out *HTTPRateLimit) {
+	*out = *in
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRateLimit.
+func (in *HTTPRateLimit) DeepCopy() *HTTPRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRequestTemplate) DeepCopyInto(out *HTTPRequestTemplate) {
+	*out = *in
+	if in.Language != nil {
+		in, out := &in.Language, &out.Language
+		*out = new(HTTPTemplateLanguage)
+		**out = **in
+	}
+	if in.URL != nil {
+		in, out := &in.URL, &out.URL
+		*out = new(string)
+		**out = **in
+	}
+	if in.Method != nil {
+		in, out := &in.Method, &out.Method
+		*out = new(string)
+		**out = **in
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRequestTemplate.
+func (in *HTTPRequestTemplate) DeepCopy() *HTTPRequestTemplate {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRequestTemplate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPResponseMapping) DeepCopyInto(out *HTTPResponseMapping) {
+	*out = *in
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = new(string)
+		**out = **in
+	}
+	if in.StatusCodeTypes != nil {
+		in, out := &in.StatusCodeTypes, &out.StatusCodeTypes
+		*out = make([]HTTPStatusCodeEventType, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPResponseMapping.
+func (in *HTTPResponseMapping) DeepCopy() *HTTPResponseMapping {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPResponseMapping)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRetry) DeepCopyInto(out *HTTPRetry) {
+	*out = *in
+	if in.BackoffDelay != nil {
+		in, out := &in.BackoffDelay, &out.BackoffDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.MaxBackoffDelay != nil {
+		in, out := &in.MaxBackoffDelay, &out.MaxBackoffDelay
+		*out = new(apis.Duration)
+		**out = **in
+	}
+	if in.RetryableStatusCodes != nil {
+		in, out := &in.RetryableStatusCodes, &out.RetryableStatusCodes
+		*out = make([]int, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRetry.
+func (in *HTTPRetry) DeepCopy() *HTTPRetry {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRetry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPStatusCodeEventType) DeepCopyInto(out *HTTPStatusCodeEventType) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPStatusCodeEventType.
+func (in *HTTPStatusCodeEventType) DeepCopy() *HTTPStatusCodeEventType {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPStatusCodeEventType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTarget) DeepCopyInto(out *HTTPTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTarget.
+func (in *HTTPTarget) DeepCopy() *HTTPTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTargetList) DeepCopyInto(out *HTTPTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTTPTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTargetList.
+func (in *HTTPTargetList) DeepCopy() *HTTPTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTTPTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPTargetSpec) DeepCopyInto(out *HTTPTargetSpec) {
+	*out = *in
+	in.Response.DeepCopyInto(&out.Response)
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Template != nil {
+		in, out := &in.Template, &out.Template
+		*out = new(HTTPRequestTemplate)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CACertificate != nil {
+		in, out := &in.CACertificate, &out.CACertificate
+		*out = new(string)
+		**out = **in
+	}
+	if in.BasicAuthUsername != nil {
+		in, out := &in.BasicAuthUsername, &out.BasicAuthUsername
+		*out = new(string)
+		**out = **in
+	}
+	in.BasicAuthPassword.DeepCopyInto(&out.BasicAuthPassword)
+	if in.OAuthClientID != nil {
+		in, out := &in.OAuthClientID, &out.OAuthClientID
+		*out = new(string)
+		**out = **in
+	}
+	in.OAuthClientSecret.DeepCopyInto(&out.OAuthClientSecret)
+	if in.OAuthTokenURL != nil {
+		in, out := &in.OAuthTokenURL, &out.OAuthTokenURL
+		*out = new(string)
+		**out = **in
+	}
+	if in.OAuthScopes != nil {
+		in, out := &in.OAuthScopes, &out.OAuthScopes
+		*out = new([]string)
+		if **in != nil {
+			in, out := *in, *out
+			*out = make([]string, len(*in))
+			copy(*out, *in)
+		}
+	}
+	if in.Retry != nil {
+		in, out := &in.Retry, &out.Retry
+		*out = new(HTTPRetry)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(HTTPRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrentRequests != nil {
+		in, out := &in.MaxConcurrentRequests, &out.MaxConcurrentRequests
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IdempotencyKeyHeader != nil {
+		in, out := &in.IdempotencyKeyHeader, &out.IdempotencyKeyHeader
+		*out = new(string)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPTargetSpec.
+func (in *HTTPTargetSpec) DeepCopy() *HTTPTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMMQTarget) DeepCopyInto(out *IBMMQTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMMQTarget.
+func (in *IBMMQTarget) DeepCopy() *IBMMQTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMMQTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMMQTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMMQTargetList) DeepCopyInto(out *IBMMQTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]IBMMQTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMMQTargetList.
+func (in *IBMMQTargetList) DeepCopy() *IBMMQTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMMQTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IBMMQTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IBMMQTargetSpec) DeepCopyInto(out *IBMMQTargetSpec) {
+	*out = *in
+	if in.ReplyTo != nil {
+		in, out := &in.ReplyTo, &out.ReplyTo
+		*out = new(MQReplyOptions)
+		**out = **in
+	}
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IBMMQTargetSpec.
+func (in *IBMMQTargetSpec) DeepCopy() *IBMMQTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IBMMQTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Instrument) DeepCopyInto(out *Instrument) {
+	*out = *in
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Instrument.
+func (in *Instrument) DeepCopy() *Instrument {
+	if in == nil {
+		return nil
+	}
+	out := new(Instrument)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraAuth) DeepCopyInto(out *JiraAuth) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraAuth.
+func (in *JiraAuth) DeepCopy() *JiraAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraTarget) DeepCopyInto(out *JiraTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraTarget.
+func (in *JiraTarget) DeepCopy() *JiraTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JiraTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraTargetList) DeepCopyInto(out *JiraTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]JiraTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraTargetList.
+func (in *JiraTargetList) DeepCopy() *JiraTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *JiraTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JiraTargetSpec) DeepCopyInto(out *JiraTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JiraTargetSpec.
+func (in *JiraTargetSpec) DeepCopy() *JiraTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(JiraTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTarget) DeepCopyInto(out *KafkaTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTarget.
+func (in *KafkaTarget) DeepCopy() *KafkaTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTargetAuth) DeepCopyInto(out *KafkaTargetAuth) {
+	*out = *in
+	if in.Kerberos != nil {
+		in, out := &in.Kerberos, &out.Kerberos
+		*out = new(KafkaTargetKerberos)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(KafkaTargetTLSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TLSEnable != nil {
+		in, out := &in.TLSEnable, &out.TLSEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.SecurityMechanisms != nil {
+		in, out := &in.SecurityMechanisms, &out.SecurityMechanisms
+		*out = new(string)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTargetAuth.
+func (in *KafkaTargetAuth) DeepCopy() *KafkaTargetAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTargetAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTargetKerberos) DeepCopyInto(out *KafkaTargetKerberos) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ServiceName != nil {
+		in, out := &in.ServiceName, &out.ServiceName
+		*out = new(string)
+		**out = **in
+	}
+	if in.ConfigPath != nil {
+		in, out := &in.ConfigPath, &out.ConfigPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.KeytabPath != nil {
+		in, out := &in.KeytabPath, &out.KeytabPath
+		*out = new(string)
+		**out = **in
+	}
+	if in.Config != nil {
+		in, out := &in.Config, &out.Config
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Keytab != nil {
+		in, out := &in.Keytab, &out.Keytab
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Realm != nil {
+		in, out := &in.Realm, &out.Realm
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTargetKerberos.
+func (in *KafkaTargetKerberos) DeepCopy() *KafkaTargetKerberos {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTargetKerberos)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTargetList) DeepCopyInto(out *KafkaTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KafkaTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTargetList.
+func (in *KafkaTargetList) DeepCopy() *KafkaTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KafkaTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTargetSpec) DeepCopyInto(out *KafkaTargetSpec) {
+	*out = *in
+	if in.TopicReplicationFactor != nil {
+		in, out := &in.TopicReplicationFactor, &out.TopicReplicationFactor
+		*out = new(int16)
+		**out = **in
+	}
+	if in.TopicPartitions != nil {
+		in, out := &in.TopicPartitions, &out.TopicPartitions
+		*out = new(int32)
+		**out = **in
+	}
+	if in.BootstrapServers != nil {
+		in, out := &in.BootstrapServers, &out.BootstrapServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(KafkaTargetAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTargetSpec.
+func (in *KafkaTargetSpec) DeepCopy() *KafkaTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KafkaTargetTLSAuth) DeepCopyInto(out *KafkaTargetTLSAuth) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCert != nil {
+		in, out := &in.ClientCert, &out.ClientCert
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKey != nil {
+		in, out := &in.ClientKey, &out.ClientKey
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KafkaTargetTLSAuth.
+func (in *KafkaTargetTLSAuth) DeepCopy() *KafkaTargetTLSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(KafkaTargetTLSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Keystore) DeepCopyInto(out *Keystore) {
+	*out = *in
+	in.KeyDatabase.DeepCopyInto(&out.KeyDatabase)
+	in.PasswordStash.DeepCopyInto(&out.PasswordStash)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Keystore.
+func (in *Keystore) DeepCopy() *Keystore {
+	if in == nil {
+		return nil
+	}
+	out := new(Keystore)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzMetricsConnection) DeepCopyInto(out *LogzMetricsConnection) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzMetricsConnection.
+func (in *LogzMetricsConnection) DeepCopy() *LogzMetricsConnection {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzMetricsConnection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzMetricsTarget) DeepCopyInto(out *LogzMetricsTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzMetricsTarget.
+func (in *LogzMetricsTarget) DeepCopy() *LogzMetricsTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzMetricsTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogzMetricsTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzMetricsTargetList) DeepCopyInto(out *LogzMetricsTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogzMetricsTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzMetricsTargetList.
+func (in *LogzMetricsTargetList) DeepCopy() *LogzMetricsTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzMetricsTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogzMetricsTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzMetricsTargetSpec) DeepCopyInto(out *LogzMetricsTargetSpec) {
+	*out = *in
+	in.Connection.DeepCopyInto(&out.Connection)
+	if in.Instruments != nil {
+		in, out := &in.Instruments, &out.Instruments
+		*out = make([]Instrument, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzMetricsTargetSpec.
+func (in *LogzMetricsTargetSpec) DeepCopy() *LogzMetricsTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzMetricsTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzTarget) DeepCopyInto(out *LogzTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzTarget.
+func (in *LogzTarget) DeepCopy() *LogzTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogzTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzTargetList) DeepCopyInto(out *LogzTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LogzTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzTargetList.
+func (in *LogzTargetList) DeepCopy() *LogzTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LogzTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LogzTargetSpec) DeepCopyInto(out *LogzTargetSpec) {
+	*out = *in
+	in.ShippingToken.DeepCopyInto(&out.ShippingToken)
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LogzTargetSpec.
+func (in *LogzTargetSpec) DeepCopy() *LogzTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LogzTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MQReplyOptions) DeepCopyInto(out *MQReplyOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MQReplyOptions.
+func (in *MQReplyOptions) DeepCopy() *MQReplyOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(MQReplyOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBTarget) DeepCopyInto(out *MongoDBTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBTarget.
+func (in *MongoDBTarget) DeepCopy() *MongoDBTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBTargetList) DeepCopyInto(out *MongoDBTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MongoDBTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBTargetList.
+func (in *MongoDBTargetList) DeepCopy() *MongoDBTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MongoDBTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MongoDBTargetSpec) DeepCopyInto(out *MongoDBTargetSpec) {
+	*out = *in
+	in.ConnectionString.DeepCopyInto(&out.ConnectionString)
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MongoDBTargetSpec.
+func (in *MongoDBTargetSpec) DeepCopy() *MongoDBTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MongoDBTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OracleFunctionSpecSpec) DeepCopyInto(out *OracleFunctionSpecSpec) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OracleFunctionSpecSpec.
+func (in *OracleFunctionSpecSpec) DeepCopy() *OracleFunctionSpecSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OracleFunctionSpecSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OracleTarget) DeepCopyInto(out *OracleTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OracleTarget.
+func (in *OracleTarget) DeepCopy() *OracleTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(OracleTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OracleTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OracleTargetList) DeepCopyInto(out *OracleTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OracleTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OracleTargetList.
+func (in *OracleTargetList) DeepCopy() *OracleTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(OracleTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OracleTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OracleTargetSpec) DeepCopyInto(out *OracleTargetSpec) {
+	*out = *in
+	in.OracleAPIPrivateKey.DeepCopyInto(&out.OracleAPIPrivateKey)
+	in.OracleAPIPrivateKeyPassphrase.DeepCopyInto(&out.OracleAPIPrivateKeyPassphrase)
+	in.OracleAPIPrivateKeyFingerprint.DeepCopyInto(&out.OracleAPIPrivateKeyFingerprint)
+	if in.OracleFunctionSpec != nil {
+		in, out := &in.OracleFunctionSpec, &out.OracleFunctionSpec
+		*out = new(OracleFunctionSpecSpec)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OracleTargetSpec.
+func (in *OracleTargetSpec) DeepCopy() *OracleTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OracleTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceAuth) DeepCopyInto(out *SalesforceAuth) {
+	*out = *in
+	in.CertKey.DeepCopyInto(&out.CertKey)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceAuth.
+func (in *SalesforceAuth) DeepCopy() *SalesforceAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceTarget) DeepCopyInto(out *SalesforceTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceTarget.
+func (in *SalesforceTarget) DeepCopy() *SalesforceTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SalesforceTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceTargetList) DeepCopyInto(out *SalesforceTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SalesforceTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceTargetList.
+func (in *SalesforceTargetList) DeepCopy() *SalesforceTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SalesforceTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SalesforceTargetSpec) DeepCopyInto(out *SalesforceTargetSpec) {
+	*out = *in
+	in.Auth.DeepCopyInto(&out.Auth)
+	if in.APIVersion != nil {
+		in, out := &in.APIVersion, &out.APIVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SalesforceTargetSpec.
+func (in *SalesforceTargetSpec) DeepCopy() *SalesforceTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SalesforceTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendGridTarget) DeepCopyInto(out *SendGridTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendGridTarget.
+func (in *SendGridTarget) DeepCopy() *SendGridTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SendGridTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SendGridTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendGridTargetList) DeepCopyInto(out *SendGridTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SendGridTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendGridTargetList.
+func (in *SendGridTargetList) DeepCopy() *SendGridTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SendGridTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SendGridTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SendGridTargetSpec) DeepCopyInto(out *SendGridTargetSpec) {
+	*out = *in
+	in.APIKey.DeepCopyInto(&out.APIKey)
+	if in.DefaultFromEmail != nil {
+		in, out := &in.DefaultFromEmail, &out.DefaultFromEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultToEmail != nil {
+		in, out := &in.DefaultToEmail, &out.DefaultToEmail
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultToName != nil {
+		in, out := &in.DefaultToName, &out.DefaultToName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultFromName != nil {
+		in, out := &in.DefaultFromName, &out.DefaultFromName
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultMessage != nil {
+		in, out := &in.DefaultMessage, &out.DefaultMessage
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultSubject != nil {
+		in, out := &in.DefaultSubject, &out.DefaultSubject
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SendGridTargetSpec.
+func (in *SendGridTargetSpec) DeepCopy() *SendGridTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SendGridTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackTarget) DeepCopyInto(out *SlackTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackTarget.
+func (in *SlackTarget) DeepCopy() *SlackTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SlackTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackTargetList) DeepCopyInto(out *SlackTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SlackTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackTargetList.
+func (in *SlackTargetList) DeepCopy() *SlackTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SlackTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SlackTargetSpec) DeepCopyInto(out *SlackTargetSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SlackTargetSpec.
+func (in *SlackTargetSpec) DeepCopy() *SlackTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SlackTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceTarget) DeepCopyInto(out *SolaceTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceTarget.
+func (in *SolaceTarget) DeepCopy() *SolaceTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SolaceTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceTargetAuth) DeepCopyInto(out *SolaceTargetAuth) {
+	*out = *in
+	if in.TLS != nil {
+		in, out := &in.TLS, &out.TLS
+		*out = new(SolaceTargetTLSAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SASLEnable != nil {
+		in, out := &in.SASLEnable, &out.SASLEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.TLSEnable != nil {
+		in, out := &in.TLSEnable, &out.TLSEnable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	if in.Password != nil {
+		in, out := &in.Password, &out.Password
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceTargetAuth.
+func (in *SolaceTargetAuth) DeepCopy() *SolaceTargetAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceTargetAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceTargetList) DeepCopyInto(out *SolaceTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SolaceTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceTargetList.
+func (in *SolaceTargetList) DeepCopy() *SolaceTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SolaceTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceTargetSpec) DeepCopyInto(out *SolaceTargetSpec) {
+	*out = *in
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(SolaceTargetAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceTargetSpec.
+func (in *SolaceTargetSpec) DeepCopy() *SolaceTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SolaceTargetTLSAuth) DeepCopyInto(out *SolaceTargetTLSAuth) {
+	*out = *in
+	if in.CA != nil {
+		in, out := &in.CA, &out.CA
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientCert != nil {
+		in, out := &in.ClientCert, &out.ClientCert
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientKey != nil {
+		in, out := &in.ClientKey, &out.ClientKey
+		*out = new(v1alpha1.ValueFromField)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SkipVerify != nil {
+		in, out := &in.SkipVerify, &out.SkipVerify
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SolaceTargetTLSAuth.
+func (in *SolaceTargetTLSAuth) DeepCopy() *SolaceTargetTLSAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(SolaceTargetTLSAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkTarget) DeepCopyInto(out *SplunkTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkTarget.
+func (in *SplunkTarget) DeepCopy() *SplunkTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SplunkTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkTargetList) DeepCopyInto(out *SplunkTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SplunkTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkTargetList.
+func (in *SplunkTargetList) DeepCopy() *SplunkTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SplunkTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SplunkTargetSpec) DeepCopyInto(out *SplunkTargetSpec) {
+	*out = *in
+	in.Endpoint.DeepCopyInto(&out.Endpoint)
+	in.Token.DeepCopyInto(&out.Token)
+	if in.Index != nil {
+		in, out := &in.Index, &out.Index
+		*out = new(string)
+		**out = **in
+	}
+	if in.SkipTLSVerify != nil {
+		in, out := &in.SkipTLSVerify, &out.SkipTLSVerify
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SplunkTargetSpec.
+func (in *SplunkTargetSpec) DeepCopy() *SplunkTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SplunkTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TLSSpec) DeepCopyInto(out *TLSSpec) {
+	*out = *in
+	if in.CertLabel != nil {
+		in, out := &in.CertLabel, &out.CertLabel
+		*out = new(string)
+		**out = **in
+	}
+	in.KeyRepository.DeepCopyInto(&out.KeyRepository)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TLSSpec.
+func (in *TLSSpec) DeepCopy() *TLSSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TLSSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwilioTarget) DeepCopyInto(out *TwilioTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioTarget.
+func (in *TwilioTarget) DeepCopy() *TwilioTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(TwilioTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TwilioTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwilioTargetList) DeepCopyInto(out *TwilioTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TwilioTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioTargetList.
+func (in *TwilioTargetList) DeepCopy() *TwilioTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(TwilioTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TwilioTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TwilioTargetSpec) DeepCopyInto(out *TwilioTargetSpec) {
+	*out = *in
+	in.AccountSID.DeepCopyInto(&out.AccountSID)
+	in.Token.DeepCopyInto(&out.Token)
+	if in.DefaultPhoneFrom != nil {
+		in, out := &in.DefaultPhoneFrom, &out.DefaultPhoneFrom
+		*out = new(string)
+		**out = **in
+	}
+	if in.DefaultPhoneTo != nil {
+		in, out := &in.DefaultPhoneTo, &out.DefaultPhoneTo
+		*out = new(string)
+		**out = **in
+	}
+	if in.EventOptions != nil {
+		in, out := &in.EventOptions, &out.EventOptions
+		*out = new(EventOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TwilioTargetSpec.
+func (in *TwilioTargetSpec) DeepCopy() *TwilioTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TwilioTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskTarget) DeepCopyInto(out *ZendeskTarget) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskTarget.
+func (in *ZendeskTarget) DeepCopy() *ZendeskTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZendeskTarget) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskTargetList) DeepCopyInto(out *ZendeskTargetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ZendeskTarget, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskTargetList.
+func (in *ZendeskTargetList) DeepCopy() *ZendeskTargetList {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskTargetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ZendeskTargetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZendeskTargetSpec) DeepCopyInto(out *ZendeskTargetSpec) {
+	*out = *in
+	in.Token.DeepCopyInto(&out.Token)
+	if in.AdapterOverrides != nil {
+		in, out := &in.AdapterOverrides, &out.AdapterOverrides
+		*out = new(v1alpha1.AdapterOverrides)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZendeskTargetSpec.
+func (in *ZendeskTargetSpec) DeepCopy() *ZendeskTargetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ZendeskTargetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -0,0 +1,127 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+)
+
+// +genclient
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudPubSubTarget is the Schema the event target.
+type GoogleCloudPubSubTarget struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GoogleCloudPubSubTargetSpec `json:"spec"`
+	Status v1alpha1.Status             `json:"status,omitempty"`
+}
+
+// GoogleCloudPubSubTargetSpec holds the desired state of the event target.
+type GoogleCloudPubSubTargetSpec struct {
+	// Full resource name of the Pub/Sub topic to subscribe to, in the
+	// format "projects/{project_name}/topics/{topic_name}".
+	Topic GCloudResourceName `json:"topic"`
+
+	// Authentication methods common for all GCP targets.
+	Auth *v1alpha1.GoogleCloudAuth `json:"auth,omitempty"`
+
+	// Adapter spec overrides parameters.
+	// +optional
+	AdapterOverrides *v1alpha1.AdapterOverrides `json:"adapterOverrides,omitempty"`
+
+	// EventOptions for targets
+	EventOptions *EventOptions `json:"eventOptions,omitempty"`
+
+	// DiscardCloudEventContext is the policy for how to handle the payload of
+	// the CloudEvent.
+	DiscardCloudEventContext bool `json:"discardCloudEventContext,omitempty"`
+
+	// Location of the ordering key of messages inside events. Messages
+	// which share an ordering key are delivered in the order they were
+	// published to subscriptions which have message ordering enabled.
+	// +optional
+	OrderingKey *v1alpha1.EventKeySource `json:"orderingKey,omitempty"`
+
+	// Names of CloudEvents context attributes and extensions to set as
+	// attributes of messages, which allows subscribers to filter messages
+	// without parsing their data.
+	// +optional
+	MessageAttributes []string `json:"messageAttributes,omitempty"`
+
+	// Mode of the CloudEvents Pub/Sub protocol binding used to encode
+	// events in messages. In binary mode, the data of messages is the data
+	// of events, and context attributes are set as "ce-" prefixed message
+	// attributes. In structured mode, the data of messages is the JSON
+	// representation of events. Cannot be combined with
+	// discardCloudEventContext.
+	// https://github.com/google/knative-gcp/blob/main/docs/spec/pubsub-protocol-binding.md
+	// +optional
+	Binding *GoogleCloudPubSubBindingMode `json:"binding,omitempty"`
+
+	// Batching of messages in publish requests. Defaults to the settings
+	// of the Pub/Sub client library.
+	// +optional
+	Batching *GoogleCloudPubSubBatching `json:"batching,omitempty"`
+}
+
+// GoogleCloudPubSubBindingMode is a mode of the CloudEvents Pub/Sub protocol binding.
+type GoogleCloudPubSubBindingMode string
+
+// Supported binding modes.
+const (
+	GoogleCloudPubSubBindingModeBinary     GoogleCloudPubSubBindingMode = "binary"
+	GoogleCloudPubSubBindingModeStructured GoogleCloudPubSubBindingMode = "structured"
+)
+
+// GoogleCloudPubSubBatching contains parameters used to group messages in
+// publish requests. A batch is published as soon as it reaches any of these
+// thresholds.
+type GoogleCloudPubSubBatching struct {
+	// Maximum number of messages in a publish request. Defaults to 100.
+	// +optional
+	MaxMessages *int32 `json:"maxMessages,omitempty"`
+
+	// Maximum size of a publish request, in bytes. Defaults to 1000000.
+	// +optional
+	MaxBytes *int32 `json:"maxBytes,omitempty"`
+
+	// Maximum amount of time a message waits for its batch to be
+	// published. Defaults to 10ms.
+	// +optional
+	MaxDelay *apis.Duration `json:"maxDelay,omitempty"`
+}
+
+// GoogleCloudPubSubTargetStatus communicates the observed state of the event target.
+type GoogleCloudPubSubTargetStatus struct {
+	duckv1.Status        `json:",inline"`
+	duckv1.AddressStatus `json:",inline"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// GoogleCloudPubSubTargetList is a list of event target instances.
+type GoogleCloudPubSubTargetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+	Items           []GoogleCloudPubSubTarget `json:"items"`
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	fuzz "github.com/google/gofuzz"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/testing/roundtrip"
+)
+
+func TestRoundTripTypesViaHub(t *testing.T) {
+	scheme, hubs := runtime.NewScheme(), runtime.NewScheme()
+	utilruntime.Must(AddToScheme(scheme))
+	utilruntime.Must(targetsv1alpha1.AddToScheme(hubs))
+
+	roundtrip.ExternalTypesViaHub(t, scheme, hubs, fuzzerFuncs)
+}
+
+func TestRoundTripHubTypesViaExternal(t *testing.T) {
+	scheme, hubs := runtime.NewScheme(), runtime.NewScheme()
+	utilruntime.Must(AddToScheme(scheme))
+	utilruntime.Must(targetsv1alpha1.AddToScheme(hubs))
+
+	roundtrip.HubTypesViaExternal(t, hubs, scheme, fuzzerFuncs)
+}
+
+// fuzzerFuncs includes fuzzing funcs for the types of the targets API group
+// which are serialized using a specific format.
+var fuzzerFuncs = fuzzer.MergeFuzzerFuncs(
+	func(codecs serializer.CodecFactory) []interface{} {
+		return []interface{}{
+			func(n *GCloudResourceName, c fuzz.Continue) {
+				n.Project = roundtrip.RandStringAtoZ(c)
+				n.Collection = roundtrip.RandStringAtoZ(c)
+				n.Resource = roundtrip.RandStringAtoZ(c)
+			},
+			func(n *targetsv1alpha1.GCloudResourceName, c fuzz.Continue) {
+				n.Project = roundtrip.RandStringAtoZ(c)
+				n.Collection = roundtrip.RandStringAtoZ(c)
+				n.Resource = roundtrip.RandStringAtoZ(c)
+			},
+			func(rID *AzureResourceID, c fuzz.Continue) {
+				rID.SubscriptionID = roundtrip.RandStringAtoZ(c)
+				rID.ResourceGroup = roundtrip.RandStringAtoZ(c)
+				rID.ResourceProvider = roundtrip.RandStringAtoZ(c)
+				rID.ResourceType = roundtrip.RandStringAtoZ(c)
+				rID.ResourceName = roundtrip.RandStringAtoZ(c)
+			},
+			func(rID *targetsv1alpha1.AzureResourceID, c fuzz.Continue) {
+				rID.SubscriptionID = roundtrip.RandStringAtoZ(c)
+				rID.ResourceGroup = roundtrip.RandStringAtoZ(c)
+				rID.ResourceProvider = roundtrip.RandStringAtoZ(c)
+				rID.ResourceType = roundtrip.RandStringAtoZ(c)
+				rID.ResourceName = roundtrip.RandStringAtoZ(c)
+			},
+		}
+	},
+)
@@ -0,0 +1,369 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// Objects are defaulted and validated by converting them to the hub version of
+// their kind, which implements the defaulting and validation logic.
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSComprehendTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSComprehendTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSComprehendTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSComprehendTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSDynamoDBTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSDynamoDBTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSDynamoDBTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSDynamoDBTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSEventBridgeTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSEventBridgeTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSEventBridgeTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSEventBridgeTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSKinesisTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSKinesisTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSKinesisTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSKinesisTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSLambdaTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSLambdaTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSLambdaTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSLambdaTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSS3Target) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSS3Target{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSS3Target) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSS3Target{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSSNSTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSSNSTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSSNSTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSSNSTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AWSSQSTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AWSSQSTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AWSSQSTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AWSSQSTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AzureEventHubsTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AzureEventHubsTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AzureEventHubsTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AzureEventHubsTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AzureSentinelTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AzureSentinelTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AzureSentinelTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AzureSentinelTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *AzureServiceBusTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.AzureServiceBusTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *AzureServiceBusTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.AzureServiceBusTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *CloudEventsTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.CloudEventsTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *CloudEventsTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.CloudEventsTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *DatadogTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.DatadogTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *DatadogTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.DatadogTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *ElasticsearchTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.ElasticsearchTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *ElasticsearchTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.ElasticsearchTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *GoogleCloudFirestoreTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.GoogleCloudFirestoreTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *GoogleCloudFirestoreTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.GoogleCloudFirestoreTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *GoogleCloudStorageTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.GoogleCloudStorageTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *GoogleCloudStorageTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.GoogleCloudStorageTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *GoogleCloudWorkflowsTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.GoogleCloudWorkflowsTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *GoogleCloudWorkflowsTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.GoogleCloudWorkflowsTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *GoogleCloudPubSubTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.GoogleCloudPubSubTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *GoogleCloudPubSubTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.GoogleCloudPubSubTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *GoogleSheetTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.GoogleSheetTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *GoogleSheetTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.GoogleSheetTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *HTTPTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.HTTPTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *HTTPTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.HTTPTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *IBMMQTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.IBMMQTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *IBMMQTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.IBMMQTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *JiraTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.JiraTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *JiraTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.JiraTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *KafkaTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.KafkaTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *KafkaTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.KafkaTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *LogzMetricsTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.LogzMetricsTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *LogzMetricsTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.LogzMetricsTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *LogzTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.LogzTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *LogzTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.LogzTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *MongoDBTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.MongoDBTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *MongoDBTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.MongoDBTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *OracleTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.OracleTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *OracleTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.OracleTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *SalesforceTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.SalesforceTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *SalesforceTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.SalesforceTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *SendGridTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.SendGridTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *SendGridTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.SendGridTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *SlackTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.SlackTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *SlackTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.SlackTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *SolaceTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.SolaceTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *SolaceTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.SolaceTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *SplunkTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.SplunkTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *SplunkTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.SplunkTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *TwilioTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.TwilioTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *TwilioTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.TwilioTarget{})
+}
+
+// SetDefaults implements apis.Defaultable.
+func (t *ZendeskTarget) SetDefaults(ctx context.Context) {
+	v1alpha1.SetDefaultsViaHub(ctx, t, &targetsv1alpha1.ZendeskTarget{})
+}
+
+// Validate implements apis.Validatable.
+func (t *ZendeskTarget) Validate(ctx context.Context) *apis.FieldError {
+	return v1alpha1.ValidateViaHub(ctx, t, &targetsv1alpha1.ZendeskTarget{})
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package roundtrip contains helpers for testing that conversions between
+// versions of the TriggerMesh APIs are lossless.
+package roundtrip
+
+import (
+	"context"
+	"math/rand"
+	"net/url"
+	"reflect"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	fuzz "github.com/google/gofuzz"
+
+	"github.com/aws/aws-sdk-go/aws/arn"
+
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apitestingroundtrip "k8s.io/apimachinery/pkg/api/apitesting/roundtrip"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+
+	pkgapis "knative.dev/pkg/apis"
+	knfuzzer "knative.dev/pkg/apis/testing/fuzzer"
+	knroundtrip "knative.dev/pkg/apis/testing/roundtrip"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+)
+
+// Funcs includes fuzzing funcs for the types which are shared by all
+// TriggerMesh APIs.
+var Funcs = fuzzer.MergeFuzzerFuncs(
+	knfuzzer.Funcs,
+	func(codecs serializer.CodecFactory) []interface{} {
+		return []interface{}{
+			// RawPath is not preserved by the JSON serialization of
+			// URLs unless it is a valid encoding of Path.
+			func(u *pkgapis.URL, c fuzz.Continue) {
+				u.Scheme = RandStringAtoZ(c)
+				u.Host = RandStringAtoZ(c)
+				u.Path = "/" + RandStringAtoZ(c)
+			},
+			// A pointer to a nil slice is serialized as null.
+			func(s **[]string, c fuzz.Continue) {
+				if c.RandBool() {
+					*s = nil
+					return
+				}
+				*s = &[]string{c.RandString()}
+			},
+			func(a *apis.ARN, c fuzz.Continue) {
+				*a = apis.ARN(arn.ARN{
+					Partition: RandStringAtoZ(c),
+					Service:   RandStringAtoZ(c),
+					Region:    RandStringAtoZ(c),
+					AccountID: RandStringAtoZ(c),
+					Resource:  RandStringAtoZ(c),
+				})
+			},
+			func(d *apis.Duration, c fuzz.Continue) {
+				*d = apis.Duration(time.Duration(c.Int63n(int64(24 * time.Hour))))
+			},
+		}
+	},
+)
+
+// RandStringAtoZ returns a non-empty random string of lower case letters. It
+// is suitable for fuzzing the elements of attributes which are serialized
+// using a specific format, such as resource identifiers.
+func RandStringAtoZ(c fuzz.Continue) string {
+	b := make([]byte, 1+c.Intn(16))
+	for i := range b {
+		b[i] = byte('a' + c.Intn('z'-'a'+1))
+	}
+	return string(b)
+}
+
+// ExternalTypesViaHub applies the round-trip test to all the external kinds
+// in the given scheme which have a hub version in hubs:
+//
+//	external version -> hub version -> external version
+func ExternalTypesViaHub(t *testing.T, scheme, hubs *runtime.Scheme, fuzzerFuncs fuzzer.FuzzerFuncs) {
+	knroundtrip.ExternalTypesViaHub(t, scheme, hubs, fuzzer.MergeFuzzerFuncs(Funcs, fuzzerFuncs))
+}
+
+// HubTypesViaExternal applies the round-trip test to all the hub kinds in the
+// given hubs scheme which have an external version in scheme:
+//
+//	hub version -> external version -> hub version
+//
+// Objects are persisted in the hub version, so this ensures that objects can
+// be read and updated through the external version without loss.
+func HubTypesViaExternal(t *testing.T, hubs, scheme *runtime.Scheme, fuzzerFuncs fuzzer.FuzzerFuncs) {
+	f := fuzzer.FuzzerFor(
+		fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, Funcs, fuzzerFuncs),
+		rand.NewSource(rand.Int63()),
+		serializer.NewCodecFactory(hubs),
+	)
+
+	f.SkipFieldsWithPattern(regexp.MustCompile("DeprecatedGeneration"))
+
+	for hubGVK := range hubs.AllKnownTypes() {
+		hubGVK := hubGVK
+
+		gvk, ok := externalGVKForGK(scheme, hubGVK)
+		if !ok {
+			continue
+		}
+
+		t.Run(gvk.Group+"."+hubGVK.Version+"."+gvk.Kind, func(t *testing.T) {
+			for i := 0; i < *apitestingroundtrip.FuzzIters; i++ {
+				roundTripViaExternal(t, hubGVK, gvk, hubs, scheme, f)
+
+				if t.Failed() {
+					break
+				}
+			}
+		})
+	}
+}
+
+// convertibleObject is an object which can be converted between versions.
+type convertibleObject interface {
+	runtime.Object
+	pkgapis.Convertible
+}
+
+func roundTripViaExternal(t *testing.T, hubGVK, gvk schema.GroupVersionKind,
+	hubs, scheme *runtime.Scheme, f *fuzz.Fuzzer) {
+
+	ctx := context.Background()
+
+	hub := objForGVK(t, hubGVK, hubs)
+	f.Fuzz(hub)
+	hub.GetObjectKind().SetGroupVersionKind(hubGVK)
+
+	original := hub.DeepCopyObject()
+
+	obj := objForGVK(t, gvk, scheme)
+	if err := hub.ConvertTo(ctx, obj); err != nil {
+		t.Errorf("Conversion from hub (%s) failed: %s", hubGVK, err)
+		return
+	}
+
+	if !equality.Semantic.DeepEqual(original, hub) {
+		t.Errorf("Conversion from hub (%s) modified the original object, diff: %s", hubGVK, diff(original, hub))
+		return
+	}
+
+	newHub := objForGVK(t, hubGVK, hubs)
+	if err := newHub.ConvertFrom(ctx, obj); err != nil {
+		t.Errorf("Conversion to hub (%s) failed: %s", hubGVK, err)
+		return
+	}
+
+	if !equality.Semantic.DeepEqual(original, newHub) {
+		t.Errorf("Round trip through %s produced a diff: %s", gvk.GroupVersion(), diff(original, newHub))
+	}
+}
+
+// externalGVKForGK returns the GVK of the external version of the given hub
+// kind, if registered in the given scheme.
+func externalGVKForGK(scheme *runtime.Scheme, hubGVK schema.GroupVersionKind) (schema.GroupVersionKind, bool) {
+	for gvk, typ := range scheme.AllKnownTypes() {
+		if gvk.GroupKind() != hubGVK.GroupKind() || gvk.Version == hubGVK.Version {
+			continue
+		}
+		if reflect.PtrTo(typ).AssignableTo(listType) {
+			continue
+		}
+		if !reflect.PtrTo(typ).AssignableTo(convertibleType) {
+			continue
+		}
+		return gvk, true
+	}
+
+	return schema.GroupVersionKind{}, false
+}
+
+var (
+	listType        = reflect.TypeOf((*metav1.ListMetaAccessor)(nil)).Elem()
+	convertibleType = reflect.TypeOf((*convertibleObject)(nil)).Elem()
+)
+
+func objForGVK(t *testing.T, gvk schema.GroupVersionKind, scheme *runtime.Scheme) convertibleObject {
+	t.Helper()
+
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		t.Fatalf("Unable to create object instance for type %s: %s", gvk, err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+
+	return obj.(convertibleObject)
+}
+
+func diff(obj1, obj2 interface{}) string {
+	return cmp.Diff(obj1, obj2, cmpopts.IgnoreUnexported(url.Userinfo{}))
+}
@@ -22,11 +22,17 @@ import (
 	"fmt"
 	"net/http"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/catalog/v1alpha1"
 	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1alpha1"
+	extensionsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1beta1"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1beta1"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1beta1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1beta1"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1beta1"
 	discovery "k8s.io/client-go/discovery"
 	rest "k8s.io/client-go/rest"
 	flowcontrol "k8s.io/client-go/util/flowcontrol"
@@ -34,22 +40,39 @@ import (
 
 type Interface interface {
 	Discovery() discovery.DiscoveryInterface
+	CatalogV1alpha1() catalogv1alpha1.CatalogV1alpha1Interface
 	ExtensionsV1alpha1() extensionsv1alpha1.ExtensionsV1alpha1Interface
+	ExtensionsV1beta1() extensionsv1beta1.ExtensionsV1beta1Interface
 	FlowV1alpha1() flowv1alpha1.FlowV1alpha1Interface
+	FlowV1beta1() flowv1beta1.FlowV1beta1Interface
 	RoutingV1alpha1() routingv1alpha1.RoutingV1alpha1Interface
+	RoutingV1beta1() routingv1beta1.RoutingV1beta1Interface
 	SourcesV1alpha1() sourcesv1alpha1.SourcesV1alpha1Interface
+	SourcesV1beta1() sourcesv1beta1.SourcesV1beta1Interface
 	TargetsV1alpha1() targetsv1alpha1.TargetsV1alpha1Interface
+	TargetsV1beta1() targetsv1beta1.TargetsV1beta1Interface
 }
 
 // Clientset contains the clients for groups. Each group has exactly one
 // version included in a Clientset.
 type Clientset struct {
 	*discovery.DiscoveryClient
+	catalogV1alpha1    *catalogv1alpha1.CatalogV1alpha1Client
 	extensionsV1alpha1 *extensionsv1alpha1.ExtensionsV1alpha1Client
+	extensionsV1beta1  *extensionsv1beta1.ExtensionsV1beta1Client
 	flowV1alpha1       *flowv1alpha1.FlowV1alpha1Client
+	flowV1beta1        *flowv1beta1.FlowV1beta1Client
 	routingV1alpha1    *routingv1alpha1.RoutingV1alpha1Client
+	routingV1beta1     *routingv1beta1.RoutingV1beta1Client
 	sourcesV1alpha1    *sourcesv1alpha1.SourcesV1alpha1Client
+	sourcesV1beta1     *sourcesv1beta1.SourcesV1beta1Client
 	targetsV1alpha1    *targetsv1alpha1.TargetsV1alpha1Client
+	targetsV1beta1     *targetsv1beta1.TargetsV1beta1Client
+}
+
+// CatalogV1alpha1 retrieves the CatalogV1alpha1Client
+func (c *Clientset) CatalogV1alpha1() catalogv1alpha1.CatalogV1alpha1Interface {
+	return c.catalogV1alpha1
 }
 
 // ExtensionsV1alpha1 retrieves the ExtensionsV1alpha1Client
@@ -57,26 +80,51 @@ func (c *Clientset) ExtensionsV1alpha1() extensionsv1alpha1.ExtensionsV1alpha1In
 	return c.extensionsV1alpha1
 }
 
+// ExtensionsV1beta1 retrieves the ExtensionsV1beta1Client
+func (c *Clientset) ExtensionsV1beta1() extensionsv1beta1.ExtensionsV1beta1Interface {
+	return c.extensionsV1beta1
+}
+
 // FlowV1alpha1 retrieves the FlowV1alpha1Client
 func (c *Clientset) FlowV1alpha1() flowv1alpha1.FlowV1alpha1Interface {
 	return c.flowV1alpha1
 }
 
+// FlowV1beta1 retrieves the FlowV1beta1Client
+func (c *Clientset) FlowV1beta1() flowv1beta1.FlowV1beta1Interface {
+	return c.flowV1beta1
+}
+
 // RoutingV1alpha1 retrieves the RoutingV1alpha1Client
 func (c *Clientset) RoutingV1alpha1() routingv1alpha1.RoutingV1alpha1Interface {
 	return c.routingV1alpha1
 }
 
+// RoutingV1beta1 retrieves the RoutingV1beta1Client
+func (c *Clientset) RoutingV1beta1() routingv1beta1.RoutingV1beta1Interface {
+	return c.routingV1beta1
+}
+
 // SourcesV1alpha1 retrieves the SourcesV1alpha1Client
 func (c *Clientset) SourcesV1alpha1() sourcesv1alpha1.SourcesV1alpha1Interface {
 	return c.sourcesV1alpha1
 }
 
+// SourcesV1beta1 retrieves the SourcesV1beta1Client
+func (c *Clientset) SourcesV1beta1() sourcesv1beta1.SourcesV1beta1Interface {
+	return c.sourcesV1beta1
+}
+
 // TargetsV1alpha1 retrieves the TargetsV1alpha1Client
 func (c *Clientset) TargetsV1alpha1() targetsv1alpha1.TargetsV1alpha1Interface {
 	return c.targetsV1alpha1
 }
 
+// TargetsV1beta1 retrieves the TargetsV1beta1Client
+func (c *Clientset) TargetsV1beta1() targetsv1beta1.TargetsV1beta1Interface {
+	return c.targetsV1beta1
+}
+
 // Discovery retrieves the DiscoveryClient
 func (c *Clientset) Discovery() discovery.DiscoveryInterface {
 	if c == nil {
@@ -121,26 +169,50 @@ func NewForConfigAndClient(c *rest.Config, httpClient *http.Client) (*Clientset,
 
 	var cs Clientset
 	var err error
+	cs.catalogV1alpha1, err = catalogv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.extensionsV1alpha1, err = extensionsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.extensionsV1beta1, err = extensionsv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.flowV1alpha1, err = flowv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.flowV1beta1, err = flowv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.routingV1alpha1, err = routingv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.routingV1beta1, err = routingv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.sourcesV1alpha1, err = sourcesv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.sourcesV1beta1, err = sourcesv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 	cs.targetsV1alpha1, err = targetsv1alpha1.NewForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
 		return nil, err
 	}
+	cs.targetsV1beta1, err = targetsv1beta1.NewForConfigAndClient(&configShallowCopy, httpClient)
+	if err != nil {
+		return nil, err
+	}
 
 	cs.DiscoveryClient, err = discovery.NewDiscoveryClientForConfigAndClient(&configShallowCopy, httpClient)
 	if err != nil {
@@ -162,11 +234,17 @@ func NewForConfigOrDie(c *rest.Config) *Clientset {
 // New creates a new Clientset for the given RESTClient.
 func New(c rest.Interface) *Clientset {
 	var cs Clientset
+	cs.catalogV1alpha1 = catalogv1alpha1.New(c)
 	cs.extensionsV1alpha1 = extensionsv1alpha1.New(c)
+	cs.extensionsV1beta1 = extensionsv1beta1.New(c)
 	cs.flowV1alpha1 = flowv1alpha1.New(c)
+	cs.flowV1beta1 = flowv1beta1.New(c)
 	cs.routingV1alpha1 = routingv1alpha1.New(c)
+	cs.routingV1beta1 = routingv1beta1.New(c)
 	cs.sourcesV1alpha1 = sourcesv1alpha1.New(c)
+	cs.sourcesV1beta1 = sourcesv1beta1.New(c)
 	cs.targetsV1alpha1 = targetsv1alpha1.New(c)
+	cs.targetsV1beta1 = targetsv1beta1.New(c)
 
 	cs.DiscoveryClient = discovery.NewDiscoveryClient(c)
 	return &cs
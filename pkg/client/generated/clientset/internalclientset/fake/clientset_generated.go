@@ -20,16 +20,28 @@ package fake
 
 import (
 	clientset "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset"
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/catalog/v1alpha1"
+	fakecatalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/catalog/v1alpha1/fake"
 	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1alpha1"
 	fakeextensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1alpha1/fake"
+	extensionsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1beta1"
+	fakeextensionsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1beta1/fake"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1alpha1"
 	fakeflowv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1alpha1/fake"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1beta1"
+	fakeflowv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1beta1/fake"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1alpha1"
 	fakeroutingv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1alpha1/fake"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1beta1"
+	fakeroutingv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1beta1/fake"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1alpha1"
 	fakesourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1alpha1/fake"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1beta1"
+	fakesourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1beta1/fake"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1alpha1"
 	faketargetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1alpha1/fake"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1beta1"
+	faketargetsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1beta1/fake"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/discovery"
@@ -87,27 +99,57 @@ var (
 	_ testing.FakeClient  = &Clientset{}
 )
 
+// CatalogV1alpha1 retrieves the CatalogV1alpha1Client
+func (c *Clientset) CatalogV1alpha1() catalogv1alpha1.CatalogV1alpha1Interface {
+	return &fakecatalogv1alpha1.FakeCatalogV1alpha1{Fake: &c.Fake}
+}
+
 // ExtensionsV1alpha1 retrieves the ExtensionsV1alpha1Client
 func (c *Clientset) ExtensionsV1alpha1() extensionsv1alpha1.ExtensionsV1alpha1Interface {
 	return &fakeextensionsv1alpha1.FakeExtensionsV1alpha1{Fake: &c.Fake}
 }
 
+// ExtensionsV1beta1 retrieves the ExtensionsV1beta1Client
+func (c *Clientset) ExtensionsV1beta1() extensionsv1beta1.ExtensionsV1beta1Interface {
+	return &fakeextensionsv1beta1.FakeExtensionsV1beta1{Fake: &c.Fake}
+}
+
 // FlowV1alpha1 retrieves the FlowV1alpha1Client
 func (c *Clientset) FlowV1alpha1() flowv1alpha1.FlowV1alpha1Interface {
 	return &fakeflowv1alpha1.FakeFlowV1alpha1{Fake: &c.Fake}
 }
 
+// FlowV1beta1 retrieves the FlowV1beta1Client
+func (c *Clientset) FlowV1beta1() flowv1beta1.FlowV1beta1Interface {
+	return &fakeflowv1beta1.FakeFlowV1beta1{Fake: &c.Fake}
+}
+
 // RoutingV1alpha1 retrieves the RoutingV1alpha1Client
 func (c *Clientset) RoutingV1alpha1() routingv1alpha1.RoutingV1alpha1Interface {
 	return &fakeroutingv1alpha1.FakeRoutingV1alpha1{Fake: &c.Fake}
 }
 
+// RoutingV1beta1 retrieves the RoutingV1beta1Client
+func (c *Clientset) RoutingV1beta1() routingv1beta1.RoutingV1beta1Interface {
+	return &fakeroutingv1beta1.FakeRoutingV1beta1{Fake: &c.Fake}
+}
+
 // SourcesV1alpha1 retrieves the SourcesV1alpha1Client
 func (c *Clientset) SourcesV1alpha1() sourcesv1alpha1.SourcesV1alpha1Interface {
 	return &fakesourcesv1alpha1.FakeSourcesV1alpha1{Fake: &c.Fake}
 }
 
+// SourcesV1beta1 retrieves the SourcesV1beta1Client
+func (c *Clientset) SourcesV1beta1() sourcesv1beta1.SourcesV1beta1Interface {
+	return &fakesourcesv1beta1.FakeSourcesV1beta1{Fake: &c.Fake}
+}
+
 // TargetsV1alpha1 retrieves the TargetsV1alpha1Client
 func (c *Clientset) TargetsV1alpha1() targetsv1alpha1.TargetsV1alpha1Interface {
 	return &faketargetsv1alpha1.FakeTargetsV1alpha1{Fake: &c.Fake}
 }
+
+// TargetsV1beta1 retrieves the TargetsV1beta1Client
+func (c *Clientset) TargetsV1beta1() targetsv1beta1.TargetsV1beta1Interface {
+	return &faketargetsv1beta1.FakeTargetsV1beta1{Fake: &c.Fake}
+}
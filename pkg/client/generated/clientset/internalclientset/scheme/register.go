@@ -19,11 +19,17 @@ limitations under the License.
 package scheme
 
 import (
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
+	extensionsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1beta1"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
@@ -35,11 +41,17 @@ var Scheme = runtime.NewScheme()
 var Codecs = serializer.NewCodecFactory(Scheme)
 var ParameterCodec = runtime.NewParameterCodec(Scheme)
 var localSchemeBuilder = runtime.SchemeBuilder{
+	catalogv1alpha1.AddToScheme,
 	extensionsv1alpha1.AddToScheme,
+	extensionsv1beta1.AddToScheme,
 	flowv1alpha1.AddToScheme,
+	flowv1beta1.AddToScheme,
 	routingv1alpha1.AddToScheme,
+	routingv1beta1.AddToScheme,
 	sourcesv1alpha1.AddToScheme,
+	sourcesv1beta1.AddToScheme,
 	targetsv1alpha1.AddToScheme,
+	targetsv1beta1.AddToScheme,
 }
 
 // AddToScheme adds all types of this clientset into the given scheme. This allows composition
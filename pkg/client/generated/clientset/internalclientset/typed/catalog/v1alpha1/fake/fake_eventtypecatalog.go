@@ -0,0 +1,130 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeEventTypeCatalogs implements EventTypeCatalogInterface
+type FakeEventTypeCatalogs struct {
+	Fake *FakeCatalogV1alpha1
+	ns   string
+}
+
+var eventtypecatalogsResource = schema.GroupVersionResource{Group: "catalog.triggermesh.io", Version: "v1alpha1", Resource: "eventtypecatalogs"}
+
+var eventtypecatalogsKind = schema.GroupVersionKind{Group: "catalog.triggermesh.io", Version: "v1alpha1", Kind: "EventTypeCatalog"}
+
+// Get takes name of the eventTypeCatalog, and returns the corresponding eventTypeCatalog object, and an error if there is any.
+func (c *FakeEventTypeCatalogs) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.EventTypeCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(eventtypecatalogsResource, c.ns, name), &v1alpha1.EventTypeCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EventTypeCatalog), err
+}
+
+// List takes label and field selectors, and returns the list of EventTypeCatalogs that match those selectors.
+func (c *FakeEventTypeCatalogs) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.EventTypeCatalogList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(eventtypecatalogsResource, eventtypecatalogsKind, c.ns, opts), &v1alpha1.EventTypeCatalogList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.EventTypeCatalogList{ListMeta: obj.(*v1alpha1.EventTypeCatalogList).ListMeta}
+	for _, item := range obj.(*v1alpha1.EventTypeCatalogList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested eventTypeCatalogs.
+func (c *FakeEventTypeCatalogs) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(eventtypecatalogsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a eventTypeCatalog and creates it.  Returns the server's representation of the eventTypeCatalog, and an error, if there is any.
+func (c *FakeEventTypeCatalogs) Create(ctx context.Context, eventTypeCatalog *v1alpha1.EventTypeCatalog, opts v1.CreateOptions) (result *v1alpha1.EventTypeCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(eventtypecatalogsResource, c.ns, eventTypeCatalog), &v1alpha1.EventTypeCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EventTypeCatalog), err
+}
+
+// Update takes the representation of a eventTypeCatalog and updates it. Returns the server's representation of the eventTypeCatalog, and an error, if there is any.
+func (c *FakeEventTypeCatalogs) Update(ctx context.Context, eventTypeCatalog *v1alpha1.EventTypeCatalog, opts v1.UpdateOptions) (result *v1alpha1.EventTypeCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(eventtypecatalogsResource, c.ns, eventTypeCatalog), &v1alpha1.EventTypeCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EventTypeCatalog), err
+}
+
+// Delete takes name of the eventTypeCatalog and deletes it. Returns an error if one occurs.
+func (c *FakeEventTypeCatalogs) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(eventtypecatalogsResource, c.ns, name, opts), &v1alpha1.EventTypeCatalog{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeEventTypeCatalogs) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(eventtypecatalogsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.EventTypeCatalogList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched eventTypeCatalog.
+func (c *FakeEventTypeCatalogs) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.EventTypeCatalog, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(eventtypecatalogsResource, c.ns, name, pt, data, subresources...), &v1alpha1.EventTypeCatalog{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.EventTypeCatalog), err
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	"time"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// BridgesGetter has a method to return a BridgeInterface.
+// A group's client should implement this interface.
+type BridgesGetter interface {
+	Bridges(namespace string) BridgeInterface
+}
+
+// BridgeInterface has methods to work with Bridge resources.
+type BridgeInterface interface {
+	Create(ctx context.Context, bridge *v1alpha1.Bridge, opts v1.CreateOptions) (*v1alpha1.Bridge, error)
+	Update(ctx context.Context, bridge *v1alpha1.Bridge, opts v1.UpdateOptions) (*v1alpha1.Bridge, error)
+	UpdateStatus(ctx context.Context, bridge *v1alpha1.Bridge, opts v1.UpdateOptions) (*v1alpha1.Bridge, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.Bridge, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.BridgeList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Bridge, err error)
+	BridgeExpansion
+}
+
+// bridges implements BridgeInterface
+type bridges struct {
+	client rest.Interface
+	ns     string
+}
+
+// newBridges returns a Bridges
+func newBridges(c *FlowV1alpha1Client, namespace string) *bridges {
+	return &bridges{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the bridge, and returns the corresponding bridge object, and an error if there is any.
+func (c *bridges) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Bridge, err error) {
+	result = &v1alpha1.Bridge{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("bridges").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Bridges that match those selectors.
+func (c *bridges) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.BridgeList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1alpha1.BridgeList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("bridges").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested bridges.
+func (c *bridges) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("bridges").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a bridge and creates it.  Returns the server's representation of the bridge, and an error, if there is any.
+func (c *bridges) Create(ctx context.Context, bridge *v1alpha1.Bridge, opts v1.CreateOptions) (result *v1alpha1.Bridge, err error) {
+	result = &v1alpha1.Bridge{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("bridges").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bridge).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a bridge and updates it. Returns the server's representation of the bridge, and an error, if there is any.
+func (c *bridges) Update(ctx context.Context, bridge *v1alpha1.Bridge, opts v1.UpdateOptions) (result *v1alpha1.Bridge, err error) {
+	result = &v1alpha1.Bridge{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("bridges").
+		Name(bridge.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bridge).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *bridges) UpdateStatus(ctx context.Context, bridge *v1alpha1.Bridge, opts v1.UpdateOptions) (result *v1alpha1.Bridge, err error) {
+	result = &v1alpha1.Bridge{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("bridges").
+		Name(bridge.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(bridge).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the bridge and deletes it. Returns an error if one occurs.
+func (c *bridges) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("bridges").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *bridges) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("bridges").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched bridge.
+func (c *bridges) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Bridge, err error) {
+	result = &v1alpha1.Bridge{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("bridges").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeDeduplicators implements DeduplicatorInterface
+type FakeDeduplicators struct {
+	Fake *FakeFlowV1alpha1
+	ns   string
+}
+
+var deduplicatorsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1alpha1", Resource: "deduplicators"}
+
+var deduplicatorsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1alpha1", Kind: "Deduplicator"}
+
+// Get takes name of the deduplicator, and returns the corresponding deduplicator object, and an error if there is any.
+func (c *FakeDeduplicators) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.Deduplicator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(deduplicatorsResource, c.ns, name), &v1alpha1.Deduplicator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Deduplicator), err
+}
+
+// List takes label and field selectors, and returns the list of Deduplicators that match those selectors.
+func (c *FakeDeduplicators) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.DeduplicatorList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(deduplicatorsResource, deduplicatorsKind, c.ns, opts), &v1alpha1.DeduplicatorList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.DeduplicatorList{ListMeta: obj.(*v1alpha1.DeduplicatorList).ListMeta}
+	for _, item := range obj.(*v1alpha1.DeduplicatorList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested deduplicators.
+func (c *FakeDeduplicators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(deduplicatorsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a deduplicator and creates it.  Returns the server's representation of the deduplicator, and an error, if there is any.
+func (c *FakeDeduplicators) Create(ctx context.Context, deduplicator *v1alpha1.Deduplicator, opts v1.CreateOptions) (result *v1alpha1.Deduplicator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(deduplicatorsResource, c.ns, deduplicator), &v1alpha1.Deduplicator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Deduplicator), err
+}
+
+// Update takes the representation of a deduplicator and updates it. Returns the server's representation of the deduplicator, and an error, if there is any.
+func (c *FakeDeduplicators) Update(ctx context.Context, deduplicator *v1alpha1.Deduplicator, opts v1.UpdateOptions) (result *v1alpha1.Deduplicator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(deduplicatorsResource, c.ns, deduplicator), &v1alpha1.Deduplicator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Deduplicator), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeDeduplicators) UpdateStatus(ctx context.Context, deduplicator *v1alpha1.Deduplicator, opts v1.UpdateOptions) (*v1alpha1.Deduplicator, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(deduplicatorsResource, "status", c.ns, deduplicator), &v1alpha1.Deduplicator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Deduplicator), err
+}
+
+// Delete takes name of the deduplicator and deletes it. Returns an error if one occurs.
+func (c *FakeDeduplicators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(deduplicatorsResource, c.ns, name, opts), &v1alpha1.Deduplicator{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeDeduplicators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(deduplicatorsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.DeduplicatorList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched deduplicator.
+func (c *FakeDeduplicators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Deduplicator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(deduplicatorsResource, c.ns, name, pt, data, subresources...), &v1alpha1.Deduplicator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.Deduplicator), err
+}
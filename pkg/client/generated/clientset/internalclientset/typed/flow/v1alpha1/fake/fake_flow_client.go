@@ -28,10 +28,22 @@ type FakeFlowV1alpha1 struct {
 	*testing.Fake
 }
 
+func (c *FakeFlowV1alpha1) Bridges(namespace string) v1alpha1.BridgeInterface {
+	return &FakeBridges{c, namespace}
+}
+
+func (c *FakeFlowV1alpha1) Deduplicators(namespace string) v1alpha1.DeduplicatorInterface {
+	return &FakeDeduplicators{c, namespace}
+}
+
 func (c *FakeFlowV1alpha1) JQTransformations(namespace string) v1alpha1.JQTransformationInterface {
 	return &FakeJQTransformations{c, namespace}
 }
 
+func (c *FakeFlowV1alpha1) SchemaValidators(namespace string) v1alpha1.SchemaValidatorInterface {
+	return &FakeSchemaValidators{c, namespace}
+}
+
 func (c *FakeFlowV1alpha1) Synchronizers(namespace string) v1alpha1.SynchronizerInterface {
 	return &FakeSynchronizers{c, namespace}
 }
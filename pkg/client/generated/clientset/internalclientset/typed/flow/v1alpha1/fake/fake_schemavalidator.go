@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSchemaValidators implements SchemaValidatorInterface
+type FakeSchemaValidators struct {
+	Fake *FakeFlowV1alpha1
+	ns   string
+}
+
+var schemavalidatorsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1alpha1", Resource: "schemavalidators"}
+
+var schemavalidatorsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1alpha1", Kind: "SchemaValidator"}
+
+// Get takes name of the schemaValidator, and returns the corresponding schemaValidator object, and an error if there is any.
+func (c *FakeSchemaValidators) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1alpha1.SchemaValidator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(schemavalidatorsResource, c.ns, name), &v1alpha1.SchemaValidator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SchemaValidator), err
+}
+
+// List takes label and field selectors, and returns the list of SchemaValidators that match those selectors.
+func (c *FakeSchemaValidators) List(ctx context.Context, opts v1.ListOptions) (result *v1alpha1.SchemaValidatorList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(schemavalidatorsResource, schemavalidatorsKind, c.ns, opts), &v1alpha1.SchemaValidatorList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1alpha1.SchemaValidatorList{ListMeta: obj.(*v1alpha1.SchemaValidatorList).ListMeta}
+	for _, item := range obj.(*v1alpha1.SchemaValidatorList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested schemaValidators.
+func (c *FakeSchemaValidators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(schemavalidatorsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a schemaValidator and creates it.  Returns the server's representation of the schemaValidator, and an error, if there is any.
+func (c *FakeSchemaValidators) Create(ctx context.Context, schemaValidator *v1alpha1.SchemaValidator, opts v1.CreateOptions) (result *v1alpha1.SchemaValidator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(schemavalidatorsResource, c.ns, schemaValidator), &v1alpha1.SchemaValidator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SchemaValidator), err
+}
+
+// Update takes the representation of a schemaValidator and updates it. Returns the server's representation of the schemaValidator, and an error, if there is any.
+func (c *FakeSchemaValidators) Update(ctx context.Context, schemaValidator *v1alpha1.SchemaValidator, opts v1.UpdateOptions) (result *v1alpha1.SchemaValidator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(schemavalidatorsResource, c.ns, schemaValidator), &v1alpha1.SchemaValidator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SchemaValidator), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSchemaValidators) UpdateStatus(ctx context.Context, schemaValidator *v1alpha1.SchemaValidator, opts v1.UpdateOptions) (*v1alpha1.SchemaValidator, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(schemavalidatorsResource, "status", c.ns, schemaValidator), &v1alpha1.SchemaValidator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SchemaValidator), err
+}
+
+// Delete takes name of the schemaValidator and deletes it. Returns an error if one occurs.
+func (c *FakeSchemaValidators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(schemavalidatorsResource, c.ns, name, opts), &v1alpha1.SchemaValidator{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSchemaValidators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(schemavalidatorsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1alpha1.SchemaValidatorList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched schemaValidator.
+func (c *FakeSchemaValidators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.SchemaValidator, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(schemavalidatorsResource, c.ns, name, pt, data, subresources...), &v1alpha1.SchemaValidator{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1alpha1.SchemaValidator), err
+}
@@ -28,7 +28,10 @@ import (
 
 type FlowV1alpha1Interface interface {
 	RESTClient() rest.Interface
+	BridgesGetter
+	DeduplicatorsGetter
 	JQTransformationsGetter
+	SchemaValidatorsGetter
 	SynchronizersGetter
 	TransformationsGetter
 	XMLToJSONTransformationsGetter
@@ -40,10 +43,22 @@ type FlowV1alpha1Client struct {
 	restClient rest.Interface
 }
 
+func (c *FlowV1alpha1Client) Bridges(namespace string) BridgeInterface {
+	return newBridges(c, namespace)
+}
+
+func (c *FlowV1alpha1Client) Deduplicators(namespace string) DeduplicatorInterface {
+	return newDeduplicators(c, namespace)
+}
+
 func (c *FlowV1alpha1Client) JQTransformations(namespace string) JQTransformationInterface {
 	return newJQTransformations(c, namespace)
 }
 
+func (c *FlowV1alpha1Client) SchemaValidators(namespace string) SchemaValidatorInterface {
+	return newSchemaValidators(c, namespace)
+}
+
 func (c *FlowV1alpha1Client) Synchronizers(namespace string) SynchronizerInterface {
 	return newSynchronizers(c, namespace)
 }
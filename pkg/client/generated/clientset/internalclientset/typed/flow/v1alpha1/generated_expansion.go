@@ -18,8 +18,14 @@ limitations under the License.
 
 package v1alpha1
 
+type BridgeExpansion interface{}
+
+type DeduplicatorExpansion interface{}
+
 type JQTransformationExpansion interface{}
 
+type SchemaValidatorExpansion interface{}
+
 type SynchronizerExpansion interface{}
 
 type TransformationExpansion interface{}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// DeduplicatorsGetter has a method to return a DeduplicatorInterface.
+// A group's client should implement this interface.
+type DeduplicatorsGetter interface {
+	Deduplicators(namespace string) DeduplicatorInterface
+}
+
+// DeduplicatorInterface has methods to work with Deduplicator resources.
+type DeduplicatorInterface interface {
+	Create(ctx context.Context, deduplicator *v1beta1.Deduplicator, opts v1.CreateOptions) (*v1beta1.Deduplicator, error)
+	Update(ctx context.Context, deduplicator *v1beta1.Deduplicator, opts v1.UpdateOptions) (*v1beta1.Deduplicator, error)
+	UpdateStatus(ctx context.Context, deduplicator *v1beta1.Deduplicator, opts v1.UpdateOptions) (*v1beta1.Deduplicator, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Deduplicator, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.DeduplicatorList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Deduplicator, err error)
+	DeduplicatorExpansion
+}
+
+// deduplicators implements DeduplicatorInterface
+type deduplicators struct {
+	client rest.Interface
+	ns     string
+}
+
+// newDeduplicators returns a Deduplicators
+func newDeduplicators(c *FlowV1beta1Client, namespace string) *deduplicators {
+	return &deduplicators{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the deduplicator, and returns the corresponding deduplicator object, and an error if there is any.
+func (c *deduplicators) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Deduplicator, err error) {
+	result = &v1beta1.Deduplicator{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of Deduplicators that match those selectors.
+func (c *deduplicators) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.DeduplicatorList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.DeduplicatorList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested deduplicators.
+func (c *deduplicators) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a deduplicator and creates it.  Returns the server's representation of the deduplicator, and an error, if there is any.
+func (c *deduplicators) Create(ctx context.Context, deduplicator *v1beta1.Deduplicator, opts v1.CreateOptions) (result *v1beta1.Deduplicator, err error) {
+	result = &v1beta1.Deduplicator{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deduplicator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a deduplicator and updates it. Returns the server's representation of the deduplicator, and an error, if there is any.
+func (c *deduplicators) Update(ctx context.Context, deduplicator *v1beta1.Deduplicator, opts v1.UpdateOptions) (result *v1beta1.Deduplicator, err error) {
+	result = &v1beta1.Deduplicator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		Name(deduplicator.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deduplicator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *deduplicators) UpdateStatus(ctx context.Context, deduplicator *v1beta1.Deduplicator, opts v1.UpdateOptions) (result *v1beta1.Deduplicator, err error) {
+	result = &v1beta1.Deduplicator{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		Name(deduplicator.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(deduplicator).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the deduplicator and deletes it. Returns an error if one occurs.
+func (c *deduplicators) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *deduplicators) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("deduplicators").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched deduplicator.
+func (c *deduplicators) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Deduplicator, err error) {
+	result = &v1beta1.Deduplicator{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("deduplicators").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
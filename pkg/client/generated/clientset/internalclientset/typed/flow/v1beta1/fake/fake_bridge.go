@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeBridges implements BridgeInterface
+type FakeBridges struct {
+	Fake *FakeFlowV1beta1
+	ns   string
+}
+
+var bridgesResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1beta1", Resource: "bridges"}
+
+var bridgesKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1beta1", Kind: "Bridge"}
+
+// Get takes name of the bridge, and returns the corresponding bridge object, and an error if there is any.
+func (c *FakeBridges) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Bridge, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(bridgesResource, c.ns, name), &v1beta1.Bridge{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Bridge), err
+}
+
+// List takes label and field selectors, and returns the list of Bridges that match those selectors.
+func (c *FakeBridges) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.BridgeList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(bridgesResource, bridgesKind, c.ns, opts), &v1beta1.BridgeList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.BridgeList{ListMeta: obj.(*v1beta1.BridgeList).ListMeta}
+	for _, item := range obj.(*v1beta1.BridgeList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested bridges.
+func (c *FakeBridges) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(bridgesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a bridge and creates it.  Returns the server's representation of the bridge, and an error, if there is any.
+func (c *FakeBridges) Create(ctx context.Context, bridge *v1beta1.Bridge, opts v1.CreateOptions) (result *v1beta1.Bridge, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(bridgesResource, c.ns, bridge), &v1beta1.Bridge{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Bridge), err
+}
+
+// Update takes the representation of a bridge and updates it. Returns the server's representation of the bridge, and an error, if there is any.
+func (c *FakeBridges) Update(ctx context.Context, bridge *v1beta1.Bridge, opts v1.UpdateOptions) (result *v1beta1.Bridge, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(bridgesResource, c.ns, bridge), &v1beta1.Bridge{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Bridge), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeBridges) UpdateStatus(ctx context.Context, bridge *v1beta1.Bridge, opts v1.UpdateOptions) (*v1beta1.Bridge, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(bridgesResource, "status", c.ns, bridge), &v1beta1.Bridge{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Bridge), err
+}
+
+// Delete takes name of the bridge and deletes it. Returns an error if one occurs.
+func (c *FakeBridges) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(bridgesResource, c.ns, name, opts), &v1beta1.Bridge{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeBridges) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(bridgesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.BridgeList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched bridge.
+func (c *FakeBridges) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Bridge, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(bridgesResource, c.ns, name, pt, data, subresources...), &v1beta1.Bridge{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Bridge), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeTransformations implements TransformationInterface
+type FakeTransformations struct {
+	Fake *FakeFlowV1beta1
+	ns   string
+}
+
+var transformationsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1beta1", Resource: "transformations"}
+
+var transformationsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1beta1", Kind: "Transformation"}
+
+// Get takes name of the transformation, and returns the corresponding transformation object, and an error if there is any.
+func (c *FakeTransformations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.Transformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(transformationsResource, c.ns, name), &v1beta1.Transformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Transformation), err
+}
+
+// List takes label and field selectors, and returns the list of Transformations that match those selectors.
+func (c *FakeTransformations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.TransformationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(transformationsResource, transformationsKind, c.ns, opts), &v1beta1.TransformationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.TransformationList{ListMeta: obj.(*v1beta1.TransformationList).ListMeta}
+	for _, item := range obj.(*v1beta1.TransformationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested transformations.
+func (c *FakeTransformations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(transformationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a transformation and creates it.  Returns the server's representation of the transformation, and an error, if there is any.
+func (c *FakeTransformations) Create(ctx context.Context, transformation *v1beta1.Transformation, opts v1.CreateOptions) (result *v1beta1.Transformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(transformationsResource, c.ns, transformation), &v1beta1.Transformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Transformation), err
+}
+
+// Update takes the representation of a transformation and updates it. Returns the server's representation of the transformation, and an error, if there is any.
+func (c *FakeTransformations) Update(ctx context.Context, transformation *v1beta1.Transformation, opts v1.UpdateOptions) (result *v1beta1.Transformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(transformationsResource, c.ns, transformation), &v1beta1.Transformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Transformation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeTransformations) UpdateStatus(ctx context.Context, transformation *v1beta1.Transformation, opts v1.UpdateOptions) (*v1beta1.Transformation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(transformationsResource, "status", c.ns, transformation), &v1beta1.Transformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Transformation), err
+}
+
+// Delete takes name of the transformation and deletes it. Returns an error if one occurs.
+func (c *FakeTransformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(transformationsResource, c.ns, name, opts), &v1beta1.Transformation{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeTransformations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(transformationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.TransformationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched transformation.
+func (c *FakeTransformations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Transformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(transformationsResource, c.ns, name, pt, data, subresources...), &v1beta1.Transformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.Transformation), err
+}
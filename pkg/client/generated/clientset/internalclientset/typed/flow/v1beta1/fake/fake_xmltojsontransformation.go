@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeXMLToJSONTransformations implements XMLToJSONTransformationInterface
+type FakeXMLToJSONTransformations struct {
+	Fake *FakeFlowV1beta1
+	ns   string
+}
+
+var xmltojsontransformationsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1beta1", Resource: "xmltojsontransformations"}
+
+var xmltojsontransformationsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1beta1", Kind: "XMLToJSONTransformation"}
+
+// Get takes name of the xMLToJSONTransformation, and returns the corresponding xMLToJSONTransformation object, and an error if there is any.
+func (c *FakeXMLToJSONTransformations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.XMLToJSONTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(xmltojsontransformationsResource, c.ns, name), &v1beta1.XMLToJSONTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XMLToJSONTransformation), err
+}
+
+// List takes label and field selectors, and returns the list of XMLToJSONTransformations that match those selectors.
+func (c *FakeXMLToJSONTransformations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.XMLToJSONTransformationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(xmltojsontransformationsResource, xmltojsontransformationsKind, c.ns, opts), &v1beta1.XMLToJSONTransformationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.XMLToJSONTransformationList{ListMeta: obj.(*v1beta1.XMLToJSONTransformationList).ListMeta}
+	for _, item := range obj.(*v1beta1.XMLToJSONTransformationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested xMLToJSONTransformations.
+func (c *FakeXMLToJSONTransformations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(xmltojsontransformationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a xMLToJSONTransformation and creates it.  Returns the server's representation of the xMLToJSONTransformation, and an error, if there is any.
+func (c *FakeXMLToJSONTransformations) Create(ctx context.Context, xMLToJSONTransformation *v1beta1.XMLToJSONTransformation, opts v1.CreateOptions) (result *v1beta1.XMLToJSONTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(xmltojsontransformationsResource, c.ns, xMLToJSONTransformation), &v1beta1.XMLToJSONTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XMLToJSONTransformation), err
+}
+
+// Update takes the representation of a xMLToJSONTransformation and updates it. Returns the server's representation of the xMLToJSONTransformation, and an error, if there is any.
+func (c *FakeXMLToJSONTransformations) Update(ctx context.Context, xMLToJSONTransformation *v1beta1.XMLToJSONTransformation, opts v1.UpdateOptions) (result *v1beta1.XMLToJSONTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(xmltojsontransformationsResource, c.ns, xMLToJSONTransformation), &v1beta1.XMLToJSONTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XMLToJSONTransformation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeXMLToJSONTransformations) UpdateStatus(ctx context.Context, xMLToJSONTransformation *v1beta1.XMLToJSONTransformation, opts v1.UpdateOptions) (*v1beta1.XMLToJSONTransformation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(xmltojsontransformationsResource, "status", c.ns, xMLToJSONTransformation), &v1beta1.XMLToJSONTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XMLToJSONTransformation), err
+}
+
+// Delete takes name of the xMLToJSONTransformation and deletes it. Returns an error if one occurs.
+func (c *FakeXMLToJSONTransformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(xmltojsontransformationsResource, c.ns, name, opts), &v1beta1.XMLToJSONTransformation{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeXMLToJSONTransformations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(xmltojsontransformationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.XMLToJSONTransformationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched xMLToJSONTransformation.
+func (c *FakeXMLToJSONTransformations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.XMLToJSONTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(xmltojsontransformationsResource, c.ns, name, pt, data, subresources...), &v1beta1.XMLToJSONTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XMLToJSONTransformation), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeXSLTTransformations implements XSLTTransformationInterface
+type FakeXSLTTransformations struct {
+	Fake *FakeFlowV1beta1
+	ns   string
+}
+
+var xslttransformationsResource = schema.GroupVersionResource{Group: "flow.triggermesh.io", Version: "v1beta1", Resource: "xslttransformations"}
+
+var xslttransformationsKind = schema.GroupVersionKind{Group: "flow.triggermesh.io", Version: "v1beta1", Kind: "XSLTTransformation"}
+
+// Get takes name of the xSLTTransformation, and returns the corresponding xSLTTransformation object, and an error if there is any.
+func (c *FakeXSLTTransformations) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.XSLTTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(xslttransformationsResource, c.ns, name), &v1beta1.XSLTTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XSLTTransformation), err
+}
+
+// List takes label and field selectors, and returns the list of XSLTTransformations that match those selectors.
+func (c *FakeXSLTTransformations) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.XSLTTransformationList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(xslttransformationsResource, xslttransformationsKind, c.ns, opts), &v1beta1.XSLTTransformationList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.XSLTTransformationList{ListMeta: obj.(*v1beta1.XSLTTransformationList).ListMeta}
+	for _, item := range obj.(*v1beta1.XSLTTransformationList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested xSLTTransformations.
+func (c *FakeXSLTTransformations) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(xslttransformationsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a xSLTTransformation and creates it.  Returns the server's representation of the xSLTTransformation, and an error, if there is any.
+func (c *FakeXSLTTransformations) Create(ctx context.Context, xSLTTransformation *v1beta1.XSLTTransformation, opts v1.CreateOptions) (result *v1beta1.XSLTTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(xslttransformationsResource, c.ns, xSLTTransformation), &v1beta1.XSLTTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XSLTTransformation), err
+}
+
+// Update takes the representation of a xSLTTransformation and updates it. Returns the server's representation of the xSLTTransformation, and an error, if there is any.
+func (c *FakeXSLTTransformations) Update(ctx context.Context, xSLTTransformation *v1beta1.XSLTTransformation, opts v1.UpdateOptions) (result *v1beta1.XSLTTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(xslttransformationsResource, c.ns, xSLTTransformation), &v1beta1.XSLTTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XSLTTransformation), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeXSLTTransformations) UpdateStatus(ctx context.Context, xSLTTransformation *v1beta1.XSLTTransformation, opts v1.UpdateOptions) (*v1beta1.XSLTTransformation, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(xslttransformationsResource, "status", c.ns, xSLTTransformation), &v1beta1.XSLTTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XSLTTransformation), err
+}
+
+// Delete takes name of the xSLTTransformation and deletes it. Returns an error if one occurs.
+func (c *FakeXSLTTransformations) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(xslttransformationsResource, c.ns, name, opts), &v1beta1.XSLTTransformation{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeXSLTTransformations) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(xslttransformationsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.XSLTTransformationList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched xSLTTransformation.
+func (c *FakeXSLTTransformations) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.XSLTTransformation, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(xslttransformationsResource, c.ns, name, pt, data, subresources...), &v1beta1.XSLTTransformation{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.XSLTTransformation), err
+}
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeRoutingV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeRoutingV1beta1) Filters(namespace string) v1beta1.FilterInterface {
+	return &FakeFilters{c, namespace}
+}
+
+func (c *FakeRoutingV1beta1) Splitters(namespace string) v1beta1.SplitterInterface {
+	return &FakeSplitters{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeRoutingV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
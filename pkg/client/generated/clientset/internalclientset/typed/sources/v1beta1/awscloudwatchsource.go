@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AWSCloudWatchSourcesGetter has a method to return a AWSCloudWatchSourceInterface.
+// A group's client should implement this interface.
+type AWSCloudWatchSourcesGetter interface {
+	AWSCloudWatchSources(namespace string) AWSCloudWatchSourceInterface
+}
+
+// AWSCloudWatchSourceInterface has methods to work with AWSCloudWatchSource resources.
+type AWSCloudWatchSourceInterface interface {
+	Create(ctx context.Context, aWSCloudWatchSource *v1beta1.AWSCloudWatchSource, opts v1.CreateOptions) (*v1beta1.AWSCloudWatchSource, error)
+	Update(ctx context.Context, aWSCloudWatchSource *v1beta1.AWSCloudWatchSource, opts v1.UpdateOptions) (*v1beta1.AWSCloudWatchSource, error)
+	UpdateStatus(ctx context.Context, aWSCloudWatchSource *v1beta1.AWSCloudWatchSource, opts v1.UpdateOptions) (*v1beta1.AWSCloudWatchSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.AWSCloudWatchSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.AWSCloudWatchSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSCloudWatchSource, err error)
+	AWSCloudWatchSourceExpansion
+}
+
+// aWSCloudWatchSources implements AWSCloudWatchSourceInterface
+type aWSCloudWatchSources struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAWSCloudWatchSources returns a AWSCloudWatchSources
+func newAWSCloudWatchSources(c *SourcesV1beta1Client, namespace string) *aWSCloudWatchSources {
+	return &aWSCloudWatchSources{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the aWSCloudWatchSource, and returns the corresponding aWSCloudWatchSource object, and an error if there is any.
+func (c *aWSCloudWatchSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSCloudWatchSource, err error) {
+	result = &v1beta1.AWSCloudWatchSource{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AWSCloudWatchSources that match those selectors.
+func (c *aWSCloudWatchSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSCloudWatchSourceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.AWSCloudWatchSourceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aWSCloudWatchSources.
+func (c *aWSCloudWatchSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a aWSCloudWatchSource and creates it.  Returns the server's representation of the aWSCloudWatchSource, and an error, if there is any.
+func (c *aWSCloudWatchSources) Create(ctx context.Context, aWSCloudWatchSource *v1beta1.AWSCloudWatchSource, opts v1.CreateOptions) (result *v1beta1.AWSCloudWatchSource, err error) {
+	result = &v1beta1.AWSCloudWatchSource{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSCloudWatchSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aWSCloudWatchSource and updates it. Returns the server's representation of the aWSCloudWatchSource, and an error, if there is any.
+func (c *aWSCloudWatchSources) Update(ctx context.Context, aWSCloudWatchSource *v1beta1.AWSCloudWatchSource, opts v1.UpdateOptions) (result *v1beta1.AWSCloudWatchSource, err error) {
+	result = &v1beta1.AWSCloudWatchSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		Name(aWSCloudWatchSource.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSCloudWatchSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aWSCloudWatchSources) UpdateStatus(ctx context.Context, aWSCloudWatchSource *v1beta1.AWSCloudWatchSource, opts v1.UpdateOptions) (result *v1beta1.AWSCloudWatchSource, err error) {
+	result = &v1beta1.AWSCloudWatchSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		Name(aWSCloudWatchSource.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSCloudWatchSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the aWSCloudWatchSource and deletes it. Returns an error if one occurs.
+func (c *aWSCloudWatchSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aWSCloudWatchSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched aWSCloudWatchSource.
+func (c *aWSCloudWatchSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSCloudWatchSource, err error) {
+	result = &v1beta1.AWSCloudWatchSource{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("awscloudwatchsources").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
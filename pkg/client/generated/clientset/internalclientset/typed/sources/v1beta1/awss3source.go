@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AWSS3SourcesGetter has a method to return a AWSS3SourceInterface.
+// A group's client should implement this interface.
+type AWSS3SourcesGetter interface {
+	AWSS3Sources(namespace string) AWSS3SourceInterface
+}
+
+// AWSS3SourceInterface has methods to work with AWSS3Source resources.
+type AWSS3SourceInterface interface {
+	Create(ctx context.Context, aWSS3Source *v1beta1.AWSS3Source, opts v1.CreateOptions) (*v1beta1.AWSS3Source, error)
+	Update(ctx context.Context, aWSS3Source *v1beta1.AWSS3Source, opts v1.UpdateOptions) (*v1beta1.AWSS3Source, error)
+	UpdateStatus(ctx context.Context, aWSS3Source *v1beta1.AWSS3Source, opts v1.UpdateOptions) (*v1beta1.AWSS3Source, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.AWSS3Source, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.AWSS3SourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSS3Source, err error)
+	AWSS3SourceExpansion
+}
+
+// aWSS3Sources implements AWSS3SourceInterface
+type aWSS3Sources struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAWSS3Sources returns a AWSS3Sources
+func newAWSS3Sources(c *SourcesV1beta1Client, namespace string) *aWSS3Sources {
+	return &aWSS3Sources{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the aWSS3Source, and returns the corresponding aWSS3Source object, and an error if there is any.
+func (c *aWSS3Sources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSS3Source, err error) {
+	result = &v1beta1.AWSS3Source{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AWSS3Sources that match those selectors.
+func (c *aWSS3Sources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSS3SourceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.AWSS3SourceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aWSS3Sources.
+func (c *aWSS3Sources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a aWSS3Source and creates it.  Returns the server's representation of the aWSS3Source, and an error, if there is any.
+func (c *aWSS3Sources) Create(ctx context.Context, aWSS3Source *v1beta1.AWSS3Source, opts v1.CreateOptions) (result *v1beta1.AWSS3Source, err error) {
+	result = &v1beta1.AWSS3Source{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSS3Source).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aWSS3Source and updates it. Returns the server's representation of the aWSS3Source, and an error, if there is any.
+func (c *aWSS3Sources) Update(ctx context.Context, aWSS3Source *v1beta1.AWSS3Source, opts v1.UpdateOptions) (result *v1beta1.AWSS3Source, err error) {
+	result = &v1beta1.AWSS3Source{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		Name(aWSS3Source.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSS3Source).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aWSS3Sources) UpdateStatus(ctx context.Context, aWSS3Source *v1beta1.AWSS3Source, opts v1.UpdateOptions) (result *v1beta1.AWSS3Source, err error) {
+	result = &v1beta1.AWSS3Source{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		Name(aWSS3Source.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSS3Source).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the aWSS3Source and deletes it. Returns an error if one occurs.
+func (c *aWSS3Sources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aWSS3Sources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awss3sources").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched aWSS3Source.
+func (c *aWSS3Sources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSS3Source, err error) {
+	result = &v1beta1.AWSS3Source{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("awss3sources").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
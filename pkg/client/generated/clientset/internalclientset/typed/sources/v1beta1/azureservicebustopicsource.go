@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AzureServiceBusTopicSourcesGetter has a method to return a AzureServiceBusTopicSourceInterface.
+// A group's client should implement this interface.
+type AzureServiceBusTopicSourcesGetter interface {
+	AzureServiceBusTopicSources(namespace string) AzureServiceBusTopicSourceInterface
+}
+
+// AzureServiceBusTopicSourceInterface has methods to work with AzureServiceBusTopicSource resources.
+type AzureServiceBusTopicSourceInterface interface {
+	Create(ctx context.Context, azureServiceBusTopicSource *v1beta1.AzureServiceBusTopicSource, opts v1.CreateOptions) (*v1beta1.AzureServiceBusTopicSource, error)
+	Update(ctx context.Context, azureServiceBusTopicSource *v1beta1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*v1beta1.AzureServiceBusTopicSource, error)
+	UpdateStatus(ctx context.Context, azureServiceBusTopicSource *v1beta1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*v1beta1.AzureServiceBusTopicSource, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.AzureServiceBusTopicSource, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.AzureServiceBusTopicSourceList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureServiceBusTopicSource, err error)
+	AzureServiceBusTopicSourceExpansion
+}
+
+// azureServiceBusTopicSources implements AzureServiceBusTopicSourceInterface
+type azureServiceBusTopicSources struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAzureServiceBusTopicSources returns a AzureServiceBusTopicSources
+func newAzureServiceBusTopicSources(c *SourcesV1beta1Client, namespace string) *azureServiceBusTopicSources {
+	return &azureServiceBusTopicSources{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the azureServiceBusTopicSource, and returns the corresponding azureServiceBusTopicSource object, and an error if there is any.
+func (c *azureServiceBusTopicSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureServiceBusTopicSource, err error) {
+	result = &v1beta1.AzureServiceBusTopicSource{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AzureServiceBusTopicSources that match those selectors.
+func (c *azureServiceBusTopicSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureServiceBusTopicSourceList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.AzureServiceBusTopicSourceList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested azureServiceBusTopicSources.
+func (c *azureServiceBusTopicSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a azureServiceBusTopicSource and creates it.  Returns the server's representation of the azureServiceBusTopicSource, and an error, if there is any.
+func (c *azureServiceBusTopicSources) Create(ctx context.Context, azureServiceBusTopicSource *v1beta1.AzureServiceBusTopicSource, opts v1.CreateOptions) (result *v1beta1.AzureServiceBusTopicSource, err error) {
+	result = &v1beta1.AzureServiceBusTopicSource{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(azureServiceBusTopicSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a azureServiceBusTopicSource and updates it. Returns the server's representation of the azureServiceBusTopicSource, and an error, if there is any.
+func (c *azureServiceBusTopicSources) Update(ctx context.Context, azureServiceBusTopicSource *v1beta1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (result *v1beta1.AzureServiceBusTopicSource, err error) {
+	result = &v1beta1.AzureServiceBusTopicSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		Name(azureServiceBusTopicSource.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(azureServiceBusTopicSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *azureServiceBusTopicSources) UpdateStatus(ctx context.Context, azureServiceBusTopicSource *v1beta1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (result *v1beta1.AzureServiceBusTopicSource, err error) {
+	result = &v1beta1.AzureServiceBusTopicSource{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		Name(azureServiceBusTopicSource.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(azureServiceBusTopicSource).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the azureServiceBusTopicSource and deletes it. Returns an error if one occurs.
+func (c *azureServiceBusTopicSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *azureServiceBusTopicSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched azureServiceBusTopicSource.
+func (c *azureServiceBusTopicSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureServiceBusTopicSource, err error) {
+	result = &v1beta1.AzureServiceBusTopicSource{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("azureservicebustopicsources").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAWSCognitoIdentitySources implements AWSCognitoIdentitySourceInterface
+type FakeAWSCognitoIdentitySources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var awscognitoidentitysourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "awscognitoidentitysources"}
+
+var awscognitoidentitysourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AWSCognitoIdentitySource"}
+
+// Get takes name of the aWSCognitoIdentitySource, and returns the corresponding aWSCognitoIdentitySource object, and an error if there is any.
+func (c *FakeAWSCognitoIdentitySources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSCognitoIdentitySource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(awscognitoidentitysourcesResource, c.ns, name), &v1beta1.AWSCognitoIdentitySource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSCognitoIdentitySource), err
+}
+
+// List takes label and field selectors, and returns the list of AWSCognitoIdentitySources that match those selectors.
+func (c *FakeAWSCognitoIdentitySources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSCognitoIdentitySourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(awscognitoidentitysourcesResource, awscognitoidentitysourcesKind, c.ns, opts), &v1beta1.AWSCognitoIdentitySourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AWSCognitoIdentitySourceList{ListMeta: obj.(*v1beta1.AWSCognitoIdentitySourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AWSCognitoIdentitySourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aWSCognitoIdentitySources.
+func (c *FakeAWSCognitoIdentitySources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(awscognitoidentitysourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a aWSCognitoIdentitySource and creates it.  Returns the server's representation of the aWSCognitoIdentitySource, and an error, if there is any.
+func (c *FakeAWSCognitoIdentitySources) Create(ctx context.Context, aWSCognitoIdentitySource *v1beta1.AWSCognitoIdentitySource, opts v1.CreateOptions) (result *v1beta1.AWSCognitoIdentitySource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(awscognitoidentitysourcesResource, c.ns, aWSCognitoIdentitySource), &v1beta1.AWSCognitoIdentitySource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSCognitoIdentitySource), err
+}
+
+// Update takes the representation of a aWSCognitoIdentitySource and updates it. Returns the server's representation of the aWSCognitoIdentitySource, and an error, if there is any.
+func (c *FakeAWSCognitoIdentitySources) Update(ctx context.Context, aWSCognitoIdentitySource *v1beta1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (result *v1beta1.AWSCognitoIdentitySource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(awscognitoidentitysourcesResource, c.ns, aWSCognitoIdentitySource), &v1beta1.AWSCognitoIdentitySource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSCognitoIdentitySource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAWSCognitoIdentitySources) UpdateStatus(ctx context.Context, aWSCognitoIdentitySource *v1beta1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*v1beta1.AWSCognitoIdentitySource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(awscognitoidentitysourcesResource, "status", c.ns, aWSCognitoIdentitySource), &v1beta1.AWSCognitoIdentitySource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSCognitoIdentitySource), err
+}
+
+// Delete takes name of the aWSCognitoIdentitySource and deletes it. Returns an error if one occurs.
+func (c *FakeAWSCognitoIdentitySources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(awscognitoidentitysourcesResource, c.ns, name, opts), &v1beta1.AWSCognitoIdentitySource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAWSCognitoIdentitySources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(awscognitoidentitysourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AWSCognitoIdentitySourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aWSCognitoIdentitySource.
+func (c *FakeAWSCognitoIdentitySources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSCognitoIdentitySource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(awscognitoidentitysourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AWSCognitoIdentitySource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSCognitoIdentitySource), err
+}
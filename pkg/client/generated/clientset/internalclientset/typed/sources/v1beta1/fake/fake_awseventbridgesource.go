@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAWSEventBridgeSources implements AWSEventBridgeSourceInterface
+type FakeAWSEventBridgeSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var awseventbridgesourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "awseventbridgesources"}
+
+var awseventbridgesourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AWSEventBridgeSource"}
+
+// Get takes name of the aWSEventBridgeSource, and returns the corresponding aWSEventBridgeSource object, and an error if there is any.
+func (c *FakeAWSEventBridgeSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSEventBridgeSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(awseventbridgesourcesResource, c.ns, name), &v1beta1.AWSEventBridgeSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSEventBridgeSource), err
+}
+
+// List takes label and field selectors, and returns the list of AWSEventBridgeSources that match those selectors.
+func (c *FakeAWSEventBridgeSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSEventBridgeSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(awseventbridgesourcesResource, awseventbridgesourcesKind, c.ns, opts), &v1beta1.AWSEventBridgeSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AWSEventBridgeSourceList{ListMeta: obj.(*v1beta1.AWSEventBridgeSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AWSEventBridgeSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aWSEventBridgeSources.
+func (c *FakeAWSEventBridgeSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(awseventbridgesourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a aWSEventBridgeSource and creates it.  Returns the server's representation of the aWSEventBridgeSource, and an error, if there is any.
+func (c *FakeAWSEventBridgeSources) Create(ctx context.Context, aWSEventBridgeSource *v1beta1.AWSEventBridgeSource, opts v1.CreateOptions) (result *v1beta1.AWSEventBridgeSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(awseventbridgesourcesResource, c.ns, aWSEventBridgeSource), &v1beta1.AWSEventBridgeSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSEventBridgeSource), err
+}
+
+// Update takes the representation of a aWSEventBridgeSource and updates it. Returns the server's representation of the aWSEventBridgeSource, and an error, if there is any.
+func (c *FakeAWSEventBridgeSources) Update(ctx context.Context, aWSEventBridgeSource *v1beta1.AWSEventBridgeSource, opts v1.UpdateOptions) (result *v1beta1.AWSEventBridgeSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(awseventbridgesourcesResource, c.ns, aWSEventBridgeSource), &v1beta1.AWSEventBridgeSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSEventBridgeSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAWSEventBridgeSources) UpdateStatus(ctx context.Context, aWSEventBridgeSource *v1beta1.AWSEventBridgeSource, opts v1.UpdateOptions) (*v1beta1.AWSEventBridgeSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(awseventbridgesourcesResource, "status", c.ns, aWSEventBridgeSource), &v1beta1.AWSEventBridgeSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSEventBridgeSource), err
+}
+
+// Delete takes name of the aWSEventBridgeSource and deletes it. Returns an error if one occurs.
+func (c *FakeAWSEventBridgeSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(awseventbridgesourcesResource, c.ns, name, opts), &v1beta1.AWSEventBridgeSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAWSEventBridgeSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(awseventbridgesourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AWSEventBridgeSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aWSEventBridgeSource.
+func (c *FakeAWSEventBridgeSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSEventBridgeSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(awseventbridgesourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AWSEventBridgeSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSEventBridgeSource), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAzureBlobStorageSources implements AzureBlobStorageSourceInterface
+type FakeAzureBlobStorageSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var azureblobstoragesourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "azureblobstoragesources"}
+
+var azureblobstoragesourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AzureBlobStorageSource"}
+
+// Get takes name of the azureBlobStorageSource, and returns the corresponding azureBlobStorageSource object, and an error if there is any.
+func (c *FakeAzureBlobStorageSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureBlobStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(azureblobstoragesourcesResource, c.ns, name), &v1beta1.AzureBlobStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureBlobStorageSource), err
+}
+
+// List takes label and field selectors, and returns the list of AzureBlobStorageSources that match those selectors.
+func (c *FakeAzureBlobStorageSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureBlobStorageSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(azureblobstoragesourcesResource, azureblobstoragesourcesKind, c.ns, opts), &v1beta1.AzureBlobStorageSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AzureBlobStorageSourceList{ListMeta: obj.(*v1beta1.AzureBlobStorageSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AzureBlobStorageSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested azureBlobStorageSources.
+func (c *FakeAzureBlobStorageSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(azureblobstoragesourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a azureBlobStorageSource and creates it.  Returns the server's representation of the azureBlobStorageSource, and an error, if there is any.
+func (c *FakeAzureBlobStorageSources) Create(ctx context.Context, azureBlobStorageSource *v1beta1.AzureBlobStorageSource, opts v1.CreateOptions) (result *v1beta1.AzureBlobStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(azureblobstoragesourcesResource, c.ns, azureBlobStorageSource), &v1beta1.AzureBlobStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureBlobStorageSource), err
+}
+
+// Update takes the representation of a azureBlobStorageSource and updates it. Returns the server's representation of the azureBlobStorageSource, and an error, if there is any.
+func (c *FakeAzureBlobStorageSources) Update(ctx context.Context, azureBlobStorageSource *v1beta1.AzureBlobStorageSource, opts v1.UpdateOptions) (result *v1beta1.AzureBlobStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(azureblobstoragesourcesResource, c.ns, azureBlobStorageSource), &v1beta1.AzureBlobStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureBlobStorageSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAzureBlobStorageSources) UpdateStatus(ctx context.Context, azureBlobStorageSource *v1beta1.AzureBlobStorageSource, opts v1.UpdateOptions) (*v1beta1.AzureBlobStorageSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(azureblobstoragesourcesResource, "status", c.ns, azureBlobStorageSource), &v1beta1.AzureBlobStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureBlobStorageSource), err
+}
+
+// Delete takes name of the azureBlobStorageSource and deletes it. Returns an error if one occurs.
+func (c *FakeAzureBlobStorageSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(azureblobstoragesourcesResource, c.ns, name, opts), &v1beta1.AzureBlobStorageSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAzureBlobStorageSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(azureblobstoragesourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AzureBlobStorageSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched azureBlobStorageSource.
+func (c *FakeAzureBlobStorageSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureBlobStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(azureblobstoragesourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AzureBlobStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureBlobStorageSource), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAzureEventGridSources implements AzureEventGridSourceInterface
+type FakeAzureEventGridSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var azureeventgridsourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "azureeventgridsources"}
+
+var azureeventgridsourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AzureEventGridSource"}
+
+// Get takes name of the azureEventGridSource, and returns the corresponding azureEventGridSource object, and an error if there is any.
+func (c *FakeAzureEventGridSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureEventGridSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(azureeventgridsourcesResource, c.ns, name), &v1beta1.AzureEventGridSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventGridSource), err
+}
+
+// List takes label and field selectors, and returns the list of AzureEventGridSources that match those selectors.
+func (c *FakeAzureEventGridSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureEventGridSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(azureeventgridsourcesResource, azureeventgridsourcesKind, c.ns, opts), &v1beta1.AzureEventGridSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AzureEventGridSourceList{ListMeta: obj.(*v1beta1.AzureEventGridSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AzureEventGridSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested azureEventGridSources.
+func (c *FakeAzureEventGridSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(azureeventgridsourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a azureEventGridSource and creates it.  Returns the server's representation of the azureEventGridSource, and an error, if there is any.
+func (c *FakeAzureEventGridSources) Create(ctx context.Context, azureEventGridSource *v1beta1.AzureEventGridSource, opts v1.CreateOptions) (result *v1beta1.AzureEventGridSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(azureeventgridsourcesResource, c.ns, azureEventGridSource), &v1beta1.AzureEventGridSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventGridSource), err
+}
+
+// Update takes the representation of a azureEventGridSource and updates it. Returns the server's representation of the azureEventGridSource, and an error, if there is any.
+func (c *FakeAzureEventGridSources) Update(ctx context.Context, azureEventGridSource *v1beta1.AzureEventGridSource, opts v1.UpdateOptions) (result *v1beta1.AzureEventGridSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(azureeventgridsourcesResource, c.ns, azureEventGridSource), &v1beta1.AzureEventGridSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventGridSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAzureEventGridSources) UpdateStatus(ctx context.Context, azureEventGridSource *v1beta1.AzureEventGridSource, opts v1.UpdateOptions) (*v1beta1.AzureEventGridSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(azureeventgridsourcesResource, "status", c.ns, azureEventGridSource), &v1beta1.AzureEventGridSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventGridSource), err
+}
+
+// Delete takes name of the azureEventGridSource and deletes it. Returns an error if one occurs.
+func (c *FakeAzureEventGridSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(azureeventgridsourcesResource, c.ns, name, opts), &v1beta1.AzureEventGridSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAzureEventGridSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(azureeventgridsourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AzureEventGridSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched azureEventGridSource.
+func (c *FakeAzureEventGridSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureEventGridSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(azureeventgridsourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AzureEventGridSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventGridSource), err
+}
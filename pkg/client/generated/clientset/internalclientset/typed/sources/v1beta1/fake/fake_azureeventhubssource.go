@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAzureEventHubsSources implements AzureEventHubsSourceInterface
+type FakeAzureEventHubsSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var azureeventhubssourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "azureeventhubssources"}
+
+var azureeventhubssourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AzureEventHubsSource"}
+
+// Get takes name of the azureEventHubsSource, and returns the corresponding azureEventHubsSource object, and an error if there is any.
+func (c *FakeAzureEventHubsSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureEventHubsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(azureeventhubssourcesResource, c.ns, name), &v1beta1.AzureEventHubsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventHubsSource), err
+}
+
+// List takes label and field selectors, and returns the list of AzureEventHubsSources that match those selectors.
+func (c *FakeAzureEventHubsSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureEventHubsSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(azureeventhubssourcesResource, azureeventhubssourcesKind, c.ns, opts), &v1beta1.AzureEventHubsSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AzureEventHubsSourceList{ListMeta: obj.(*v1beta1.AzureEventHubsSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AzureEventHubsSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested azureEventHubsSources.
+func (c *FakeAzureEventHubsSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(azureeventhubssourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a azureEventHubsSource and creates it.  Returns the server's representation of the azureEventHubsSource, and an error, if there is any.
+func (c *FakeAzureEventHubsSources) Create(ctx context.Context, azureEventHubsSource *v1beta1.AzureEventHubsSource, opts v1.CreateOptions) (result *v1beta1.AzureEventHubsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(azureeventhubssourcesResource, c.ns, azureEventHubsSource), &v1beta1.AzureEventHubsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventHubsSource), err
+}
+
+// Update takes the representation of a azureEventHubsSource and updates it. Returns the server's representation of the azureEventHubsSource, and an error, if there is any.
+func (c *FakeAzureEventHubsSources) Update(ctx context.Context, azureEventHubsSource *v1beta1.AzureEventHubsSource, opts v1.UpdateOptions) (result *v1beta1.AzureEventHubsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(azureeventhubssourcesResource, c.ns, azureEventHubsSource), &v1beta1.AzureEventHubsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventHubsSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAzureEventHubsSources) UpdateStatus(ctx context.Context, azureEventHubsSource *v1beta1.AzureEventHubsSource, opts v1.UpdateOptions) (*v1beta1.AzureEventHubsSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(azureeventhubssourcesResource, "status", c.ns, azureEventHubsSource), &v1beta1.AzureEventHubsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventHubsSource), err
+}
+
+// Delete takes name of the azureEventHubsSource and deletes it. Returns an error if one occurs.
+func (c *FakeAzureEventHubsSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(azureeventhubssourcesResource, c.ns, name, opts), &v1beta1.AzureEventHubsSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAzureEventHubsSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(azureeventhubssourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AzureEventHubsSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched azureEventHubsSource.
+func (c *FakeAzureEventHubsSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureEventHubsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(azureeventhubssourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AzureEventHubsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureEventHubsSource), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAzureServiceBusQueueSources implements AzureServiceBusQueueSourceInterface
+type FakeAzureServiceBusQueueSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var azureservicebusqueuesourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "azureservicebusqueuesources"}
+
+var azureservicebusqueuesourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AzureServiceBusQueueSource"}
+
+// Get takes name of the azureServiceBusQueueSource, and returns the corresponding azureServiceBusQueueSource object, and an error if there is any.
+func (c *FakeAzureServiceBusQueueSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureServiceBusQueueSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(azureservicebusqueuesourcesResource, c.ns, name), &v1beta1.AzureServiceBusQueueSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusQueueSource), err
+}
+
+// List takes label and field selectors, and returns the list of AzureServiceBusQueueSources that match those selectors.
+func (c *FakeAzureServiceBusQueueSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureServiceBusQueueSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(azureservicebusqueuesourcesResource, azureservicebusqueuesourcesKind, c.ns, opts), &v1beta1.AzureServiceBusQueueSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AzureServiceBusQueueSourceList{ListMeta: obj.(*v1beta1.AzureServiceBusQueueSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AzureServiceBusQueueSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested azureServiceBusQueueSources.
+func (c *FakeAzureServiceBusQueueSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(azureservicebusqueuesourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a azureServiceBusQueueSource and creates it.  Returns the server's representation of the azureServiceBusQueueSource, and an error, if there is any.
+func (c *FakeAzureServiceBusQueueSources) Create(ctx context.Context, azureServiceBusQueueSource *v1beta1.AzureServiceBusQueueSource, opts v1.CreateOptions) (result *v1beta1.AzureServiceBusQueueSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(azureservicebusqueuesourcesResource, c.ns, azureServiceBusQueueSource), &v1beta1.AzureServiceBusQueueSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusQueueSource), err
+}
+
+// Update takes the representation of a azureServiceBusQueueSource and updates it. Returns the server's representation of the azureServiceBusQueueSource, and an error, if there is any.
+func (c *FakeAzureServiceBusQueueSources) Update(ctx context.Context, azureServiceBusQueueSource *v1beta1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (result *v1beta1.AzureServiceBusQueueSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(azureservicebusqueuesourcesResource, c.ns, azureServiceBusQueueSource), &v1beta1.AzureServiceBusQueueSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusQueueSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAzureServiceBusQueueSources) UpdateStatus(ctx context.Context, azureServiceBusQueueSource *v1beta1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*v1beta1.AzureServiceBusQueueSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(azureservicebusqueuesourcesResource, "status", c.ns, azureServiceBusQueueSource), &v1beta1.AzureServiceBusQueueSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusQueueSource), err
+}
+
+// Delete takes name of the azureServiceBusQueueSource and deletes it. Returns an error if one occurs.
+func (c *FakeAzureServiceBusQueueSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(azureservicebusqueuesourcesResource, c.ns, name, opts), &v1beta1.AzureServiceBusQueueSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAzureServiceBusQueueSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(azureservicebusqueuesourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AzureServiceBusQueueSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched azureServiceBusQueueSource.
+func (c *FakeAzureServiceBusQueueSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureServiceBusQueueSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(azureservicebusqueuesourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AzureServiceBusQueueSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusQueueSource), err
+}
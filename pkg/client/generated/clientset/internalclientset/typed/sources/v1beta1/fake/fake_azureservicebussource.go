@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAzureServiceBusSources implements AzureServiceBusSourceInterface
+type FakeAzureServiceBusSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var azureservicebussourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "azureservicebussources"}
+
+var azureservicebussourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "AzureServiceBusSource"}
+
+// Get takes name of the azureServiceBusSource, and returns the corresponding azureServiceBusSource object, and an error if there is any.
+func (c *FakeAzureServiceBusSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureServiceBusSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(azureservicebussourcesResource, c.ns, name), &v1beta1.AzureServiceBusSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusSource), err
+}
+
+// List takes label and field selectors, and returns the list of AzureServiceBusSources that match those selectors.
+func (c *FakeAzureServiceBusSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureServiceBusSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(azureservicebussourcesResource, azureservicebussourcesKind, c.ns, opts), &v1beta1.AzureServiceBusSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AzureServiceBusSourceList{ListMeta: obj.(*v1beta1.AzureServiceBusSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.AzureServiceBusSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested azureServiceBusSources.
+func (c *FakeAzureServiceBusSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(azureservicebussourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a azureServiceBusSource and creates it.  Returns the server's representation of the azureServiceBusSource, and an error, if there is any.
+func (c *FakeAzureServiceBusSources) Create(ctx context.Context, azureServiceBusSource *v1beta1.AzureServiceBusSource, opts v1.CreateOptions) (result *v1beta1.AzureServiceBusSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(azureservicebussourcesResource, c.ns, azureServiceBusSource), &v1beta1.AzureServiceBusSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusSource), err
+}
+
+// Update takes the representation of a azureServiceBusSource and updates it. Returns the server's representation of the azureServiceBusSource, and an error, if there is any.
+func (c *FakeAzureServiceBusSources) Update(ctx context.Context, azureServiceBusSource *v1beta1.AzureServiceBusSource, opts v1.UpdateOptions) (result *v1beta1.AzureServiceBusSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(azureservicebussourcesResource, c.ns, azureServiceBusSource), &v1beta1.AzureServiceBusSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAzureServiceBusSources) UpdateStatus(ctx context.Context, azureServiceBusSource *v1beta1.AzureServiceBusSource, opts v1.UpdateOptions) (*v1beta1.AzureServiceBusSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(azureservicebussourcesResource, "status", c.ns, azureServiceBusSource), &v1beta1.AzureServiceBusSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusSource), err
+}
+
+// Delete takes name of the azureServiceBusSource and deletes it. Returns an error if one occurs.
+func (c *FakeAzureServiceBusSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(azureservicebussourcesResource, c.ns, name, opts), &v1beta1.AzureServiceBusSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAzureServiceBusSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(azureservicebussourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AzureServiceBusSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched azureServiceBusSource.
+func (c *FakeAzureServiceBusSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureServiceBusSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(azureservicebussourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.AzureServiceBusSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureServiceBusSource), err
+}
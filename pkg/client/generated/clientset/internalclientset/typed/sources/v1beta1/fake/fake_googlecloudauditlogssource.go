@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudAuditLogsSources implements GoogleCloudAuditLogsSourceInterface
+type FakeGoogleCloudAuditLogsSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var googlecloudauditlogssourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "googlecloudauditlogssources"}
+
+var googlecloudauditlogssourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudAuditLogsSource"}
+
+// Get takes name of the googleCloudAuditLogsSource, and returns the corresponding googleCloudAuditLogsSource object, and an error if there is any.
+func (c *FakeGoogleCloudAuditLogsSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudAuditLogsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudauditlogssourcesResource, c.ns, name), &v1beta1.GoogleCloudAuditLogsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudAuditLogsSource), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudAuditLogsSources that match those selectors.
+func (c *FakeGoogleCloudAuditLogsSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudAuditLogsSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudauditlogssourcesResource, googlecloudauditlogssourcesKind, c.ns, opts), &v1beta1.GoogleCloudAuditLogsSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudAuditLogsSourceList{ListMeta: obj.(*v1beta1.GoogleCloudAuditLogsSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudAuditLogsSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudAuditLogsSources.
+func (c *FakeGoogleCloudAuditLogsSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudauditlogssourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudAuditLogsSource and creates it.  Returns the server's representation of the googleCloudAuditLogsSource, and an error, if there is any.
+func (c *FakeGoogleCloudAuditLogsSources) Create(ctx context.Context, googleCloudAuditLogsSource *v1beta1.GoogleCloudAuditLogsSource, opts v1.CreateOptions) (result *v1beta1.GoogleCloudAuditLogsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudauditlogssourcesResource, c.ns, googleCloudAuditLogsSource), &v1beta1.GoogleCloudAuditLogsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudAuditLogsSource), err
+}
+
+// Update takes the representation of a googleCloudAuditLogsSource and updates it. Returns the server's representation of the googleCloudAuditLogsSource, and an error, if there is any.
+func (c *FakeGoogleCloudAuditLogsSources) Update(ctx context.Context, googleCloudAuditLogsSource *v1beta1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudAuditLogsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudauditlogssourcesResource, c.ns, googleCloudAuditLogsSource), &v1beta1.GoogleCloudAuditLogsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudAuditLogsSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudAuditLogsSources) UpdateStatus(ctx context.Context, googleCloudAuditLogsSource *v1beta1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*v1beta1.GoogleCloudAuditLogsSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudauditlogssourcesResource, "status", c.ns, googleCloudAuditLogsSource), &v1beta1.GoogleCloudAuditLogsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudAuditLogsSource), err
+}
+
+// Delete takes name of the googleCloudAuditLogsSource and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudAuditLogsSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudauditlogssourcesResource, c.ns, name, opts), &v1beta1.GoogleCloudAuditLogsSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudAuditLogsSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudauditlogssourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudAuditLogsSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudAuditLogsSource.
+func (c *FakeGoogleCloudAuditLogsSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudAuditLogsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudauditlogssourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudAuditLogsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudAuditLogsSource), err
+}
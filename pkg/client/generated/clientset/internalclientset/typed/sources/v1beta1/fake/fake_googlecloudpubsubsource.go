@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudPubSubSources implements GoogleCloudPubSubSourceInterface
+type FakeGoogleCloudPubSubSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var googlecloudpubsubsourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "googlecloudpubsubsources"}
+
+var googlecloudpubsubsourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudPubSubSource"}
+
+// Get takes name of the googleCloudPubSubSource, and returns the corresponding googleCloudPubSubSource object, and an error if there is any.
+func (c *FakeGoogleCloudPubSubSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudPubSubSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudpubsubsourcesResource, c.ns, name), &v1beta1.GoogleCloudPubSubSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubSource), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudPubSubSources that match those selectors.
+func (c *FakeGoogleCloudPubSubSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudPubSubSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudpubsubsourcesResource, googlecloudpubsubsourcesKind, c.ns, opts), &v1beta1.GoogleCloudPubSubSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudPubSubSourceList{ListMeta: obj.(*v1beta1.GoogleCloudPubSubSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudPubSubSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudPubSubSources.
+func (c *FakeGoogleCloudPubSubSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudpubsubsourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudPubSubSource and creates it.  Returns the server's representation of the googleCloudPubSubSource, and an error, if there is any.
+func (c *FakeGoogleCloudPubSubSources) Create(ctx context.Context, googleCloudPubSubSource *v1beta1.GoogleCloudPubSubSource, opts v1.CreateOptions) (result *v1beta1.GoogleCloudPubSubSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudpubsubsourcesResource, c.ns, googleCloudPubSubSource), &v1beta1.GoogleCloudPubSubSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubSource), err
+}
+
+// Update takes the representation of a googleCloudPubSubSource and updates it. Returns the server's representation of the googleCloudPubSubSource, and an error, if there is any.
+func (c *FakeGoogleCloudPubSubSources) Update(ctx context.Context, googleCloudPubSubSource *v1beta1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudPubSubSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudpubsubsourcesResource, c.ns, googleCloudPubSubSource), &v1beta1.GoogleCloudPubSubSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudPubSubSources) UpdateStatus(ctx context.Context, googleCloudPubSubSource *v1beta1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*v1beta1.GoogleCloudPubSubSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudpubsubsourcesResource, "status", c.ns, googleCloudPubSubSource), &v1beta1.GoogleCloudPubSubSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubSource), err
+}
+
+// Delete takes name of the googleCloudPubSubSource and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudPubSubSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudpubsubsourcesResource, c.ns, name, opts), &v1beta1.GoogleCloudPubSubSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudPubSubSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudpubsubsourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudPubSubSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudPubSubSource.
+func (c *FakeGoogleCloudPubSubSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudPubSubSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudpubsubsourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudPubSubSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubSource), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudStorageSources implements GoogleCloudStorageSourceInterface
+type FakeGoogleCloudStorageSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var googlecloudstoragesourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "googlecloudstoragesources"}
+
+var googlecloudstoragesourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudStorageSource"}
+
+// Get takes name of the googleCloudStorageSource, and returns the corresponding googleCloudStorageSource object, and an error if there is any.
+func (c *FakeGoogleCloudStorageSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudstoragesourcesResource, c.ns, name), &v1beta1.GoogleCloudStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageSource), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudStorageSources that match those selectors.
+func (c *FakeGoogleCloudStorageSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudStorageSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudstoragesourcesResource, googlecloudstoragesourcesKind, c.ns, opts), &v1beta1.GoogleCloudStorageSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudStorageSourceList{ListMeta: obj.(*v1beta1.GoogleCloudStorageSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudStorageSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudStorageSources.
+func (c *FakeGoogleCloudStorageSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudstoragesourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudStorageSource and creates it.  Returns the server's representation of the googleCloudStorageSource, and an error, if there is any.
+func (c *FakeGoogleCloudStorageSources) Create(ctx context.Context, googleCloudStorageSource *v1beta1.GoogleCloudStorageSource, opts v1.CreateOptions) (result *v1beta1.GoogleCloudStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudstoragesourcesResource, c.ns, googleCloudStorageSource), &v1beta1.GoogleCloudStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageSource), err
+}
+
+// Update takes the representation of a googleCloudStorageSource and updates it. Returns the server's representation of the googleCloudStorageSource, and an error, if there is any.
+func (c *FakeGoogleCloudStorageSources) Update(ctx context.Context, googleCloudStorageSource *v1beta1.GoogleCloudStorageSource, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudstoragesourcesResource, c.ns, googleCloudStorageSource), &v1beta1.GoogleCloudStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudStorageSources) UpdateStatus(ctx context.Context, googleCloudStorageSource *v1beta1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*v1beta1.GoogleCloudStorageSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudstoragesourcesResource, "status", c.ns, googleCloudStorageSource), &v1beta1.GoogleCloudStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageSource), err
+}
+
+// Delete takes name of the googleCloudStorageSource and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudStorageSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudstoragesourcesResource, c.ns, name, opts), &v1beta1.GoogleCloudStorageSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudStorageSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudstoragesourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudStorageSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudStorageSource.
+func (c *FakeGoogleCloudStorageSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudStorageSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudstoragesourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudStorageSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageSource), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeOCIMetricsSources implements OCIMetricsSourceInterface
+type FakeOCIMetricsSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var ocimetricssourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "ocimetricssources"}
+
+var ocimetricssourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "OCIMetricsSource"}
+
+// Get takes name of the oCIMetricsSource, and returns the corresponding oCIMetricsSource object, and an error if there is any.
+func (c *FakeOCIMetricsSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.OCIMetricsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(ocimetricssourcesResource, c.ns, name), &v1beta1.OCIMetricsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OCIMetricsSource), err
+}
+
+// List takes label and field selectors, and returns the list of OCIMetricsSources that match those selectors.
+func (c *FakeOCIMetricsSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.OCIMetricsSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(ocimetricssourcesResource, ocimetricssourcesKind, c.ns, opts), &v1beta1.OCIMetricsSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.OCIMetricsSourceList{ListMeta: obj.(*v1beta1.OCIMetricsSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.OCIMetricsSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested oCIMetricsSources.
+func (c *FakeOCIMetricsSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(ocimetricssourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a oCIMetricsSource and creates it.  Returns the server's representation of the oCIMetricsSource, and an error, if there is any.
+func (c *FakeOCIMetricsSources) Create(ctx context.Context, oCIMetricsSource *v1beta1.OCIMetricsSource, opts v1.CreateOptions) (result *v1beta1.OCIMetricsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(ocimetricssourcesResource, c.ns, oCIMetricsSource), &v1beta1.OCIMetricsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OCIMetricsSource), err
+}
+
+// Update takes the representation of a oCIMetricsSource and updates it. Returns the server's representation of the oCIMetricsSource, and an error, if there is any.
+func (c *FakeOCIMetricsSources) Update(ctx context.Context, oCIMetricsSource *v1beta1.OCIMetricsSource, opts v1.UpdateOptions) (result *v1beta1.OCIMetricsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(ocimetricssourcesResource, c.ns, oCIMetricsSource), &v1beta1.OCIMetricsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OCIMetricsSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeOCIMetricsSources) UpdateStatus(ctx context.Context, oCIMetricsSource *v1beta1.OCIMetricsSource, opts v1.UpdateOptions) (*v1beta1.OCIMetricsSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(ocimetricssourcesResource, "status", c.ns, oCIMetricsSource), &v1beta1.OCIMetricsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OCIMetricsSource), err
+}
+
+// Delete takes name of the oCIMetricsSource and deletes it. Returns an error if one occurs.
+func (c *FakeOCIMetricsSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(ocimetricssourcesResource, c.ns, name, opts), &v1beta1.OCIMetricsSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeOCIMetricsSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(ocimetricssourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.OCIMetricsSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched oCIMetricsSource.
+func (c *FakeOCIMetricsSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.OCIMetricsSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(ocimetricssourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.OCIMetricsSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.OCIMetricsSource), err
+}
@@ -0,0 +1,188 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeSourcesV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeSourcesV1beta1) AWSCloudWatchLogsSources(namespace string) v1beta1.AWSCloudWatchLogsSourceInterface {
+	return &FakeAWSCloudWatchLogsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSCloudWatchSources(namespace string) v1beta1.AWSCloudWatchSourceInterface {
+	return &FakeAWSCloudWatchSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSCodeCommitSources(namespace string) v1beta1.AWSCodeCommitSourceInterface {
+	return &FakeAWSCodeCommitSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSCognitoIdentitySources(namespace string) v1beta1.AWSCognitoIdentitySourceInterface {
+	return &FakeAWSCognitoIdentitySources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSCognitoUserPoolSources(namespace string) v1beta1.AWSCognitoUserPoolSourceInterface {
+	return &FakeAWSCognitoUserPoolSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSDynamoDBSources(namespace string) v1beta1.AWSDynamoDBSourceInterface {
+	return &FakeAWSDynamoDBSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSEventBridgeSources(namespace string) v1beta1.AWSEventBridgeSourceInterface {
+	return &FakeAWSEventBridgeSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSKinesisSources(namespace string) v1beta1.AWSKinesisSourceInterface {
+	return &FakeAWSKinesisSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSPerformanceInsightsSources(namespace string) v1beta1.AWSPerformanceInsightsSourceInterface {
+	return &FakeAWSPerformanceInsightsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSS3Sources(namespace string) v1beta1.AWSS3SourceInterface {
+	return &FakeAWSS3Sources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSSNSSources(namespace string) v1beta1.AWSSNSSourceInterface {
+	return &FakeAWSSNSSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AWSSQSSources(namespace string) v1beta1.AWSSQSSourceInterface {
+	return &FakeAWSSQSSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureActivityLogsSources(namespace string) v1beta1.AzureActivityLogsSourceInterface {
+	return &FakeAzureActivityLogsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureBlobStorageSources(namespace string) v1beta1.AzureBlobStorageSourceInterface {
+	return &FakeAzureBlobStorageSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureEventGridSources(namespace string) v1beta1.AzureEventGridSourceInterface {
+	return &FakeAzureEventGridSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureEventHubsSources(namespace string) v1beta1.AzureEventHubsSourceInterface {
+	return &FakeAzureEventHubsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureIOTHubSources(namespace string) v1beta1.AzureIOTHubSourceInterface {
+	return &FakeAzureIOTHubSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureQueueStorageSources(namespace string) v1beta1.AzureQueueStorageSourceInterface {
+	return &FakeAzureQueueStorageSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureServiceBusQueueSources(namespace string) v1beta1.AzureServiceBusQueueSourceInterface {
+	return &FakeAzureServiceBusQueueSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureServiceBusSources(namespace string) v1beta1.AzureServiceBusSourceInterface {
+	return &FakeAzureServiceBusSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) AzureServiceBusTopicSources(namespace string) v1beta1.AzureServiceBusTopicSourceInterface {
+	return &FakeAzureServiceBusTopicSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) CloudEventsSources(namespace string) v1beta1.CloudEventsSourceInterface {
+	return &FakeCloudEventsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) GoogleCloudAuditLogsSources(namespace string) v1beta1.GoogleCloudAuditLogsSourceInterface {
+	return &FakeGoogleCloudAuditLogsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) GoogleCloudBillingSources(namespace string) v1beta1.GoogleCloudBillingSourceInterface {
+	return &FakeGoogleCloudBillingSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) GoogleCloudPubSubSources(namespace string) v1beta1.GoogleCloudPubSubSourceInterface {
+	return &FakeGoogleCloudPubSubSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) GoogleCloudSourceRepositoriesSources(namespace string) v1beta1.GoogleCloudSourceRepositoriesSourceInterface {
+	return &FakeGoogleCloudSourceRepositoriesSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) GoogleCloudStorageSources(namespace string) v1beta1.GoogleCloudStorageSourceInterface {
+	return &FakeGoogleCloudStorageSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) HTTPPollerSources(namespace string) v1beta1.HTTPPollerSourceInterface {
+	return &FakeHTTPPollerSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) IBMMQSources(namespace string) v1beta1.IBMMQSourceInterface {
+	return &FakeIBMMQSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) KafkaSources(namespace string) v1beta1.KafkaSourceInterface {
+	return &FakeKafkaSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) MongoDBSources(namespace string) v1beta1.MongoDBSourceInterface {
+	return &FakeMongoDBSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) OCIMetricsSources(namespace string) v1beta1.OCIMetricsSourceInterface {
+	return &FakeOCIMetricsSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) SalesforceSources(namespace string) v1beta1.SalesforceSourceInterface {
+	return &FakeSalesforceSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) SlackSources(namespace string) v1beta1.SlackSourceInterface {
+	return &FakeSlackSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) SolaceSources(namespace string) v1beta1.SolaceSourceInterface {
+	return &FakeSolaceSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) TwilioSources(namespace string) v1beta1.TwilioSourceInterface {
+	return &FakeTwilioSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) WebhookSources(namespace string) v1beta1.WebhookSourceInterface {
+	return &FakeWebhookSources{c, namespace}
+}
+
+func (c *FakeSourcesV1beta1) ZendeskSources(namespace string) v1beta1.ZendeskSourceInterface {
+	return &FakeZendeskSources{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeSourcesV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeZendeskSources implements ZendeskSourceInterface
+type FakeZendeskSources struct {
+	Fake *FakeSourcesV1beta1
+	ns   string
+}
+
+var zendesksourcesResource = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1beta1", Resource: "zendesksources"}
+
+var zendesksourcesKind = schema.GroupVersionKind{Group: "sources.triggermesh.io", Version: "v1beta1", Kind: "ZendeskSource"}
+
+// Get takes name of the zendeskSource, and returns the corresponding zendeskSource object, and an error if there is any.
+func (c *FakeZendeskSources) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ZendeskSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(zendesksourcesResource, c.ns, name), &v1beta1.ZendeskSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskSource), err
+}
+
+// List takes label and field selectors, and returns the list of ZendeskSources that match those selectors.
+func (c *FakeZendeskSources) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ZendeskSourceList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(zendesksourcesResource, zendesksourcesKind, c.ns, opts), &v1beta1.ZendeskSourceList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ZendeskSourceList{ListMeta: obj.(*v1beta1.ZendeskSourceList).ListMeta}
+	for _, item := range obj.(*v1beta1.ZendeskSourceList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested zendeskSources.
+func (c *FakeZendeskSources) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(zendesksourcesResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a zendeskSource and creates it.  Returns the server's representation of the zendeskSource, and an error, if there is any.
+func (c *FakeZendeskSources) Create(ctx context.Context, zendeskSource *v1beta1.ZendeskSource, opts v1.CreateOptions) (result *v1beta1.ZendeskSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(zendesksourcesResource, c.ns, zendeskSource), &v1beta1.ZendeskSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskSource), err
+}
+
+// Update takes the representation of a zendeskSource and updates it. Returns the server's representation of the zendeskSource, and an error, if there is any.
+func (c *FakeZendeskSources) Update(ctx context.Context, zendeskSource *v1beta1.ZendeskSource, opts v1.UpdateOptions) (result *v1beta1.ZendeskSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(zendesksourcesResource, c.ns, zendeskSource), &v1beta1.ZendeskSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskSource), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeZendeskSources) UpdateStatus(ctx context.Context, zendeskSource *v1beta1.ZendeskSource, opts v1.UpdateOptions) (*v1beta1.ZendeskSource, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(zendesksourcesResource, "status", c.ns, zendeskSource), &v1beta1.ZendeskSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskSource), err
+}
+
+// Delete takes name of the zendeskSource and deletes it. Returns an error if one occurs.
+func (c *FakeZendeskSources) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(zendesksourcesResource, c.ns, name, opts), &v1beta1.ZendeskSource{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeZendeskSources) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(zendesksourcesResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ZendeskSourceList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched zendeskSource.
+func (c *FakeZendeskSources) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ZendeskSource, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(zendesksourcesResource, c.ns, name, pt, data, subresources...), &v1beta1.ZendeskSource{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskSource), err
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+type AWSCloudWatchLogsSourceExpansion interface{}
+
+type AWSCloudWatchSourceExpansion interface{}
+
+type AWSCodeCommitSourceExpansion interface{}
+
+type AWSCognitoIdentitySourceExpansion interface{}
+
+type AWSCognitoUserPoolSourceExpansion interface{}
+
+type AWSDynamoDBSourceExpansion interface{}
+
+type AWSEventBridgeSourceExpansion interface{}
+
+type AWSKinesisSourceExpansion interface{}
+
+type AWSPerformanceInsightsSourceExpansion interface{}
+
+type AWSS3SourceExpansion interface{}
+
+type AWSSNSSourceExpansion interface{}
+
+type AWSSQSSourceExpansion interface{}
+
+type AzureActivityLogsSourceExpansion interface{}
+
+type AzureBlobStorageSourceExpansion interface{}
+
+type AzureEventGridSourceExpansion interface{}
+
+type AzureEventHubsSourceExpansion interface{}
+
+type AzureIOTHubSourceExpansion interface{}
+
+type AzureQueueStorageSourceExpansion interface{}
+
+type AzureServiceBusQueueSourceExpansion interface{}
+
+type AzureServiceBusSourceExpansion interface{}
+
+type AzureServiceBusTopicSourceExpansion interface{}
+
+type CloudEventsSourceExpansion interface{}
+
+type GoogleCloudAuditLogsSourceExpansion interface{}
+
+type GoogleCloudBillingSourceExpansion interface{}
+
+type GoogleCloudPubSubSourceExpansion interface{}
+
+type GoogleCloudSourceRepositoriesSourceExpansion interface{}
+
+type GoogleCloudStorageSourceExpansion interface{}
+
+type HTTPPollerSourceExpansion interface{}
+
+type IBMMQSourceExpansion interface{}
+
+type KafkaSourceExpansion interface{}
+
+type MongoDBSourceExpansion interface{}
+
+type OCIMetricsSourceExpansion interface{}
+
+type SalesforceSourceExpansion interface{}
+
+type SlackSourceExpansion interface{}
+
+type SolaceSourceExpansion interface{}
+
+type TwilioSourceExpansion interface{}
+
+type WebhookSourceExpansion interface{}
+
+type ZendeskSourceExpansion interface{}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AWSDynamoDBTargetsGetter has a method to return a AWSDynamoDBTargetInterface.
+// A group's client should implement this interface.
+type AWSDynamoDBTargetsGetter interface {
+	AWSDynamoDBTargets(namespace string) AWSDynamoDBTargetInterface
+}
+
+// AWSDynamoDBTargetInterface has methods to work with AWSDynamoDBTarget resources.
+type AWSDynamoDBTargetInterface interface {
+	Create(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.CreateOptions) (*v1beta1.AWSDynamoDBTarget, error)
+	Update(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*v1beta1.AWSDynamoDBTarget, error)
+	UpdateStatus(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*v1beta1.AWSDynamoDBTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.AWSDynamoDBTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.AWSDynamoDBTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSDynamoDBTarget, err error)
+	AWSDynamoDBTargetExpansion
+}
+
+// aWSDynamoDBTargets implements AWSDynamoDBTargetInterface
+type aWSDynamoDBTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAWSDynamoDBTargets returns a AWSDynamoDBTargets
+func newAWSDynamoDBTargets(c *TargetsV1beta1Client, namespace string) *aWSDynamoDBTargets {
+	return &aWSDynamoDBTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the aWSDynamoDBTarget, and returns the corresponding aWSDynamoDBTarget object, and an error if there is any.
+func (c *aWSDynamoDBTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	result = &v1beta1.AWSDynamoDBTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AWSDynamoDBTargets that match those selectors.
+func (c *aWSDynamoDBTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSDynamoDBTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.AWSDynamoDBTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aWSDynamoDBTargets.
+func (c *aWSDynamoDBTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a aWSDynamoDBTarget and creates it.  Returns the server's representation of the aWSDynamoDBTarget, and an error, if there is any.
+func (c *aWSDynamoDBTargets) Create(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.CreateOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	result = &v1beta1.AWSDynamoDBTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSDynamoDBTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aWSDynamoDBTarget and updates it. Returns the server's representation of the aWSDynamoDBTarget, and an error, if there is any.
+func (c *aWSDynamoDBTargets) Update(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	result = &v1beta1.AWSDynamoDBTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		Name(aWSDynamoDBTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSDynamoDBTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aWSDynamoDBTargets) UpdateStatus(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	result = &v1beta1.AWSDynamoDBTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		Name(aWSDynamoDBTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSDynamoDBTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the aWSDynamoDBTarget and deletes it. Returns an error if one occurs.
+func (c *aWSDynamoDBTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aWSDynamoDBTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched aWSDynamoDBTarget.
+func (c *aWSDynamoDBTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	result = &v1beta1.AWSDynamoDBTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("awsdynamodbtargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
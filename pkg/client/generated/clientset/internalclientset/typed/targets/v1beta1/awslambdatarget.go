@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AWSLambdaTargetsGetter has a method to return a AWSLambdaTargetInterface.
+// A group's client should implement this interface.
+type AWSLambdaTargetsGetter interface {
+	AWSLambdaTargets(namespace string) AWSLambdaTargetInterface
+}
+
+// AWSLambdaTargetInterface has methods to work with AWSLambdaTarget resources.
+type AWSLambdaTargetInterface interface {
+	Create(ctx context.Context, aWSLambdaTarget *v1beta1.AWSLambdaTarget, opts v1.CreateOptions) (*v1beta1.AWSLambdaTarget, error)
+	Update(ctx context.Context, aWSLambdaTarget *v1beta1.AWSLambdaTarget, opts v1.UpdateOptions) (*v1beta1.AWSLambdaTarget, error)
+	UpdateStatus(ctx context.Context, aWSLambdaTarget *v1beta1.AWSLambdaTarget, opts v1.UpdateOptions) (*v1beta1.AWSLambdaTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.AWSLambdaTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.AWSLambdaTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSLambdaTarget, err error)
+	AWSLambdaTargetExpansion
+}
+
+// aWSLambdaTargets implements AWSLambdaTargetInterface
+type aWSLambdaTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAWSLambdaTargets returns a AWSLambdaTargets
+func newAWSLambdaTargets(c *TargetsV1beta1Client, namespace string) *aWSLambdaTargets {
+	return &aWSLambdaTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the aWSLambdaTarget, and returns the corresponding aWSLambdaTarget object, and an error if there is any.
+func (c *aWSLambdaTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSLambdaTarget, err error) {
+	result = &v1beta1.AWSLambdaTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AWSLambdaTargets that match those selectors.
+func (c *aWSLambdaTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSLambdaTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.AWSLambdaTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aWSLambdaTargets.
+func (c *aWSLambdaTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a aWSLambdaTarget and creates it.  Returns the server's representation of the aWSLambdaTarget, and an error, if there is any.
+func (c *aWSLambdaTargets) Create(ctx context.Context, aWSLambdaTarget *v1beta1.AWSLambdaTarget, opts v1.CreateOptions) (result *v1beta1.AWSLambdaTarget, err error) {
+	result = &v1beta1.AWSLambdaTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSLambdaTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aWSLambdaTarget and updates it. Returns the server's representation of the aWSLambdaTarget, and an error, if there is any.
+func (c *aWSLambdaTargets) Update(ctx context.Context, aWSLambdaTarget *v1beta1.AWSLambdaTarget, opts v1.UpdateOptions) (result *v1beta1.AWSLambdaTarget, err error) {
+	result = &v1beta1.AWSLambdaTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		Name(aWSLambdaTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSLambdaTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aWSLambdaTargets) UpdateStatus(ctx context.Context, aWSLambdaTarget *v1beta1.AWSLambdaTarget, opts v1.UpdateOptions) (result *v1beta1.AWSLambdaTarget, err error) {
+	result = &v1beta1.AWSLambdaTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		Name(aWSLambdaTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSLambdaTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the aWSLambdaTarget and deletes it. Returns an error if one occurs.
+func (c *aWSLambdaTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aWSLambdaTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched aWSLambdaTarget.
+func (c *aWSLambdaTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSLambdaTarget, err error) {
+	result = &v1beta1.AWSLambdaTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("awslambdatargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// AWSSQSTargetsGetter has a method to return a AWSSQSTargetInterface.
+// A group's client should implement this interface.
+type AWSSQSTargetsGetter interface {
+	AWSSQSTargets(namespace string) AWSSQSTargetInterface
+}
+
+// AWSSQSTargetInterface has methods to work with AWSSQSTarget resources.
+type AWSSQSTargetInterface interface {
+	Create(ctx context.Context, aWSSQSTarget *v1beta1.AWSSQSTarget, opts v1.CreateOptions) (*v1beta1.AWSSQSTarget, error)
+	Update(ctx context.Context, aWSSQSTarget *v1beta1.AWSSQSTarget, opts v1.UpdateOptions) (*v1beta1.AWSSQSTarget, error)
+	UpdateStatus(ctx context.Context, aWSSQSTarget *v1beta1.AWSSQSTarget, opts v1.UpdateOptions) (*v1beta1.AWSSQSTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.AWSSQSTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.AWSSQSTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSSQSTarget, err error)
+	AWSSQSTargetExpansion
+}
+
+// aWSSQSTargets implements AWSSQSTargetInterface
+type aWSSQSTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newAWSSQSTargets returns a AWSSQSTargets
+func newAWSSQSTargets(c *TargetsV1beta1Client, namespace string) *aWSSQSTargets {
+	return &aWSSQSTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the aWSSQSTarget, and returns the corresponding aWSSQSTarget object, and an error if there is any.
+func (c *aWSSQSTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSSQSTarget, err error) {
+	result = &v1beta1.AWSSQSTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of AWSSQSTargets that match those selectors.
+func (c *aWSSQSTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSSQSTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.AWSSQSTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested aWSSQSTargets.
+func (c *aWSSQSTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a aWSSQSTarget and creates it.  Returns the server's representation of the aWSSQSTarget, and an error, if there is any.
+func (c *aWSSQSTargets) Create(ctx context.Context, aWSSQSTarget *v1beta1.AWSSQSTarget, opts v1.CreateOptions) (result *v1beta1.AWSSQSTarget, err error) {
+	result = &v1beta1.AWSSQSTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSSQSTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a aWSSQSTarget and updates it. Returns the server's representation of the aWSSQSTarget, and an error, if there is any.
+func (c *aWSSQSTargets) Update(ctx context.Context, aWSSQSTarget *v1beta1.AWSSQSTarget, opts v1.UpdateOptions) (result *v1beta1.AWSSQSTarget, err error) {
+	result = &v1beta1.AWSSQSTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		Name(aWSSQSTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSSQSTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *aWSSQSTargets) UpdateStatus(ctx context.Context, aWSSQSTarget *v1beta1.AWSSQSTarget, opts v1.UpdateOptions) (result *v1beta1.AWSSQSTarget, err error) {
+	result = &v1beta1.AWSSQSTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		Name(aWSSQSTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(aWSSQSTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the aWSSQSTarget and deletes it. Returns an error if one occurs.
+func (c *aWSSQSTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *aWSSQSTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched aWSSQSTarget.
+func (c *aWSSQSTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSSQSTarget, err error) {
+	result = &v1beta1.AWSSQSTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("awssqstargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAWSDynamoDBTargets implements AWSDynamoDBTargetInterface
+type FakeAWSDynamoDBTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var awsdynamodbtargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "awsdynamodbtargets"}
+
+var awsdynamodbtargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "AWSDynamoDBTarget"}
+
+// Get takes name of the aWSDynamoDBTarget, and returns the corresponding aWSDynamoDBTarget object, and an error if there is any.
+func (c *FakeAWSDynamoDBTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(awsdynamodbtargetsResource, c.ns, name), &v1beta1.AWSDynamoDBTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSDynamoDBTarget), err
+}
+
+// List takes label and field selectors, and returns the list of AWSDynamoDBTargets that match those selectors.
+func (c *FakeAWSDynamoDBTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AWSDynamoDBTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(awsdynamodbtargetsResource, awsdynamodbtargetsKind, c.ns, opts), &v1beta1.AWSDynamoDBTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AWSDynamoDBTargetList{ListMeta: obj.(*v1beta1.AWSDynamoDBTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.AWSDynamoDBTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested aWSDynamoDBTargets.
+func (c *FakeAWSDynamoDBTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(awsdynamodbtargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a aWSDynamoDBTarget and creates it.  Returns the server's representation of the aWSDynamoDBTarget, and an error, if there is any.
+func (c *FakeAWSDynamoDBTargets) Create(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.CreateOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(awsdynamodbtargetsResource, c.ns, aWSDynamoDBTarget), &v1beta1.AWSDynamoDBTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSDynamoDBTarget), err
+}
+
+// Update takes the representation of a aWSDynamoDBTarget and updates it. Returns the server's representation of the aWSDynamoDBTarget, and an error, if there is any.
+func (c *FakeAWSDynamoDBTargets) Update(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(awsdynamodbtargetsResource, c.ns, aWSDynamoDBTarget), &v1beta1.AWSDynamoDBTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSDynamoDBTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAWSDynamoDBTargets) UpdateStatus(ctx context.Context, aWSDynamoDBTarget *v1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*v1beta1.AWSDynamoDBTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(awsdynamodbtargetsResource, "status", c.ns, aWSDynamoDBTarget), &v1beta1.AWSDynamoDBTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSDynamoDBTarget), err
+}
+
+// Delete takes name of the aWSDynamoDBTarget and deletes it. Returns an error if one occurs.
+func (c *FakeAWSDynamoDBTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(awsdynamodbtargetsResource, c.ns, name, opts), &v1beta1.AWSDynamoDBTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAWSDynamoDBTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(awsdynamodbtargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AWSDynamoDBTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched aWSDynamoDBTarget.
+func (c *FakeAWSDynamoDBTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AWSDynamoDBTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(awsdynamodbtargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.AWSDynamoDBTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AWSDynamoDBTarget), err
+}
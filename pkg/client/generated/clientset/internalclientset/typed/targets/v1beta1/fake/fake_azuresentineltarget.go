@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeAzureSentinelTargets implements AzureSentinelTargetInterface
+type FakeAzureSentinelTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var azuresentineltargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "azuresentineltargets"}
+
+var azuresentineltargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "AzureSentinelTarget"}
+
+// Get takes name of the azureSentinelTarget, and returns the corresponding azureSentinelTarget object, and an error if there is any.
+func (c *FakeAzureSentinelTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.AzureSentinelTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(azuresentineltargetsResource, c.ns, name), &v1beta1.AzureSentinelTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureSentinelTarget), err
+}
+
+// List takes label and field selectors, and returns the list of AzureSentinelTargets that match those selectors.
+func (c *FakeAzureSentinelTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.AzureSentinelTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(azuresentineltargetsResource, azuresentineltargetsKind, c.ns, opts), &v1beta1.AzureSentinelTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.AzureSentinelTargetList{ListMeta: obj.(*v1beta1.AzureSentinelTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.AzureSentinelTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested azureSentinelTargets.
+func (c *FakeAzureSentinelTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(azuresentineltargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a azureSentinelTarget and creates it.  Returns the server's representation of the azureSentinelTarget, and an error, if there is any.
+func (c *FakeAzureSentinelTargets) Create(ctx context.Context, azureSentinelTarget *v1beta1.AzureSentinelTarget, opts v1.CreateOptions) (result *v1beta1.AzureSentinelTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(azuresentineltargetsResource, c.ns, azureSentinelTarget), &v1beta1.AzureSentinelTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureSentinelTarget), err
+}
+
+// Update takes the representation of a azureSentinelTarget and updates it. Returns the server's representation of the azureSentinelTarget, and an error, if there is any.
+func (c *FakeAzureSentinelTargets) Update(ctx context.Context, azureSentinelTarget *v1beta1.AzureSentinelTarget, opts v1.UpdateOptions) (result *v1beta1.AzureSentinelTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(azuresentineltargetsResource, c.ns, azureSentinelTarget), &v1beta1.AzureSentinelTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureSentinelTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeAzureSentinelTargets) UpdateStatus(ctx context.Context, azureSentinelTarget *v1beta1.AzureSentinelTarget, opts v1.UpdateOptions) (*v1beta1.AzureSentinelTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(azuresentineltargetsResource, "status", c.ns, azureSentinelTarget), &v1beta1.AzureSentinelTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureSentinelTarget), err
+}
+
+// Delete takes name of the azureSentinelTarget and deletes it. Returns an error if one occurs.
+func (c *FakeAzureSentinelTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(azuresentineltargetsResource, c.ns, name, opts), &v1beta1.AzureSentinelTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeAzureSentinelTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(azuresentineltargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.AzureSentinelTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched azureSentinelTarget.
+func (c *FakeAzureSentinelTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.AzureSentinelTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(azuresentineltargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.AzureSentinelTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.AzureSentinelTarget), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudFirestoreTargets implements GoogleCloudFirestoreTargetInterface
+type FakeGoogleCloudFirestoreTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var googlecloudfirestoretargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "googlecloudfirestoretargets"}
+
+var googlecloudfirestoretargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudFirestoreTarget"}
+
+// Get takes name of the googleCloudFirestoreTarget, and returns the corresponding googleCloudFirestoreTarget object, and an error if there is any.
+func (c *FakeGoogleCloudFirestoreTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudFirestoreTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudfirestoretargetsResource, c.ns, name), &v1beta1.GoogleCloudFirestoreTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudFirestoreTarget), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudFirestoreTargets that match those selectors.
+func (c *FakeGoogleCloudFirestoreTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudFirestoreTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudfirestoretargetsResource, googlecloudfirestoretargetsKind, c.ns, opts), &v1beta1.GoogleCloudFirestoreTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudFirestoreTargetList{ListMeta: obj.(*v1beta1.GoogleCloudFirestoreTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudFirestoreTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudFirestoreTargets.
+func (c *FakeGoogleCloudFirestoreTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudfirestoretargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudFirestoreTarget and creates it.  Returns the server's representation of the googleCloudFirestoreTarget, and an error, if there is any.
+func (c *FakeGoogleCloudFirestoreTargets) Create(ctx context.Context, googleCloudFirestoreTarget *v1beta1.GoogleCloudFirestoreTarget, opts v1.CreateOptions) (result *v1beta1.GoogleCloudFirestoreTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudfirestoretargetsResource, c.ns, googleCloudFirestoreTarget), &v1beta1.GoogleCloudFirestoreTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudFirestoreTarget), err
+}
+
+// Update takes the representation of a googleCloudFirestoreTarget and updates it. Returns the server's representation of the googleCloudFirestoreTarget, and an error, if there is any.
+func (c *FakeGoogleCloudFirestoreTargets) Update(ctx context.Context, googleCloudFirestoreTarget *v1beta1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudFirestoreTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudfirestoretargetsResource, c.ns, googleCloudFirestoreTarget), &v1beta1.GoogleCloudFirestoreTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudFirestoreTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudFirestoreTargets) UpdateStatus(ctx context.Context, googleCloudFirestoreTarget *v1beta1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*v1beta1.GoogleCloudFirestoreTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudfirestoretargetsResource, "status", c.ns, googleCloudFirestoreTarget), &v1beta1.GoogleCloudFirestoreTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudFirestoreTarget), err
+}
+
+// Delete takes name of the googleCloudFirestoreTarget and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudFirestoreTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudfirestoretargetsResource, c.ns, name, opts), &v1beta1.GoogleCloudFirestoreTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudFirestoreTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudfirestoretargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudFirestoreTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudFirestoreTarget.
+func (c *FakeGoogleCloudFirestoreTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudFirestoreTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudfirestoretargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudFirestoreTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudFirestoreTarget), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudPubSubTargets implements GoogleCloudPubSubTargetInterface
+type FakeGoogleCloudPubSubTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var googlecloudpubsubtargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "googlecloudpubsubtargets"}
+
+var googlecloudpubsubtargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudPubSubTarget"}
+
+// Get takes name of the googleCloudPubSubTarget, and returns the corresponding googleCloudPubSubTarget object, and an error if there is any.
+func (c *FakeGoogleCloudPubSubTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudPubSubTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudpubsubtargetsResource, c.ns, name), &v1beta1.GoogleCloudPubSubTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubTarget), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudPubSubTargets that match those selectors.
+func (c *FakeGoogleCloudPubSubTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudPubSubTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudpubsubtargetsResource, googlecloudpubsubtargetsKind, c.ns, opts), &v1beta1.GoogleCloudPubSubTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudPubSubTargetList{ListMeta: obj.(*v1beta1.GoogleCloudPubSubTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudPubSubTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudPubSubTargets.
+func (c *FakeGoogleCloudPubSubTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudpubsubtargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudPubSubTarget and creates it.  Returns the server's representation of the googleCloudPubSubTarget, and an error, if there is any.
+func (c *FakeGoogleCloudPubSubTargets) Create(ctx context.Context, googleCloudPubSubTarget *v1beta1.GoogleCloudPubSubTarget, opts v1.CreateOptions) (result *v1beta1.GoogleCloudPubSubTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudpubsubtargetsResource, c.ns, googleCloudPubSubTarget), &v1beta1.GoogleCloudPubSubTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubTarget), err
+}
+
+// Update takes the representation of a googleCloudPubSubTarget and updates it. Returns the server's representation of the googleCloudPubSubTarget, and an error, if there is any.
+func (c *FakeGoogleCloudPubSubTargets) Update(ctx context.Context, googleCloudPubSubTarget *v1beta1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudPubSubTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudpubsubtargetsResource, c.ns, googleCloudPubSubTarget), &v1beta1.GoogleCloudPubSubTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudPubSubTargets) UpdateStatus(ctx context.Context, googleCloudPubSubTarget *v1beta1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*v1beta1.GoogleCloudPubSubTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudpubsubtargetsResource, "status", c.ns, googleCloudPubSubTarget), &v1beta1.GoogleCloudPubSubTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubTarget), err
+}
+
+// Delete takes name of the googleCloudPubSubTarget and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudPubSubTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudpubsubtargetsResource, c.ns, name, opts), &v1beta1.GoogleCloudPubSubTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudPubSubTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudpubsubtargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudPubSubTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudPubSubTarget.
+func (c *FakeGoogleCloudPubSubTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudPubSubTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudpubsubtargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudPubSubTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubTarget), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudStorageTargets implements GoogleCloudStorageTargetInterface
+type FakeGoogleCloudStorageTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var googlecloudstoragetargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "googlecloudstoragetargets"}
+
+var googlecloudstoragetargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudStorageTarget"}
+
+// Get takes name of the googleCloudStorageTarget, and returns the corresponding googleCloudStorageTarget object, and an error if there is any.
+func (c *FakeGoogleCloudStorageTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudStorageTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudstoragetargetsResource, c.ns, name), &v1beta1.GoogleCloudStorageTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageTarget), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudStorageTargets that match those selectors.
+func (c *FakeGoogleCloudStorageTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudStorageTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudstoragetargetsResource, googlecloudstoragetargetsKind, c.ns, opts), &v1beta1.GoogleCloudStorageTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudStorageTargetList{ListMeta: obj.(*v1beta1.GoogleCloudStorageTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudStorageTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudStorageTargets.
+func (c *FakeGoogleCloudStorageTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudstoragetargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudStorageTarget and creates it.  Returns the server's representation of the googleCloudStorageTarget, and an error, if there is any.
+func (c *FakeGoogleCloudStorageTargets) Create(ctx context.Context, googleCloudStorageTarget *v1beta1.GoogleCloudStorageTarget, opts v1.CreateOptions) (result *v1beta1.GoogleCloudStorageTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudstoragetargetsResource, c.ns, googleCloudStorageTarget), &v1beta1.GoogleCloudStorageTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageTarget), err
+}
+
+// Update takes the representation of a googleCloudStorageTarget and updates it. Returns the server's representation of the googleCloudStorageTarget, and an error, if there is any.
+func (c *FakeGoogleCloudStorageTargets) Update(ctx context.Context, googleCloudStorageTarget *v1beta1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudStorageTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudstoragetargetsResource, c.ns, googleCloudStorageTarget), &v1beta1.GoogleCloudStorageTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudStorageTargets) UpdateStatus(ctx context.Context, googleCloudStorageTarget *v1beta1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*v1beta1.GoogleCloudStorageTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudstoragetargetsResource, "status", c.ns, googleCloudStorageTarget), &v1beta1.GoogleCloudStorageTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageTarget), err
+}
+
+// Delete takes name of the googleCloudStorageTarget and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudStorageTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudstoragetargetsResource, c.ns, name, opts), &v1beta1.GoogleCloudStorageTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudStorageTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudstoragetargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudStorageTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudStorageTarget.
+func (c *FakeGoogleCloudStorageTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudStorageTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudstoragetargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudStorageTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudStorageTarget), err
+}
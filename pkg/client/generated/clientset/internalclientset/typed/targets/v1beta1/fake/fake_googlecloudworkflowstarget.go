@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleCloudWorkflowsTargets implements GoogleCloudWorkflowsTargetInterface
+type FakeGoogleCloudWorkflowsTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var googlecloudworkflowstargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "googlecloudworkflowstargets"}
+
+var googlecloudworkflowstargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "GoogleCloudWorkflowsTarget"}
+
+// Get takes name of the googleCloudWorkflowsTarget, and returns the corresponding googleCloudWorkflowsTarget object, and an error if there is any.
+func (c *FakeGoogleCloudWorkflowsTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleCloudWorkflowsTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlecloudworkflowstargetsResource, c.ns, name), &v1beta1.GoogleCloudWorkflowsTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudWorkflowsTarget), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleCloudWorkflowsTargets that match those selectors.
+func (c *FakeGoogleCloudWorkflowsTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleCloudWorkflowsTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlecloudworkflowstargetsResource, googlecloudworkflowstargetsKind, c.ns, opts), &v1beta1.GoogleCloudWorkflowsTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleCloudWorkflowsTargetList{ListMeta: obj.(*v1beta1.GoogleCloudWorkflowsTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleCloudWorkflowsTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleCloudWorkflowsTargets.
+func (c *FakeGoogleCloudWorkflowsTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlecloudworkflowstargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleCloudWorkflowsTarget and creates it.  Returns the server's representation of the googleCloudWorkflowsTarget, and an error, if there is any.
+func (c *FakeGoogleCloudWorkflowsTargets) Create(ctx context.Context, googleCloudWorkflowsTarget *v1beta1.GoogleCloudWorkflowsTarget, opts v1.CreateOptions) (result *v1beta1.GoogleCloudWorkflowsTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlecloudworkflowstargetsResource, c.ns, googleCloudWorkflowsTarget), &v1beta1.GoogleCloudWorkflowsTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudWorkflowsTarget), err
+}
+
+// Update takes the representation of a googleCloudWorkflowsTarget and updates it. Returns the server's representation of the googleCloudWorkflowsTarget, and an error, if there is any.
+func (c *FakeGoogleCloudWorkflowsTargets) Update(ctx context.Context, googleCloudWorkflowsTarget *v1beta1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleCloudWorkflowsTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlecloudworkflowstargetsResource, c.ns, googleCloudWorkflowsTarget), &v1beta1.GoogleCloudWorkflowsTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudWorkflowsTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleCloudWorkflowsTargets) UpdateStatus(ctx context.Context, googleCloudWorkflowsTarget *v1beta1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*v1beta1.GoogleCloudWorkflowsTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlecloudworkflowstargetsResource, "status", c.ns, googleCloudWorkflowsTarget), &v1beta1.GoogleCloudWorkflowsTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudWorkflowsTarget), err
+}
+
+// Delete takes name of the googleCloudWorkflowsTarget and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleCloudWorkflowsTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlecloudworkflowstargetsResource, c.ns, name, opts), &v1beta1.GoogleCloudWorkflowsTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleCloudWorkflowsTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlecloudworkflowstargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleCloudWorkflowsTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleCloudWorkflowsTarget.
+func (c *FakeGoogleCloudWorkflowsTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleCloudWorkflowsTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlecloudworkflowstargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleCloudWorkflowsTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleCloudWorkflowsTarget), err
+}
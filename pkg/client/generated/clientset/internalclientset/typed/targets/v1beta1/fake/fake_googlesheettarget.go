@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeGoogleSheetTargets implements GoogleSheetTargetInterface
+type FakeGoogleSheetTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var googlesheettargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "googlesheettargets"}
+
+var googlesheettargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "GoogleSheetTarget"}
+
+// Get takes name of the googleSheetTarget, and returns the corresponding googleSheetTarget object, and an error if there is any.
+func (c *FakeGoogleSheetTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(googlesheettargetsResource, c.ns, name), &v1beta1.GoogleSheetTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleSheetTarget), err
+}
+
+// List takes label and field selectors, and returns the list of GoogleSheetTargets that match those selectors.
+func (c *FakeGoogleSheetTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleSheetTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(googlesheettargetsResource, googlesheettargetsKind, c.ns, opts), &v1beta1.GoogleSheetTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.GoogleSheetTargetList{ListMeta: obj.(*v1beta1.GoogleSheetTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.GoogleSheetTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested googleSheetTargets.
+func (c *FakeGoogleSheetTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(googlesheettargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a googleSheetTarget and creates it.  Returns the server's representation of the googleSheetTarget, and an error, if there is any.
+func (c *FakeGoogleSheetTargets) Create(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.CreateOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(googlesheettargetsResource, c.ns, googleSheetTarget), &v1beta1.GoogleSheetTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleSheetTarget), err
+}
+
+// Update takes the representation of a googleSheetTarget and updates it. Returns the server's representation of the googleSheetTarget, and an error, if there is any.
+func (c *FakeGoogleSheetTargets) Update(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(googlesheettargetsResource, c.ns, googleSheetTarget), &v1beta1.GoogleSheetTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleSheetTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeGoogleSheetTargets) UpdateStatus(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (*v1beta1.GoogleSheetTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(googlesheettargetsResource, "status", c.ns, googleSheetTarget), &v1beta1.GoogleSheetTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleSheetTarget), err
+}
+
+// Delete takes name of the googleSheetTarget and deletes it. Returns an error if one occurs.
+func (c *FakeGoogleSheetTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(googlesheettargetsResource, c.ns, name, opts), &v1beta1.GoogleSheetTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeGoogleSheetTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(googlesheettargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.GoogleSheetTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched googleSheetTarget.
+func (c *FakeGoogleSheetTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleSheetTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(googlesheettargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.GoogleSheetTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.GoogleSheetTarget), err
+}
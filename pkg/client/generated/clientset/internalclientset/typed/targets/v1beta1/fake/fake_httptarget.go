@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeHTTPTargets implements HTTPTargetInterface
+type FakeHTTPTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var httptargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "httptargets"}
+
+var httptargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "HTTPTarget"}
+
+// Get takes name of the hTTPTarget, and returns the corresponding hTTPTarget object, and an error if there is any.
+func (c *FakeHTTPTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.HTTPTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(httptargetsResource, c.ns, name), &v1beta1.HTTPTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.HTTPTarget), err
+}
+
+// List takes label and field selectors, and returns the list of HTTPTargets that match those selectors.
+func (c *FakeHTTPTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.HTTPTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(httptargetsResource, httptargetsKind, c.ns, opts), &v1beta1.HTTPTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.HTTPTargetList{ListMeta: obj.(*v1beta1.HTTPTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.HTTPTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested hTTPTargets.
+func (c *FakeHTTPTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(httptargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a hTTPTarget and creates it.  Returns the server's representation of the hTTPTarget, and an error, if there is any.
+func (c *FakeHTTPTargets) Create(ctx context.Context, hTTPTarget *v1beta1.HTTPTarget, opts v1.CreateOptions) (result *v1beta1.HTTPTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(httptargetsResource, c.ns, hTTPTarget), &v1beta1.HTTPTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.HTTPTarget), err
+}
+
+// Update takes the representation of a hTTPTarget and updates it. Returns the server's representation of the hTTPTarget, and an error, if there is any.
+func (c *FakeHTTPTargets) Update(ctx context.Context, hTTPTarget *v1beta1.HTTPTarget, opts v1.UpdateOptions) (result *v1beta1.HTTPTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(httptargetsResource, c.ns, hTTPTarget), &v1beta1.HTTPTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.HTTPTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeHTTPTargets) UpdateStatus(ctx context.Context, hTTPTarget *v1beta1.HTTPTarget, opts v1.UpdateOptions) (*v1beta1.HTTPTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(httptargetsResource, "status", c.ns, hTTPTarget), &v1beta1.HTTPTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.HTTPTarget), err
+}
+
+// Delete takes name of the hTTPTarget and deletes it. Returns an error if one occurs.
+func (c *FakeHTTPTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(httptargetsResource, c.ns, name, opts), &v1beta1.HTTPTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeHTTPTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(httptargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.HTTPTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched hTTPTarget.
+func (c *FakeHTTPTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.HTTPTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(httptargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.HTTPTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.HTTPTarget), err
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSalesforceTargets implements SalesforceTargetInterface
+type FakeSalesforceTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var salesforcetargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "salesforcetargets"}
+
+var salesforcetargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "SalesforceTarget"}
+
+// Get takes name of the salesforceTarget, and returns the corresponding salesforceTarget object, and an error if there is any.
+func (c *FakeSalesforceTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.SalesforceTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(salesforcetargetsResource, c.ns, name), &v1beta1.SalesforceTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SalesforceTarget), err
+}
+
+// List takes label and field selectors, and returns the list of SalesforceTargets that match those selectors.
+func (c *FakeSalesforceTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.SalesforceTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(salesforcetargetsResource, salesforcetargetsKind, c.ns, opts), &v1beta1.SalesforceTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.SalesforceTargetList{ListMeta: obj.(*v1beta1.SalesforceTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.SalesforceTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested salesforceTargets.
+func (c *FakeSalesforceTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(salesforcetargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a salesforceTarget and creates it.  Returns the server's representation of the salesforceTarget, and an error, if there is any.
+func (c *FakeSalesforceTargets) Create(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.CreateOptions) (result *v1beta1.SalesforceTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(salesforcetargetsResource, c.ns, salesforceTarget), &v1beta1.SalesforceTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SalesforceTarget), err
+}
+
+// Update takes the representation of a salesforceTarget and updates it. Returns the server's representation of the salesforceTarget, and an error, if there is any.
+func (c *FakeSalesforceTargets) Update(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.UpdateOptions) (result *v1beta1.SalesforceTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(salesforcetargetsResource, c.ns, salesforceTarget), &v1beta1.SalesforceTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SalesforceTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSalesforceTargets) UpdateStatus(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.UpdateOptions) (*v1beta1.SalesforceTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(salesforcetargetsResource, "status", c.ns, salesforceTarget), &v1beta1.SalesforceTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SalesforceTarget), err
+}
+
+// Delete takes name of the salesforceTarget and deletes it. Returns an error if one occurs.
+func (c *FakeSalesforceTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(salesforcetargetsResource, c.ns, name, opts), &v1beta1.SalesforceTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSalesforceTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(salesforcetargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.SalesforceTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched salesforceTarget.
+func (c *FakeSalesforceTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.SalesforceTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(salesforcetargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.SalesforceTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SalesforceTarget), err
+}
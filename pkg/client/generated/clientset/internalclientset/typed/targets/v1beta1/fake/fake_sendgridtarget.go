@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSendGridTargets implements SendGridTargetInterface
+type FakeSendGridTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var sendgridtargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "sendgridtargets"}
+
+var sendgridtargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "SendGridTarget"}
+
+// Get takes name of the sendGridTarget, and returns the corresponding sendGridTarget object, and an error if there is any.
+func (c *FakeSendGridTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.SendGridTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(sendgridtargetsResource, c.ns, name), &v1beta1.SendGridTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SendGridTarget), err
+}
+
+// List takes label and field selectors, and returns the list of SendGridTargets that match those selectors.
+func (c *FakeSendGridTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.SendGridTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(sendgridtargetsResource, sendgridtargetsKind, c.ns, opts), &v1beta1.SendGridTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.SendGridTargetList{ListMeta: obj.(*v1beta1.SendGridTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.SendGridTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested sendGridTargets.
+func (c *FakeSendGridTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(sendgridtargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a sendGridTarget and creates it.  Returns the server's representation of the sendGridTarget, and an error, if there is any.
+func (c *FakeSendGridTargets) Create(ctx context.Context, sendGridTarget *v1beta1.SendGridTarget, opts v1.CreateOptions) (result *v1beta1.SendGridTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(sendgridtargetsResource, c.ns, sendGridTarget), &v1beta1.SendGridTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SendGridTarget), err
+}
+
+// Update takes the representation of a sendGridTarget and updates it. Returns the server's representation of the sendGridTarget, and an error, if there is any.
+func (c *FakeSendGridTargets) Update(ctx context.Context, sendGridTarget *v1beta1.SendGridTarget, opts v1.UpdateOptions) (result *v1beta1.SendGridTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(sendgridtargetsResource, c.ns, sendGridTarget), &v1beta1.SendGridTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SendGridTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSendGridTargets) UpdateStatus(ctx context.Context, sendGridTarget *v1beta1.SendGridTarget, opts v1.UpdateOptions) (*v1beta1.SendGridTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(sendgridtargetsResource, "status", c.ns, sendGridTarget), &v1beta1.SendGridTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SendGridTarget), err
+}
+
+// Delete takes name of the sendGridTarget and deletes it. Returns an error if one occurs.
+func (c *FakeSendGridTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(sendgridtargetsResource, c.ns, name, opts), &v1beta1.SendGridTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSendGridTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(sendgridtargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.SendGridTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched sendGridTarget.
+func (c *FakeSendGridTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.SendGridTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(sendgridtargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.SendGridTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SendGridTarget), err
+}
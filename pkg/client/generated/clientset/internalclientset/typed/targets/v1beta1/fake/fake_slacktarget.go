@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSlackTargets implements SlackTargetInterface
+type FakeSlackTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var slacktargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "slacktargets"}
+
+var slacktargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "SlackTarget"}
+
+// Get takes name of the slackTarget, and returns the corresponding slackTarget object, and an error if there is any.
+func (c *FakeSlackTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.SlackTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(slacktargetsResource, c.ns, name), &v1beta1.SlackTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SlackTarget), err
+}
+
+// List takes label and field selectors, and returns the list of SlackTargets that match those selectors.
+func (c *FakeSlackTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.SlackTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(slacktargetsResource, slacktargetsKind, c.ns, opts), &v1beta1.SlackTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.SlackTargetList{ListMeta: obj.(*v1beta1.SlackTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.SlackTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested slackTargets.
+func (c *FakeSlackTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(slacktargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a slackTarget and creates it.  Returns the server's representation of the slackTarget, and an error, if there is any.
+func (c *FakeSlackTargets) Create(ctx context.Context, slackTarget *v1beta1.SlackTarget, opts v1.CreateOptions) (result *v1beta1.SlackTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(slacktargetsResource, c.ns, slackTarget), &v1beta1.SlackTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SlackTarget), err
+}
+
+// Update takes the representation of a slackTarget and updates it. Returns the server's representation of the slackTarget, and an error, if there is any.
+func (c *FakeSlackTargets) Update(ctx context.Context, slackTarget *v1beta1.SlackTarget, opts v1.UpdateOptions) (result *v1beta1.SlackTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(slacktargetsResource, c.ns, slackTarget), &v1beta1.SlackTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SlackTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSlackTargets) UpdateStatus(ctx context.Context, slackTarget *v1beta1.SlackTarget, opts v1.UpdateOptions) (*v1beta1.SlackTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(slacktargetsResource, "status", c.ns, slackTarget), &v1beta1.SlackTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SlackTarget), err
+}
+
+// Delete takes name of the slackTarget and deletes it. Returns an error if one occurs.
+func (c *FakeSlackTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(slacktargetsResource, c.ns, name, opts), &v1beta1.SlackTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSlackTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(slacktargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.SlackTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched slackTarget.
+func (c *FakeSlackTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.SlackTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(slacktargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.SlackTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SlackTarget), err
+}
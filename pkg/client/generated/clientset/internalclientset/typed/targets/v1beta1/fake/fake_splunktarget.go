@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeSplunkTargets implements SplunkTargetInterface
+type FakeSplunkTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var splunktargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "splunktargets"}
+
+var splunktargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "SplunkTarget"}
+
+// Get takes name of the splunkTarget, and returns the corresponding splunkTarget object, and an error if there is any.
+func (c *FakeSplunkTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.SplunkTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(splunktargetsResource, c.ns, name), &v1beta1.SplunkTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SplunkTarget), err
+}
+
+// List takes label and field selectors, and returns the list of SplunkTargets that match those selectors.
+func (c *FakeSplunkTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.SplunkTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(splunktargetsResource, splunktargetsKind, c.ns, opts), &v1beta1.SplunkTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.SplunkTargetList{ListMeta: obj.(*v1beta1.SplunkTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.SplunkTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested splunkTargets.
+func (c *FakeSplunkTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(splunktargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a splunkTarget and creates it.  Returns the server's representation of the splunkTarget, and an error, if there is any.
+func (c *FakeSplunkTargets) Create(ctx context.Context, splunkTarget *v1beta1.SplunkTarget, opts v1.CreateOptions) (result *v1beta1.SplunkTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(splunktargetsResource, c.ns, splunkTarget), &v1beta1.SplunkTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SplunkTarget), err
+}
+
+// Update takes the representation of a splunkTarget and updates it. Returns the server's representation of the splunkTarget, and an error, if there is any.
+func (c *FakeSplunkTargets) Update(ctx context.Context, splunkTarget *v1beta1.SplunkTarget, opts v1.UpdateOptions) (result *v1beta1.SplunkTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(splunktargetsResource, c.ns, splunkTarget), &v1beta1.SplunkTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SplunkTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeSplunkTargets) UpdateStatus(ctx context.Context, splunkTarget *v1beta1.SplunkTarget, opts v1.UpdateOptions) (*v1beta1.SplunkTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(splunktargetsResource, "status", c.ns, splunkTarget), &v1beta1.SplunkTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SplunkTarget), err
+}
+
+// Delete takes name of the splunkTarget and deletes it. Returns an error if one occurs.
+func (c *FakeSplunkTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(splunktargetsResource, c.ns, name, opts), &v1beta1.SplunkTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeSplunkTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(splunktargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.SplunkTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched splunkTarget.
+func (c *FakeSplunkTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.SplunkTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(splunktargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.SplunkTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.SplunkTarget), err
+}
@@ -0,0 +1,172 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeTargetsV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeTargetsV1beta1) AWSComprehendTargets(namespace string) v1beta1.AWSComprehendTargetInterface {
+	return &FakeAWSComprehendTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSDynamoDBTargets(namespace string) v1beta1.AWSDynamoDBTargetInterface {
+	return &FakeAWSDynamoDBTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSEventBridgeTargets(namespace string) v1beta1.AWSEventBridgeTargetInterface {
+	return &FakeAWSEventBridgeTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSKinesisTargets(namespace string) v1beta1.AWSKinesisTargetInterface {
+	return &FakeAWSKinesisTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSLambdaTargets(namespace string) v1beta1.AWSLambdaTargetInterface {
+	return &FakeAWSLambdaTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSS3Targets(namespace string) v1beta1.AWSS3TargetInterface {
+	return &FakeAWSS3Targets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSSNSTargets(namespace string) v1beta1.AWSSNSTargetInterface {
+	return &FakeAWSSNSTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AWSSQSTargets(namespace string) v1beta1.AWSSQSTargetInterface {
+	return &FakeAWSSQSTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AzureEventHubsTargets(namespace string) v1beta1.AzureEventHubsTargetInterface {
+	return &FakeAzureEventHubsTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AzureSentinelTargets(namespace string) v1beta1.AzureSentinelTargetInterface {
+	return &FakeAzureSentinelTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) AzureServiceBusTargets(namespace string) v1beta1.AzureServiceBusTargetInterface {
+	return &FakeAzureServiceBusTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) CloudEventsTargets(namespace string) v1beta1.CloudEventsTargetInterface {
+	return &FakeCloudEventsTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) DatadogTargets(namespace string) v1beta1.DatadogTargetInterface {
+	return &FakeDatadogTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) ElasticsearchTargets(namespace string) v1beta1.ElasticsearchTargetInterface {
+	return &FakeElasticsearchTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) GoogleCloudFirestoreTargets(namespace string) v1beta1.GoogleCloudFirestoreTargetInterface {
+	return &FakeGoogleCloudFirestoreTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) GoogleCloudPubSubTargets(namespace string) v1beta1.GoogleCloudPubSubTargetInterface {
+	return &FakeGoogleCloudPubSubTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) GoogleCloudStorageTargets(namespace string) v1beta1.GoogleCloudStorageTargetInterface {
+	return &FakeGoogleCloudStorageTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) GoogleCloudWorkflowsTargets(namespace string) v1beta1.GoogleCloudWorkflowsTargetInterface {
+	return &FakeGoogleCloudWorkflowsTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) GoogleSheetTargets(namespace string) v1beta1.GoogleSheetTargetInterface {
+	return &FakeGoogleSheetTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) HTTPTargets(namespace string) v1beta1.HTTPTargetInterface {
+	return &FakeHTTPTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) IBMMQTargets(namespace string) v1beta1.IBMMQTargetInterface {
+	return &FakeIBMMQTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) JiraTargets(namespace string) v1beta1.JiraTargetInterface {
+	return &FakeJiraTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) KafkaTargets(namespace string) v1beta1.KafkaTargetInterface {
+	return &FakeKafkaTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) LogzMetricsTargets(namespace string) v1beta1.LogzMetricsTargetInterface {
+	return &FakeLogzMetricsTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) LogzTargets(namespace string) v1beta1.LogzTargetInterface {
+	return &FakeLogzTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) MongoDBTargets(namespace string) v1beta1.MongoDBTargetInterface {
+	return &FakeMongoDBTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) OracleTargets(namespace string) v1beta1.OracleTargetInterface {
+	return &FakeOracleTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) SalesforceTargets(namespace string) v1beta1.SalesforceTargetInterface {
+	return &FakeSalesforceTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) SendGridTargets(namespace string) v1beta1.SendGridTargetInterface {
+	return &FakeSendGridTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) SlackTargets(namespace string) v1beta1.SlackTargetInterface {
+	return &FakeSlackTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) SolaceTargets(namespace string) v1beta1.SolaceTargetInterface {
+	return &FakeSolaceTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) SplunkTargets(namespace string) v1beta1.SplunkTargetInterface {
+	return &FakeSplunkTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) TwilioTargets(namespace string) v1beta1.TwilioTargetInterface {
+	return &FakeTwilioTargets{c, namespace}
+}
+
+func (c *FakeTargetsV1beta1) ZendeskTargets(namespace string) v1beta1.ZendeskTargetInterface {
+	return &FakeZendeskTargets{c, namespace}
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeTargetsV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
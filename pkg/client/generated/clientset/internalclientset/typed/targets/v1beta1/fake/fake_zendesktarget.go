@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	"context"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	schema "k8s.io/apimachinery/pkg/runtime/schema"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	testing "k8s.io/client-go/testing"
+)
+
+// FakeZendeskTargets implements ZendeskTargetInterface
+type FakeZendeskTargets struct {
+	Fake *FakeTargetsV1beta1
+	ns   string
+}
+
+var zendesktargetsResource = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1beta1", Resource: "zendesktargets"}
+
+var zendesktargetsKind = schema.GroupVersionKind{Group: "targets.triggermesh.io", Version: "v1beta1", Kind: "ZendeskTarget"}
+
+// Get takes name of the zendeskTarget, and returns the corresponding zendeskTarget object, and an error if there is any.
+func (c *FakeZendeskTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.ZendeskTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewGetAction(zendesktargetsResource, c.ns, name), &v1beta1.ZendeskTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskTarget), err
+}
+
+// List takes label and field selectors, and returns the list of ZendeskTargets that match those selectors.
+func (c *FakeZendeskTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.ZendeskTargetList, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewListAction(zendesktargetsResource, zendesktargetsKind, c.ns, opts), &v1beta1.ZendeskTargetList{})
+
+	if obj == nil {
+		return nil, err
+	}
+
+	label, _, _ := testing.ExtractFromListOptions(opts)
+	if label == nil {
+		label = labels.Everything()
+	}
+	list := &v1beta1.ZendeskTargetList{ListMeta: obj.(*v1beta1.ZendeskTargetList).ListMeta}
+	for _, item := range obj.(*v1beta1.ZendeskTargetList).Items {
+		if label.Matches(labels.Set(item.Labels)) {
+			list.Items = append(list.Items, item)
+		}
+	}
+	return list, err
+}
+
+// Watch returns a watch.Interface that watches the requested zendeskTargets.
+func (c *FakeZendeskTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return c.Fake.
+		InvokesWatch(testing.NewWatchAction(zendesktargetsResource, c.ns, opts))
+
+}
+
+// Create takes the representation of a zendeskTarget and creates it.  Returns the server's representation of the zendeskTarget, and an error, if there is any.
+func (c *FakeZendeskTargets) Create(ctx context.Context, zendeskTarget *v1beta1.ZendeskTarget, opts v1.CreateOptions) (result *v1beta1.ZendeskTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewCreateAction(zendesktargetsResource, c.ns, zendeskTarget), &v1beta1.ZendeskTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskTarget), err
+}
+
+// Update takes the representation of a zendeskTarget and updates it. Returns the server's representation of the zendeskTarget, and an error, if there is any.
+func (c *FakeZendeskTargets) Update(ctx context.Context, zendeskTarget *v1beta1.ZendeskTarget, opts v1.UpdateOptions) (result *v1beta1.ZendeskTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateAction(zendesktargetsResource, c.ns, zendeskTarget), &v1beta1.ZendeskTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskTarget), err
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *FakeZendeskTargets) UpdateStatus(ctx context.Context, zendeskTarget *v1beta1.ZendeskTarget, opts v1.UpdateOptions) (*v1beta1.ZendeskTarget, error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewUpdateSubresourceAction(zendesktargetsResource, "status", c.ns, zendeskTarget), &v1beta1.ZendeskTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskTarget), err
+}
+
+// Delete takes name of the zendeskTarget and deletes it. Returns an error if one occurs.
+func (c *FakeZendeskTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	_, err := c.Fake.
+		Invokes(testing.NewDeleteActionWithOptions(zendesktargetsResource, c.ns, name, opts), &v1beta1.ZendeskTarget{})
+
+	return err
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *FakeZendeskTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	action := testing.NewDeleteCollectionAction(zendesktargetsResource, c.ns, listOpts)
+
+	_, err := c.Fake.Invokes(action, &v1beta1.ZendeskTargetList{})
+	return err
+}
+
+// Patch applies the patch and returns the patched zendeskTarget.
+func (c *FakeZendeskTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.ZendeskTarget, err error) {
+	obj, err := c.Fake.
+		Invokes(testing.NewPatchSubresourceAction(zendesktargetsResource, c.ns, name, pt, data, subresources...), &v1beta1.ZendeskTarget{})
+
+	if obj == nil {
+		return nil, err
+	}
+	return obj.(*v1beta1.ZendeskTarget), err
+}
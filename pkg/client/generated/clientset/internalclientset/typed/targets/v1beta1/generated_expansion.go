@@ -0,0 +1,87 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+type AWSComprehendTargetExpansion interface{}
+
+type AWSDynamoDBTargetExpansion interface{}
+
+type AWSEventBridgeTargetExpansion interface{}
+
+type AWSKinesisTargetExpansion interface{}
+
+type AWSLambdaTargetExpansion interface{}
+
+type AWSS3TargetExpansion interface{}
+
+type AWSSNSTargetExpansion interface{}
+
+type AWSSQSTargetExpansion interface{}
+
+type AzureEventHubsTargetExpansion interface{}
+
+type AzureSentinelTargetExpansion interface{}
+
+type AzureServiceBusTargetExpansion interface{}
+
+type CloudEventsTargetExpansion interface{}
+
+type DatadogTargetExpansion interface{}
+
+type ElasticsearchTargetExpansion interface{}
+
+type GoogleCloudFirestoreTargetExpansion interface{}
+
+type GoogleCloudPubSubTargetExpansion interface{}
+
+type GoogleCloudStorageTargetExpansion interface{}
+
+type GoogleCloudWorkflowsTargetExpansion interface{}
+
+type GoogleSheetTargetExpansion interface{}
+
+type HTTPTargetExpansion interface{}
+
+type IBMMQTargetExpansion interface{}
+
+type JiraTargetExpansion interface{}
+
+type KafkaTargetExpansion interface{}
+
+type LogzMetricsTargetExpansion interface{}
+
+type LogzTargetExpansion interface{}
+
+type MongoDBTargetExpansion interface{}
+
+type OracleTargetExpansion interface{}
+
+type SalesforceTargetExpansion interface{}
+
+type SendGridTargetExpansion interface{}
+
+type SlackTargetExpansion interface{}
+
+type SolaceTargetExpansion interface{}
+
+type SplunkTargetExpansion interface{}
+
+type TwilioTargetExpansion interface{}
+
+type ZendeskTargetExpansion interface{}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// GoogleSheetTargetsGetter has a method to return a GoogleSheetTargetInterface.
+// A group's client should implement this interface.
+type GoogleSheetTargetsGetter interface {
+	GoogleSheetTargets(namespace string) GoogleSheetTargetInterface
+}
+
+// GoogleSheetTargetInterface has methods to work with GoogleSheetTarget resources.
+type GoogleSheetTargetInterface interface {
+	Create(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.CreateOptions) (*v1beta1.GoogleSheetTarget, error)
+	Update(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (*v1beta1.GoogleSheetTarget, error)
+	UpdateStatus(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (*v1beta1.GoogleSheetTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.GoogleSheetTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.GoogleSheetTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleSheetTarget, err error)
+	GoogleSheetTargetExpansion
+}
+
+// googleSheetTargets implements GoogleSheetTargetInterface
+type googleSheetTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newGoogleSheetTargets returns a GoogleSheetTargets
+func newGoogleSheetTargets(c *TargetsV1beta1Client, namespace string) *googleSheetTargets {
+	return &googleSheetTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the googleSheetTarget, and returns the corresponding googleSheetTarget object, and an error if there is any.
+func (c *googleSheetTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	result = &v1beta1.GoogleSheetTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of GoogleSheetTargets that match those selectors.
+func (c *googleSheetTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.GoogleSheetTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.GoogleSheetTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested googleSheetTargets.
+func (c *googleSheetTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a googleSheetTarget and creates it.  Returns the server's representation of the googleSheetTarget, and an error, if there is any.
+func (c *googleSheetTargets) Create(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.CreateOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	result = &v1beta1.GoogleSheetTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(googleSheetTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a googleSheetTarget and updates it. Returns the server's representation of the googleSheetTarget, and an error, if there is any.
+func (c *googleSheetTargets) Update(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	result = &v1beta1.GoogleSheetTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		Name(googleSheetTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(googleSheetTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *googleSheetTargets) UpdateStatus(ctx context.Context, googleSheetTarget *v1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (result *v1beta1.GoogleSheetTarget, err error) {
+	result = &v1beta1.GoogleSheetTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		Name(googleSheetTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(googleSheetTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the googleSheetTarget and deletes it. Returns an error if one occurs.
+func (c *googleSheetTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *googleSheetTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched googleSheetTarget.
+func (c *googleSheetTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.GoogleSheetTarget, err error) {
+	result = &v1beta1.GoogleSheetTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("googlesheettargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by client-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	"time"
+
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	scheme "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	types "k8s.io/apimachinery/pkg/types"
+	watch "k8s.io/apimachinery/pkg/watch"
+	rest "k8s.io/client-go/rest"
+)
+
+// SalesforceTargetsGetter has a method to return a SalesforceTargetInterface.
+// A group's client should implement this interface.
+type SalesforceTargetsGetter interface {
+	SalesforceTargets(namespace string) SalesforceTargetInterface
+}
+
+// SalesforceTargetInterface has methods to work with SalesforceTarget resources.
+type SalesforceTargetInterface interface {
+	Create(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.CreateOptions) (*v1beta1.SalesforceTarget, error)
+	Update(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.UpdateOptions) (*v1beta1.SalesforceTarget, error)
+	UpdateStatus(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.UpdateOptions) (*v1beta1.SalesforceTarget, error)
+	Delete(ctx context.Context, name string, opts v1.DeleteOptions) error
+	DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error
+	Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.SalesforceTarget, error)
+	List(ctx context.Context, opts v1.ListOptions) (*v1beta1.SalesforceTargetList, error)
+	Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error)
+	Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.SalesforceTarget, err error)
+	SalesforceTargetExpansion
+}
+
+// salesforceTargets implements SalesforceTargetInterface
+type salesforceTargets struct {
+	client rest.Interface
+	ns     string
+}
+
+// newSalesforceTargets returns a SalesforceTargets
+func newSalesforceTargets(c *TargetsV1beta1Client, namespace string) *salesforceTargets {
+	return &salesforceTargets{
+		client: c.RESTClient(),
+		ns:     namespace,
+	}
+}
+
+// Get takes name of the salesforceTarget, and returns the corresponding salesforceTarget object, and an error if there is any.
+func (c *salesforceTargets) Get(ctx context.Context, name string, options v1.GetOptions) (result *v1beta1.SalesforceTarget, err error) {
+	result = &v1beta1.SalesforceTarget{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		Name(name).
+		VersionedParams(&options, scheme.ParameterCodec).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// List takes label and field selectors, and returns the list of SalesforceTargets that match those selectors.
+func (c *salesforceTargets) List(ctx context.Context, opts v1.ListOptions) (result *v1beta1.SalesforceTargetList, err error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	result = &v1beta1.SalesforceTargetList{}
+	err = c.client.Get().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Watch returns a watch.Interface that watches the requested salesforceTargets.
+func (c *salesforceTargets) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	var timeout time.Duration
+	if opts.TimeoutSeconds != nil {
+		timeout = time.Duration(*opts.TimeoutSeconds) * time.Second
+	}
+	opts.Watch = true
+	return c.client.Get().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Watch(ctx)
+}
+
+// Create takes the representation of a salesforceTarget and creates it.  Returns the server's representation of the salesforceTarget, and an error, if there is any.
+func (c *salesforceTargets) Create(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.CreateOptions) (result *v1beta1.SalesforceTarget, err error) {
+	result = &v1beta1.SalesforceTarget{}
+	err = c.client.Post().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(salesforceTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Update takes the representation of a salesforceTarget and updates it. Returns the server's representation of the salesforceTarget, and an error, if there is any.
+func (c *salesforceTargets) Update(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.UpdateOptions) (result *v1beta1.SalesforceTarget, err error) {
+	result = &v1beta1.SalesforceTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		Name(salesforceTarget.Name).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(salesforceTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// UpdateStatus was generated because the type contains a Status member.
+// Add a +genclient:noStatus comment above the type to avoid generating UpdateStatus().
+func (c *salesforceTargets) UpdateStatus(ctx context.Context, salesforceTarget *v1beta1.SalesforceTarget, opts v1.UpdateOptions) (result *v1beta1.SalesforceTarget, err error) {
+	result = &v1beta1.SalesforceTarget{}
+	err = c.client.Put().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		Name(salesforceTarget.Name).
+		SubResource("status").
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(salesforceTarget).
+		Do(ctx).
+		Into(result)
+	return
+}
+
+// Delete takes name of the salesforceTarget and deletes it. Returns an error if one occurs.
+func (c *salesforceTargets) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		Name(name).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// DeleteCollection deletes a collection of objects.
+func (c *salesforceTargets) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	var timeout time.Duration
+	if listOpts.TimeoutSeconds != nil {
+		timeout = time.Duration(*listOpts.TimeoutSeconds) * time.Second
+	}
+	return c.client.Delete().
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		VersionedParams(&listOpts, scheme.ParameterCodec).
+		Timeout(timeout).
+		Body(&opts).
+		Do(ctx).
+		Error()
+}
+
+// Patch applies the patch and returns the patched salesforceTarget.
+func (c *salesforceTargets) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.SalesforceTarget, err error) {
+	result = &v1beta1.SalesforceTarget{}
+	err = c.client.Patch(pt).
+		Namespace(c.ns).
+		Resource("salesforcetargets").
+		Name(name).
+		SubResource(subresources...).
+		VersionedParams(&opts, scheme.ParameterCodec).
+		Body(data).
+		Do(ctx).
+		Into(result)
+	return
+}
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	"context"
+	time "time"
+
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
+	internalclientset "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset"
+	internalinterfaces "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/internalinterfaces"
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/catalog/v1alpha1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// EventTypeCatalogInformer provides access to a shared informer and lister for
+// EventTypeCatalogs.
+type EventTypeCatalogInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1alpha1.EventTypeCatalogLister
+}
+
+type eventTypeCatalogInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewEventTypeCatalogInformer constructs a new informer for EventTypeCatalog type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewEventTypeCatalogInformer(client internalclientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredEventTypeCatalogInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredEventTypeCatalogInformer constructs a new informer for EventTypeCatalog type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredEventTypeCatalogInformer(client internalclientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CatalogV1alpha1().EventTypeCatalogs(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.CatalogV1alpha1().EventTypeCatalogs(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&catalogv1alpha1.EventTypeCatalog{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *eventTypeCatalogInformer) defaultInformer(client internalclientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredEventTypeCatalogInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *eventTypeCatalogInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&catalogv1alpha1.EventTypeCatalog{}, f.defaultInformer)
+}
+
+func (f *eventTypeCatalogInformer) Lister() v1alpha1.EventTypeCatalogLister {
+	return v1alpha1.NewEventTypeCatalogLister(f.Informer().GetIndexer())
+}
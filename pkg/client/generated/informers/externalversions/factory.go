@@ -24,6 +24,7 @@ import (
 	time "time"
 
 	internalclientset "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset"
+	catalog "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/catalog"
 	extensions "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/extensions"
 	flow "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/flow"
 	internalinterfaces "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/internalinterfaces"
@@ -176,6 +177,7 @@ type SharedInformerFactory interface {
 	ForResource(resource schema.GroupVersionResource) (GenericInformer, error)
 	WaitForCacheSync(stopCh <-chan struct{}) map[reflect.Type]bool
 
+	Catalog() catalog.Interface
 	Extensions() extensions.Interface
 	Flow() flow.Interface
 	Routing() routing.Interface
@@ -183,6 +185,10 @@ type SharedInformerFactory interface {
 	Targets() targets.Interface
 }
 
+func (f *sharedInformerFactory) Catalog() catalog.Interface {
+	return catalog.New(f, f.namespace, f.tweakListOptions)
+}
+
 func (f *sharedInformerFactory) Extensions() extensions.Interface {
 	return extensions.New(f, f.namespace, f.tweakListOptions)
 }
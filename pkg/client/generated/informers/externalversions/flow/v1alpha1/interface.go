@@ -24,8 +24,14 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Bridges returns a BridgeInformer.
+	Bridges() BridgeInformer
+	// Deduplicators returns a DeduplicatorInformer.
+	Deduplicators() DeduplicatorInformer
 	// JQTransformations returns a JQTransformationInformer.
 	JQTransformations() JQTransformationInformer
+	// SchemaValidators returns a SchemaValidatorInformer.
+	SchemaValidators() SchemaValidatorInformer
 	// Synchronizers returns a SynchronizerInformer.
 	Synchronizers() SynchronizerInformer
 	// Transformations returns a TransformationInformer.
@@ -47,11 +53,26 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Bridges returns a BridgeInformer.
+func (v *version) Bridges() BridgeInformer {
+	return &bridgeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// Deduplicators returns a DeduplicatorInformer.
+func (v *version) Deduplicators() DeduplicatorInformer {
+	return &deduplicatorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // JQTransformations returns a JQTransformationInformer.
 func (v *version) JQTransformations() JQTransformationInformer {
 	return &jQTransformationInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// SchemaValidators returns a SchemaValidatorInformer.
+func (v *version) SchemaValidators() SchemaValidatorInformer {
+	return &schemaValidatorInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Synchronizers returns a SynchronizerInformer.
 func (v *version) Synchronizers() SynchronizerInformer {
 	return &synchronizerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
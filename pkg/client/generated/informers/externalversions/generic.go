@@ -21,11 +21,17 @@ package externalversions
 import (
 	"fmt"
 
-	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
+	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1beta1"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	cache "k8s.io/client-go/tools/cache"
 )
@@ -56,13 +62,27 @@ func (f *genericInformer) Lister() cache.GenericLister {
 // TODO extend this to unknown resources with a client pool
 func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource) (GenericInformer, error) {
 	switch resource {
-	// Group=extensions.triggermesh.io, Version=v1alpha1
-	case v1alpha1.SchemeGroupVersion.WithResource("functions"):
+	// Group=catalog.triggermesh.io, Version=v1alpha1
+	case v1alpha1.SchemeGroupVersion.WithResource("eventtypecatalogs"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Catalog().V1alpha1().EventTypeCatalogs().Informer()}, nil
+
+		// Group=extensions.triggermesh.io, Version=v1alpha1
+	case extensionsv1alpha1.SchemeGroupVersion.WithResource("functions"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1alpha1().Functions().Informer()}, nil
 
+		// Group=extensions.triggermesh.io, Version=v1beta1
+	case v1beta1.SchemeGroupVersion.WithResource("functions"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Extensions().V1beta1().Functions().Informer()}, nil
+
 		// Group=flow.triggermesh.io, Version=v1alpha1
+	case flowv1alpha1.SchemeGroupVersion.WithResource("bridges"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Bridges().Informer()}, nil
+	case flowv1alpha1.SchemeGroupVersion.WithResource("deduplicators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Deduplicators().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("jqtransformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().JQTransformations().Informer()}, nil
+	case flowv1alpha1.SchemeGroupVersion.WithResource("schemavalidators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().SchemaValidators().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("synchronizers"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().Synchronizers().Informer()}, nil
 	case flowv1alpha1.SchemeGroupVersion.WithResource("transformations"):
@@ -72,12 +92,36 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case flowv1alpha1.SchemeGroupVersion.WithResource("xslttransformations"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1alpha1().XSLTTransformations().Informer()}, nil
 
+		// Group=flow.triggermesh.io, Version=v1beta1
+	case flowv1beta1.SchemeGroupVersion.WithResource("bridges"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().Bridges().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("deduplicators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().Deduplicators().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("jqtransformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().JQTransformations().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("schemavalidators"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().SchemaValidators().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("synchronizers"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().Synchronizers().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("transformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().Transformations().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("xmltojsontransformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().XMLToJSONTransformations().Informer()}, nil
+	case flowv1beta1.SchemeGroupVersion.WithResource("xslttransformations"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Flow().V1beta1().XSLTTransformations().Informer()}, nil
+
 		// Group=routing.triggermesh.io, Version=v1alpha1
 	case routingv1alpha1.SchemeGroupVersion.WithResource("filters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Filters().Informer()}, nil
 	case routingv1alpha1.SchemeGroupVersion.WithResource("splitters"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1alpha1().Splitters().Informer()}, nil
 
+		// Group=routing.triggermesh.io, Version=v1beta1
+	case routingv1beta1.SchemeGroupVersion.WithResource("filters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1beta1().Filters().Informer()}, nil
+	case routingv1beta1.SchemeGroupVersion.WithResource("splitters"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Routing().V1beta1().Splitters().Informer()}, nil
+
 		// Group=sources.triggermesh.io, Version=v1alpha1
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("awscloudwatchlogssources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().AWSCloudWatchLogsSources().Informer()}, nil
@@ -156,6 +200,84 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case sourcesv1alpha1.SchemeGroupVersion.WithResource("zendesksources"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1alpha1().ZendeskSources().Informer()}, nil
 
+		// Group=sources.triggermesh.io, Version=v1beta1
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awscloudwatchlogssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSCloudWatchLogsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awscloudwatchsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSCloudWatchSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awscodecommitsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSCodeCommitSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awscognitoidentitysources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSCognitoIdentitySources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awscognitouserpoolsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSCognitoUserPoolSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awsdynamodbsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSDynamoDBSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awseventbridgesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSEventBridgeSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awskinesissources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSKinesisSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awsperformanceinsightssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSPerformanceInsightsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awss3sources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSS3Sources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awssnssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSSNSSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("awssqssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AWSSQSSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureactivitylogssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureActivityLogsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureblobstoragesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureBlobStorageSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureeventgridsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureEventGridSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureeventhubssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureEventHubsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureiothubsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureIOTHubSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azurequeuestoragesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureQueueStorageSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureservicebusqueuesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureServiceBusQueueSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureservicebussources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureServiceBusSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("azureservicebustopicsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().AzureServiceBusTopicSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("cloudeventssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().CloudEventsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("googlecloudauditlogssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().GoogleCloudAuditLogsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("googlecloudbillingsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().GoogleCloudBillingSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("googlecloudpubsubsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().GoogleCloudPubSubSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("googlecloudsourcerepositoriessources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().GoogleCloudSourceRepositoriesSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("googlecloudstoragesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().GoogleCloudStorageSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("httppollersources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().HTTPPollerSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("ibmmqsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().IBMMQSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("kafkasources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().KafkaSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("mongodbsources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().MongoDBSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("ocimetricssources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().OCIMetricsSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("salesforcesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().SalesforceSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("slacksources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().SlackSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("solacesources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().SolaceSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("twiliosources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().TwilioSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("webhooksources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().WebhookSources().Informer()}, nil
+	case sourcesv1beta1.SchemeGroupVersion.WithResource("zendesksources"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Sources().V1beta1().ZendeskSources().Informer()}, nil
+
 		// Group=targets.triggermesh.io, Version=v1alpha1
 	case targetsv1alpha1.SchemeGroupVersion.WithResource("awscomprehendtargets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1alpha1().AWSComprehendTargets().Informer()}, nil
@@ -226,6 +348,76 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 	case targetsv1alpha1.SchemeGroupVersion.WithResource("zendesktargets"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1alpha1().ZendeskTargets().Informer()}, nil
 
+		// Group=targets.triggermesh.io, Version=v1beta1
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awscomprehendtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSComprehendTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awsdynamodbtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSDynamoDBTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awseventbridgetargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSEventBridgeTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awskinesistargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSKinesisTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awslambdatargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSLambdaTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awss3targets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSS3Targets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awssnstargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSSNSTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("awssqstargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AWSSQSTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("azureeventhubstargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AzureEventHubsTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("azuresentineltargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AzureSentinelTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("azureservicebustargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().AzureServiceBusTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("cloudeventstargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().CloudEventsTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("datadogtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().DatadogTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("elasticsearchtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().ElasticsearchTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("googlecloudfirestoretargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().GoogleCloudFirestoreTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("googlecloudpubsubtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().GoogleCloudPubSubTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("googlecloudstoragetargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().GoogleCloudStorageTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("googlecloudworkflowstargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().GoogleCloudWorkflowsTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("googlesheettargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().GoogleSheetTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("httptargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().HTTPTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("ibmmqtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().IBMMQTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("jiratargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().JiraTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("kafkatargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().KafkaTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("logzmetricstargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().LogzMetricsTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("logztargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().LogzTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("mongodbtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().MongoDBTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("oracletargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().OracleTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("salesforcetargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().SalesforceTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("sendgridtargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().SendGridTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("slacktargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().SlackTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("solacetargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().SolaceTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("splunktargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().SplunkTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("twiliotargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().TwilioTargets().Informer()}, nil
+	case targetsv1beta1.SchemeGroupVersion.WithResource("zendesktargets"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Targets().V1beta1().ZendeskTargets().Informer()}, nil
+
 	}
 
 	return nil, fmt.Errorf("no informer found for %v", resource)
@@ -0,0 +1,304 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	internalinterfaces "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// AWSCloudWatchLogsSources returns a AWSCloudWatchLogsSourceInformer.
+	AWSCloudWatchLogsSources() AWSCloudWatchLogsSourceInformer
+	// AWSCloudWatchSources returns a AWSCloudWatchSourceInformer.
+	AWSCloudWatchSources() AWSCloudWatchSourceInformer
+	// AWSCodeCommitSources returns a AWSCodeCommitSourceInformer.
+	AWSCodeCommitSources() AWSCodeCommitSourceInformer
+	// AWSCognitoIdentitySources returns a AWSCognitoIdentitySourceInformer.
+	AWSCognitoIdentitySources() AWSCognitoIdentitySourceInformer
+	// AWSCognitoUserPoolSources returns a AWSCognitoUserPoolSourceInformer.
+	AWSCognitoUserPoolSources() AWSCognitoUserPoolSourceInformer
+	// AWSDynamoDBSources returns a AWSDynamoDBSourceInformer.
+	AWSDynamoDBSources() AWSDynamoDBSourceInformer
+	// AWSEventBridgeSources returns a AWSEventBridgeSourceInformer.
+	AWSEventBridgeSources() AWSEventBridgeSourceInformer
+	// AWSKinesisSources returns a AWSKinesisSourceInformer.
+	AWSKinesisSources() AWSKinesisSourceInformer
+	// AWSPerformanceInsightsSources returns a AWSPerformanceInsightsSourceInformer.
+	AWSPerformanceInsightsSources() AWSPerformanceInsightsSourceInformer
+	// AWSS3Sources returns a AWSS3SourceInformer.
+	AWSS3Sources() AWSS3SourceInformer
+	// AWSSNSSources returns a AWSSNSSourceInformer.
+	AWSSNSSources() AWSSNSSourceInformer
+	// AWSSQSSources returns a AWSSQSSourceInformer.
+	AWSSQSSources() AWSSQSSourceInformer
+	// AzureActivityLogsSources returns a AzureActivityLogsSourceInformer.
+	AzureActivityLogsSources() AzureActivityLogsSourceInformer
+	// AzureBlobStorageSources returns a AzureBlobStorageSourceInformer.
+	AzureBlobStorageSources() AzureBlobStorageSourceInformer
+	// AzureEventGridSources returns a AzureEventGridSourceInformer.
+	AzureEventGridSources() AzureEventGridSourceInformer
+	// AzureEventHubsSources returns a AzureEventHubsSourceInformer.
+	AzureEventHubsSources() AzureEventHubsSourceInformer
+	// AzureIOTHubSources returns a AzureIOTHubSourceInformer.
+	AzureIOTHubSources() AzureIOTHubSourceInformer
+	// AzureQueueStorageSources returns a AzureQueueStorageSourceInformer.
+	AzureQueueStorageSources() AzureQueueStorageSourceInformer
+	// AzureServiceBusQueueSources returns a AzureServiceBusQueueSourceInformer.
+	AzureServiceBusQueueSources() AzureServiceBusQueueSourceInformer
+	// AzureServiceBusSources returns a AzureServiceBusSourceInformer.
+	AzureServiceBusSources() AzureServiceBusSourceInformer
+	// AzureServiceBusTopicSources returns a AzureServiceBusTopicSourceInformer.
+	AzureServiceBusTopicSources() AzureServiceBusTopicSourceInformer
+	// CloudEventsSources returns a CloudEventsSourceInformer.
+	CloudEventsSources() CloudEventsSourceInformer
+	// GoogleCloudAuditLogsSources returns a GoogleCloudAuditLogsSourceInformer.
+	GoogleCloudAuditLogsSources() GoogleCloudAuditLogsSourceInformer
+	// GoogleCloudBillingSources returns a GoogleCloudBillingSourceInformer.
+	GoogleCloudBillingSources() GoogleCloudBillingSourceInformer
+	// GoogleCloudPubSubSources returns a GoogleCloudPubSubSourceInformer.
+	GoogleCloudPubSubSources() GoogleCloudPubSubSourceInformer
+	// GoogleCloudSourceRepositoriesSources returns a GoogleCloudSourceRepositoriesSourceInformer.
+	GoogleCloudSourceRepositoriesSources() GoogleCloudSourceRepositoriesSourceInformer
+	// GoogleCloudStorageSources returns a GoogleCloudStorageSourceInformer.
+	GoogleCloudStorageSources() GoogleCloudStorageSourceInformer
+	// HTTPPollerSources returns a HTTPPollerSourceInformer.
+	HTTPPollerSources() HTTPPollerSourceInformer
+	// IBMMQSources returns a IBMMQSourceInformer.
+	IBMMQSources() IBMMQSourceInformer
+	// KafkaSources returns a KafkaSourceInformer.
+	KafkaSources() KafkaSourceInformer
+	// MongoDBSources returns a MongoDBSourceInformer.
+	MongoDBSources() MongoDBSourceInformer
+	// OCIMetricsSources returns a OCIMetricsSourceInformer.
+	OCIMetricsSources() OCIMetricsSourceInformer
+	// SalesforceSources returns a SalesforceSourceInformer.
+	SalesforceSources() SalesforceSourceInformer
+	// SlackSources returns a SlackSourceInformer.
+	SlackSources() SlackSourceInformer
+	// SolaceSources returns a SolaceSourceInformer.
+	SolaceSources() SolaceSourceInformer
+	// TwilioSources returns a TwilioSourceInformer.
+	TwilioSources() TwilioSourceInformer
+	// WebhookSources returns a WebhookSourceInformer.
+	WebhookSources() WebhookSourceInformer
+	// ZendeskSources returns a ZendeskSourceInformer.
+	ZendeskSources() ZendeskSourceInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// AWSCloudWatchLogsSources returns a AWSCloudWatchLogsSourceInformer.
+func (v *version) AWSCloudWatchLogsSources() AWSCloudWatchLogsSourceInformer {
+	return &aWSCloudWatchLogsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSCloudWatchSources returns a AWSCloudWatchSourceInformer.
+func (v *version) AWSCloudWatchSources() AWSCloudWatchSourceInformer {
+	return &aWSCloudWatchSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSCodeCommitSources returns a AWSCodeCommitSourceInformer.
+func (v *version) AWSCodeCommitSources() AWSCodeCommitSourceInformer {
+	return &aWSCodeCommitSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSCognitoIdentitySources returns a AWSCognitoIdentitySourceInformer.
+func (v *version) AWSCognitoIdentitySources() AWSCognitoIdentitySourceInformer {
+	return &aWSCognitoIdentitySourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSCognitoUserPoolSources returns a AWSCognitoUserPoolSourceInformer.
+func (v *version) AWSCognitoUserPoolSources() AWSCognitoUserPoolSourceInformer {
+	return &aWSCognitoUserPoolSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSDynamoDBSources returns a AWSDynamoDBSourceInformer.
+func (v *version) AWSDynamoDBSources() AWSDynamoDBSourceInformer {
+	return &aWSDynamoDBSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSEventBridgeSources returns a AWSEventBridgeSourceInformer.
+func (v *version) AWSEventBridgeSources() AWSEventBridgeSourceInformer {
+	return &aWSEventBridgeSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSKinesisSources returns a AWSKinesisSourceInformer.
+func (v *version) AWSKinesisSources() AWSKinesisSourceInformer {
+	return &aWSKinesisSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSPerformanceInsightsSources returns a AWSPerformanceInsightsSourceInformer.
+func (v *version) AWSPerformanceInsightsSources() AWSPerformanceInsightsSourceInformer {
+	return &aWSPerformanceInsightsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSS3Sources returns a AWSS3SourceInformer.
+func (v *version) AWSS3Sources() AWSS3SourceInformer {
+	return &aWSS3SourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSSNSSources returns a AWSSNSSourceInformer.
+func (v *version) AWSSNSSources() AWSSNSSourceInformer {
+	return &aWSSNSSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSSQSSources returns a AWSSQSSourceInformer.
+func (v *version) AWSSQSSources() AWSSQSSourceInformer {
+	return &aWSSQSSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureActivityLogsSources returns a AzureActivityLogsSourceInformer.
+func (v *version) AzureActivityLogsSources() AzureActivityLogsSourceInformer {
+	return &azureActivityLogsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureBlobStorageSources returns a AzureBlobStorageSourceInformer.
+func (v *version) AzureBlobStorageSources() AzureBlobStorageSourceInformer {
+	return &azureBlobStorageSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureEventGridSources returns a AzureEventGridSourceInformer.
+func (v *version) AzureEventGridSources() AzureEventGridSourceInformer {
+	return &azureEventGridSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureEventHubsSources returns a AzureEventHubsSourceInformer.
+func (v *version) AzureEventHubsSources() AzureEventHubsSourceInformer {
+	return &azureEventHubsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureIOTHubSources returns a AzureIOTHubSourceInformer.
+func (v *version) AzureIOTHubSources() AzureIOTHubSourceInformer {
+	return &azureIOTHubSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureQueueStorageSources returns a AzureQueueStorageSourceInformer.
+func (v *version) AzureQueueStorageSources() AzureQueueStorageSourceInformer {
+	return &azureQueueStorageSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureServiceBusQueueSources returns a AzureServiceBusQueueSourceInformer.
+func (v *version) AzureServiceBusQueueSources() AzureServiceBusQueueSourceInformer {
+	return &azureServiceBusQueueSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureServiceBusSources returns a AzureServiceBusSourceInformer.
+func (v *version) AzureServiceBusSources() AzureServiceBusSourceInformer {
+	return &azureServiceBusSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureServiceBusTopicSources returns a AzureServiceBusTopicSourceInformer.
+func (v *version) AzureServiceBusTopicSources() AzureServiceBusTopicSourceInformer {
+	return &azureServiceBusTopicSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CloudEventsSources returns a CloudEventsSourceInformer.
+func (v *version) CloudEventsSources() CloudEventsSourceInformer {
+	return &cloudEventsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudAuditLogsSources returns a GoogleCloudAuditLogsSourceInformer.
+func (v *version) GoogleCloudAuditLogsSources() GoogleCloudAuditLogsSourceInformer {
+	return &googleCloudAuditLogsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudBillingSources returns a GoogleCloudBillingSourceInformer.
+func (v *version) GoogleCloudBillingSources() GoogleCloudBillingSourceInformer {
+	return &googleCloudBillingSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudPubSubSources returns a GoogleCloudPubSubSourceInformer.
+func (v *version) GoogleCloudPubSubSources() GoogleCloudPubSubSourceInformer {
+	return &googleCloudPubSubSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudSourceRepositoriesSources returns a GoogleCloudSourceRepositoriesSourceInformer.
+func (v *version) GoogleCloudSourceRepositoriesSources() GoogleCloudSourceRepositoriesSourceInformer {
+	return &googleCloudSourceRepositoriesSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudStorageSources returns a GoogleCloudStorageSourceInformer.
+func (v *version) GoogleCloudStorageSources() GoogleCloudStorageSourceInformer {
+	return &googleCloudStorageSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// HTTPPollerSources returns a HTTPPollerSourceInformer.
+func (v *version) HTTPPollerSources() HTTPPollerSourceInformer {
+	return &hTTPPollerSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// IBMMQSources returns a IBMMQSourceInformer.
+func (v *version) IBMMQSources() IBMMQSourceInformer {
+	return &iBMMQSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// KafkaSources returns a KafkaSourceInformer.
+func (v *version) KafkaSources() KafkaSourceInformer {
+	return &kafkaSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MongoDBSources returns a MongoDBSourceInformer.
+func (v *version) MongoDBSources() MongoDBSourceInformer {
+	return &mongoDBSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// OCIMetricsSources returns a OCIMetricsSourceInformer.
+func (v *version) OCIMetricsSources() OCIMetricsSourceInformer {
+	return &oCIMetricsSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SalesforceSources returns a SalesforceSourceInformer.
+func (v *version) SalesforceSources() SalesforceSourceInformer {
+	return &salesforceSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SlackSources returns a SlackSourceInformer.
+func (v *version) SlackSources() SlackSourceInformer {
+	return &slackSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SolaceSources returns a SolaceSourceInformer.
+func (v *version) SolaceSources() SolaceSourceInformer {
+	return &solaceSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TwilioSources returns a TwilioSourceInformer.
+func (v *version) TwilioSources() TwilioSourceInformer {
+	return &twilioSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// WebhookSources returns a WebhookSourceInformer.
+func (v *version) WebhookSources() WebhookSourceInformer {
+	return &webhookSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ZendeskSources returns a ZendeskSourceInformer.
+func (v *version) ZendeskSources() ZendeskSourceInformer {
+	return &zendeskSourceInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
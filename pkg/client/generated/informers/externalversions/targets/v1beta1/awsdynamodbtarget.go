@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	"context"
+	time "time"
+
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	internalclientset "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset"
+	internalinterfaces "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/internalinterfaces"
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/targets/v1beta1"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	runtime "k8s.io/apimachinery/pkg/runtime"
+	watch "k8s.io/apimachinery/pkg/watch"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// AWSDynamoDBTargetInformer provides access to a shared informer and lister for
+// AWSDynamoDBTargets.
+type AWSDynamoDBTargetInformer interface {
+	Informer() cache.SharedIndexInformer
+	Lister() v1beta1.AWSDynamoDBTargetLister
+}
+
+type aWSDynamoDBTargetInformer struct {
+	factory          internalinterfaces.SharedInformerFactory
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+	namespace        string
+}
+
+// NewAWSDynamoDBTargetInformer constructs a new informer for AWSDynamoDBTarget type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewAWSDynamoDBTargetInformer(client internalclientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers) cache.SharedIndexInformer {
+	return NewFilteredAWSDynamoDBTargetInformer(client, namespace, resyncPeriod, indexers, nil)
+}
+
+// NewFilteredAWSDynamoDBTargetInformer constructs a new informer for AWSDynamoDBTarget type.
+// Always prefer using an informer factory to get a shared informer instead of getting an independent
+// one. This reduces memory footprint and number of connections to the server.
+func NewFilteredAWSDynamoDBTargetInformer(client internalclientset.Interface, namespace string, resyncPeriod time.Duration, indexers cache.Indexers, tweakListOptions internalinterfaces.TweakListOptionsFunc) cache.SharedIndexInformer {
+	return cache.NewSharedIndexInformer(
+		&cache.ListWatch{
+			ListFunc: func(options v1.ListOptions) (runtime.Object, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.TargetsV1beta1().AWSDynamoDBTargets(namespace).List(context.TODO(), options)
+			},
+			WatchFunc: func(options v1.ListOptions) (watch.Interface, error) {
+				if tweakListOptions != nil {
+					tweakListOptions(&options)
+				}
+				return client.TargetsV1beta1().AWSDynamoDBTargets(namespace).Watch(context.TODO(), options)
+			},
+		},
+		&targetsv1beta1.AWSDynamoDBTarget{},
+		resyncPeriod,
+		indexers,
+	)
+}
+
+func (f *aWSDynamoDBTargetInformer) defaultInformer(client internalclientset.Interface, resyncPeriod time.Duration) cache.SharedIndexInformer {
+	return NewFilteredAWSDynamoDBTargetInformer(client, f.namespace, resyncPeriod, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}, f.tweakListOptions)
+}
+
+func (f *aWSDynamoDBTargetInformer) Informer() cache.SharedIndexInformer {
+	return f.factory.InformerFor(&targetsv1beta1.AWSDynamoDBTarget{}, f.defaultInformer)
+}
+
+func (f *aWSDynamoDBTargetInformer) Lister() v1beta1.AWSDynamoDBTargetLister {
+	return v1beta1.NewAWSDynamoDBTargetLister(f.Informer().GetIndexer())
+}
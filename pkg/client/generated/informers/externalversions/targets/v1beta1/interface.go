@@ -0,0 +1,276 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by informer-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	internalinterfaces "github.com/triggermesh/triggermesh/pkg/client/generated/informers/externalversions/internalinterfaces"
+)
+
+// Interface provides access to all the informers in this group version.
+type Interface interface {
+	// AWSComprehendTargets returns a AWSComprehendTargetInformer.
+	AWSComprehendTargets() AWSComprehendTargetInformer
+	// AWSDynamoDBTargets returns a AWSDynamoDBTargetInformer.
+	AWSDynamoDBTargets() AWSDynamoDBTargetInformer
+	// AWSEventBridgeTargets returns a AWSEventBridgeTargetInformer.
+	AWSEventBridgeTargets() AWSEventBridgeTargetInformer
+	// AWSKinesisTargets returns a AWSKinesisTargetInformer.
+	AWSKinesisTargets() AWSKinesisTargetInformer
+	// AWSLambdaTargets returns a AWSLambdaTargetInformer.
+	AWSLambdaTargets() AWSLambdaTargetInformer
+	// AWSS3Targets returns a AWSS3TargetInformer.
+	AWSS3Targets() AWSS3TargetInformer
+	// AWSSNSTargets returns a AWSSNSTargetInformer.
+	AWSSNSTargets() AWSSNSTargetInformer
+	// AWSSQSTargets returns a AWSSQSTargetInformer.
+	AWSSQSTargets() AWSSQSTargetInformer
+	// AzureEventHubsTargets returns a AzureEventHubsTargetInformer.
+	AzureEventHubsTargets() AzureEventHubsTargetInformer
+	// AzureSentinelTargets returns a AzureSentinelTargetInformer.
+	AzureSentinelTargets() AzureSentinelTargetInformer
+	// AzureServiceBusTargets returns a AzureServiceBusTargetInformer.
+	AzureServiceBusTargets() AzureServiceBusTargetInformer
+	// CloudEventsTargets returns a CloudEventsTargetInformer.
+	CloudEventsTargets() CloudEventsTargetInformer
+	// DatadogTargets returns a DatadogTargetInformer.
+	DatadogTargets() DatadogTargetInformer
+	// ElasticsearchTargets returns a ElasticsearchTargetInformer.
+	ElasticsearchTargets() ElasticsearchTargetInformer
+	// GoogleCloudFirestoreTargets returns a GoogleCloudFirestoreTargetInformer.
+	GoogleCloudFirestoreTargets() GoogleCloudFirestoreTargetInformer
+	// GoogleCloudPubSubTargets returns a GoogleCloudPubSubTargetInformer.
+	GoogleCloudPubSubTargets() GoogleCloudPubSubTargetInformer
+	// GoogleCloudStorageTargets returns a GoogleCloudStorageTargetInformer.
+	GoogleCloudStorageTargets() GoogleCloudStorageTargetInformer
+	// GoogleCloudWorkflowsTargets returns a GoogleCloudWorkflowsTargetInformer.
+	GoogleCloudWorkflowsTargets() GoogleCloudWorkflowsTargetInformer
+	// GoogleSheetTargets returns a GoogleSheetTargetInformer.
+	GoogleSheetTargets() GoogleSheetTargetInformer
+	// HTTPTargets returns a HTTPTargetInformer.
+	HTTPTargets() HTTPTargetInformer
+	// IBMMQTargets returns a IBMMQTargetInformer.
+	IBMMQTargets() IBMMQTargetInformer
+	// JiraTargets returns a JiraTargetInformer.
+	JiraTargets() JiraTargetInformer
+	// KafkaTargets returns a KafkaTargetInformer.
+	KafkaTargets() KafkaTargetInformer
+	// LogzMetricsTargets returns a LogzMetricsTargetInformer.
+	LogzMetricsTargets() LogzMetricsTargetInformer
+	// LogzTargets returns a LogzTargetInformer.
+	LogzTargets() LogzTargetInformer
+	// MongoDBTargets returns a MongoDBTargetInformer.
+	MongoDBTargets() MongoDBTargetInformer
+	// OracleTargets returns a OracleTargetInformer.
+	OracleTargets() OracleTargetInformer
+	// SalesforceTargets returns a SalesforceTargetInformer.
+	SalesforceTargets() SalesforceTargetInformer
+	// SendGridTargets returns a SendGridTargetInformer.
+	SendGridTargets() SendGridTargetInformer
+	// SlackTargets returns a SlackTargetInformer.
+	SlackTargets() SlackTargetInformer
+	// SolaceTargets returns a SolaceTargetInformer.
+	SolaceTargets() SolaceTargetInformer
+	// SplunkTargets returns a SplunkTargetInformer.
+	SplunkTargets() SplunkTargetInformer
+	// TwilioTargets returns a TwilioTargetInformer.
+	TwilioTargets() TwilioTargetInformer
+	// ZendeskTargets returns a ZendeskTargetInformer.
+	ZendeskTargets() ZendeskTargetInformer
+}
+
+type version struct {
+	factory          internalinterfaces.SharedInformerFactory
+	namespace        string
+	tweakListOptions internalinterfaces.TweakListOptionsFunc
+}
+
+// New returns a new Interface.
+func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakListOptions internalinterfaces.TweakListOptionsFunc) Interface {
+	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
+}
+
+// AWSComprehendTargets returns a AWSComprehendTargetInformer.
+func (v *version) AWSComprehendTargets() AWSComprehendTargetInformer {
+	return &aWSComprehendTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSDynamoDBTargets returns a AWSDynamoDBTargetInformer.
+func (v *version) AWSDynamoDBTargets() AWSDynamoDBTargetInformer {
+	return &aWSDynamoDBTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSEventBridgeTargets returns a AWSEventBridgeTargetInformer.
+func (v *version) AWSEventBridgeTargets() AWSEventBridgeTargetInformer {
+	return &aWSEventBridgeTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSKinesisTargets returns a AWSKinesisTargetInformer.
+func (v *version) AWSKinesisTargets() AWSKinesisTargetInformer {
+	return &aWSKinesisTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSLambdaTargets returns a AWSLambdaTargetInformer.
+func (v *version) AWSLambdaTargets() AWSLambdaTargetInformer {
+	return &aWSLambdaTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSS3Targets returns a AWSS3TargetInformer.
+func (v *version) AWSS3Targets() AWSS3TargetInformer {
+	return &aWSS3TargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSSNSTargets returns a AWSSNSTargetInformer.
+func (v *version) AWSSNSTargets() AWSSNSTargetInformer {
+	return &aWSSNSTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AWSSQSTargets returns a AWSSQSTargetInformer.
+func (v *version) AWSSQSTargets() AWSSQSTargetInformer {
+	return &aWSSQSTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureEventHubsTargets returns a AzureEventHubsTargetInformer.
+func (v *version) AzureEventHubsTargets() AzureEventHubsTargetInformer {
+	return &azureEventHubsTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureSentinelTargets returns a AzureSentinelTargetInformer.
+func (v *version) AzureSentinelTargets() AzureSentinelTargetInformer {
+	return &azureSentinelTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// AzureServiceBusTargets returns a AzureServiceBusTargetInformer.
+func (v *version) AzureServiceBusTargets() AzureServiceBusTargetInformer {
+	return &azureServiceBusTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// CloudEventsTargets returns a CloudEventsTargetInformer.
+func (v *version) CloudEventsTargets() CloudEventsTargetInformer {
+	return &cloudEventsTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// DatadogTargets returns a DatadogTargetInformer.
+func (v *version) DatadogTargets() DatadogTargetInformer {
+	return &datadogTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ElasticsearchTargets returns a ElasticsearchTargetInformer.
+func (v *version) ElasticsearchTargets() ElasticsearchTargetInformer {
+	return &elasticsearchTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudFirestoreTargets returns a GoogleCloudFirestoreTargetInformer.
+func (v *version) GoogleCloudFirestoreTargets() GoogleCloudFirestoreTargetInformer {
+	return &googleCloudFirestoreTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudPubSubTargets returns a GoogleCloudPubSubTargetInformer.
+func (v *version) GoogleCloudPubSubTargets() GoogleCloudPubSubTargetInformer {
+	return &googleCloudPubSubTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudStorageTargets returns a GoogleCloudStorageTargetInformer.
+func (v *version) GoogleCloudStorageTargets() GoogleCloudStorageTargetInformer {
+	return &googleCloudStorageTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleCloudWorkflowsTargets returns a GoogleCloudWorkflowsTargetInformer.
+func (v *version) GoogleCloudWorkflowsTargets() GoogleCloudWorkflowsTargetInformer {
+	return &googleCloudWorkflowsTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// GoogleSheetTargets returns a GoogleSheetTargetInformer.
+func (v *version) GoogleSheetTargets() GoogleSheetTargetInformer {
+	return &googleSheetTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// HTTPTargets returns a HTTPTargetInformer.
+func (v *version) HTTPTargets() HTTPTargetInformer {
+	return &hTTPTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// IBMMQTargets returns a IBMMQTargetInformer.
+func (v *version) IBMMQTargets() IBMMQTargetInformer {
+	return &iBMMQTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// JiraTargets returns a JiraTargetInformer.
+func (v *version) JiraTargets() JiraTargetInformer {
+	return &jiraTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// KafkaTargets returns a KafkaTargetInformer.
+func (v *version) KafkaTargets() KafkaTargetInformer {
+	return &kafkaTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// LogzMetricsTargets returns a LogzMetricsTargetInformer.
+func (v *version) LogzMetricsTargets() LogzMetricsTargetInformer {
+	return &logzMetricsTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// LogzTargets returns a LogzTargetInformer.
+func (v *version) LogzTargets() LogzTargetInformer {
+	return &logzTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// MongoDBTargets returns a MongoDBTargetInformer.
+func (v *version) MongoDBTargets() MongoDBTargetInformer {
+	return &mongoDBTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// OracleTargets returns a OracleTargetInformer.
+func (v *version) OracleTargets() OracleTargetInformer {
+	return &oracleTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SalesforceTargets returns a SalesforceTargetInformer.
+func (v *version) SalesforceTargets() SalesforceTargetInformer {
+	return &salesforceTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SendGridTargets returns a SendGridTargetInformer.
+func (v *version) SendGridTargets() SendGridTargetInformer {
+	return &sendGridTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SlackTargets returns a SlackTargetInformer.
+func (v *version) SlackTargets() SlackTargetInformer {
+	return &slackTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SolaceTargets returns a SolaceTargetInformer.
+func (v *version) SolaceTargets() SolaceTargetInformer {
+	return &solaceTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// SplunkTargets returns a SplunkTargetInformer.
+func (v *version) SplunkTargets() SplunkTargetInformer {
+	return &splunkTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// TwilioTargets returns a TwilioTargetInformer.
+func (v *version) TwilioTargets() TwilioTargetInformer {
+	return &twilioTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// ZendeskTargets returns a ZendeskTargetInformer.
+func (v *version) ZendeskTargets() ZendeskTargetInformer {
+	return &zendeskTargetInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
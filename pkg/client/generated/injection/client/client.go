@@ -24,17 +24,29 @@ import (
 	errors "errors"
 	fmt "fmt"
 
-	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
+	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1beta1"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	flowv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
 	routingv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1alpha1"
+	routingv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/routing/v1beta1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	sourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetsv1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
 	internalclientset "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset"
+	typedcatalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/catalog/v1alpha1"
 	typedextensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1alpha1"
+	typedextensionsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/extensions/v1beta1"
 	typedflowv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1alpha1"
+	typedflowv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/flow/v1beta1"
 	typedroutingv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1alpha1"
+	typedroutingv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/routing/v1beta1"
 	typedsourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1alpha1"
+	typedsourcesv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/sources/v1beta1"
 	typedtargetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1alpha1"
+	typedtargetsv1beta1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/targets/v1beta1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	runtime "k8s.io/apimachinery/pkg/runtime"
@@ -104,46 +116,46 @@ func convert(from interface{}, to runtime.Object) error {
 	return nil
 }
 
-// ExtensionsV1alpha1 retrieves the ExtensionsV1alpha1Client
-func (w *wrapClient) ExtensionsV1alpha1() typedextensionsv1alpha1.ExtensionsV1alpha1Interface {
-	return &wrapExtensionsV1alpha1{
+// CatalogV1alpha1 retrieves the CatalogV1alpha1Client
+func (w *wrapClient) CatalogV1alpha1() typedcatalogv1alpha1.CatalogV1alpha1Interface {
+	return &wrapCatalogV1alpha1{
 		dyn: w.dyn,
 	}
 }
 
-type wrapExtensionsV1alpha1 struct {
+type wrapCatalogV1alpha1 struct {
 	dyn dynamic.Interface
 }
 
-func (w *wrapExtensionsV1alpha1) RESTClient() rest.Interface {
+func (w *wrapCatalogV1alpha1) RESTClient() rest.Interface {
 	panic("RESTClient called on dynamic client!")
 }
 
-func (w *wrapExtensionsV1alpha1) Functions(namespace string) typedextensionsv1alpha1.FunctionInterface {
-	return &wrapExtensionsV1alpha1FunctionImpl{
+func (w *wrapCatalogV1alpha1) EventTypeCatalogs(namespace string) typedcatalogv1alpha1.EventTypeCatalogInterface {
+	return &wrapCatalogV1alpha1EventTypeCatalogImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "extensions.triggermesh.io",
+			Group:    "catalog.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "functions",
+			Resource: "eventtypecatalogs",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapExtensionsV1alpha1FunctionImpl struct {
+type wrapCatalogV1alpha1EventTypeCatalogImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedextensionsv1alpha1.FunctionInterface = (*wrapExtensionsV1alpha1FunctionImpl)(nil)
+var _ typedcatalogv1alpha1.EventTypeCatalogInterface = (*wrapCatalogV1alpha1EventTypeCatalogImpl)(nil)
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) Create(ctx context.Context, in *v1alpha1.Function, opts v1.CreateOptions) (*v1alpha1.Function, error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) Create(ctx context.Context, in *v1alpha1.EventTypeCatalog, opts v1.CreateOptions) (*v1alpha1.EventTypeCatalog, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "extensions.triggermesh.io",
+		Group:   "catalog.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Function",
+		Kind:    "EventTypeCatalog",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -153,62 +165,62 @@ func (w *wrapExtensionsV1alpha1FunctionImpl) Create(ctx context.Context, in *v1a
 	if err != nil {
 		return nil, err
 	}
-	out := &v1alpha1.Function{}
+	out := &v1alpha1.EventTypeCatalog{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.Function, error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*v1alpha1.EventTypeCatalog, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &v1alpha1.Function{}
+	out := &v1alpha1.EventTypeCatalog{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.FunctionList, error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) List(ctx context.Context, opts v1.ListOptions) (*v1alpha1.EventTypeCatalogList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &v1alpha1.FunctionList{}
+	out := &v1alpha1.EventTypeCatalogList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.Function, err error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1alpha1.EventTypeCatalog, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &v1alpha1.Function{}
+	out := &v1alpha1.EventTypeCatalog{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) Update(ctx context.Context, in *v1alpha1.Function, opts v1.UpdateOptions) (*v1alpha1.Function, error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) Update(ctx context.Context, in *v1alpha1.EventTypeCatalog, opts v1.UpdateOptions) (*v1alpha1.EventTypeCatalog, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "extensions.triggermesh.io",
+		Group:   "catalog.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Function",
+		Kind:    "EventTypeCatalog",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -218,18 +230,18 @@ func (w *wrapExtensionsV1alpha1FunctionImpl) Update(ctx context.Context, in *v1a
 	if err != nil {
 		return nil, err
 	}
-	out := &v1alpha1.Function{}
+	out := &v1alpha1.EventTypeCatalog{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) UpdateStatus(ctx context.Context, in *v1alpha1.Function, opts v1.UpdateOptions) (*v1alpha1.Function, error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) UpdateStatus(ctx context.Context, in *v1alpha1.EventTypeCatalog, opts v1.UpdateOptions) (*v1alpha1.EventTypeCatalog, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "extensions.triggermesh.io",
+		Group:   "catalog.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Function",
+		Kind:    "EventTypeCatalog",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -239,57 +251,57 @@ func (w *wrapExtensionsV1alpha1FunctionImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &v1alpha1.Function{}
+	out := &v1alpha1.EventTypeCatalog{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapExtensionsV1alpha1FunctionImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapCatalogV1alpha1EventTypeCatalogImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-// FlowV1alpha1 retrieves the FlowV1alpha1Client
-func (w *wrapClient) FlowV1alpha1() typedflowv1alpha1.FlowV1alpha1Interface {
-	return &wrapFlowV1alpha1{
+// ExtensionsV1alpha1 retrieves the ExtensionsV1alpha1Client
+func (w *wrapClient) ExtensionsV1alpha1() typedextensionsv1alpha1.ExtensionsV1alpha1Interface {
+	return &wrapExtensionsV1alpha1{
 		dyn: w.dyn,
 	}
 }
 
-type wrapFlowV1alpha1 struct {
+type wrapExtensionsV1alpha1 struct {
 	dyn dynamic.Interface
 }
 
-func (w *wrapFlowV1alpha1) RESTClient() rest.Interface {
+func (w *wrapExtensionsV1alpha1) RESTClient() rest.Interface {
 	panic("RESTClient called on dynamic client!")
 }
 
-func (w *wrapFlowV1alpha1) JQTransformations(namespace string) typedflowv1alpha1.JQTransformationInterface {
-	return &wrapFlowV1alpha1JQTransformationImpl{
+func (w *wrapExtensionsV1alpha1) Functions(namespace string) typedextensionsv1alpha1.FunctionInterface {
+	return &wrapExtensionsV1alpha1FunctionImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "flow.triggermesh.io",
+			Group:    "extensions.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "jqtransformations",
+			Resource: "functions",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapFlowV1alpha1JQTransformationImpl struct {
+type wrapExtensionsV1alpha1FunctionImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedflowv1alpha1.JQTransformationInterface = (*wrapFlowV1alpha1JQTransformationImpl)(nil)
+var _ typedextensionsv1alpha1.FunctionInterface = (*wrapExtensionsV1alpha1FunctionImpl)(nil)
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.JQTransformation, opts v1.CreateOptions) (*flowv1alpha1.JQTransformation, error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) Create(ctx context.Context, in *extensionsv1alpha1.Function, opts v1.CreateOptions) (*extensionsv1alpha1.Function, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "flow.triggermesh.io",
+		Group:   "extensions.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "JQTransformation",
+		Kind:    "Function",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -299,62 +311,62 @@ func (w *wrapFlowV1alpha1JQTransformationImpl) Create(ctx context.Context, in *f
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.JQTransformation{}
+	out := &extensionsv1alpha1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapExtensionsV1alpha1FunctionImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapExtensionsV1alpha1FunctionImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.JQTransformation, error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*extensionsv1alpha1.Function, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.JQTransformation{}
+	out := &extensionsv1alpha1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.JQTransformationList, error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) List(ctx context.Context, opts v1.ListOptions) (*extensionsv1alpha1.FunctionList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.JQTransformationList{}
+	out := &extensionsv1alpha1.FunctionList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.JQTransformation, err error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *extensionsv1alpha1.Function, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.JQTransformation{}
+	out := &extensionsv1alpha1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.JQTransformation, opts v1.UpdateOptions) (*flowv1alpha1.JQTransformation, error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) Update(ctx context.Context, in *extensionsv1alpha1.Function, opts v1.UpdateOptions) (*extensionsv1alpha1.Function, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "flow.triggermesh.io",
+		Group:   "extensions.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "JQTransformation",
+		Kind:    "Function",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -364,18 +376,18 @@ func (w *wrapFlowV1alpha1JQTransformationImpl) Update(ctx context.Context, in *f
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.JQTransformation{}
+	out := &extensionsv1alpha1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.JQTransformation, opts v1.UpdateOptions) (*flowv1alpha1.JQTransformation, error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) UpdateStatus(ctx context.Context, in *extensionsv1alpha1.Function, opts v1.UpdateOptions) (*extensionsv1alpha1.Function, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "flow.triggermesh.io",
+		Group:   "extensions.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "JQTransformation",
+		Kind:    "Function",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -385,42 +397,57 @@ func (w *wrapFlowV1alpha1JQTransformationImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.JQTransformation{}
+	out := &extensionsv1alpha1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1JQTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapExtensionsV1alpha1FunctionImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapFlowV1alpha1) Synchronizers(namespace string) typedflowv1alpha1.SynchronizerInterface {
-	return &wrapFlowV1alpha1SynchronizerImpl{
+// ExtensionsV1beta1 retrieves the ExtensionsV1beta1Client
+func (w *wrapClient) ExtensionsV1beta1() typedextensionsv1beta1.ExtensionsV1beta1Interface {
+	return &wrapExtensionsV1beta1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapExtensionsV1beta1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapExtensionsV1beta1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapExtensionsV1beta1) Functions(namespace string) typedextensionsv1beta1.FunctionInterface {
+	return &wrapExtensionsV1beta1FunctionImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "flow.triggermesh.io",
-			Version:  "v1alpha1",
-			Resource: "synchronizers",
+			Group:    "extensions.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "functions",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapFlowV1alpha1SynchronizerImpl struct {
+type wrapExtensionsV1beta1FunctionImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedflowv1alpha1.SynchronizerInterface = (*wrapFlowV1alpha1SynchronizerImpl)(nil)
+var _ typedextensionsv1beta1.FunctionInterface = (*wrapExtensionsV1beta1FunctionImpl)(nil)
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) Create(ctx context.Context, in *flowv1alpha1.Synchronizer, opts v1.CreateOptions) (*flowv1alpha1.Synchronizer, error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) Create(ctx context.Context, in *v1beta1.Function, opts v1.CreateOptions) (*v1beta1.Function, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "flow.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "Synchronizer",
+		Group:   "extensions.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Function",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -430,62 +457,62 @@ func (w *wrapFlowV1alpha1SynchronizerImpl) Create(ctx context.Context, in *flowv
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Synchronizer{}
+	out := &v1beta1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapExtensionsV1beta1FunctionImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapExtensionsV1beta1FunctionImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.Synchronizer, error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*v1beta1.Function, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Synchronizer{}
+	out := &v1beta1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.SynchronizerList, error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) List(ctx context.Context, opts v1.ListOptions) (*v1beta1.FunctionList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.SynchronizerList{}
+	out := &v1beta1.FunctionList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.Synchronizer, err error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *v1beta1.Function, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Synchronizer{}
+	out := &v1beta1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) Update(ctx context.Context, in *flowv1alpha1.Synchronizer, opts v1.UpdateOptions) (*flowv1alpha1.Synchronizer, error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) Update(ctx context.Context, in *v1beta1.Function, opts v1.UpdateOptions) (*v1beta1.Function, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "flow.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "Synchronizer",
+		Group:   "extensions.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Function",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -495,18 +522,18 @@ func (w *wrapFlowV1alpha1SynchronizerImpl) Update(ctx context.Context, in *flowv
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Synchronizer{}
+	out := &v1beta1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.Synchronizer, opts v1.UpdateOptions) (*flowv1alpha1.Synchronizer, error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) UpdateStatus(ctx context.Context, in *v1beta1.Function, opts v1.UpdateOptions) (*v1beta1.Function, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "flow.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "Synchronizer",
+		Group:   "extensions.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Function",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -516,42 +543,57 @@ func (w *wrapFlowV1alpha1SynchronizerImpl) UpdateStatus(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Synchronizer{}
+	out := &v1beta1.Function{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1SynchronizerImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapExtensionsV1beta1FunctionImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapFlowV1alpha1) Transformations(namespace string) typedflowv1alpha1.TransformationInterface {
-	return &wrapFlowV1alpha1TransformationImpl{
+// FlowV1alpha1 retrieves the FlowV1alpha1Client
+func (w *wrapClient) FlowV1alpha1() typedflowv1alpha1.FlowV1alpha1Interface {
+	return &wrapFlowV1alpha1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapFlowV1alpha1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapFlowV1alpha1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapFlowV1alpha1) Bridges(namespace string) typedflowv1alpha1.BridgeInterface {
+	return &wrapFlowV1alpha1BridgeImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "flow.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "transformations",
+			Resource: "bridges",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapFlowV1alpha1TransformationImpl struct {
+type wrapFlowV1alpha1BridgeImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedflowv1alpha1.TransformationInterface = (*wrapFlowV1alpha1TransformationImpl)(nil)
+var _ typedflowv1alpha1.BridgeInterface = (*wrapFlowV1alpha1BridgeImpl)(nil)
 
-func (w *wrapFlowV1alpha1TransformationImpl) Create(ctx context.Context, in *flowv1alpha1.Transformation, opts v1.CreateOptions) (*flowv1alpha1.Transformation, error) {
+func (w *wrapFlowV1alpha1BridgeImpl) Create(ctx context.Context, in *flowv1alpha1.Bridge, opts v1.CreateOptions) (*flowv1alpha1.Bridge, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Transformation",
+		Kind:    "Bridge",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -561,62 +603,62 @@ func (w *wrapFlowV1alpha1TransformationImpl) Create(ctx context.Context, in *flo
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Transformation{}
+	out := &flowv1alpha1.Bridge{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapFlowV1alpha1BridgeImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapFlowV1alpha1BridgeImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.Transformation, error) {
+func (w *wrapFlowV1alpha1BridgeImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.Bridge, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Transformation{}
+	out := &flowv1alpha1.Bridge{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.TransformationList, error) {
+func (w *wrapFlowV1alpha1BridgeImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.BridgeList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.TransformationList{}
+	out := &flowv1alpha1.BridgeList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.Transformation, err error) {
+func (w *wrapFlowV1alpha1BridgeImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.Bridge, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Transformation{}
+	out := &flowv1alpha1.Bridge{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) Update(ctx context.Context, in *flowv1alpha1.Transformation, opts v1.UpdateOptions) (*flowv1alpha1.Transformation, error) {
+func (w *wrapFlowV1alpha1BridgeImpl) Update(ctx context.Context, in *flowv1alpha1.Bridge, opts v1.UpdateOptions) (*flowv1alpha1.Bridge, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Transformation",
+		Kind:    "Bridge",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -626,18 +668,18 @@ func (w *wrapFlowV1alpha1TransformationImpl) Update(ctx context.Context, in *flo
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Transformation{}
+	out := &flowv1alpha1.Bridge{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.Transformation, opts v1.UpdateOptions) (*flowv1alpha1.Transformation, error) {
+func (w *wrapFlowV1alpha1BridgeImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.Bridge, opts v1.UpdateOptions) (*flowv1alpha1.Bridge, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Transformation",
+		Kind:    "Bridge",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -647,42 +689,42 @@ func (w *wrapFlowV1alpha1TransformationImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.Transformation{}
+	out := &flowv1alpha1.Bridge{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1TransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapFlowV1alpha1BridgeImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapFlowV1alpha1) XMLToJSONTransformations(namespace string) typedflowv1alpha1.XMLToJSONTransformationInterface {
-	return &wrapFlowV1alpha1XMLToJSONTransformationImpl{
+func (w *wrapFlowV1alpha1) Deduplicators(namespace string) typedflowv1alpha1.DeduplicatorInterface {
+	return &wrapFlowV1alpha1DeduplicatorImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "flow.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "xmltojsontransformations",
+			Resource: "deduplicators",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapFlowV1alpha1XMLToJSONTransformationImpl struct {
+type wrapFlowV1alpha1DeduplicatorImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedflowv1alpha1.XMLToJSONTransformationInterface = (*wrapFlowV1alpha1XMLToJSONTransformationImpl)(nil)
+var _ typedflowv1alpha1.DeduplicatorInterface = (*wrapFlowV1alpha1DeduplicatorImpl)(nil)
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.XMLToJSONTransformation, opts v1.CreateOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) Create(ctx context.Context, in *flowv1alpha1.Deduplicator, opts v1.CreateOptions) (*flowv1alpha1.Deduplicator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "XMLToJSONTransformation",
+		Kind:    "Deduplicator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -692,62 +734,62 @@ func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Create(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XMLToJSONTransformation{}
+	out := &flowv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.Deduplicator, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XMLToJSONTransformation{}
+	out := &flowv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.XMLToJSONTransformationList, error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.DeduplicatorList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XMLToJSONTransformationList{}
+	out := &flowv1alpha1.DeduplicatorList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.XMLToJSONTransformation, err error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.Deduplicator, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XMLToJSONTransformation{}
+	out := &flowv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.XMLToJSONTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) Update(ctx context.Context, in *flowv1alpha1.Deduplicator, opts v1.UpdateOptions) (*flowv1alpha1.Deduplicator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "XMLToJSONTransformation",
+		Kind:    "Deduplicator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -757,18 +799,18 @@ func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Update(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XMLToJSONTransformation{}
+	out := &flowv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.XMLToJSONTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.Deduplicator, opts v1.UpdateOptions) (*flowv1alpha1.Deduplicator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "XMLToJSONTransformation",
+		Kind:    "Deduplicator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -778,42 +820,42 @@ func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) UpdateStatus(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XMLToJSONTransformation{}
+	out := &flowv1alpha1.Deduplicator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapFlowV1alpha1DeduplicatorImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapFlowV1alpha1) XSLTTransformations(namespace string) typedflowv1alpha1.XSLTTransformationInterface {
-	return &wrapFlowV1alpha1XSLTTransformationImpl{
+func (w *wrapFlowV1alpha1) JQTransformations(namespace string) typedflowv1alpha1.JQTransformationInterface {
+	return &wrapFlowV1alpha1JQTransformationImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "flow.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "xslttransformations",
+			Resource: "jqtransformations",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapFlowV1alpha1XSLTTransformationImpl struct {
+type wrapFlowV1alpha1JQTransformationImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedflowv1alpha1.XSLTTransformationInterface = (*wrapFlowV1alpha1XSLTTransformationImpl)(nil)
+var _ typedflowv1alpha1.JQTransformationInterface = (*wrapFlowV1alpha1JQTransformationImpl)(nil)
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.XSLTTransformation, opts v1.CreateOptions) (*flowv1alpha1.XSLTTransformation, error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.JQTransformation, opts v1.CreateOptions) (*flowv1alpha1.JQTransformation, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "XSLTTransformation",
+		Kind:    "JQTransformation",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -823,62 +865,62 @@ func (w *wrapFlowV1alpha1XSLTTransformationImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XSLTTransformation{}
+	out := &flowv1alpha1.JQTransformation{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapFlowV1alpha1JQTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapFlowV1alpha1JQTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.XSLTTransformation, error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.JQTransformation, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XSLTTransformation{}
+	out := &flowv1alpha1.JQTransformation{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.XSLTTransformationList, error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.JQTransformationList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XSLTTransformationList{}
+	out := &flowv1alpha1.JQTransformationList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.XSLTTransformation, err error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.JQTransformation, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XSLTTransformation{}
+	out := &flowv1alpha1.JQTransformation{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.XSLTTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XSLTTransformation, error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.JQTransformation, opts v1.UpdateOptions) (*flowv1alpha1.JQTransformation, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "XSLTTransformation",
+		Kind:    "JQTransformation",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -888,18 +930,18 @@ func (w *wrapFlowV1alpha1XSLTTransformationImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XSLTTransformation{}
+	out := &flowv1alpha1.JQTransformation{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.XSLTTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XSLTTransformation, error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.JQTransformation, opts v1.UpdateOptions) (*flowv1alpha1.JQTransformation, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "XSLTTransformation",
+		Kind:    "JQTransformation",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -909,57 +951,42 @@ func (w *wrapFlowV1alpha1XSLTTransformationImpl) UpdateStatus(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &flowv1alpha1.XSLTTransformation{}
+	out := &flowv1alpha1.JQTransformation{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapFlowV1alpha1XSLTTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapFlowV1alpha1JQTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-// RoutingV1alpha1 retrieves the RoutingV1alpha1Client
-func (w *wrapClient) RoutingV1alpha1() typedroutingv1alpha1.RoutingV1alpha1Interface {
-	return &wrapRoutingV1alpha1{
-		dyn: w.dyn,
-	}
-}
-
-type wrapRoutingV1alpha1 struct {
-	dyn dynamic.Interface
-}
-
-func (w *wrapRoutingV1alpha1) RESTClient() rest.Interface {
-	panic("RESTClient called on dynamic client!")
-}
-
-func (w *wrapRoutingV1alpha1) Filters(namespace string) typedroutingv1alpha1.FilterInterface {
-	return &wrapRoutingV1alpha1FilterImpl{
+func (w *wrapFlowV1alpha1) SchemaValidators(namespace string) typedflowv1alpha1.SchemaValidatorInterface {
+	return &wrapFlowV1alpha1SchemaValidatorImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "routing.triggermesh.io",
+			Group:    "flow.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "filters",
+			Resource: "schemavalidators",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapRoutingV1alpha1FilterImpl struct {
+type wrapFlowV1alpha1SchemaValidatorImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedroutingv1alpha1.FilterInterface = (*wrapRoutingV1alpha1FilterImpl)(nil)
+var _ typedflowv1alpha1.SchemaValidatorInterface = (*wrapFlowV1alpha1SchemaValidatorImpl)(nil)
 
-func (w *wrapRoutingV1alpha1FilterImpl) Create(ctx context.Context, in *routingv1alpha1.Filter, opts v1.CreateOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) Create(ctx context.Context, in *flowv1alpha1.SchemaValidator, opts v1.CreateOptions) (*flowv1alpha1.SchemaValidator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "routing.triggermesh.io",
+		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Filter",
+		Kind:    "SchemaValidator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -969,62 +996,62 @@ func (w *wrapRoutingV1alpha1FilterImpl) Create(ctx context.Context, in *routingv
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &flowv1alpha1.SchemaValidator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.SchemaValidator, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &flowv1alpha1.SchemaValidator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.FilterList, error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.SchemaValidatorList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.FilterList{}
+	out := &flowv1alpha1.SchemaValidatorList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Filter, err error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.SchemaValidator, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &flowv1alpha1.SchemaValidator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Update(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) Update(ctx context.Context, in *flowv1alpha1.SchemaValidator, opts v1.UpdateOptions) (*flowv1alpha1.SchemaValidator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "routing.triggermesh.io",
+		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Filter",
+		Kind:    "SchemaValidator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1034,18 +1061,18 @@ func (w *wrapRoutingV1alpha1FilterImpl) Update(ctx context.Context, in *routingv
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &flowv1alpha1.SchemaValidator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.SchemaValidator, opts v1.UpdateOptions) (*flowv1alpha1.SchemaValidator, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "routing.triggermesh.io",
+		Group:   "flow.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "Filter",
+		Kind:    "SchemaValidator",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1055,42 +1082,11514 @@ func (w *wrapRoutingV1alpha1FilterImpl) UpdateStatus(ctx context.Context, in *ro
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Filter{}
+	out := &flowv1alpha1.SchemaValidator{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1FilterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapFlowV1alpha1SchemaValidatorImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1alpha1) Synchronizers(namespace string) typedflowv1alpha1.SynchronizerInterface {
+	return &wrapFlowV1alpha1SynchronizerImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "synchronizers",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1alpha1SynchronizerImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1alpha1.SynchronizerInterface = (*wrapFlowV1alpha1SynchronizerImpl)(nil)
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) Create(ctx context.Context, in *flowv1alpha1.Synchronizer, opts v1.CreateOptions) (*flowv1alpha1.Synchronizer, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Synchronizer",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.Synchronizer, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.SynchronizerList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.SynchronizerList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.Synchronizer, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) Update(ctx context.Context, in *flowv1alpha1.Synchronizer, opts v1.UpdateOptions) (*flowv1alpha1.Synchronizer, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Synchronizer",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.Synchronizer, opts v1.UpdateOptions) (*flowv1alpha1.Synchronizer, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Synchronizer",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1SynchronizerImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1alpha1) Transformations(namespace string) typedflowv1alpha1.TransformationInterface {
+	return &wrapFlowV1alpha1TransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "transformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1alpha1TransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1alpha1.TransformationInterface = (*wrapFlowV1alpha1TransformationImpl)(nil)
+
+func (w *wrapFlowV1alpha1TransformationImpl) Create(ctx context.Context, in *flowv1alpha1.Transformation, opts v1.CreateOptions) (*flowv1alpha1.Transformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Transformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.Transformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.TransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.TransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.Transformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) Update(ctx context.Context, in *flowv1alpha1.Transformation, opts v1.UpdateOptions) (*flowv1alpha1.Transformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Transformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.Transformation, opts v1.UpdateOptions) (*flowv1alpha1.Transformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Transformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1TransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1alpha1) XMLToJSONTransformations(namespace string) typedflowv1alpha1.XMLToJSONTransformationInterface {
+	return &wrapFlowV1alpha1XMLToJSONTransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "xmltojsontransformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1alpha1XMLToJSONTransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1alpha1.XMLToJSONTransformationInterface = (*wrapFlowV1alpha1XMLToJSONTransformationImpl)(nil)
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.XMLToJSONTransformation, opts v1.CreateOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "XMLToJSONTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.XMLToJSONTransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XMLToJSONTransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.XMLToJSONTransformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.XMLToJSONTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "XMLToJSONTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.XMLToJSONTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XMLToJSONTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "XMLToJSONTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XMLToJSONTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1alpha1) XSLTTransformations(namespace string) typedflowv1alpha1.XSLTTransformationInterface {
+	return &wrapFlowV1alpha1XSLTTransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "xslttransformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1alpha1XSLTTransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1alpha1.XSLTTransformationInterface = (*wrapFlowV1alpha1XSLTTransformationImpl)(nil)
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) Create(ctx context.Context, in *flowv1alpha1.XSLTTransformation, opts v1.CreateOptions) (*flowv1alpha1.XSLTTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "XSLTTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1alpha1.XSLTTransformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1alpha1.XSLTTransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XSLTTransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1alpha1.XSLTTransformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) Update(ctx context.Context, in *flowv1alpha1.XSLTTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XSLTTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "XSLTTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1alpha1.XSLTTransformation, opts v1.UpdateOptions) (*flowv1alpha1.XSLTTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "XSLTTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1alpha1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1alpha1XSLTTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+// FlowV1beta1 retrieves the FlowV1beta1Client
+func (w *wrapClient) FlowV1beta1() typedflowv1beta1.FlowV1beta1Interface {
+	return &wrapFlowV1beta1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapFlowV1beta1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapFlowV1beta1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapFlowV1beta1) Bridges(namespace string) typedflowv1beta1.BridgeInterface {
+	return &wrapFlowV1beta1BridgeImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "bridges",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1BridgeImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.BridgeInterface = (*wrapFlowV1beta1BridgeImpl)(nil)
+
+func (w *wrapFlowV1beta1BridgeImpl) Create(ctx context.Context, in *flowv1beta1.Bridge, opts v1.CreateOptions) (*flowv1beta1.Bridge, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Bridge",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Bridge{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.Bridge, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Bridge{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.BridgeList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.BridgeList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.Bridge, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Bridge{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) Update(ctx context.Context, in *flowv1beta1.Bridge, opts v1.UpdateOptions) (*flowv1beta1.Bridge, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Bridge",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Bridge{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.Bridge, opts v1.UpdateOptions) (*flowv1beta1.Bridge, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Bridge",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Bridge{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1BridgeImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) Deduplicators(namespace string) typedflowv1beta1.DeduplicatorInterface {
+	return &wrapFlowV1beta1DeduplicatorImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "deduplicators",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1DeduplicatorImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.DeduplicatorInterface = (*wrapFlowV1beta1DeduplicatorImpl)(nil)
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) Create(ctx context.Context, in *flowv1beta1.Deduplicator, opts v1.CreateOptions) (*flowv1beta1.Deduplicator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Deduplicator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.Deduplicator, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.DeduplicatorList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.DeduplicatorList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.Deduplicator, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) Update(ctx context.Context, in *flowv1beta1.Deduplicator, opts v1.UpdateOptions) (*flowv1beta1.Deduplicator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Deduplicator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.Deduplicator, opts v1.UpdateOptions) (*flowv1beta1.Deduplicator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Deduplicator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Deduplicator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1DeduplicatorImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) JQTransformations(namespace string) typedflowv1beta1.JQTransformationInterface {
+	return &wrapFlowV1beta1JQTransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "jqtransformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1JQTransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.JQTransformationInterface = (*wrapFlowV1beta1JQTransformationImpl)(nil)
+
+func (w *wrapFlowV1beta1JQTransformationImpl) Create(ctx context.Context, in *flowv1beta1.JQTransformation, opts v1.CreateOptions) (*flowv1beta1.JQTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "JQTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.JQTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.JQTransformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.JQTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.JQTransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.JQTransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.JQTransformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.JQTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) Update(ctx context.Context, in *flowv1beta1.JQTransformation, opts v1.UpdateOptions) (*flowv1beta1.JQTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "JQTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.JQTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.JQTransformation, opts v1.UpdateOptions) (*flowv1beta1.JQTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "JQTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.JQTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1JQTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) SchemaValidators(namespace string) typedflowv1beta1.SchemaValidatorInterface {
+	return &wrapFlowV1beta1SchemaValidatorImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "schemavalidators",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1SchemaValidatorImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.SchemaValidatorInterface = (*wrapFlowV1beta1SchemaValidatorImpl)(nil)
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) Create(ctx context.Context, in *flowv1beta1.SchemaValidator, opts v1.CreateOptions) (*flowv1beta1.SchemaValidator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SchemaValidator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SchemaValidator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.SchemaValidator, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SchemaValidator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.SchemaValidatorList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SchemaValidatorList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.SchemaValidator, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SchemaValidator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) Update(ctx context.Context, in *flowv1beta1.SchemaValidator, opts v1.UpdateOptions) (*flowv1beta1.SchemaValidator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SchemaValidator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SchemaValidator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.SchemaValidator, opts v1.UpdateOptions) (*flowv1beta1.SchemaValidator, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SchemaValidator",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SchemaValidator{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SchemaValidatorImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) Synchronizers(namespace string) typedflowv1beta1.SynchronizerInterface {
+	return &wrapFlowV1beta1SynchronizerImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "synchronizers",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1SynchronizerImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.SynchronizerInterface = (*wrapFlowV1beta1SynchronizerImpl)(nil)
+
+func (w *wrapFlowV1beta1SynchronizerImpl) Create(ctx context.Context, in *flowv1beta1.Synchronizer, opts v1.CreateOptions) (*flowv1beta1.Synchronizer, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Synchronizer",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.Synchronizer, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.SynchronizerList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.SynchronizerList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.Synchronizer, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) Update(ctx context.Context, in *flowv1beta1.Synchronizer, opts v1.UpdateOptions) (*flowv1beta1.Synchronizer, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Synchronizer",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.Synchronizer, opts v1.UpdateOptions) (*flowv1beta1.Synchronizer, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Synchronizer",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Synchronizer{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1SynchronizerImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) Transformations(namespace string) typedflowv1beta1.TransformationInterface {
+	return &wrapFlowV1beta1TransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "transformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1TransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.TransformationInterface = (*wrapFlowV1beta1TransformationImpl)(nil)
+
+func (w *wrapFlowV1beta1TransformationImpl) Create(ctx context.Context, in *flowv1beta1.Transformation, opts v1.CreateOptions) (*flowv1beta1.Transformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Transformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.Transformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.TransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.TransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.Transformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) Update(ctx context.Context, in *flowv1beta1.Transformation, opts v1.UpdateOptions) (*flowv1beta1.Transformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Transformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.Transformation, opts v1.UpdateOptions) (*flowv1beta1.Transformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Transformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.Transformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1TransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) XMLToJSONTransformations(namespace string) typedflowv1beta1.XMLToJSONTransformationInterface {
+	return &wrapFlowV1beta1XMLToJSONTransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "xmltojsontransformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1XMLToJSONTransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.XMLToJSONTransformationInterface = (*wrapFlowV1beta1XMLToJSONTransformationImpl)(nil)
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) Create(ctx context.Context, in *flowv1beta1.XMLToJSONTransformation, opts v1.CreateOptions) (*flowv1beta1.XMLToJSONTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "XMLToJSONTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.XMLToJSONTransformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.XMLToJSONTransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XMLToJSONTransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.XMLToJSONTransformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) Update(ctx context.Context, in *flowv1beta1.XMLToJSONTransformation, opts v1.UpdateOptions) (*flowv1beta1.XMLToJSONTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "XMLToJSONTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.XMLToJSONTransformation, opts v1.UpdateOptions) (*flowv1beta1.XMLToJSONTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "XMLToJSONTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XMLToJSONTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XMLToJSONTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapFlowV1beta1) XSLTTransformations(namespace string) typedflowv1beta1.XSLTTransformationInterface {
+	return &wrapFlowV1beta1XSLTTransformationImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "flow.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "xslttransformations",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapFlowV1beta1XSLTTransformationImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedflowv1beta1.XSLTTransformationInterface = (*wrapFlowV1beta1XSLTTransformationImpl)(nil)
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) Create(ctx context.Context, in *flowv1beta1.XSLTTransformation, opts v1.CreateOptions) (*flowv1beta1.XSLTTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "XSLTTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*flowv1beta1.XSLTTransformation, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) List(ctx context.Context, opts v1.ListOptions) (*flowv1beta1.XSLTTransformationList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XSLTTransformationList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *flowv1beta1.XSLTTransformation, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) Update(ctx context.Context, in *flowv1beta1.XSLTTransformation, opts v1.UpdateOptions) (*flowv1beta1.XSLTTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "XSLTTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) UpdateStatus(ctx context.Context, in *flowv1beta1.XSLTTransformation, opts v1.UpdateOptions) (*flowv1beta1.XSLTTransformation, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "flow.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "XSLTTransformation",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &flowv1beta1.XSLTTransformation{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapFlowV1beta1XSLTTransformationImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+// RoutingV1alpha1 retrieves the RoutingV1alpha1Client
+func (w *wrapClient) RoutingV1alpha1() typedroutingv1alpha1.RoutingV1alpha1Interface {
+	return &wrapRoutingV1alpha1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapRoutingV1alpha1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapRoutingV1alpha1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapRoutingV1alpha1) Filters(namespace string) typedroutingv1alpha1.FilterInterface {
+	return &wrapRoutingV1alpha1FilterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "filters",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1FilterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.FilterInterface = (*wrapRoutingV1alpha1FilterImpl)(nil)
+
+func (w *wrapRoutingV1alpha1FilterImpl) Create(ctx context.Context, in *routingv1alpha1.Filter, opts v1.CreateOptions) (*routingv1alpha1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Filter, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.FilterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.FilterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Filter, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Update(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Filter, opts v1.UpdateOptions) (*routingv1alpha1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1FilterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapRoutingV1alpha1) Splitters(namespace string) typedroutingv1alpha1.SplitterInterface {
+	return &wrapRoutingV1alpha1SplitterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "splitters",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1alpha1SplitterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1alpha1.SplitterInterface = (*wrapRoutingV1alpha1SplitterImpl)(nil)
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Create(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.CreateOptions) (*routingv1alpha1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Splitter, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.SplitterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.SplitterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Splitter, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Update(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.UpdateOptions) (*routingv1alpha1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.UpdateOptions) (*routingv1alpha1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1alpha1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1alpha1SplitterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+// RoutingV1beta1 retrieves the RoutingV1beta1Client
+func (w *wrapClient) RoutingV1beta1() typedroutingv1beta1.RoutingV1beta1Interface {
+	return &wrapRoutingV1beta1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapRoutingV1beta1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapRoutingV1beta1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapRoutingV1beta1) Filters(namespace string) typedroutingv1beta1.FilterInterface {
+	return &wrapRoutingV1beta1FilterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "filters",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1beta1FilterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1beta1.FilterInterface = (*wrapRoutingV1beta1FilterImpl)(nil)
+
+func (w *wrapRoutingV1beta1FilterImpl) Create(ctx context.Context, in *routingv1beta1.Filter, opts v1.CreateOptions) (*routingv1beta1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1beta1.Filter, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1beta1.FilterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.FilterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1beta1.Filter, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) Update(ctx context.Context, in *routingv1beta1.Filter, opts v1.UpdateOptions) (*routingv1beta1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) UpdateStatus(ctx context.Context, in *routingv1beta1.Filter, opts v1.UpdateOptions) (*routingv1beta1.Filter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Filter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Filter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1FilterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapRoutingV1beta1) Splitters(namespace string) typedroutingv1beta1.SplitterInterface {
+	return &wrapRoutingV1beta1SplitterImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "routing.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "splitters",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapRoutingV1beta1SplitterImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedroutingv1beta1.SplitterInterface = (*wrapRoutingV1beta1SplitterImpl)(nil)
+
+func (w *wrapRoutingV1beta1SplitterImpl) Create(ctx context.Context, in *routingv1beta1.Splitter, opts v1.CreateOptions) (*routingv1beta1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1beta1.Splitter, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1beta1.SplitterList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.SplitterList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1beta1.Splitter, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) Update(ctx context.Context, in *routingv1beta1.Splitter, opts v1.UpdateOptions) (*routingv1beta1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) UpdateStatus(ctx context.Context, in *routingv1beta1.Splitter, opts v1.UpdateOptions) (*routingv1beta1.Splitter, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "routing.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "Splitter",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &routingv1beta1.Splitter{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapRoutingV1beta1SplitterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+// SourcesV1alpha1 retrieves the SourcesV1alpha1Client
+func (w *wrapClient) SourcesV1alpha1() typedsourcesv1alpha1.SourcesV1alpha1Interface {
+	return &wrapSourcesV1alpha1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapSourcesV1alpha1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapSourcesV1alpha1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapSourcesV1alpha1) AWSCloudWatchLogsSources(namespace string) typedsourcesv1alpha1.AWSCloudWatchLogsSourceInterface {
+	return &wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awscloudwatchlogssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSCloudWatchLogsSourceInterface = (*wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchLogsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCloudWatchLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchLogsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCloudWatchLogsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCloudWatchLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCloudWatchLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSCloudWatchSources(namespace string) typedsourcesv1alpha1.AWSCloudWatchSourceInterface {
+	return &wrapSourcesV1alpha1AWSCloudWatchSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awscloudwatchsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSCloudWatchSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSCloudWatchSourceInterface = (*wrapSourcesV1alpha1AWSCloudWatchSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCloudWatchSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCloudWatchSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCloudWatchSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCloudWatchSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCloudWatchSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSCodeCommitSources(namespace string) typedsourcesv1alpha1.AWSCodeCommitSourceInterface {
+	return &wrapSourcesV1alpha1AWSCodeCommitSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awscodecommitsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSCodeCommitSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSCodeCommitSourceInterface = (*wrapSourcesV1alpha1AWSCodeCommitSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCodeCommitSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCodeCommitSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCodeCommitSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCodeCommitSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCodeCommitSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCodeCommitSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCodeCommitSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCodeCommitSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCodeCommitSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSCognitoIdentitySources(namespace string) typedsourcesv1alpha1.AWSCognitoIdentitySourceInterface {
+	return &wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awscognitoidentitysources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSCognitoIdentitySourceInterface = (*wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCognitoIdentitySource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCognitoIdentitySource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCognitoIdentitySourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoIdentitySourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCognitoIdentitySource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCognitoIdentitySource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCognitoIdentitySource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSCognitoUserPoolSources(namespace string) typedsourcesv1alpha1.AWSCognitoUserPoolSourceInterface {
+	return &wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awscognitouserpoolsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSCognitoUserPoolSourceInterface = (*wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCognitoUserPoolSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCognitoUserPoolSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoUserPoolSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCognitoUserPoolSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCognitoUserPoolSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCognitoUserPoolSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCognitoUserPoolSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSCognitoUserPoolSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSDynamoDBSources(namespace string) typedsourcesv1alpha1.AWSDynamoDBSourceInterface {
+	return &wrapSourcesV1alpha1AWSDynamoDBSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awsdynamodbsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSDynamoDBSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSDynamoDBSourceInterface = (*wrapSourcesV1alpha1AWSDynamoDBSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSDynamoDBSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSDynamoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSDynamoDBSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSDynamoDBSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSDynamoDBSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSDynamoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSDynamoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSDynamoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSDynamoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSEventBridgeSources(namespace string) typedsourcesv1alpha1.AWSEventBridgeSourceInterface {
+	return &wrapSourcesV1alpha1AWSEventBridgeSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awseventbridgesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSEventBridgeSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSEventBridgeSourceInterface = (*wrapSourcesV1alpha1AWSEventBridgeSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSEventBridgeSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSEventBridgeSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSEventBridgeSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSEventBridgeSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSEventBridgeSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSEventBridgeSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSEventBridgeSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSEventBridgeSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSEventBridgeSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSKinesisSources(namespace string) typedsourcesv1alpha1.AWSKinesisSourceInterface {
+	return &wrapSourcesV1alpha1AWSKinesisSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awskinesissources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSKinesisSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSKinesisSourceInterface = (*wrapSourcesV1alpha1AWSKinesisSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSKinesisSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSKinesisSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSKinesisSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSKinesisSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSKinesisSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSKinesisSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSKinesisSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSKinesisSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSKinesisSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSPerformanceInsightsSources(namespace string) typedsourcesv1alpha1.AWSPerformanceInsightsSourceInterface {
+	return &wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awsperformanceinsightssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSPerformanceInsightsSourceInterface = (*wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSPerformanceInsightsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSPerformanceInsightsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSPerformanceInsightsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSPerformanceInsightsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSPerformanceInsightsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSPerformanceInsightsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSPerformanceInsightsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSPerformanceInsightsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSS3Sources(namespace string) typedsourcesv1alpha1.AWSS3SourceInterface {
+	return &wrapSourcesV1alpha1AWSS3SourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awss3sources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSS3SourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSS3SourceInterface = (*wrapSourcesV1alpha1AWSS3SourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSS3Source, opts v1.CreateOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSS3Source",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSS3SourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSS3SourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSS3Source, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSS3Source, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSS3Source",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSS3Source, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSS3Source",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSSNSSources(namespace string) typedsourcesv1alpha1.AWSSNSSourceInterface {
+	return &wrapSourcesV1alpha1AWSSNSSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awssnssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSSNSSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSSNSSourceInterface = (*wrapSourcesV1alpha1AWSSNSSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSSNSSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSSNSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSSNSSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSNSSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSSNSSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSSNSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSSNSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSSNSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSSNSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AWSSQSSources(namespace string) typedsourcesv1alpha1.AWSSQSSourceInterface {
+	return &wrapSourcesV1alpha1AWSSQSSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "awssqssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AWSSQSSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AWSSQSSourceInterface = (*wrapSourcesV1alpha1AWSSQSSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSSQSSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSSQSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSSQSSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSQSSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSSQSSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSSQSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSSQSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSSQSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AWSSQSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureActivityLogsSources(namespace string) typedsourcesv1alpha1.AzureActivityLogsSourceInterface {
+	return &wrapSourcesV1alpha1AzureActivityLogsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureactivitylogssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureActivityLogsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureActivityLogsSourceInterface = (*wrapSourcesV1alpha1AzureActivityLogsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureActivityLogsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureActivityLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureActivityLogsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureActivityLogsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureActivityLogsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureActivityLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureActivityLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureActivityLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureActivityLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureBlobStorageSources(namespace string) typedsourcesv1alpha1.AzureBlobStorageSourceInterface {
+	return &wrapSourcesV1alpha1AzureBlobStorageSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureblobstoragesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureBlobStorageSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureBlobStorageSourceInterface = (*wrapSourcesV1alpha1AzureBlobStorageSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureBlobStorageSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureBlobStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureBlobStorageSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureBlobStorageSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureBlobStorageSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureBlobStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureBlobStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureBlobStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureBlobStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureEventGridSources(namespace string) typedsourcesv1alpha1.AzureEventGridSourceInterface {
+	return &wrapSourcesV1alpha1AzureEventGridSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureeventgridsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureEventGridSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureEventGridSourceInterface = (*wrapSourcesV1alpha1AzureEventGridSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureEventGridSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureEventGridSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureEventGridSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventGridSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureEventGridSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureEventGridSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureEventGridSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureEventGridSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureEventGridSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureEventHubsSources(namespace string) typedsourcesv1alpha1.AzureEventHubsSourceInterface {
+	return &wrapSourcesV1alpha1AzureEventHubsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureeventhubssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureEventHubsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureEventHubsSourceInterface = (*wrapSourcesV1alpha1AzureEventHubsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureEventHubsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureEventHubsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureEventHubsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventHubsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureEventHubsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureEventHubsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureEventHubsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureEventHubsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureEventHubsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureIOTHubSources(namespace string) typedsourcesv1alpha1.AzureIOTHubSourceInterface {
+	return &wrapSourcesV1alpha1AzureIOTHubSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureiothubsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureIOTHubSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureIOTHubSourceInterface = (*wrapSourcesV1alpha1AzureIOTHubSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureIOTHubSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureIOTHubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureIOTHubSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureIOTHubSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureIOTHubSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureIOTHubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureIOTHubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureIOTHubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureIOTHubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureQueueStorageSources(namespace string) typedsourcesv1alpha1.AzureQueueStorageSourceInterface {
+	return &wrapSourcesV1alpha1AzureQueueStorageSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azurequeuestoragesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureQueueStorageSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureQueueStorageSourceInterface = (*wrapSourcesV1alpha1AzureQueueStorageSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureQueueStorageSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureQueueStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureQueueStorageSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureQueueStorageSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureQueueStorageSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureQueueStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureQueueStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureQueueStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureQueueStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureServiceBusQueueSources(namespace string) typedsourcesv1alpha1.AzureServiceBusQueueSourceInterface {
+	return &wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureservicebusqueuesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureServiceBusQueueSourceInterface = (*wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusQueueSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusQueueSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureServiceBusQueueSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusQueueSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureServiceBusQueueSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusQueueSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusQueueSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureServiceBusSources(namespace string) typedsourcesv1alpha1.AzureServiceBusSourceInterface {
+	return &wrapSourcesV1alpha1AzureServiceBusSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureservicebussources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureServiceBusSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureServiceBusSourceInterface = (*wrapSourcesV1alpha1AzureServiceBusSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureServiceBusSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureServiceBusSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) AzureServiceBusTopicSources(namespace string) typedsourcesv1alpha1.AzureServiceBusTopicSourceInterface {
+	return &wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "azureservicebustopicsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.AzureServiceBusTopicSourceInterface = (*wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusTopicSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusTopicSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureServiceBusTopicSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusTopicSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureServiceBusTopicSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusTopicSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "AzureServiceBusTopicSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) CloudEventsSources(namespace string) typedsourcesv1alpha1.CloudEventsSourceInterface {
+	return &wrapSourcesV1alpha1CloudEventsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "cloudeventssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1CloudEventsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.CloudEventsSourceInterface = (*wrapSourcesV1alpha1CloudEventsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.CloudEventsSource, opts v1.CreateOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "CloudEventsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.CloudEventsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.CloudEventsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.CloudEventsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.CloudEventsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "CloudEventsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.CloudEventsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "CloudEventsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) GoogleCloudAuditLogsSources(namespace string) typedsourcesv1alpha1.GoogleCloudAuditLogsSourceInterface {
+	return &wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "googlecloudauditlogssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.GoogleCloudAuditLogsSourceInterface = (*wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudAuditLogsSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudAuditLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudAuditLogsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudAuditLogsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudAuditLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudAuditLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) GoogleCloudBillingSources(namespace string) typedsourcesv1alpha1.GoogleCloudBillingSourceInterface {
+	return &wrapSourcesV1alpha1GoogleCloudBillingSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "googlecloudbillingsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1GoogleCloudBillingSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.GoogleCloudBillingSourceInterface = (*wrapSourcesV1alpha1GoogleCloudBillingSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudBillingSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudBillingSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudBillingSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudBillingSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudBillingSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudBillingSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudBillingSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudBillingSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudBillingSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) GoogleCloudPubSubSources(namespace string) typedsourcesv1alpha1.GoogleCloudPubSubSourceInterface {
+	return &wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "googlecloudpubsubsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.GoogleCloudPubSubSourceInterface = (*wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudPubSubSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudPubSubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudPubSubSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudPubSubSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudPubSubSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudPubSubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudPubSubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) GoogleCloudSourceRepositoriesSources(namespace string) typedsourcesv1alpha1.GoogleCloudSourceRepositoriesSourceInterface {
+	return &wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "googlecloudsourcerepositoriessources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.GoogleCloudSourceRepositoriesSourceInterface = (*wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudSourceRepositoriesSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudSourceRepositoriesSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudSourceRepositoriesSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) GoogleCloudStorageSources(namespace string) typedsourcesv1alpha1.GoogleCloudStorageSourceInterface {
+	return &wrapSourcesV1alpha1GoogleCloudStorageSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "googlecloudstoragesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1GoogleCloudStorageSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.GoogleCloudStorageSourceInterface = (*wrapSourcesV1alpha1GoogleCloudStorageSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudStorageSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudStorageSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudStorageSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudStorageSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "GoogleCloudStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) HTTPPollerSources(namespace string) typedsourcesv1alpha1.HTTPPollerSourceInterface {
+	return &wrapSourcesV1alpha1HTTPPollerSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "httppollersources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1HTTPPollerSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.HTTPPollerSourceInterface = (*wrapSourcesV1alpha1HTTPPollerSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.HTTPPollerSource, opts v1.CreateOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "HTTPPollerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.HTTPPollerSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.HTTPPollerSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.HTTPPollerSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.HTTPPollerSource, opts v1.UpdateOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "HTTPPollerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.HTTPPollerSource, opts v1.UpdateOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "HTTPPollerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) IBMMQSources(namespace string) typedsourcesv1alpha1.IBMMQSourceInterface {
+	return &wrapSourcesV1alpha1IBMMQSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "ibmmqsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1IBMMQSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.IBMMQSourceInterface = (*wrapSourcesV1alpha1IBMMQSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.IBMMQSource, opts v1.CreateOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "IBMMQSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.IBMMQSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.IBMMQSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.IBMMQSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.IBMMQSource, opts v1.UpdateOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "IBMMQSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.IBMMQSource, opts v1.UpdateOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "IBMMQSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) KafkaSources(namespace string) typedsourcesv1alpha1.KafkaSourceInterface {
+	return &wrapSourcesV1alpha1KafkaSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "kafkasources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1KafkaSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.KafkaSourceInterface = (*wrapSourcesV1alpha1KafkaSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.KafkaSource, opts v1.CreateOptions) (*sourcesv1alpha1.KafkaSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "KafkaSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.KafkaSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.KafkaSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.KafkaSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.KafkaSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.KafkaSource, opts v1.UpdateOptions) (*sourcesv1alpha1.KafkaSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "KafkaSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.KafkaSource, opts v1.UpdateOptions) (*sourcesv1alpha1.KafkaSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "KafkaSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1KafkaSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) MongoDBSources(namespace string) typedsourcesv1alpha1.MongoDBSourceInterface {
+	return &wrapSourcesV1alpha1MongoDBSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "mongodbsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1MongoDBSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.MongoDBSourceInterface = (*wrapSourcesV1alpha1MongoDBSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.MongoDBSource, opts v1.CreateOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "MongoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.MongoDBSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.MongoDBSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.MongoDBSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.MongoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "MongoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.MongoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "MongoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) OCIMetricsSources(namespace string) typedsourcesv1alpha1.OCIMetricsSourceInterface {
+	return &wrapSourcesV1alpha1OCIMetricsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "ocimetricssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1OCIMetricsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.OCIMetricsSourceInterface = (*wrapSourcesV1alpha1OCIMetricsSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.OCIMetricsSource, opts v1.CreateOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "OCIMetricsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.OCIMetricsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.OCIMetricsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.OCIMetricsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.OCIMetricsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "OCIMetricsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.OCIMetricsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "OCIMetricsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) SalesforceSources(namespace string) typedsourcesv1alpha1.SalesforceSourceInterface {
+	return &wrapSourcesV1alpha1SalesforceSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "salesforcesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1SalesforceSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.SalesforceSourceInterface = (*wrapSourcesV1alpha1SalesforceSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.SalesforceSource, opts v1.CreateOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SalesforceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.SalesforceSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SalesforceSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.SalesforceSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.SalesforceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SalesforceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.SalesforceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SalesforceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) SlackSources(namespace string) typedsourcesv1alpha1.SlackSourceInterface {
+	return &wrapSourcesV1alpha1SlackSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "slacksources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1SlackSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.SlackSourceInterface = (*wrapSourcesV1alpha1SlackSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.SlackSource, opts v1.CreateOptions) (*sourcesv1alpha1.SlackSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SlackSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SlackSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.SlackSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SlackSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.SlackSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SlackSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.SlackSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SlackSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.SlackSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SlackSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SlackSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SlackSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.SlackSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SlackSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SlackSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SlackSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SlackSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) SolaceSources(namespace string) typedsourcesv1alpha1.SolaceSourceInterface {
+	return &wrapSourcesV1alpha1SolaceSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "solacesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1SolaceSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.SolaceSourceInterface = (*wrapSourcesV1alpha1SolaceSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.SolaceSource, opts v1.CreateOptions) (*sourcesv1alpha1.SolaceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SolaceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SolaceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.SolaceSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SolaceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.SolaceSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SolaceSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.SolaceSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SolaceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.SolaceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SolaceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SolaceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SolaceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.SolaceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SolaceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "SolaceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.SolaceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1SolaceSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) TwilioSources(namespace string) typedsourcesv1alpha1.TwilioSourceInterface {
+	return &wrapSourcesV1alpha1TwilioSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "twiliosources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1TwilioSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.TwilioSourceInterface = (*wrapSourcesV1alpha1TwilioSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.TwilioSource, opts v1.CreateOptions) (*sourcesv1alpha1.TwilioSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "TwilioSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.TwilioSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.TwilioSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.TwilioSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.TwilioSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.TwilioSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.TwilioSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.TwilioSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.TwilioSource, opts v1.UpdateOptions) (*sourcesv1alpha1.TwilioSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "TwilioSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.TwilioSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.TwilioSource, opts v1.UpdateOptions) (*sourcesv1alpha1.TwilioSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "TwilioSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.TwilioSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1TwilioSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) WebhookSources(namespace string) typedsourcesv1alpha1.WebhookSourceInterface {
+	return &wrapSourcesV1alpha1WebhookSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "webhooksources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1WebhookSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.WebhookSourceInterface = (*wrapSourcesV1alpha1WebhookSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.WebhookSource, opts v1.CreateOptions) (*sourcesv1alpha1.WebhookSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "WebhookSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.WebhookSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.WebhookSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.WebhookSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.WebhookSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.WebhookSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.WebhookSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.WebhookSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.WebhookSource, opts v1.UpdateOptions) (*sourcesv1alpha1.WebhookSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "WebhookSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.WebhookSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.WebhookSource, opts v1.UpdateOptions) (*sourcesv1alpha1.WebhookSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "WebhookSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.WebhookSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1WebhookSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1alpha1) ZendeskSources(namespace string) typedsourcesv1alpha1.ZendeskSourceInterface {
+	return &wrapSourcesV1alpha1ZendeskSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1alpha1",
+			Resource: "zendesksources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1alpha1ZendeskSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1alpha1.ZendeskSourceInterface = (*wrapSourcesV1alpha1ZendeskSourceImpl)(nil)
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.ZendeskSource, opts v1.CreateOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "ZendeskSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.ZendeskSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.ZendeskSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.ZendeskSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.ZendeskSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.ZendeskSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.ZendeskSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.ZendeskSource, opts v1.UpdateOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "ZendeskSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.ZendeskSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.ZendeskSource, opts v1.UpdateOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1alpha1",
+		Kind:    "ZendeskSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1alpha1.ZendeskSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+// SourcesV1beta1 retrieves the SourcesV1beta1Client
+func (w *wrapClient) SourcesV1beta1() typedsourcesv1beta1.SourcesV1beta1Interface {
+	return &wrapSourcesV1beta1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapSourcesV1beta1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapSourcesV1beta1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapSourcesV1beta1) AWSCloudWatchLogsSources(namespace string) typedsourcesv1beta1.AWSCloudWatchLogsSourceInterface {
+	return &wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awscloudwatchlogssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSCloudWatchLogsSourceInterface = (*wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSCloudWatchLogsSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSCloudWatchLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCloudWatchLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSCloudWatchLogsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSCloudWatchLogsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchLogsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSCloudWatchLogsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSCloudWatchLogsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCloudWatchLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCloudWatchLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSCloudWatchLogsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCloudWatchLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCloudWatchLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSCloudWatchSources(namespace string) typedsourcesv1beta1.AWSCloudWatchSourceInterface {
+	return &wrapSourcesV1beta1AWSCloudWatchSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awscloudwatchsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSCloudWatchSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSCloudWatchSourceInterface = (*wrapSourcesV1beta1AWSCloudWatchSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSCloudWatchSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSCloudWatchSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCloudWatchSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSCloudWatchSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSCloudWatchSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSCloudWatchSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSCloudWatchSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCloudWatchSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCloudWatchSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSCloudWatchSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCloudWatchSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCloudWatchSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCloudWatchSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCloudWatchSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSCodeCommitSources(namespace string) typedsourcesv1beta1.AWSCodeCommitSourceInterface {
+	return &wrapSourcesV1beta1AWSCodeCommitSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awscodecommitsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSCodeCommitSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSCodeCommitSourceInterface = (*wrapSourcesV1beta1AWSCodeCommitSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSCodeCommitSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSCodeCommitSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCodeCommitSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSCodeCommitSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSCodeCommitSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCodeCommitSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSCodeCommitSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSCodeCommitSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCodeCommitSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCodeCommitSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSCodeCommitSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCodeCommitSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCodeCommitSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCodeCommitSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCodeCommitSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSCognitoIdentitySources(namespace string) typedsourcesv1beta1.AWSCognitoIdentitySourceInterface {
+	return &wrapSourcesV1beta1AWSCognitoIdentitySourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awscognitoidentitysources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSCognitoIdentitySourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSCognitoIdentitySourceInterface = (*wrapSourcesV1beta1AWSCognitoIdentitySourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSCognitoIdentitySource, opts v1.CreateOptions) (*sourcesv1beta1.AWSCognitoIdentitySource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCognitoIdentitySource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSCognitoIdentitySource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSCognitoIdentitySourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoIdentitySourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSCognitoIdentitySource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCognitoIdentitySource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCognitoIdentitySource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCognitoIdentitySource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCognitoIdentitySource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoIdentitySource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoIdentitySourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSCognitoUserPoolSources(namespace string) typedsourcesv1beta1.AWSCognitoUserPoolSourceInterface {
+	return &wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awscognitouserpoolsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSCognitoUserPoolSourceInterface = (*wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSCognitoUserPoolSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSCognitoUserPoolSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCognitoUserPoolSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSCognitoUserPoolSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSCognitoUserPoolSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoUserPoolSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSCognitoUserPoolSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSCognitoUserPoolSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCognitoUserPoolSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCognitoUserPoolSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSCognitoUserPoolSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSCognitoUserPoolSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSCognitoUserPoolSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSCognitoUserPoolSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSCognitoUserPoolSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSDynamoDBSources(namespace string) typedsourcesv1beta1.AWSDynamoDBSourceInterface {
+	return &wrapSourcesV1beta1AWSDynamoDBSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awsdynamodbsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSDynamoDBSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSDynamoDBSourceInterface = (*wrapSourcesV1beta1AWSDynamoDBSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSDynamoDBSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSDynamoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSDynamoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSDynamoDBSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSDynamoDBSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSDynamoDBSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSDynamoDBSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSDynamoDBSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSDynamoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSDynamoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSDynamoDBSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSDynamoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSDynamoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSDynamoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSDynamoDBSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSEventBridgeSources(namespace string) typedsourcesv1beta1.AWSEventBridgeSourceInterface {
+	return &wrapSourcesV1beta1AWSEventBridgeSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awseventbridgesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSEventBridgeSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSEventBridgeSourceInterface = (*wrapSourcesV1beta1AWSEventBridgeSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSEventBridgeSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSEventBridgeSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSEventBridgeSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSEventBridgeSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSEventBridgeSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSEventBridgeSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSEventBridgeSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSEventBridgeSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSEventBridgeSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSEventBridgeSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSEventBridgeSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSEventBridgeSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSEventBridgeSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSEventBridgeSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSEventBridgeSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSKinesisSources(namespace string) typedsourcesv1beta1.AWSKinesisSourceInterface {
+	return &wrapSourcesV1beta1AWSKinesisSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awskinesissources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSKinesisSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSKinesisSourceInterface = (*wrapSourcesV1beta1AWSKinesisSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSKinesisSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSKinesisSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSKinesisSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSKinesisSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSKinesisSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSKinesisSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSKinesisSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSKinesisSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSKinesisSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSKinesisSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSKinesisSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSKinesisSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSKinesisSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSKinesisSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSKinesisSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSPerformanceInsightsSources(namespace string) typedsourcesv1beta1.AWSPerformanceInsightsSourceInterface {
+	return &wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awsperformanceinsightssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSPerformanceInsightsSourceInterface = (*wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSPerformanceInsightsSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSPerformanceInsightsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSPerformanceInsightsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSPerformanceInsightsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSPerformanceInsightsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSPerformanceInsightsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSPerformanceInsightsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSPerformanceInsightsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSPerformanceInsightsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSPerformanceInsightsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSPerformanceInsightsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSPerformanceInsightsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSPerformanceInsightsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSPerformanceInsightsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSPerformanceInsightsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSS3Sources(namespace string) typedsourcesv1beta1.AWSS3SourceInterface {
+	return &wrapSourcesV1beta1AWSS3SourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awss3sources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSS3SourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSS3SourceInterface = (*wrapSourcesV1beta1AWSS3SourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSS3Source, opts v1.CreateOptions) (*sourcesv1beta1.AWSS3Source, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSS3Source",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSS3Source, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSS3SourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSS3SourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSS3Source, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSS3Source, opts v1.UpdateOptions) (*sourcesv1beta1.AWSS3Source, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSS3Source",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSS3Source, opts v1.UpdateOptions) (*sourcesv1beta1.AWSS3Source, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSS3Source",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSS3Source{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSS3SourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSSNSSources(namespace string) typedsourcesv1beta1.AWSSNSSourceInterface {
+	return &wrapSourcesV1beta1AWSSNSSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awssnssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSSNSSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSSNSSourceInterface = (*wrapSourcesV1beta1AWSSNSSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSSNSSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSSNSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSSNSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSSNSSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSSNSSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSNSSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSSNSSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSSNSSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSSNSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSSNSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSSNSSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSSNSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSSNSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSNSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSNSSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AWSSQSSources(namespace string) typedsourcesv1beta1.AWSSQSSourceInterface {
+	return &wrapSourcesV1beta1AWSSQSSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "awssqssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AWSSQSSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AWSSQSSourceInterface = (*wrapSourcesV1beta1AWSSQSSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AWSSQSSource, opts v1.CreateOptions) (*sourcesv1beta1.AWSSQSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSSQSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AWSSQSSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AWSSQSSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSQSSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AWSSQSSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AWSSQSSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSSQSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSSQSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AWSSQSSource, opts v1.UpdateOptions) (*sourcesv1beta1.AWSSQSSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AWSSQSSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AWSSQSSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AWSSQSSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureActivityLogsSources(namespace string) typedsourcesv1beta1.AzureActivityLogsSourceInterface {
+	return &wrapSourcesV1beta1AzureActivityLogsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureactivitylogssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureActivityLogsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureActivityLogsSourceInterface = (*wrapSourcesV1beta1AzureActivityLogsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureActivityLogsSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureActivityLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureActivityLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureActivityLogsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureActivityLogsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureActivityLogsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureActivityLogsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureActivityLogsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureActivityLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureActivityLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureActivityLogsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureActivityLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureActivityLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureActivityLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureActivityLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureBlobStorageSources(namespace string) typedsourcesv1beta1.AzureBlobStorageSourceInterface {
+	return &wrapSourcesV1beta1AzureBlobStorageSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureblobstoragesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureBlobStorageSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureBlobStorageSourceInterface = (*wrapSourcesV1beta1AzureBlobStorageSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureBlobStorageSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureBlobStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureBlobStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureBlobStorageSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureBlobStorageSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureBlobStorageSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureBlobStorageSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureBlobStorageSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureBlobStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureBlobStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureBlobStorageSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureBlobStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureBlobStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureBlobStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureBlobStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureEventGridSources(namespace string) typedsourcesv1beta1.AzureEventGridSourceInterface {
+	return &wrapSourcesV1beta1AzureEventGridSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureeventgridsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureEventGridSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureEventGridSourceInterface = (*wrapSourcesV1beta1AzureEventGridSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureEventGridSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureEventGridSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureEventGridSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureEventGridSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureEventGridSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventGridSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureEventGridSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureEventGridSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureEventGridSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureEventGridSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureEventGridSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureEventGridSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureEventGridSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventGridSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventGridSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureEventHubsSources(namespace string) typedsourcesv1beta1.AzureEventHubsSourceInterface {
+	return &wrapSourcesV1beta1AzureEventHubsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureeventhubssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureEventHubsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureEventHubsSourceInterface = (*wrapSourcesV1beta1AzureEventHubsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureEventHubsSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureEventHubsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureEventHubsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureEventHubsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureEventHubsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventHubsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureEventHubsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureEventHubsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureEventHubsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureEventHubsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureEventHubsSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureEventHubsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureEventHubsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureEventHubsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureEventHubsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureIOTHubSources(namespace string) typedsourcesv1beta1.AzureIOTHubSourceInterface {
+	return &wrapSourcesV1beta1AzureIOTHubSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureiothubsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureIOTHubSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureIOTHubSourceInterface = (*wrapSourcesV1beta1AzureIOTHubSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureIOTHubSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureIOTHubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureIOTHubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureIOTHubSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureIOTHubSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureIOTHubSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureIOTHubSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureIOTHubSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureIOTHubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureIOTHubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureIOTHubSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureIOTHubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureIOTHubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureIOTHubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureIOTHubSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureQueueStorageSources(namespace string) typedsourcesv1beta1.AzureQueueStorageSourceInterface {
+	return &wrapSourcesV1beta1AzureQueueStorageSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azurequeuestoragesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureQueueStorageSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureQueueStorageSourceInterface = (*wrapSourcesV1beta1AzureQueueStorageSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureQueueStorageSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureQueueStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureQueueStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureQueueStorageSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureQueueStorageSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureQueueStorageSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureQueueStorageSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureQueueStorageSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureQueueStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureQueueStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureQueueStorageSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureQueueStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureQueueStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureQueueStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureQueueStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureServiceBusQueueSources(namespace string) typedsourcesv1beta1.AzureServiceBusQueueSourceInterface {
+	return &wrapSourcesV1beta1AzureServiceBusQueueSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureservicebusqueuesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureServiceBusQueueSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureServiceBusQueueSourceInterface = (*wrapSourcesV1beta1AzureServiceBusQueueSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureServiceBusQueueSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureServiceBusQueueSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusQueueSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureServiceBusQueueSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureServiceBusQueueSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusQueueSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureServiceBusQueueSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureServiceBusQueueSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusQueueSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureServiceBusQueueSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusQueueSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusQueueSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusQueueSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureServiceBusSources(namespace string) typedsourcesv1beta1.AzureServiceBusSourceInterface {
+	return &wrapSourcesV1beta1AzureServiceBusSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureservicebussources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureServiceBusSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureServiceBusSourceInterface = (*wrapSourcesV1beta1AzureServiceBusSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureServiceBusSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureServiceBusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureServiceBusSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureServiceBusSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureServiceBusSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureServiceBusSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureServiceBusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureServiceBusSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureServiceBusSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) AzureServiceBusTopicSources(namespace string) typedsourcesv1beta1.AzureServiceBusTopicSourceInterface {
+	return &wrapSourcesV1beta1AzureServiceBusTopicSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "azureservicebustopicsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1AzureServiceBusTopicSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.AzureServiceBusTopicSourceInterface = (*wrapSourcesV1beta1AzureServiceBusTopicSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.AzureServiceBusTopicSource, opts v1.CreateOptions) (*sourcesv1beta1.AzureServiceBusTopicSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusTopicSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.AzureServiceBusTopicSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.AzureServiceBusTopicSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusTopicSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.AzureServiceBusTopicSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureServiceBusTopicSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusTopicSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*sourcesv1beta1.AzureServiceBusTopicSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "AzureServiceBusTopicSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.AzureServiceBusTopicSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1AzureServiceBusTopicSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) CloudEventsSources(namespace string) typedsourcesv1beta1.CloudEventsSourceInterface {
+	return &wrapSourcesV1beta1CloudEventsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "cloudeventssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1CloudEventsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.CloudEventsSourceInterface = (*wrapSourcesV1beta1CloudEventsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.CloudEventsSource, opts v1.CreateOptions) (*sourcesv1beta1.CloudEventsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "CloudEventsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.CloudEventsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.CloudEventsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.CloudEventsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.CloudEventsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.CloudEventsSource, opts v1.UpdateOptions) (*sourcesv1beta1.CloudEventsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "CloudEventsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.CloudEventsSource, opts v1.UpdateOptions) (*sourcesv1beta1.CloudEventsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "CloudEventsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.CloudEventsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1CloudEventsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) GoogleCloudAuditLogsSources(namespace string) typedsourcesv1beta1.GoogleCloudAuditLogsSourceInterface {
+	return &wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "googlecloudauditlogssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.GoogleCloudAuditLogsSourceInterface = (*wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.GoogleCloudAuditLogsSource, opts v1.CreateOptions) (*sourcesv1beta1.GoogleCloudAuditLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudAuditLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.GoogleCloudAuditLogsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.GoogleCloudAuditLogsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudAuditLogsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.GoogleCloudAuditLogsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudAuditLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudAuditLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudAuditLogsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudAuditLogsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudAuditLogsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudAuditLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) GoogleCloudBillingSources(namespace string) typedsourcesv1beta1.GoogleCloudBillingSourceInterface {
+	return &wrapSourcesV1beta1GoogleCloudBillingSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "googlecloudbillingsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1GoogleCloudBillingSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.GoogleCloudBillingSourceInterface = (*wrapSourcesV1beta1GoogleCloudBillingSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.GoogleCloudBillingSource, opts v1.CreateOptions) (*sourcesv1beta1.GoogleCloudBillingSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudBillingSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.GoogleCloudBillingSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.GoogleCloudBillingSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudBillingSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.GoogleCloudBillingSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.GoogleCloudBillingSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudBillingSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudBillingSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.GoogleCloudBillingSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudBillingSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudBillingSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudBillingSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudBillingSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) GoogleCloudPubSubSources(namespace string) typedsourcesv1beta1.GoogleCloudPubSubSourceInterface {
+	return &wrapSourcesV1beta1GoogleCloudPubSubSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "googlecloudpubsubsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1GoogleCloudPubSubSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.GoogleCloudPubSubSourceInterface = (*wrapSourcesV1beta1GoogleCloudPubSubSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.GoogleCloudPubSubSource, opts v1.CreateOptions) (*sourcesv1beta1.GoogleCloudPubSubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudPubSubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.GoogleCloudPubSubSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.GoogleCloudPubSubSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudPubSubSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.GoogleCloudPubSubSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudPubSubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudPubSubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudPubSubSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudPubSubSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudPubSubSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudPubSubSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) GoogleCloudSourceRepositoriesSources(namespace string) typedsourcesv1beta1.GoogleCloudSourceRepositoriesSourceInterface {
+	return &wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "googlecloudsourcerepositoriessources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.GoogleCloudSourceRepositoriesSourceInterface = (*wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.GoogleCloudSourceRepositoriesSource, opts v1.CreateOptions) (*sourcesv1beta1.GoogleCloudSourceRepositoriesSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudSourceRepositoriesSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.GoogleCloudSourceRepositoriesSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.GoogleCloudSourceRepositoriesSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudSourceRepositoriesSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.GoogleCloudSourceRepositoriesSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.GoogleCloudSourceRepositoriesSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudSourceRepositoriesSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudSourceRepositoriesSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.GoogleCloudSourceRepositoriesSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudSourceRepositoriesSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudSourceRepositoriesSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudSourceRepositoriesSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudSourceRepositoriesSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) GoogleCloudStorageSources(namespace string) typedsourcesv1beta1.GoogleCloudStorageSourceInterface {
+	return &wrapSourcesV1beta1GoogleCloudStorageSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "googlecloudstoragesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1GoogleCloudStorageSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.GoogleCloudStorageSourceInterface = (*wrapSourcesV1beta1GoogleCloudStorageSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.GoogleCloudStorageSource, opts v1.CreateOptions) (*sourcesv1beta1.GoogleCloudStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.GoogleCloudStorageSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.GoogleCloudStorageSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudStorageSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.GoogleCloudStorageSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*sourcesv1beta1.GoogleCloudStorageSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "GoogleCloudStorageSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.GoogleCloudStorageSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1GoogleCloudStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) HTTPPollerSources(namespace string) typedsourcesv1beta1.HTTPPollerSourceInterface {
+	return &wrapSourcesV1beta1HTTPPollerSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "httppollersources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1HTTPPollerSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.HTTPPollerSourceInterface = (*wrapSourcesV1beta1HTTPPollerSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.HTTPPollerSource, opts v1.CreateOptions) (*sourcesv1beta1.HTTPPollerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "HTTPPollerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.HTTPPollerSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.HTTPPollerSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.HTTPPollerSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.HTTPPollerSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.HTTPPollerSource, opts v1.UpdateOptions) (*sourcesv1beta1.HTTPPollerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "HTTPPollerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.HTTPPollerSource, opts v1.UpdateOptions) (*sourcesv1beta1.HTTPPollerSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "HTTPPollerSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.HTTPPollerSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1HTTPPollerSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) IBMMQSources(namespace string) typedsourcesv1beta1.IBMMQSourceInterface {
+	return &wrapSourcesV1beta1IBMMQSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "ibmmqsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1IBMMQSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.IBMMQSourceInterface = (*wrapSourcesV1beta1IBMMQSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.IBMMQSource, opts v1.CreateOptions) (*sourcesv1beta1.IBMMQSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "IBMMQSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.IBMMQSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.IBMMQSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.IBMMQSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.IBMMQSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.IBMMQSource, opts v1.UpdateOptions) (*sourcesv1beta1.IBMMQSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "IBMMQSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.IBMMQSource, opts v1.UpdateOptions) (*sourcesv1beta1.IBMMQSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "IBMMQSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.IBMMQSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1IBMMQSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) KafkaSources(namespace string) typedsourcesv1beta1.KafkaSourceInterface {
+	return &wrapSourcesV1beta1KafkaSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "kafkasources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1KafkaSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.KafkaSourceInterface = (*wrapSourcesV1beta1KafkaSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.KafkaSource, opts v1.CreateOptions) (*sourcesv1beta1.KafkaSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "KafkaSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.KafkaSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.KafkaSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.KafkaSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.KafkaSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.KafkaSource, opts v1.UpdateOptions) (*sourcesv1beta1.KafkaSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "KafkaSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.KafkaSource, opts v1.UpdateOptions) (*sourcesv1beta1.KafkaSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "KafkaSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.KafkaSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1KafkaSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) MongoDBSources(namespace string) typedsourcesv1beta1.MongoDBSourceInterface {
+	return &wrapSourcesV1beta1MongoDBSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "mongodbsources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1MongoDBSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.MongoDBSourceInterface = (*wrapSourcesV1beta1MongoDBSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.MongoDBSource, opts v1.CreateOptions) (*sourcesv1beta1.MongoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "MongoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.MongoDBSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.MongoDBSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.MongoDBSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.MongoDBSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.MongoDBSource, opts v1.UpdateOptions) (*sourcesv1beta1.MongoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "MongoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.MongoDBSource, opts v1.UpdateOptions) (*sourcesv1beta1.MongoDBSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "MongoDBSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.MongoDBSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1MongoDBSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) OCIMetricsSources(namespace string) typedsourcesv1beta1.OCIMetricsSourceInterface {
+	return &wrapSourcesV1beta1OCIMetricsSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "ocimetricssources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1OCIMetricsSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.OCIMetricsSourceInterface = (*wrapSourcesV1beta1OCIMetricsSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.OCIMetricsSource, opts v1.CreateOptions) (*sourcesv1beta1.OCIMetricsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "OCIMetricsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.OCIMetricsSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.OCIMetricsSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.OCIMetricsSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.OCIMetricsSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.OCIMetricsSource, opts v1.UpdateOptions) (*sourcesv1beta1.OCIMetricsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "OCIMetricsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.OCIMetricsSource, opts v1.UpdateOptions) (*sourcesv1beta1.OCIMetricsSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "OCIMetricsSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.OCIMetricsSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1OCIMetricsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+	return nil, errors.New("NYI: Watch")
+}
+
+func (w *wrapSourcesV1beta1) SalesforceSources(namespace string) typedsourcesv1beta1.SalesforceSourceInterface {
+	return &wrapSourcesV1beta1SalesforceSourceImpl{
+		dyn: w.dyn.Resource(schema.GroupVersionResource{
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "salesforcesources",
+		}),
+
+		namespace: namespace,
+	}
+}
+
+type wrapSourcesV1beta1SalesforceSourceImpl struct {
+	dyn dynamic.NamespaceableResourceInterface
+
+	namespace string
+}
+
+var _ typedsourcesv1beta1.SalesforceSourceInterface = (*wrapSourcesV1beta1SalesforceSourceImpl)(nil)
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.SalesforceSource, opts v1.CreateOptions) (*sourcesv1beta1.SalesforceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SalesforceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Create(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.SalesforceSource, error) {
+	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.SalesforceSourceList, error) {
+	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.SalesforceSourceList{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.SalesforceSource, err error) {
+	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.SalesforceSource, opts v1.UpdateOptions) (*sourcesv1beta1.SalesforceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SalesforceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).Update(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.SalesforceSource, opts v1.UpdateOptions) (*sourcesv1beta1.SalesforceSource, error) {
+	in.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SalesforceSource",
+	})
+	uo := &unstructured.Unstructured{}
+	if err := convert(in, uo); err != nil {
+		return nil, err
+	}
+	uo, err := w.dyn.Namespace(w.namespace).UpdateStatus(ctx, uo, opts)
+	if err != nil {
+		return nil, err
+	}
+	out := &sourcesv1beta1.SalesforceSource{}
+	if err := convert(uo, out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (w *wrapSourcesV1beta1SalesforceSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapRoutingV1alpha1) Splitters(namespace string) typedroutingv1alpha1.SplitterInterface {
-	return &wrapRoutingV1alpha1SplitterImpl{
+func (w *wrapSourcesV1beta1) SlackSources(namespace string) typedsourcesv1beta1.SlackSourceInterface {
+	return &wrapSourcesV1beta1SlackSourceImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "routing.triggermesh.io",
-			Version:  "v1alpha1",
-			Resource: "splitters",
+			Group:    "sources.triggermesh.io",
+			Version:  "v1beta1",
+			Resource: "slacksources",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapRoutingV1alpha1SplitterImpl struct {
+type wrapSourcesV1beta1SlackSourceImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedroutingv1alpha1.SplitterInterface = (*wrapRoutingV1alpha1SplitterImpl)(nil)
+var _ typedsourcesv1beta1.SlackSourceInterface = (*wrapSourcesV1beta1SlackSourceImpl)(nil)
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Create(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.CreateOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.SlackSource, opts v1.CreateOptions) (*sourcesv1beta1.SlackSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "routing.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "Splitter",
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SlackSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1100,62 +12599,62 @@ func (w *wrapRoutingV1alpha1SplitterImpl) Create(ctx context.Context, in *routin
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &sourcesv1beta1.SlackSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapSourcesV1beta1SlackSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapSourcesV1beta1SlackSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.SlackSource, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &sourcesv1beta1.SlackSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) List(ctx context.Context, opts v1.ListOptions) (*routingv1alpha1.SplitterList, error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.SlackSourceList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.SplitterList{}
+	out := &sourcesv1beta1.SlackSourceList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *routingv1alpha1.Splitter, err error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.SlackSource, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &sourcesv1beta1.SlackSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Update(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.UpdateOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.SlackSource, opts v1.UpdateOptions) (*sourcesv1beta1.SlackSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "routing.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "Splitter",
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SlackSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1165,18 +12664,18 @@ func (w *wrapRoutingV1alpha1SplitterImpl) Update(ctx context.Context, in *routin
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &sourcesv1beta1.SlackSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) UpdateStatus(ctx context.Context, in *routingv1alpha1.Splitter, opts v1.UpdateOptions) (*routingv1alpha1.Splitter, error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.SlackSource, opts v1.UpdateOptions) (*sourcesv1beta1.SlackSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "routing.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "Splitter",
+		Group:   "sources.triggermesh.io",
+		Version: "v1beta1",
+		Kind:    "SlackSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1186,57 +12685,42 @@ func (w *wrapRoutingV1alpha1SplitterImpl) UpdateStatus(ctx context.Context, in *
 	if err != nil {
 		return nil, err
 	}
-	out := &routingv1alpha1.Splitter{}
+	out := &sourcesv1beta1.SlackSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapRoutingV1alpha1SplitterImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapSourcesV1beta1SlackSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-// SourcesV1alpha1 retrieves the SourcesV1alpha1Client
-func (w *wrapClient) SourcesV1alpha1() typedsourcesv1alpha1.SourcesV1alpha1Interface {
-	return &wrapSourcesV1alpha1{
-		dyn: w.dyn,
-	}
-}
-
-type wrapSourcesV1alpha1 struct {
-	dyn dynamic.Interface
-}
-
-func (w *wrapSourcesV1alpha1) RESTClient() rest.Interface {
-	panic("RESTClient called on dynamic client!")
-}
-
-func (w *wrapSourcesV1alpha1) AWSCloudWatchLogsSources(namespace string) typedsourcesv1alpha1.AWSCloudWatchLogsSourceInterface {
-	return &wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl{
+func (w *wrapSourcesV1beta1) SolaceSources(namespace string) typedsourcesv1beta1.SolaceSourceInterface {
+	return &wrapSourcesV1beta1SolaceSourceImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "sources.triggermesh.io",
-			Version:  "v1alpha1",
-			Resource: "awscloudwatchlogssources",
+			Version:  "v1beta1",
+			Resource: "solacesources",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl struct {
+type wrapSourcesV1beta1SolaceSourceImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSCloudWatchLogsSourceInterface = (*wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl)(nil)
+var _ typedsourcesv1beta1.SolaceSourceInterface = (*wrapSourcesV1beta1SolaceSourceImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchLogsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.SolaceSource, opts v1.CreateOptions) (*sourcesv1beta1.SolaceSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCloudWatchLogsSource",
+		Version: "v1beta1",
+		Kind:    "SolaceSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1246,62 +12730,62 @@ func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Create(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	out := &sourcesv1beta1.SolaceSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.SolaceSource, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	out := &sourcesv1beta1.SolaceSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSourceList, error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.SolaceSourceList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchLogsSourceList{}
+	out := &sourcesv1beta1.SolaceSourceList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCloudWatchLogsSource, err error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.SolaceSource, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	out := &sourcesv1beta1.SolaceSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.SolaceSource, opts v1.UpdateOptions) (*sourcesv1beta1.SolaceSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCloudWatchLogsSource",
+		Version: "v1beta1",
+		Kind:    "SolaceSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1311,18 +12795,18 @@ func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Update(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	out := &sourcesv1beta1.SolaceSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchLogsSource, error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.SolaceSource, opts v1.UpdateOptions) (*sourcesv1beta1.SolaceSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCloudWatchLogsSource",
+		Version: "v1beta1",
+		Kind:    "SolaceSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1332,42 +12816,42 @@ func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) UpdateStatus(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchLogsSource{}
+	out := &sourcesv1beta1.SolaceSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapSourcesV1beta1SolaceSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSCloudWatchSources(namespace string) typedsourcesv1alpha1.AWSCloudWatchSourceInterface {
-	return &wrapSourcesV1alpha1AWSCloudWatchSourceImpl{
+func (w *wrapSourcesV1beta1) TwilioSources(namespace string) typedsourcesv1beta1.TwilioSourceInterface {
+	return &wrapSourcesV1beta1TwilioSourceImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "sources.triggermesh.io",
-			Version:  "v1alpha1",
-			Resource: "awscloudwatchsources",
+			Version:  "v1beta1",
+			Resource: "twiliosources",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSCloudWatchSourceImpl struct {
+type wrapSourcesV1beta1TwilioSourceImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSCloudWatchSourceInterface = (*wrapSourcesV1alpha1AWSCloudWatchSourceImpl)(nil)
+var _ typedsourcesv1beta1.TwilioSourceInterface = (*wrapSourcesV1beta1TwilioSourceImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.TwilioSource, opts v1.CreateOptions) (*sourcesv1beta1.TwilioSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCloudWatchSource",
+		Version: "v1beta1",
+		Kind:    "TwilioSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1377,62 +12861,62 @@ func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Create(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	out := &sourcesv1beta1.TwilioSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.TwilioSource, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	out := &sourcesv1beta1.TwilioSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCloudWatchSourceList, error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.TwilioSourceList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchSourceList{}
+	out := &sourcesv1beta1.TwilioSourceList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCloudWatchSource, err error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.TwilioSource, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	out := &sourcesv1beta1.TwilioSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.TwilioSource, opts v1.UpdateOptions) (*sourcesv1beta1.TwilioSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCloudWatchSource",
+		Version: "v1beta1",
+		Kind:    "TwilioSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1442,18 +12926,18 @@ func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Update(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	out := &sourcesv1beta1.TwilioSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCloudWatchSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCloudWatchSource, error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.TwilioSource, opts v1.UpdateOptions) (*sourcesv1beta1.TwilioSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCloudWatchSource",
+		Version: "v1beta1",
+		Kind:    "TwilioSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1463,42 +12947,42 @@ func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) UpdateStatus(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCloudWatchSource{}
+	out := &sourcesv1beta1.TwilioSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCloudWatchSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapSourcesV1beta1TwilioSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSCodeCommitSources(namespace string) typedsourcesv1alpha1.AWSCodeCommitSourceInterface {
-	return &wrapSourcesV1alpha1AWSCodeCommitSourceImpl{
+func (w *wrapSourcesV1beta1) WebhookSources(namespace string) typedsourcesv1beta1.WebhookSourceInterface {
+	return &wrapSourcesV1beta1WebhookSourceImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "sources.triggermesh.io",
-			Version:  "v1alpha1",
-			Resource: "awscodecommitsources",
+			Version:  "v1beta1",
+			Resource: "webhooksources",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSCodeCommitSourceImpl struct {
+type wrapSourcesV1beta1WebhookSourceImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSCodeCommitSourceInterface = (*wrapSourcesV1alpha1AWSCodeCommitSourceImpl)(nil)
+var _ typedsourcesv1beta1.WebhookSourceInterface = (*wrapSourcesV1beta1WebhookSourceImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCodeCommitSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.WebhookSource, opts v1.CreateOptions) (*sourcesv1beta1.WebhookSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCodeCommitSource",
+		Version: "v1beta1",
+		Kind:    "WebhookSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1508,62 +12992,62 @@ func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Create(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	out := &sourcesv1beta1.WebhookSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.WebhookSource, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	out := &sourcesv1beta1.WebhookSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCodeCommitSourceList, error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.WebhookSourceList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCodeCommitSourceList{}
+	out := &sourcesv1beta1.WebhookSourceList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCodeCommitSource, err error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.WebhookSource, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	out := &sourcesv1beta1.WebhookSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCodeCommitSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.WebhookSource, opts v1.UpdateOptions) (*sourcesv1beta1.WebhookSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCodeCommitSource",
+		Version: "v1beta1",
+		Kind:    "WebhookSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1573,18 +13057,18 @@ func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Update(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	out := &sourcesv1beta1.WebhookSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCodeCommitSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCodeCommitSource, error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.WebhookSource, opts v1.UpdateOptions) (*sourcesv1beta1.WebhookSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCodeCommitSource",
+		Version: "v1beta1",
+		Kind:    "WebhookSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1594,42 +13078,42 @@ func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) UpdateStatus(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCodeCommitSource{}
+	out := &sourcesv1beta1.WebhookSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCodeCommitSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapSourcesV1beta1WebhookSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSCognitoIdentitySources(namespace string) typedsourcesv1alpha1.AWSCognitoIdentitySourceInterface {
-	return &wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl{
+func (w *wrapSourcesV1beta1) ZendeskSources(namespace string) typedsourcesv1beta1.ZendeskSourceInterface {
+	return &wrapSourcesV1beta1ZendeskSourceImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "sources.triggermesh.io",
-			Version:  "v1alpha1",
-			Resource: "awscognitoidentitysources",
+			Version:  "v1beta1",
+			Resource: "zendesksources",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl struct {
+type wrapSourcesV1beta1ZendeskSourceImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSCognitoIdentitySourceInterface = (*wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl)(nil)
+var _ typedsourcesv1beta1.ZendeskSourceInterface = (*wrapSourcesV1beta1ZendeskSourceImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCognitoIdentitySource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) Create(ctx context.Context, in *sourcesv1beta1.ZendeskSource, opts v1.CreateOptions) (*sourcesv1beta1.ZendeskSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCognitoIdentitySource",
+		Version: "v1beta1",
+		Kind:    "ZendeskSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1639,62 +13123,62 @@ func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Create(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	out := &sourcesv1beta1.ZendeskSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1beta1.ZendeskSource, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	out := &sourcesv1beta1.ZendeskSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCognitoIdentitySourceList, error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1beta1.ZendeskSourceList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoIdentitySourceList{}
+	out := &sourcesv1beta1.ZendeskSourceList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCognitoIdentitySource, err error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1beta1.ZendeskSource, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	out := &sourcesv1beta1.ZendeskSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) Update(ctx context.Context, in *sourcesv1beta1.ZendeskSource, opts v1.UpdateOptions) (*sourcesv1beta1.ZendeskSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCognitoIdentitySource",
+		Version: "v1beta1",
+		Kind:    "ZendeskSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1704,18 +13188,18 @@ func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Update(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	out := &sourcesv1beta1.ZendeskSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCognitoIdentitySource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoIdentitySource, error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1beta1.ZendeskSource, opts v1.UpdateOptions) (*sourcesv1beta1.ZendeskSource, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "sources.triggermesh.io",
-		Version: "v1alpha1",
-		Kind:    "AWSCognitoIdentitySource",
+		Version: "v1beta1",
+		Kind:    "ZendeskSource",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1725,42 +13209,57 @@ func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) UpdateStatus(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoIdentitySource{}
+	out := &sourcesv1beta1.ZendeskSource{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoIdentitySourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapSourcesV1beta1ZendeskSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSCognitoUserPoolSources(namespace string) typedsourcesv1alpha1.AWSCognitoUserPoolSourceInterface {
-	return &wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl{
+// TargetsV1alpha1 retrieves the TargetsV1alpha1Client
+func (w *wrapClient) TargetsV1alpha1() typedtargetsv1alpha1.TargetsV1alpha1Interface {
+	return &wrapTargetsV1alpha1{
+		dyn: w.dyn,
+	}
+}
+
+type wrapTargetsV1alpha1 struct {
+	dyn dynamic.Interface
+}
+
+func (w *wrapTargetsV1alpha1) RESTClient() rest.Interface {
+	panic("RESTClient called on dynamic client!")
+}
+
+func (w *wrapTargetsV1alpha1) AWSComprehendTargets(namespace string) typedtargetsv1alpha1.AWSComprehendTargetInterface {
+	return &wrapTargetsV1alpha1AWSComprehendTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awscognitouserpoolsources",
+			Resource: "awscomprehendtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl struct {
+type wrapTargetsV1alpha1AWSComprehendTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSCognitoUserPoolSourceInterface = (*wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSComprehendTargetInterface = (*wrapTargetsV1alpha1AWSComprehendTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSCognitoUserPoolSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSComprehendTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSCognitoUserPoolSource",
+		Kind:    "AWSComprehendTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1770,62 +13269,62 @@ func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Create(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	out := &targetsv1alpha1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	out := &targetsv1alpha1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSComprehendTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoUserPoolSourceList{}
+	out := &targetsv1alpha1.AWSComprehendTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSCognitoUserPoolSource, err error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSComprehendTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	out := &targetsv1alpha1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSCognitoUserPoolSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSComprehendTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSCognitoUserPoolSource",
+		Kind:    "AWSComprehendTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1835,18 +13334,18 @@ func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Update(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	out := &targetsv1alpha1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSCognitoUserPoolSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSCognitoUserPoolSource, error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSComprehendTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSCognitoUserPoolSource",
+		Kind:    "AWSComprehendTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1856,42 +13355,42 @@ func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) UpdateStatus(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSCognitoUserPoolSource{}
+	out := &targetsv1alpha1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSCognitoUserPoolSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSDynamoDBSources(namespace string) typedsourcesv1alpha1.AWSDynamoDBSourceInterface {
-	return &wrapSourcesV1alpha1AWSDynamoDBSourceImpl{
+func (w *wrapTargetsV1alpha1) AWSDynamoDBTargets(namespace string) typedtargetsv1alpha1.AWSDynamoDBTargetInterface {
+	return &wrapTargetsV1alpha1AWSDynamoDBTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awsdynamodbsources",
+			Resource: "awsdynamodbtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSDynamoDBSourceImpl struct {
+type wrapTargetsV1alpha1AWSDynamoDBTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSDynamoDBSourceInterface = (*wrapSourcesV1alpha1AWSDynamoDBSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSDynamoDBTargetInterface = (*wrapTargetsV1alpha1AWSDynamoDBTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSDynamoDBSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSDynamoDBTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSDynamoDBSource",
+		Kind:    "AWSDynamoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1901,62 +13400,62 @@ func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	out := &targetsv1alpha1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	out := &targetsv1alpha1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSDynamoDBSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSDynamoDBTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSDynamoDBSourceList{}
+	out := &targetsv1alpha1.AWSDynamoDBTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSDynamoDBSource, err error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSDynamoDBTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	out := &targetsv1alpha1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSDynamoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSDynamoDBSource",
+		Kind:    "AWSDynamoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1966,18 +13465,18 @@ func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	out := &targetsv1alpha1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSDynamoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSDynamoDBSource, error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSDynamoDBSource",
+		Kind:    "AWSDynamoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -1987,42 +13486,42 @@ func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSDynamoDBSource{}
+	out := &targetsv1alpha1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSDynamoDBSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSEventBridgeSources(namespace string) typedsourcesv1alpha1.AWSEventBridgeSourceInterface {
-	return &wrapSourcesV1alpha1AWSEventBridgeSourceImpl{
+func (w *wrapTargetsV1alpha1) AWSEventBridgeTargets(namespace string) typedtargetsv1alpha1.AWSEventBridgeTargetInterface {
+	return &wrapTargetsV1alpha1AWSEventBridgeTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awseventbridgesources",
+			Resource: "awseventbridgetargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSEventBridgeSourceImpl struct {
+type wrapTargetsV1alpha1AWSEventBridgeTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSEventBridgeSourceInterface = (*wrapSourcesV1alpha1AWSEventBridgeSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSEventBridgeTargetInterface = (*wrapTargetsV1alpha1AWSEventBridgeTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSEventBridgeSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSEventBridgeTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSEventBridgeSource",
+		Kind:    "AWSEventBridgeTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2032,62 +13531,62 @@ func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Create(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	out := &targetsv1alpha1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	out := &targetsv1alpha1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSEventBridgeSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSEventBridgeTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSEventBridgeSourceList{}
+	out := &targetsv1alpha1.AWSEventBridgeTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSEventBridgeSource, err error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSEventBridgeTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	out := &targetsv1alpha1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSEventBridgeSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSEventBridgeTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSEventBridgeSource",
+		Kind:    "AWSEventBridgeTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2097,18 +13596,18 @@ func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Update(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	out := &targetsv1alpha1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSEventBridgeSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSEventBridgeSource, error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSEventBridgeTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSEventBridgeSource",
+		Kind:    "AWSEventBridgeTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2118,42 +13617,42 @@ func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) UpdateStatus(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSEventBridgeSource{}
+	out := &targetsv1alpha1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSEventBridgeSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSKinesisSources(namespace string) typedsourcesv1alpha1.AWSKinesisSourceInterface {
-	return &wrapSourcesV1alpha1AWSKinesisSourceImpl{
+func (w *wrapTargetsV1alpha1) AWSKinesisTargets(namespace string) typedtargetsv1alpha1.AWSKinesisTargetInterface {
+	return &wrapTargetsV1alpha1AWSKinesisTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awskinesissources",
+			Resource: "awskinesistargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSKinesisSourceImpl struct {
+type wrapTargetsV1alpha1AWSKinesisTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSKinesisSourceInterface = (*wrapSourcesV1alpha1AWSKinesisSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSKinesisTargetInterface = (*wrapTargetsV1alpha1AWSKinesisTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSKinesisSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSKinesisTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSKinesisSource",
+		Kind:    "AWSKinesisTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2163,62 +13662,62 @@ func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSKinesisSource{}
+	out := &targetsv1alpha1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSKinesisSource{}
+	out := &targetsv1alpha1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSKinesisSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSKinesisTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSKinesisSourceList{}
+	out := &targetsv1alpha1.AWSKinesisTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSKinesisSource, err error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSKinesisTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSKinesisSource{}
+	out := &targetsv1alpha1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSKinesisSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSKinesisTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSKinesisSource",
+		Kind:    "AWSKinesisTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2228,18 +13727,18 @@ func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSKinesisSource{}
+	out := &targetsv1alpha1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSKinesisSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSKinesisSource, error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSKinesisTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSKinesisSource",
+		Kind:    "AWSKinesisTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2249,42 +13748,42 @@ func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) UpdateStatus(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSKinesisSource{}
+	out := &targetsv1alpha1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSKinesisSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSPerformanceInsightsSources(namespace string) typedsourcesv1alpha1.AWSPerformanceInsightsSourceInterface {
-	return &wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl{
+func (w *wrapTargetsV1alpha1) AWSLambdaTargets(namespace string) typedtargetsv1alpha1.AWSLambdaTargetInterface {
+	return &wrapTargetsV1alpha1AWSLambdaTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awsperformanceinsightssources",
+			Resource: "awslambdatargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl struct {
+type wrapTargetsV1alpha1AWSLambdaTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSPerformanceInsightsSourceInterface = (*wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSLambdaTargetInterface = (*wrapTargetsV1alpha1AWSLambdaTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSPerformanceInsightsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSLambdaTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSPerformanceInsightsSource",
+		Kind:    "AWSLambdaTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2294,62 +13793,62 @@ func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Create(ctx context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	out := &targetsv1alpha1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	out := &targetsv1alpha1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSLambdaTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSPerformanceInsightsSourceList{}
+	out := &targetsv1alpha1.AWSLambdaTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSPerformanceInsightsSource, err error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSLambdaTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	out := &targetsv1alpha1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSPerformanceInsightsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSLambdaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSPerformanceInsightsSource",
+		Kind:    "AWSLambdaTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2359,18 +13858,18 @@ func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Update(ctx context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	out := &targetsv1alpha1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSPerformanceInsightsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSPerformanceInsightsSource, error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSLambdaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSPerformanceInsightsSource",
+		Kind:    "AWSLambdaTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2380,42 +13879,42 @@ func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) UpdateStatus(ctx c
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSPerformanceInsightsSource{}
+	out := &targetsv1alpha1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSPerformanceInsightsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSS3Sources(namespace string) typedsourcesv1alpha1.AWSS3SourceInterface {
-	return &wrapSourcesV1alpha1AWSS3SourceImpl{
+func (w *wrapTargetsV1alpha1) AWSS3Targets(namespace string) typedtargetsv1alpha1.AWSS3TargetInterface {
+	return &wrapTargetsV1alpha1AWSS3TargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awss3sources",
+			Resource: "awss3targets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSS3SourceImpl struct {
+type wrapTargetsV1alpha1AWSS3TargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSS3SourceInterface = (*wrapSourcesV1alpha1AWSS3SourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSS3TargetInterface = (*wrapTargetsV1alpha1AWSS3TargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSS3Source, opts v1.CreateOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSS3Target, opts v1.CreateOptions) (*targetsv1alpha1.AWSS3Target, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSS3Source",
+		Kind:    "AWSS3Target",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2425,62 +13924,62 @@ func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Create(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSS3Source{}
+	out := &targetsv1alpha1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSS3Target, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSS3Source{}
+	out := &targetsv1alpha1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSS3SourceList, error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSS3TargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSS3SourceList{}
+	out := &targetsv1alpha1.AWSS3TargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSS3Source, err error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSS3Target, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSS3Source{}
+	out := &targetsv1alpha1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSS3Source, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSS3Target, opts v1.UpdateOptions) (*targetsv1alpha1.AWSS3Target, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSS3Source",
+		Kind:    "AWSS3Target",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2490,18 +13989,18 @@ func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Update(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSS3Source{}
+	out := &targetsv1alpha1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSS3Source, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSS3Source, error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSS3Target, opts v1.UpdateOptions) (*targetsv1alpha1.AWSS3Target, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSS3Source",
+		Kind:    "AWSS3Target",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2511,42 +14010,42 @@ func (w *wrapSourcesV1alpha1AWSS3SourceImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSS3Source{}
+	out := &targetsv1alpha1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSS3SourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSSNSSources(namespace string) typedsourcesv1alpha1.AWSSNSSourceInterface {
-	return &wrapSourcesV1alpha1AWSSNSSourceImpl{
+func (w *wrapTargetsV1alpha1) AWSSNSTargets(namespace string) typedtargetsv1alpha1.AWSSNSTargetInterface {
+	return &wrapTargetsV1alpha1AWSSNSTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awssnssources",
+			Resource: "awssnstargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSSNSSourceImpl struct {
+type wrapTargetsV1alpha1AWSSNSTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSSNSSourceInterface = (*wrapSourcesV1alpha1AWSSNSSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSSNSTargetInterface = (*wrapTargetsV1alpha1AWSSNSTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSSNSSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSSNSTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSSNSSource",
+		Kind:    "AWSSNSTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2556,62 +14055,62 @@ func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Create(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSNSSource{}
+	out := &targetsv1alpha1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSNSSource{}
+	out := &targetsv1alpha1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSSNSSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSSNSTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSNSSourceList{}
+	out := &targetsv1alpha1.AWSSNSTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSSNSSource, err error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSSNSTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSNSSource{}
+	out := &targetsv1alpha1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSSNSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSSNSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSSNSSource",
+		Kind:    "AWSSNSTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2621,18 +14120,18 @@ func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Update(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSNSSource{}
+	out := &targetsv1alpha1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSSNSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSNSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSSNSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSSNSSource",
+		Kind:    "AWSSNSTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2642,42 +14141,42 @@ func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSNSSource{}
+	out := &targetsv1alpha1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSNSSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AWSSQSSources(namespace string) typedsourcesv1alpha1.AWSSQSSourceInterface {
-	return &wrapSourcesV1alpha1AWSSQSSourceImpl{
+func (w *wrapTargetsV1alpha1) AWSSQSTargets(namespace string) typedtargetsv1alpha1.AWSSQSTargetInterface {
+	return &wrapTargetsV1alpha1AWSSQSTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "awssqssources",
+			Resource: "awssqstargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AWSSQSSourceImpl struct {
+type wrapTargetsV1alpha1AWSSQSTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AWSSQSSourceInterface = (*wrapSourcesV1alpha1AWSSQSSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AWSSQSTargetInterface = (*wrapTargetsV1alpha1AWSSQSTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AWSSQSSource, opts v1.CreateOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSSQSTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSSQSSource",
+		Kind:    "AWSSQSTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2687,62 +14186,62 @@ func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Create(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSQSSource{}
+	out := &targetsv1alpha1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSQSSource{}
+	out := &targetsv1alpha1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AWSSQSSourceList, error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSSQSTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSQSSourceList{}
+	out := &targetsv1alpha1.AWSSQSTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AWSSQSSource, err error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSSQSTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSQSSource{}
+	out := &targetsv1alpha1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AWSSQSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSSQSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSSQSSource",
+		Kind:    "AWSSQSTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2752,18 +14251,18 @@ func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Update(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSQSSource{}
+	out := &targetsv1alpha1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AWSSQSSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AWSSQSSource, error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSSQSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AWSSQSSource",
+		Kind:    "AWSSQSTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2773,42 +14272,42 @@ func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AWSSQSSource{}
+	out := &targetsv1alpha1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AWSSQSSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureActivityLogsSources(namespace string) typedsourcesv1alpha1.AzureActivityLogsSourceInterface {
-	return &wrapSourcesV1alpha1AzureActivityLogsSourceImpl{
+func (w *wrapTargetsV1alpha1) AzureEventHubsTargets(namespace string) typedtargetsv1alpha1.AzureEventHubsTargetInterface {
+	return &wrapTargetsV1alpha1AzureEventHubsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureactivitylogssources",
+			Resource: "azureeventhubstargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureActivityLogsSourceImpl struct {
+type wrapTargetsV1alpha1AzureEventHubsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureActivityLogsSourceInterface = (*wrapSourcesV1alpha1AzureActivityLogsSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AzureEventHubsTargetInterface = (*wrapTargetsV1alpha1AzureEventHubsTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureActivityLogsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AzureEventHubsTarget, opts v1.CreateOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureActivityLogsSource",
+		Kind:    "AzureEventHubsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2818,62 +14317,62 @@ func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Create(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	out := &targetsv1alpha1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	out := &targetsv1alpha1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureActivityLogsSourceList, error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AzureEventHubsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureActivityLogsSourceList{}
+	out := &targetsv1alpha1.AzureEventHubsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureActivityLogsSource, err error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AzureEventHubsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	out := &targetsv1alpha1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureActivityLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AzureEventHubsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureActivityLogsSource",
+		Kind:    "AzureEventHubsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2883,18 +14382,18 @@ func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Update(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	out := &targetsv1alpha1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureActivityLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureActivityLogsSource, error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AzureEventHubsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureActivityLogsSource",
+		Kind:    "AzureEventHubsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2904,42 +14403,42 @@ func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) UpdateStatus(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureActivityLogsSource{}
+	out := &targetsv1alpha1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureActivityLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureBlobStorageSources(namespace string) typedsourcesv1alpha1.AzureBlobStorageSourceInterface {
-	return &wrapSourcesV1alpha1AzureBlobStorageSourceImpl{
+func (w *wrapTargetsV1alpha1) AzureSentinelTargets(namespace string) typedtargetsv1alpha1.AzureSentinelTargetInterface {
+	return &wrapTargetsV1alpha1AzureSentinelTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureblobstoragesources",
+			Resource: "azuresentineltargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureBlobStorageSourceImpl struct {
+type wrapTargetsV1alpha1AzureSentinelTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureBlobStorageSourceInterface = (*wrapSourcesV1alpha1AzureBlobStorageSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AzureSentinelTargetInterface = (*wrapTargetsV1alpha1AzureSentinelTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureBlobStorageSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AzureSentinelTarget, opts v1.CreateOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureBlobStorageSource",
+		Kind:    "AzureSentinelTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -2949,62 +14448,62 @@ func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Create(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	out := &targetsv1alpha1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	out := &targetsv1alpha1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureBlobStorageSourceList, error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AzureSentinelTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureBlobStorageSourceList{}
+	out := &targetsv1alpha1.AzureSentinelTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureBlobStorageSource, err error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AzureSentinelTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	out := &targetsv1alpha1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureBlobStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AzureSentinelTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureBlobStorageSource",
+		Kind:    "AzureSentinelTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3014,18 +14513,18 @@ func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Update(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	out := &targetsv1alpha1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureBlobStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureBlobStorageSource, error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AzureSentinelTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureBlobStorageSource",
+		Kind:    "AzureSentinelTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3035,42 +14534,42 @@ func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) UpdateStatus(ctx context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureBlobStorageSource{}
+	out := &targetsv1alpha1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureBlobStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureEventGridSources(namespace string) typedsourcesv1alpha1.AzureEventGridSourceInterface {
-	return &wrapSourcesV1alpha1AzureEventGridSourceImpl{
+func (w *wrapTargetsV1alpha1) AzureServiceBusTargets(namespace string) typedtargetsv1alpha1.AzureServiceBusTargetInterface {
+	return &wrapTargetsV1alpha1AzureServiceBusTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureeventgridsources",
+			Resource: "azureservicebustargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureEventGridSourceImpl struct {
+type wrapTargetsV1alpha1AzureServiceBusTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureEventGridSourceInterface = (*wrapSourcesV1alpha1AzureEventGridSourceImpl)(nil)
+var _ typedtargetsv1alpha1.AzureServiceBusTargetInterface = (*wrapTargetsV1alpha1AzureServiceBusTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureEventGridSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AzureServiceBusTarget, opts v1.CreateOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureEventGridSource",
+		Kind:    "AzureServiceBusTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3080,62 +14579,62 @@ func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Create(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventGridSource{}
+	out := &targetsv1alpha1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventGridSource{}
+	out := &targetsv1alpha1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureEventGridSourceList, error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AzureServiceBusTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventGridSourceList{}
+	out := &targetsv1alpha1.AzureServiceBusTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureEventGridSource, err error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AzureServiceBusTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventGridSource{}
+	out := &targetsv1alpha1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureEventGridSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AzureServiceBusTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureEventGridSource",
+		Kind:    "AzureServiceBusTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3145,18 +14644,18 @@ func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Update(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventGridSource{}
+	out := &targetsv1alpha1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureEventGridSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventGridSource, error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AzureServiceBusTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureEventGridSource",
+		Kind:    "AzureServiceBusTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3166,42 +14665,42 @@ func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) UpdateStatus(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventGridSource{}
+	out := &targetsv1alpha1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventGridSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureEventHubsSources(namespace string) typedsourcesv1alpha1.AzureEventHubsSourceInterface {
-	return &wrapSourcesV1alpha1AzureEventHubsSourceImpl{
+func (w *wrapTargetsV1alpha1) CloudEventsTargets(namespace string) typedtargetsv1alpha1.CloudEventsTargetInterface {
+	return &wrapTargetsV1alpha1CloudEventsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureeventhubssources",
+			Resource: "cloudeventstargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureEventHubsSourceImpl struct {
+type wrapTargetsV1alpha1CloudEventsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureEventHubsSourceInterface = (*wrapSourcesV1alpha1AzureEventHubsSourceImpl)(nil)
+var _ typedtargetsv1alpha1.CloudEventsTargetInterface = (*wrapTargetsV1alpha1CloudEventsTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureEventHubsSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.CloudEventsTarget, opts v1.CreateOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureEventHubsSource",
+		Kind:    "CloudEventsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3211,62 +14710,62 @@ func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Create(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	out := &targetsv1alpha1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	out := &targetsv1alpha1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureEventHubsSourceList, error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.CloudEventsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventHubsSourceList{}
+	out := &targetsv1alpha1.CloudEventsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureEventHubsSource, err error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.CloudEventsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	out := &targetsv1alpha1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureEventHubsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.CloudEventsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureEventHubsSource",
+		Kind:    "CloudEventsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3276,18 +14775,18 @@ func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Update(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	out := &targetsv1alpha1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureEventHubsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureEventHubsSource, error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.CloudEventsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureEventHubsSource",
+		Kind:    "CloudEventsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3297,42 +14796,42 @@ func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) UpdateStatus(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureEventHubsSource{}
+	out := &targetsv1alpha1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureEventHubsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureIOTHubSources(namespace string) typedsourcesv1alpha1.AzureIOTHubSourceInterface {
-	return &wrapSourcesV1alpha1AzureIOTHubSourceImpl{
+func (w *wrapTargetsV1alpha1) DatadogTargets(namespace string) typedtargetsv1alpha1.DatadogTargetInterface {
+	return &wrapTargetsV1alpha1DatadogTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureiothubsources",
+			Resource: "datadogtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureIOTHubSourceImpl struct {
+type wrapTargetsV1alpha1DatadogTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureIOTHubSourceInterface = (*wrapSourcesV1alpha1AzureIOTHubSourceImpl)(nil)
+var _ typedtargetsv1alpha1.DatadogTargetInterface = (*wrapTargetsV1alpha1DatadogTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureIOTHubSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.DatadogTarget, opts v1.CreateOptions) (*targetsv1alpha1.DatadogTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureIOTHubSource",
+		Kind:    "DatadogTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3342,62 +14841,62 @@ func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	out := &targetsv1alpha1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.DatadogTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	out := &targetsv1alpha1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureIOTHubSourceList, error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.DatadogTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureIOTHubSourceList{}
+	out := &targetsv1alpha1.DatadogTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureIOTHubSource, err error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.DatadogTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	out := &targetsv1alpha1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureIOTHubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.DatadogTarget, opts v1.UpdateOptions) (*targetsv1alpha1.DatadogTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureIOTHubSource",
+		Kind:    "DatadogTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3407,18 +14906,18 @@ func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	out := &targetsv1alpha1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureIOTHubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureIOTHubSource, error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.DatadogTarget, opts v1.UpdateOptions) (*targetsv1alpha1.DatadogTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureIOTHubSource",
+		Kind:    "DatadogTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3428,42 +14927,42 @@ func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureIOTHubSource{}
+	out := &targetsv1alpha1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureIOTHubSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1DatadogTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureQueueStorageSources(namespace string) typedsourcesv1alpha1.AzureQueueStorageSourceInterface {
-	return &wrapSourcesV1alpha1AzureQueueStorageSourceImpl{
+func (w *wrapTargetsV1alpha1) ElasticsearchTargets(namespace string) typedtargetsv1alpha1.ElasticsearchTargetInterface {
+	return &wrapTargetsV1alpha1ElasticsearchTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azurequeuestoragesources",
+			Resource: "elasticsearchtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureQueueStorageSourceImpl struct {
+type wrapTargetsV1alpha1ElasticsearchTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureQueueStorageSourceInterface = (*wrapSourcesV1alpha1AzureQueueStorageSourceImpl)(nil)
+var _ typedtargetsv1alpha1.ElasticsearchTargetInterface = (*wrapTargetsV1alpha1ElasticsearchTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureQueueStorageSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.ElasticsearchTarget, opts v1.CreateOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureQueueStorageSource",
+		Kind:    "ElasticsearchTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3473,62 +14972,62 @@ func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Create(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	out := &targetsv1alpha1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	out := &targetsv1alpha1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureQueueStorageSourceList, error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.ElasticsearchTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureQueueStorageSourceList{}
+	out := &targetsv1alpha1.ElasticsearchTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureQueueStorageSource, err error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.ElasticsearchTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	out := &targetsv1alpha1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureQueueStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.ElasticsearchTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureQueueStorageSource",
+		Kind:    "ElasticsearchTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3538,18 +15037,18 @@ func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Update(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	out := &targetsv1alpha1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureQueueStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureQueueStorageSource, error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.ElasticsearchTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureQueueStorageSource",
+		Kind:    "ElasticsearchTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3559,42 +15058,42 @@ func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) UpdateStatus(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureQueueStorageSource{}
+	out := &targetsv1alpha1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureQueueStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureServiceBusQueueSources(namespace string) typedsourcesv1alpha1.AzureServiceBusQueueSourceInterface {
-	return &wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl{
+func (w *wrapTargetsV1alpha1) GoogleCloudFirestoreTargets(namespace string) typedtargetsv1alpha1.GoogleCloudFirestoreTargetInterface {
+	return &wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureservicebusqueuesources",
+			Resource: "googlecloudfirestoretargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl struct {
+type wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureServiceBusQueueSourceInterface = (*wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl)(nil)
+var _ typedtargetsv1alpha1.GoogleCloudFirestoreTargetInterface = (*wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusQueueSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudFirestoreTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusQueueSource",
+		Kind:    "GoogleCloudFirestoreTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3604,62 +15103,62 @@ func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Create(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureServiceBusQueueSourceList, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudFirestoreTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusQueueSourceList{}
+	out := &targetsv1alpha1.GoogleCloudFirestoreTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureServiceBusQueueSource, err error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudFirestoreTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusQueueSource",
+		Kind:    "GoogleCloudFirestoreTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3669,18 +15168,18 @@ func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Update(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusQueueSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusQueueSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusQueueSource",
+		Kind:    "GoogleCloudFirestoreTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3690,42 +15189,42 @@ func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) UpdateStatus(ctx con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusQueueSource{}
+	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusQueueSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureServiceBusSources(namespace string) typedsourcesv1alpha1.AzureServiceBusSourceInterface {
-	return &wrapSourcesV1alpha1AzureServiceBusSourceImpl{
+func (w *wrapTargetsV1alpha1) GoogleCloudPubSubTargets(namespace string) typedtargetsv1alpha1.GoogleCloudPubSubTargetInterface {
+	return &wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureservicebussources",
+			Resource: "googlecloudpubsubtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureServiceBusSourceImpl struct {
+type wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureServiceBusSourceInterface = (*wrapSourcesV1alpha1AzureServiceBusSourceImpl)(nil)
+var _ typedtargetsv1alpha1.GoogleCloudPubSubTargetInterface = (*wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudPubSubTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusSource",
+		Kind:    "GoogleCloudPubSubTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3735,62 +15234,62 @@ func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Create(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureServiceBusSourceList, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudPubSubTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusSourceList{}
+	out := &targetsv1alpha1.GoogleCloudPubSubTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureServiceBusSource, err error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudPubSubTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusSource",
+		Kind:    "GoogleCloudPubSubTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3800,18 +15299,18 @@ func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Update(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusSource",
+		Kind:    "GoogleCloudPubSubTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3821,42 +15320,42 @@ func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) UpdateStatus(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusSource{}
+	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) AzureServiceBusTopicSources(namespace string) typedsourcesv1alpha1.AzureServiceBusTopicSourceInterface {
-	return &wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl{
+func (w *wrapTargetsV1alpha1) GoogleCloudStorageTargets(namespace string) typedtargetsv1alpha1.GoogleCloudStorageTargetInterface {
+	return &wrapTargetsV1alpha1GoogleCloudStorageTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "azureservicebustopicsources",
+			Resource: "googlecloudstoragetargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl struct {
+type wrapTargetsV1alpha1GoogleCloudStorageTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.AzureServiceBusTopicSourceInterface = (*wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl)(nil)
+var _ typedtargetsv1alpha1.GoogleCloudStorageTargetInterface = (*wrapTargetsV1alpha1GoogleCloudStorageTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusTopicSource, opts v1.CreateOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudStorageTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusTopicSource",
+		Kind:    "GoogleCloudStorageTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3866,62 +15365,62 @@ func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Create(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.AzureServiceBusTopicSourceList, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudStorageTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusTopicSourceList{}
+	out := &targetsv1alpha1.GoogleCloudStorageTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.AzureServiceBusTopicSource, err error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudStorageTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusTopicSource",
+		Kind:    "GoogleCloudStorageTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3931,18 +15430,18 @@ func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Update(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.AzureServiceBusTopicSource, opts v1.UpdateOptions) (*sourcesv1alpha1.AzureServiceBusTopicSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "AzureServiceBusTopicSource",
+		Kind:    "GoogleCloudStorageTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3952,42 +15451,42 @@ func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) UpdateStatus(ctx con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.AzureServiceBusTopicSource{}
+	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1AzureServiceBusTopicSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) CloudEventsSources(namespace string) typedsourcesv1alpha1.CloudEventsSourceInterface {
-	return &wrapSourcesV1alpha1CloudEventsSourceImpl{
+func (w *wrapTargetsV1alpha1) GoogleCloudWorkflowsTargets(namespace string) typedtargetsv1alpha1.GoogleCloudWorkflowsTargetInterface {
+	return &wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "cloudeventssources",
+			Resource: "googlecloudworkflowstargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1CloudEventsSourceImpl struct {
+type wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.CloudEventsSourceInterface = (*wrapSourcesV1alpha1CloudEventsSourceImpl)(nil)
+var _ typedtargetsv1alpha1.GoogleCloudWorkflowsTargetInterface = (*wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.CloudEventsSource, opts v1.CreateOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudWorkflowsTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "CloudEventsSource",
+		Kind:    "GoogleCloudWorkflowsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -3997,62 +15496,62 @@ func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.CloudEventsSource{}
+	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.CloudEventsSource{}
+	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.CloudEventsSourceList, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.CloudEventsSourceList{}
+	out := &targetsv1alpha1.GoogleCloudWorkflowsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.CloudEventsSource, err error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudWorkflowsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.CloudEventsSource{}
+	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.CloudEventsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "CloudEventsSource",
+		Kind:    "GoogleCloudWorkflowsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4062,18 +15561,18 @@ func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.CloudEventsSource{}
+	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.CloudEventsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.CloudEventsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "CloudEventsSource",
+		Kind:    "GoogleCloudWorkflowsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4083,42 +15582,42 @@ func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.CloudEventsSource{}
+	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1CloudEventsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) GoogleCloudAuditLogsSources(namespace string) typedsourcesv1alpha1.GoogleCloudAuditLogsSourceInterface {
-	return &wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl{
+func (w *wrapTargetsV1alpha1) GoogleSheetTargets(namespace string) typedtargetsv1alpha1.GoogleSheetTargetInterface {
+	return &wrapTargetsV1alpha1GoogleSheetTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "googlecloudauditlogssources",
+			Resource: "googlesheettargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl struct {
+type wrapTargetsV1alpha1GoogleSheetTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.GoogleCloudAuditLogsSourceInterface = (*wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl)(nil)
+var _ typedtargetsv1alpha1.GoogleSheetTargetInterface = (*wrapTargetsV1alpha1GoogleSheetTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudAuditLogsSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleSheetTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudAuditLogsSource",
+		Kind:    "GoogleSheetTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4128,62 +15627,62 @@ func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Create(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	out := &targetsv1alpha1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	out := &targetsv1alpha1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSourceList, error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleSheetTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudAuditLogsSourceList{}
+	out := &targetsv1alpha1.GoogleSheetTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudAuditLogsSource, err error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleSheetTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	out := &targetsv1alpha1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleSheetTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudAuditLogsSource",
+		Kind:    "GoogleSheetTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4193,18 +15692,18 @@ func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Update(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	out := &targetsv1alpha1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudAuditLogsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudAuditLogsSource, error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleSheetTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudAuditLogsSource",
+		Kind:    "GoogleSheetTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4214,42 +15713,42 @@ func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) UpdateStatus(ctx con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudAuditLogsSource{}
+	out := &targetsv1alpha1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudAuditLogsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) GoogleCloudBillingSources(namespace string) typedsourcesv1alpha1.GoogleCloudBillingSourceInterface {
-	return &wrapSourcesV1alpha1GoogleCloudBillingSourceImpl{
+func (w *wrapTargetsV1alpha1) HTTPTargets(namespace string) typedtargetsv1alpha1.HTTPTargetInterface {
+	return &wrapTargetsV1alpha1HTTPTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "googlecloudbillingsources",
+			Resource: "httptargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1GoogleCloudBillingSourceImpl struct {
+type wrapTargetsV1alpha1HTTPTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.GoogleCloudBillingSourceInterface = (*wrapSourcesV1alpha1GoogleCloudBillingSourceImpl)(nil)
+var _ typedtargetsv1alpha1.HTTPTargetInterface = (*wrapTargetsV1alpha1HTTPTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudBillingSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.HTTPTarget, opts v1.CreateOptions) (*targetsv1alpha1.HTTPTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudBillingSource",
+		Kind:    "HTTPTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4259,62 +15758,62 @@ func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Create(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	out := &targetsv1alpha1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.HTTPTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	out := &targetsv1alpha1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudBillingSourceList, error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.HTTPTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudBillingSourceList{}
+	out := &targetsv1alpha1.HTTPTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudBillingSource, err error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.HTTPTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	out := &targetsv1alpha1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudBillingSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.HTTPTarget, opts v1.UpdateOptions) (*targetsv1alpha1.HTTPTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudBillingSource",
+		Kind:    "HTTPTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4324,18 +15823,18 @@ func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Update(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	out := &targetsv1alpha1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudBillingSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudBillingSource, error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.HTTPTarget, opts v1.UpdateOptions) (*targetsv1alpha1.HTTPTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudBillingSource",
+		Kind:    "HTTPTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4345,42 +15844,42 @@ func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) UpdateStatus(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudBillingSource{}
+	out := &targetsv1alpha1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudBillingSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1HTTPTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) GoogleCloudPubSubSources(namespace string) typedsourcesv1alpha1.GoogleCloudPubSubSourceInterface {
-	return &wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl{
+func (w *wrapTargetsV1alpha1) IBMMQTargets(namespace string) typedtargetsv1alpha1.IBMMQTargetInterface {
+	return &wrapTargetsV1alpha1IBMMQTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "googlecloudpubsubsources",
+			Resource: "ibmmqtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl struct {
+type wrapTargetsV1alpha1IBMMQTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.GoogleCloudPubSubSourceInterface = (*wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl)(nil)
+var _ typedtargetsv1alpha1.IBMMQTargetInterface = (*wrapTargetsV1alpha1IBMMQTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudPubSubSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.IBMMQTarget, opts v1.CreateOptions) (*targetsv1alpha1.IBMMQTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudPubSubSource",
+		Kind:    "IBMMQTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4390,62 +15889,62 @@ func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Create(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	out := &targetsv1alpha1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.IBMMQTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	out := &targetsv1alpha1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudPubSubSourceList, error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.IBMMQTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudPubSubSourceList{}
+	out := &targetsv1alpha1.IBMMQTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudPubSubSource, err error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.IBMMQTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	out := &targetsv1alpha1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.IBMMQTarget, opts v1.UpdateOptions) (*targetsv1alpha1.IBMMQTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudPubSubSource",
+		Kind:    "IBMMQTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4455,18 +15954,18 @@ func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Update(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	out := &targetsv1alpha1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudPubSubSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudPubSubSource, error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.IBMMQTarget, opts v1.UpdateOptions) (*targetsv1alpha1.IBMMQTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudPubSubSource",
+		Kind:    "IBMMQTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4476,42 +15975,42 @@ func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) UpdateStatus(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudPubSubSource{}
+	out := &targetsv1alpha1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudPubSubSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) GoogleCloudSourceRepositoriesSources(namespace string) typedsourcesv1alpha1.GoogleCloudSourceRepositoriesSourceInterface {
-	return &wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl{
+func (w *wrapTargetsV1alpha1) JiraTargets(namespace string) typedtargetsv1alpha1.JiraTargetInterface {
+	return &wrapTargetsV1alpha1JiraTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "googlecloudsourcerepositoriessources",
+			Resource: "jiratargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl struct {
+type wrapTargetsV1alpha1JiraTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.GoogleCloudSourceRepositoriesSourceInterface = (*wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl)(nil)
+var _ typedtargetsv1alpha1.JiraTargetInterface = (*wrapTargetsV1alpha1JiraTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.JiraTarget, opts v1.CreateOptions) (*targetsv1alpha1.JiraTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudSourceRepositoriesSource",
+		Kind:    "JiraTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4521,62 +16020,62 @@ func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Create(ctx
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	out := &targetsv1alpha1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.JiraTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	out := &targetsv1alpha1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSourceList, error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.JiraTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSourceList{}
+	out := &targetsv1alpha1.JiraTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, err error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.JiraTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	out := &targetsv1alpha1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.JiraTarget, opts v1.UpdateOptions) (*targetsv1alpha1.JiraTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudSourceRepositoriesSource",
+		Kind:    "JiraTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4586,18 +16085,18 @@ func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Update(ctx
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	out := &targetsv1alpha1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudSourceRepositoriesSource, error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.JiraTarget, opts v1.UpdateOptions) (*targetsv1alpha1.JiraTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudSourceRepositoriesSource",
+		Kind:    "JiraTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4607,42 +16106,42 @@ func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) UpdateStatu
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudSourceRepositoriesSource{}
+	out := &targetsv1alpha1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudSourceRepositoriesSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1JiraTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) GoogleCloudStorageSources(namespace string) typedsourcesv1alpha1.GoogleCloudStorageSourceInterface {
-	return &wrapSourcesV1alpha1GoogleCloudStorageSourceImpl{
+func (w *wrapTargetsV1alpha1) KafkaTargets(namespace string) typedtargetsv1alpha1.KafkaTargetInterface {
+	return &wrapTargetsV1alpha1KafkaTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "googlecloudstoragesources",
+			Resource: "kafkatargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1GoogleCloudStorageSourceImpl struct {
+type wrapTargetsV1alpha1KafkaTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.GoogleCloudStorageSourceInterface = (*wrapSourcesV1alpha1GoogleCloudStorageSourceImpl)(nil)
+var _ typedtargetsv1alpha1.KafkaTargetInterface = (*wrapTargetsV1alpha1KafkaTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.GoogleCloudStorageSource, opts v1.CreateOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.KafkaTarget, opts v1.CreateOptions) (*targetsv1alpha1.KafkaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudStorageSource",
+		Kind:    "KafkaTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4652,62 +16151,62 @@ func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Create(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	out := &targetsv1alpha1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.KafkaTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	out := &targetsv1alpha1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.GoogleCloudStorageSourceList, error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.KafkaTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudStorageSourceList{}
+	out := &targetsv1alpha1.KafkaTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.GoogleCloudStorageSource, err error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.KafkaTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	out := &targetsv1alpha1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.KafkaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.KafkaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudStorageSource",
+		Kind:    "KafkaTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4717,18 +16216,18 @@ func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Update(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	out := &targetsv1alpha1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.GoogleCloudStorageSource, opts v1.UpdateOptions) (*sourcesv1alpha1.GoogleCloudStorageSource, error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.KafkaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.KafkaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "GoogleCloudStorageSource",
+		Kind:    "KafkaTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4738,42 +16237,42 @@ func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) UpdateStatus(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.GoogleCloudStorageSource{}
+	out := &targetsv1alpha1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1GoogleCloudStorageSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1KafkaTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) HTTPPollerSources(namespace string) typedsourcesv1alpha1.HTTPPollerSourceInterface {
-	return &wrapSourcesV1alpha1HTTPPollerSourceImpl{
+func (w *wrapTargetsV1alpha1) LogzMetricsTargets(namespace string) typedtargetsv1alpha1.LogzMetricsTargetInterface {
+	return &wrapTargetsV1alpha1LogzMetricsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "httppollersources",
+			Resource: "logzmetricstargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1HTTPPollerSourceImpl struct {
+type wrapTargetsV1alpha1LogzMetricsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.HTTPPollerSourceInterface = (*wrapSourcesV1alpha1HTTPPollerSourceImpl)(nil)
+var _ typedtargetsv1alpha1.LogzMetricsTargetInterface = (*wrapTargetsV1alpha1LogzMetricsTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.HTTPPollerSource, opts v1.CreateOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.LogzMetricsTarget, opts v1.CreateOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "HTTPPollerSource",
+		Kind:    "LogzMetricsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4783,62 +16282,62 @@ func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.HTTPPollerSource{}
+	out := &targetsv1alpha1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.HTTPPollerSource{}
+	out := &targetsv1alpha1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.HTTPPollerSourceList, error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.LogzMetricsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.HTTPPollerSourceList{}
+	out := &targetsv1alpha1.LogzMetricsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.HTTPPollerSource, err error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.LogzMetricsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.HTTPPollerSource{}
+	out := &targetsv1alpha1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.HTTPPollerSource, opts v1.UpdateOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.LogzMetricsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "HTTPPollerSource",
+		Kind:    "LogzMetricsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4848,18 +16347,18 @@ func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.HTTPPollerSource{}
+	out := &targetsv1alpha1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.HTTPPollerSource, opts v1.UpdateOptions) (*sourcesv1alpha1.HTTPPollerSource, error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.LogzMetricsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "HTTPPollerSource",
+		Kind:    "LogzMetricsTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4869,42 +16368,42 @@ func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) UpdateStatus(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.HTTPPollerSource{}
+	out := &targetsv1alpha1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1HTTPPollerSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) IBMMQSources(namespace string) typedsourcesv1alpha1.IBMMQSourceInterface {
-	return &wrapSourcesV1alpha1IBMMQSourceImpl{
+func (w *wrapTargetsV1alpha1) LogzTargets(namespace string) typedtargetsv1alpha1.LogzTargetInterface {
+	return &wrapTargetsV1alpha1LogzTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "ibmmqsources",
+			Resource: "logztargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1IBMMQSourceImpl struct {
+type wrapTargetsV1alpha1LogzTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.IBMMQSourceInterface = (*wrapSourcesV1alpha1IBMMQSourceImpl)(nil)
+var _ typedtargetsv1alpha1.LogzTargetInterface = (*wrapTargetsV1alpha1LogzTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.IBMMQSource, opts v1.CreateOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.LogzTarget, opts v1.CreateOptions) (*targetsv1alpha1.LogzTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "IBMMQSource",
+		Kind:    "LogzTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4914,62 +16413,62 @@ func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Create(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.IBMMQSource{}
+	out := &targetsv1alpha1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.LogzTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.IBMMQSource{}
+	out := &targetsv1alpha1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.IBMMQSourceList, error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.LogzTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.IBMMQSourceList{}
+	out := &targetsv1alpha1.LogzTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.IBMMQSource, err error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.LogzTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.IBMMQSource{}
+	out := &targetsv1alpha1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.IBMMQSource, opts v1.UpdateOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.LogzTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "IBMMQSource",
+		Kind:    "LogzTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -4979,18 +16478,18 @@ func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Update(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.IBMMQSource{}
+	out := &targetsv1alpha1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.IBMMQSource, opts v1.UpdateOptions) (*sourcesv1alpha1.IBMMQSource, error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.LogzTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "IBMMQSource",
+		Kind:    "LogzTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5000,42 +16499,42 @@ func (w *wrapSourcesV1alpha1IBMMQSourceImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.IBMMQSource{}
+	out := &targetsv1alpha1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1IBMMQSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1LogzTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) KafkaSources(namespace string) typedsourcesv1alpha1.KafkaSourceInterface {
-	return &wrapSourcesV1alpha1KafkaSourceImpl{
+func (w *wrapTargetsV1alpha1) MongoDBTargets(namespace string) typedtargetsv1alpha1.MongoDBTargetInterface {
+	return &wrapTargetsV1alpha1MongoDBTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "kafkasources",
+			Resource: "mongodbtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1KafkaSourceImpl struct {
+type wrapTargetsV1alpha1MongoDBTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.KafkaSourceInterface = (*wrapSourcesV1alpha1KafkaSourceImpl)(nil)
+var _ typedtargetsv1alpha1.MongoDBTargetInterface = (*wrapTargetsV1alpha1MongoDBTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.KafkaSource, opts v1.CreateOptions) (*sourcesv1alpha1.KafkaSource, error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.MongoDBTarget, opts v1.CreateOptions) (*targetsv1alpha1.MongoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "KafkaSource",
+		Kind:    "MongoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5045,62 +16544,62 @@ func (w *wrapSourcesV1alpha1KafkaSourceImpl) Create(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.KafkaSource{}
+	out := &targetsv1alpha1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.KafkaSource, error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.MongoDBTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.KafkaSource{}
+	out := &targetsv1alpha1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.KafkaSourceList, error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.MongoDBTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.KafkaSourceList{}
+	out := &targetsv1alpha1.MongoDBTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.KafkaSource, err error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.MongoDBTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.KafkaSource{}
+	out := &targetsv1alpha1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.KafkaSource, opts v1.UpdateOptions) (*sourcesv1alpha1.KafkaSource, error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.MongoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.MongoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "KafkaSource",
+		Kind:    "MongoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5110,18 +16609,18 @@ func (w *wrapSourcesV1alpha1KafkaSourceImpl) Update(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.KafkaSource{}
+	out := &targetsv1alpha1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.KafkaSource, opts v1.UpdateOptions) (*sourcesv1alpha1.KafkaSource, error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.MongoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.MongoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "KafkaSource",
+		Kind:    "MongoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5131,42 +16630,42 @@ func (w *wrapSourcesV1alpha1KafkaSourceImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.KafkaSource{}
+	out := &targetsv1alpha1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1KafkaSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) MongoDBSources(namespace string) typedsourcesv1alpha1.MongoDBSourceInterface {
-	return &wrapSourcesV1alpha1MongoDBSourceImpl{
+func (w *wrapTargetsV1alpha1) OracleTargets(namespace string) typedtargetsv1alpha1.OracleTargetInterface {
+	return &wrapTargetsV1alpha1OracleTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "mongodbsources",
+			Resource: "oracletargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1MongoDBSourceImpl struct {
+type wrapTargetsV1alpha1OracleTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.MongoDBSourceInterface = (*wrapSourcesV1alpha1MongoDBSourceImpl)(nil)
+var _ typedtargetsv1alpha1.OracleTargetInterface = (*wrapTargetsV1alpha1OracleTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.MongoDBSource, opts v1.CreateOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.OracleTarget, opts v1.CreateOptions) (*targetsv1alpha1.OracleTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "MongoDBSource",
+		Kind:    "OracleTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5176,62 +16675,62 @@ func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Create(ctx context.Context, in *s
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.MongoDBSource{}
+	out := &targetsv1alpha1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.OracleTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.MongoDBSource{}
+	out := &targetsv1alpha1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.MongoDBSourceList, error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.OracleTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.MongoDBSourceList{}
+	out := &targetsv1alpha1.OracleTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.MongoDBSource, err error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.OracleTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.MongoDBSource{}
+	out := &targetsv1alpha1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.MongoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.OracleTarget, opts v1.UpdateOptions) (*targetsv1alpha1.OracleTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "MongoDBSource",
+		Kind:    "OracleTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5241,18 +16740,18 @@ func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Update(ctx context.Context, in *s
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.MongoDBSource{}
+	out := &targetsv1alpha1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.MongoDBSource, opts v1.UpdateOptions) (*sourcesv1alpha1.MongoDBSource, error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.OracleTarget, opts v1.UpdateOptions) (*targetsv1alpha1.OracleTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "MongoDBSource",
+		Kind:    "OracleTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5262,42 +16761,42 @@ func (w *wrapSourcesV1alpha1MongoDBSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.MongoDBSource{}
+	out := &targetsv1alpha1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1MongoDBSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1OracleTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) OCIMetricsSources(namespace string) typedsourcesv1alpha1.OCIMetricsSourceInterface {
-	return &wrapSourcesV1alpha1OCIMetricsSourceImpl{
+func (w *wrapTargetsV1alpha1) SalesforceTargets(namespace string) typedtargetsv1alpha1.SalesforceTargetInterface {
+	return &wrapTargetsV1alpha1SalesforceTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "ocimetricssources",
+			Resource: "salesforcetargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1OCIMetricsSourceImpl struct {
+type wrapTargetsV1alpha1SalesforceTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.OCIMetricsSourceInterface = (*wrapSourcesV1alpha1OCIMetricsSourceImpl)(nil)
+var _ typedtargetsv1alpha1.SalesforceTargetInterface = (*wrapTargetsV1alpha1SalesforceTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.OCIMetricsSource, opts v1.CreateOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SalesforceTarget, opts v1.CreateOptions) (*targetsv1alpha1.SalesforceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "OCIMetricsSource",
+		Kind:    "SalesforceTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5307,62 +16806,62 @@ func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.OCIMetricsSource{}
+	out := &targetsv1alpha1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SalesforceTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.OCIMetricsSource{}
+	out := &targetsv1alpha1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.OCIMetricsSourceList, error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SalesforceTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.OCIMetricsSourceList{}
+	out := &targetsv1alpha1.SalesforceTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.OCIMetricsSource, err error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SalesforceTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.OCIMetricsSource{}
+	out := &targetsv1alpha1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.OCIMetricsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SalesforceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SalesforceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "OCIMetricsSource",
+		Kind:    "SalesforceTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5372,18 +16871,18 @@ func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.OCIMetricsSource{}
+	out := &targetsv1alpha1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.OCIMetricsSource, opts v1.UpdateOptions) (*sourcesv1alpha1.OCIMetricsSource, error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SalesforceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SalesforceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "OCIMetricsSource",
+		Kind:    "SalesforceTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5393,42 +16892,42 @@ func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) UpdateStatus(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.OCIMetricsSource{}
+	out := &targetsv1alpha1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1OCIMetricsSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) SalesforceSources(namespace string) typedsourcesv1alpha1.SalesforceSourceInterface {
-	return &wrapSourcesV1alpha1SalesforceSourceImpl{
+func (w *wrapTargetsV1alpha1) SendGridTargets(namespace string) typedtargetsv1alpha1.SendGridTargetInterface {
+	return &wrapTargetsV1alpha1SendGridTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "salesforcesources",
+			Resource: "sendgridtargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1SalesforceSourceImpl struct {
+type wrapTargetsV1alpha1SendGridTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.SalesforceSourceInterface = (*wrapSourcesV1alpha1SalesforceSourceImpl)(nil)
+var _ typedtargetsv1alpha1.SendGridTargetInterface = (*wrapTargetsV1alpha1SendGridTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.SalesforceSource, opts v1.CreateOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SendGridTarget, opts v1.CreateOptions) (*targetsv1alpha1.SendGridTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SalesforceSource",
+		Kind:    "SendGridTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5438,62 +16937,62 @@ func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SalesforceSource{}
+	out := &targetsv1alpha1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SendGridTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SalesforceSource{}
+	out := &targetsv1alpha1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.SalesforceSourceList, error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SendGridTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SalesforceSourceList{}
+	out := &targetsv1alpha1.SendGridTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.SalesforceSource, err error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SendGridTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SalesforceSource{}
+	out := &targetsv1alpha1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.SalesforceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SendGridTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SendGridTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SalesforceSource",
+		Kind:    "SendGridTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5503,18 +17002,18 @@ func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SalesforceSource{}
+	out := &targetsv1alpha1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.SalesforceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SalesforceSource, error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SendGridTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SendGridTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SalesforceSource",
+		Kind:    "SendGridTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5524,42 +17023,42 @@ func (w *wrapSourcesV1alpha1SalesforceSourceImpl) UpdateStatus(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SalesforceSource{}
+	out := &targetsv1alpha1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SalesforceSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1SendGridTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) SlackSources(namespace string) typedsourcesv1alpha1.SlackSourceInterface {
-	return &wrapSourcesV1alpha1SlackSourceImpl{
+func (w *wrapTargetsV1alpha1) SlackTargets(namespace string) typedtargetsv1alpha1.SlackTargetInterface {
+	return &wrapTargetsV1alpha1SlackTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "slacksources",
+			Resource: "slacktargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1SlackSourceImpl struct {
+type wrapTargetsV1alpha1SlackTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.SlackSourceInterface = (*wrapSourcesV1alpha1SlackSourceImpl)(nil)
+var _ typedtargetsv1alpha1.SlackTargetInterface = (*wrapTargetsV1alpha1SlackTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.SlackSource, opts v1.CreateOptions) (*sourcesv1alpha1.SlackSource, error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SlackTarget, opts v1.CreateOptions) (*targetsv1alpha1.SlackTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SlackSource",
+		Kind:    "SlackTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5569,62 +17068,62 @@ func (w *wrapSourcesV1alpha1SlackSourceImpl) Create(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SlackSource{}
+	out := &targetsv1alpha1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.SlackSource, error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SlackTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SlackSource{}
+	out := &targetsv1alpha1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.SlackSourceList, error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SlackTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SlackSourceList{}
+	out := &targetsv1alpha1.SlackTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.SlackSource, err error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SlackTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SlackSource{}
+	out := &targetsv1alpha1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.SlackSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SlackSource, error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SlackTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SlackTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SlackSource",
+		Kind:    "SlackTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5634,18 +17133,18 @@ func (w *wrapSourcesV1alpha1SlackSourceImpl) Update(ctx context.Context, in *sou
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SlackSource{}
+	out := &targetsv1alpha1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.SlackSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SlackSource, error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SlackTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SlackTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SlackSource",
+		Kind:    "SlackTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5655,42 +17154,42 @@ func (w *wrapSourcesV1alpha1SlackSourceImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SlackSource{}
+	out := &targetsv1alpha1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SlackSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1SlackTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) SolaceSources(namespace string) typedsourcesv1alpha1.SolaceSourceInterface {
-	return &wrapSourcesV1alpha1SolaceSourceImpl{
+func (w *wrapTargetsV1alpha1) SolaceTargets(namespace string) typedtargetsv1alpha1.SolaceTargetInterface {
+	return &wrapTargetsV1alpha1SolaceTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "solacesources",
+			Resource: "solacetargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1SolaceSourceImpl struct {
+type wrapTargetsV1alpha1SolaceTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.SolaceSourceInterface = (*wrapSourcesV1alpha1SolaceSourceImpl)(nil)
+var _ typedtargetsv1alpha1.SolaceTargetInterface = (*wrapTargetsV1alpha1SolaceTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.SolaceSource, opts v1.CreateOptions) (*sourcesv1alpha1.SolaceSource, error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SolaceTarget, opts v1.CreateOptions) (*targetsv1alpha1.SolaceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SolaceSource",
+		Kind:    "SolaceTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5700,62 +17199,62 @@ func (w *wrapSourcesV1alpha1SolaceSourceImpl) Create(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SolaceSource{}
+	out := &targetsv1alpha1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.SolaceSource, error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SolaceTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SolaceSource{}
+	out := &targetsv1alpha1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.SolaceSourceList, error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SolaceTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SolaceSourceList{}
+	out := &targetsv1alpha1.SolaceTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.SolaceSource, err error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SolaceTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SolaceSource{}
+	out := &targetsv1alpha1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.SolaceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SolaceSource, error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SolaceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SolaceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SolaceSource",
+		Kind:    "SolaceTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5765,18 +17264,18 @@ func (w *wrapSourcesV1alpha1SolaceSourceImpl) Update(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SolaceSource{}
+	out := &targetsv1alpha1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.SolaceSource, opts v1.UpdateOptions) (*sourcesv1alpha1.SolaceSource, error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SolaceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SolaceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "SolaceSource",
+		Kind:    "SolaceTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5786,42 +17285,42 @@ func (w *wrapSourcesV1alpha1SolaceSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.SolaceSource{}
+	out := &targetsv1alpha1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1SolaceSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1SolaceTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) TwilioSources(namespace string) typedsourcesv1alpha1.TwilioSourceInterface {
-	return &wrapSourcesV1alpha1TwilioSourceImpl{
+func (w *wrapTargetsV1alpha1) SplunkTargets(namespace string) typedtargetsv1alpha1.SplunkTargetInterface {
+	return &wrapTargetsV1alpha1SplunkTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "twiliosources",
+			Resource: "splunktargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1TwilioSourceImpl struct {
+type wrapTargetsV1alpha1SplunkTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.TwilioSourceInterface = (*wrapSourcesV1alpha1TwilioSourceImpl)(nil)
+var _ typedtargetsv1alpha1.SplunkTargetInterface = (*wrapTargetsV1alpha1SplunkTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.TwilioSource, opts v1.CreateOptions) (*sourcesv1alpha1.TwilioSource, error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SplunkTarget, opts v1.CreateOptions) (*targetsv1alpha1.SplunkTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "TwilioSource",
+		Kind:    "SplunkTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5831,62 +17330,62 @@ func (w *wrapSourcesV1alpha1TwilioSourceImpl) Create(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.TwilioSource{}
+	out := &targetsv1alpha1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.TwilioSource, error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SplunkTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.TwilioSource{}
+	out := &targetsv1alpha1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.TwilioSourceList, error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SplunkTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.TwilioSourceList{}
+	out := &targetsv1alpha1.SplunkTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.TwilioSource, err error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SplunkTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.TwilioSource{}
+	out := &targetsv1alpha1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.TwilioSource, opts v1.UpdateOptions) (*sourcesv1alpha1.TwilioSource, error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SplunkTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SplunkTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "TwilioSource",
+		Kind:    "SplunkTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5896,18 +17395,18 @@ func (w *wrapSourcesV1alpha1TwilioSourceImpl) Update(ctx context.Context, in *so
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.TwilioSource{}
+	out := &targetsv1alpha1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.TwilioSource, opts v1.UpdateOptions) (*sourcesv1alpha1.TwilioSource, error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SplunkTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SplunkTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "TwilioSource",
+		Kind:    "SplunkTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5917,42 +17416,42 @@ func (w *wrapSourcesV1alpha1TwilioSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.TwilioSource{}
+	out := &targetsv1alpha1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1TwilioSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1SplunkTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) WebhookSources(namespace string) typedsourcesv1alpha1.WebhookSourceInterface {
-	return &wrapSourcesV1alpha1WebhookSourceImpl{
+func (w *wrapTargetsV1alpha1) TwilioTargets(namespace string) typedtargetsv1alpha1.TwilioTargetInterface {
+	return &wrapTargetsV1alpha1TwilioTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "webhooksources",
+			Resource: "twiliotargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1WebhookSourceImpl struct {
+type wrapTargetsV1alpha1TwilioTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.WebhookSourceInterface = (*wrapSourcesV1alpha1WebhookSourceImpl)(nil)
+var _ typedtargetsv1alpha1.TwilioTargetInterface = (*wrapTargetsV1alpha1TwilioTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.WebhookSource, opts v1.CreateOptions) (*sourcesv1alpha1.WebhookSource, error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.TwilioTarget, opts v1.CreateOptions) (*targetsv1alpha1.TwilioTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "WebhookSource",
+		Kind:    "TwilioTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -5962,62 +17461,62 @@ func (w *wrapSourcesV1alpha1WebhookSourceImpl) Create(ctx context.Context, in *s
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.WebhookSource{}
+	out := &targetsv1alpha1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.WebhookSource, error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.TwilioTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.WebhookSource{}
+	out := &targetsv1alpha1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.WebhookSourceList, error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.TwilioTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.WebhookSourceList{}
+	out := &targetsv1alpha1.TwilioTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.WebhookSource, err error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.TwilioTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.WebhookSource{}
+	out := &targetsv1alpha1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.WebhookSource, opts v1.UpdateOptions) (*sourcesv1alpha1.WebhookSource, error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.TwilioTarget, opts v1.UpdateOptions) (*targetsv1alpha1.TwilioTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "WebhookSource",
+		Kind:    "TwilioTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -6027,18 +17526,18 @@ func (w *wrapSourcesV1alpha1WebhookSourceImpl) Update(ctx context.Context, in *s
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.WebhookSource{}
+	out := &targetsv1alpha1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.WebhookSource, opts v1.UpdateOptions) (*sourcesv1alpha1.WebhookSource, error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.TwilioTarget, opts v1.UpdateOptions) (*targetsv1alpha1.TwilioTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "WebhookSource",
+		Kind:    "TwilioTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -6048,42 +17547,42 @@ func (w *wrapSourcesV1alpha1WebhookSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.WebhookSource{}
+	out := &targetsv1alpha1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1WebhookSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1TwilioTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapSourcesV1alpha1) ZendeskSources(namespace string) typedsourcesv1alpha1.ZendeskSourceInterface {
-	return &wrapSourcesV1alpha1ZendeskSourceImpl{
+func (w *wrapTargetsV1alpha1) ZendeskTargets(namespace string) typedtargetsv1alpha1.ZendeskTargetInterface {
+	return &wrapTargetsV1alpha1ZendeskTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
-			Group:    "sources.triggermesh.io",
+			Group:    "targets.triggermesh.io",
 			Version:  "v1alpha1",
-			Resource: "zendesksources",
+			Resource: "zendesktargets",
 		}),
 
 		namespace: namespace,
 	}
 }
 
-type wrapSourcesV1alpha1ZendeskSourceImpl struct {
+type wrapTargetsV1alpha1ZendeskTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedsourcesv1alpha1.ZendeskSourceInterface = (*wrapSourcesV1alpha1ZendeskSourceImpl)(nil)
+var _ typedtargetsv1alpha1.ZendeskTargetInterface = (*wrapTargetsV1alpha1ZendeskTargetImpl)(nil)
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Create(ctx context.Context, in *sourcesv1alpha1.ZendeskSource, opts v1.CreateOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.ZendeskTarget, opts v1.CreateOptions) (*targetsv1alpha1.ZendeskTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "ZendeskSource",
+		Kind:    "ZendeskTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -6093,62 +17592,62 @@ func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Create(ctx context.Context, in *s
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.ZendeskSource{}
+	out := &targetsv1alpha1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.ZendeskTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.ZendeskSource{}
+	out := &targetsv1alpha1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) List(ctx context.Context, opts v1.ListOptions) (*sourcesv1alpha1.ZendeskSourceList, error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.ZendeskTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.ZendeskSourceList{}
+	out := &targetsv1alpha1.ZendeskTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *sourcesv1alpha1.ZendeskSource, err error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.ZendeskTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.ZendeskSource{}
+	out := &targetsv1alpha1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Update(ctx context.Context, in *sourcesv1alpha1.ZendeskSource, opts v1.UpdateOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.ZendeskTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ZendeskTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "ZendeskSource",
+		Kind:    "ZendeskTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -6158,18 +17657,18 @@ func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Update(ctx context.Context, in *s
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.ZendeskSource{}
+	out := &targetsv1alpha1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) UpdateStatus(ctx context.Context, in *sourcesv1alpha1.ZendeskSource, opts v1.UpdateOptions) (*sourcesv1alpha1.ZendeskSource, error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.ZendeskTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ZendeskTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "sources.triggermesh.io",
+		Group:   "targets.triggermesh.io",
 		Version: "v1alpha1",
-		Kind:    "ZendeskSource",
+		Kind:    "ZendeskTarget",
 	})
 	uo := &unstructured.Unstructured{}
 	if err := convert(in, uo); err != nil {
@@ -6179,37 +17678,37 @@ func (w *wrapSourcesV1alpha1ZendeskSourceImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &sourcesv1alpha1.ZendeskSource{}
+	out := &targetsv1alpha1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapSourcesV1alpha1ZendeskSourceImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-// TargetsV1alpha1 retrieves the TargetsV1alpha1Client
-func (w *wrapClient) TargetsV1alpha1() typedtargetsv1alpha1.TargetsV1alpha1Interface {
-	return &wrapTargetsV1alpha1{
+// TargetsV1beta1 retrieves the TargetsV1beta1Client
+func (w *wrapClient) TargetsV1beta1() typedtargetsv1beta1.TargetsV1beta1Interface {
+	return &wrapTargetsV1beta1{
 		dyn: w.dyn,
 	}
 }
 
-type wrapTargetsV1alpha1 struct {
+type wrapTargetsV1beta1 struct {
 	dyn dynamic.Interface
 }
 
-func (w *wrapTargetsV1alpha1) RESTClient() rest.Interface {
+func (w *wrapTargetsV1beta1) RESTClient() rest.Interface {
 	panic("RESTClient called on dynamic client!")
 }
 
-func (w *wrapTargetsV1alpha1) AWSComprehendTargets(namespace string) typedtargetsv1alpha1.AWSComprehendTargetInterface {
-	return &wrapTargetsV1alpha1AWSComprehendTargetImpl{
+func (w *wrapTargetsV1beta1) AWSComprehendTargets(namespace string) typedtargetsv1beta1.AWSComprehendTargetInterface {
+	return &wrapTargetsV1beta1AWSComprehendTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awscomprehendtargets",
 		}),
 
@@ -6217,18 +17716,18 @@ func (w *wrapTargetsV1alpha1) AWSComprehendTargets(namespace string) typedtarget
 	}
 }
 
-type wrapTargetsV1alpha1AWSComprehendTargetImpl struct {
+type wrapTargetsV1beta1AWSComprehendTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSComprehendTargetInterface = (*wrapTargetsV1alpha1AWSComprehendTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSComprehendTargetInterface = (*wrapTargetsV1beta1AWSComprehendTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSComprehendTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSComprehendTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSComprehendTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSComprehendTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6239,61 +17738,61 @@ func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Create(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSComprehendTarget{}
+	out := &targetsv1beta1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSComprehendTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSComprehendTarget{}
+	out := &targetsv1beta1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSComprehendTargetList, error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSComprehendTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSComprehendTargetList{}
+	out := &targetsv1beta1.AWSComprehendTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSComprehendTarget, err error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSComprehendTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSComprehendTarget{}
+	out := &targetsv1beta1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSComprehendTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSComprehendTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSComprehendTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSComprehendTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6304,17 +17803,17 @@ func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Update(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSComprehendTarget{}
+	out := &targetsv1beta1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSComprehendTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSComprehendTarget, error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSComprehendTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSComprehendTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSComprehendTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6325,22 +17824,22 @@ func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) UpdateStatus(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSComprehendTarget{}
+	out := &targetsv1beta1.AWSComprehendTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSComprehendTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSComprehendTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSDynamoDBTargets(namespace string) typedtargetsv1alpha1.AWSDynamoDBTargetInterface {
-	return &wrapTargetsV1alpha1AWSDynamoDBTargetImpl{
+func (w *wrapTargetsV1beta1) AWSDynamoDBTargets(namespace string) typedtargetsv1beta1.AWSDynamoDBTargetInterface {
+	return &wrapTargetsV1beta1AWSDynamoDBTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awsdynamodbtargets",
 		}),
 
@@ -6348,18 +17847,18 @@ func (w *wrapTargetsV1alpha1) AWSDynamoDBTargets(namespace string) typedtargetsv
 	}
 }
 
-type wrapTargetsV1alpha1AWSDynamoDBTargetImpl struct {
+type wrapTargetsV1beta1AWSDynamoDBTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSDynamoDBTargetInterface = (*wrapTargetsV1alpha1AWSDynamoDBTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSDynamoDBTargetInterface = (*wrapTargetsV1beta1AWSDynamoDBTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSDynamoDBTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSDynamoDBTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSDynamoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSDynamoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6370,61 +17869,61 @@ func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSDynamoDBTarget{}
+	out := &targetsv1beta1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSDynamoDBTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSDynamoDBTarget{}
+	out := &targetsv1beta1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSDynamoDBTargetList, error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSDynamoDBTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSDynamoDBTargetList{}
+	out := &targetsv1beta1.AWSDynamoDBTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSDynamoDBTarget, err error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSDynamoDBTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSDynamoDBTarget{}
+	out := &targetsv1beta1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSDynamoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSDynamoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6435,17 +17934,17 @@ func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSDynamoDBTarget{}
+	out := &targetsv1beta1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSDynamoDBTarget, error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSDynamoDBTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSDynamoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSDynamoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6456,22 +17955,22 @@ func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSDynamoDBTarget{}
+	out := &targetsv1beta1.AWSDynamoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSDynamoDBTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSDynamoDBTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSEventBridgeTargets(namespace string) typedtargetsv1alpha1.AWSEventBridgeTargetInterface {
-	return &wrapTargetsV1alpha1AWSEventBridgeTargetImpl{
+func (w *wrapTargetsV1beta1) AWSEventBridgeTargets(namespace string) typedtargetsv1beta1.AWSEventBridgeTargetInterface {
+	return &wrapTargetsV1beta1AWSEventBridgeTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awseventbridgetargets",
 		}),
 
@@ -6479,18 +17978,18 @@ func (w *wrapTargetsV1alpha1) AWSEventBridgeTargets(namespace string) typedtarge
 	}
 }
 
-type wrapTargetsV1alpha1AWSEventBridgeTargetImpl struct {
+type wrapTargetsV1beta1AWSEventBridgeTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSEventBridgeTargetInterface = (*wrapTargetsV1alpha1AWSEventBridgeTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSEventBridgeTargetInterface = (*wrapTargetsV1beta1AWSEventBridgeTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSEventBridgeTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSEventBridgeTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSEventBridgeTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSEventBridgeTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6501,61 +18000,61 @@ func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Create(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSEventBridgeTarget{}
+	out := &targetsv1beta1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSEventBridgeTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSEventBridgeTarget{}
+	out := &targetsv1beta1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSEventBridgeTargetList, error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSEventBridgeTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSEventBridgeTargetList{}
+	out := &targetsv1beta1.AWSEventBridgeTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSEventBridgeTarget, err error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSEventBridgeTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSEventBridgeTarget{}
+	out := &targetsv1beta1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSEventBridgeTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSEventBridgeTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSEventBridgeTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSEventBridgeTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6566,17 +18065,17 @@ func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Update(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSEventBridgeTarget{}
+	out := &targetsv1beta1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSEventBridgeTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSEventBridgeTarget, error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSEventBridgeTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSEventBridgeTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSEventBridgeTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6587,22 +18086,22 @@ func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) UpdateStatus(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSEventBridgeTarget{}
+	out := &targetsv1beta1.AWSEventBridgeTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSEventBridgeTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSEventBridgeTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSKinesisTargets(namespace string) typedtargetsv1alpha1.AWSKinesisTargetInterface {
-	return &wrapTargetsV1alpha1AWSKinesisTargetImpl{
+func (w *wrapTargetsV1beta1) AWSKinesisTargets(namespace string) typedtargetsv1beta1.AWSKinesisTargetInterface {
+	return &wrapTargetsV1beta1AWSKinesisTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awskinesistargets",
 		}),
 
@@ -6610,18 +18109,18 @@ func (w *wrapTargetsV1alpha1) AWSKinesisTargets(namespace string) typedtargetsv1
 	}
 }
 
-type wrapTargetsV1alpha1AWSKinesisTargetImpl struct {
+type wrapTargetsV1beta1AWSKinesisTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSKinesisTargetInterface = (*wrapTargetsV1alpha1AWSKinesisTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSKinesisTargetInterface = (*wrapTargetsV1beta1AWSKinesisTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSKinesisTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSKinesisTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSKinesisTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSKinesisTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6632,61 +18131,61 @@ func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSKinesisTarget{}
+	out := &targetsv1beta1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSKinesisTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSKinesisTarget{}
+	out := &targetsv1beta1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSKinesisTargetList, error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSKinesisTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSKinesisTargetList{}
+	out := &targetsv1beta1.AWSKinesisTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSKinesisTarget, err error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSKinesisTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSKinesisTarget{}
+	out := &targetsv1beta1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSKinesisTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSKinesisTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSKinesisTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSKinesisTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6697,17 +18196,17 @@ func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSKinesisTarget{}
+	out := &targetsv1beta1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSKinesisTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSKinesisTarget, error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSKinesisTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSKinesisTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSKinesisTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6718,22 +18217,22 @@ func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) UpdateStatus(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSKinesisTarget{}
+	out := &targetsv1beta1.AWSKinesisTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSKinesisTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSKinesisTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSLambdaTargets(namespace string) typedtargetsv1alpha1.AWSLambdaTargetInterface {
-	return &wrapTargetsV1alpha1AWSLambdaTargetImpl{
+func (w *wrapTargetsV1beta1) AWSLambdaTargets(namespace string) typedtargetsv1beta1.AWSLambdaTargetInterface {
+	return &wrapTargetsV1beta1AWSLambdaTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awslambdatargets",
 		}),
 
@@ -6741,18 +18240,18 @@ func (w *wrapTargetsV1alpha1) AWSLambdaTargets(namespace string) typedtargetsv1a
 	}
 }
 
-type wrapTargetsV1alpha1AWSLambdaTargetImpl struct {
+type wrapTargetsV1beta1AWSLambdaTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSLambdaTargetInterface = (*wrapTargetsV1alpha1AWSLambdaTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSLambdaTargetInterface = (*wrapTargetsV1beta1AWSLambdaTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSLambdaTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSLambdaTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSLambdaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSLambdaTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6763,61 +18262,61 @@ func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSLambdaTarget{}
+	out := &targetsv1beta1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSLambdaTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSLambdaTarget{}
+	out := &targetsv1beta1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSLambdaTargetList, error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSLambdaTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSLambdaTargetList{}
+	out := &targetsv1beta1.AWSLambdaTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSLambdaTarget, err error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSLambdaTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSLambdaTarget{}
+	out := &targetsv1beta1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSLambdaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSLambdaTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSLambdaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSLambdaTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6828,17 +18327,17 @@ func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSLambdaTarget{}
+	out := &targetsv1beta1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSLambdaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSLambdaTarget, error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSLambdaTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSLambdaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSLambdaTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6849,22 +18348,22 @@ func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) UpdateStatus(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSLambdaTarget{}
+	out := &targetsv1beta1.AWSLambdaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSLambdaTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSLambdaTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSS3Targets(namespace string) typedtargetsv1alpha1.AWSS3TargetInterface {
-	return &wrapTargetsV1alpha1AWSS3TargetImpl{
+func (w *wrapTargetsV1beta1) AWSS3Targets(namespace string) typedtargetsv1beta1.AWSS3TargetInterface {
+	return &wrapTargetsV1beta1AWSS3TargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awss3targets",
 		}),
 
@@ -6872,18 +18371,18 @@ func (w *wrapTargetsV1alpha1) AWSS3Targets(namespace string) typedtargetsv1alpha
 	}
 }
 
-type wrapTargetsV1alpha1AWSS3TargetImpl struct {
+type wrapTargetsV1beta1AWSS3TargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSS3TargetInterface = (*wrapTargetsV1alpha1AWSS3TargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSS3TargetInterface = (*wrapTargetsV1beta1AWSS3TargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSS3Target, opts v1.CreateOptions) (*targetsv1alpha1.AWSS3Target, error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSS3Target, opts v1.CreateOptions) (*targetsv1beta1.AWSS3Target, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSS3Target",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6894,61 +18393,61 @@ func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Create(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSS3Target{}
+	out := &targetsv1beta1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSS3Target, error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSS3Target, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSS3Target{}
+	out := &targetsv1beta1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSS3TargetList, error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSS3TargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSS3TargetList{}
+	out := &targetsv1beta1.AWSS3TargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSS3Target, err error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSS3Target, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSS3Target{}
+	out := &targetsv1beta1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSS3Target, opts v1.UpdateOptions) (*targetsv1alpha1.AWSS3Target, error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSS3Target, opts v1.UpdateOptions) (*targetsv1beta1.AWSS3Target, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSS3Target",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6959,17 +18458,17 @@ func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Update(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSS3Target{}
+	out := &targetsv1beta1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSS3Target, opts v1.UpdateOptions) (*targetsv1alpha1.AWSS3Target, error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSS3Target, opts v1.UpdateOptions) (*targetsv1beta1.AWSS3Target, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSS3Target",
 	})
 	uo := &unstructured.Unstructured{}
@@ -6980,22 +18479,22 @@ func (w *wrapTargetsV1alpha1AWSS3TargetImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSS3Target{}
+	out := &targetsv1beta1.AWSS3Target{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSS3TargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSS3TargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSSNSTargets(namespace string) typedtargetsv1alpha1.AWSSNSTargetInterface {
-	return &wrapTargetsV1alpha1AWSSNSTargetImpl{
+func (w *wrapTargetsV1beta1) AWSSNSTargets(namespace string) typedtargetsv1beta1.AWSSNSTargetInterface {
+	return &wrapTargetsV1beta1AWSSNSTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awssnstargets",
 		}),
 
@@ -7003,18 +18502,18 @@ func (w *wrapTargetsV1alpha1) AWSSNSTargets(namespace string) typedtargetsv1alph
 	}
 }
 
-type wrapTargetsV1alpha1AWSSNSTargetImpl struct {
+type wrapTargetsV1beta1AWSSNSTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSSNSTargetInterface = (*wrapTargetsV1alpha1AWSSNSTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSSNSTargetInterface = (*wrapTargetsV1beta1AWSSNSTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSSNSTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSSNSTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSSNSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSSNSTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7025,61 +18524,61 @@ func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Create(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSNSTarget{}
+	out := &targetsv1beta1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSSNSTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSNSTarget{}
+	out := &targetsv1beta1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSSNSTargetList, error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSSNSTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSNSTargetList{}
+	out := &targetsv1beta1.AWSSNSTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSSNSTarget, err error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSSNSTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSNSTarget{}
+	out := &targetsv1beta1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSSNSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSSNSTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSSNSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSSNSTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7090,17 +18589,17 @@ func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Update(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSNSTarget{}
+	out := &targetsv1beta1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSSNSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSNSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSSNSTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSSNSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSSNSTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7111,22 +18610,22 @@ func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSNSTarget{}
+	out := &targetsv1beta1.AWSSNSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSNSTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSSNSTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AWSSQSTargets(namespace string) typedtargetsv1alpha1.AWSSQSTargetInterface {
-	return &wrapTargetsV1alpha1AWSSQSTargetImpl{
+func (w *wrapTargetsV1beta1) AWSSQSTargets(namespace string) typedtargetsv1beta1.AWSSQSTargetInterface {
+	return &wrapTargetsV1beta1AWSSQSTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "awssqstargets",
 		}),
 
@@ -7134,18 +18633,18 @@ func (w *wrapTargetsV1alpha1) AWSSQSTargets(namespace string) typedtargetsv1alph
 	}
 }
 
-type wrapTargetsV1alpha1AWSSQSTargetImpl struct {
+type wrapTargetsV1beta1AWSSQSTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AWSSQSTargetInterface = (*wrapTargetsV1alpha1AWSSQSTargetImpl)(nil)
+var _ typedtargetsv1beta1.AWSSQSTargetInterface = (*wrapTargetsV1beta1AWSSQSTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AWSSQSTarget, opts v1.CreateOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AWSSQSTarget, opts v1.CreateOptions) (*targetsv1beta1.AWSSQSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSSQSTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7156,61 +18655,61 @@ func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Create(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSQSTarget{}
+	out := &targetsv1beta1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AWSSQSTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSQSTarget{}
+	out := &targetsv1beta1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AWSSQSTargetList, error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AWSSQSTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSQSTargetList{}
+	out := &targetsv1beta1.AWSSQSTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AWSSQSTarget, err error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AWSSQSTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSQSTarget{}
+	out := &targetsv1beta1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AWSSQSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AWSSQSTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSSQSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSSQSTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7221,17 +18720,17 @@ func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Update(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSQSTarget{}
+	out := &targetsv1beta1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AWSSQSTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AWSSQSTarget, error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AWSSQSTarget, opts v1.UpdateOptions) (*targetsv1beta1.AWSSQSTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AWSSQSTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7242,22 +18741,22 @@ func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AWSSQSTarget{}
+	out := &targetsv1beta1.AWSSQSTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AWSSQSTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AWSSQSTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AzureEventHubsTargets(namespace string) typedtargetsv1alpha1.AzureEventHubsTargetInterface {
-	return &wrapTargetsV1alpha1AzureEventHubsTargetImpl{
+func (w *wrapTargetsV1beta1) AzureEventHubsTargets(namespace string) typedtargetsv1beta1.AzureEventHubsTargetInterface {
+	return &wrapTargetsV1beta1AzureEventHubsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "azureeventhubstargets",
 		}),
 
@@ -7265,18 +18764,18 @@ func (w *wrapTargetsV1alpha1) AzureEventHubsTargets(namespace string) typedtarge
 	}
 }
 
-type wrapTargetsV1alpha1AzureEventHubsTargetImpl struct {
+type wrapTargetsV1beta1AzureEventHubsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AzureEventHubsTargetInterface = (*wrapTargetsV1alpha1AzureEventHubsTargetImpl)(nil)
+var _ typedtargetsv1beta1.AzureEventHubsTargetInterface = (*wrapTargetsV1beta1AzureEventHubsTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AzureEventHubsTarget, opts v1.CreateOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AzureEventHubsTarget, opts v1.CreateOptions) (*targetsv1beta1.AzureEventHubsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureEventHubsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7287,61 +18786,61 @@ func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Create(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureEventHubsTarget{}
+	out := &targetsv1beta1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AzureEventHubsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureEventHubsTarget{}
+	out := &targetsv1beta1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AzureEventHubsTargetList, error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AzureEventHubsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureEventHubsTargetList{}
+	out := &targetsv1beta1.AzureEventHubsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AzureEventHubsTarget, err error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AzureEventHubsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureEventHubsTarget{}
+	out := &targetsv1beta1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AzureEventHubsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AzureEventHubsTarget, opts v1.UpdateOptions) (*targetsv1beta1.AzureEventHubsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureEventHubsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7352,17 +18851,17 @@ func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Update(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureEventHubsTarget{}
+	out := &targetsv1beta1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AzureEventHubsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureEventHubsTarget, error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AzureEventHubsTarget, opts v1.UpdateOptions) (*targetsv1beta1.AzureEventHubsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureEventHubsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7373,22 +18872,22 @@ func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) UpdateStatus(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureEventHubsTarget{}
+	out := &targetsv1beta1.AzureEventHubsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureEventHubsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AzureEventHubsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AzureSentinelTargets(namespace string) typedtargetsv1alpha1.AzureSentinelTargetInterface {
-	return &wrapTargetsV1alpha1AzureSentinelTargetImpl{
+func (w *wrapTargetsV1beta1) AzureSentinelTargets(namespace string) typedtargetsv1beta1.AzureSentinelTargetInterface {
+	return &wrapTargetsV1beta1AzureSentinelTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "azuresentineltargets",
 		}),
 
@@ -7396,18 +18895,18 @@ func (w *wrapTargetsV1alpha1) AzureSentinelTargets(namespace string) typedtarget
 	}
 }
 
-type wrapTargetsV1alpha1AzureSentinelTargetImpl struct {
+type wrapTargetsV1beta1AzureSentinelTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AzureSentinelTargetInterface = (*wrapTargetsV1alpha1AzureSentinelTargetImpl)(nil)
+var _ typedtargetsv1beta1.AzureSentinelTargetInterface = (*wrapTargetsV1beta1AzureSentinelTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AzureSentinelTarget, opts v1.CreateOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AzureSentinelTarget, opts v1.CreateOptions) (*targetsv1beta1.AzureSentinelTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureSentinelTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7418,61 +18917,61 @@ func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Create(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureSentinelTarget{}
+	out := &targetsv1beta1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AzureSentinelTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureSentinelTarget{}
+	out := &targetsv1beta1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AzureSentinelTargetList, error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AzureSentinelTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureSentinelTargetList{}
+	out := &targetsv1beta1.AzureSentinelTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AzureSentinelTarget, err error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AzureSentinelTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureSentinelTarget{}
+	out := &targetsv1beta1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AzureSentinelTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AzureSentinelTarget, opts v1.UpdateOptions) (*targetsv1beta1.AzureSentinelTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureSentinelTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7483,17 +18982,17 @@ func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Update(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureSentinelTarget{}
+	out := &targetsv1beta1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AzureSentinelTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureSentinelTarget, error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AzureSentinelTarget, opts v1.UpdateOptions) (*targetsv1beta1.AzureSentinelTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureSentinelTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7504,22 +19003,22 @@ func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) UpdateStatus(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureSentinelTarget{}
+	out := &targetsv1beta1.AzureSentinelTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureSentinelTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AzureSentinelTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) AzureServiceBusTargets(namespace string) typedtargetsv1alpha1.AzureServiceBusTargetInterface {
-	return &wrapTargetsV1alpha1AzureServiceBusTargetImpl{
+func (w *wrapTargetsV1beta1) AzureServiceBusTargets(namespace string) typedtargetsv1beta1.AzureServiceBusTargetInterface {
+	return &wrapTargetsV1beta1AzureServiceBusTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "azureservicebustargets",
 		}),
 
@@ -7527,18 +19026,18 @@ func (w *wrapTargetsV1alpha1) AzureServiceBusTargets(namespace string) typedtarg
 	}
 }
 
-type wrapTargetsV1alpha1AzureServiceBusTargetImpl struct {
+type wrapTargetsV1beta1AzureServiceBusTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.AzureServiceBusTargetInterface = (*wrapTargetsV1alpha1AzureServiceBusTargetImpl)(nil)
+var _ typedtargetsv1beta1.AzureServiceBusTargetInterface = (*wrapTargetsV1beta1AzureServiceBusTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.AzureServiceBusTarget, opts v1.CreateOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) Create(ctx context.Context, in *targetsv1beta1.AzureServiceBusTarget, opts v1.CreateOptions) (*targetsv1beta1.AzureServiceBusTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureServiceBusTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7549,61 +19048,61 @@ func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Create(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureServiceBusTarget{}
+	out := &targetsv1beta1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.AzureServiceBusTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureServiceBusTarget{}
+	out := &targetsv1beta1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.AzureServiceBusTargetList, error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.AzureServiceBusTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureServiceBusTargetList{}
+	out := &targetsv1beta1.AzureServiceBusTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.AzureServiceBusTarget, err error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.AzureServiceBusTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureServiceBusTarget{}
+	out := &targetsv1beta1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.AzureServiceBusTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) Update(ctx context.Context, in *targetsv1beta1.AzureServiceBusTarget, opts v1.UpdateOptions) (*targetsv1beta1.AzureServiceBusTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureServiceBusTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7614,17 +19113,17 @@ func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Update(ctx context.Contex
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureServiceBusTarget{}
+	out := &targetsv1beta1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.AzureServiceBusTarget, opts v1.UpdateOptions) (*targetsv1alpha1.AzureServiceBusTarget, error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.AzureServiceBusTarget, opts v1.UpdateOptions) (*targetsv1beta1.AzureServiceBusTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "AzureServiceBusTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7635,22 +19134,22 @@ func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) UpdateStatus(ctx context.
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.AzureServiceBusTarget{}
+	out := &targetsv1beta1.AzureServiceBusTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1AzureServiceBusTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1AzureServiceBusTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) CloudEventsTargets(namespace string) typedtargetsv1alpha1.CloudEventsTargetInterface {
-	return &wrapTargetsV1alpha1CloudEventsTargetImpl{
+func (w *wrapTargetsV1beta1) CloudEventsTargets(namespace string) typedtargetsv1beta1.CloudEventsTargetInterface {
+	return &wrapTargetsV1beta1CloudEventsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "cloudeventstargets",
 		}),
 
@@ -7658,18 +19157,18 @@ func (w *wrapTargetsV1alpha1) CloudEventsTargets(namespace string) typedtargetsv
 	}
 }
 
-type wrapTargetsV1alpha1CloudEventsTargetImpl struct {
+type wrapTargetsV1beta1CloudEventsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.CloudEventsTargetInterface = (*wrapTargetsV1alpha1CloudEventsTargetImpl)(nil)
+var _ typedtargetsv1beta1.CloudEventsTargetInterface = (*wrapTargetsV1beta1CloudEventsTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.CloudEventsTarget, opts v1.CreateOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) Create(ctx context.Context, in *targetsv1beta1.CloudEventsTarget, opts v1.CreateOptions) (*targetsv1beta1.CloudEventsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "CloudEventsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7680,61 +19179,61 @@ func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.CloudEventsTarget{}
+	out := &targetsv1beta1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.CloudEventsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.CloudEventsTarget{}
+	out := &targetsv1beta1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.CloudEventsTargetList, error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.CloudEventsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.CloudEventsTargetList{}
+	out := &targetsv1beta1.CloudEventsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.CloudEventsTarget, err error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.CloudEventsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.CloudEventsTarget{}
+	out := &targetsv1beta1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.CloudEventsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) Update(ctx context.Context, in *targetsv1beta1.CloudEventsTarget, opts v1.UpdateOptions) (*targetsv1beta1.CloudEventsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "CloudEventsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7745,17 +19244,17 @@ func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.CloudEventsTarget{}
+	out := &targetsv1beta1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.CloudEventsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.CloudEventsTarget, error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.CloudEventsTarget, opts v1.UpdateOptions) (*targetsv1beta1.CloudEventsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "CloudEventsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7766,22 +19265,22 @@ func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.CloudEventsTarget{}
+	out := &targetsv1beta1.CloudEventsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1CloudEventsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1CloudEventsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) DatadogTargets(namespace string) typedtargetsv1alpha1.DatadogTargetInterface {
-	return &wrapTargetsV1alpha1DatadogTargetImpl{
+func (w *wrapTargetsV1beta1) DatadogTargets(namespace string) typedtargetsv1beta1.DatadogTargetInterface {
+	return &wrapTargetsV1beta1DatadogTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "datadogtargets",
 		}),
 
@@ -7789,18 +19288,18 @@ func (w *wrapTargetsV1alpha1) DatadogTargets(namespace string) typedtargetsv1alp
 	}
 }
 
-type wrapTargetsV1alpha1DatadogTargetImpl struct {
+type wrapTargetsV1beta1DatadogTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.DatadogTargetInterface = (*wrapTargetsV1alpha1DatadogTargetImpl)(nil)
+var _ typedtargetsv1beta1.DatadogTargetInterface = (*wrapTargetsV1beta1DatadogTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.DatadogTarget, opts v1.CreateOptions) (*targetsv1alpha1.DatadogTarget, error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) Create(ctx context.Context, in *targetsv1beta1.DatadogTarget, opts v1.CreateOptions) (*targetsv1beta1.DatadogTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "DatadogTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7811,61 +19310,61 @@ func (w *wrapTargetsV1alpha1DatadogTargetImpl) Create(ctx context.Context, in *t
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.DatadogTarget{}
+	out := &targetsv1beta1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.DatadogTarget, error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.DatadogTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.DatadogTarget{}
+	out := &targetsv1beta1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.DatadogTargetList, error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.DatadogTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.DatadogTargetList{}
+	out := &targetsv1beta1.DatadogTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.DatadogTarget, err error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.DatadogTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.DatadogTarget{}
+	out := &targetsv1beta1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.DatadogTarget, opts v1.UpdateOptions) (*targetsv1alpha1.DatadogTarget, error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) Update(ctx context.Context, in *targetsv1beta1.DatadogTarget, opts v1.UpdateOptions) (*targetsv1beta1.DatadogTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "DatadogTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7876,17 +19375,17 @@ func (w *wrapTargetsV1alpha1DatadogTargetImpl) Update(ctx context.Context, in *t
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.DatadogTarget{}
+	out := &targetsv1beta1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.DatadogTarget, opts v1.UpdateOptions) (*targetsv1alpha1.DatadogTarget, error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.DatadogTarget, opts v1.UpdateOptions) (*targetsv1beta1.DatadogTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "DatadogTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7897,22 +19396,22 @@ func (w *wrapTargetsV1alpha1DatadogTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.DatadogTarget{}
+	out := &targetsv1beta1.DatadogTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1DatadogTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1DatadogTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) ElasticsearchTargets(namespace string) typedtargetsv1alpha1.ElasticsearchTargetInterface {
-	return &wrapTargetsV1alpha1ElasticsearchTargetImpl{
+func (w *wrapTargetsV1beta1) ElasticsearchTargets(namespace string) typedtargetsv1beta1.ElasticsearchTargetInterface {
+	return &wrapTargetsV1beta1ElasticsearchTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "elasticsearchtargets",
 		}),
 
@@ -7920,18 +19419,18 @@ func (w *wrapTargetsV1alpha1) ElasticsearchTargets(namespace string) typedtarget
 	}
 }
 
-type wrapTargetsV1alpha1ElasticsearchTargetImpl struct {
+type wrapTargetsV1beta1ElasticsearchTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.ElasticsearchTargetInterface = (*wrapTargetsV1alpha1ElasticsearchTargetImpl)(nil)
+var _ typedtargetsv1beta1.ElasticsearchTargetInterface = (*wrapTargetsV1beta1ElasticsearchTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.ElasticsearchTarget, opts v1.CreateOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) Create(ctx context.Context, in *targetsv1beta1.ElasticsearchTarget, opts v1.CreateOptions) (*targetsv1beta1.ElasticsearchTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "ElasticsearchTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -7942,61 +19441,61 @@ func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Create(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ElasticsearchTarget{}
+	out := &targetsv1beta1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.ElasticsearchTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ElasticsearchTarget{}
+	out := &targetsv1beta1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.ElasticsearchTargetList, error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.ElasticsearchTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ElasticsearchTargetList{}
+	out := &targetsv1beta1.ElasticsearchTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.ElasticsearchTarget, err error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.ElasticsearchTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ElasticsearchTarget{}
+	out := &targetsv1beta1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.ElasticsearchTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) Update(ctx context.Context, in *targetsv1beta1.ElasticsearchTarget, opts v1.UpdateOptions) (*targetsv1beta1.ElasticsearchTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "ElasticsearchTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8007,17 +19506,17 @@ func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Update(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ElasticsearchTarget{}
+	out := &targetsv1beta1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.ElasticsearchTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ElasticsearchTarget, error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.ElasticsearchTarget, opts v1.UpdateOptions) (*targetsv1beta1.ElasticsearchTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "ElasticsearchTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8028,22 +19527,22 @@ func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) UpdateStatus(ctx context.Co
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ElasticsearchTarget{}
+	out := &targetsv1beta1.ElasticsearchTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ElasticsearchTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1ElasticsearchTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) GoogleCloudFirestoreTargets(namespace string) typedtargetsv1alpha1.GoogleCloudFirestoreTargetInterface {
-	return &wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl{
+func (w *wrapTargetsV1beta1) GoogleCloudFirestoreTargets(namespace string) typedtargetsv1beta1.GoogleCloudFirestoreTargetInterface {
+	return &wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "googlecloudfirestoretargets",
 		}),
 
@@ -8051,18 +19550,18 @@ func (w *wrapTargetsV1alpha1) GoogleCloudFirestoreTargets(namespace string) type
 	}
 }
 
-type wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl struct {
+type wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.GoogleCloudFirestoreTargetInterface = (*wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl)(nil)
+var _ typedtargetsv1beta1.GoogleCloudFirestoreTargetInterface = (*wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudFirestoreTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) Create(ctx context.Context, in *targetsv1beta1.GoogleCloudFirestoreTarget, opts v1.CreateOptions) (*targetsv1beta1.GoogleCloudFirestoreTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudFirestoreTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8073,61 +19572,61 @@ func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Create(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
+	out := &targetsv1beta1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.GoogleCloudFirestoreTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
+	out := &targetsv1beta1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudFirestoreTargetList, error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.GoogleCloudFirestoreTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudFirestoreTargetList{}
+	out := &targetsv1beta1.GoogleCloudFirestoreTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudFirestoreTarget, err error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.GoogleCloudFirestoreTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
+	out := &targetsv1beta1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) Update(ctx context.Context, in *targetsv1beta1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudFirestoreTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudFirestoreTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8138,17 +19637,17 @@ func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Update(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
+	out := &targetsv1beta1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudFirestoreTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.GoogleCloudFirestoreTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudFirestoreTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudFirestoreTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8159,22 +19658,22 @@ func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) UpdateStatus(ctx con
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudFirestoreTarget{}
+	out := &targetsv1beta1.GoogleCloudFirestoreTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudFirestoreTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1GoogleCloudFirestoreTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) GoogleCloudPubSubTargets(namespace string) typedtargetsv1alpha1.GoogleCloudPubSubTargetInterface {
-	return &wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl{
+func (w *wrapTargetsV1beta1) GoogleCloudPubSubTargets(namespace string) typedtargetsv1beta1.GoogleCloudPubSubTargetInterface {
+	return &wrapTargetsV1beta1GoogleCloudPubSubTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "googlecloudpubsubtargets",
 		}),
 
@@ -8182,18 +19681,18 @@ func (w *wrapTargetsV1alpha1) GoogleCloudPubSubTargets(namespace string) typedta
 	}
 }
 
-type wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl struct {
+type wrapTargetsV1beta1GoogleCloudPubSubTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.GoogleCloudPubSubTargetInterface = (*wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl)(nil)
+var _ typedtargetsv1beta1.GoogleCloudPubSubTargetInterface = (*wrapTargetsV1beta1GoogleCloudPubSubTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudPubSubTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) Create(ctx context.Context, in *targetsv1beta1.GoogleCloudPubSubTarget, opts v1.CreateOptions) (*targetsv1beta1.GoogleCloudPubSubTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudPubSubTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8204,61 +19703,61 @@ func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Create(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
+	out := &targetsv1beta1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.GoogleCloudPubSubTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
+	out := &targetsv1beta1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudPubSubTargetList, error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.GoogleCloudPubSubTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudPubSubTargetList{}
+	out := &targetsv1beta1.GoogleCloudPubSubTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudPubSubTarget, err error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.GoogleCloudPubSubTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
+	out := &targetsv1beta1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) Update(ctx context.Context, in *targetsv1beta1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudPubSubTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudPubSubTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8269,17 +19768,17 @@ func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Update(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
+	out := &targetsv1beta1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudPubSubTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.GoogleCloudPubSubTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudPubSubTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudPubSubTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8290,22 +19789,22 @@ func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) UpdateStatus(ctx contex
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudPubSubTarget{}
+	out := &targetsv1beta1.GoogleCloudPubSubTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudPubSubTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1GoogleCloudPubSubTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) GoogleCloudStorageTargets(namespace string) typedtargetsv1alpha1.GoogleCloudStorageTargetInterface {
-	return &wrapTargetsV1alpha1GoogleCloudStorageTargetImpl{
+func (w *wrapTargetsV1beta1) GoogleCloudStorageTargets(namespace string) typedtargetsv1beta1.GoogleCloudStorageTargetInterface {
+	return &wrapTargetsV1beta1GoogleCloudStorageTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "googlecloudstoragetargets",
 		}),
 
@@ -8313,18 +19812,18 @@ func (w *wrapTargetsV1alpha1) GoogleCloudStorageTargets(namespace string) typedt
 	}
 }
 
-type wrapTargetsV1alpha1GoogleCloudStorageTargetImpl struct {
+type wrapTargetsV1beta1GoogleCloudStorageTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.GoogleCloudStorageTargetInterface = (*wrapTargetsV1alpha1GoogleCloudStorageTargetImpl)(nil)
+var _ typedtargetsv1beta1.GoogleCloudStorageTargetInterface = (*wrapTargetsV1beta1GoogleCloudStorageTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudStorageTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) Create(ctx context.Context, in *targetsv1beta1.GoogleCloudStorageTarget, opts v1.CreateOptions) (*targetsv1beta1.GoogleCloudStorageTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudStorageTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8335,61 +19834,61 @@ func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Create(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
+	out := &targetsv1beta1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.GoogleCloudStorageTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
+	out := &targetsv1beta1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudStorageTargetList, error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.GoogleCloudStorageTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudStorageTargetList{}
+	out := &targetsv1beta1.GoogleCloudStorageTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudStorageTarget, err error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.GoogleCloudStorageTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
+	out := &targetsv1beta1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) Update(ctx context.Context, in *targetsv1beta1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudStorageTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudStorageTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8400,17 +19899,17 @@ func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Update(ctx context.Con
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
+	out := &targetsv1beta1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudStorageTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.GoogleCloudStorageTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudStorageTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudStorageTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8421,22 +19920,22 @@ func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) UpdateStatus(ctx conte
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudStorageTarget{}
+	out := &targetsv1beta1.GoogleCloudStorageTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudStorageTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1GoogleCloudStorageTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) GoogleCloudWorkflowsTargets(namespace string) typedtargetsv1alpha1.GoogleCloudWorkflowsTargetInterface {
-	return &wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl{
+func (w *wrapTargetsV1beta1) GoogleCloudWorkflowsTargets(namespace string) typedtargetsv1beta1.GoogleCloudWorkflowsTargetInterface {
+	return &wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "googlecloudworkflowstargets",
 		}),
 
@@ -8444,18 +19943,18 @@ func (w *wrapTargetsV1alpha1) GoogleCloudWorkflowsTargets(namespace string) type
 	}
 }
 
-type wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl struct {
+type wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.GoogleCloudWorkflowsTargetInterface = (*wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl)(nil)
+var _ typedtargetsv1beta1.GoogleCloudWorkflowsTargetInterface = (*wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleCloudWorkflowsTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) Create(ctx context.Context, in *targetsv1beta1.GoogleCloudWorkflowsTarget, opts v1.CreateOptions) (*targetsv1beta1.GoogleCloudWorkflowsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudWorkflowsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8466,61 +19965,61 @@ func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Create(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
+	out := &targetsv1beta1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.GoogleCloudWorkflowsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
+	out := &targetsv1beta1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTargetList, error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.GoogleCloudWorkflowsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudWorkflowsTargetList{}
+	out := &targetsv1beta1.GoogleCloudWorkflowsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleCloudWorkflowsTarget, err error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.GoogleCloudWorkflowsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
+	out := &targetsv1beta1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) Update(ctx context.Context, in *targetsv1beta1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudWorkflowsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudWorkflowsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8531,17 +20030,17 @@ func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Update(ctx context.C
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
+	out := &targetsv1beta1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleCloudWorkflowsTarget, error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.GoogleCloudWorkflowsTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleCloudWorkflowsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleCloudWorkflowsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8552,22 +20051,22 @@ func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) UpdateStatus(ctx con
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleCloudWorkflowsTarget{}
+	out := &targetsv1beta1.GoogleCloudWorkflowsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleCloudWorkflowsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1GoogleCloudWorkflowsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) GoogleSheetTargets(namespace string) typedtargetsv1alpha1.GoogleSheetTargetInterface {
-	return &wrapTargetsV1alpha1GoogleSheetTargetImpl{
+func (w *wrapTargetsV1beta1) GoogleSheetTargets(namespace string) typedtargetsv1beta1.GoogleSheetTargetInterface {
+	return &wrapTargetsV1beta1GoogleSheetTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "googlesheettargets",
 		}),
 
@@ -8575,18 +20074,18 @@ func (w *wrapTargetsV1alpha1) GoogleSheetTargets(namespace string) typedtargetsv
 	}
 }
 
-type wrapTargetsV1alpha1GoogleSheetTargetImpl struct {
+type wrapTargetsV1beta1GoogleSheetTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.GoogleSheetTargetInterface = (*wrapTargetsV1alpha1GoogleSheetTargetImpl)(nil)
+var _ typedtargetsv1beta1.GoogleSheetTargetInterface = (*wrapTargetsV1beta1GoogleSheetTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.GoogleSheetTarget, opts v1.CreateOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) Create(ctx context.Context, in *targetsv1beta1.GoogleSheetTarget, opts v1.CreateOptions) (*targetsv1beta1.GoogleSheetTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleSheetTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8597,61 +20096,61 @@ func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleSheetTarget{}
+	out := &targetsv1beta1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.GoogleSheetTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleSheetTarget{}
+	out := &targetsv1beta1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.GoogleSheetTargetList, error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.GoogleSheetTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleSheetTargetList{}
+	out := &targetsv1beta1.GoogleSheetTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.GoogleSheetTarget, err error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.GoogleSheetTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleSheetTarget{}
+	out := &targetsv1beta1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.GoogleSheetTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) Update(ctx context.Context, in *targetsv1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleSheetTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleSheetTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8662,17 +20161,17 @@ func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleSheetTarget{}
+	out := &targetsv1beta1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.GoogleSheetTarget, opts v1.UpdateOptions) (*targetsv1alpha1.GoogleSheetTarget, error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.GoogleSheetTarget, opts v1.UpdateOptions) (*targetsv1beta1.GoogleSheetTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "GoogleSheetTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8683,22 +20182,22 @@ func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.GoogleSheetTarget{}
+	out := &targetsv1beta1.GoogleSheetTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1GoogleSheetTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1GoogleSheetTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) HTTPTargets(namespace string) typedtargetsv1alpha1.HTTPTargetInterface {
-	return &wrapTargetsV1alpha1HTTPTargetImpl{
+func (w *wrapTargetsV1beta1) HTTPTargets(namespace string) typedtargetsv1beta1.HTTPTargetInterface {
+	return &wrapTargetsV1beta1HTTPTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "httptargets",
 		}),
 
@@ -8706,18 +20205,18 @@ func (w *wrapTargetsV1alpha1) HTTPTargets(namespace string) typedtargetsv1alpha1
 	}
 }
 
-type wrapTargetsV1alpha1HTTPTargetImpl struct {
+type wrapTargetsV1beta1HTTPTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.HTTPTargetInterface = (*wrapTargetsV1alpha1HTTPTargetImpl)(nil)
+var _ typedtargetsv1beta1.HTTPTargetInterface = (*wrapTargetsV1beta1HTTPTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.HTTPTarget, opts v1.CreateOptions) (*targetsv1alpha1.HTTPTarget, error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) Create(ctx context.Context, in *targetsv1beta1.HTTPTarget, opts v1.CreateOptions) (*targetsv1beta1.HTTPTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "HTTPTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8728,61 +20227,61 @@ func (w *wrapTargetsV1alpha1HTTPTargetImpl) Create(ctx context.Context, in *targ
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.HTTPTarget{}
+	out := &targetsv1beta1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.HTTPTarget, error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.HTTPTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.HTTPTarget{}
+	out := &targetsv1beta1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.HTTPTargetList, error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.HTTPTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.HTTPTargetList{}
+	out := &targetsv1beta1.HTTPTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.HTTPTarget, err error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.HTTPTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.HTTPTarget{}
+	out := &targetsv1beta1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.HTTPTarget, opts v1.UpdateOptions) (*targetsv1alpha1.HTTPTarget, error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) Update(ctx context.Context, in *targetsv1beta1.HTTPTarget, opts v1.UpdateOptions) (*targetsv1beta1.HTTPTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "HTTPTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8793,17 +20292,17 @@ func (w *wrapTargetsV1alpha1HTTPTargetImpl) Update(ctx context.Context, in *targ
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.HTTPTarget{}
+	out := &targetsv1beta1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.HTTPTarget, opts v1.UpdateOptions) (*targetsv1alpha1.HTTPTarget, error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.HTTPTarget, opts v1.UpdateOptions) (*targetsv1beta1.HTTPTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "HTTPTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8814,22 +20313,22 @@ func (w *wrapTargetsV1alpha1HTTPTargetImpl) UpdateStatus(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.HTTPTarget{}
+	out := &targetsv1beta1.HTTPTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1HTTPTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1HTTPTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) IBMMQTargets(namespace string) typedtargetsv1alpha1.IBMMQTargetInterface {
-	return &wrapTargetsV1alpha1IBMMQTargetImpl{
+func (w *wrapTargetsV1beta1) IBMMQTargets(namespace string) typedtargetsv1beta1.IBMMQTargetInterface {
+	return &wrapTargetsV1beta1IBMMQTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "ibmmqtargets",
 		}),
 
@@ -8837,18 +20336,18 @@ func (w *wrapTargetsV1alpha1) IBMMQTargets(namespace string) typedtargetsv1alpha
 	}
 }
 
-type wrapTargetsV1alpha1IBMMQTargetImpl struct {
+type wrapTargetsV1beta1IBMMQTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.IBMMQTargetInterface = (*wrapTargetsV1alpha1IBMMQTargetImpl)(nil)
+var _ typedtargetsv1beta1.IBMMQTargetInterface = (*wrapTargetsV1beta1IBMMQTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.IBMMQTarget, opts v1.CreateOptions) (*targetsv1alpha1.IBMMQTarget, error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) Create(ctx context.Context, in *targetsv1beta1.IBMMQTarget, opts v1.CreateOptions) (*targetsv1beta1.IBMMQTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "IBMMQTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8859,61 +20358,61 @@ func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Create(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.IBMMQTarget{}
+	out := &targetsv1beta1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.IBMMQTarget, error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.IBMMQTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.IBMMQTarget{}
+	out := &targetsv1beta1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.IBMMQTargetList, error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.IBMMQTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.IBMMQTargetList{}
+	out := &targetsv1beta1.IBMMQTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.IBMMQTarget, err error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.IBMMQTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.IBMMQTarget{}
+	out := &targetsv1beta1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.IBMMQTarget, opts v1.UpdateOptions) (*targetsv1alpha1.IBMMQTarget, error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) Update(ctx context.Context, in *targetsv1beta1.IBMMQTarget, opts v1.UpdateOptions) (*targetsv1beta1.IBMMQTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "IBMMQTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8924,17 +20423,17 @@ func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Update(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.IBMMQTarget{}
+	out := &targetsv1beta1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.IBMMQTarget, opts v1.UpdateOptions) (*targetsv1alpha1.IBMMQTarget, error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.IBMMQTarget, opts v1.UpdateOptions) (*targetsv1beta1.IBMMQTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "IBMMQTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8945,22 +20444,22 @@ func (w *wrapTargetsV1alpha1IBMMQTargetImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.IBMMQTarget{}
+	out := &targetsv1beta1.IBMMQTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1IBMMQTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1IBMMQTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) JiraTargets(namespace string) typedtargetsv1alpha1.JiraTargetInterface {
-	return &wrapTargetsV1alpha1JiraTargetImpl{
+func (w *wrapTargetsV1beta1) JiraTargets(namespace string) typedtargetsv1beta1.JiraTargetInterface {
+	return &wrapTargetsV1beta1JiraTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "jiratargets",
 		}),
 
@@ -8968,18 +20467,18 @@ func (w *wrapTargetsV1alpha1) JiraTargets(namespace string) typedtargetsv1alpha1
 	}
 }
 
-type wrapTargetsV1alpha1JiraTargetImpl struct {
+type wrapTargetsV1beta1JiraTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.JiraTargetInterface = (*wrapTargetsV1alpha1JiraTargetImpl)(nil)
+var _ typedtargetsv1beta1.JiraTargetInterface = (*wrapTargetsV1beta1JiraTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.JiraTarget, opts v1.CreateOptions) (*targetsv1alpha1.JiraTarget, error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) Create(ctx context.Context, in *targetsv1beta1.JiraTarget, opts v1.CreateOptions) (*targetsv1beta1.JiraTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "JiraTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -8990,61 +20489,61 @@ func (w *wrapTargetsV1alpha1JiraTargetImpl) Create(ctx context.Context, in *targ
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.JiraTarget{}
+	out := &targetsv1beta1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1JiraTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1JiraTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.JiraTarget, error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.JiraTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.JiraTarget{}
+	out := &targetsv1beta1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.JiraTargetList, error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.JiraTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.JiraTargetList{}
+	out := &targetsv1beta1.JiraTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.JiraTarget, err error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.JiraTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.JiraTarget{}
+	out := &targetsv1beta1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.JiraTarget, opts v1.UpdateOptions) (*targetsv1alpha1.JiraTarget, error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) Update(ctx context.Context, in *targetsv1beta1.JiraTarget, opts v1.UpdateOptions) (*targetsv1beta1.JiraTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "JiraTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9055,17 +20554,17 @@ func (w *wrapTargetsV1alpha1JiraTargetImpl) Update(ctx context.Context, in *targ
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.JiraTarget{}
+	out := &targetsv1beta1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.JiraTarget, opts v1.UpdateOptions) (*targetsv1alpha1.JiraTarget, error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.JiraTarget, opts v1.UpdateOptions) (*targetsv1beta1.JiraTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "JiraTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9076,22 +20575,22 @@ func (w *wrapTargetsV1alpha1JiraTargetImpl) UpdateStatus(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.JiraTarget{}
+	out := &targetsv1beta1.JiraTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1JiraTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1JiraTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) KafkaTargets(namespace string) typedtargetsv1alpha1.KafkaTargetInterface {
-	return &wrapTargetsV1alpha1KafkaTargetImpl{
+func (w *wrapTargetsV1beta1) KafkaTargets(namespace string) typedtargetsv1beta1.KafkaTargetInterface {
+	return &wrapTargetsV1beta1KafkaTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "kafkatargets",
 		}),
 
@@ -9099,18 +20598,18 @@ func (w *wrapTargetsV1alpha1) KafkaTargets(namespace string) typedtargetsv1alpha
 	}
 }
 
-type wrapTargetsV1alpha1KafkaTargetImpl struct {
+type wrapTargetsV1beta1KafkaTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.KafkaTargetInterface = (*wrapTargetsV1alpha1KafkaTargetImpl)(nil)
+var _ typedtargetsv1beta1.KafkaTargetInterface = (*wrapTargetsV1beta1KafkaTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.KafkaTarget, opts v1.CreateOptions) (*targetsv1alpha1.KafkaTarget, error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) Create(ctx context.Context, in *targetsv1beta1.KafkaTarget, opts v1.CreateOptions) (*targetsv1beta1.KafkaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "KafkaTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9121,61 +20620,61 @@ func (w *wrapTargetsV1alpha1KafkaTargetImpl) Create(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.KafkaTarget{}
+	out := &targetsv1beta1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.KafkaTarget, error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.KafkaTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.KafkaTarget{}
+	out := &targetsv1beta1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.KafkaTargetList, error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.KafkaTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.KafkaTargetList{}
+	out := &targetsv1beta1.KafkaTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.KafkaTarget, err error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.KafkaTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.KafkaTarget{}
+	out := &targetsv1beta1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.KafkaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.KafkaTarget, error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) Update(ctx context.Context, in *targetsv1beta1.KafkaTarget, opts v1.UpdateOptions) (*targetsv1beta1.KafkaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "KafkaTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9186,17 +20685,17 @@ func (w *wrapTargetsV1alpha1KafkaTargetImpl) Update(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.KafkaTarget{}
+	out := &targetsv1beta1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.KafkaTarget, opts v1.UpdateOptions) (*targetsv1alpha1.KafkaTarget, error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.KafkaTarget, opts v1.UpdateOptions) (*targetsv1beta1.KafkaTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "KafkaTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9207,22 +20706,22 @@ func (w *wrapTargetsV1alpha1KafkaTargetImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.KafkaTarget{}
+	out := &targetsv1beta1.KafkaTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1KafkaTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1KafkaTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) LogzMetricsTargets(namespace string) typedtargetsv1alpha1.LogzMetricsTargetInterface {
-	return &wrapTargetsV1alpha1LogzMetricsTargetImpl{
+func (w *wrapTargetsV1beta1) LogzMetricsTargets(namespace string) typedtargetsv1beta1.LogzMetricsTargetInterface {
+	return &wrapTargetsV1beta1LogzMetricsTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "logzmetricstargets",
 		}),
 
@@ -9230,18 +20729,18 @@ func (w *wrapTargetsV1alpha1) LogzMetricsTargets(namespace string) typedtargetsv
 	}
 }
 
-type wrapTargetsV1alpha1LogzMetricsTargetImpl struct {
+type wrapTargetsV1beta1LogzMetricsTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.LogzMetricsTargetInterface = (*wrapTargetsV1alpha1LogzMetricsTargetImpl)(nil)
+var _ typedtargetsv1beta1.LogzMetricsTargetInterface = (*wrapTargetsV1beta1LogzMetricsTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.LogzMetricsTarget, opts v1.CreateOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) Create(ctx context.Context, in *targetsv1beta1.LogzMetricsTarget, opts v1.CreateOptions) (*targetsv1beta1.LogzMetricsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "LogzMetricsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9252,61 +20751,61 @@ func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Create(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzMetricsTarget{}
+	out := &targetsv1beta1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.LogzMetricsTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzMetricsTarget{}
+	out := &targetsv1beta1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.LogzMetricsTargetList, error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.LogzMetricsTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzMetricsTargetList{}
+	out := &targetsv1beta1.LogzMetricsTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.LogzMetricsTarget, err error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.LogzMetricsTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzMetricsTarget{}
+	out := &targetsv1beta1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.LogzMetricsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) Update(ctx context.Context, in *targetsv1beta1.LogzMetricsTarget, opts v1.UpdateOptions) (*targetsv1beta1.LogzMetricsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "LogzMetricsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9317,17 +20816,17 @@ func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Update(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzMetricsTarget{}
+	out := &targetsv1beta1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.LogzMetricsTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzMetricsTarget, error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.LogzMetricsTarget, opts v1.UpdateOptions) (*targetsv1beta1.LogzMetricsTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "LogzMetricsTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9338,22 +20837,22 @@ func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) UpdateStatus(ctx context.Cont
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzMetricsTarget{}
+	out := &targetsv1beta1.LogzMetricsTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzMetricsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1LogzMetricsTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) LogzTargets(namespace string) typedtargetsv1alpha1.LogzTargetInterface {
-	return &wrapTargetsV1alpha1LogzTargetImpl{
+func (w *wrapTargetsV1beta1) LogzTargets(namespace string) typedtargetsv1beta1.LogzTargetInterface {
+	return &wrapTargetsV1beta1LogzTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "logztargets",
 		}),
 
@@ -9361,18 +20860,18 @@ func (w *wrapTargetsV1alpha1) LogzTargets(namespace string) typedtargetsv1alpha1
 	}
 }
 
-type wrapTargetsV1alpha1LogzTargetImpl struct {
+type wrapTargetsV1beta1LogzTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.LogzTargetInterface = (*wrapTargetsV1alpha1LogzTargetImpl)(nil)
+var _ typedtargetsv1beta1.LogzTargetInterface = (*wrapTargetsV1beta1LogzTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.LogzTarget, opts v1.CreateOptions) (*targetsv1alpha1.LogzTarget, error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) Create(ctx context.Context, in *targetsv1beta1.LogzTarget, opts v1.CreateOptions) (*targetsv1beta1.LogzTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "LogzTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9383,61 +20882,61 @@ func (w *wrapTargetsV1alpha1LogzTargetImpl) Create(ctx context.Context, in *targ
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzTarget{}
+	out := &targetsv1beta1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1LogzTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1LogzTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.LogzTarget, error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.LogzTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzTarget{}
+	out := &targetsv1beta1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.LogzTargetList, error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.LogzTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzTargetList{}
+	out := &targetsv1beta1.LogzTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.LogzTarget, err error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.LogzTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzTarget{}
+	out := &targetsv1beta1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.LogzTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzTarget, error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) Update(ctx context.Context, in *targetsv1beta1.LogzTarget, opts v1.UpdateOptions) (*targetsv1beta1.LogzTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "LogzTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9448,17 +20947,17 @@ func (w *wrapTargetsV1alpha1LogzTargetImpl) Update(ctx context.Context, in *targ
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzTarget{}
+	out := &targetsv1beta1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.LogzTarget, opts v1.UpdateOptions) (*targetsv1alpha1.LogzTarget, error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.LogzTarget, opts v1.UpdateOptions) (*targetsv1beta1.LogzTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "LogzTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9469,22 +20968,22 @@ func (w *wrapTargetsV1alpha1LogzTargetImpl) UpdateStatus(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.LogzTarget{}
+	out := &targetsv1beta1.LogzTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1LogzTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1LogzTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) MongoDBTargets(namespace string) typedtargetsv1alpha1.MongoDBTargetInterface {
-	return &wrapTargetsV1alpha1MongoDBTargetImpl{
+func (w *wrapTargetsV1beta1) MongoDBTargets(namespace string) typedtargetsv1beta1.MongoDBTargetInterface {
+	return &wrapTargetsV1beta1MongoDBTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "mongodbtargets",
 		}),
 
@@ -9492,18 +20991,18 @@ func (w *wrapTargetsV1alpha1) MongoDBTargets(namespace string) typedtargetsv1alp
 	}
 }
 
-type wrapTargetsV1alpha1MongoDBTargetImpl struct {
+type wrapTargetsV1beta1MongoDBTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.MongoDBTargetInterface = (*wrapTargetsV1alpha1MongoDBTargetImpl)(nil)
+var _ typedtargetsv1beta1.MongoDBTargetInterface = (*wrapTargetsV1beta1MongoDBTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.MongoDBTarget, opts v1.CreateOptions) (*targetsv1alpha1.MongoDBTarget, error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) Create(ctx context.Context, in *targetsv1beta1.MongoDBTarget, opts v1.CreateOptions) (*targetsv1beta1.MongoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "MongoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9514,61 +21013,61 @@ func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Create(ctx context.Context, in *t
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.MongoDBTarget{}
+	out := &targetsv1beta1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.MongoDBTarget, error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.MongoDBTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.MongoDBTarget{}
+	out := &targetsv1beta1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.MongoDBTargetList, error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.MongoDBTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.MongoDBTargetList{}
+	out := &targetsv1beta1.MongoDBTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.MongoDBTarget, err error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.MongoDBTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.MongoDBTarget{}
+	out := &targetsv1beta1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.MongoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.MongoDBTarget, error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) Update(ctx context.Context, in *targetsv1beta1.MongoDBTarget, opts v1.UpdateOptions) (*targetsv1beta1.MongoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "MongoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9579,17 +21078,17 @@ func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Update(ctx context.Context, in *t
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.MongoDBTarget{}
+	out := &targetsv1beta1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.MongoDBTarget, opts v1.UpdateOptions) (*targetsv1alpha1.MongoDBTarget, error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.MongoDBTarget, opts v1.UpdateOptions) (*targetsv1beta1.MongoDBTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "MongoDBTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9600,22 +21099,22 @@ func (w *wrapTargetsV1alpha1MongoDBTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.MongoDBTarget{}
+	out := &targetsv1beta1.MongoDBTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1MongoDBTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1MongoDBTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) OracleTargets(namespace string) typedtargetsv1alpha1.OracleTargetInterface {
-	return &wrapTargetsV1alpha1OracleTargetImpl{
+func (w *wrapTargetsV1beta1) OracleTargets(namespace string) typedtargetsv1beta1.OracleTargetInterface {
+	return &wrapTargetsV1beta1OracleTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "oracletargets",
 		}),
 
@@ -9623,18 +21122,18 @@ func (w *wrapTargetsV1alpha1) OracleTargets(namespace string) typedtargetsv1alph
 	}
 }
 
-type wrapTargetsV1alpha1OracleTargetImpl struct {
+type wrapTargetsV1beta1OracleTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.OracleTargetInterface = (*wrapTargetsV1alpha1OracleTargetImpl)(nil)
+var _ typedtargetsv1beta1.OracleTargetInterface = (*wrapTargetsV1beta1OracleTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.OracleTarget, opts v1.CreateOptions) (*targetsv1alpha1.OracleTarget, error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) Create(ctx context.Context, in *targetsv1beta1.OracleTarget, opts v1.CreateOptions) (*targetsv1beta1.OracleTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "OracleTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9645,61 +21144,61 @@ func (w *wrapTargetsV1alpha1OracleTargetImpl) Create(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.OracleTarget{}
+	out := &targetsv1beta1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1OracleTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1OracleTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.OracleTarget, error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.OracleTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.OracleTarget{}
+	out := &targetsv1beta1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.OracleTargetList, error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.OracleTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.OracleTargetList{}
+	out := &targetsv1beta1.OracleTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.OracleTarget, err error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.OracleTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.OracleTarget{}
+	out := &targetsv1beta1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.OracleTarget, opts v1.UpdateOptions) (*targetsv1alpha1.OracleTarget, error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) Update(ctx context.Context, in *targetsv1beta1.OracleTarget, opts v1.UpdateOptions) (*targetsv1beta1.OracleTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "OracleTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9710,17 +21209,17 @@ func (w *wrapTargetsV1alpha1OracleTargetImpl) Update(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.OracleTarget{}
+	out := &targetsv1beta1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.OracleTarget, opts v1.UpdateOptions) (*targetsv1alpha1.OracleTarget, error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.OracleTarget, opts v1.UpdateOptions) (*targetsv1beta1.OracleTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "OracleTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9731,22 +21230,22 @@ func (w *wrapTargetsV1alpha1OracleTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.OracleTarget{}
+	out := &targetsv1beta1.OracleTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1OracleTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1OracleTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) SalesforceTargets(namespace string) typedtargetsv1alpha1.SalesforceTargetInterface {
-	return &wrapTargetsV1alpha1SalesforceTargetImpl{
+func (w *wrapTargetsV1beta1) SalesforceTargets(namespace string) typedtargetsv1beta1.SalesforceTargetInterface {
+	return &wrapTargetsV1beta1SalesforceTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "salesforcetargets",
 		}),
 
@@ -9754,18 +21253,18 @@ func (w *wrapTargetsV1alpha1) SalesforceTargets(namespace string) typedtargetsv1
 	}
 }
 
-type wrapTargetsV1alpha1SalesforceTargetImpl struct {
+type wrapTargetsV1beta1SalesforceTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.SalesforceTargetInterface = (*wrapTargetsV1alpha1SalesforceTargetImpl)(nil)
+var _ typedtargetsv1beta1.SalesforceTargetInterface = (*wrapTargetsV1beta1SalesforceTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SalesforceTarget, opts v1.CreateOptions) (*targetsv1alpha1.SalesforceTarget, error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) Create(ctx context.Context, in *targetsv1beta1.SalesforceTarget, opts v1.CreateOptions) (*targetsv1beta1.SalesforceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SalesforceTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9776,61 +21275,61 @@ func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Create(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SalesforceTarget{}
+	out := &targetsv1beta1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SalesforceTarget, error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.SalesforceTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SalesforceTarget{}
+	out := &targetsv1beta1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SalesforceTargetList, error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.SalesforceTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SalesforceTargetList{}
+	out := &targetsv1beta1.SalesforceTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SalesforceTarget, err error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.SalesforceTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SalesforceTarget{}
+	out := &targetsv1beta1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SalesforceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SalesforceTarget, error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) Update(ctx context.Context, in *targetsv1beta1.SalesforceTarget, opts v1.UpdateOptions) (*targetsv1beta1.SalesforceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SalesforceTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9841,17 +21340,17 @@ func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Update(ctx context.Context, in
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SalesforceTarget{}
+	out := &targetsv1beta1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SalesforceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SalesforceTarget, error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.SalesforceTarget, opts v1.UpdateOptions) (*targetsv1beta1.SalesforceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SalesforceTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9862,22 +21361,22 @@ func (w *wrapTargetsV1alpha1SalesforceTargetImpl) UpdateStatus(ctx context.Conte
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SalesforceTarget{}
+	out := &targetsv1beta1.SalesforceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SalesforceTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1SalesforceTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) SendGridTargets(namespace string) typedtargetsv1alpha1.SendGridTargetInterface {
-	return &wrapTargetsV1alpha1SendGridTargetImpl{
+func (w *wrapTargetsV1beta1) SendGridTargets(namespace string) typedtargetsv1beta1.SendGridTargetInterface {
+	return &wrapTargetsV1beta1SendGridTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "sendgridtargets",
 		}),
 
@@ -9885,18 +21384,18 @@ func (w *wrapTargetsV1alpha1) SendGridTargets(namespace string) typedtargetsv1al
 	}
 }
 
-type wrapTargetsV1alpha1SendGridTargetImpl struct {
+type wrapTargetsV1beta1SendGridTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.SendGridTargetInterface = (*wrapTargetsV1alpha1SendGridTargetImpl)(nil)
+var _ typedtargetsv1beta1.SendGridTargetInterface = (*wrapTargetsV1beta1SendGridTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SendGridTarget, opts v1.CreateOptions) (*targetsv1alpha1.SendGridTarget, error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) Create(ctx context.Context, in *targetsv1beta1.SendGridTarget, opts v1.CreateOptions) (*targetsv1beta1.SendGridTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SendGridTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9907,61 +21406,61 @@ func (w *wrapTargetsV1alpha1SendGridTargetImpl) Create(ctx context.Context, in *
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SendGridTarget{}
+	out := &targetsv1beta1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SendGridTarget, error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.SendGridTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SendGridTarget{}
+	out := &targetsv1beta1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SendGridTargetList, error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.SendGridTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SendGridTargetList{}
+	out := &targetsv1beta1.SendGridTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SendGridTarget, err error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.SendGridTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SendGridTarget{}
+	out := &targetsv1beta1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SendGridTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SendGridTarget, error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) Update(ctx context.Context, in *targetsv1beta1.SendGridTarget, opts v1.UpdateOptions) (*targetsv1beta1.SendGridTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SendGridTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9972,17 +21471,17 @@ func (w *wrapTargetsV1alpha1SendGridTargetImpl) Update(ctx context.Context, in *
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SendGridTarget{}
+	out := &targetsv1beta1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SendGridTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SendGridTarget, error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.SendGridTarget, opts v1.UpdateOptions) (*targetsv1beta1.SendGridTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SendGridTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -9993,22 +21492,22 @@ func (w *wrapTargetsV1alpha1SendGridTargetImpl) UpdateStatus(ctx context.Context
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SendGridTarget{}
+	out := &targetsv1beta1.SendGridTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SendGridTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1SendGridTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) SlackTargets(namespace string) typedtargetsv1alpha1.SlackTargetInterface {
-	return &wrapTargetsV1alpha1SlackTargetImpl{
+func (w *wrapTargetsV1beta1) SlackTargets(namespace string) typedtargetsv1beta1.SlackTargetInterface {
+	return &wrapTargetsV1beta1SlackTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "slacktargets",
 		}),
 
@@ -10016,18 +21515,18 @@ func (w *wrapTargetsV1alpha1) SlackTargets(namespace string) typedtargetsv1alpha
 	}
 }
 
-type wrapTargetsV1alpha1SlackTargetImpl struct {
+type wrapTargetsV1beta1SlackTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.SlackTargetInterface = (*wrapTargetsV1alpha1SlackTargetImpl)(nil)
+var _ typedtargetsv1beta1.SlackTargetInterface = (*wrapTargetsV1beta1SlackTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SlackTarget, opts v1.CreateOptions) (*targetsv1alpha1.SlackTarget, error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) Create(ctx context.Context, in *targetsv1beta1.SlackTarget, opts v1.CreateOptions) (*targetsv1beta1.SlackTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SlackTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10038,61 +21537,61 @@ func (w *wrapTargetsV1alpha1SlackTargetImpl) Create(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SlackTarget{}
+	out := &targetsv1beta1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1SlackTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1SlackTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SlackTarget, error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.SlackTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SlackTarget{}
+	out := &targetsv1beta1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SlackTargetList, error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.SlackTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SlackTargetList{}
+	out := &targetsv1beta1.SlackTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SlackTarget, err error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.SlackTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SlackTarget{}
+	out := &targetsv1beta1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SlackTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SlackTarget, error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) Update(ctx context.Context, in *targetsv1beta1.SlackTarget, opts v1.UpdateOptions) (*targetsv1beta1.SlackTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SlackTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10103,17 +21602,17 @@ func (w *wrapTargetsV1alpha1SlackTargetImpl) Update(ctx context.Context, in *tar
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SlackTarget{}
+	out := &targetsv1beta1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SlackTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SlackTarget, error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.SlackTarget, opts v1.UpdateOptions) (*targetsv1beta1.SlackTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SlackTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10124,22 +21623,22 @@ func (w *wrapTargetsV1alpha1SlackTargetImpl) UpdateStatus(ctx context.Context, i
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SlackTarget{}
+	out := &targetsv1beta1.SlackTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SlackTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1SlackTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) SolaceTargets(namespace string) typedtargetsv1alpha1.SolaceTargetInterface {
-	return &wrapTargetsV1alpha1SolaceTargetImpl{
+func (w *wrapTargetsV1beta1) SolaceTargets(namespace string) typedtargetsv1beta1.SolaceTargetInterface {
+	return &wrapTargetsV1beta1SolaceTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "solacetargets",
 		}),
 
@@ -10147,18 +21646,18 @@ func (w *wrapTargetsV1alpha1) SolaceTargets(namespace string) typedtargetsv1alph
 	}
 }
 
-type wrapTargetsV1alpha1SolaceTargetImpl struct {
+type wrapTargetsV1beta1SolaceTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.SolaceTargetInterface = (*wrapTargetsV1alpha1SolaceTargetImpl)(nil)
+var _ typedtargetsv1beta1.SolaceTargetInterface = (*wrapTargetsV1beta1SolaceTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SolaceTarget, opts v1.CreateOptions) (*targetsv1alpha1.SolaceTarget, error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) Create(ctx context.Context, in *targetsv1beta1.SolaceTarget, opts v1.CreateOptions) (*targetsv1beta1.SolaceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SolaceTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10169,61 +21668,61 @@ func (w *wrapTargetsV1alpha1SolaceTargetImpl) Create(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SolaceTarget{}
+	out := &targetsv1beta1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SolaceTarget, error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.SolaceTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SolaceTarget{}
+	out := &targetsv1beta1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SolaceTargetList, error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.SolaceTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SolaceTargetList{}
+	out := &targetsv1beta1.SolaceTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SolaceTarget, err error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.SolaceTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SolaceTarget{}
+	out := &targetsv1beta1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SolaceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SolaceTarget, error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) Update(ctx context.Context, in *targetsv1beta1.SolaceTarget, opts v1.UpdateOptions) (*targetsv1beta1.SolaceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SolaceTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10234,17 +21733,17 @@ func (w *wrapTargetsV1alpha1SolaceTargetImpl) Update(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SolaceTarget{}
+	out := &targetsv1beta1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SolaceTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SolaceTarget, error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.SolaceTarget, opts v1.UpdateOptions) (*targetsv1beta1.SolaceTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SolaceTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10255,22 +21754,22 @@ func (w *wrapTargetsV1alpha1SolaceTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SolaceTarget{}
+	out := &targetsv1beta1.SolaceTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SolaceTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1SolaceTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) SplunkTargets(namespace string) typedtargetsv1alpha1.SplunkTargetInterface {
-	return &wrapTargetsV1alpha1SplunkTargetImpl{
+func (w *wrapTargetsV1beta1) SplunkTargets(namespace string) typedtargetsv1beta1.SplunkTargetInterface {
+	return &wrapTargetsV1beta1SplunkTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "splunktargets",
 		}),
 
@@ -10278,18 +21777,18 @@ func (w *wrapTargetsV1alpha1) SplunkTargets(namespace string) typedtargetsv1alph
 	}
 }
 
-type wrapTargetsV1alpha1SplunkTargetImpl struct {
+type wrapTargetsV1beta1SplunkTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.SplunkTargetInterface = (*wrapTargetsV1alpha1SplunkTargetImpl)(nil)
+var _ typedtargetsv1beta1.SplunkTargetInterface = (*wrapTargetsV1beta1SplunkTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.SplunkTarget, opts v1.CreateOptions) (*targetsv1alpha1.SplunkTarget, error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) Create(ctx context.Context, in *targetsv1beta1.SplunkTarget, opts v1.CreateOptions) (*targetsv1beta1.SplunkTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SplunkTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10300,61 +21799,61 @@ func (w *wrapTargetsV1alpha1SplunkTargetImpl) Create(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SplunkTarget{}
+	out := &targetsv1beta1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.SplunkTarget, error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.SplunkTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SplunkTarget{}
+	out := &targetsv1beta1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.SplunkTargetList, error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.SplunkTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SplunkTargetList{}
+	out := &targetsv1beta1.SplunkTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.SplunkTarget, err error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.SplunkTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SplunkTarget{}
+	out := &targetsv1beta1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.SplunkTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SplunkTarget, error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) Update(ctx context.Context, in *targetsv1beta1.SplunkTarget, opts v1.UpdateOptions) (*targetsv1beta1.SplunkTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SplunkTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10365,17 +21864,17 @@ func (w *wrapTargetsV1alpha1SplunkTargetImpl) Update(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SplunkTarget{}
+	out := &targetsv1beta1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.SplunkTarget, opts v1.UpdateOptions) (*targetsv1alpha1.SplunkTarget, error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.SplunkTarget, opts v1.UpdateOptions) (*targetsv1beta1.SplunkTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "SplunkTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10386,22 +21885,22 @@ func (w *wrapTargetsV1alpha1SplunkTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.SplunkTarget{}
+	out := &targetsv1beta1.SplunkTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1SplunkTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1SplunkTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) TwilioTargets(namespace string) typedtargetsv1alpha1.TwilioTargetInterface {
-	return &wrapTargetsV1alpha1TwilioTargetImpl{
+func (w *wrapTargetsV1beta1) TwilioTargets(namespace string) typedtargetsv1beta1.TwilioTargetInterface {
+	return &wrapTargetsV1beta1TwilioTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "twiliotargets",
 		}),
 
@@ -10409,18 +21908,18 @@ func (w *wrapTargetsV1alpha1) TwilioTargets(namespace string) typedtargetsv1alph
 	}
 }
 
-type wrapTargetsV1alpha1TwilioTargetImpl struct {
+type wrapTargetsV1beta1TwilioTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.TwilioTargetInterface = (*wrapTargetsV1alpha1TwilioTargetImpl)(nil)
+var _ typedtargetsv1beta1.TwilioTargetInterface = (*wrapTargetsV1beta1TwilioTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.TwilioTarget, opts v1.CreateOptions) (*targetsv1alpha1.TwilioTarget, error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) Create(ctx context.Context, in *targetsv1beta1.TwilioTarget, opts v1.CreateOptions) (*targetsv1beta1.TwilioTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "TwilioTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10431,61 +21930,61 @@ func (w *wrapTargetsV1alpha1TwilioTargetImpl) Create(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.TwilioTarget{}
+	out := &targetsv1beta1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.TwilioTarget, error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.TwilioTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.TwilioTarget{}
+	out := &targetsv1beta1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.TwilioTargetList, error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.TwilioTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.TwilioTargetList{}
+	out := &targetsv1beta1.TwilioTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.TwilioTarget, err error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.TwilioTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.TwilioTarget{}
+	out := &targetsv1beta1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.TwilioTarget, opts v1.UpdateOptions) (*targetsv1alpha1.TwilioTarget, error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) Update(ctx context.Context, in *targetsv1beta1.TwilioTarget, opts v1.UpdateOptions) (*targetsv1beta1.TwilioTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "TwilioTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10496,17 +21995,17 @@ func (w *wrapTargetsV1alpha1TwilioTargetImpl) Update(ctx context.Context, in *ta
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.TwilioTarget{}
+	out := &targetsv1beta1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.TwilioTarget, opts v1.UpdateOptions) (*targetsv1alpha1.TwilioTarget, error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.TwilioTarget, opts v1.UpdateOptions) (*targetsv1beta1.TwilioTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "TwilioTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10517,22 +22016,22 @@ func (w *wrapTargetsV1alpha1TwilioTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.TwilioTarget{}
+	out := &targetsv1beta1.TwilioTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1TwilioTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1TwilioTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
 
-func (w *wrapTargetsV1alpha1) ZendeskTargets(namespace string) typedtargetsv1alpha1.ZendeskTargetInterface {
-	return &wrapTargetsV1alpha1ZendeskTargetImpl{
+func (w *wrapTargetsV1beta1) ZendeskTargets(namespace string) typedtargetsv1beta1.ZendeskTargetInterface {
+	return &wrapTargetsV1beta1ZendeskTargetImpl{
 		dyn: w.dyn.Resource(schema.GroupVersionResource{
 			Group:    "targets.triggermesh.io",
-			Version:  "v1alpha1",
+			Version:  "v1beta1",
 			Resource: "zendesktargets",
 		}),
 
@@ -10540,18 +22039,18 @@ func (w *wrapTargetsV1alpha1) ZendeskTargets(namespace string) typedtargetsv1alp
 	}
 }
 
-type wrapTargetsV1alpha1ZendeskTargetImpl struct {
+type wrapTargetsV1beta1ZendeskTargetImpl struct {
 	dyn dynamic.NamespaceableResourceInterface
 
 	namespace string
 }
 
-var _ typedtargetsv1alpha1.ZendeskTargetInterface = (*wrapTargetsV1alpha1ZendeskTargetImpl)(nil)
+var _ typedtargetsv1beta1.ZendeskTargetInterface = (*wrapTargetsV1beta1ZendeskTargetImpl)(nil)
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Create(ctx context.Context, in *targetsv1alpha1.ZendeskTarget, opts v1.CreateOptions) (*targetsv1alpha1.ZendeskTarget, error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) Create(ctx context.Context, in *targetsv1beta1.ZendeskTarget, opts v1.CreateOptions) (*targetsv1beta1.ZendeskTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "ZendeskTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10562,61 +22061,61 @@ func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Create(ctx context.Context, in *t
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ZendeskTarget{}
+	out := &targetsv1beta1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) Delete(ctx context.Context, name string, opts v1.DeleteOptions) error {
 	return w.dyn.Namespace(w.namespace).Delete(ctx, name, opts)
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) DeleteCollection(ctx context.Context, opts v1.DeleteOptions, listOpts v1.ListOptions) error {
 	return w.dyn.Namespace(w.namespace).DeleteCollection(ctx, opts, listOpts)
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1alpha1.ZendeskTarget, error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) Get(ctx context.Context, name string, opts v1.GetOptions) (*targetsv1beta1.ZendeskTarget, error) {
 	uo, err := w.dyn.Namespace(w.namespace).Get(ctx, name, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ZendeskTarget{}
+	out := &targetsv1beta1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1alpha1.ZendeskTargetList, error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) List(ctx context.Context, opts v1.ListOptions) (*targetsv1beta1.ZendeskTargetList, error) {
 	uo, err := w.dyn.Namespace(w.namespace).List(ctx, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ZendeskTargetList{}
+	out := &targetsv1beta1.ZendeskTargetList{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1alpha1.ZendeskTarget, err error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) Patch(ctx context.Context, name string, pt types.PatchType, data []byte, opts v1.PatchOptions, subresources ...string) (result *targetsv1beta1.ZendeskTarget, err error) {
 	uo, err := w.dyn.Namespace(w.namespace).Patch(ctx, name, pt, data, opts)
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ZendeskTarget{}
+	out := &targetsv1beta1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Update(ctx context.Context, in *targetsv1alpha1.ZendeskTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ZendeskTarget, error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) Update(ctx context.Context, in *targetsv1beta1.ZendeskTarget, opts v1.UpdateOptions) (*targetsv1beta1.ZendeskTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "ZendeskTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10627,17 +22126,17 @@ func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Update(ctx context.Context, in *t
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ZendeskTarget{}
+	out := &targetsv1beta1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1alpha1.ZendeskTarget, opts v1.UpdateOptions) (*targetsv1alpha1.ZendeskTarget, error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) UpdateStatus(ctx context.Context, in *targetsv1beta1.ZendeskTarget, opts v1.UpdateOptions) (*targetsv1beta1.ZendeskTarget, error) {
 	in.SetGroupVersionKind(schema.GroupVersionKind{
 		Group:   "targets.triggermesh.io",
-		Version: "v1alpha1",
+		Version: "v1beta1",
 		Kind:    "ZendeskTarget",
 	})
 	uo := &unstructured.Unstructured{}
@@ -10648,13 +22147,13 @@ func (w *wrapTargetsV1alpha1ZendeskTargetImpl) UpdateStatus(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
-	out := &targetsv1alpha1.ZendeskTarget{}
+	out := &targetsv1beta1.ZendeskTarget{}
 	if err := convert(uo, out); err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (w *wrapTargetsV1alpha1ZendeskTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
+func (w *wrapTargetsV1beta1ZendeskTargetImpl) Watch(ctx context.Context, opts v1.ListOptions) (watch.Interface, error) {
 	return nil, errors.New("NYI: Watch")
 }
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// EventTypeCatalogLister helps list EventTypeCatalogs.
+// All objects returned here must be treated as read-only.
+type EventTypeCatalogLister interface {
+	// List lists all EventTypeCatalogs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.EventTypeCatalog, err error)
+	// EventTypeCatalogs returns an object that can list and get EventTypeCatalogs.
+	EventTypeCatalogs(namespace string) EventTypeCatalogNamespaceLister
+	EventTypeCatalogListerExpansion
+}
+
+// eventTypeCatalogLister implements the EventTypeCatalogLister interface.
+type eventTypeCatalogLister struct {
+	indexer cache.Indexer
+}
+
+// NewEventTypeCatalogLister returns a new EventTypeCatalogLister.
+func NewEventTypeCatalogLister(indexer cache.Indexer) EventTypeCatalogLister {
+	return &eventTypeCatalogLister{indexer: indexer}
+}
+
+// List lists all EventTypeCatalogs in the indexer.
+func (s *eventTypeCatalogLister) List(selector labels.Selector) (ret []*v1alpha1.EventTypeCatalog, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.EventTypeCatalog))
+	})
+	return ret, err
+}
+
+// EventTypeCatalogs returns an object that can list and get EventTypeCatalogs.
+func (s *eventTypeCatalogLister) EventTypeCatalogs(namespace string) EventTypeCatalogNamespaceLister {
+	return eventTypeCatalogNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// EventTypeCatalogNamespaceLister helps list and get EventTypeCatalogs.
+// All objects returned here must be treated as read-only.
+type EventTypeCatalogNamespaceLister interface {
+	// List lists all EventTypeCatalogs in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.EventTypeCatalog, err error)
+	// Get retrieves the EventTypeCatalog from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.EventTypeCatalog, error)
+	EventTypeCatalogNamespaceListerExpansion
+}
+
+// eventTypeCatalogNamespaceLister implements the EventTypeCatalogNamespaceLister
+// interface.
+type eventTypeCatalogNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all EventTypeCatalogs in the indexer for a given namespace.
+func (s eventTypeCatalogNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.EventTypeCatalog, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.EventTypeCatalog))
+	})
+	return ret, err
+}
+
+// Get retrieves the EventTypeCatalog from the indexer for a given namespace and name.
+func (s eventTypeCatalogNamespaceLister) Get(name string) (*v1alpha1.EventTypeCatalog, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("eventtypecatalog"), name)
+	}
+	return obj.(*v1alpha1.EventTypeCatalog), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1alpha1
+
+import (
+	v1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DeduplicatorLister helps list Deduplicators.
+// All objects returned here must be treated as read-only.
+type DeduplicatorLister interface {
+	// List lists all Deduplicators in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Deduplicator, err error)
+	// Deduplicators returns an object that can list and get Deduplicators.
+	Deduplicators(namespace string) DeduplicatorNamespaceLister
+	DeduplicatorListerExpansion
+}
+
+// deduplicatorLister implements the DeduplicatorLister interface.
+type deduplicatorLister struct {
+	indexer cache.Indexer
+}
+
+// NewDeduplicatorLister returns a new DeduplicatorLister.
+func NewDeduplicatorLister(indexer cache.Indexer) DeduplicatorLister {
+	return &deduplicatorLister{indexer: indexer}
+}
+
+// List lists all Deduplicators in the indexer.
+func (s *deduplicatorLister) List(selector labels.Selector) (ret []*v1alpha1.Deduplicator, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Deduplicator))
+	})
+	return ret, err
+}
+
+// Deduplicators returns an object that can list and get Deduplicators.
+func (s *deduplicatorLister) Deduplicators(namespace string) DeduplicatorNamespaceLister {
+	return deduplicatorNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DeduplicatorNamespaceLister helps list and get Deduplicators.
+// All objects returned here must be treated as read-only.
+type DeduplicatorNamespaceLister interface {
+	// List lists all Deduplicators in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1alpha1.Deduplicator, err error)
+	// Get retrieves the Deduplicator from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1alpha1.Deduplicator, error)
+	DeduplicatorNamespaceListerExpansion
+}
+
+// deduplicatorNamespaceLister implements the DeduplicatorNamespaceLister
+// interface.
+type deduplicatorNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Deduplicators in the indexer for a given namespace.
+func (s deduplicatorNamespaceLister) List(selector labels.Selector) (ret []*v1alpha1.Deduplicator, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1alpha1.Deduplicator))
+	})
+	return ret, err
+}
+
+// Get retrieves the Deduplicator from the indexer for a given namespace and name.
+func (s deduplicatorNamespaceLister) Get(name string) (*v1alpha1.Deduplicator, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1alpha1.Resource("deduplicator"), name)
+	}
+	return obj.(*v1alpha1.Deduplicator), nil
+}
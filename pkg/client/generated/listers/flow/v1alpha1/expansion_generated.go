@@ -18,6 +18,22 @@ limitations under the License.
 
 package v1alpha1
 
+// BridgeListerExpansion allows custom methods to be added to
+// BridgeLister.
+type BridgeListerExpansion interface{}
+
+// BridgeNamespaceListerExpansion allows custom methods to be added to
+// BridgeNamespaceLister.
+type BridgeNamespaceListerExpansion interface{}
+
+// DeduplicatorListerExpansion allows custom methods to be added to
+// DeduplicatorLister.
+type DeduplicatorListerExpansion interface{}
+
+// DeduplicatorNamespaceListerExpansion allows custom methods to be added to
+// DeduplicatorNamespaceLister.
+type DeduplicatorNamespaceListerExpansion interface{}
+
 // JQTransformationListerExpansion allows custom methods to be added to
 // JQTransformationLister.
 type JQTransformationListerExpansion interface{}
@@ -26,6 +42,14 @@ type JQTransformationListerExpansion interface{}
 // JQTransformationNamespaceLister.
 type JQTransformationNamespaceListerExpansion interface{}
 
+// SchemaValidatorListerExpansion allows custom methods to be added to
+// SchemaValidatorLister.
+type SchemaValidatorListerExpansion interface{}
+
+// SchemaValidatorNamespaceListerExpansion allows custom methods to be added to
+// SchemaValidatorNamespaceLister.
+type SchemaValidatorNamespaceListerExpansion interface{}
+
 // SynchronizerListerExpansion allows custom methods to be added to
 // SynchronizerLister.
 type SynchronizerListerExpansion interface{}
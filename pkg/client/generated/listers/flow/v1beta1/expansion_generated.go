@@ -0,0 +1,83 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// BridgeListerExpansion allows custom methods to be added to
+// BridgeLister.
+type BridgeListerExpansion interface{}
+
+// BridgeNamespaceListerExpansion allows custom methods to be added to
+// BridgeNamespaceLister.
+type BridgeNamespaceListerExpansion interface{}
+
+// DeduplicatorListerExpansion allows custom methods to be added to
+// DeduplicatorLister.
+type DeduplicatorListerExpansion interface{}
+
+// DeduplicatorNamespaceListerExpansion allows custom methods to be added to
+// DeduplicatorNamespaceLister.
+type DeduplicatorNamespaceListerExpansion interface{}
+
+// JQTransformationListerExpansion allows custom methods to be added to
+// JQTransformationLister.
+type JQTransformationListerExpansion interface{}
+
+// JQTransformationNamespaceListerExpansion allows custom methods to be added to
+// JQTransformationNamespaceLister.
+type JQTransformationNamespaceListerExpansion interface{}
+
+// SchemaValidatorListerExpansion allows custom methods to be added to
+// SchemaValidatorLister.
+type SchemaValidatorListerExpansion interface{}
+
+// SchemaValidatorNamespaceListerExpansion allows custom methods to be added to
+// SchemaValidatorNamespaceLister.
+type SchemaValidatorNamespaceListerExpansion interface{}
+
+// SynchronizerListerExpansion allows custom methods to be added to
+// SynchronizerLister.
+type SynchronizerListerExpansion interface{}
+
+// SynchronizerNamespaceListerExpansion allows custom methods to be added to
+// SynchronizerNamespaceLister.
+type SynchronizerNamespaceListerExpansion interface{}
+
+// TransformationListerExpansion allows custom methods to be added to
+// TransformationLister.
+type TransformationListerExpansion interface{}
+
+// TransformationNamespaceListerExpansion allows custom methods to be added to
+// TransformationNamespaceLister.
+type TransformationNamespaceListerExpansion interface{}
+
+// XMLToJSONTransformationListerExpansion allows custom methods to be added to
+// XMLToJSONTransformationLister.
+type XMLToJSONTransformationListerExpansion interface{}
+
+// XMLToJSONTransformationNamespaceListerExpansion allows custom methods to be added to
+// XMLToJSONTransformationNamespaceLister.
+type XMLToJSONTransformationNamespaceListerExpansion interface{}
+
+// XSLTTransformationListerExpansion allows custom methods to be added to
+// XSLTTransformationLister.
+type XSLTTransformationListerExpansion interface{}
+
+// XSLTTransformationNamespaceListerExpansion allows custom methods to be added to
+// XSLTTransformationNamespaceLister.
+type XSLTTransformationNamespaceListerExpansion interface{}
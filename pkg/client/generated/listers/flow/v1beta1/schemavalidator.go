@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SchemaValidatorLister helps list SchemaValidators.
+// All objects returned here must be treated as read-only.
+type SchemaValidatorLister interface {
+	// List lists all SchemaValidators in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.SchemaValidator, err error)
+	// SchemaValidators returns an object that can list and get SchemaValidators.
+	SchemaValidators(namespace string) SchemaValidatorNamespaceLister
+	SchemaValidatorListerExpansion
+}
+
+// schemaValidatorLister implements the SchemaValidatorLister interface.
+type schemaValidatorLister struct {
+	indexer cache.Indexer
+}
+
+// NewSchemaValidatorLister returns a new SchemaValidatorLister.
+func NewSchemaValidatorLister(indexer cache.Indexer) SchemaValidatorLister {
+	return &schemaValidatorLister{indexer: indexer}
+}
+
+// List lists all SchemaValidators in the indexer.
+func (s *schemaValidatorLister) List(selector labels.Selector) (ret []*v1beta1.SchemaValidator, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.SchemaValidator))
+	})
+	return ret, err
+}
+
+// SchemaValidators returns an object that can list and get SchemaValidators.
+func (s *schemaValidatorLister) SchemaValidators(namespace string) SchemaValidatorNamespaceLister {
+	return schemaValidatorNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// SchemaValidatorNamespaceLister helps list and get SchemaValidators.
+// All objects returned here must be treated as read-only.
+type SchemaValidatorNamespaceLister interface {
+	// List lists all SchemaValidators in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.SchemaValidator, err error)
+	// Get retrieves the SchemaValidator from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.SchemaValidator, error)
+	SchemaValidatorNamespaceListerExpansion
+}
+
+// schemaValidatorNamespaceLister implements the SchemaValidatorNamespaceLister
+// interface.
+type schemaValidatorNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all SchemaValidators in the indexer for a given namespace.
+func (s schemaValidatorNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.SchemaValidator, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.SchemaValidator))
+	})
+	return ret, err
+}
+
+// Get retrieves the SchemaValidator from the indexer for a given namespace and name.
+func (s schemaValidatorNamespaceLister) Get(name string) (*v1beta1.SchemaValidator, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("schemavalidator"), name)
+	}
+	return obj.(*v1beta1.SchemaValidator), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AWSDynamoDBSourceLister helps list AWSDynamoDBSources.
+// All objects returned here must be treated as read-only.
+type AWSDynamoDBSourceLister interface {
+	// List lists all AWSDynamoDBSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AWSDynamoDBSource, err error)
+	// AWSDynamoDBSources returns an object that can list and get AWSDynamoDBSources.
+	AWSDynamoDBSources(namespace string) AWSDynamoDBSourceNamespaceLister
+	AWSDynamoDBSourceListerExpansion
+}
+
+// aWSDynamoDBSourceLister implements the AWSDynamoDBSourceLister interface.
+type aWSDynamoDBSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewAWSDynamoDBSourceLister returns a new AWSDynamoDBSourceLister.
+func NewAWSDynamoDBSourceLister(indexer cache.Indexer) AWSDynamoDBSourceLister {
+	return &aWSDynamoDBSourceLister{indexer: indexer}
+}
+
+// List lists all AWSDynamoDBSources in the indexer.
+func (s *aWSDynamoDBSourceLister) List(selector labels.Selector) (ret []*v1beta1.AWSDynamoDBSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AWSDynamoDBSource))
+	})
+	return ret, err
+}
+
+// AWSDynamoDBSources returns an object that can list and get AWSDynamoDBSources.
+func (s *aWSDynamoDBSourceLister) AWSDynamoDBSources(namespace string) AWSDynamoDBSourceNamespaceLister {
+	return aWSDynamoDBSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AWSDynamoDBSourceNamespaceLister helps list and get AWSDynamoDBSources.
+// All objects returned here must be treated as read-only.
+type AWSDynamoDBSourceNamespaceLister interface {
+	// List lists all AWSDynamoDBSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AWSDynamoDBSource, err error)
+	// Get retrieves the AWSDynamoDBSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.AWSDynamoDBSource, error)
+	AWSDynamoDBSourceNamespaceListerExpansion
+}
+
+// aWSDynamoDBSourceNamespaceLister implements the AWSDynamoDBSourceNamespaceLister
+// interface.
+type aWSDynamoDBSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AWSDynamoDBSources in the indexer for a given namespace.
+func (s aWSDynamoDBSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.AWSDynamoDBSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AWSDynamoDBSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the AWSDynamoDBSource from the indexer for a given namespace and name.
+func (s aWSDynamoDBSourceNamespaceLister) Get(name string) (*v1beta1.AWSDynamoDBSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("awsdynamodbsource"), name)
+	}
+	return obj.(*v1beta1.AWSDynamoDBSource), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AWSSQSSourceLister helps list AWSSQSSources.
+// All objects returned here must be treated as read-only.
+type AWSSQSSourceLister interface {
+	// List lists all AWSSQSSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AWSSQSSource, err error)
+	// AWSSQSSources returns an object that can list and get AWSSQSSources.
+	AWSSQSSources(namespace string) AWSSQSSourceNamespaceLister
+	AWSSQSSourceListerExpansion
+}
+
+// aWSSQSSourceLister implements the AWSSQSSourceLister interface.
+type aWSSQSSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewAWSSQSSourceLister returns a new AWSSQSSourceLister.
+func NewAWSSQSSourceLister(indexer cache.Indexer) AWSSQSSourceLister {
+	return &aWSSQSSourceLister{indexer: indexer}
+}
+
+// List lists all AWSSQSSources in the indexer.
+func (s *aWSSQSSourceLister) List(selector labels.Selector) (ret []*v1beta1.AWSSQSSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AWSSQSSource))
+	})
+	return ret, err
+}
+
+// AWSSQSSources returns an object that can list and get AWSSQSSources.
+func (s *aWSSQSSourceLister) AWSSQSSources(namespace string) AWSSQSSourceNamespaceLister {
+	return aWSSQSSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AWSSQSSourceNamespaceLister helps list and get AWSSQSSources.
+// All objects returned here must be treated as read-only.
+type AWSSQSSourceNamespaceLister interface {
+	// List lists all AWSSQSSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AWSSQSSource, err error)
+	// Get retrieves the AWSSQSSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.AWSSQSSource, error)
+	AWSSQSSourceNamespaceListerExpansion
+}
+
+// aWSSQSSourceNamespaceLister implements the AWSSQSSourceNamespaceLister
+// interface.
+type aWSSQSSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AWSSQSSources in the indexer for a given namespace.
+func (s aWSSQSSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.AWSSQSSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AWSSQSSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the AWSSQSSource from the indexer for a given namespace and name.
+func (s aWSSQSSourceNamespaceLister) Get(name string) (*v1beta1.AWSSQSSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("awssqssource"), name)
+	}
+	return obj.(*v1beta1.AWSSQSSource), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AzureActivityLogsSourceLister helps list AzureActivityLogsSources.
+// All objects returned here must be treated as read-only.
+type AzureActivityLogsSourceLister interface {
+	// List lists all AzureActivityLogsSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AzureActivityLogsSource, err error)
+	// AzureActivityLogsSources returns an object that can list and get AzureActivityLogsSources.
+	AzureActivityLogsSources(namespace string) AzureActivityLogsSourceNamespaceLister
+	AzureActivityLogsSourceListerExpansion
+}
+
+// azureActivityLogsSourceLister implements the AzureActivityLogsSourceLister interface.
+type azureActivityLogsSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewAzureActivityLogsSourceLister returns a new AzureActivityLogsSourceLister.
+func NewAzureActivityLogsSourceLister(indexer cache.Indexer) AzureActivityLogsSourceLister {
+	return &azureActivityLogsSourceLister{indexer: indexer}
+}
+
+// List lists all AzureActivityLogsSources in the indexer.
+func (s *azureActivityLogsSourceLister) List(selector labels.Selector) (ret []*v1beta1.AzureActivityLogsSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AzureActivityLogsSource))
+	})
+	return ret, err
+}
+
+// AzureActivityLogsSources returns an object that can list and get AzureActivityLogsSources.
+func (s *azureActivityLogsSourceLister) AzureActivityLogsSources(namespace string) AzureActivityLogsSourceNamespaceLister {
+	return azureActivityLogsSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AzureActivityLogsSourceNamespaceLister helps list and get AzureActivityLogsSources.
+// All objects returned here must be treated as read-only.
+type AzureActivityLogsSourceNamespaceLister interface {
+	// List lists all AzureActivityLogsSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AzureActivityLogsSource, err error)
+	// Get retrieves the AzureActivityLogsSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.AzureActivityLogsSource, error)
+	AzureActivityLogsSourceNamespaceListerExpansion
+}
+
+// azureActivityLogsSourceNamespaceLister implements the AzureActivityLogsSourceNamespaceLister
+// interface.
+type azureActivityLogsSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AzureActivityLogsSources in the indexer for a given namespace.
+func (s azureActivityLogsSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.AzureActivityLogsSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AzureActivityLogsSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the AzureActivityLogsSource from the indexer for a given namespace and name.
+func (s azureActivityLogsSourceNamespaceLister) Get(name string) (*v1beta1.AzureActivityLogsSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("azureactivitylogssource"), name)
+	}
+	return obj.(*v1beta1.AzureActivityLogsSource), nil
+}
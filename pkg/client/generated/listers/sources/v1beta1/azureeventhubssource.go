@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AzureEventHubsSourceLister helps list AzureEventHubsSources.
+// All objects returned here must be treated as read-only.
+type AzureEventHubsSourceLister interface {
+	// List lists all AzureEventHubsSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AzureEventHubsSource, err error)
+	// AzureEventHubsSources returns an object that can list and get AzureEventHubsSources.
+	AzureEventHubsSources(namespace string) AzureEventHubsSourceNamespaceLister
+	AzureEventHubsSourceListerExpansion
+}
+
+// azureEventHubsSourceLister implements the AzureEventHubsSourceLister interface.
+type azureEventHubsSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewAzureEventHubsSourceLister returns a new AzureEventHubsSourceLister.
+func NewAzureEventHubsSourceLister(indexer cache.Indexer) AzureEventHubsSourceLister {
+	return &azureEventHubsSourceLister{indexer: indexer}
+}
+
+// List lists all AzureEventHubsSources in the indexer.
+func (s *azureEventHubsSourceLister) List(selector labels.Selector) (ret []*v1beta1.AzureEventHubsSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AzureEventHubsSource))
+	})
+	return ret, err
+}
+
+// AzureEventHubsSources returns an object that can list and get AzureEventHubsSources.
+func (s *azureEventHubsSourceLister) AzureEventHubsSources(namespace string) AzureEventHubsSourceNamespaceLister {
+	return azureEventHubsSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AzureEventHubsSourceNamespaceLister helps list and get AzureEventHubsSources.
+// All objects returned here must be treated as read-only.
+type AzureEventHubsSourceNamespaceLister interface {
+	// List lists all AzureEventHubsSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AzureEventHubsSource, err error)
+	// Get retrieves the AzureEventHubsSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.AzureEventHubsSource, error)
+	AzureEventHubsSourceNamespaceListerExpansion
+}
+
+// azureEventHubsSourceNamespaceLister implements the AzureEventHubsSourceNamespaceLister
+// interface.
+type azureEventHubsSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AzureEventHubsSources in the indexer for a given namespace.
+func (s azureEventHubsSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.AzureEventHubsSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AzureEventHubsSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the AzureEventHubsSource from the indexer for a given namespace and name.
+func (s azureEventHubsSourceNamespaceLister) Get(name string) (*v1beta1.AzureEventHubsSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("azureeventhubssource"), name)
+	}
+	return obj.(*v1beta1.AzureEventHubsSource), nil
+}
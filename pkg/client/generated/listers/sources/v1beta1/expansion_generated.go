@@ -0,0 +1,323 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// AWSCloudWatchLogsSourceListerExpansion allows custom methods to be added to
+// AWSCloudWatchLogsSourceLister.
+type AWSCloudWatchLogsSourceListerExpansion interface{}
+
+// AWSCloudWatchLogsSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSCloudWatchLogsSourceNamespaceLister.
+type AWSCloudWatchLogsSourceNamespaceListerExpansion interface{}
+
+// AWSCloudWatchSourceListerExpansion allows custom methods to be added to
+// AWSCloudWatchSourceLister.
+type AWSCloudWatchSourceListerExpansion interface{}
+
+// AWSCloudWatchSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSCloudWatchSourceNamespaceLister.
+type AWSCloudWatchSourceNamespaceListerExpansion interface{}
+
+// AWSCodeCommitSourceListerExpansion allows custom methods to be added to
+// AWSCodeCommitSourceLister.
+type AWSCodeCommitSourceListerExpansion interface{}
+
+// AWSCodeCommitSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSCodeCommitSourceNamespaceLister.
+type AWSCodeCommitSourceNamespaceListerExpansion interface{}
+
+// AWSCognitoIdentitySourceListerExpansion allows custom methods to be added to
+// AWSCognitoIdentitySourceLister.
+type AWSCognitoIdentitySourceListerExpansion interface{}
+
+// AWSCognitoIdentitySourceNamespaceListerExpansion allows custom methods to be added to
+// AWSCognitoIdentitySourceNamespaceLister.
+type AWSCognitoIdentitySourceNamespaceListerExpansion interface{}
+
+// AWSCognitoUserPoolSourceListerExpansion allows custom methods to be added to
+// AWSCognitoUserPoolSourceLister.
+type AWSCognitoUserPoolSourceListerExpansion interface{}
+
+// AWSCognitoUserPoolSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSCognitoUserPoolSourceNamespaceLister.
+type AWSCognitoUserPoolSourceNamespaceListerExpansion interface{}
+
+// AWSDynamoDBSourceListerExpansion allows custom methods to be added to
+// AWSDynamoDBSourceLister.
+type AWSDynamoDBSourceListerExpansion interface{}
+
+// AWSDynamoDBSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSDynamoDBSourceNamespaceLister.
+type AWSDynamoDBSourceNamespaceListerExpansion interface{}
+
+// AWSEventBridgeSourceListerExpansion allows custom methods to be added to
+// AWSEventBridgeSourceLister.
+type AWSEventBridgeSourceListerExpansion interface{}
+
+// AWSEventBridgeSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSEventBridgeSourceNamespaceLister.
+type AWSEventBridgeSourceNamespaceListerExpansion interface{}
+
+// AWSKinesisSourceListerExpansion allows custom methods to be added to
+// AWSKinesisSourceLister.
+type AWSKinesisSourceListerExpansion interface{}
+
+// AWSKinesisSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSKinesisSourceNamespaceLister.
+type AWSKinesisSourceNamespaceListerExpansion interface{}
+
+// AWSPerformanceInsightsSourceListerExpansion allows custom methods to be added to
+// AWSPerformanceInsightsSourceLister.
+type AWSPerformanceInsightsSourceListerExpansion interface{}
+
+// AWSPerformanceInsightsSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSPerformanceInsightsSourceNamespaceLister.
+type AWSPerformanceInsightsSourceNamespaceListerExpansion interface{}
+
+// AWSS3SourceListerExpansion allows custom methods to be added to
+// AWSS3SourceLister.
+type AWSS3SourceListerExpansion interface{}
+
+// AWSS3SourceNamespaceListerExpansion allows custom methods to be added to
+// AWSS3SourceNamespaceLister.
+type AWSS3SourceNamespaceListerExpansion interface{}
+
+// AWSSNSSourceListerExpansion allows custom methods to be added to
+// AWSSNSSourceLister.
+type AWSSNSSourceListerExpansion interface{}
+
+// AWSSNSSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSSNSSourceNamespaceLister.
+type AWSSNSSourceNamespaceListerExpansion interface{}
+
+// AWSSQSSourceListerExpansion allows custom methods to be added to
+// AWSSQSSourceLister.
+type AWSSQSSourceListerExpansion interface{}
+
+// AWSSQSSourceNamespaceListerExpansion allows custom methods to be added to
+// AWSSQSSourceNamespaceLister.
+type AWSSQSSourceNamespaceListerExpansion interface{}
+
+// AzureActivityLogsSourceListerExpansion allows custom methods to be added to
+// AzureActivityLogsSourceLister.
+type AzureActivityLogsSourceListerExpansion interface{}
+
+// AzureActivityLogsSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureActivityLogsSourceNamespaceLister.
+type AzureActivityLogsSourceNamespaceListerExpansion interface{}
+
+// AzureBlobStorageSourceListerExpansion allows custom methods to be added to
+// AzureBlobStorageSourceLister.
+type AzureBlobStorageSourceListerExpansion interface{}
+
+// AzureBlobStorageSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureBlobStorageSourceNamespaceLister.
+type AzureBlobStorageSourceNamespaceListerExpansion interface{}
+
+// AzureEventGridSourceListerExpansion allows custom methods to be added to
+// AzureEventGridSourceLister.
+type AzureEventGridSourceListerExpansion interface{}
+
+// AzureEventGridSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureEventGridSourceNamespaceLister.
+type AzureEventGridSourceNamespaceListerExpansion interface{}
+
+// AzureEventHubsSourceListerExpansion allows custom methods to be added to
+// AzureEventHubsSourceLister.
+type AzureEventHubsSourceListerExpansion interface{}
+
+// AzureEventHubsSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureEventHubsSourceNamespaceLister.
+type AzureEventHubsSourceNamespaceListerExpansion interface{}
+
+// AzureIOTHubSourceListerExpansion allows custom methods to be added to
+// AzureIOTHubSourceLister.
+type AzureIOTHubSourceListerExpansion interface{}
+
+// AzureIOTHubSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureIOTHubSourceNamespaceLister.
+type AzureIOTHubSourceNamespaceListerExpansion interface{}
+
+// AzureQueueStorageSourceListerExpansion allows custom methods to be added to
+// AzureQueueStorageSourceLister.
+type AzureQueueStorageSourceListerExpansion interface{}
+
+// AzureQueueStorageSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureQueueStorageSourceNamespaceLister.
+type AzureQueueStorageSourceNamespaceListerExpansion interface{}
+
+// AzureServiceBusQueueSourceListerExpansion allows custom methods to be added to
+// AzureServiceBusQueueSourceLister.
+type AzureServiceBusQueueSourceListerExpansion interface{}
+
+// AzureServiceBusQueueSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureServiceBusQueueSourceNamespaceLister.
+type AzureServiceBusQueueSourceNamespaceListerExpansion interface{}
+
+// AzureServiceBusSourceListerExpansion allows custom methods to be added to
+// AzureServiceBusSourceLister.
+type AzureServiceBusSourceListerExpansion interface{}
+
+// AzureServiceBusSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureServiceBusSourceNamespaceLister.
+type AzureServiceBusSourceNamespaceListerExpansion interface{}
+
+// AzureServiceBusTopicSourceListerExpansion allows custom methods to be added to
+// AzureServiceBusTopicSourceLister.
+type AzureServiceBusTopicSourceListerExpansion interface{}
+
+// AzureServiceBusTopicSourceNamespaceListerExpansion allows custom methods to be added to
+// AzureServiceBusTopicSourceNamespaceLister.
+type AzureServiceBusTopicSourceNamespaceListerExpansion interface{}
+
+// CloudEventsSourceListerExpansion allows custom methods to be added to
+// CloudEventsSourceLister.
+type CloudEventsSourceListerExpansion interface{}
+
+// CloudEventsSourceNamespaceListerExpansion allows custom methods to be added to
+// CloudEventsSourceNamespaceLister.
+type CloudEventsSourceNamespaceListerExpansion interface{}
+
+// GoogleCloudAuditLogsSourceListerExpansion allows custom methods to be added to
+// GoogleCloudAuditLogsSourceLister.
+type GoogleCloudAuditLogsSourceListerExpansion interface{}
+
+// GoogleCloudAuditLogsSourceNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudAuditLogsSourceNamespaceLister.
+type GoogleCloudAuditLogsSourceNamespaceListerExpansion interface{}
+
+// GoogleCloudBillingSourceListerExpansion allows custom methods to be added to
+// GoogleCloudBillingSourceLister.
+type GoogleCloudBillingSourceListerExpansion interface{}
+
+// GoogleCloudBillingSourceNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudBillingSourceNamespaceLister.
+type GoogleCloudBillingSourceNamespaceListerExpansion interface{}
+
+// GoogleCloudPubSubSourceListerExpansion allows custom methods to be added to
+// GoogleCloudPubSubSourceLister.
+type GoogleCloudPubSubSourceListerExpansion interface{}
+
+// GoogleCloudPubSubSourceNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudPubSubSourceNamespaceLister.
+type GoogleCloudPubSubSourceNamespaceListerExpansion interface{}
+
+// GoogleCloudSourceRepositoriesSourceListerExpansion allows custom methods to be added to
+// GoogleCloudSourceRepositoriesSourceLister.
+type GoogleCloudSourceRepositoriesSourceListerExpansion interface{}
+
+// GoogleCloudSourceRepositoriesSourceNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudSourceRepositoriesSourceNamespaceLister.
+type GoogleCloudSourceRepositoriesSourceNamespaceListerExpansion interface{}
+
+// GoogleCloudStorageSourceListerExpansion allows custom methods to be added to
+// GoogleCloudStorageSourceLister.
+type GoogleCloudStorageSourceListerExpansion interface{}
+
+// GoogleCloudStorageSourceNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudStorageSourceNamespaceLister.
+type GoogleCloudStorageSourceNamespaceListerExpansion interface{}
+
+// HTTPPollerSourceListerExpansion allows custom methods to be added to
+// HTTPPollerSourceLister.
+type HTTPPollerSourceListerExpansion interface{}
+
+// HTTPPollerSourceNamespaceListerExpansion allows custom methods to be added to
+// HTTPPollerSourceNamespaceLister.
+type HTTPPollerSourceNamespaceListerExpansion interface{}
+
+// IBMMQSourceListerExpansion allows custom methods to be added to
+// IBMMQSourceLister.
+type IBMMQSourceListerExpansion interface{}
+
+// IBMMQSourceNamespaceListerExpansion allows custom methods to be added to
+// IBMMQSourceNamespaceLister.
+type IBMMQSourceNamespaceListerExpansion interface{}
+
+// KafkaSourceListerExpansion allows custom methods to be added to
+// KafkaSourceLister.
+type KafkaSourceListerExpansion interface{}
+
+// KafkaSourceNamespaceListerExpansion allows custom methods to be added to
+// KafkaSourceNamespaceLister.
+type KafkaSourceNamespaceListerExpansion interface{}
+
+// MongoDBSourceListerExpansion allows custom methods to be added to
+// MongoDBSourceLister.
+type MongoDBSourceListerExpansion interface{}
+
+// MongoDBSourceNamespaceListerExpansion allows custom methods to be added to
+// MongoDBSourceNamespaceLister.
+type MongoDBSourceNamespaceListerExpansion interface{}
+
+// OCIMetricsSourceListerExpansion allows custom methods to be added to
+// OCIMetricsSourceLister.
+type OCIMetricsSourceListerExpansion interface{}
+
+// OCIMetricsSourceNamespaceListerExpansion allows custom methods to be added to
+// OCIMetricsSourceNamespaceLister.
+type OCIMetricsSourceNamespaceListerExpansion interface{}
+
+// SalesforceSourceListerExpansion allows custom methods to be added to
+// SalesforceSourceLister.
+type SalesforceSourceListerExpansion interface{}
+
+// SalesforceSourceNamespaceListerExpansion allows custom methods to be added to
+// SalesforceSourceNamespaceLister.
+type SalesforceSourceNamespaceListerExpansion interface{}
+
+// SlackSourceListerExpansion allows custom methods to be added to
+// SlackSourceLister.
+type SlackSourceListerExpansion interface{}
+
+// SlackSourceNamespaceListerExpansion allows custom methods to be added to
+// SlackSourceNamespaceLister.
+type SlackSourceNamespaceListerExpansion interface{}
+
+// SolaceSourceListerExpansion allows custom methods to be added to
+// SolaceSourceLister.
+type SolaceSourceListerExpansion interface{}
+
+// SolaceSourceNamespaceListerExpansion allows custom methods to be added to
+// SolaceSourceNamespaceLister.
+type SolaceSourceNamespaceListerExpansion interface{}
+
+// TwilioSourceListerExpansion allows custom methods to be added to
+// TwilioSourceLister.
+type TwilioSourceListerExpansion interface{}
+
+// TwilioSourceNamespaceListerExpansion allows custom methods to be added to
+// TwilioSourceNamespaceLister.
+type TwilioSourceNamespaceListerExpansion interface{}
+
+// WebhookSourceListerExpansion allows custom methods to be added to
+// WebhookSourceLister.
+type WebhookSourceListerExpansion interface{}
+
+// WebhookSourceNamespaceListerExpansion allows custom methods to be added to
+// WebhookSourceNamespaceLister.
+type WebhookSourceNamespaceListerExpansion interface{}
+
+// ZendeskSourceListerExpansion allows custom methods to be added to
+// ZendeskSourceLister.
+type ZendeskSourceListerExpansion interface{}
+
+// ZendeskSourceNamespaceListerExpansion allows custom methods to be added to
+// ZendeskSourceNamespaceLister.
+type ZendeskSourceNamespaceListerExpansion interface{}
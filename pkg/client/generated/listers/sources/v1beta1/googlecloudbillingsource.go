@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// GoogleCloudBillingSourceLister helps list GoogleCloudBillingSources.
+// All objects returned here must be treated as read-only.
+type GoogleCloudBillingSourceLister interface {
+	// List lists all GoogleCloudBillingSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleCloudBillingSource, err error)
+	// GoogleCloudBillingSources returns an object that can list and get GoogleCloudBillingSources.
+	GoogleCloudBillingSources(namespace string) GoogleCloudBillingSourceNamespaceLister
+	GoogleCloudBillingSourceListerExpansion
+}
+
+// googleCloudBillingSourceLister implements the GoogleCloudBillingSourceLister interface.
+type googleCloudBillingSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewGoogleCloudBillingSourceLister returns a new GoogleCloudBillingSourceLister.
+func NewGoogleCloudBillingSourceLister(indexer cache.Indexer) GoogleCloudBillingSourceLister {
+	return &googleCloudBillingSourceLister{indexer: indexer}
+}
+
+// List lists all GoogleCloudBillingSources in the indexer.
+func (s *googleCloudBillingSourceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleCloudBillingSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleCloudBillingSource))
+	})
+	return ret, err
+}
+
+// GoogleCloudBillingSources returns an object that can list and get GoogleCloudBillingSources.
+func (s *googleCloudBillingSourceLister) GoogleCloudBillingSources(namespace string) GoogleCloudBillingSourceNamespaceLister {
+	return googleCloudBillingSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// GoogleCloudBillingSourceNamespaceLister helps list and get GoogleCloudBillingSources.
+// All objects returned here must be treated as read-only.
+type GoogleCloudBillingSourceNamespaceLister interface {
+	// List lists all GoogleCloudBillingSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleCloudBillingSource, err error)
+	// Get retrieves the GoogleCloudBillingSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.GoogleCloudBillingSource, error)
+	GoogleCloudBillingSourceNamespaceListerExpansion
+}
+
+// googleCloudBillingSourceNamespaceLister implements the GoogleCloudBillingSourceNamespaceLister
+// interface.
+type googleCloudBillingSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all GoogleCloudBillingSources in the indexer for a given namespace.
+func (s googleCloudBillingSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleCloudBillingSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleCloudBillingSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the GoogleCloudBillingSource from the indexer for a given namespace and name.
+func (s googleCloudBillingSourceNamespaceLister) Get(name string) (*v1beta1.GoogleCloudBillingSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("googlecloudbillingsource"), name)
+	}
+	return obj.(*v1beta1.GoogleCloudBillingSource), nil
+}
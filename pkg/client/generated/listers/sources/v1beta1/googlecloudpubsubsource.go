@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// GoogleCloudPubSubSourceLister helps list GoogleCloudPubSubSources.
+// All objects returned here must be treated as read-only.
+type GoogleCloudPubSubSourceLister interface {
+	// List lists all GoogleCloudPubSubSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleCloudPubSubSource, err error)
+	// GoogleCloudPubSubSources returns an object that can list and get GoogleCloudPubSubSources.
+	GoogleCloudPubSubSources(namespace string) GoogleCloudPubSubSourceNamespaceLister
+	GoogleCloudPubSubSourceListerExpansion
+}
+
+// googleCloudPubSubSourceLister implements the GoogleCloudPubSubSourceLister interface.
+type googleCloudPubSubSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewGoogleCloudPubSubSourceLister returns a new GoogleCloudPubSubSourceLister.
+func NewGoogleCloudPubSubSourceLister(indexer cache.Indexer) GoogleCloudPubSubSourceLister {
+	return &googleCloudPubSubSourceLister{indexer: indexer}
+}
+
+// List lists all GoogleCloudPubSubSources in the indexer.
+func (s *googleCloudPubSubSourceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleCloudPubSubSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleCloudPubSubSource))
+	})
+	return ret, err
+}
+
+// GoogleCloudPubSubSources returns an object that can list and get GoogleCloudPubSubSources.
+func (s *googleCloudPubSubSourceLister) GoogleCloudPubSubSources(namespace string) GoogleCloudPubSubSourceNamespaceLister {
+	return googleCloudPubSubSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// GoogleCloudPubSubSourceNamespaceLister helps list and get GoogleCloudPubSubSources.
+// All objects returned here must be treated as read-only.
+type GoogleCloudPubSubSourceNamespaceLister interface {
+	// List lists all GoogleCloudPubSubSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleCloudPubSubSource, err error)
+	// Get retrieves the GoogleCloudPubSubSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.GoogleCloudPubSubSource, error)
+	GoogleCloudPubSubSourceNamespaceListerExpansion
+}
+
+// googleCloudPubSubSourceNamespaceLister implements the GoogleCloudPubSubSourceNamespaceLister
+// interface.
+type googleCloudPubSubSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all GoogleCloudPubSubSources in the indexer for a given namespace.
+func (s googleCloudPubSubSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleCloudPubSubSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleCloudPubSubSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the GoogleCloudPubSubSource from the indexer for a given namespace and name.
+func (s googleCloudPubSubSourceNamespaceLister) Get(name string) (*v1beta1.GoogleCloudPubSubSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("googlecloudpubsubsource"), name)
+	}
+	return obj.(*v1beta1.GoogleCloudPubSubSource), nil
+}
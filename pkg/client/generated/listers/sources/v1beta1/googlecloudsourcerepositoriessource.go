@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// GoogleCloudSourceRepositoriesSourceLister helps list GoogleCloudSourceRepositoriesSources.
+// All objects returned here must be treated as read-only.
+type GoogleCloudSourceRepositoriesSourceLister interface {
+	// List lists all GoogleCloudSourceRepositoriesSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleCloudSourceRepositoriesSource, err error)
+	// GoogleCloudSourceRepositoriesSources returns an object that can list and get GoogleCloudSourceRepositoriesSources.
+	GoogleCloudSourceRepositoriesSources(namespace string) GoogleCloudSourceRepositoriesSourceNamespaceLister
+	GoogleCloudSourceRepositoriesSourceListerExpansion
+}
+
+// googleCloudSourceRepositoriesSourceLister implements the GoogleCloudSourceRepositoriesSourceLister interface.
+type googleCloudSourceRepositoriesSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewGoogleCloudSourceRepositoriesSourceLister returns a new GoogleCloudSourceRepositoriesSourceLister.
+func NewGoogleCloudSourceRepositoriesSourceLister(indexer cache.Indexer) GoogleCloudSourceRepositoriesSourceLister {
+	return &googleCloudSourceRepositoriesSourceLister{indexer: indexer}
+}
+
+// List lists all GoogleCloudSourceRepositoriesSources in the indexer.
+func (s *googleCloudSourceRepositoriesSourceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleCloudSourceRepositoriesSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleCloudSourceRepositoriesSource))
+	})
+	return ret, err
+}
+
+// GoogleCloudSourceRepositoriesSources returns an object that can list and get GoogleCloudSourceRepositoriesSources.
+func (s *googleCloudSourceRepositoriesSourceLister) GoogleCloudSourceRepositoriesSources(namespace string) GoogleCloudSourceRepositoriesSourceNamespaceLister {
+	return googleCloudSourceRepositoriesSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// GoogleCloudSourceRepositoriesSourceNamespaceLister helps list and get GoogleCloudSourceRepositoriesSources.
+// All objects returned here must be treated as read-only.
+type GoogleCloudSourceRepositoriesSourceNamespaceLister interface {
+	// List lists all GoogleCloudSourceRepositoriesSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleCloudSourceRepositoriesSource, err error)
+	// Get retrieves the GoogleCloudSourceRepositoriesSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.GoogleCloudSourceRepositoriesSource, error)
+	GoogleCloudSourceRepositoriesSourceNamespaceListerExpansion
+}
+
+// googleCloudSourceRepositoriesSourceNamespaceLister implements the GoogleCloudSourceRepositoriesSourceNamespaceLister
+// interface.
+type googleCloudSourceRepositoriesSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all GoogleCloudSourceRepositoriesSources in the indexer for a given namespace.
+func (s googleCloudSourceRepositoriesSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleCloudSourceRepositoriesSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleCloudSourceRepositoriesSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the GoogleCloudSourceRepositoriesSource from the indexer for a given namespace and name.
+func (s googleCloudSourceRepositoriesSourceNamespaceLister) Get(name string) (*v1beta1.GoogleCloudSourceRepositoriesSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("googlecloudsourcerepositoriessource"), name)
+	}
+	return obj.(*v1beta1.GoogleCloudSourceRepositoriesSource), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SalesforceSourceLister helps list SalesforceSources.
+// All objects returned here must be treated as read-only.
+type SalesforceSourceLister interface {
+	// List lists all SalesforceSources in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.SalesforceSource, err error)
+	// SalesforceSources returns an object that can list and get SalesforceSources.
+	SalesforceSources(namespace string) SalesforceSourceNamespaceLister
+	SalesforceSourceListerExpansion
+}
+
+// salesforceSourceLister implements the SalesforceSourceLister interface.
+type salesforceSourceLister struct {
+	indexer cache.Indexer
+}
+
+// NewSalesforceSourceLister returns a new SalesforceSourceLister.
+func NewSalesforceSourceLister(indexer cache.Indexer) SalesforceSourceLister {
+	return &salesforceSourceLister{indexer: indexer}
+}
+
+// List lists all SalesforceSources in the indexer.
+func (s *salesforceSourceLister) List(selector labels.Selector) (ret []*v1beta1.SalesforceSource, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.SalesforceSource))
+	})
+	return ret, err
+}
+
+// SalesforceSources returns an object that can list and get SalesforceSources.
+func (s *salesforceSourceLister) SalesforceSources(namespace string) SalesforceSourceNamespaceLister {
+	return salesforceSourceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// SalesforceSourceNamespaceLister helps list and get SalesforceSources.
+// All objects returned here must be treated as read-only.
+type SalesforceSourceNamespaceLister interface {
+	// List lists all SalesforceSources in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.SalesforceSource, err error)
+	// Get retrieves the SalesforceSource from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.SalesforceSource, error)
+	SalesforceSourceNamespaceListerExpansion
+}
+
+// salesforceSourceNamespaceLister implements the SalesforceSourceNamespaceLister
+// interface.
+type salesforceSourceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all SalesforceSources in the indexer for a given namespace.
+func (s salesforceSourceNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.SalesforceSource, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.SalesforceSource))
+	})
+	return ret, err
+}
+
+// Get retrieves the SalesforceSource from the indexer for a given namespace and name.
+func (s salesforceSourceNamespaceLister) Get(name string) (*v1beta1.SalesforceSource, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("salesforcesource"), name)
+	}
+	return obj.(*v1beta1.SalesforceSource), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// AWSKinesisTargetLister helps list AWSKinesisTargets.
+// All objects returned here must be treated as read-only.
+type AWSKinesisTargetLister interface {
+	// List lists all AWSKinesisTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AWSKinesisTarget, err error)
+	// AWSKinesisTargets returns an object that can list and get AWSKinesisTargets.
+	AWSKinesisTargets(namespace string) AWSKinesisTargetNamespaceLister
+	AWSKinesisTargetListerExpansion
+}
+
+// aWSKinesisTargetLister implements the AWSKinesisTargetLister interface.
+type aWSKinesisTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewAWSKinesisTargetLister returns a new AWSKinesisTargetLister.
+func NewAWSKinesisTargetLister(indexer cache.Indexer) AWSKinesisTargetLister {
+	return &aWSKinesisTargetLister{indexer: indexer}
+}
+
+// List lists all AWSKinesisTargets in the indexer.
+func (s *aWSKinesisTargetLister) List(selector labels.Selector) (ret []*v1beta1.AWSKinesisTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AWSKinesisTarget))
+	})
+	return ret, err
+}
+
+// AWSKinesisTargets returns an object that can list and get AWSKinesisTargets.
+func (s *aWSKinesisTargetLister) AWSKinesisTargets(namespace string) AWSKinesisTargetNamespaceLister {
+	return aWSKinesisTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// AWSKinesisTargetNamespaceLister helps list and get AWSKinesisTargets.
+// All objects returned here must be treated as read-only.
+type AWSKinesisTargetNamespaceLister interface {
+	// List lists all AWSKinesisTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.AWSKinesisTarget, err error)
+	// Get retrieves the AWSKinesisTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.AWSKinesisTarget, error)
+	AWSKinesisTargetNamespaceListerExpansion
+}
+
+// aWSKinesisTargetNamespaceLister implements the AWSKinesisTargetNamespaceLister
+// interface.
+type aWSKinesisTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all AWSKinesisTargets in the indexer for a given namespace.
+func (s aWSKinesisTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.AWSKinesisTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.AWSKinesisTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the AWSKinesisTarget from the indexer for a given namespace and name.
+func (s aWSKinesisTargetNamespaceLister) Get(name string) (*v1beta1.AWSKinesisTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("awskinesistarget"), name)
+	}
+	return obj.(*v1beta1.AWSKinesisTarget), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// DatadogTargetLister helps list DatadogTargets.
+// All objects returned here must be treated as read-only.
+type DatadogTargetLister interface {
+	// List lists all DatadogTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.DatadogTarget, err error)
+	// DatadogTargets returns an object that can list and get DatadogTargets.
+	DatadogTargets(namespace string) DatadogTargetNamespaceLister
+	DatadogTargetListerExpansion
+}
+
+// datadogTargetLister implements the DatadogTargetLister interface.
+type datadogTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewDatadogTargetLister returns a new DatadogTargetLister.
+func NewDatadogTargetLister(indexer cache.Indexer) DatadogTargetLister {
+	return &datadogTargetLister{indexer: indexer}
+}
+
+// List lists all DatadogTargets in the indexer.
+func (s *datadogTargetLister) List(selector labels.Selector) (ret []*v1beta1.DatadogTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.DatadogTarget))
+	})
+	return ret, err
+}
+
+// DatadogTargets returns an object that can list and get DatadogTargets.
+func (s *datadogTargetLister) DatadogTargets(namespace string) DatadogTargetNamespaceLister {
+	return datadogTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// DatadogTargetNamespaceLister helps list and get DatadogTargets.
+// All objects returned here must be treated as read-only.
+type DatadogTargetNamespaceLister interface {
+	// List lists all DatadogTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.DatadogTarget, err error)
+	// Get retrieves the DatadogTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.DatadogTarget, error)
+	DatadogTargetNamespaceListerExpansion
+}
+
+// datadogTargetNamespaceLister implements the DatadogTargetNamespaceLister
+// interface.
+type datadogTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all DatadogTargets in the indexer for a given namespace.
+func (s datadogTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.DatadogTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.DatadogTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the DatadogTarget from the indexer for a given namespace and name.
+func (s datadogTargetNamespaceLister) Get(name string) (*v1beta1.DatadogTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("datadogtarget"), name)
+	}
+	return obj.(*v1beta1.DatadogTarget), nil
+}
@@ -0,0 +1,291 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+// AWSComprehendTargetListerExpansion allows custom methods to be added to
+// AWSComprehendTargetLister.
+type AWSComprehendTargetListerExpansion interface{}
+
+// AWSComprehendTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSComprehendTargetNamespaceLister.
+type AWSComprehendTargetNamespaceListerExpansion interface{}
+
+// AWSDynamoDBTargetListerExpansion allows custom methods to be added to
+// AWSDynamoDBTargetLister.
+type AWSDynamoDBTargetListerExpansion interface{}
+
+// AWSDynamoDBTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSDynamoDBTargetNamespaceLister.
+type AWSDynamoDBTargetNamespaceListerExpansion interface{}
+
+// AWSEventBridgeTargetListerExpansion allows custom methods to be added to
+// AWSEventBridgeTargetLister.
+type AWSEventBridgeTargetListerExpansion interface{}
+
+// AWSEventBridgeTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSEventBridgeTargetNamespaceLister.
+type AWSEventBridgeTargetNamespaceListerExpansion interface{}
+
+// AWSKinesisTargetListerExpansion allows custom methods to be added to
+// AWSKinesisTargetLister.
+type AWSKinesisTargetListerExpansion interface{}
+
+// AWSKinesisTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSKinesisTargetNamespaceLister.
+type AWSKinesisTargetNamespaceListerExpansion interface{}
+
+// AWSLambdaTargetListerExpansion allows custom methods to be added to
+// AWSLambdaTargetLister.
+type AWSLambdaTargetListerExpansion interface{}
+
+// AWSLambdaTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSLambdaTargetNamespaceLister.
+type AWSLambdaTargetNamespaceListerExpansion interface{}
+
+// AWSS3TargetListerExpansion allows custom methods to be added to
+// AWSS3TargetLister.
+type AWSS3TargetListerExpansion interface{}
+
+// AWSS3TargetNamespaceListerExpansion allows custom methods to be added to
+// AWSS3TargetNamespaceLister.
+type AWSS3TargetNamespaceListerExpansion interface{}
+
+// AWSSNSTargetListerExpansion allows custom methods to be added to
+// AWSSNSTargetLister.
+type AWSSNSTargetListerExpansion interface{}
+
+// AWSSNSTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSSNSTargetNamespaceLister.
+type AWSSNSTargetNamespaceListerExpansion interface{}
+
+// AWSSQSTargetListerExpansion allows custom methods to be added to
+// AWSSQSTargetLister.
+type AWSSQSTargetListerExpansion interface{}
+
+// AWSSQSTargetNamespaceListerExpansion allows custom methods to be added to
+// AWSSQSTargetNamespaceLister.
+type AWSSQSTargetNamespaceListerExpansion interface{}
+
+// AzureEventHubsTargetListerExpansion allows custom methods to be added to
+// AzureEventHubsTargetLister.
+type AzureEventHubsTargetListerExpansion interface{}
+
+// AzureEventHubsTargetNamespaceListerExpansion allows custom methods to be added to
+// AzureEventHubsTargetNamespaceLister.
+type AzureEventHubsTargetNamespaceListerExpansion interface{}
+
+// AzureSentinelTargetListerExpansion allows custom methods to be added to
+// AzureSentinelTargetLister.
+type AzureSentinelTargetListerExpansion interface{}
+
+// AzureSentinelTargetNamespaceListerExpansion allows custom methods to be added to
+// AzureSentinelTargetNamespaceLister.
+type AzureSentinelTargetNamespaceListerExpansion interface{}
+
+// AzureServiceBusTargetListerExpansion allows custom methods to be added to
+// AzureServiceBusTargetLister.
+type AzureServiceBusTargetListerExpansion interface{}
+
+// AzureServiceBusTargetNamespaceListerExpansion allows custom methods to be added to
+// AzureServiceBusTargetNamespaceLister.
+type AzureServiceBusTargetNamespaceListerExpansion interface{}
+
+// CloudEventsTargetListerExpansion allows custom methods to be added to
+// CloudEventsTargetLister.
+type CloudEventsTargetListerExpansion interface{}
+
+// CloudEventsTargetNamespaceListerExpansion allows custom methods to be added to
+// CloudEventsTargetNamespaceLister.
+type CloudEventsTargetNamespaceListerExpansion interface{}
+
+// DatadogTargetListerExpansion allows custom methods to be added to
+// DatadogTargetLister.
+type DatadogTargetListerExpansion interface{}
+
+// DatadogTargetNamespaceListerExpansion allows custom methods to be added to
+// DatadogTargetNamespaceLister.
+type DatadogTargetNamespaceListerExpansion interface{}
+
+// ElasticsearchTargetListerExpansion allows custom methods to be added to
+// ElasticsearchTargetLister.
+type ElasticsearchTargetListerExpansion interface{}
+
+// ElasticsearchTargetNamespaceListerExpansion allows custom methods to be added to
+// ElasticsearchTargetNamespaceLister.
+type ElasticsearchTargetNamespaceListerExpansion interface{}
+
+// GoogleCloudFirestoreTargetListerExpansion allows custom methods to be added to
+// GoogleCloudFirestoreTargetLister.
+type GoogleCloudFirestoreTargetListerExpansion interface{}
+
+// GoogleCloudFirestoreTargetNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudFirestoreTargetNamespaceLister.
+type GoogleCloudFirestoreTargetNamespaceListerExpansion interface{}
+
+// GoogleCloudPubSubTargetListerExpansion allows custom methods to be added to
+// GoogleCloudPubSubTargetLister.
+type GoogleCloudPubSubTargetListerExpansion interface{}
+
+// GoogleCloudPubSubTargetNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudPubSubTargetNamespaceLister.
+type GoogleCloudPubSubTargetNamespaceListerExpansion interface{}
+
+// GoogleCloudStorageTargetListerExpansion allows custom methods to be added to
+// GoogleCloudStorageTargetLister.
+type GoogleCloudStorageTargetListerExpansion interface{}
+
+// GoogleCloudStorageTargetNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudStorageTargetNamespaceLister.
+type GoogleCloudStorageTargetNamespaceListerExpansion interface{}
+
+// GoogleCloudWorkflowsTargetListerExpansion allows custom methods to be added to
+// GoogleCloudWorkflowsTargetLister.
+type GoogleCloudWorkflowsTargetListerExpansion interface{}
+
+// GoogleCloudWorkflowsTargetNamespaceListerExpansion allows custom methods to be added to
+// GoogleCloudWorkflowsTargetNamespaceLister.
+type GoogleCloudWorkflowsTargetNamespaceListerExpansion interface{}
+
+// GoogleSheetTargetListerExpansion allows custom methods to be added to
+// GoogleSheetTargetLister.
+type GoogleSheetTargetListerExpansion interface{}
+
+// GoogleSheetTargetNamespaceListerExpansion allows custom methods to be added to
+// GoogleSheetTargetNamespaceLister.
+type GoogleSheetTargetNamespaceListerExpansion interface{}
+
+// HTTPTargetListerExpansion allows custom methods to be added to
+// HTTPTargetLister.
+type HTTPTargetListerExpansion interface{}
+
+// HTTPTargetNamespaceListerExpansion allows custom methods to be added to
+// HTTPTargetNamespaceLister.
+type HTTPTargetNamespaceListerExpansion interface{}
+
+// IBMMQTargetListerExpansion allows custom methods to be added to
+// IBMMQTargetLister.
+type IBMMQTargetListerExpansion interface{}
+
+// IBMMQTargetNamespaceListerExpansion allows custom methods to be added to
+// IBMMQTargetNamespaceLister.
+type IBMMQTargetNamespaceListerExpansion interface{}
+
+// JiraTargetListerExpansion allows custom methods to be added to
+// JiraTargetLister.
+type JiraTargetListerExpansion interface{}
+
+// JiraTargetNamespaceListerExpansion allows custom methods to be added to
+// JiraTargetNamespaceLister.
+type JiraTargetNamespaceListerExpansion interface{}
+
+// KafkaTargetListerExpansion allows custom methods to be added to
+// KafkaTargetLister.
+type KafkaTargetListerExpansion interface{}
+
+// KafkaTargetNamespaceListerExpansion allows custom methods to be added to
+// KafkaTargetNamespaceLister.
+type KafkaTargetNamespaceListerExpansion interface{}
+
+// LogzMetricsTargetListerExpansion allows custom methods to be added to
+// LogzMetricsTargetLister.
+type LogzMetricsTargetListerExpansion interface{}
+
+// LogzMetricsTargetNamespaceListerExpansion allows custom methods to be added to
+// LogzMetricsTargetNamespaceLister.
+type LogzMetricsTargetNamespaceListerExpansion interface{}
+
+// LogzTargetListerExpansion allows custom methods to be added to
+// LogzTargetLister.
+type LogzTargetListerExpansion interface{}
+
+// LogzTargetNamespaceListerExpansion allows custom methods to be added to
+// LogzTargetNamespaceLister.
+type LogzTargetNamespaceListerExpansion interface{}
+
+// MongoDBTargetListerExpansion allows custom methods to be added to
+// MongoDBTargetLister.
+type MongoDBTargetListerExpansion interface{}
+
+// MongoDBTargetNamespaceListerExpansion allows custom methods to be added to
+// MongoDBTargetNamespaceLister.
+type MongoDBTargetNamespaceListerExpansion interface{}
+
+// OracleTargetListerExpansion allows custom methods to be added to
+// OracleTargetLister.
+type OracleTargetListerExpansion interface{}
+
+// OracleTargetNamespaceListerExpansion allows custom methods to be added to
+// OracleTargetNamespaceLister.
+type OracleTargetNamespaceListerExpansion interface{}
+
+// SalesforceTargetListerExpansion allows custom methods to be added to
+// SalesforceTargetLister.
+type SalesforceTargetListerExpansion interface{}
+
+// SalesforceTargetNamespaceListerExpansion allows custom methods to be added to
+// SalesforceTargetNamespaceLister.
+type SalesforceTargetNamespaceListerExpansion interface{}
+
+// SendGridTargetListerExpansion allows custom methods to be added to
+// SendGridTargetLister.
+type SendGridTargetListerExpansion interface{}
+
+// SendGridTargetNamespaceListerExpansion allows custom methods to be added to
+// SendGridTargetNamespaceLister.
+type SendGridTargetNamespaceListerExpansion interface{}
+
+// SlackTargetListerExpansion allows custom methods to be added to
+// SlackTargetLister.
+type SlackTargetListerExpansion interface{}
+
+// SlackTargetNamespaceListerExpansion allows custom methods to be added to
+// SlackTargetNamespaceLister.
+type SlackTargetNamespaceListerExpansion interface{}
+
+// SolaceTargetListerExpansion allows custom methods to be added to
+// SolaceTargetLister.
+type SolaceTargetListerExpansion interface{}
+
+// SolaceTargetNamespaceListerExpansion allows custom methods to be added to
+// SolaceTargetNamespaceLister.
+type SolaceTargetNamespaceListerExpansion interface{}
+
+// SplunkTargetListerExpansion allows custom methods to be added to
+// SplunkTargetLister.
+type SplunkTargetListerExpansion interface{}
+
+// SplunkTargetNamespaceListerExpansion allows custom methods to be added to
+// SplunkTargetNamespaceLister.
+type SplunkTargetNamespaceListerExpansion interface{}
+
+// TwilioTargetListerExpansion allows custom methods to be added to
+// TwilioTargetLister.
+type TwilioTargetListerExpansion interface{}
+
+// TwilioTargetNamespaceListerExpansion allows custom methods to be added to
+// TwilioTargetNamespaceLister.
+type TwilioTargetNamespaceListerExpansion interface{}
+
+// ZendeskTargetListerExpansion allows custom methods to be added to
+// ZendeskTargetLister.
+type ZendeskTargetListerExpansion interface{}
+
+// ZendeskTargetNamespaceListerExpansion allows custom methods to be added to
+// ZendeskTargetNamespaceLister.
+type ZendeskTargetNamespaceListerExpansion interface{}
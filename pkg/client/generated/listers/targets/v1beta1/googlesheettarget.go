@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// GoogleSheetTargetLister helps list GoogleSheetTargets.
+// All objects returned here must be treated as read-only.
+type GoogleSheetTargetLister interface {
+	// List lists all GoogleSheetTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleSheetTarget, err error)
+	// GoogleSheetTargets returns an object that can list and get GoogleSheetTargets.
+	GoogleSheetTargets(namespace string) GoogleSheetTargetNamespaceLister
+	GoogleSheetTargetListerExpansion
+}
+
+// googleSheetTargetLister implements the GoogleSheetTargetLister interface.
+type googleSheetTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewGoogleSheetTargetLister returns a new GoogleSheetTargetLister.
+func NewGoogleSheetTargetLister(indexer cache.Indexer) GoogleSheetTargetLister {
+	return &googleSheetTargetLister{indexer: indexer}
+}
+
+// List lists all GoogleSheetTargets in the indexer.
+func (s *googleSheetTargetLister) List(selector labels.Selector) (ret []*v1beta1.GoogleSheetTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleSheetTarget))
+	})
+	return ret, err
+}
+
+// GoogleSheetTargets returns an object that can list and get GoogleSheetTargets.
+func (s *googleSheetTargetLister) GoogleSheetTargets(namespace string) GoogleSheetTargetNamespaceLister {
+	return googleSheetTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// GoogleSheetTargetNamespaceLister helps list and get GoogleSheetTargets.
+// All objects returned here must be treated as read-only.
+type GoogleSheetTargetNamespaceLister interface {
+	// List lists all GoogleSheetTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.GoogleSheetTarget, err error)
+	// Get retrieves the GoogleSheetTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.GoogleSheetTarget, error)
+	GoogleSheetTargetNamespaceListerExpansion
+}
+
+// googleSheetTargetNamespaceLister implements the GoogleSheetTargetNamespaceLister
+// interface.
+type googleSheetTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all GoogleSheetTargets in the indexer for a given namespace.
+func (s googleSheetTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.GoogleSheetTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.GoogleSheetTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the GoogleSheetTarget from the indexer for a given namespace and name.
+func (s googleSheetTargetNamespaceLister) Get(name string) (*v1beta1.GoogleSheetTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("googlesheettarget"), name)
+	}
+	return obj.(*v1beta1.GoogleSheetTarget), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// KafkaTargetLister helps list KafkaTargets.
+// All objects returned here must be treated as read-only.
+type KafkaTargetLister interface {
+	// List lists all KafkaTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.KafkaTarget, err error)
+	// KafkaTargets returns an object that can list and get KafkaTargets.
+	KafkaTargets(namespace string) KafkaTargetNamespaceLister
+	KafkaTargetListerExpansion
+}
+
+// kafkaTargetLister implements the KafkaTargetLister interface.
+type kafkaTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewKafkaTargetLister returns a new KafkaTargetLister.
+func NewKafkaTargetLister(indexer cache.Indexer) KafkaTargetLister {
+	return &kafkaTargetLister{indexer: indexer}
+}
+
+// List lists all KafkaTargets in the indexer.
+func (s *kafkaTargetLister) List(selector labels.Selector) (ret []*v1beta1.KafkaTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.KafkaTarget))
+	})
+	return ret, err
+}
+
+// KafkaTargets returns an object that can list and get KafkaTargets.
+func (s *kafkaTargetLister) KafkaTargets(namespace string) KafkaTargetNamespaceLister {
+	return kafkaTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// KafkaTargetNamespaceLister helps list and get KafkaTargets.
+// All objects returned here must be treated as read-only.
+type KafkaTargetNamespaceLister interface {
+	// List lists all KafkaTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.KafkaTarget, err error)
+	// Get retrieves the KafkaTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.KafkaTarget, error)
+	KafkaTargetNamespaceListerExpansion
+}
+
+// kafkaTargetNamespaceLister implements the KafkaTargetNamespaceLister
+// interface.
+type kafkaTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all KafkaTargets in the indexer for a given namespace.
+func (s kafkaTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.KafkaTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.KafkaTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the KafkaTarget from the indexer for a given namespace and name.
+func (s kafkaTargetNamespaceLister) Get(name string) (*v1beta1.KafkaTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("kafkatarget"), name)
+	}
+	return obj.(*v1beta1.KafkaTarget), nil
+}
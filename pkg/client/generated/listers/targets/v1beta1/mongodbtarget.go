@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// MongoDBTargetLister helps list MongoDBTargets.
+// All objects returned here must be treated as read-only.
+type MongoDBTargetLister interface {
+	// List lists all MongoDBTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MongoDBTarget, err error)
+	// MongoDBTargets returns an object that can list and get MongoDBTargets.
+	MongoDBTargets(namespace string) MongoDBTargetNamespaceLister
+	MongoDBTargetListerExpansion
+}
+
+// mongoDBTargetLister implements the MongoDBTargetLister interface.
+type mongoDBTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewMongoDBTargetLister returns a new MongoDBTargetLister.
+func NewMongoDBTargetLister(indexer cache.Indexer) MongoDBTargetLister {
+	return &mongoDBTargetLister{indexer: indexer}
+}
+
+// List lists all MongoDBTargets in the indexer.
+func (s *mongoDBTargetLister) List(selector labels.Selector) (ret []*v1beta1.MongoDBTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.MongoDBTarget))
+	})
+	return ret, err
+}
+
+// MongoDBTargets returns an object that can list and get MongoDBTargets.
+func (s *mongoDBTargetLister) MongoDBTargets(namespace string) MongoDBTargetNamespaceLister {
+	return mongoDBTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// MongoDBTargetNamespaceLister helps list and get MongoDBTargets.
+// All objects returned here must be treated as read-only.
+type MongoDBTargetNamespaceLister interface {
+	// List lists all MongoDBTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.MongoDBTarget, err error)
+	// Get retrieves the MongoDBTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.MongoDBTarget, error)
+	MongoDBTargetNamespaceListerExpansion
+}
+
+// mongoDBTargetNamespaceLister implements the MongoDBTargetNamespaceLister
+// interface.
+type mongoDBTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all MongoDBTargets in the indexer for a given namespace.
+func (s mongoDBTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.MongoDBTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.MongoDBTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the MongoDBTarget from the indexer for a given namespace and name.
+func (s mongoDBTargetNamespaceLister) Get(name string) (*v1beta1.MongoDBTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("mongodbtarget"), name)
+	}
+	return obj.(*v1beta1.MongoDBTarget), nil
+}
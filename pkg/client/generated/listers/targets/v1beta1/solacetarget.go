@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// SolaceTargetLister helps list SolaceTargets.
+// All objects returned here must be treated as read-only.
+type SolaceTargetLister interface {
+	// List lists all SolaceTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.SolaceTarget, err error)
+	// SolaceTargets returns an object that can list and get SolaceTargets.
+	SolaceTargets(namespace string) SolaceTargetNamespaceLister
+	SolaceTargetListerExpansion
+}
+
+// solaceTargetLister implements the SolaceTargetLister interface.
+type solaceTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewSolaceTargetLister returns a new SolaceTargetLister.
+func NewSolaceTargetLister(indexer cache.Indexer) SolaceTargetLister {
+	return &solaceTargetLister{indexer: indexer}
+}
+
+// List lists all SolaceTargets in the indexer.
+func (s *solaceTargetLister) List(selector labels.Selector) (ret []*v1beta1.SolaceTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.SolaceTarget))
+	})
+	return ret, err
+}
+
+// SolaceTargets returns an object that can list and get SolaceTargets.
+func (s *solaceTargetLister) SolaceTargets(namespace string) SolaceTargetNamespaceLister {
+	return solaceTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// SolaceTargetNamespaceLister helps list and get SolaceTargets.
+// All objects returned here must be treated as read-only.
+type SolaceTargetNamespaceLister interface {
+	// List lists all SolaceTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.SolaceTarget, err error)
+	// Get retrieves the SolaceTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.SolaceTarget, error)
+	SolaceTargetNamespaceListerExpansion
+}
+
+// solaceTargetNamespaceLister implements the SolaceTargetNamespaceLister
+// interface.
+type solaceTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all SolaceTargets in the indexer for a given namespace.
+func (s solaceTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.SolaceTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.SolaceTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the SolaceTarget from the indexer for a given namespace and name.
+func (s solaceTargetNamespaceLister) Get(name string) (*v1beta1.SolaceTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("solacetarget"), name)
+	}
+	return obj.(*v1beta1.SolaceTarget), nil
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	v1beta1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+)
+
+// ZendeskTargetLister helps list ZendeskTargets.
+// All objects returned here must be treated as read-only.
+type ZendeskTargetLister interface {
+	// List lists all ZendeskTargets in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.ZendeskTarget, err error)
+	// ZendeskTargets returns an object that can list and get ZendeskTargets.
+	ZendeskTargets(namespace string) ZendeskTargetNamespaceLister
+	ZendeskTargetListerExpansion
+}
+
+// zendeskTargetLister implements the ZendeskTargetLister interface.
+type zendeskTargetLister struct {
+	indexer cache.Indexer
+}
+
+// NewZendeskTargetLister returns a new ZendeskTargetLister.
+func NewZendeskTargetLister(indexer cache.Indexer) ZendeskTargetLister {
+	return &zendeskTargetLister{indexer: indexer}
+}
+
+// List lists all ZendeskTargets in the indexer.
+func (s *zendeskTargetLister) List(selector labels.Selector) (ret []*v1beta1.ZendeskTarget, err error) {
+	err = cache.ListAll(s.indexer, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ZendeskTarget))
+	})
+	return ret, err
+}
+
+// ZendeskTargets returns an object that can list and get ZendeskTargets.
+func (s *zendeskTargetLister) ZendeskTargets(namespace string) ZendeskTargetNamespaceLister {
+	return zendeskTargetNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// ZendeskTargetNamespaceLister helps list and get ZendeskTargets.
+// All objects returned here must be treated as read-only.
+type ZendeskTargetNamespaceLister interface {
+	// List lists all ZendeskTargets in the indexer for a given namespace.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*v1beta1.ZendeskTarget, err error)
+	// Get retrieves the ZendeskTarget from the indexer for a given namespace and name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*v1beta1.ZendeskTarget, error)
+	ZendeskTargetNamespaceListerExpansion
+}
+
+// zendeskTargetNamespaceLister implements the ZendeskTargetNamespaceLister
+// interface.
+type zendeskTargetNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ZendeskTargets in the indexer for a given namespace.
+func (s zendeskTargetNamespaceLister) List(selector labels.Selector) (ret []*v1beta1.ZendeskTarget, err error) {
+	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
+		ret = append(ret, m.(*v1beta1.ZendeskTarget))
+	})
+	return ret, err
+}
+
+// Get retrieves the ZendeskTarget from the indexer for a given namespace and name.
+func (s zendeskTargetNamespaceLister) Get(name string) (*v1beta1.ZendeskTarget, error) {
+	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NewNotFound(v1beta1.Resource("zendesktarget"), name)
+	}
+	return obj.(*v1beta1.ZendeskTarget), nil
+}
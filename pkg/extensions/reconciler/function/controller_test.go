@@ -22,9 +22,14 @@ import (
 	. "github.com/triggermesh/triggermesh/pkg/reconciler/testing"
 
 	// Link fake informers accessed by our controller
+	_ "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/catalog/v1alpha1/eventtypecatalog/fake"
 	_ "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/extensions/v1alpha1/function/fake"
+	_ "github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata/configmap/fake"
+	_ "github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata/secret/fake"
+	_ "knative.dev/pkg/client/injection/apiextensions/informers/apiextensions/v1/customresourcedefinition/fake"
 	_ "knative.dev/pkg/client/injection/ducks/duck/v1/addressable/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/configmap/fake"
+	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/pod/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/core/v1/serviceaccount/fake"
 	_ "knative.dev/pkg/client/injection/kube/informers/rbac/v1/rolebinding/fake"
 	_ "knative.dev/serving/pkg/client/injection/informers/serving/v1/service/fake"
@@ -33,8 +38,8 @@ import (
 func TestNewController(t *testing.T) {
 	t.Run("No failure", func(t *testing.T) {
 		TestControllerConstructor(t, NewController,
-			// we expect "ConfigMap" as an additional informer in this reconciler implementation
-			ExpectExtraInformers(1),
+			// we expect "ConfigMap" and "Pod" as additional informers in this reconciler implementation
+			ExpectExtraInformers(2),
 		)
 	})
 
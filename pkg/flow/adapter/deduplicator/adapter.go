@@ -0,0 +1,120 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deduplicator
+
+import (
+	"context"
+
+	"github.com/kelseyhightower/envconfig"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// NewAdapter adapter implementation
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: flow.DeduplicatorResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	env := envAcc.(*envAccessor)
+
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithStaticResponseType("io.triggermesh.deduplicator.error"))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	dedupCfg := &dedup.EnvConfig{}
+	if err := envconfig.Process("", dedupCfg); err != nil {
+		logger.Panicf("Error processing deduplication configuration: %v", err)
+	}
+
+	d, err := dedup.New(dedupCfg)
+	if err != nil {
+		logger.Panicf("Error creating deduplicator: %v", err)
+	}
+
+	return &deduplicator{
+		dedup: d,
+
+		sink:     env.Sink,
+		replier:  replier,
+		ceClient: ceClient,
+		logger:   logger,
+
+		mt: mt,
+	}
+}
+
+var _ pkgadapter.Adapter = (*deduplicator)(nil)
+
+type deduplicator struct {
+	dedup *dedup.Deduplicator
+
+	sink     string
+	replier  *targetce.Replier
+	ceClient cloudevents.Client
+	logger   *zap.SugaredLogger
+
+	mt *pkgadapter.MetricTag
+}
+
+// Start is a blocking function and will return if an error occurs
+// or the context is cancelled.
+func (a *deduplicator) Start(ctx context.Context) error {
+	a.logger.Info("Starting Deduplicator Adapter")
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+// dispatch forwards events which weren't already received, and acknowledges
+// duplicates without forwarding them. Events are forwarded when the
+// deduplication store is unavailable.
+func (a *deduplicator) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	seen, err := a.dedup.Seen(ctx, &event)
+	switch {
+	case err != nil:
+		a.logger.Errorw("Unable to determine whether the event is a duplicate", zap.Error(err))
+	case seen:
+		a.logger.Debugw("Discarding duplicate event", zap.String("id", event.ID()), zap.String("source", event.Source()))
+		return nil, cloudevents.ResultACK
+	}
+
+	if a.sink != "" {
+		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+			if err := a.dedup.Forget(ctx, &event); err != nil {
+				a.logger.Errorw("Unable to forget event", zap.Error(err))
+			}
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+		}
+		return nil, cloudevents.ResultACK
+	}
+
+	return &event, cloudevents.ResultACK
+}
@@ -0,0 +1,99 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deduplicator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/dedup"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tCloudEventType   = "ce.test.type"
+	tCloudEventSource = "ce.test.source"
+)
+
+func TestDispatch(t *testing.T) {
+	logger := logtesting.TestLogger(t)
+
+	replier, err := targetce.New(tCloudEventSource, logger)
+	require.NoError(t, err)
+
+	t.Run("reply", func(t *testing.T) {
+		a := &deduplicator{
+			dedup:   dedup.NewDeduplicator(dedup.NewMemoryStore(10), time.Minute),
+			replier: replier,
+			logger:  logger,
+		}
+
+		ctx := context.Background()
+
+		resp, res := a.dispatch(ctx, newCloudEvent("1"))
+		assert.True(t, cloudevents.IsACK(res))
+		require.NotNil(t, resp, "first occurrence of the event should be replied")
+		assert.Equal(t, "1", resp.ID())
+
+		resp, res = a.dispatch(ctx, newCloudEvent("1"))
+		assert.True(t, cloudevents.IsACK(res))
+		assert.Nil(t, resp, "duplicate event should not be replied")
+
+		resp, res = a.dispatch(ctx, newCloudEvent("2"))
+		assert.True(t, cloudevents.IsACK(res))
+		assert.NotNil(t, resp, "distinct event should be replied")
+	})
+
+	t.Run("sink", func(t *testing.T) {
+		ceClient, chEvents := cetest.NewMockSenderClient(t, 10)
+
+		a := &deduplicator{
+			dedup:    dedup.NewDeduplicator(dedup.NewMemoryStore(10), time.Minute),
+			sink:     "http://sink.test",
+			replier:  replier,
+			ceClient: ceClient,
+			logger:   logger,
+		}
+
+		ctx := context.Background()
+
+		for i := 0; i < 3; i++ {
+			resp, res := a.dispatch(ctx, newCloudEvent("1"))
+			assert.True(t, cloudevents.IsACK(res))
+			assert.Nil(t, resp)
+		}
+
+		assert.Len(t, chEvents, 1, "duplicate events should not be sent to the sink")
+	})
+}
+
+func newCloudEvent(id string) cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(id)
+	event.SetType(tCloudEventType)
+	event.SetSource(tCloudEventSource)
+	return event
+}
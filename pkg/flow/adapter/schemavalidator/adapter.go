@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"go.uber.org/zap"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// ExtensionValidationErrors is the CloudEvents extension attribute which
+// carries the validation errors of invalid events.
+const ExtensionValidationErrors = "validationerrors"
+
+// validationErrorsSeparator separates validation errors in the value of the
+// ExtensionValidationErrors extension attribute.
+const validationErrorsSeparator = "; "
+
+// NewAdapter adapter implementation
+func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+	logger := logging.FromContext(ctx)
+
+	mt := &pkgadapter.MetricTag{
+		ResourceGroup: flow.SchemaValidatorResource.String(),
+		Namespace:     envAcc.GetNamespace(),
+		Name:          envAcc.GetName(),
+	}
+
+	env := envAcc.(*envAccessor)
+
+	replier, err := targetce.New(env.Component, logger.Named("replier"),
+		targetce.ReplierWithStatefulHeaders(env.BridgeIdentifier),
+		targetce.ReplierWithStaticResponseType("io.triggermesh.schemavalidator.error"))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	v, err := newValidator(env.Schemas, schemaDocument, env.UseBundledSchemas, env.RejectUnknown)
+	if err != nil {
+		logger.Panicf("Error creating schema validator: %v", err)
+	}
+
+	return &schemaValidator{
+		validator: v,
+
+		sink:        env.Sink,
+		invalidSink: env.InvalidSink,
+		replier:     replier,
+		ceClient:    ceClient,
+		logger:      logger,
+
+		mt: mt,
+	}
+}
+
+var _ pkgadapter.Adapter = (*schemaValidator)(nil)
+
+type schemaValidator struct {
+	validator *validator
+
+	sink        string
+	invalidSink string
+	replier     *targetce.Replier
+	ceClient    cloudevents.Client
+	logger      *zap.SugaredLogger
+
+	mt *pkgadapter.MetricTag
+}
+
+// Start is a blocking function and will return if an error occurs
+// or the context is cancelled.
+func (a *schemaValidator) Start(ctx context.Context) error {
+	a.logger.Info("Starting SchemaValidator Adapter")
+	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	return a.ceClient.StartReceiver(ctx, a.dispatch)
+}
+
+// dispatch forwards valid events, and sends invalid events to the invalid
+// events sink enriched with their validation errors.
+func (a *schemaValidator) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	validationErrs, err := a.validator.Validate(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, "selecting the schema of the event")
+	}
+
+	if len(validationErrs) != 0 {
+		return a.dispatchInvalid(ctx, event, validationErrs)
+	}
+
+	if a.sink != "" {
+		if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the sink")
+		}
+		return nil, cloudevents.ResultACK
+	}
+
+	return &event, cloudevents.ResultACK
+}
+
+// dispatchInvalid handles an event which failed validation.
+func (a *schemaValidator) dispatchInvalid(ctx context.Context, event cloudevents.Event,
+	validationErrs []string) (*cloudevents.Event, cloudevents.Result) {
+
+	a.logger.Debugw("Event failed validation", zap.String("id", event.ID()),
+		zap.String("type", event.Type()), zap.Strings("errors", validationErrs))
+
+	if a.invalidSink == "" {
+		return a.replier.Error(&event, targetce.ErrorCodeRequestValidation,
+			errors.New("event data does not match its schema"), validationErrs)
+	}
+
+	event.SetExtension(ExtensionValidationErrors, strings.Join(validationErrs, validationErrorsSeparator))
+
+	ctx = cloudevents.ContextWithTarget(ctx, a.invalidSink)
+	if result := a.ceClient.Send(ctx, event); !cloudevents.IsACK(result) {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, result, "sending the cloudevent to the invalid events sink")
+	}
+
+	return nil, cloudevents.ResultACK
+}
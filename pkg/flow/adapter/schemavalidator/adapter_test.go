@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cetest "github.com/cloudevents/sdk-go/v2/client/test"
+
+	logtesting "knative.dev/pkg/logging/testing"
+
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const (
+	tCloudEventType   = "ce.test.type"
+	tCloudEventSource = "ce.test.source"
+)
+
+func TestDispatch(t *testing.T) {
+	logger := logtesting.TestLogger(t)
+
+	replier, err := targetce.New(tCloudEventSource, logger)
+	require.NoError(t, err)
+
+	selectors := []SchemaSelector{{Type: tCloudEventType}}
+	doc := func(int) ([]byte, error) {
+		return []byte(`{"type": "object", "required": ["id"]}`), nil
+	}
+
+	v, err := newValidator(selectors, doc, false, false)
+	require.NoError(t, err)
+
+	valid := newEvent(tCloudEventType, "", `{"id": "1"}`)
+	invalid := newEvent(tCloudEventType, "", `{"name": "foo"}`)
+
+	t.Run("reply", func(t *testing.T) {
+		a := &schemaValidator{
+			validator: v,
+			replier:   replier,
+			logger:    logger,
+		}
+
+		ctx := context.Background()
+
+		resp, res := a.dispatch(ctx, *valid)
+		assert.True(t, cloudevents.IsACK(res))
+		require.NotNil(t, resp, "valid event should be replied")
+		assert.Equal(t, valid.ID(), resp.ID())
+
+		resp, res = a.dispatch(ctx, *invalid)
+		assert.True(t, cloudevents.IsACK(res))
+		require.NotNil(t, resp, "invalid event should be replied to with an error")
+		assert.Equal(t, targetce.ExtensionCategoryValueError, resp.Extensions()[targetce.ExtensionCategory])
+	})
+
+	t.Run("invalid sink", func(t *testing.T) {
+		ceClient, chEvents := cetest.NewMockSenderClient(t, 10)
+
+		a := &schemaValidator{
+			validator:   v,
+			sink:        "http://sink.test",
+			invalidSink: "http://invalid.test",
+			replier:     replier,
+			ceClient:    ceClient,
+			logger:      logger,
+		}
+
+		ctx := context.Background()
+
+		resp, res := a.dispatch(ctx, *valid)
+		assert.True(t, cloudevents.IsACK(res))
+		assert.Nil(t, resp)
+
+		resp, res = a.dispatch(ctx, *invalid)
+		assert.True(t, cloudevents.IsACK(res))
+		assert.Nil(t, resp)
+
+		sent := <-chEvents
+		assert.Equal(t, valid.ID(), sent.ID())
+		assert.NotContains(t, sent.Extensions(), ExtensionValidationErrors)
+
+		sent = <-chEvents
+		assert.Contains(t, sent.Extensions(), ExtensionValidationErrors,
+			"invalid event should be enriched with validation errors")
+	})
+}
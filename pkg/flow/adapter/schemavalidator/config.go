@@ -0,0 +1,96 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+)
+
+// EnvAccessorCtor for configuration parameters
+func EnvAccessorCtor() pkgadapter.EnvConfigAccessor {
+	return &envAccessor{}
+}
+
+type envAccessor struct {
+	pkgadapter.EnvConfig
+	// BridgeIdentifier is the name of the bridge workflow this target is part of
+	BridgeIdentifier string `envconfig:"EVENTS_BRIDGE_IDENTIFIER"`
+	// Sink defines the target sink for the events. If no Sink is defined the
+	// events are replied back to the sender.
+	Sink string `envconfig:"K_SINK"`
+	// InvalidSink defines the destination of events which fail validation.
+	// If no InvalidSink is defined, invalid events are replied to with an
+	// error.
+	InvalidSink string `envconfig:"SCHEMAVALIDATOR_INVALID_SINK"`
+
+	// Selectors of the user-provided schemas, in order of precedence. The
+	// schema document at index i is read from the environment variable
+	// SCHEMAVALIDATOR_SCHEMA_<i>.
+	Schemas SchemaSelectors `envconfig:"SCHEMAVALIDATOR_SCHEMAS"`
+	// Whether events are validated against bundled schemas when none of the
+	// user-provided schemas applies.
+	UseBundledSchemas bool `envconfig:"SCHEMAVALIDATOR_USE_BUNDLED_SCHEMAS" default:"true"`
+	// Whether events for which no schema can be found are invalid.
+	RejectUnknown bool `envconfig:"SCHEMAVALIDATOR_REJECT_UNKNOWN"`
+}
+
+// SchemaSelectors is a list of SchemaSelector which can be decoded from a
+// JSON string by envconfig.
+type SchemaSelectors []SchemaSelector
+
+// SchemaSelector determines which events a schema applies to.
+type SchemaSelector struct {
+	Type       string `json:"type,omitempty"`
+	DataSchema string `json:"dataSchema,omitempty"`
+}
+
+var _ interface{ Decode(string) error } = (*SchemaSelectors)(nil)
+
+// Decode implements envconfig.Decoder.
+func (s *SchemaSelectors) Decode(value string) error {
+	if value == "" {
+		return nil
+	}
+	if err := json.Unmarshal([]byte(value), s); err != nil {
+		return fmt.Errorf("decoding schema selectors: %w", err)
+	}
+	return nil
+}
+
+// schemaEnvVarName returns the name of the environment variable containing
+// the document of the user-provided schema at the given index.
+func schemaEnvVarName(i int) string {
+	return "SCHEMAVALIDATOR_SCHEMA_" + strconv.Itoa(i)
+}
+
+// schemaDocument returns the document of the user-provided schema at the
+// given index.
+func schemaDocument(i int) ([]byte, error) {
+	name := schemaEnvVarName(i)
+
+	doc, ok := os.LookupEnv(name)
+	if !ok || doc == "" {
+		return nil, fmt.Errorf("environment variable %s is not set", name)
+	}
+
+	return []byte(doc), nil
+}
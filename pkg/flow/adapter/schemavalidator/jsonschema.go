@@ -0,0 +1,116 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// schemaURL is the URL at which schema documents are registered with the
+// compiler. It only serves as base URI for resolving local references.
+const schemaURL = "mem://schemavalidator/schema.json"
+
+// compileSchema parses the given JSON schema document into a validator.
+//
+// The draft of the schema is determined by its "$schema" keyword, and
+// defaults to 2020-12 when the keyword is absent. Drafts 4, 6, 7, 2019-09 and
+// 2020-12 are supported, any other "$schema" causes the compilation to fail.
+// References are resolved within the document only, references to other
+// documents are rejected.
+func compileSchema(doc []byte) (*jsonschema.Schema, error) {
+	c := jsonschema.NewCompiler()
+	c.Draft = jsonschema.Draft2020
+	c.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("unsupported reference to external document %q", url)
+	}
+
+	if err := c.AddResource(schemaURL, bytes.NewReader(doc)); err != nil {
+		return nil, fmt.Errorf("parsing schema document: %w", err)
+	}
+
+	s, err := c.Compile(schemaURL)
+	if err != nil {
+		return nil, fmt.Errorf("compiling schema: %w", err)
+	}
+
+	return s, nil
+}
+
+// validateData validates the given JSON document against the given schema,
+// and returns the validation errors. The returned list is empty if the
+// document is valid.
+func validateData(s *jsonschema.Schema, data []byte) []string {
+	var v interface{}
+	if err := decodeJSON(data, &v); err != nil {
+		return []string{"event data is not valid JSON: " + err.Error()}
+	}
+
+	err := s.Validate(v)
+	if err == nil {
+		return nil
+	}
+
+	verr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return []string{err.Error()}
+	}
+
+	var errs []string
+	collectLeafErrors(verr, &errs)
+	sort.Strings(errs)
+
+	return errs
+}
+
+// collectLeafErrors appends the leaves of the given tree of validation errors
+// to errs. Only leaf errors carry the actual reason of a validation failure,
+// their ancestors merely state that a subschema didn't validate.
+func collectLeafErrors(verr *jsonschema.ValidationError, errs *[]string) {
+	if len(verr.Causes) == 0 {
+		loc := verr.InstanceLocation
+		if loc == "" {
+			loc = "/"
+		}
+		*errs = append(*errs, loc+": "+verr.Message)
+		return
+	}
+
+	for _, c := range verr.Causes {
+		collectLeafErrors(c, errs)
+	}
+}
+
+// decodeJSON decodes the given JSON document, preserving the precision of
+// numbers.
+func decodeJSON(doc []byte, v interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+
+	if err := dec.Decode(v); err != nil {
+		return err
+	}
+	if _, err := dec.Token(); err != io.EOF {
+		return fmt.Errorf("unexpected data after top-level value")
+	}
+	return nil
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"fmt"
+	"sync"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/santhosh-tekuri/jsonschema/v5"
+
+	"github.com/triggermesh/triggermesh/schemas"
+)
+
+// validator validates the data of events against the JSON schema which
+// applies to them.
+type validator struct {
+	// user-provided schemas
+	byDataSchema map[string]*jsonschema.Schema
+	byType       map[string]*jsonschema.Schema
+
+	useBundled    bool
+	rejectUnknown bool
+
+	// bundled schemas are compiled on first use
+	bundledMu sync.Mutex
+	bundled   map[string]*jsonschema.Schema
+}
+
+// newValidator returns a validator for the given user-provided schemas.
+// The document of the schema at index i of selectors is returned by doc(i).
+func newValidator(selectors []SchemaSelector, doc func(int) ([]byte, error),
+	useBundled, rejectUnknown bool) (*validator, error) {
+
+	v := &validator{
+		byDataSchema:  make(map[string]*jsonschema.Schema),
+		byType:        make(map[string]*jsonschema.Schema),
+		useBundled:    useBundled,
+		rejectUnknown: rejectUnknown,
+		bundled:       make(map[string]*jsonschema.Schema),
+	}
+
+	for i, sel := range selectors {
+		d, err := doc(i)
+		if err != nil {
+			return nil, fmt.Errorf("reading schema at index %d: %w", i, err)
+		}
+
+		s, err := compileSchema(d)
+		if err != nil {
+			return nil, fmt.Errorf("compiling schema at index %d: %w", i, err)
+		}
+
+		// the first schema to match an event takes precedence
+		if sel.DataSchema != "" {
+			if _, exists := v.byDataSchema[sel.DataSchema]; !exists {
+				v.byDataSchema[sel.DataSchema] = s
+			}
+			continue
+		}
+		if _, exists := v.byType[sel.Type]; !exists {
+			v.byType[sel.Type] = s
+		}
+	}
+
+	return v, nil
+}
+
+// Validate validates the data of the given event, and returns the validation
+// errors. The returned list is empty if the event is valid.
+func (v *validator) Validate(e *cloudevents.Event) ([]string, error) {
+	s, err := v.schemaFor(e)
+	if err != nil {
+		return nil, err
+	}
+
+	if s == nil {
+		if v.rejectUnknown {
+			return []string{fmt.Sprintf("no schema found for events of type %q", e.Type())}, nil
+		}
+		return nil, nil
+	}
+
+	return validateData(s, e.Data()), nil
+}
+
+// schemaFor returns the schema which applies to the given event, or nil if
+// no schema applies.
+// User-provided schemas take precedence over bundled ones, and schemas
+// matching the event's "dataschema" attribute take precedence over schemas
+// matching its type.
+func (v *validator) schemaFor(e *cloudevents.Event) (*jsonschema.Schema, error) {
+	if s, ok := v.byDataSchema[e.DataSchema()]; ok {
+		return s, nil
+	}
+	if s, ok := v.byType[e.Type()]; ok {
+		return s, nil
+	}
+
+	if !v.useBundled {
+		return nil, nil
+	}
+
+	if doc, ok := schemas.ForDataSchema(e.DataSchema()); ok {
+		return v.bundledSchema("dataschema:"+e.DataSchema(), doc)
+	}
+	if doc, ok := schemas.ForEventType(e.Type()); ok {
+		return v.bundledSchema("type:"+e.Type(), doc)
+	}
+
+	return nil, nil
+}
+
+// bundledSchema returns the compiled version of the given bundled schema,
+// compiling it if it wasn't already.
+func (v *validator) bundledSchema(key string, doc []byte) (*jsonschema.Schema, error) {
+	v.bundledMu.Lock()
+	defer v.bundledMu.Unlock()
+
+	if s, ok := v.bundled[key]; ok {
+		return s, nil
+	}
+
+	s, err := compileSchema(doc)
+	if err != nil {
+		return nil, fmt.Errorf("compiling bundled schema: %w", err)
+	}
+	v.bundled[key] = s
+
+	return s, nil
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+)
+
+const tSchema = `{
+	"$schema": "https://json-schema.org/draft/2020-12/schema",
+	"$ref": "#/$defs/Order",
+	"$defs": {
+		"Order": {
+			"type": "object",
+			"properties": {
+				"id": { "type": "string" },
+				"items": {
+					"type": "array",
+					"items": { "$ref": "#/$defs/Item" }
+				},
+				"notes": true,
+				"parent": { "$ref": "#/$defs/Order" }
+			},
+			"required": ["id", "items"],
+			"additionalProperties": false
+		},
+		"Item": {
+			"type": "object",
+			"properties": {
+				"sku": { "type": "string" },
+				"quantity": { "type": "integer", "minimum": 1 }
+			},
+			"required": ["sku", "quantity"]
+		}
+	}
+}`
+
+func TestCompileSchema(t *testing.T) {
+	s, err := compileSchema([]byte(tSchema))
+	require.NoError(t, err)
+
+	testCases := map[string]struct {
+		data      string
+		expectErr bool
+	}{
+		"valid": {
+			data: `{"id": "o-1", "items": [{"sku": "a", "quantity": 1}], "notes": ["anything"]}`,
+		},
+		"missing property in referenced schema": {
+			data:      `{"id": "o-1", "items": [{"sku": "a"}]}`,
+			expectErr: true,
+		},
+		"recursive reference": {
+			data:      `{"id": "o-2", "items": [], "parent": {"id": "o-1", "items": [], "extra": 1}}`,
+			expectErr: true,
+		},
+		"additional property": {
+			data:      `{"id": "o-1", "items": [], "extra": 1}`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			errs := validateData(s, []byte(tc.data))
+			assert.Equal(t, tc.expectErr, len(errs) > 0, "Validation errors: %v", errs)
+		})
+	}
+}
+
+func TestCompileSchemaDrafts(t *testing.T) {
+	testCases := map[string]struct {
+		schema    string
+		data      string
+		expectErr bool
+	}{
+		"draft-04 boolean exclusiveMaximum": {
+			schema:    `{"$schema": "http://json-schema.org/draft-04/schema#", "maximum": 10, "exclusiveMaximum": true}`,
+			data:      `10`,
+			expectErr: true,
+		},
+		"draft-07 numeric exclusiveMaximum": {
+			schema:    `{"$schema": "http://json-schema.org/draft-07/schema#", "exclusiveMaximum": 10}`,
+			data:      `10`,
+			expectErr: true,
+		},
+		"draft-07 if/then": {
+			schema: `{"$schema": "http://json-schema.org/draft-07/schema#",
+				"if": {"properties": {"kind": {"const": "a"}}}, "then": {"required": ["a"]}}`,
+			data:      `{"kind": "a"}`,
+			expectErr: true,
+		},
+		"2020-12 prefixItems": {
+			schema:    `{"$schema": "https://json-schema.org/draft/2020-12/schema", "prefixItems": [{"type": "string"}]}`,
+			data:      `[1]`,
+			expectErr: true,
+		},
+		"2020-12 by default": {
+			schema:    `{"prefixItems": [{"type": "string"}], "items": false}`,
+			data:      `["a", "b"]`,
+			expectErr: true,
+		},
+		"valid data": {
+			schema: `{"$schema": "https://json-schema.org/draft/2020-12/schema", "prefixItems": [{"type": "string"}]}`,
+			data:   `["a", 1]`,
+		},
+		"data is not JSON": {
+			schema:    `{}`,
+			data:      `not json`,
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			s, err := compileSchema([]byte(tc.schema))
+			require.NoError(t, err)
+
+			errs := validateData(s, []byte(tc.data))
+			assert.Equal(t, tc.expectErr, len(errs) > 0, "Validation errors: %v", errs)
+		})
+	}
+}
+
+func TestCompileSchemaErrors(t *testing.T) {
+	_, err := compileSchema([]byte(`{"type": `))
+	assert.Error(t, err, "malformed document")
+
+	_, err = compileSchema([]byte(`{"$ref": "https://example.com/schema.json"}`))
+	assert.Error(t, err, "non-local reference")
+
+	_, err = compileSchema([]byte(`{"$ref": "#/definitions/Missing"}`))
+	assert.Error(t, err, "reference to a non-existing location")
+
+	_, err = compileSchema([]byte(`{"$schema": "https://example.com/custom-draft/schema"}`))
+	assert.Error(t, err, "unsupported draft")
+}
+
+func TestCompileBundledSchemas(t *testing.T) {
+	files, err := filepath.Glob("../../../../schemas/*.json")
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+
+	for _, f := range files {
+		doc, err := os.ReadFile(f)
+		require.NoError(t, err)
+
+		_, err = compileSchema(doc)
+		assert.NoError(t, err, "Failed to compile bundled schema %s", filepath.Base(f))
+	}
+}
+
+func TestValidatorSchemaSelection(t *testing.T) {
+	const typeOnly = `{"type": "object", "required": ["byType"]}`
+	const dataSchemaOnly = `{"type": "object", "required": ["byDataSchema"]}`
+
+	docs := []string{typeOnly, dataSchemaOnly}
+	docFn := func(i int) ([]byte, error) {
+		if i >= len(docs) {
+			return nil, fmt.Errorf("no document at index %d", i)
+		}
+		return []byte(docs[i]), nil
+	}
+
+	selectors := []SchemaSelector{
+		{Type: "test.type"},
+		{DataSchema: "https://example.com/schema.json"},
+	}
+
+	t.Run("user-provided schemas", func(t *testing.T) {
+		v, err := newValidator(selectors, docFn, false, false)
+		require.NoError(t, err)
+
+		errs, err := v.Validate(newEvent("test.type", "", `{"byType": 1}`))
+		require.NoError(t, err)
+		assert.Empty(t, errs)
+
+		errs, err = v.Validate(newEvent("test.type", "https://example.com/schema.json", `{"byType": 1}`))
+		require.NoError(t, err)
+		assert.NotEmpty(t, errs, "dataschema should take precedence over type")
+
+		errs, err = v.Validate(newEvent("test.type", "", `not json`))
+		require.NoError(t, err)
+		assert.NotEmpty(t, errs)
+	})
+
+	t.Run("bundled schemas", func(t *testing.T) {
+		v, err := newValidator(nil, docFn, true, false)
+		require.NoError(t, err)
+
+		errs, err := v.Validate(newEvent("com.amazon.sqs.message", "", `{"foo": "bar"}`))
+		require.NoError(t, err)
+		assert.NotEmpty(t, errs)
+
+		errs, err = v.Validate(newEvent("com.example.unknown",
+			"https://raw.githubusercontent.com/triggermesh/triggermesh/main/schemas/com.amazon.sqs.message.json",
+			`{"foo": "bar"}`))
+		require.NoError(t, err)
+		assert.NotEmpty(t, errs, "bundled schema should be selected by dataschema")
+
+		v, err = newValidator(nil, docFn, false, false)
+		require.NoError(t, err)
+
+		errs, err = v.Validate(newEvent("com.amazon.sqs.message", "", `{"foo": "bar"}`))
+		require.NoError(t, err)
+		assert.Empty(t, errs, "bundled schemas should not be used when disabled")
+	})
+
+	t.Run("unknown events", func(t *testing.T) {
+		v, err := newValidator(nil, docFn, true, false)
+		require.NoError(t, err)
+
+		errs, err := v.Validate(newEvent("com.example.unknown", "", `{}`))
+		require.NoError(t, err)
+		assert.Empty(t, errs)
+
+		v, err = newValidator(nil, docFn, true, true)
+		require.NoError(t, err)
+
+		errs, err = v.Validate(newEvent("com.example.unknown", "", `{}`))
+		require.NoError(t, err)
+		assert.NotEmpty(t, errs)
+	})
+
+	t.Run("missing document", func(t *testing.T) {
+		_, err := newValidator(append(selectors, SchemaSelector{Type: "other"}), docFn, false, false)
+		assert.Error(t, err)
+	})
+}
+
+func newEvent(typ, dataSchema, data string) *cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID("1")
+	e.SetType(typ)
+	e.SetSource("test.source")
+	if dataSchema != "" {
+		e.SetDataSchema(dataSchema)
+	}
+	_ = e.SetData(cloudevents.ApplicationJSON, []byte(data))
+	return &e
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/apis/duck"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/kmeta"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/scheme"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+)
+
+// Reasons reported for components which are not ready.
+const (
+	reasonNotObserved = "NotObserved"
+	reasonNoReadyCond = "NoReadyCondition"
+)
+
+// hasSink returns whether components of a given kind send events to a sink.
+var hasSink = v1alpha1.SinkCapabilityFromScheme(scheme.Scheme)
+
+// componentObjectName returns the name of the object of the given component.
+func componentObjectName(b *v1alpha1.Bridge, componentName string) string {
+	return kmeta.ChildName(b.Name+"-", componentName)
+}
+
+// newComponentObjects returns the desired objects of all the components of
+// the given Bridge, in the order of the Bridge's spec.
+func newComponentObjects(b *v1alpha1.Bridge) ([]*unstructured.Unstructured, error) {
+	objs := make([]*unstructured.Unstructured, len(b.Spec.Components))
+	byName := make(map[string]*unstructured.Unstructured, len(b.Spec.Components))
+
+	for i := range b.Spec.Components {
+		c := &b.Spec.Components[i]
+
+		obj, err := newComponentObject(b, c)
+		if err != nil {
+			return nil, fmt.Errorf("component %q: %w", c.Name, err)
+		}
+
+		objs[i] = obj
+		byName[c.Name] = obj
+	}
+
+	for i, c := range b.Spec.Components {
+		if c.To == nil {
+			continue
+		}
+
+		dest, ok := byName[*c.To]
+		if !ok {
+			return nil, fmt.Errorf("component %q: no component named %q", c.Name, *c.To)
+		}
+
+		if ok, known := hasSink(objs[i].GroupVersionKind()); known && !ok {
+			return nil, fmt.Errorf("component %q: components of kind %s do not send events to a sink",
+				c.Name, objs[i].GetKind())
+		}
+
+		sink := map[string]interface{}{
+			"ref": map[string]interface{}{
+				"apiVersion": dest.GetAPIVersion(),
+				"kind":       dest.GetKind(),
+				"name":       dest.GetName(),
+			},
+		}
+		if err := unstructured.SetNestedMap(objs[i].Object, sink, "spec", "sink"); err != nil {
+			return nil, fmt.Errorf("component %q: setting sink: %w", c.Name, err)
+		}
+	}
+
+	return objs, nil
+}
+
+// newComponentObject returns the desired object of the given component,
+// without any wiring to other components.
+func newComponentObject(b *v1alpha1.Bridge, c *v1alpha1.BridgeComponent) (*unstructured.Unstructured, error) {
+	tmpl, err := c.ObjectTemplate()
+	if err != nil {
+		return nil, err
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion(tmpl.GetAPIVersion())
+	obj.SetKind(tmpl.GetKind())
+	obj.SetNamespace(b.Namespace)
+	obj.SetName(componentObjectName(b, c.Name))
+
+	lbls := tmpl.GetLabels()
+	if lbls == nil {
+		lbls = make(map[string]string, 1)
+	}
+	lbls[common.LabelBridgeUsedByPrefix+b.Name] = common.LabelValueBridgeDominant
+	obj.SetLabels(lbls)
+
+	obj.SetAnnotations(tmpl.GetAnnotations())
+	obj.SetOwnerReferences([]metav1.OwnerReference{*kmeta.NewControllerRef(b)})
+
+	spec, found, err := unstructured.NestedMap(tmpl.Object, "spec")
+	if err != nil {
+		return nil, fmt.Errorf("reading spec: %w", err)
+	}
+	if !found {
+		spec = make(map[string]interface{})
+	}
+	obj.Object["spec"] = spec
+
+	return obj, nil
+}
+
+// componentStatus returns the observed state of a component from its object.
+func componentStatus(name string, obj *unstructured.Unstructured) v1alpha1.BridgeComponentStatus {
+	st := v1alpha1.BridgeComponentStatus{
+		Name: name,
+		Ref: duckv1.KReference{
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Name:       obj.GetName(),
+			Namespace:  obj.GetNamespace(),
+		},
+		Ready: corev1.ConditionUnknown,
+	}
+
+	kr := &duckv1.KResource{}
+	if err := duck.FromUnstructured(obj, kr); err != nil {
+		st.Reason = "Failed to read status: " + err.Error()
+		return st
+	}
+
+	if kr.Status.ObservedGeneration != kr.Generation {
+		st.Reason = reasonNotObserved
+		return st
+	}
+
+	cond := kr.Status.GetCondition(apis.ConditionReady)
+	if cond == nil {
+		st.Reason = reasonNoReadyCond
+		return st
+	}
+
+	st.Ready = cond.Status
+	st.Reason = cond.Reason
+
+	return st
+}
+
+// eventFlow returns the paths followed by events across the given
+// components, starting from the components which don't receive events from
+// any other component.
+func eventFlow(components []v1alpha1.BridgeComponent) []string {
+	to := make(map[string]string, len(components))
+	isDest := make(map[string]struct{}, len(components))
+
+	for _, c := range components {
+		if c.To != nil {
+			to[c.Name] = *c.To
+			isDest[*c.To] = struct{}{}
+		}
+	}
+
+	var flow []string
+
+	for _, c := range components {
+		if _, ok := isDest[c.Name]; ok {
+			continue
+		}
+
+		path := []string{c.Name}
+		seen := map[string]struct{}{c.Name: {}}
+		for next, ok := to[c.Name]; ok; next, ok = to[next] {
+			if _, isSeen := seen[next]; isSeen {
+				break
+			}
+			seen[next] = struct{}{}
+			path = append(path, next)
+		}
+
+		flow = append(flow, strings.Join(path, " -> "))
+	}
+
+	return flow
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/apis/duck"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	"knative.dev/pkg/configmap"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection/clients/dynamicclient"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	informerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/flow/v1alpha1/bridge"
+	reconcilerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/reconciler/flow/v1alpha1/bridge"
+)
+
+// NewController initializes the controller and is called by the generated code
+// Registers event handlers to enqueue events
+func NewController(
+	ctx context.Context,
+	cmw configmap.Watcher,
+) *controller.Impl {
+
+	informer := informerv1alpha1.Get(ctx)
+	dynamicCli := dynamicclient.Get(ctx)
+
+	r := &Reconciler{
+		dynamicCli: dynamicCli,
+	}
+	impl := reconcilerv1alpha1.NewImpl(ctx, r)
+
+	// objects of components are watched using informers which are created
+	// on demand, for each kind of object referenced by Bridges
+	r.watcher = &informerWatcher{
+		factory: &duck.CachedInformerFactory{
+			Delegate: &duck.TypedInformerFactory{
+				Client:       dynamicCli,
+				Type:         &duckv1.KResource{},
+				ResyncPeriod: controller.GetResyncPeriod(ctx),
+				StopChannel:  ctx.Done(),
+			},
+		},
+		handler: cache.FilteringResourceEventHandler{
+			FilterFunc: controller.FilterControllerGK(v1alpha1.Kind("Bridge")),
+			Handler:    controller.HandleAll(impl.EnqueueControllerOf),
+		},
+		watched: make(map[schema.GroupVersionResource]struct{}),
+	}
+
+	informer.Informer().AddEventHandler(controller.HandleAll(impl.Enqueue))
+
+	return impl
+}
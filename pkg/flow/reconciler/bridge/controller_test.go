@@ -0,0 +1,47 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"testing"
+
+	"knative.dev/pkg/configmap"
+	rt "knative.dev/pkg/reconciler/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/testing/structs"
+
+	// Link fake clients and informers accessed by our controller
+	_ "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/flow/v1alpha1/bridge/fake"
+	_ "knative.dev/pkg/injection/clients/dynamicclient/fake"
+)
+
+func TestNewController(t *testing.T) {
+	ctx, informers := rt.SetupFakeContext(t)
+
+	// Informers that are expected:
+	// - Bridge
+	const expectInformers = 1
+
+	if got := len(informers); got != expectInformers {
+		t.Errorf("Expected %d injected informers, got %d", expectInformers, got)
+	}
+
+	ctrler := NewController(ctx, configmap.NewStaticWatcher())
+
+	// catch unitialized fields in Reconciler struct
+	structs.EnsureNoNilField(t, ctrler)
+}
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	reconcilerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/reconciler/flow/v1alpha1/bridge"
+)
+
+// Reconciler implements controller.Reconciler for the Bridge type.
+type Reconciler struct {
+	dynamicCli dynamic.Interface
+	watcher    componentWatcher
+}
+
+// Check that our Reconciler implements Interface
+var _ reconcilerv1alpha1.Interface = (*Reconciler)(nil)
+
+// ReconcileKind implements Interface.ReconcileKind.
+func (r *Reconciler) ReconcileKind(ctx context.Context, b *v1alpha1.Bridge) reconciler.Event {
+	status := &b.Status
+
+	objs, err := newComponentObjects(b)
+	if err != nil {
+		status.MarkComponentsFailed(v1alpha1.BridgeReasonFailedSync, err.Error())
+		return controller.NewPermanentError(err)
+	}
+
+	compStatuses := make([]v1alpha1.BridgeComponentStatus, 0, len(objs))
+	var failed []string
+	var notOwned []string
+	var syncErrs []string
+
+	for i, desired := range objs {
+		name := b.Spec.Components[i].Name
+
+		current, err := r.reconcileComponent(ctx, b, desired)
+		switch {
+		case errors.Is(err, errNotOwned):
+			notOwned = append(notOwned, name)
+			continue
+		case err != nil:
+			syncErrs = append(syncErrs, fmt.Sprintf("%s: %s", name, err))
+			continue
+		}
+
+		cs := componentStatus(name, current)
+		if cs.Ready != corev1.ConditionTrue {
+			failed = append(failed, name)
+		}
+		compStatuses = append(compStatuses, cs)
+	}
+
+	// only prune objects once all desired objects were successfully
+	// synchronized, to avoid deleting anything based on a partial state
+	if len(syncErrs) == 0 {
+		if err := r.pruneComponents(ctx, b, objs); err != nil {
+			syncErrs = append(syncErrs, err.Error())
+		}
+	}
+
+	status.Components = mergeComponentStatuses(status.Components, compStatuses, len(syncErrs) > 0)
+	status.EventFlow = eventFlow(b.Spec.Components)
+
+	switch {
+	case len(notOwned) > 0:
+		msg := "Objects of components already exist and are not owned by the Bridge: " + strings.Join(notOwned, ", ")
+		status.MarkComponentsFailed(v1alpha1.BridgeReasonNotOwned, msg)
+		return controller.NewPermanentError(reconciler.NewEvent(corev1.EventTypeWarning,
+			v1alpha1.BridgeReasonNotOwned, msg))
+
+	case len(syncErrs) > 0:
+		msg := "Failed to synchronize components: " + strings.Join(syncErrs, "; ")
+		status.MarkComponentsFailed(v1alpha1.BridgeReasonFailedSync, msg)
+		return errors.New(msg)
+
+	case len(failed) > 0:
+		status.MarkComponentsNotReady(v1alpha1.BridgeReasonComponentsNotReady,
+			"Components are not ready: "+strings.Join(failed, ", "))
+
+	default:
+		status.MarkComponentsReady()
+	}
+
+	return nil
+}
+
+// errNotOwned is returned when the object of a component exists but is not
+// controlled by the Bridge.
+var errNotOwned = errors.New("object is not owned by the Bridge")
+
+// reconcileComponent ensures the object of a component exists in its
+// desired state and returns its current state.
+func (r *Reconciler) reconcileComponent(ctx context.Context, b *v1alpha1.Bridge,
+	desired *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+
+	gvr, _ := meta.UnsafeGuessKindToResource(desired.GroupVersionKind())
+
+	if err := r.watcher.Watch(ctx, gvr); err != nil {
+		return nil, err
+	}
+
+	cli := r.dynamicCli.Resource(gvr).Namespace(desired.GetNamespace())
+
+	current, err := cli.Get(ctx, desired.GetName(), metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		current, err = cli.Create(ctx, desired, metav1.CreateOptions{})
+		if err != nil {
+			recordEvent(ctx, b, corev1.EventTypeWarning, "FailedComponentCreate",
+				"Failed to create %s %q: %s", desired.GetKind(), desired.GetName(), err)
+			return nil, fmt.Errorf("creating %s: %w", desired.GetKind(), err)
+		}
+		recordEvent(ctx, b, corev1.EventTypeNormal, "CreateComponent",
+			"Created %s %q", current.GetKind(), current.GetName())
+		return current, nil
+
+	case err != nil:
+		return nil, fmt.Errorf("getting %s: %w", desired.GetKind(), err)
+	}
+
+	if !metav1.IsControlledBy(current, b) {
+		return nil, errNotOwned
+	}
+
+	if isSynced(desired, current) {
+		return current, nil
+	}
+
+	updated := current.DeepCopy()
+	updated.SetLabels(desired.GetLabels())
+	updated.SetAnnotations(desired.GetAnnotations())
+	updated.Object["spec"] = desired.Object["spec"]
+
+	current, err = cli.Update(ctx, updated, metav1.UpdateOptions{})
+	if err != nil {
+		recordEvent(ctx, b, corev1.EventTypeWarning, "FailedComponentUpdate",
+			"Failed to update %s %q: %s", desired.GetKind(), desired.GetName(), err)
+		return nil, fmt.Errorf("updating %s: %w", desired.GetKind(), err)
+	}
+	recordEvent(ctx, b, corev1.EventTypeNormal, "UpdateComponent",
+		"Updated %s %q", current.GetKind(), current.GetName())
+
+	return current, nil
+}
+
+// isSynced returns whether the current state of a component's object
+// matches its desired state. Fields which are unset in the desired state,
+// such as defaulted values, are ignored.
+func isSynced(desired, current *unstructured.Unstructured) bool {
+	return equality.Semantic.DeepDerivative(desired.Object["spec"], current.Object["spec"]) &&
+		equality.Semantic.DeepDerivative(desired.GetLabels(), current.GetLabels()) &&
+		equality.Semantic.DeepDerivative(desired.GetAnnotations(), current.GetAnnotations())
+}
+
+// pruneComponents deletes the objects of components which were previously
+// part of the Bridge but aren't anymore.
+func (r *Reconciler) pruneComponents(ctx context.Context, b *v1alpha1.Bridge,
+	desired []*unstructured.Unstructured) error {
+
+	type objKey struct {
+		gk   schema.GroupKind
+		name string
+	}
+
+	isDesired := make(map[objKey]struct{}, len(desired))
+	for _, obj := range desired {
+		isDesired[objKey{obj.GroupVersionKind().GroupKind(), obj.GetName()}] = struct{}{}
+	}
+
+	for _, cs := range b.Status.Components {
+		gv, err := schema.ParseGroupVersion(cs.Ref.APIVersion)
+		if err != nil {
+			continue
+		}
+		gvk := gv.WithKind(cs.Ref.Kind)
+
+		if _, ok := isDesired[objKey{gvk.GroupKind(), cs.Ref.Name}]; ok {
+			continue
+		}
+
+		gvr, _ := meta.UnsafeGuessKindToResource(gvk)
+		cli := r.dynamicCli.Resource(gvr).Namespace(b.Namespace)
+
+		obj, err := cli.Get(ctx, cs.Ref.Name, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			continue
+		case err != nil:
+			return fmt.Errorf("getting %s %q: %w", cs.Ref.Kind, cs.Ref.Name, err)
+		}
+
+		if !metav1.IsControlledBy(obj, b) {
+			continue
+		}
+
+		err = cli.Delete(ctx, cs.Ref.Name, metav1.DeleteOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			recordEvent(ctx, b, corev1.EventTypeWarning, "FailedComponentDelete",
+				"Failed to delete %s %q: %s", cs.Ref.Kind, cs.Ref.Name, err)
+			return fmt.Errorf("deleting %s %q: %w", cs.Ref.Kind, cs.Ref.Name, err)
+		}
+		recordEvent(ctx, b, corev1.EventTypeNormal, "DeleteComponent",
+			"Deleted %s %q", cs.Ref.Kind, cs.Ref.Name)
+	}
+
+	return nil
+}
+
+// mergeComponentStatuses returns the statuses of components observed
+// during the current reconciliation. When some components couldn't be
+// synchronized, the previous statuses of components which weren't observed
+// are retained, so that their objects remain candidates for pruning.
+func mergeComponentStatuses(prev, observed []v1alpha1.BridgeComponentStatus,
+	hasSyncErrs bool) []v1alpha1.BridgeComponentStatus {
+
+	if !hasSyncErrs {
+		return observed
+	}
+
+	isObserved := make(map[string]struct{}, len(observed))
+	for _, cs := range observed {
+		isObserved[cs.Name] = struct{}{}
+	}
+
+	merged := observed
+	for _, cs := range prev {
+		if _, ok := isObserved[cs.Name]; !ok {
+			merged = append(merged, cs)
+		}
+	}
+
+	return merged
+}
+
+// recordEvent records an API event for the given Bridge.
+func recordEvent(ctx context.Context, b *v1alpha1.Bridge, typ, reason, msgFmt string, args ...interface{}) {
+	if recorder := controller.GetEventRecorder(ctx); recorder != nil {
+		recorder.Eventf(b, typ, reason, msgFmt, args...)
+	}
+}
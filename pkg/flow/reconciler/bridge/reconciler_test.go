@@ -0,0 +1,303 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+
+	"knative.dev/pkg/apis"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+)
+
+const (
+	tNs   = "test-namespace"
+	tName = "test"
+	tUID  = types.UID("00000000-0000-0000-0000-000000000000")
+)
+
+var (
+	webhookSourceGVR     = schema.GroupVersionResource{Group: "sources.triggermesh.io", Version: "v1alpha1", Resource: "webhooksources"}
+	cloudEventsTargetGVR = schema.GroupVersionResource{Group: "targets.triggermesh.io", Version: "v1alpha1", Resource: "cloudeventstargets"}
+)
+
+func TestReconcileCreatesComponents(t *testing.T) {
+	b := newBridge()
+	r, cli := newTestReconciler(t)
+
+	err := r.ReconcileKind(context.Background(), b)
+	require.NoError(t, err)
+
+	src, err := cli.Resource(webhookSourceGVR).Namespace(tNs).Get(context.Background(), "test-src", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, "com.example.test", nestedString(t, src, "spec", "eventType"))
+	assert.Equal(t, map[string]interface{}{
+		"apiVersion": "targets.triggermesh.io/v1alpha1",
+		"kind":       "CloudEventsTarget",
+		"name":       "test-tgt",
+	}, nested(t, src, "spec", "sink", "ref"))
+	assert.Equal(t, map[string]string{
+		"app":                                  "demo",
+		"flow.triggermesh.io/used-by." + tName: "dominant",
+	}, src.GetLabels())
+	assert.True(t, metav1.IsControlledBy(src, b))
+
+	_, err = cli.Resource(cloudEventsTargetGVR).Namespace(tNs).Get(context.Background(), "test-tgt", metav1.GetOptions{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"src -> tgt"}, b.Status.EventFlow)
+	require.Len(t, b.Status.Components, 2)
+	assert.Equal(t, corev1.ConditionUnknown, b.Status.Components[0].Ready)
+
+	cond := b.Status.GetCondition(v1alpha1.BridgeConditionComponentsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionUnknown, cond.Status)
+	assert.Equal(t, v1alpha1.BridgeReasonComponentsNotReady, cond.Reason)
+}
+
+func TestReconcileReadyComponents(t *testing.T) {
+	b := newBridge()
+
+	objs, err := newComponentObjects(b)
+	require.NoError(t, err)
+
+	existing := make([]runtime.Object, len(objs))
+	for i, obj := range objs {
+		existing[i] = markReady(obj)
+	}
+
+	r, cli := newTestReconciler(t, existing...)
+
+	err = r.ReconcileKind(context.Background(), b)
+	require.NoError(t, err)
+
+	for _, a := range cli.Actions() {
+		assert.Equal(t, "get", a.GetVerb(), "Unexpected action on synchronized objects")
+	}
+
+	require.Len(t, b.Status.Components, 2)
+	for _, cs := range b.Status.Components {
+		assert.Equal(t, corev1.ConditionTrue, cs.Ready)
+	}
+	assert.True(t, b.Status.GetCondition(apis.ConditionReady).IsTrue())
+}
+
+func TestReconcileUpdatesComponents(t *testing.T) {
+	b := newBridge()
+
+	objs, err := newComponentObjects(b)
+	require.NoError(t, err)
+
+	outdated := objs[0].DeepCopy()
+	require.NoError(t, unstructured.SetNestedField(outdated.Object, "com.example.old", "spec", "eventType"))
+
+	r, cli := newTestReconciler(t, outdated)
+
+	err = r.ReconcileKind(context.Background(), b)
+	require.NoError(t, err)
+
+	src, err := cli.Resource(webhookSourceGVR).Namespace(tNs).Get(context.Background(), "test-src", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, "com.example.test", nestedString(t, src, "spec", "eventType"))
+}
+
+func TestReconcileNotOwned(t *testing.T) {
+	b := newBridge()
+
+	foreign := &unstructured.Unstructured{}
+	foreign.SetAPIVersion("targets.triggermesh.io/v1alpha1")
+	foreign.SetKind("CloudEventsTarget")
+	foreign.SetNamespace(tNs)
+	foreign.SetName("test-tgt")
+
+	r, _ := newTestReconciler(t, foreign)
+
+	err := r.ReconcileKind(context.Background(), b)
+	require.Error(t, err)
+	assert.True(t, controller.IsPermanentError(err))
+
+	cond := b.Status.GetCondition(v1alpha1.BridgeConditionComponentsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1alpha1.BridgeReasonNotOwned, cond.Reason)
+}
+
+func TestReconcileComponentWithoutSink(t *testing.T) {
+	b := newBridge()
+	b.Spec.Components[0], b.Spec.Components[1] = b.Spec.Components[1], b.Spec.Components[0]
+	b.Spec.Components[0].To, b.Spec.Components[1].To = ptr.String("src"), nil
+
+	r, cli := newTestReconciler(t)
+
+	err := r.ReconcileKind(context.Background(), b)
+	require.Error(t, err)
+	assert.True(t, controller.IsPermanentError(err))
+	assert.Empty(t, cli.Actions(), "No component should be created")
+
+	cond := b.Status.GetCondition(v1alpha1.BridgeConditionComponentsReady)
+	require.NotNil(t, cond)
+	assert.Equal(t, corev1.ConditionFalse, cond.Status)
+	assert.Equal(t, v1alpha1.BridgeReasonFailedSync, cond.Reason)
+}
+
+func TestReconcilePrunesComponents(t *testing.T) {
+	b := newBridge()
+
+	objs, err := newComponentObjects(b)
+	require.NoError(t, err)
+
+	// the source was removed from the Bridge since the last reconciliation
+	b.Status.Components = []v1alpha1.BridgeComponentStatus{
+		componentStatus("src", objs[0]),
+		componentStatus("tgt", objs[1]),
+	}
+	b.Spec.Components = b.Spec.Components[1:]
+
+	r, cli := newTestReconciler(t, objs[0], objs[1])
+
+	err = r.ReconcileKind(context.Background(), b)
+	require.NoError(t, err)
+
+	_, err = cli.Resource(webhookSourceGVR).Namespace(tNs).Get(context.Background(), "test-src", metav1.GetOptions{})
+	assert.Error(t, err, "Expected the source to be deleted")
+
+	require.Len(t, b.Status.Components, 1)
+	assert.Equal(t, "tgt", b.Status.Components[0].Name)
+	assert.Equal(t, []string{"tgt"}, b.Status.EventFlow)
+}
+
+func TestEventFlow(t *testing.T) {
+	components := []v1alpha1.BridgeComponent{
+		{Name: "tgt"},
+		{Name: "step", To: ptr.String("tgt")},
+		{Name: "src1", To: ptr.String("step")},
+		{Name: "src2", To: ptr.String("step")},
+		{Name: "standalone"},
+	}
+
+	expect := []string{
+		"src1 -> step -> tgt",
+		"src2 -> step -> tgt",
+		"standalone",
+	}
+
+	assert.Equal(t, expect, eventFlow(components))
+}
+
+// newBridge returns a Bridge made of a source which sends events to a target.
+func newBridge() *v1alpha1.Bridge {
+	return &v1alpha1.Bridge{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: tNs,
+			Name:      tName,
+			UID:       tUID,
+		},
+		Spec: v1alpha1.BridgeSpec{
+			Components: []v1alpha1.BridgeComponent{{
+				Name: "src",
+				Object: runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "sources.triggermesh.io/v1alpha1",
+					"kind": "WebhookSource",
+					"metadata": {"labels": {"app": "demo"}},
+					"spec": {"eventType": "com.example.test"}
+				}`)},
+				To: ptr.String("tgt"),
+			}, {
+				Name: "tgt",
+				Object: runtime.RawExtension{Raw: []byte(`{
+					"apiVersion": "targets.triggermesh.io/v1alpha1",
+					"kind": "CloudEventsTarget",
+					"spec": {"endpoint": "https://example.com"}
+				}`)},
+			}},
+		},
+	}
+}
+
+// markReady returns a copy of the given object with a Ready status.
+func markReady(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	obj.SetGeneration(1)
+	obj.Object["status"] = map[string]interface{}{
+		"observedGeneration": int64(1),
+		"conditions": []interface{}{
+			map[string]interface{}{"type": "Ready", "status": "True"},
+		},
+	}
+	return obj
+}
+
+// newTestReconciler returns a Reconciler backed by a fake dynamic client
+// populated with the given objects.
+func newTestReconciler(t *testing.T, objs ...runtime.Object) (*Reconciler, *dynamicfake.FakeDynamicClient) {
+	t.Helper()
+
+	cli := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+		map[schema.GroupVersionResource]string{
+			webhookSourceGVR:     "WebhookSourceList",
+			cloudEventsTargetGVR: "CloudEventsTargetList",
+		},
+		objs...,
+	)
+
+	return &Reconciler{
+		dynamicCli: cli,
+		watcher:    noopWatcher{},
+	}, cli
+}
+
+// noopWatcher is a componentWatcher which doesn't watch anything.
+type noopWatcher struct{}
+
+func (noopWatcher) Watch(context.Context, schema.GroupVersionResource) error { return nil }
+
+// nested returns the map at the given path in an unstructured object.
+func nested(t *testing.T, obj *unstructured.Unstructured, fields ...string) map[string]interface{} {
+	t.Helper()
+
+	m, found, err := unstructured.NestedMap(obj.Object, fields...)
+	require.NoError(t, err)
+	require.True(t, found, "Field not found: %v", fields)
+
+	return m
+}
+
+// nestedString returns the string at the given path in an unstructured object.
+func nestedString(t *testing.T, obj *unstructured.Unstructured, fields ...string) string {
+	t.Helper()
+
+	s, found, err := unstructured.NestedString(obj.Object, fields...)
+	require.NoError(t, err)
+	require.True(t, found, "Field not found: %v", fields)
+
+	return s
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bridge
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/apis/duck"
+)
+
+// componentWatcher ensures that changes to the objects of the components of
+// Bridges trigger a reconciliation of the owning Bridge.
+type componentWatcher interface {
+	Watch(context.Context, schema.GroupVersionResource) error
+}
+
+// informerWatcher is a componentWatcher which starts a shared informer for
+// each watched resource type. Informers are started lazily, the first time
+// a resource type is referenced by a Bridge.
+type informerWatcher struct {
+	factory duck.InformerFactory
+	handler cache.ResourceEventHandler
+
+	mu      sync.Mutex
+	watched map[schema.GroupVersionResource]struct{}
+}
+
+// Check that informerWatcher implements componentWatcher.
+var _ componentWatcher = (*informerWatcher)(nil)
+
+// Watch implements componentWatcher.
+func (w *informerWatcher) Watch(ctx context.Context, gvr schema.GroupVersionResource) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, isWatched := w.watched[gvr]; isWatched {
+		return nil
+	}
+
+	inf, _, err := w.factory.Get(ctx, gvr)
+	if err != nil {
+		return fmt.Errorf("starting informer for %s: %w", gvr, err)
+	}
+
+	inf.AddEventHandler(w.handler)
+	w.watched[gvr] = struct{}{}
+
+	return nil
+}
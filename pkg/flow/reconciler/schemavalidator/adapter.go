@@ -0,0 +1,130 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"encoding/json"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/eventing/pkg/reconciler/source"
+	"knative.dev/pkg/apis"
+	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
+)
+
+const (
+	envInvalidSink       = "SCHEMAVALIDATOR_INVALID_SINK"
+	envSchemas           = "SCHEMAVALIDATOR_SCHEMAS"
+	envUseBundledSchemas = "SCHEMAVALIDATOR_USE_BUNDLED_SCHEMAS"
+	envRejectUnknown     = "SCHEMAVALIDATOR_REJECT_UNKNOWN"
+
+	// suffixed with the index of the schema
+	envSchemaPrefix = "SCHEMAVALIDATOR_SCHEMA_"
+)
+
+// schemaSelector is the representation of a schema selector in the
+// adapter's environment.
+type schemaSelector struct {
+	Type       string `json:"type,omitempty"`
+	DataSchema string `json:"dataSchema,omitempty"`
+}
+
+// adapterConfig contains properties used to configure the target's adapter.
+// Public fields are automatically populated by envconfig.
+type adapterConfig struct {
+	// Configuration accessor for logging/metrics/tracing
+	obsConfig source.ConfigAccessor
+	// Container image
+	Image string `default:"gcr.io/triggermesh/schemavalidator-adapter"`
+}
+
+// Verify that Reconciler implements common.AdapterBuilder.
+var _ common.AdapterBuilder[*servingv1.Service] = (*Reconciler)(nil)
+
+// BuildAdapter implements common.AdapterBuilder.
+func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, sinkURI *apis.URL) (*servingv1.Service, error) {
+	typedTrg := trg.(*v1alpha1.SchemaValidator)
+
+	return common.NewAdapterKnService(trg, sinkURI,
+		resource.Image(r.adapterCfg.Image),
+		resource.EnvVars(MakeAppEnv(typedTrg)...),
+		resource.EnvVars(r.adapterCfg.obsConfig.ToEnvVars()...),
+	), nil
+}
+
+// MakeAppEnv extracts environment variables from the object.
+// Exported to be used in external tools for local test environments.
+func MakeAppEnv(o *v1alpha1.SchemaValidator) []corev1.EnvVar {
+	env := []corev1.EnvVar{
+		{
+			Name:  common.EnvBridgeID,
+			Value: common.GetStatefulBridgeID(o),
+		},
+	}
+
+	if uri := o.Status.InvalidSinkURI; uri != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envInvalidSink,
+			Value: uri.String(),
+		})
+	}
+
+	if len(o.Spec.Schemas) != 0 {
+		selectors := make([]schemaSelector, len(o.Spec.Schemas))
+
+		for i, s := range o.Spec.Schemas {
+			if s.Type != nil {
+				selectors[i].Type = *s.Type
+			}
+			if s.DataSchema != nil {
+				selectors[i].DataSchema = *s.DataSchema
+			}
+
+			env = append(env, *s.Schema.ToEnvironmentVariable(envSchemaPrefix + strconv.Itoa(i)))
+		}
+
+		// marshaling a list of structs with string fields can not fail
+		b, _ := json.Marshal(selectors)
+
+		env = append(env, corev1.EnvVar{
+			Name:  envSchemas,
+			Value: string(b),
+		})
+	}
+
+	if o.Spec.UseBundledSchemas != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envUseBundledSchemas,
+			Value: strconv.FormatBool(*o.Spec.UseBundledSchemas),
+		})
+	}
+
+	if o.Spec.RejectUnknown != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envRejectUnknown,
+			Value: strconv.FormatBool(*o.Spec.RejectUnknown),
+		})
+	}
+
+	return env
+}
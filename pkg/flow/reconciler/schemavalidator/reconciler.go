@@ -0,0 +1,77 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemavalidator
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/reconciler"
+
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	reconcilerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/reconciler/flow/v1alpha1/schemavalidator"
+	listersv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/flow/v1alpha1"
+	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+)
+
+// Reconciler implements controller.Reconciler for the event target type.
+type Reconciler struct {
+	base       common.GenericServiceReconciler[*v1alpha1.SchemaValidator, listersv1alpha1.SchemaValidatorNamespaceLister]
+	adapterCfg *adapterConfig
+}
+
+// Check that our Reconciler implements Interface
+var _ reconcilerv1alpha1.Interface = (*Reconciler)(nil)
+
+// ReconcileKind implements Interface.ReconcileKind.
+func (r *Reconciler) ReconcileKind(ctx context.Context, trg *v1alpha1.SchemaValidator) reconciler.Event {
+	// inject target into context for usage in reconciliation logic
+	ctx = commonv1alpha1.WithReconcilable(ctx, trg)
+
+	if err := r.resolveInvalidSink(ctx, trg); err != nil {
+		return err
+	}
+
+	return r.base.ReconcileAdapter(ctx, r)
+}
+
+// resolveInvalidSink resolves the URI of the destination of invalid events
+// and propagates it to the status of the given SchemaValidator.
+func (r *Reconciler) resolveInvalidSink(ctx context.Context, trg *v1alpha1.SchemaValidator) error {
+	dest := trg.Spec.InvalidSink
+	if dest == nil || (dest.Ref == nil && dest.URI == nil) {
+		trg.Status.InvalidSinkURI = nil
+		return nil
+	}
+
+	dest = dest.DeepCopy()
+	if ref := dest.Ref; ref != nil && ref.Namespace == "" {
+		ref.Namespace = trg.Namespace
+	}
+
+	uri, err := r.base.SinkResolver.URIFromDestinationV1(ctx, *dest, trg)
+	if err != nil {
+		return controller.NewPermanentError(reconciler.NewEvent(corev1.EventTypeWarning,
+			common.ReasonBadSinkURI, "Could not resolve URI of invalid events sink: %s", err))
+	}
+	trg.Status.InvalidSinkURI = uri
+
+	return nil
+}
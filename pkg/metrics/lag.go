@@ -0,0 +1,130 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opencensus.io/stats"
+	"go.opencensus.io/stats/view"
+	"go.opencensus.io/tag"
+	"go.uber.org/zap"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/metrics"
+)
+
+// MetricNameConsumerLag is the name of the metric which conveys the number of
+// messages pending consumption by a component.
+//
+// The name of the metric exported by a component's adapter is prefixed with
+// the name of that component (e.g. "awssqssource_consumer_lag").
+const MetricNameConsumerLag = "consumer_lag"
+
+// DefaultConsumerLagPollInterval is the default interval at which the
+// consumer lag of a component is observed.
+const DefaultConsumerLagPollInterval = 15 * time.Second
+
+// consumerLagM is a measure of the number of messages pending consumption by
+// a component.
+var consumerLagM = stats.Int64(
+	MetricNameConsumerLag,
+	"Number of messages pending consumption",
+	stats.UnitDimensionless,
+)
+
+// MustRegisterConsumerLagStatsView registers an OpenCensus stats view for
+// metrics related to the consumer lag, and panics in case of error.
+func MustRegisterConsumerLagStatsView() {
+	err := view.Register(
+		&view.View{
+			Measure:     consumerLagM,
+			Description: consumerLagM.Description(),
+			Aggregation: view.LastValue(),
+			TagKeys: []tag.Key{
+				tagKeyResourceGroup,
+				tagKeyNamespace,
+				tagKeyName,
+			},
+		},
+	)
+	if err != nil {
+		panic(fmt.Errorf("error registering OpenCensus stats view: %w", err))
+	}
+}
+
+// ConsumerLagFunc returns the number of messages pending consumption.
+type ConsumerLagFunc func(context.Context) (int64, error)
+
+// ConsumerLagStatsReporter collects and reports stats about the consumer lag
+// of a component.
+type ConsumerLagStatsReporter struct {
+	// context that holds pre-populated OpenCensus tags
+	tagsCtx context.Context
+}
+
+// MustNewConsumerLagStatsReporter returns a new ConsumerLagStatsReporter
+// initialized with the given tags and panics in case of error.
+func MustNewConsumerLagStatsReporter(tags *pkgadapter.MetricTag) *ConsumerLagStatsReporter {
+	ctx, err := tag.New(context.Background(),
+		tag.Insert(tagKeyResourceGroup, tags.ResourceGroup),
+		tag.Insert(tagKeyNamespace, tags.Namespace),
+		tag.Insert(tagKeyName, tags.Name),
+	)
+	if err != nil {
+		panic(fmt.Errorf("error creating OpenCensus tags: %w", err))
+	}
+
+	return &ConsumerLagStatsReporter{
+		tagsCtx: ctx,
+	}
+}
+
+// ReportConsumerLag sets the value of consumerLagM.
+func (r *ConsumerLagStatsReporter) ReportConsumerLag(lag int64) {
+	metrics.Record(r.tagsCtx, consumerLagM.M(lag))
+}
+
+// Run observes the consumer lag returned by the given function at the given
+// interval, and reports it until the context is cancelled.
+// Failures to observe the consumer lag are logged but not fatal, the last
+// reported value is retained until the next successful observation.
+func (r *ConsumerLagStatsReporter) Run(ctx context.Context, interval time.Duration, fn ConsumerLagFunc) {
+	logger := logging.FromContext(ctx)
+
+	t := time.NewTicker(interval)
+	defer t.Stop()
+
+	for {
+		lag, err := fn(ctx)
+		switch {
+		case err == nil:
+			r.ReportConsumerLag(lag)
+		case ctx.Err() == nil:
+			logger.Warnw("Unable to observe the consumer lag", zap.Error(err))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/metrics/metricstest"
+
+	// Essential. Initializes a Prometheus metrics exporter for tests.
+	_ "knative.dev/pkg/metrics/testing"
+
+	. "github.com/triggermesh/triggermesh/pkg/metrics"
+)
+
+func TestConsumerLagStatsReporter(t *testing.T) {
+	const (
+		tRg   = "foos.fake.example.com"
+		tNs   = "test-ns"
+		tName = "test"
+	)
+
+	testMetricTags := &pkgadapter.MetricTag{
+		ResourceGroup: tRg,
+		Namespace:     tNs,
+		Name:          tName,
+	}
+
+	wantTags := map[string]string{
+		"resource_group": tRg,
+		"namespace_name": tNs,
+		"name":           tName,
+	}
+
+	resetMetrics := func(t *testing.T) {
+		t.Helper()
+		metricstest.Unregister(MetricNameConsumerLag)
+		MustRegisterConsumerLagStatsView()
+		metricstest.AssertNoMetric(t, MetricNameConsumerLag)
+	}
+
+	st := MustNewConsumerLagStatsReporter(testMetricTags)
+
+	t.Run("report", func(t *testing.T) {
+		resetMetrics(t)
+
+		st.ReportConsumerLag(12)
+		st.ReportConsumerLag(34)
+
+		metricstest.CheckLastValueData(t, MetricNameConsumerLag, wantTags, 34)
+	})
+
+	t.Run("poll", func(t *testing.T) {
+		resetMetrics(t)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		var calls int
+		lagFn := func(context.Context) (int64, error) {
+			calls++
+			if calls == 1 {
+				return 42, nil
+			}
+			// the last observed value must be retained on failures
+			cancel()
+			return 0, errors.New("fake error")
+		}
+
+		done := make(chan struct{})
+		go func() {
+			st.Run(ctx, time.Millisecond, lagFn)
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatal("Timeout waiting for Run to return")
+		}
+
+		if calls != 2 {
+			t.Errorf("Expected the consumer lag to be observed 2 times, got %d", calls)
+		}
+
+		metricstest.CheckLastValueData(t, MetricNameConsumerLag, wantTags, 42)
+	})
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package reconciler
 
 import (
+	"os"
 	"strconv"
 	"strings"
 	"time"
@@ -55,6 +56,8 @@ const roleNameConfigWatcher = "triggermesh-config-watcher"
 
 const defaultSinkTimeout = 30 * time.Second
 
+const defaultDispatchWorkers = 10
+
 // ComponentName returns the component name for the given object.
 func ComponentName(o kmeta.OwnerRefable) string {
 	return strings.ToLower(o.GetGroupVersionKind().Kind)
@@ -96,7 +99,7 @@ func NewAdapterDeployment(rcl v1alpha1.Reconcilable, sinkURI *apis.URL, opts ...
 	}
 
 	return resource.NewDeployment(rclNs, kmeta.ChildName(ComponentName(rcl)+"-", rclName),
-		append(commonAdapterDeploymentOptions(rcl), append([]resource.ObjectOption{
+		append(commonAdapterDeploymentOptions(rcl), append(append([]resource.ObjectOption{
 			resource.Controller(rcl),
 
 			// Used to label Prometheus metrics with the component
@@ -108,10 +111,14 @@ func NewAdapterDeployment(rcl v1alpha1.Reconcilable, sinkURI *apis.URL, opts ...
 			resource.Selector(appInstanceLabel, rclName),
 
 			resource.EnvVar(envSink, sinkURIStr),
-		}, opts...)...)...,
+		}, opts...), joinSecretsReferences)...)...,
 	)
 }
 
+// joinSecretsReferences merges the lists of variables resolved by the adapter
+// at runtime, which may be set by distinct options.
+var joinSecretsReferences = resource.JoinedEnvVar(envSecretsReferences, secretsReferencesSep)
+
 // NewMTAdapterDeployment is a wrapper around resource.NewDeployment which
 // pre-populates attributes common to all multi-tenant adapters backed by a
 // Deployment.
@@ -184,7 +191,7 @@ func NewAdapterKnService(rcl v1alpha1.Reconcilable, sinkURI *apis.URL, opts ...r
 	}
 
 	return resource.NewKnService(rclNs, kmeta.ChildName(ComponentName(rcl)+"-", rclName),
-		append(commonAdapterKnServiceOptions(rcl), append([]resource.ObjectOption{
+		append(commonAdapterKnServiceOptions(rcl), append(append([]resource.ObjectOption{
 			resource.Controller(rcl),
 
 			// Used to label Prometheus metrics with the component
@@ -196,7 +203,7 @@ func NewAdapterKnService(rcl v1alpha1.Reconcilable, sinkURI *apis.URL, opts ...r
 			resource.PodLabel(appInstanceLabel, rclName),
 
 			resource.EnvVar(envSink, sinkURIStr),
-		}, opts...)...)...,
+		}, opts...), joinSecretsReferences)...)...,
 	)
 }
 
@@ -347,8 +354,14 @@ var CommonObjectLabels = TMCommonObjectLabels
 
 // MaybeAppendValueFromEnvVar conditionally appends an EnvVar to env based on
 // the contents of valueFrom.
-// ValueFromSecret takes precedence over Value in case the API didn't reject
-// the object despite the CRD's schema validation
+// ValueFromSecret takes precedence over ValueFromProvider, ValueFromFile and
+// Value, in that order, in case the API didn't reject the object despite the
+// CRD's schema validation.
+//
+// Values read from a file or a secret provider are resolved by the adapter at
+// runtime, which receives a reference to them in a variable named after key
+// with a suffix, and the name of key in the list of variables to resolve (see
+// pkg/adapter/secrets).
 func MaybeAppendValueFromEnvVar(envs []corev1.EnvVar, key string, valueFrom v1alpha1.ValueFromField) []corev1.EnvVar {
 	if vfs := valueFrom.ValueFromSecret; vfs != nil {
 		return append(envs, corev1.EnvVar{
@@ -359,6 +372,23 @@ func MaybeAppendValueFromEnvVar(envs []corev1.EnvVar, key string, valueFrom v1al
 		})
 	}
 
+	if vfp := valueFrom.ValueFromProvider; vfp != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  key + envSuffixValueFromProvider,
+			Value: string(vfp.Provider) + ":" + vfp.Path + "#" + vfp.Key,
+		})
+		envs = appendSecretsReference(envs, key)
+		return appendSecretProviderEnvVars(envs, vfp.Provider)
+	}
+
+	if vff := valueFrom.ValueFromFile; vff != "" {
+		envs = append(envs, corev1.EnvVar{
+			Name:  key + envSuffixValueFromFile,
+			Value: vff,
+		})
+		return appendSecretsReference(envs, key)
+	}
+
 	if v := valueFrom.Value; v != "" {
 		return append(envs, corev1.EnvVar{
 			Name:  key,
@@ -369,6 +399,180 @@ func MaybeAppendValueFromEnvVar(envs []corev1.EnvVar, key string, valueFrom v1al
 	return envs
 }
 
+// appendSecretsReference adds the given variable to the list of variables
+// which the adapter resolves at runtime. Lists which end up in distinct
+// EnvVars are merged by the adapter builders.
+func appendSecretsReference(envs []corev1.EnvVar, key string) []corev1.EnvVar {
+	for i := range envs {
+		if envs[i].Name == envSecretsReferences {
+			envs[i].Value += secretsReferencesSep + key
+			return envs
+		}
+	}
+
+	return append(envs, corev1.EnvVar{
+		Name:  envSecretsReferences,
+		Value: key,
+	})
+}
+
+// secretProviderEnvVars lists, for each secret provider, the environment
+// variables which configure the provider in adapters. Their values are
+// propagated from the environment of the controller, when set.
+var secretProviderEnvVars = map[v1alpha1.SecretProvider][]string{
+	v1alpha1.SecretProviderVault: {
+		envVaultAddr,
+		envVaultNamespace,
+		envVaultAuthMount,
+		envVaultAuthRole,
+	},
+}
+
+// appendSecretProviderEnvVars appends to envs the variables which configure
+// the given secret provider and aren't already part of envs.
+func appendSecretProviderEnvVars(envs []corev1.EnvVar, p v1alpha1.SecretProvider) []corev1.EnvVar {
+	for _, name := range secretProviderEnvVars[p] {
+		val, ok := os.LookupEnv(name)
+		if !ok || hasEnvVar(envs, name) {
+			continue
+		}
+		envs = append(envs, corev1.EnvVar{
+			Name:  name,
+			Value: val,
+		})
+	}
+
+	return envs
+}
+
+// hasEnvVar returns whether envs contains a variable with the given name.
+func hasEnvVar(envs []corev1.EnvVar, name string) bool {
+	for _, e := range envs {
+		if e.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// MakeDeduplicationEnvVars returns the environment variables which enable the
+// deduplication of events in adapters.
+func MakeDeduplicationEnvVars(d *v1alpha1.Deduplication) []corev1.EnvVar {
+	store := "memory"
+	if d.Store != nil && d.Store.Redis != nil {
+		store = "redis"
+	}
+
+	env := []corev1.EnvVar{{
+		Name:  EnvDedupStore,
+		Value: store,
+	}}
+
+	if d.Key != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  EnvDedupKey,
+			Value: *d.Key,
+		})
+	}
+
+	if d.TTL != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  EnvDedupTTL,
+			Value: d.TTL.String(),
+		})
+	}
+
+	if d.Store == nil {
+		return env
+	}
+
+	if m := d.Store.Memory; m != nil && m.Size != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  EnvDedupMemorySize,
+			Value: strconv.Itoa(int(*m.Size)),
+		})
+	}
+
+	if r := d.Store.Redis; r != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  EnvDedupRedisAddress,
+			Value: r.Address,
+		})
+
+		if r.Password != nil {
+			env = MaybeAppendValueFromEnvVar(env, EnvDedupRedisPassword, *r.Password)
+		}
+
+		if r.Database != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  EnvDedupRedisDatabase,
+				Value: strconv.Itoa(int(*r.Database)),
+			})
+		}
+
+		if r.TLS != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  EnvDedupRedisTLS,
+				Value: strconv.FormatBool(*r.TLS),
+			})
+		}
+	}
+
+	return env
+}
+
+// MakeDispatchEnvVars returns the environment variables which enable the
+// partitioned dispatch of events in targets.
+func MakeDispatchEnvVars(d *v1alpha1.Dispatch) []corev1.EnvVar {
+	workers := int32(defaultDispatchWorkers)
+	if d.Workers != nil {
+		workers = *d.Workers
+	}
+
+	env := []corev1.EnvVar{{
+		Name:  EnvDispatchWorkers,
+		Value: strconv.Itoa(int(workers)),
+	}}
+
+	if d.QueueDepth != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  EnvDispatchQueueDepth,
+			Value: strconv.Itoa(int(*d.QueueDepth)),
+		})
+	}
+
+	env = append(env, MakeEventKeySourceEnvVars(d.OrderingKey,
+		EnvDispatchOrderingAttribute, EnvDispatchOrderingDataPath)...)
+
+	return env
+}
+
+// MakeEventKeySourceEnvVars returns the environment variables which configure
+// the location of a value inside events, given the names of the variables
+// which hold a context attribute and a data path respectively.
+func MakeEventKeySourceEnvVars(k *v1alpha1.EventKeySource, attrEnv, dataPathEnv string) []corev1.EnvVar {
+	if k == nil {
+		return nil
+	}
+
+	var env []corev1.EnvVar
+
+	if k.Attribute != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  attrEnv,
+			Value: *k.Attribute,
+		})
+	}
+	if k.DataPath != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  dataPathEnv,
+			Value: *k.DataPath,
+		})
+	}
+
+	return env
+}
+
 // adapterOverrideOptions applies adapter override parameters depending on
 // deployment type.
 func adapterOverrideOptions(overrides *v1alpha1.AdapterOverrides) []resource.ObjectOption {
@@ -405,6 +609,22 @@ func adapterOverrideOptions(overrides *v1alpha1.AdapterOverrides) []resource.Obj
 		opts = append(opts, resource.EnvVar(t.Name, t.Value))
 	}
 
+	if len(overrides.Volumes) > 0 {
+		opts = append(opts, resource.Volumes(overrides.Volumes...))
+	}
+
+	if len(overrides.VolumeMounts) > 0 {
+		opts = append(opts, resource.VolumeMounts(overrides.VolumeMounts...))
+	}
+
+	if overrides.Deduplication != nil {
+		opts = append(opts, resource.EnvVars(MakeDeduplicationEnvVars(overrides.Deduplication)...))
+	}
+
+	if overrides.Dispatch != nil {
+		opts = append(opts, resource.EnvVars(MakeDispatchEnvVars(overrides.Dispatch)...))
+	}
+
 	for k, v := range overrides.Labels {
 		opts = append(opts, resource.Label(k, v))
 		opts = append(opts, resource.PodLabel(k, v))
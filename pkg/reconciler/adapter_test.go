@@ -0,0 +1,102 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestMaybeAppendValueFromEnvVar(t *testing.T) {
+	t.Setenv(envVaultAddr, "https://vault:8200")
+	t.Setenv(envVaultAuthRole, "triggermesh")
+
+	secretRef := &corev1.SecretKeySelector{
+		LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+		Key:                  "token",
+	}
+	providerRef := &v1alpha1.ProviderSecretSelector{
+		Provider: v1alpha1.SecretProviderVault,
+		Path:     "secret/data/app",
+		Key:      "token",
+	}
+
+	testCases := map[string]struct {
+		envs      []corev1.EnvVar
+		valueFrom v1alpha1.ValueFromField
+		expect    []corev1.EnvVar
+	}{
+		"no value": {
+			expect: nil,
+		},
+		"literal value": {
+			valueFrom: v1alpha1.ValueFromField{Value: "xyz"},
+			expect:    []corev1.EnvVar{{Name: "TOKEN", Value: "xyz"}},
+		},
+		"from secret": {
+			valueFrom: v1alpha1.ValueFromField{ValueFromSecret: secretRef, ValueFromFile: "/ignored"},
+			expect:    []corev1.EnvVar{{Name: "TOKEN", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: secretRef}}},
+		},
+		"from file": {
+			valueFrom: v1alpha1.ValueFromField{ValueFromFile: "/mnt/secrets/token", Value: "ignored"},
+			expect: []corev1.EnvVar{
+				{Name: "TOKEN_FROM_FILE", Value: "/mnt/secrets/token"},
+				{Name: "SECRETS_REFERENCES", Value: "TOKEN"},
+			},
+		},
+		"from provider": {
+			valueFrom: v1alpha1.ValueFromField{ValueFromProvider: providerRef},
+			expect: []corev1.EnvVar{
+				{Name: "TOKEN_FROM_PROVIDER", Value: "vault:secret/data/app#token"},
+				{Name: "SECRETS_REFERENCES", Value: "TOKEN"},
+				{Name: "VAULT_ADDR", Value: "https://vault:8200"},
+				{Name: "VAULT_AUTH_ROLE", Value: "triggermesh"},
+			},
+		},
+		"from provider with existing provider config": {
+			envs:      []corev1.EnvVar{{Name: "VAULT_ADDR", Value: "https://other:8200"}},
+			valueFrom: v1alpha1.ValueFromField{ValueFromProvider: providerRef},
+			expect: []corev1.EnvVar{
+				{Name: "VAULT_ADDR", Value: "https://other:8200"},
+				{Name: "TOKEN_FROM_PROVIDER", Value: "vault:secret/data/app#token"},
+				{Name: "SECRETS_REFERENCES", Value: "TOKEN"},
+				{Name: "VAULT_AUTH_ROLE", Value: "triggermesh"},
+			},
+		},
+		"from file with other references": {
+			envs:      []corev1.EnvVar{{Name: "SECRETS_REFERENCES", Value: "PASSWORD"}},
+			valueFrom: v1alpha1.ValueFromField{ValueFromFile: "/mnt/secrets/token"},
+			expect: []corev1.EnvVar{
+				{Name: "SECRETS_REFERENCES", Value: "PASSWORD,TOKEN"},
+				{Name: "TOKEN_FROM_FILE", Value: "/mnt/secrets/token"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			envs := MaybeAppendValueFromEnvVar(tc.envs, "TOKEN", tc.valueFrom)
+			assert.Equal(t, tc.expect, envs)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+
+	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	autoscalingclientv2 "k8s.io/client-go/kubernetes/typed/autoscaling/v2"
+	autoscalinglistersv2 "k8s.io/client-go/listers/autoscaling/v2"
+	"k8s.io/client-go/tools/cache"
+
+	eventingmetrics "knative.dev/eventing/pkg/metrics"
+	k8sclient "knative.dev/pkg/client/injection/kube/client"
+	hpainformerv2 "knative.dev/pkg/client/injection/kube/informers/autoscaling/v2/horizontalpodautoscaler"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/ptr"
+	"knative.dev/pkg/reconciler"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/event"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/semantic"
+)
+
+// Default autoscaling parameters.
+const (
+	defaultAutoscalingMinReplicas = 1
+	defaultAutoscalingTargetLag   = 100
+)
+
+// GenericAutoscalerReconciler reconciles the HorizontalPodAutoscaler of
+// component adapters backed by a Deployment.
+type GenericAutoscalerReconciler struct {
+	// API clients
+	Client func(namespace string) autoscalingclientv2.HorizontalPodAutoscalerInterface
+	// objects listers
+	Lister func(namespace string) autoscalinglistersv2.HorizontalPodAutoscalerNamespaceLister
+}
+
+// NewGenericAutoscalerReconciler creates a new GenericAutoscalerReconciler
+// and attaches an event handler to its HorizontalPodAutoscaler informer, so
+// that the owner of an autoscaler is reconciled when this autoscaler is
+// modified or deleted.
+func NewGenericAutoscalerReconciler(ctx context.Context, gvk schema.GroupVersionKind,
+	ownerHandlerFn func(obj interface{})) *GenericAutoscalerReconciler {
+
+	hpaInformer := hpainformerv2.Get(ctx)
+
+	hpaInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGVK(gvk),
+		Handler:    controller.HandleAll(ownerHandlerFn),
+	})
+
+	return &GenericAutoscalerReconciler{
+		Client: k8sclient.Get(ctx).AutoscalingV2().HorizontalPodAutoscalers,
+		Lister: hpaInformer.Lister().HorizontalPodAutoscalers,
+	}
+}
+
+// ReconcileAutoscaler reconciles the HorizontalPodAutoscaler of the given
+// adapter Deployment, according to the autoscaling policy of the component
+// instance.
+func (r *GenericAutoscalerReconciler) ReconcileAutoscaler(ctx context.Context, adapter *appsv1.Deployment) error {
+	rcl := v1alpha1.ReconcilableFromContext(ctx)
+
+	current, err := r.Lister(adapter.Namespace).Get(adapter.Name)
+	switch {
+	case apierrors.IsNotFound(err):
+		current = nil
+	case err != nil:
+		return fmt.Errorf("getting HorizontalPodAutoscaler from cache: %w", err)
+	}
+
+	policy := autoscalingPolicy(rcl)
+	if policy == nil {
+		if current == nil || !metav1.IsControlledBy(current, rcl) {
+			return nil
+		}
+
+		if err := r.Client(current.Namespace).Delete(ctx, current.Name, metav1.DeleteOptions{}); err != nil {
+			return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedAutoscalerDelete,
+				"Failed to delete HorizontalPodAutoscaler %q: %s", current.Name, err)
+		}
+		event.Normal(ctx, ReasonAutoscalerDelete, "Deleted HorizontalPodAutoscaler %q", current.Name)
+		return nil
+	}
+
+	desired := MakeAdapterAutoscaler(rcl, adapter, policy)
+
+	if current == nil {
+		if _, err = r.Client(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedAutoscalerCreate,
+				"Failed to create HorizontalPodAutoscaler %q: %s", desired.Name, err)
+		}
+		event.Normal(ctx, ReasonAutoscalerCreate, "Created HorizontalPodAutoscaler %q", desired.Name)
+		return nil
+	}
+
+	if !metav1.IsControlledBy(current, rcl) {
+		return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedAutoscalerUpdate,
+			"HorizontalPodAutoscaler %q is not owned by this %s", current.Name, rcl.GetGroupVersionKind().Kind)
+	}
+
+	if semantic.Semantic.DeepEqual(desired, current) {
+		return nil
+	}
+
+	// resourceVersion must be returned to the API server unmodified for
+	// optimistic concurrency, as per Kubernetes API conventions
+	desired.ResourceVersion = current.ResourceVersion
+
+	if _, err = r.Client(desired.Namespace).Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+		return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedAutoscalerUpdate,
+			"Failed to update HorizontalPodAutoscaler %q: %s", desired.Name, err)
+	}
+	event.Normal(ctx, ReasonAutoscalerUpdate, "Updated HorizontalPodAutoscaler %q", desired.Name)
+
+	return nil
+}
+
+// autoscalingPolicy returns the autoscaling policy of the given component
+// instance, if any.
+func autoscalingPolicy(rcl v1alpha1.Reconcilable) *v1alpha1.Autoscaling {
+	// The adapter of a multi-tenant component is shared by all instances
+	// of that component in the namespace, so it can't be scaled based on
+	// the lag of a single instance.
+	if v1alpha1.IsMultiTenant(rcl) {
+		return nil
+	}
+
+	cfbl, canConfigureAdapter := rcl.(v1alpha1.AdapterConfigurable)
+	if !canConfigureAdapter {
+		return nil
+	}
+
+	if overrides := cfbl.GetAdapterOverrides(); overrides != nil {
+		return overrides.Autoscaling
+	}
+	return nil
+}
+
+// ConsumerLagMetricName returns the name of the metric which conveys the
+// consumer lag of the given component's adapter.
+func ConsumerLagMetricName(rcl v1alpha1.Reconcilable) string {
+	return ComponentName(rcl) + "_" + metrics.MetricNameConsumerLag
+}
+
+// MakeAdapterAutoscaler returns the desired state of the
+// HorizontalPodAutoscaler of the given adapter Deployment. The adapter is
+// scaled based on the consumer lag reported by the component instance.
+func MakeAdapterAutoscaler(rcl v1alpha1.Reconcilable, adapter *appsv1.Deployment,
+	policy *v1alpha1.Autoscaling) *autoscalingv2.HorizontalPodAutoscaler {
+
+	minReplicas := int32(defaultAutoscalingMinReplicas)
+	if policy.MinReplicas != nil {
+		minReplicas = *policy.MinReplicas
+	}
+
+	targetLag := int64(defaultAutoscalingTargetLag)
+	if policy.TargetLag != nil {
+		targetLag = *policy.TargetLag
+	}
+
+	hpa := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       adapter.Namespace,
+			Name:            adapter.Name,
+			Labels:          CommonObjectLabels(rcl),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(rcl)},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: appsv1.SchemeGroupVersion.String(),
+				Kind:       "Deployment",
+				Name:       adapter.Name,
+			},
+			MinReplicas: ptr.Int32(minReplicas),
+			MaxReplicas: policy.MaxReplicas,
+			Metrics: []autoscalingv2.MetricSpec{{
+				Type: autoscalingv2.ExternalMetricSourceType,
+				External: &autoscalingv2.ExternalMetricSource{
+					Metric: autoscalingv2.MetricIdentifier{
+						Name: ConsumerLagMetricName(rcl),
+						Selector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								eventingmetrics.LabelNamespaceName: rcl.GetNamespace(),
+								eventingmetrics.LabelName:          rcl.GetName(),
+							},
+						},
+					},
+					Target: autoscalingv2.MetricTarget{
+						Type:         autoscalingv2.AverageValueMetricType,
+						AverageValue: resource.NewQuantity(targetLag, resource.DecimalSI),
+					},
+				},
+			}},
+		},
+	}
+	hpa.Labels[appInstanceLabel] = rcl.GetName()
+	hpa.Labels[appComponentLabel] = componentAutoscaler
+
+	return hpa
+}
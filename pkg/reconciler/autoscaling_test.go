@@ -0,0 +1,140 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakek8s "k8s.io/client-go/kubernetes/fake"
+	autoscalinglistersv2 "k8s.io/client-go/listers/autoscaling/v2"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestMakeAdapterAutoscaler(t *testing.T) {
+	src := newAutoscaledSource(&v1alpha1.Autoscaling{MaxReplicas: 5})
+	adapter := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "awssqssource-test"}}
+
+	hpa := MakeAdapterAutoscaler(src, adapter, src.Spec.AdapterOverrides.Autoscaling)
+
+	assert.Equal(t, "awssqssource-test", hpa.Name)
+	assert.Equal(t, "Deployment", hpa.Spec.ScaleTargetRef.Kind)
+	assert.Equal(t, "awssqssource-test", hpa.Spec.ScaleTargetRef.Name)
+	assert.True(t, metav1.IsControlledBy(hpa, src))
+
+	assert.EqualValues(t, defaultAutoscalingMinReplicas, *hpa.Spec.MinReplicas)
+	assert.EqualValues(t, 5, hpa.Spec.MaxReplicas)
+
+	require.Len(t, hpa.Spec.Metrics, 1)
+	ext := hpa.Spec.Metrics[0].External
+	require.NotNil(t, ext)
+	assert.Equal(t, "awssqssource_consumer_lag", ext.Metric.Name)
+	assert.Equal(t, map[string]string{"namespace_name": "test", "name": "test"}, ext.Metric.Selector.MatchLabels)
+	assert.EqualValues(t, defaultAutoscalingTargetLag, ext.Target.AverageValue.Value())
+}
+
+func TestReconcileAutoscaler(t *testing.T) {
+	adapter := &appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "awssqssource-test"}}
+
+	policy := &v1alpha1.Autoscaling{MaxReplicas: 5}
+	otherPolicy := &v1alpha1.Autoscaling{MaxReplicas: 10, TargetLag: ptr.Int64(20)}
+
+	testCases := map[string]struct {
+		policy    *v1alpha1.Autoscaling
+		current   *v1alpha1.Autoscaling // nil if no HPA exists
+		expectHPA *v1alpha1.Autoscaling // nil if the HPA should not exist
+	}{
+		"create when requested": {
+			policy:    policy,
+			expectHPA: policy,
+		},
+		"update when changed": {
+			policy:    otherPolicy,
+			current:   policy,
+			expectHPA: otherPolicy,
+		},
+		"delete when no longer requested": {
+			current: policy,
+		},
+		"nothing to do": {},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := newAutoscaledSource(tc.policy)
+
+			var objs []runtime.Object
+			idx := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if tc.current != nil {
+				hpa := MakeAdapterAutoscaler(src, adapter, tc.current)
+				hpa.ResourceVersion = "1"
+				objs = append(objs, hpa)
+				require.NoError(t, idx.Add(hpa))
+			}
+
+			cli := fakek8s.NewSimpleClientset(objs...)
+
+			r := &GenericAutoscalerReconciler{
+				Client: cli.AutoscalingV2().HorizontalPodAutoscalers,
+				Lister: autoscalinglistersv2.NewHorizontalPodAutoscalerLister(idx).HorizontalPodAutoscalers,
+			}
+
+			ctx := v1alpha1.WithReconcilable(context.Background(), src)
+			require.NoError(t, r.ReconcileAutoscaler(ctx, adapter))
+
+			hpa, err := cli.AutoscalingV2().HorizontalPodAutoscalers(adapter.Namespace).Get(ctx, adapter.Name, metav1.GetOptions{})
+			if tc.expectHPA == nil {
+				assert.True(t, apierrors.IsNotFound(err), "Expected the HorizontalPodAutoscaler to be absent")
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, MakeAdapterAutoscaler(src, adapter, tc.expectHPA).Spec, hpa.Spec)
+		})
+	}
+}
+
+// newAutoscaledSource returns a component instance with the given autoscaling policy.
+func newAutoscaledSource(policy *v1alpha1.Autoscaling) *sourcesv1alpha1.AWSSQSSource {
+	src := &sourcesv1alpha1.AWSSQSSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "test",
+		},
+	}
+
+	if policy != nil {
+		src.Spec.AdapterOverrides = &v1alpha1.AdapterOverrides{
+			Autoscaling: policy,
+		}
+	}
+
+	return src
+}
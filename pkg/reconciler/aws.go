@@ -0,0 +1,62 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"sort"
+	"strconv"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// MakeAWSEndpointEnvVars returns environment variables for the given AWS
+// endpoint parameters. The URL of the endpoint applies to the given primary
+// AWS services of the component only, designated by their endpoint ID.
+func MakeAWSEndpointEnvVars(endpoint *v1alpha1.AWSEndpoint, primaryServices ...string) []corev1.EnvVar {
+	if endpoint == nil {
+		return nil
+	}
+
+	var endpointEnvVars []corev1.EnvVar
+
+	urls := endpoint.URLsByService(primaryServices...)
+
+	services := make([]string, 0, len(urls))
+	for svc := range urls {
+		services = append(services, svc)
+	}
+	sort.Strings(services)
+
+	for _, svc := range services {
+		endpointEnvVars = append(endpointEnvVars, corev1.EnvVar{
+			Name:  awsendpoint.EnvServiceEndpointURL(svc),
+			Value: urls[svc],
+		})
+	}
+
+	if endpoint.S3ForcePathStyle {
+		endpointEnvVars = append(endpointEnvVars, corev1.EnvVar{
+			Name:  EnvS3ForcePathStyle,
+			Value: strconv.FormatBool(true),
+		})
+	}
+
+	return endpointEnvVars
+}
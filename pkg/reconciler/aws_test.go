@@ -0,0 +1,74 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+func TestMakeAWSEndpointEnvVars(t *testing.T) {
+	testCases := map[string]struct {
+		endpoint *v1alpha1.AWSEndpoint
+		expect   []corev1.EnvVar
+	}{
+		"no endpoint": {
+			endpoint: nil,
+			expect:   nil,
+		},
+		"primary services": {
+			endpoint: &v1alpha1.AWSEndpoint{
+				URL:              &apis.URL{Scheme: "http", Host: "localstack:4566"},
+				S3ForcePathStyle: true,
+			},
+			expect: []corev1.EnvVar{
+				{Name: "AWS_ENDPOINT_URL_DYNAMODB", Value: "http://localstack:4566"},
+				{Name: "AWS_ENDPOINT_URL_STREAMS_DYNAMODB", Value: "http://localstack:4566"},
+				{Name: EnvS3ForcePathStyle, Value: "true"},
+			},
+		},
+		"other services": {
+			endpoint: &v1alpha1.AWSEndpoint{
+				URL: &apis.URL{Scheme: "http", Host: "localstack:4566"},
+				ServiceURLs: map[string]apis.URL{
+					"streams.dynamodb": {Scheme: "http", Host: "streams:8000"},
+					"sts":              {Scheme: "http", Host: "sts:8000"},
+				},
+			},
+			expect: []corev1.EnvVar{
+				{Name: "AWS_ENDPOINT_URL_DYNAMODB", Value: "http://localstack:4566"},
+				{Name: "AWS_ENDPOINT_URL_STREAMS_DYNAMODB", Value: "http://streams:8000"},
+				{Name: "AWS_ENDPOINT_URL_STS", Value: "http://sts:8000"},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			envs := MakeAWSEndpointEnvVars(tc.endpoint, "dynamodb", "streams.dynamodb")
+			assert.Equal(t, tc.expect, envs)
+		})
+	}
+}
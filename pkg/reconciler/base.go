@@ -62,6 +62,14 @@ type GenericDeploymentReconciler[T kmeta.OwnerRefable, L Lister[T]] struct {
 	PodLister func(namespace string) corelistersv1.PodNamespaceLister
 
 	*GenericRBACReconciler[T, L]
+	// EventTypeCatalog reconciler
+	CatalogReconciler *GenericCatalogReconciler
+	// HorizontalPodAutoscaler reconciler
+	AutoscalerReconciler *GenericAutoscalerReconciler
+	// Hash of referenced Secrets and ConfigMaps
+	ConfigHashReconciler *GenericConfigHashReconciler
+	// Runtime status reported by adapters
+	HealthReconciler *GenericHealthReconciler
 }
 
 // GenericServiceReconciler contains interfaces shared across Service reconcilers.
@@ -74,6 +82,12 @@ type GenericServiceReconciler[T kmeta.OwnerRefable, L Lister[T]] struct {
 	Lister func(namespace string) servinglistersv1.ServiceNamespaceLister
 
 	*GenericRBACReconciler[T, L]
+	// EventTypeCatalog reconciler
+	CatalogReconciler *GenericCatalogReconciler
+	// Hash of referenced Secrets and ConfigMaps
+	ConfigHashReconciler *GenericConfigHashReconciler
+	// Runtime status reported by adapters
+	HealthReconciler *GenericHealthReconciler
 }
 
 // GenericRBACReconciler reconciles RBAC objects for components adapters.
@@ -136,6 +150,7 @@ type k8sListerGetter[T metav1.Object, L k8sLister[T]] func(namespace string) L
 type k8sClient[T metav1.Object] interface {
 	Create(context.Context, T, metav1.CreateOptions) (T, error)
 	Update(context.Context, T, metav1.UpdateOptions) (T, error)
+	Delete(context.Context, string, metav1.DeleteOptions) error
 }
 
 // k8sClientGetter obtains a namespaced k8sClient.
@@ -152,12 +167,18 @@ func NewGenericDeploymentReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx conte
 	deplInformer := deploymentinformerv1.Get(ctx)
 	podInformer := podinformerv1.Get(ctx)
 
+	var outermostCtlrType T
+
 	r := GenericDeploymentReconciler[T, L]{
 		SinkResolver:          resolver.NewURIResolverFromTracker(ctx, tracker),
 		Client:                k8sclient.Get(ctx).AppsV1().Deployments,
 		Lister:                deplInformer.Lister().Deployments,
 		PodLister:             podInformer.Lister().Pods,
 		GenericRBACReconciler: NewGenericRBACReconciler(ctx, ownersLister),
+		CatalogReconciler:     NewGenericCatalogReconciler(ctx, gvk, adapterHandlerFn),
+		AutoscalerReconciler:  NewGenericAutoscalerReconciler(ctx, gvk, adapterHandlerFn),
+		ConfigHashReconciler:  NewGenericConfigHashReconciler(ctx, tracker),
+		HealthReconciler:      NewGenericHealthReconciler(ctx, outermostCtlrType, adapterHandlerFn),
 	}
 
 	deplInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
@@ -165,8 +186,6 @@ func NewGenericDeploymentReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx conte
 		Handler:    controller.HandleAll(adapterHandlerFn),
 	})
 
-	var outermostCtlrType T
-
 	podinformerv1.Get(ctx).Informer().AddEventHandler(cache.FilteringResourceEventHandler{
 		FilterFunc: adapterPodWithAncestorOfKind(ctx, outermostCtlrType),
 		Handler: controller.HandleAll(
@@ -190,7 +209,7 @@ func NewGenericServiceReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx context.
 		Handler:    controller.HandleAll(adapterHandlerFn),
 	})
 
-	return newGenericServiceReconciler(ctx, tracker, ownersLister)
+	return newGenericServiceReconciler(ctx, gvk, tracker, adapterHandlerFn, ownersLister)
 }
 
 // NewMTGenericServiceReconciler creates a new GenericServiceReconciler for a
@@ -207,7 +226,7 @@ func NewMTGenericServiceReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx contex
 		Handler:    controller.HandleAll(adapterHandlerFn),
 	})
 
-	return newGenericServiceReconciler(ctx, tracker, ownersLister)
+	return newGenericServiceReconciler(ctx, typ.GetGroupVersionKind(), tracker, adapterHandlerFn, ownersLister)
 }
 
 // NewGenericRBACReconciler creates a new GenericRBACReconciler.
@@ -225,16 +244,22 @@ func NewGenericRBACReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx context.Con
 }
 
 // newGenericServiceReconciler creates a new GenericServiceReconciler.
-func newGenericServiceReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx context.Context,
+func newGenericServiceReconciler[T kmeta.OwnerRefable, L Lister[T]](ctx context.Context, gvk schema.GroupVersionKind,
 	tracker tracker.Interface,
+	ownersHandlerFn func(obj interface{}),
 	ownersLister ListerGetter[T, L],
 ) GenericServiceReconciler[T, L] {
 
+	var ownerType T
+
 	return GenericServiceReconciler[T, L]{
 		SinkResolver:          resolver.NewURIResolverFromTracker(ctx, tracker),
 		Client:                servingclient.Get(ctx).ServingV1().Services,
 		Lister:                serviceinformerv1.Get(ctx).Lister().Services,
 		GenericRBACReconciler: NewGenericRBACReconciler(ctx, ownersLister),
+		CatalogReconciler:     NewGenericCatalogReconciler(ctx, gvk, ownersHandlerFn),
+		ConfigHashReconciler:  NewGenericConfigHashReconciler(ctx, tracker),
+		HealthReconciler:      NewGenericHealthReconciler(ctx, ownerType, ownersHandlerFn),
 	}
 
 }
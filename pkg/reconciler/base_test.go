@@ -21,7 +21,9 @@ import (
 	"testing"
 	"time"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -36,6 +38,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
+	"knative.dev/pkg/apis"
 	fakek8sclient "knative.dev/pkg/client/injection/kube/client/fake"
 	"knative.dev/pkg/controller"
 	"knative.dev/pkg/injection"
@@ -93,6 +96,107 @@ func TestAdapterOverrideOptions(t *testing.T) {
 	})
 }
 
+func TestMakeDeduplicationEnvVars(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		env := MakeDeduplicationEnvVars(&v1alpha1.Deduplication{})
+		assert.Equal(t, []corev1.EnvVar{{Name: EnvDedupStore, Value: "memory"}}, env)
+	})
+
+	t.Run("redis store", func(t *testing.T) {
+		env := MakeDeduplicationEnvVars(&v1alpha1.Deduplication{
+			Key: ptr.String("id"),
+			Store: &v1alpha1.DeduplicationStore{
+				Redis: &v1alpha1.DeduplicationRedisStore{
+					Address: "redis:6379",
+					Password: &v1alpha1.ValueFromField{
+						ValueFromSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "redis"},
+							Key:                  "password",
+						},
+					},
+					Database: ptr.Int32(2),
+				},
+			},
+		})
+
+		expect := []corev1.EnvVar{
+			{Name: EnvDedupStore, Value: "redis"},
+			{Name: EnvDedupKey, Value: "id"},
+			{Name: EnvDedupRedisAddress, Value: "redis:6379"},
+			{Name: EnvDedupRedisPassword, ValueFrom: &corev1.EnvVarSource{
+				SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "redis"},
+					Key:                  "password",
+				},
+			}},
+			{Name: EnvDedupRedisDatabase, Value: "2"},
+		}
+		assert.Equal(t, expect, env)
+	})
+}
+
+func TestMakeDispatchEnvVars(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		env := MakeDispatchEnvVars(&v1alpha1.Dispatch{})
+		assert.Equal(t, []corev1.EnvVar{{Name: EnvDispatchWorkers, Value: "10"}}, env)
+	})
+
+	t.Run("ordering key", func(t *testing.T) {
+		env := MakeDispatchEnvVars(&v1alpha1.Dispatch{
+			Workers:    ptr.Int32(4),
+			QueueDepth: ptr.Int32(50),
+			OrderingKey: &v1alpha1.EventKeySource{
+				DataPath: ptr.String("order.id"),
+			},
+		})
+
+		expect := []corev1.EnvVar{
+			{Name: EnvDispatchWorkers, Value: "4"},
+			{Name: EnvDispatchQueueDepth, Value: "50"},
+			{Name: EnvDispatchOrderingDataPath, Value: "order.id"},
+		}
+		assert.Equal(t, expect, env)
+	})
+}
+
+func TestMakeEventTypeCatalog(t *testing.T) {
+	trg := &targetsv1alpha1.HTTPTarget{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "test",
+		},
+	}
+
+	crdAnnotations := map[string]string{
+		acceptedEventTypesAnnotation: `[
+			{ "type": "io.triggermesh.http.request", "schema": "https://example.com/schemas/request.json" },
+			{ "type": "*" }
+		]`,
+		producedEventTypesAnnotation: `not json`,
+	}
+
+	cat := MakeEventTypeCatalog(trg, crdAnnotations)
+
+	assert.Equal(t, "httptarget-test", cat.Name)
+	assert.Equal(t, "test", cat.Namespace)
+	assert.Equal(t, "HTTPTarget", cat.Spec.Component.Kind)
+
+	schema, err := apis.ParseURL("https://example.com/schemas/request.json")
+	require.NoError(t, err)
+
+	expectAccepts := []catalogv1alpha1.EventType{
+		{Type: "io.triggermesh.http.request", Schema: schema},
+		{Type: "*"},
+	}
+	assert.Equal(t, expectAccepts, cat.Status.Accepts)
+
+	require.Len(t, cat.Status.Produces, len(trg.GetEventTypes()))
+	for _, typ := range cat.Status.Produces {
+		assert.Equal(t, trg.AsEventSource(), typ.Source)
+		assert.Nil(t, typ.Schema, "Malformed annotations should be ignored")
+	}
+}
+
 func TestHasAdapterLabelsForType(t *testing.T) {
 	typ := &fakeObject{}
 	filterFn := hasAdapterLabelsForType(&fakeObject{})
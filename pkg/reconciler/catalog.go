@@ -0,0 +1,221 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionslistersv1 "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/apis"
+	duckv1 "knative.dev/pkg/apis/duck/v1"
+	crdinformerv1 "knative.dev/pkg/client/injection/apiextensions/informers/apiextensions/v1/customresourcedefinition"
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/reconciler"
+
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	catalogclientv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/typed/catalog/v1alpha1"
+	tmclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client"
+	catalogtypeinformerv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/injection/informers/catalog/v1alpha1/eventtypecatalog"
+	cataloglistersv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/catalog/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/event"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/semantic"
+)
+
+// Annotations of component CRDs which declare the event types produced and
+// accepted by components, along with the reference to their schema.
+const (
+	producedEventTypesAnnotation = "registry.knative.dev/eventTypes"
+	acceptedEventTypesAnnotation = "registry.triggermesh.io/acceptedEventTypes"
+)
+
+// GenericCatalogReconciler reconciles the EventTypeCatalog of component
+// instances.
+type GenericCatalogReconciler struct {
+	// API clients
+	Client func(namespace string) catalogclientv1alpha1.EventTypeCatalogInterface
+	// objects listers
+	Lister    func(namespace string) cataloglistersv1alpha1.EventTypeCatalogNamespaceLister
+	CRDLister apiextensionslistersv1.CustomResourceDefinitionLister
+}
+
+// NewGenericCatalogReconciler creates a new GenericCatalogReconciler and
+// attaches an event handler to its EventTypeCatalog informer, so that the
+// owner of a catalog is reconciled when this catalog is modified or deleted.
+func NewGenericCatalogReconciler(ctx context.Context, gvk schema.GroupVersionKind,
+	ownerHandlerFn func(obj interface{})) *GenericCatalogReconciler {
+
+	catalogInformer := catalogtypeinformerv1alpha1.Get(ctx)
+
+	catalogInformer.Informer().AddEventHandler(cache.FilteringResourceEventHandler{
+		FilterFunc: controller.FilterControllerGVK(gvk),
+		Handler:    controller.HandleAll(ownerHandlerFn),
+	})
+
+	return &GenericCatalogReconciler{
+		Client:    tmclient.Get(ctx).CatalogV1alpha1().EventTypeCatalogs,
+		Lister:    catalogInformer.Lister().EventTypeCatalogs,
+		CRDLister: crdinformerv1.Get(ctx).Lister(),
+	}
+}
+
+// ReconcileCatalog reconciles the EventTypeCatalog of a component instance.
+func (r *GenericCatalogReconciler) ReconcileCatalog(ctx context.Context) error {
+	rcl := v1alpha1.ReconcilableFromContext(ctx)
+
+	crdAnnotations, err := r.componentCRDAnnotations(rcl)
+	if err != nil {
+		return err
+	}
+
+	desired := MakeEventTypeCatalog(rcl, crdAnnotations)
+
+	current, err := r.Lister(desired.Namespace).Get(desired.Name)
+	switch {
+	case apierrors.IsNotFound(err):
+		if _, err = r.Client(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedCatalogCreate,
+				"Failed to create EventTypeCatalog %q: %s", desired.Name, err)
+		}
+		event.Normal(ctx, ReasonCatalogCreate, "Created EventTypeCatalog %q", desired.Name)
+		rcl.GetStatusManager().SetEventTypeCatalog(desired.Name)
+		return nil
+
+	case err != nil:
+		return fmt.Errorf("getting EventTypeCatalog from cache: %w", err)
+	}
+
+	rcl.GetStatusManager().SetEventTypeCatalog(current.Name)
+
+	if semantic.Semantic.DeepEqual(desired, current) {
+		return nil
+	}
+
+	// resourceVersion must be returned to the API server unmodified for
+	// optimistic concurrency, as per Kubernetes API conventions
+	desired.ResourceVersion = current.ResourceVersion
+
+	if _, err = r.Client(desired.Namespace).Update(ctx, desired, metav1.UpdateOptions{}); err != nil {
+		return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedCatalogUpdate,
+			"Failed to update EventTypeCatalog %q: %s", desired.Name, err)
+	}
+	event.Normal(ctx, ReasonCatalogUpdate, "Updated EventTypeCatalog %q", desired.Name)
+
+	return nil
+}
+
+// EventTypeCatalogName returns the name of the EventTypeCatalog associated
+// with the given component instance.
+func EventTypeCatalogName(rcl v1alpha1.Reconcilable) string {
+	return kmeta.ChildName(ComponentName(rcl)+"-", rcl.GetName())
+}
+
+// MakeEventTypeCatalog returns the desired state of the EventTypeCatalog of the
+// given component instance. Schema references are read from the given
+// annotations of the component's CustomResourceDefinition.
+func MakeEventTypeCatalog(rcl v1alpha1.Reconcilable, crdAnnotations map[string]string) *catalogv1alpha1.EventTypeCatalog {
+	cat := &catalogv1alpha1.EventTypeCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       rcl.GetNamespace(),
+			Name:            EventTypeCatalogName(rcl),
+			Labels:          CommonObjectLabels(rcl),
+			OwnerReferences: []metav1.OwnerReference{*kmeta.NewControllerRef(rcl)},
+		},
+		Spec: catalogv1alpha1.EventTypeCatalogSpec{
+			Component: duckv1.KReference{
+				APIVersion: rcl.GetGroupVersionKind().GroupVersion().String(),
+				Kind:       rcl.GetGroupVersionKind().Kind,
+				Name:       rcl.GetName(),
+			},
+		},
+	}
+	cat.Labels[appInstanceLabel] = rcl.GetName()
+	cat.Labels[appComponentLabel] = componentCatalog
+
+	if src, isEventSource := rcl.(v1alpha1.EventSource); isEventSource {
+		schemas := parseSchemaAnnotation(crdAnnotations[producedEventTypesAnnotation])
+		for _, typ := range src.GetEventTypes() {
+			cat.Status.Produces = append(cat.Status.Produces, catalogv1alpha1.EventType{
+				Type:   typ,
+				Source: src.AsEventSource(),
+				Schema: schemas[typ],
+			})
+		}
+	}
+
+	if rcv, isEventReceiver := rcl.(v1alpha1.EventReceiver); isEventReceiver {
+		schemas := parseSchemaAnnotation(crdAnnotations[acceptedEventTypesAnnotation])
+		for _, typ := range rcv.AcceptedEventTypes() {
+			cat.Status.Accepts = append(cat.Status.Accepts, catalogv1alpha1.EventType{
+				Type:   typ,
+				Schema: schemas[typ],
+			})
+		}
+	}
+
+	return cat
+}
+
+// componentCRDAnnotations returns the annotations of the
+// CustomResourceDefinition of the given component instance's kind.
+func (r *GenericCatalogReconciler) componentCRDAnnotations(rcl v1alpha1.Reconcilable) (map[string]string, error) {
+	gvk := rcl.GetGroupVersionKind()
+	crdName := apis.KindToResource(gvk).GroupResource().String()
+
+	crd, err := r.CRDLister.Get(crdName)
+	switch {
+	case apierrors.IsNotFound(err):
+		// schema references are informational and can be omitted
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting CustomResourceDefinition %q from cache: %w", crdName, err)
+	}
+
+	return crd.Annotations, nil
+}
+
+// parseSchemaAnnotation parses the JSON value of an annotation which declares
+// event types and returns the schema reference of each of them, by type.
+// Malformed values are ignored.
+func parseSchemaAnnotation(val string) map[string]*apis.URL {
+	var declaredTypes []struct {
+		Type   string `json:"type"`
+		Schema string `json:"schema"`
+	}
+	_ = json.Unmarshal([]byte(val), &declaredTypes)
+
+	schemas := make(map[string]*apis.URL, len(declaredTypes))
+	for _, t := range declaredTypes {
+		if t.Schema == "" {
+			continue
+		}
+		if u, err := apis.ParseURL(t.Schema); err == nil {
+			schemas[t.Type] = u
+		}
+	}
+
+	return schemas
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/metadata/metadatalister"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/tracker"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	cmmetainformer "github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata/configmap"
+	secretmetainformer "github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata/secret"
+)
+
+// GenericConfigHashReconciler computes a hash of the versions of the Secrets
+// and ConfigMaps referenced by the environment of component adapters, so that
+// changes to those objects trigger a rollout of the adapters.
+//
+// Only the metadata of those objects is cached and hashed. Neither their
+// contents nor any value derived from them ever leave the API server.
+type GenericConfigHashReconciler struct {
+	// Tracker of referenced objects
+	Tracker tracker.Interface
+	// objects metadata listers
+	SecretLister    metadatalister.Lister
+	ConfigMapLister metadatalister.Lister
+}
+
+// NewGenericConfigHashReconciler creates a new GenericConfigHashReconciler and
+// notifies the given tracker about changes to Secrets and ConfigMaps.
+func NewGenericConfigHashReconciler(ctx context.Context, tracker tracker.Interface) *GenericConfigHashReconciler {
+	secretInformer := secretmetainformer.Get(ctx)
+	cmInformer := cmmetainformer.Get(ctx)
+
+	secretInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("Secret")),
+	))
+	cmInformer.Informer().AddEventHandler(controller.HandleAll(
+		controller.EnsureTypeMeta(tracker.OnChanged, corev1.SchemeGroupVersion.WithKind("ConfigMap")),
+	))
+
+	return &GenericConfigHashReconciler{
+		Tracker:         tracker,
+		SecretLister:    metadatalister.New(secretInformer.Informer().GetIndexer(), secretmetainformer.Resource),
+		ConfigMapLister: metadatalister.New(cmInformer.Informer().GetIndexer(), cmmetainformer.Resource),
+	}
+}
+
+// ReconcileConfigHash sets an annotation containing a hash of the versions of
+// the Secrets and ConfigMaps referenced by the environment of the containers
+// of the given Pod template, and reports that hash in the status of the
+// component instance.
+//
+// The hash is computed from the UID and resourceVersion of each referenced
+// object, so any update to one of those objects changes it, regardless of the
+// keys which are actually referenced.
+//
+// Referenced objects are tracked, so that the component instance is enqueued
+// whenever one of them is created, updated or deleted. Objects which don't
+// exist yet are excluded from the hash, and no annotation is set when none of
+// the referenced objects exists.
+func (r *GenericConfigHashReconciler) ReconcileConfigHash(ctx context.Context,
+	podMeta *metav1.ObjectMeta, podSpec *corev1.PodSpec) error {
+
+	rcl := v1alpha1.ReconcilableFromContext(ctx)
+
+	h := sha256.New()
+	var hashedObjects int
+
+	for _, ref := range configReferences(podSpec) {
+		if err := r.Tracker.TrackReference(tracker.Reference{
+			APIVersion: "v1",
+			Kind:       ref.kind,
+			Namespace:  rcl.GetNamespace(),
+			Name:       ref.name,
+		}, rcl); err != nil {
+			return fmt.Errorf("tracking %s %q: %w", ref.kind, ref.name, err)
+		}
+
+		obj, err := r.referencedObject(rcl.GetNamespace(), ref)
+		if err != nil {
+			return err
+		}
+		if obj == nil {
+			continue
+		}
+
+		fmt.Fprintf(h, "%s/%s %s %s\n", ref.kind, ref.name, obj.UID, obj.ResourceVersion)
+		hashedObjects++
+	}
+
+	if hashedObjects == 0 {
+		rcl.GetStatusManager().SetConfigHash("")
+		return nil
+	}
+
+	hash := hex.EncodeToString(h.Sum(nil))
+
+	metav1.SetMetaDataAnnotation(podMeta, v1alpha1.AnnotationConfigHash, hash)
+	rcl.GetStatusManager().SetConfigHash(hash)
+
+	return nil
+}
+
+// referencedObject returns the metadata of the object designated by the given
+// configReference. A nil object is returned if the object doesn't exist.
+func (r *GenericConfigHashReconciler) referencedObject(namespace string,
+	ref configReference) (*metav1.PartialObjectMetadata, error) {
+
+	lister := r.SecretLister
+	if ref.kind == "ConfigMap" {
+		lister = r.ConfigMapLister
+	}
+
+	obj, err := lister.Namespace(namespace).Get(ref.name)
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("getting %s from cache: %w", ref.kind, err)
+	}
+
+	return obj, nil
+}
+
+// configReference designates a Secret or ConfigMap referenced by the
+// environment of a container.
+type configReference struct {
+	kind string
+	name string
+}
+
+// configReferences returns the Secrets and ConfigMaps referenced by the
+// environment of the containers of the given PodSpec, in a stable order.
+func configReferences(podSpec *corev1.PodSpec) []configReference {
+	seen := make(map[configReference]struct{})
+
+	containers := make([]corev1.Container, 0, len(podSpec.InitContainers)+len(podSpec.Containers))
+	containers = append(containers, podSpec.InitContainers...)
+	containers = append(containers, podSpec.Containers...)
+
+	for _, c := range containers {
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if ref := e.ValueFrom.SecretKeyRef; ref != nil && ref.Name != "" {
+				seen[configReference{kind: "Secret", name: ref.Name}] = struct{}{}
+			}
+			if ref := e.ValueFrom.ConfigMapKeyRef; ref != nil && ref.Name != "" {
+				seen[configReference{kind: "ConfigMap", name: ref.Name}] = struct{}{}
+			}
+		}
+
+		for _, e := range c.EnvFrom {
+			if ref := e.SecretRef; ref != nil && ref.Name != "" {
+				seen[configReference{kind: "Secret", name: ref.Name}] = struct{}{}
+			}
+			if ref := e.ConfigMapRef; ref != nil && ref.Name != "" {
+				seen[configReference{kind: "ConfigMap", name: ref.Name}] = struct{}{}
+			}
+		}
+	}
+
+	refs := make([]configReference, 0, len(seen))
+	for ref := range seen {
+		refs = append(refs, ref)
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		if refs[i].kind != refs[j].kind {
+			return refs[i].kind < refs[j].kind
+		}
+		return refs[i].name < refs[j].name
+	})
+
+	return refs
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/metadata/metadatalister"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/tracker"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestReconcileConfigHash(t *testing.T) {
+	podSpec := &corev1.PodSpec{
+		Containers: []corev1.Container{{
+			Env: []corev1.EnvVar{{
+				Name: "AWS_ACCESS_KEY_ID",
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{Name: "creds"},
+						Key:                  "keyId",
+					},
+				},
+			}},
+			EnvFrom: []corev1.EnvFromSource{{
+				ConfigMapRef: &corev1.ConfigMapEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "settings"},
+				},
+			}},
+		}},
+	}
+
+	newSecret := func(resourceVersion string) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "test",
+				Name:            "creds",
+				UID:             "00000000-0000-0000-0000-000000000001",
+				ResourceVersion: resourceVersion,
+			},
+		}
+	}
+	newConfigMap := func() *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "test",
+				Name:            "settings",
+				UID:             "00000000-0000-0000-0000-000000000002",
+				ResourceVersion: "1",
+			},
+		}
+	}
+
+	// reconcile returns the hash annotated on the Pod template, and the
+	// hash reported in the status of the component instance.
+	reconcile := func(t *testing.T, secret, cm *metav1.PartialObjectMetadata) (string, string) {
+		t.Helper()
+
+		newIndexer := func(obj *metav1.PartialObjectMetadata) cache.Indexer {
+			idx := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			if obj != nil {
+				require.NoError(t, idx.Add(obj))
+			}
+			return idx
+		}
+
+		var enqueued []types.NamespacedName
+
+		r := &GenericConfigHashReconciler{
+			Tracker: tracker.New(func(key types.NamespacedName) {
+				enqueued = append(enqueued, key)
+			}, 0),
+			SecretLister:    metadatalister.New(newIndexer(secret), corev1.SchemeGroupVersion.WithResource("secrets")),
+			ConfigMapLister: metadatalister.New(newIndexer(cm), corev1.SchemeGroupVersion.WithResource("configmaps")),
+		}
+
+		src := &sourcesv1alpha1.AWSSQSSource{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test"}}
+		ctx := v1alpha1.WithReconcilable(context.Background(), src)
+
+		podMeta := &metav1.ObjectMeta{}
+		require.NoError(t, r.ReconcileConfigHash(ctx, podMeta, podSpec))
+
+		r.Tracker.OnChanged(&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "creds"},
+		})
+		assert.Contains(t, enqueued, types.NamespacedName{Namespace: "test", Name: "test"},
+			"Expected referenced Secret to be tracked")
+
+		return podMeta.Annotations[v1alpha1.AnnotationConfigHash], src.Status.Annotations[v1alpha1.AnnotationConfigHash]
+	}
+
+	t.Run("no referenced object exists", func(t *testing.T) {
+		podHash, statusHash := reconcile(t, nil, nil)
+		assert.Empty(t, podHash)
+		assert.Empty(t, statusHash)
+	})
+
+	t.Run("referenced objects exist", func(t *testing.T) {
+		podHash, statusHash := reconcile(t, newSecret("1"), newConfigMap())
+		assert.NotEmpty(t, podHash)
+		assert.Equal(t, podHash, statusHash)
+
+		sameHash, _ := reconcile(t, newSecret("1"), newConfigMap())
+		assert.Equal(t, podHash, sameHash, "Expected the hash to be stable")
+
+		rotatedHash, _ := reconcile(t, newSecret("2"), newConfigMap())
+		assert.NotEqual(t, podHash, rotatedHash, "Expected the hash to change with the version of referenced objects")
+
+		partialHash, _ := reconcile(t, newSecret("1"), nil)
+		assert.NotEqual(t, podHash, partialHash, "Expected the hash to change with the referenced objects")
+	})
+}
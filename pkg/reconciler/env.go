@@ -30,13 +30,41 @@ const (
 	EnvCESource = "CE_SOURCE"
 	EnvCEType   = "CE_TYPE"
 
+	// Deduplication of events (see pkg/adapter/dedup)
+	EnvDedupKey           = "DEDUPLICATION_KEY"
+	EnvDedupTTL           = "DEDUPLICATION_TTL"
+	EnvDedupStore         = "DEDUPLICATION_STORE"
+	EnvDedupMemorySize    = "DEDUPLICATION_MEMORY_SIZE"
+	EnvDedupRedisAddress  = "DEDUPLICATION_REDIS_ADDRESS"
+	EnvDedupRedisPassword = "DEDUPLICATION_REDIS_PASSWORD"
+	EnvDedupRedisDatabase = "DEDUPLICATION_REDIS_DATABASE"
+	EnvDedupRedisTLS      = "DEDUPLICATION_REDIS_TLS"
+
+	// Values read from external sources at runtime (see pkg/adapter/secrets)
+	envSecretsReferences       = "SECRETS_REFERENCES"
+	secretsReferencesSep       = ","
+	envSuffixValueFromFile     = "_FROM_FILE"
+	envSuffixValueFromProvider = "_FROM_PROVIDER"
+
+	// HashiCorp Vault secret provider (see pkg/adapter/secrets)
+	envVaultAddr      = "VAULT_ADDR"
+	envVaultNamespace = "VAULT_NAMESPACE"
+	envVaultAuthMount = "VAULT_AUTH_MOUNT"
+	envVaultAuthRole  = "VAULT_AUTH_ROLE"
+
+	// Dispatch of events in targets (see pkg/targets/adapter/dispatcher)
+	EnvDispatchWorkers           = "DISPATCH_WORKERS"
+	EnvDispatchQueueDepth        = "DISPATCH_QUEUE_DEPTH"
+	EnvDispatchOrderingAttribute = "DISPATCH_ORDERING_ATTRIBUTE"
+	EnvDispatchOrderingDataPath  = "DISPATCH_ORDERING_DATA_PATH"
+
 	// Common AWS attributes
-	EnvARN             = "ARN"
-	EnvAccessKeyID     = "AWS_ACCESS_KEY_ID"
-	EnvSecretAccessKey = "AWS_SECRET_ACCESS_KEY" //nolint:gosec
-	EnvSessionToken    = "AWS_SESSION_TOKEN"
-	EnvEndpointURL     = "AWS_ENDPOINT_URL"
-	EnvAssumeIamRole   = "AWS_ASSUME_ROLE_ARN"
+	EnvARN              = "ARN"
+	EnvAccessKeyID      = "AWS_ACCESS_KEY_ID"
+	EnvSecretAccessKey  = "AWS_SECRET_ACCESS_KEY" //nolint:gosec
+	EnvSessionToken     = "AWS_SESSION_TOKEN"
+	EnvS3ForcePathStyle = "AWS_S3_FORCE_PATH_STYLE"
+	EnvAssumeIamRole    = "AWS_ASSUME_ROLE_ARN"
 
 	// Common Azure attributes
 	EnvAADTenantID     = "AZURE_TENANT_ID"
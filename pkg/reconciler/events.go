@@ -31,10 +31,36 @@ const (
 	ReasonAdapterCreate = "CreateAdapter"
 	// ReasonAdapterUpdate indicates that an adapter object was successfully updated.
 	ReasonAdapterUpdate = "UpdateAdapter"
+	// ReasonAdapterDelete indicates that an adapter object was successfully deleted.
+	ReasonAdapterDelete = "DeleteAdapter"
 	// ReasonFailedAdapterCreate indicates that the creation of an adapter object failed.
 	ReasonFailedAdapterCreate = "FailedAdapterCreate"
 	// ReasonFailedAdapterUpdate indicates that the update of an adapter object failed.
 	ReasonFailedAdapterUpdate = "FailedAdapterUpdate"
+	// ReasonFailedAdapterDelete indicates that the deletion of an adapter object failed.
+	ReasonFailedAdapterDelete = "FailedAdapterDelete"
+
+	// ReasonCatalogCreate indicates that an EventTypeCatalog was successfully created.
+	ReasonCatalogCreate = "CreateEventTypeCatalog"
+	// ReasonCatalogUpdate indicates that an EventTypeCatalog was successfully updated.
+	ReasonCatalogUpdate = "UpdateEventTypeCatalog"
+	// ReasonFailedCatalogCreate indicates that the creation of an EventTypeCatalog failed.
+	ReasonFailedCatalogCreate = "FailedEventTypeCatalogCreate"
+	// ReasonFailedCatalogUpdate indicates that the update of an EventTypeCatalog failed.
+	ReasonFailedCatalogUpdate = "FailedEventTypeCatalogUpdate"
+
+	// ReasonAutoscalerCreate indicates that an adapter autoscaler was successfully created.
+	ReasonAutoscalerCreate = "CreateAutoscaler"
+	// ReasonAutoscalerUpdate indicates that an adapter autoscaler was successfully updated.
+	ReasonAutoscalerUpdate = "UpdateAutoscaler"
+	// ReasonAutoscalerDelete indicates that an adapter autoscaler was successfully deleted.
+	ReasonAutoscalerDelete = "DeleteAutoscaler"
+	// ReasonFailedAutoscalerCreate indicates that the creation of an adapter autoscaler failed.
+	ReasonFailedAutoscalerCreate = "FailedAutoscalerCreate"
+	// ReasonFailedAutoscalerUpdate indicates that the update of an adapter autoscaler failed.
+	ReasonFailedAutoscalerUpdate = "FailedAutoscalerUpdate"
+	// ReasonFailedAutoscalerDelete indicates that the deletion of an adapter autoscaler failed.
+	ReasonFailedAutoscalerDelete = "FailedAutoscalerDelete"
 
 	// ReasonBadSinkURI indicates that the URI of a sink can't be determined.
 	ReasonBadSinkURI = "BadSinkURI"
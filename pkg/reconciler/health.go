@@ -0,0 +1,414 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	podinformerv1 "knative.dev/pkg/client/injection/kube/informers/core/v1/pod"
+	"knative.dev/pkg/kmeta"
+	"knative.dev/pkg/logging"
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+)
+
+// Parameters of the probing of the runtime status of adapters.
+const (
+	adapterHealthProbePeriod = 1 * time.Minute
+	adapterHealthTimeout     = 2 * time.Second
+	// maximum number of adapter Pods probed concurrently
+	adapterHealthProbeWorkers = 16
+)
+
+// AdapterHealthGetter obtains the runtime status reported by the adapter
+// running in the given Pod.
+type AdapterHealthGetter func(ctx context.Context, p *corev1.Pod) (*health.Status, error)
+
+// AdapterHealthStore gives access to the runtime status reported by the
+// adapters of component instances.
+type AdapterHealthStore interface {
+	// AdapterHealth returns the runtime status reported by the adapter of
+	// the component instance with the given key, or nil if the adapter
+	// doesn't report any. The returned boolean is false when the runtime
+	// status of adapters hasn't been probed yet.
+	AdapterHealth(key types.NamespacedName) (st *health.Status, probed bool)
+}
+
+// GenericHealthReconciler propagates the runtime status reported by the Pods
+// of component adapters to the status of components.
+//
+// Adapters are probed periodically in the background, outside of the
+// reconciliation of components, and component instances are enqueued only
+// when the status conditions derived from the runtime status of their adapter
+// change.
+type GenericHealthReconciler struct {
+	// Runtime status of adapters
+	HealthStore AdapterHealthStore
+}
+
+// NewGenericHealthReconciler creates a new GenericHealthReconciler and starts
+// probing the adapters of the given component type in the background until
+// ctx is cancelled.
+func NewGenericHealthReconciler(ctx context.Context, typ kmeta.OwnerRefable,
+	adapterHandlerFn func(obj interface{})) *GenericHealthReconciler {
+
+	podInformer := podinformerv1.Get(ctx)
+
+	p := &adapterHealthProber{
+		selector:  adapterPodsSelector(typ),
+		podLister: podInformer.Lister(),
+		getHealth: httpAdapterHealthGetter(&http.Client{Timeout: adapterHealthTimeout}),
+		enqueue:   componentEnqueuer(typ, adapterHandlerFn),
+	}
+
+	go func() {
+		if !cache.WaitForCacheSync(ctx.Done(), podInformer.Informer().HasSynced) {
+			return
+		}
+		wait.UntilWithContext(ctx, p.probe, adapterHealthProbePeriod)
+	}()
+
+	return &GenericHealthReconciler{
+		HealthStore: p,
+	}
+}
+
+// ReconcileHealth sets the UpstreamConnected and LastEventDelivered status
+// conditions of the component instance from the last runtime status reported
+// by its adapter.
+//
+// The conditions are cleared when the adapter doesn't report a runtime status,
+// e.g. when it doesn't support it, and left untouched until the adapters have
+// been probed for the first time.
+func (r *GenericHealthReconciler) ReconcileHealth(ctx context.Context) {
+	rcl := v1alpha1.ReconcilableFromContext(ctx)
+
+	// The Pods of multi-tenant adapters serve multiple component
+	// instances, and don't report the status of individual instances.
+	if v1alpha1.IsMultiTenant(rcl) {
+		healthConditions{}.propagate(rcl.GetStatusManager())
+		return
+	}
+
+	st, probed := r.HealthStore.AdapterHealth(types.NamespacedName{Namespace: rcl.GetNamespace(), Name: rcl.GetName()})
+	if !probed {
+		return
+	}
+
+	summarizeHealth(st).propagate(rcl.GetStatusManager())
+}
+
+// adapterHealthProber probes the runtime status of the adapters of a component
+// type, and records it by component instance.
+type adapterHealthProber struct {
+	// selects the Pods of all single-tenant adapters of a component type
+	selector  labels.Selector
+	podLister corelistersv1.PodLister
+	getHealth AdapterHealthGetter
+	// enqueues the component instance with the given key
+	enqueue func(types.NamespacedName)
+
+	mu       sync.RWMutex
+	probed   bool
+	statuses map[types.NamespacedName]*health.Status
+}
+
+var _ AdapterHealthStore = (*adapterHealthProber)(nil)
+
+// AdapterHealth implements AdapterHealthStore.
+func (p *adapterHealthProber) AdapterHealth(key types.NamespacedName) (*health.Status, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	return p.statuses[key], p.probed
+}
+
+// probe obtains the runtime status of all running adapter Pods, and enqueues
+// the component instances for which the resulting status conditions differ
+// from the ones derived from the previous probe.
+func (p *adapterHealthProber) probe(ctx context.Context) {
+	logger := logging.FromContext(ctx)
+
+	pods, err := p.podLister.List(p.selector)
+	if err != nil {
+		logger.Warnw("Unable to list adapter Pods", zap.Error(err))
+		return
+	}
+
+	var mu sync.Mutex
+	sts := make(map[types.NamespacedName][]*health.Status)
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, adapterHealthProbeWorkers)
+
+	for _, pod := range pods {
+		if pod.Status.Phase != corev1.PodRunning || pod.Status.PodIP == "" || pod.DeletionTimestamp != nil {
+			continue
+		}
+
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(pod *corev1.Pod) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			st, err := p.getHealth(ctx, pod)
+			if err != nil {
+				logger.Debugw("Unable to obtain runtime status of adapter Pod "+pod.Namespace+"/"+pod.Name, zap.Error(err))
+				return
+			}
+
+			key := types.NamespacedName{Namespace: pod.Namespace, Name: pod.Labels[appInstanceLabel]}
+
+			mu.Lock()
+			sts[key] = append(sts[key], st)
+			mu.Unlock()
+		}(pod)
+	}
+
+	wg.Wait()
+
+	statuses := make(map[types.NamespacedName]*health.Status, len(sts))
+	for key, st := range sts {
+		statuses[key] = aggregateHealth(st)
+	}
+
+	p.mu.Lock()
+	prevStatuses := p.statuses
+	p.statuses = statuses
+	p.probed = true
+	p.mu.Unlock()
+
+	for key := range changedHealth(prevStatuses, statuses) {
+		p.enqueue(key)
+	}
+}
+
+// changedHealth returns the keys of the runtime statuses from which different
+// status conditions are derived in prev and curr.
+func changedHealth(prev, curr map[types.NamespacedName]*health.Status) map[types.NamespacedName]struct{} {
+	changed := make(map[types.NamespacedName]struct{})
+
+	for key, st := range curr {
+		if summarizeHealth(st) != summarizeHealth(prev[key]) {
+			changed[key] = struct{}{}
+		}
+	}
+	for key, st := range prev {
+		if _, ok := curr[key]; !ok && summarizeHealth(st) != (healthConditions{}) {
+			changed[key] = struct{}{}
+		}
+	}
+
+	return changed
+}
+
+// aggregateHealth aggregates the runtime statuses reported by multiple
+// replicas of an adapter. It returns nil if the given list is empty.
+//
+// The adapter is considered connected only if all replicas which report their
+// connectivity are connected.
+func aggregateHealth(sts []*health.Status) *health.Status {
+	if len(sts) == 0 {
+		return nil
+	}
+
+	agg := &health.Status{}
+
+	for _, st := range sts {
+		if st.Connected != nil {
+			connected := *st.Connected && (agg.Connected == nil || *agg.Connected)
+			agg.Connected = &connected
+		}
+		if st.Connected != nil && !*st.Connected {
+			agg.LastError = latestError(agg.LastError, st.LastError)
+		}
+
+		agg.LastReceiveTime = latestTime(agg.LastReceiveTime, st.LastReceiveTime)
+		agg.LastSendTime = latestTime(agg.LastSendTime, st.LastSendTime)
+		agg.LastSendError = latestError(agg.LastSendError, st.LastSendError)
+	}
+
+	return agg
+}
+
+// healthConditions is the part of the runtime status of an adapter which is
+// reflected in the status conditions of a component instance. It deliberately
+// excludes the times reported by adapters, so that the status of components
+// isn't updated each time an adapter receives or sends an event.
+type healthConditions struct {
+	upstream      corev1.ConditionStatus // empty if not reported
+	upstreamError string
+	eventReceived bool
+
+	delivery      corev1.ConditionStatus // empty if not reported
+	deliveryError string
+}
+
+// summarizeHealth returns the status conditions derived from the given
+// runtime status.
+func summarizeHealth(st *health.Status) healthConditions {
+	var c healthConditions
+
+	if st == nil {
+		return c
+	}
+
+	switch {
+	case st.Connected == nil:
+	case *st.Connected:
+		c.upstream = corev1.ConditionTrue
+		c.eventReceived = st.LastReceiveTime != nil
+	default:
+		c.upstream = corev1.ConditionFalse
+		if st.LastError != nil {
+			c.upstreamError = st.LastError.Message
+		}
+	}
+
+	switch {
+	case st.LastSendError != nil && (st.LastSendTime == nil || st.LastSendError.Time.After(*st.LastSendTime)):
+		c.delivery = corev1.ConditionFalse
+		c.deliveryError = st.LastSendError.Message
+	case st.LastSendTime != nil:
+		c.delivery = corev1.ConditionTrue
+	}
+
+	return c
+}
+
+// propagate sets the status conditions of a component instance.
+func (c healthConditions) propagate(sm *v1alpha1.StatusManager) {
+	switch c.upstream {
+	case corev1.ConditionTrue:
+		sm.MarkUpstreamConnected(c.eventReceived)
+	case corev1.ConditionFalse:
+		sm.MarkUpstreamDisconnected(c.upstreamError)
+	default:
+		sm.ClearUpstreamConnected()
+	}
+
+	switch c.delivery {
+	case corev1.ConditionTrue:
+		sm.MarkLastEventDelivered()
+	case corev1.ConditionFalse:
+		sm.MarkLastEventNotDelivered(c.deliveryError)
+	default:
+		sm.ClearLastEventDelivered()
+	}
+}
+
+// latestTime returns the most recent of the given times.
+func latestTime(a, b *time.Time) *time.Time {
+	if a == nil || (b != nil && b.After(*a)) {
+		return b
+	}
+	return a
+}
+
+// latestError returns the most recent of the given errors.
+func latestError(a, b *health.Error) *health.Error {
+	if a == nil || (b != nil && b.Time.After(a.Time)) {
+		return b
+	}
+	return a
+}
+
+// adapterPodsSelector returns a label selector which matches the Pods of all
+// single-tenant adapters of the given component type, whether they are backed
+// by a Deployment or a Knative Service.
+func adapterPodsSelector(typ kmeta.OwnerRefable) labels.Selector {
+	instanceExists, err := labels.NewRequirement(appInstanceLabel, selection.Exists, nil)
+	if err != nil {
+		// only fails with invalid label keys or values
+		panic(fmt.Errorf("creating label requirement: %w", err))
+	}
+
+	return labels.SelectorFromValidatedSet(CommonObjectLabels(typ)).Add(*instanceExists)
+}
+
+// httpAdapterHealthGetter returns an AdapterHealthGetter which obtains the
+// runtime status of adapters from their status endpoint using the given HTTP
+// client.
+func httpAdapterHealthGetter(cli *http.Client) AdapterHealthGetter {
+	return func(ctx context.Context, p *corev1.Pod) (*health.Status, error) {
+		url := "http://" + net.JoinHostPort(p.Status.PodIP, strconv.Itoa(health.StatusPort)) + health.StatusPath
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("creating HTTP request: %w", err)
+		}
+
+		resp, err := cli.Do(req)
+		if err != nil {
+			return nil, fmt.Errorf("sending HTTP request: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("received unexpected HTTP status code %d", resp.StatusCode)
+		}
+
+		st := &health.Status{}
+		if err := json.NewDecoder(resp.Body).Decode(st); err != nil {
+			return nil, fmt.Errorf("decoding runtime status: %w", err)
+		}
+
+		return st, nil
+	}
+}
+
+// componentEnqueuer returns a function which enqueues the instance of the
+// given component type with the given key, using the given handler.
+//
+// It is assumed that handlerFn is impl.EnqueueControllerOf, originally passed
+// by the component's Reconciler implementation.
+func componentEnqueuer(typ kmeta.OwnerRefable, handlerFn func(interface{})) func(types.NamespacedName) {
+	apiVersion, kind := typ.GetGroupVersionKind().ToAPIVersionAndKind()
+
+	return func(key types.NamespacedName) {
+		handlerFn(newAccessorWithController(key.Namespace, &metav1.OwnerReference{
+			APIVersion: apiVersion,
+			Kind:       kind,
+			Name:       key.Name,
+			Controller: ptr.Bool(true),
+		}))
+	}
+}
@@ -0,0 +1,275 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corelistersv1 "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"knative.dev/pkg/apis"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
+)
+
+func TestReconcileHealth(t *testing.T) {
+	t0 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	testCases := map[string]struct {
+		health        *health.Status
+		notProbed     bool
+		expectConds   map[apis.ConditionType]corev1.ConditionStatus
+		expectReasons map[apis.ConditionType]string
+	}{
+		"not probed yet": {
+			notProbed: true,
+			expectConds: map[apis.ConditionType]corev1.ConditionStatus{
+				v1alpha1.ConditionUpstreamConnected:  corev1.ConditionFalse,
+				v1alpha1.ConditionLastEventDelivered: corev1.ConditionFalse,
+			},
+		},
+		"no runtime status": {
+			expectConds: map[apis.ConditionType]corev1.ConditionStatus{},
+		},
+		"connected and delivered": {
+			health: &health.Status{Connected: ptrBool(true), LastReceiveTime: &t0, LastSendTime: &t0},
+			expectConds: map[apis.ConditionType]corev1.ConditionStatus{
+				v1alpha1.ConditionUpstreamConnected:  corev1.ConditionTrue,
+				v1alpha1.ConditionLastEventDelivered: corev1.ConditionTrue,
+			},
+			expectReasons: map[apis.ConditionType]string{
+				v1alpha1.ConditionUpstreamConnected:  v1alpha1.ReasonEventReceived,
+				v1alpha1.ConditionLastEventDelivered: v1alpha1.ReasonEventDelivered,
+			},
+		},
+		"disconnected, delivery failed after success": {
+			health: &health.Status{
+				Connected:     ptrBool(false),
+				LastError:     &health.Error{Message: "access denied", Time: t1},
+				LastSendTime:  &t0,
+				LastSendError: &health.Error{Message: "sink unavailable", Time: t1},
+			},
+			expectConds: map[apis.ConditionType]corev1.ConditionStatus{
+				v1alpha1.ConditionUpstreamConnected:  corev1.ConditionFalse,
+				v1alpha1.ConditionLastEventDelivered: corev1.ConditionFalse,
+			},
+			expectReasons: map[apis.ConditionType]string{
+				v1alpha1.ConditionUpstreamConnected:  v1alpha1.ReasonUpstreamError,
+				v1alpha1.ConditionLastEventDelivered: v1alpha1.ReasonDeliveryFailed,
+			},
+		},
+		"connectivity not reported": {
+			health:      &health.Status{},
+			expectConds: map[apis.ConditionType]corev1.ConditionStatus{},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			src := &sourcesv1alpha1.AWSSQSSource{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test"}}
+
+			// stale conditions are expected to be overwritten once
+			// adapters were probed
+			sm := src.GetStatusManager()
+			sm.MarkUpstreamDisconnected("stale")
+			sm.MarkLastEventNotDelivered("stale")
+
+			r := &GenericHealthReconciler{
+				HealthStore: staticHealthStore{
+					key:    types.NamespacedName{Namespace: "test", Name: "test"},
+					status: tc.health,
+					probed: !tc.notProbed,
+				},
+			}
+
+			r.ReconcileHealth(v1alpha1.WithReconcilable(context.Background(), src))
+
+			conds := make(map[apis.ConditionType]corev1.ConditionStatus)
+			reasons := make(map[apis.ConditionType]string)
+			for _, c := range src.Status.Conditions {
+				if c.Type == v1alpha1.ConditionUpstreamConnected || c.Type == v1alpha1.ConditionLastEventDelivered {
+					conds[c.Type] = c.Status
+					if tc.expectReasons != nil {
+						reasons[c.Type] = c.Reason
+					}
+				}
+			}
+
+			assert.Equal(t, tc.expectConds, conds)
+			if tc.expectReasons != nil {
+				assert.Equal(t, tc.expectReasons, reasons)
+			}
+		})
+	}
+}
+
+func TestAdapterHealthProber(t *testing.T) {
+	t0 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	var mu sync.Mutex
+	healthByIP := make(map[string]*health.Status)
+
+	setHealth := func(h map[string]*health.Status) {
+		mu.Lock()
+		defer mu.Unlock()
+		healthByIP = h
+	}
+
+	var enqueued []types.NamespacedName
+
+	p := &adapterHealthProber{
+		selector: adapterPodsSelector(&sourcesv1alpha1.AWSSQSSource{}),
+		podLister: newPodLister(t,
+			newAdapterPod("test", "a", "10.0.0.1"),
+			newAdapterPod("test", "a", "10.0.0.2"),
+			newAdapterPod("test", "b", "10.0.0.3"),
+		),
+		getHealth: func(_ context.Context, p *corev1.Pod) (*health.Status, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			if st, ok := healthByIP[p.Status.PodIP]; ok {
+				return st, nil
+			}
+			return nil, errors.New("not found")
+		},
+		enqueue: func(key types.NamespacedName) { enqueued = append(enqueued, key) },
+	}
+
+	keyA := types.NamespacedName{Namespace: "test", Name: "a"}
+	keyB := types.NamespacedName{Namespace: "test", Name: "b"}
+
+	_, probed := p.AdapterHealth(keyA)
+	assert.False(t, probed, "Adapters are not probed yet")
+
+	setHealth(map[string]*health.Status{
+		"10.0.0.1": {Connected: ptrBool(true), LastSendTime: &t0},
+		"10.0.0.2": {Connected: ptrBool(false), LastError: &health.Error{Message: "err", Time: t0}},
+		"10.0.0.3": {LastSendTime: &t0},
+	})
+	p.probe(context.Background())
+
+	st, probed := p.AdapterHealth(keyA)
+	require.True(t, probed)
+	require.NotNil(t, st)
+	require.NotNil(t, st.Connected)
+	assert.False(t, *st.Connected, "Expected replicas to be aggregated")
+	assert.ElementsMatch(t, []types.NamespacedName{keyA, keyB}, enqueued)
+
+	enqueued = nil
+
+	// newer times alone don't change the status conditions
+	setHealth(map[string]*health.Status{
+		"10.0.0.1": {Connected: ptrBool(true), LastSendTime: &t1},
+		"10.0.0.2": {Connected: ptrBool(false), LastError: &health.Error{Message: "err", Time: t1}},
+		"10.0.0.3": {LastSendTime: &t1},
+	})
+	p.probe(context.Background())
+
+	assert.Empty(t, enqueued)
+
+	// an adapter which stops reporting a runtime status
+	setHealth(map[string]*health.Status{
+		"10.0.0.1": {Connected: ptrBool(true), LastSendTime: &t1},
+		"10.0.0.2": {Connected: ptrBool(false), LastError: &health.Error{Message: "err", Time: t1}},
+	})
+	p.probe(context.Background())
+
+	assert.Equal(t, []types.NamespacedName{keyB}, enqueued)
+
+	st, probed = p.AdapterHealth(keyB)
+	assert.True(t, probed)
+	assert.Nil(t, st)
+}
+
+func TestAggregateHealth(t *testing.T) {
+	t0 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	assert.Nil(t, aggregateHealth(nil))
+
+	agg := aggregateHealth([]*health.Status{
+		{Connected: ptrBool(true), LastReceiveTime: &t1, LastSendTime: &t0},
+		{Connected: ptrBool(false), LastError: &health.Error{Message: "err", Time: t0}, LastSendTime: &t1},
+		{},
+	})
+
+	require.NotNil(t, agg.Connected)
+	assert.False(t, *agg.Connected)
+	assert.Equal(t, &health.Error{Message: "err", Time: t0}, agg.LastError)
+	assert.Equal(t, &t1, agg.LastReceiveTime)
+	assert.Equal(t, &t1, agg.LastSendTime)
+	assert.Nil(t, agg.LastSendError)
+}
+
+// staticHealthStore is an AdapterHealthStore which contains the runtime
+// status of a single adapter.
+type staticHealthStore struct {
+	key    types.NamespacedName
+	status *health.Status
+	probed bool
+}
+
+// AdapterHealth implements AdapterHealthStore.
+func (s staticHealthStore) AdapterHealth(key types.NamespacedName) (*health.Status, bool) {
+	if key != s.key {
+		return nil, s.probed
+	}
+	return s.status, s.probed
+}
+
+// newAdapterPod returns a running Pod of the AWSSQSSource adapter with the
+// given instance name.
+func newAdapterPod(ns, instance, ip string) *corev1.Pod {
+	lbls := CommonObjectLabels(&sourcesv1alpha1.AWSSQSSource{})
+	lbls[appInstanceLabel] = instance
+
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "pod-" + ip, Labels: lbls},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning, PodIP: ip},
+	}
+}
+
+func newPodLister(t *testing.T, pods ...*corev1.Pod) corelistersv1.PodLister {
+	t.Helper()
+
+	idx := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, p := range pods {
+		require.NoError(t, idx.Add(p))
+	}
+
+	return corelistersv1.NewPodLister(idx)
+}
+
+func ptrBool(b bool) *bool {
+	return &b
+}
@@ -33,9 +33,11 @@ const (
 
 // Common label values
 const (
-	partOf           = "triggermesh"
-	managedBy        = "triggermesh-controller"
-	componentAdapter = "adapter"
+	partOf              = "triggermesh"
+	managedBy           = "triggermesh-controller"
+	componentAdapter    = "adapter"
+	componentCatalog    = "eventtype-catalog"
+	componentAutoscaler = "autoscaler"
 )
 
 // labelsPropagationList is a list of labels that, if present on the parent
@@ -0,0 +1,63 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake injects a fake metadata client.
+package fake
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	fakemetadata "k8s.io/client-go/metadata/fake"
+	"k8s.io/client-go/rest"
+
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata"
+)
+
+func init() {
+	injection.Fake.RegisterClient(withClient)
+}
+
+func withClient(ctx context.Context, _ *rest.Config) context.Context {
+	ctx, _ = With(ctx)
+	return ctx
+}
+
+// With returns a context carrying a fake metadata client initialized with
+// the given objects.
+func With(ctx context.Context, objects ...runtime.Object) (context.Context, *fakemetadata.FakeMetadataClient) {
+	scheme := runtime.NewScheme()
+	if err := metav1.AddMetaToScheme(scheme); err != nil {
+		panic(err)
+	}
+
+	cs := fakemetadata.NewSimpleMetadataClient(scheme, objects...)
+	return context.WithValue(ctx, partialmetadata.Key{}, cs), cs
+}
+
+// Get extracts the fake metadata client from the context.
+func Get(ctx context.Context) *fakemetadata.FakeMetadataClient {
+	untyped := ctx.Value(partialmetadata.Key{})
+	if untyped == nil {
+		logging.FromContext(ctx).Panic(
+			"Unable to fetch k8s.io/client-go/metadata/fake.FakeMetadataClient from context.")
+	}
+	return untyped.(*fakemetadata.FakeMetadataClient)
+}
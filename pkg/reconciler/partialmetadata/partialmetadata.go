@@ -0,0 +1,65 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package partialmetadata provides injection helpers for informers which only
+// cache the metadata of Kubernetes objects (PartialObjectMetadata), and never
+// their contents.
+package partialmetadata
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/metadata"
+	"k8s.io/client-go/metadata/metadatainformer"
+	"k8s.io/client-go/rest"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+)
+
+func init() {
+	injection.Default.RegisterClient(withClientFromConfig)
+}
+
+// Key is used as the key for associating information with a context.Context.
+type Key struct{}
+
+func withClientFromConfig(ctx context.Context, cfg *rest.Config) context.Context {
+	return context.WithValue(ctx, Key{}, metadata.NewForConfigOrDie(cfg))
+}
+
+// Get extracts the metadata client from the context.
+func Get(ctx context.Context) metadata.Interface {
+	untyped := ctx.Value(Key{})
+	if untyped == nil {
+		logging.FromContext(ctx).Panic(
+			"Unable to fetch k8s.io/client-go/metadata.Interface from context.")
+	}
+	return untyped.(metadata.Interface)
+}
+
+// NewInformer returns an informer which caches the metadata of objects of the
+// given resource. The informer is scoped to the namespace of the injection
+// context, if any.
+func NewInformer(ctx context.Context, gvr schema.GroupVersionResource,
+	tweakListOptions metadatainformer.TweakListOptionsFunc) informers.GenericInformer {
+
+	return metadatainformer.NewFilteredMetadataInformer(Get(ctx), gvr,
+		injection.GetNamespaceScope(ctx), controller.GetResyncPeriod(ctx), nil, tweakListOptions)
+}
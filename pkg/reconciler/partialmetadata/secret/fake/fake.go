@@ -0,0 +1,41 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake injects a fake informer which caches the metadata of Secrets.
+package fake
+
+import (
+	"context"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+
+	"github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata"
+	_ "github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata/fake"
+	"github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata/secret"
+)
+
+// Get extracts the metadata informer of Secrets from the context.
+var Get = secret.Get
+
+func init() {
+	injection.Fake.RegisterInformer(withInformer)
+}
+
+func withInformer(ctx context.Context) (context.Context, controller.Informer) {
+	inf := partialmetadata.NewInformer(ctx, secret.Resource, nil)
+	return context.WithValue(ctx, secret.Key{}, inf), inf.Informer()
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secret injects an informer which caches the metadata of Secrets.
+package secret
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/informers"
+
+	"knative.dev/pkg/controller"
+	"knative.dev/pkg/injection"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/reconciler/partialmetadata"
+)
+
+func init() {
+	injection.Default.RegisterInformer(withInformer)
+}
+
+// Key is used for associating the Informer inside the context.Context.
+type Key struct{}
+
+// Resource is the resource watched by the informer.
+var Resource = corev1.SchemeGroupVersion.WithResource("secrets")
+
+func withInformer(ctx context.Context) (context.Context, controller.Informer) {
+	inf := partialmetadata.NewInformer(ctx, Resource, excludeServiceAccountTokens)
+	return context.WithValue(ctx, Key{}, inf), inf.Informer()
+}
+
+// Get extracts the metadata informer of Secrets from the context.
+func Get(ctx context.Context) informers.GenericInformer {
+	untyped := ctx.Value(Key{})
+	if untyped == nil {
+		logging.FromContext(ctx).Panic(
+			"Unable to fetch the Secret metadata informer from context.")
+	}
+	return untyped.(informers.GenericInformer)
+}
+
+// excludeServiceAccountTokens filters out the Secrets which hold the tokens
+// of ServiceAccounts. They are never referenced by components, and often make
+// up the majority of the Secrets of a cluster.
+func excludeServiceAccountTokens(opts *metav1.ListOptions) {
+	opts.FieldSelector = fields.OneTermNotEqualSelector("type", string(corev1.SecretTypeServiceAccountToken)).String()
+}
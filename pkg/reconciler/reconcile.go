@@ -78,6 +78,17 @@ func (r *GenericDeploymentReconciler[T, L]) ReconcileAdapter(ctx context.Context
 			ReasonInvalidSpec, "Could not generate desired state of adapter Deployment: %s", err))
 	}
 
+	err = r.ConfigHashReconciler.ReconcileConfigHash(ctx,
+		&desiredAdapter.Spec.Template.ObjectMeta, &desiredAdapter.Spec.Template.Spec)
+	if err != nil {
+		return fmt.Errorf("failed to compute hash of adapter configuration: %w", err)
+	}
+
+	if reconcileSuspension(rcl) {
+		suspendDeployment(desiredAdapter)
+		rcl.GetStatusManager().MarkSuspended()
+	}
+
 	saOwners, err := serviceAccountOwners[T](rcl, r.OwnersLister(rcl.GetNamespace()))
 	if err != nil {
 		return err
@@ -86,6 +97,10 @@ func (r *GenericDeploymentReconciler[T, L]) ReconcileAdapter(ctx context.Context
 	if err := r.reconcileAdapter(ctx, desiredAdapter, saOwners); err != nil {
 		return fmt.Errorf("failed to reconcile adapter: %w", err)
 	}
+
+	if err := r.CatalogReconciler.ReconcileCatalog(ctx); err != nil {
+		return fmt.Errorf("failed to reconcile EventTypeCatalog: %w", err)
+	}
 	return nil
 }
 
@@ -131,6 +146,11 @@ func (r *GenericDeploymentReconciler[T, L]) reconcileAdapter(ctx context.Context
 	}
 
 	rcl.GetStatusManager().PropagateDeploymentAvailability(ctx, currentAdapter, r.PodLister(rcl.GetNamespace()))
+	r.HealthReconciler.ReconcileHealth(ctx)
+
+	if err := r.AutoscalerReconciler.ReconcileAutoscaler(ctx, currentAdapter); err != nil {
+		return fmt.Errorf("reconciling adapter autoscaler: %w", err)
+	}
 
 	return nil
 }
@@ -145,7 +165,17 @@ func (r *GenericDeploymentReconciler[T, L]) syncAdapterDeployment(ctx context.Co
 	// older version of TriggerMesh.
 	desiredAdapter.Name = currentAdapter.Name
 
-	if semantic.Semantic.DeepEqual(desiredAdapter, currentAdapter) {
+	isResumed := isResumed(currentAdapter, desiredAdapter)
+
+	// The number of replicas of an autoscaled adapter is managed by its
+	// HorizontalPodAutoscaler and must be preserved across updates.
+	if autoscalingPolicy(v1alpha1.ReconcilableFromContext(ctx)) != nil &&
+		desiredAdapter.Spec.Replicas == nil && !isResumed {
+
+		desiredAdapter.Spec.Replicas = currentAdapter.Spec.Replicas
+	}
+
+	if semantic.Semantic.DeepEqual(desiredAdapter, currentAdapter) && !isResumed {
 		return currentAdapter, nil
 	}
 
@@ -179,13 +209,34 @@ func (r *GenericServiceReconciler[T, L]) ReconcileAdapter(ctx context.Context,
 			ReasonInvalidSpec, "Could not generate desired state of adapter Service: %s", err))
 	}
 
-	saOwners, err := serviceAccountOwners[T](rcl, r.OwnersLister(rcl.GetNamespace()))
+	err = r.ConfigHashReconciler.ReconcileConfigHash(ctx,
+		&desiredAdapter.Spec.Template.ObjectMeta, &desiredAdapter.Spec.Template.Spec.PodSpec)
 	if err != nil {
-		return err
+		return fmt.Errorf("failed to compute hash of adapter configuration: %w", err)
 	}
 
-	if err := r.reconcileAdapter(ctx, desiredAdapter, saOwners); err != nil {
-		return fmt.Errorf("failed to reconcile adapter: %w", err)
+	// Knative activates a Service upon incoming requests regardless of its
+	// scale bounds, so the adapter Service is deleted instead of being
+	// scaled to zero, and re-created when the component is resumed.
+	if reconcileSuspension(rcl) {
+		if err := deleteAdapter(ctx, r.Lister, r.Client, desiredAdapter, desiredAdapter.GetGroupVersionKind()); err != nil {
+			return fmt.Errorf("failed to suspend adapter: %w", err)
+		}
+		rcl.GetStatusManager().MarkServiceSuspended()
+
+	} else {
+		saOwners, err := serviceAccountOwners[T](rcl, r.OwnersLister(rcl.GetNamespace()))
+		if err != nil {
+			return err
+		}
+
+		if err := r.reconcileAdapter(ctx, desiredAdapter, saOwners); err != nil {
+			return fmt.Errorf("failed to reconcile adapter: %w", err)
+		}
+	}
+
+	if err := r.CatalogReconciler.ReconcileCatalog(ctx); err != nil {
+		return fmt.Errorf("failed to reconcile EventTypeCatalog: %w", err)
 	}
 	return nil
 }
@@ -234,6 +285,7 @@ func (r *GenericServiceReconciler[T, L]) reconcileAdapter(ctx context.Context,
 	}
 
 	rcl.GetStatusManager().PropagateServiceAvailability(currentAdapter)
+	r.HealthReconciler.ReconcileHealth(ctx)
 	if isMultiTenant {
 		rcl.GetStatusManager().SetRoute(mturl.URLPath(rcl))
 	}
@@ -623,6 +675,34 @@ func getOrCreateAdapter[T metav1.Object, L k8sLister[T], C k8sClient[T]](ctx con
 	return adapter, nil
 }
 
+// deleteAdapter deletes the adapter object of a given component instance if it
+// exists.
+func deleteAdapter[T metav1.Object, L k8sLister[T], C k8sClient[T]](ctx context.Context,
+	lg k8sListerGetter[T, L], cg k8sClientGetter[T, C], desiredAdapter T, gvk schema.GroupVersionKind) error {
+
+	rcl := v1alpha1.ReconcilableFromContext(ctx)
+
+	adapter, err := findAdapter(lg, gvk, rcl, metav1.GetControllerOfNoCopy(desiredAdapter))
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get adapter %s from cache: %w", gvk.Kind, err)
+	}
+
+	err = cg(rcl.GetNamespace()).Delete(ctx, adapter.GetName(), metav1.DeleteOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil
+	case err != nil:
+		return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedAdapterDelete,
+			"Failed to delete adapter %s %q: %s", gvk.Kind, adapter.GetName(), err)
+	}
+	event.Normal(ctx, ReasonAdapterDelete, "Deleted adapter %s %q", gvk.Kind, adapter.GetName())
+
+	return nil
+}
+
 // findAdapter returns the adapter object for a given component instance if it exists.
 func findAdapter[T metav1.Object, L k8sLister[T]](lg k8sListerGetter[T, L],
 	gvk schema.GroupVersionKind, rcl v1alpha1.Reconcilable, owner *metav1.OwnerReference) (T, error) {
@@ -17,6 +17,8 @@ limitations under the License.
 package resource
 
 import (
+	"strings"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
@@ -100,6 +102,38 @@ func EnvVars(evs ...corev1.EnvVar) ObjectOption {
 	}
 }
 
+// JoinedEnvVar merges all occurrences of a Container's environment variable
+// into the first one, joining their values with the given separator. It is
+// used for variables which hold lists, and are set by distinct options.
+func JoinedEnvVar(name, sep string) ObjectOption {
+	return func(object interface{}) {
+		objEnvVars := envVarsFrom(object)
+
+		first := -1
+		var vals []string
+		envVars := (*objEnvVars)[:0]
+
+		for _, ev := range *objEnvVars {
+			if ev.Name != name {
+				envVars = append(envVars, ev)
+				continue
+			}
+
+			vals = append(vals, ev.Value)
+			if first == -1 {
+				first = len(envVars)
+				envVars = append(envVars, ev)
+			}
+		}
+
+		if first != -1 {
+			envVars[first].Value = strings.Join(vals, sep)
+		}
+
+		*objEnvVars = envVars
+	}
+}
+
 // EnvVarFromSecret sets the value of a Container's environment variable to a
 // reference to a Kubernetes Secret.
 func EnvVarFromSecret(name, secretName, secretKey string) ObjectOption {
@@ -38,9 +38,11 @@ func TestNewContainer(t *testing.T) {
 		Port("h2c", 8080),
 		Image(tImg),
 		EnvVar("TEST_ENV1", "val1"),
+		EnvVar("TEST_LIST", "a"),
 		Port("health", 8081),
 		EnvVars(makeEnvVars(2, "MULTI_ENV", "val")...),
 		EnvVar("TEST_ENV2", "val2"),
+		EnvVar("TEST_LIST", "b"),
 		EntrypointCommand("test", "--verbose"),
 		Probe("/health", "health"),
 		StartupProbe("/initialized", "health"),
@@ -49,6 +51,7 @@ func TestNewContainer(t *testing.T) {
 		Limits(&cpuRes, nil),
 		TerminationErrorToLogs,
 		VolumeMounts(vm),
+		JoinedEnvVar("TEST_LIST", ","),
 	)
 
 	expectCont := &corev1.Container{
@@ -65,6 +68,9 @@ func TestNewContainer(t *testing.T) {
 		Env: []corev1.EnvVar{{
 			Name:  "TEST_ENV1",
 			Value: "val1",
+		}, {
+			Name:  "TEST_LIST",
+			Value: "a,b",
 		}, {
 			Name:  "MULTI_ENV1",
 			Value: "val1",
@@ -18,13 +18,17 @@ package semantic
 
 import (
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/conversion"
 
 	network "knative.dev/networking/pkg"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 )
 
 // Semantic can do semantic deep equality checks for Kubernetes API objects.
@@ -39,6 +43,8 @@ var Semantic = conversion.EqualitiesOrDie(
 	deploymentEqual,
 	knServiceEqual,
 	serviceAccountEqual,
+	eventTypeCatalogEqual,
+	horizontalPodAutoscalerEqual,
 )
 
 // eq is an instance of Equalities for internal deep derivative comparisons
@@ -177,3 +183,49 @@ func serviceAccountEqual(a, b *corev1.ServiceAccount) bool {
 
 	return true
 }
+
+// eventTypeCatalogEqual returns whether two EventTypeCatalogs are semantically equivalent.
+func eventTypeCatalogEqual(a, b *catalogv1alpha1.EventTypeCatalog) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+
+	if !eq.DeepDerivative(&a.ObjectMeta, &b.ObjectMeta) {
+		return false
+	}
+
+	// Event types which are no longer produced or accepted must be
+	// removed from the catalog, so a strict comparison is required.
+	if !equality.Semantic.DeepEqual(&a.Spec, &b.Spec) {
+		return false
+	}
+	if !equality.Semantic.DeepEqual(&a.Status, &b.Status) {
+		return false
+	}
+
+	return true
+}
+
+// horizontalPodAutoscalerEqual returns whether two HorizontalPodAutoscalers
+// are semantically equivalent.
+func horizontalPodAutoscalerEqual(a, b *autoscalingv2.HorizontalPodAutoscaler) bool {
+	if a == b {
+		return true
+	}
+	if a == nil || b == nil {
+		return false
+	}
+
+	if !eq.DeepDerivative(&a.ObjectMeta, &b.ObjectMeta) {
+		return false
+	}
+
+	if !eq.DeepDerivative(&a.Spec, &b.Spec) {
+		return false
+	}
+
+	return true
+}
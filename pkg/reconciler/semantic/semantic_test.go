@@ -25,11 +25,16 @@ import (
 	"github.com/stretchr/testify/require"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	duckv1 "knative.dev/pkg/apis/duck/v1"
 	"knative.dev/pkg/ptr"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
+
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 )
 
 const (
@@ -285,6 +290,135 @@ func TestServiceAccountEqual(t *testing.T) {
 	}
 }
 
+func TestEventTypeCatalogEqual(t *testing.T) {
+	current := &catalogv1alpha1.EventTypeCatalog{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "test",
+			Name:            "test",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"app.kubernetes.io/name": "test"},
+		},
+		Spec: catalogv1alpha1.EventTypeCatalogSpec{
+			Component: duckv1.KReference{Kind: "Test", Name: "test"},
+		},
+		Status: catalogv1alpha1.EventTypeCatalogStatus{
+			Produces: []catalogv1alpha1.EventType{
+				{Type: "test.type1", Source: "test.source"},
+				{Type: "test.type2", Source: "test.source"},
+			},
+		},
+	}
+
+	assert.True(t, eventTypeCatalogEqual(nil, nil), "Two nil elements should be equal")
+
+	testCases := map[string]struct {
+		prep   func() *catalogv1alpha1.EventTypeCatalog
+		expect bool
+	}{
+		"not equal when one element is nil": {
+			func() *catalogv1alpha1.EventTypeCatalog {
+				return nil
+			},
+			false,
+		},
+		"equal when current has more metadata than desired": {
+			func() *catalogv1alpha1.EventTypeCatalog {
+				desired := current.DeepCopy()
+				desired.ResourceVersion = ""
+				return desired
+			},
+			true,
+		},
+		"not equal when some event type differs": {
+			func() *catalogv1alpha1.EventTypeCatalog {
+				desired := current.DeepCopy()
+				desired.Status.Produces[0].Source = "other.source"
+				return desired
+			},
+			false,
+		},
+		"not equal when desired has fewer event types than current": {
+			func() *catalogv1alpha1.EventTypeCatalog {
+				desired := current.DeepCopy()
+				desired.Status.Produces = desired.Status.Produces[:1]
+				return desired
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, eventTypeCatalogEqual(tc.prep(), current))
+		})
+	}
+}
+
+func TestHorizontalPodAutoscalerEqual(t *testing.T) {
+	current := &autoscalingv2.HorizontalPodAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "test",
+			Name:            "test",
+			ResourceVersion: "1",
+			Labels:          map[string]string{"app.kubernetes.io/name": "test"},
+		},
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			ScaleTargetRef: autoscalingv2.CrossVersionObjectReference{
+				APIVersion: "apps/v1",
+				Kind:       "Deployment",
+				Name:       "test",
+			},
+			MinReplicas: ptr.Int32(1),
+			MaxReplicas: 5,
+			// defaulted by the API server
+			Behavior: &autoscalingv2.HorizontalPodAutoscalerBehavior{
+				ScaleDown: &autoscalingv2.HPAScalingRules{
+					StabilizationWindowSeconds: ptr.Int32(300),
+				},
+			},
+		},
+	}
+
+	assert.True(t, horizontalPodAutoscalerEqual(nil, nil), "Two nil elements should be equal")
+
+	testCases := map[string]struct {
+		prep   func() *autoscalingv2.HorizontalPodAutoscaler
+		expect bool
+	}{
+		"not equal when one element is nil": {
+			func() *autoscalingv2.HorizontalPodAutoscaler {
+				return nil
+			},
+			false,
+		},
+		"equal when current has defaulted attributes": {
+			func() *autoscalingv2.HorizontalPodAutoscaler {
+				desired := current.DeepCopy()
+				desired.ResourceVersion = ""
+				desired.Spec.Behavior = nil
+				return desired
+			},
+			true,
+		},
+		"not equal when replicas bounds differ": {
+			func() *autoscalingv2.HorizontalPodAutoscaler {
+				desired := current.DeepCopy()
+				desired.Spec.MaxReplicas = 10
+				return desired
+			},
+			false,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, horizontalPodAutoscalerEqual(tc.prep(), current))
+		})
+	}
+}
+
 func loadFixture(t *testing.T, file string, obj runtime.Object) {
 	t.Helper()
 
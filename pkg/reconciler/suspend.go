@@ -0,0 +1,64 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"knative.dev/pkg/ptr"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// reconcileSuspension returns whether the adapter of the given component
+// instance should be suspended, and reflects in its status the suspension
+// requests which can't be honored. It is up to the caller to mark the
+// component as suspended once its adapter has been suspended.
+func reconcileSuspension(rcl v1alpha1.Reconcilable) bool {
+	if !v1alpha1.IsSuspended(rcl) {
+		rcl.GetStatusManager().MarkNotSuspended()
+		return false
+	}
+
+	// The adapter of a multi-tenant component is shared by all instances
+	// of that component in the namespace, so it can't be scaled down on
+	// behalf of a single instance.
+	if v1alpha1.IsMultiTenant(rcl) {
+		rcl.GetStatusManager().MarkSuspendUnsupported(v1alpha1.SuspendUnsupportedMultiTenant)
+		return false
+	}
+
+	return true
+}
+
+// suspendDeployment scales the given adapter Deployment to zero.
+func suspendDeployment(d *appsv1.Deployment) {
+	d.Spec.Replicas = ptr.Int32(0)
+	metav1.SetMetaDataAnnotation(&d.ObjectMeta, v1alpha1.AnnotationSuspended, "true")
+}
+
+// isResumed returns whether the current state of an adapter is suspended
+// while its desired state isn't.
+// Semantic comparisons of adapters ignore attributes which are unset in the
+// desired state, such as the number of replicas of a Deployment, so a change
+// of that kind must be detected explicitly.
+func isResumed(current, desired metav1.Object) bool {
+	_, isSuspended := current.GetAnnotations()[v1alpha1.AnnotationSuspended]
+	_, wantSuspended := desired.GetAnnotations()[v1alpha1.AnnotationSuspended]
+	return isSuspended && !wantSuspended
+}
@@ -49,7 +49,11 @@ func TestControllerConstructor(t *testing.T, ctor injection.ControllerConstructo
 	// - Adapter kind (Deployment/Kn Service)
 	// - ServiceAccount
 	// - RoleBinding
-	expectInformers := 4
+	// - EventTypeCatalog
+	// - CustomResourceDefinition
+	// - Secret (metadata)
+	// - ConfigMap (metadata)
+	expectInformers := 8
 
 	for _, opt := range opts {
 		switch t := reflect.TypeOf(opt); {
@@ -40,6 +40,7 @@ import (
 
 	fakeinjectionclient "github.com/triggermesh/triggermesh/pkg/client/generated/injection/client/fake"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 // Ctor constructs a controller.Reconciler.
@@ -125,6 +126,10 @@ func NewTestDeploymentReconciler[T kmeta.OwnerRefable, L common.Lister[T]](ctx c
 		Lister:                ls.GetDeploymentLister().Deployments,
 		PodLister:             ls.GetPodLister().Pods,
 		GenericRBACReconciler: newTestRBACReconciler(ctx, ls, ownersLister),
+		CatalogReconciler:     newTestCatalogReconciler(ctx, ls),
+		AutoscalerReconciler:  newTestAutoscalerReconciler(ctx, ls),
+		ConfigHashReconciler:  newTestConfigHashReconciler(ls),
+		HealthReconciler:      newTestHealthReconciler(),
 	}
 }
 
@@ -140,6 +145,9 @@ func NewTestServiceReconciler[T kmeta.OwnerRefable, L common.Lister[T]](ctx cont
 		Lister:                ls.GetServiceLister().Services,
 		Client:                fakeservinginjectionclient.Get(ctx).ServingV1().Services,
 		GenericRBACReconciler: newTestRBACReconciler(ctx, ls, ownersLister),
+		CatalogReconciler:     newTestCatalogReconciler(ctx, ls),
+		ConfigHashReconciler:  newTestConfigHashReconciler(ls),
+		HealthReconciler:      newTestHealthReconciler(),
 	}
 }
 
@@ -158,6 +166,51 @@ func newTestRBACReconciler[T kmeta.OwnerRefable, L common.Lister[T]](ctx context
 	}
 }
 
+// newTestCatalogReconciler returns a GenericCatalogReconciler initialized with test clients.
+func newTestCatalogReconciler(ctx context.Context, ls *Listers) *common.GenericCatalogReconciler {
+	return &common.GenericCatalogReconciler{
+		Lister:    ls.GetEventTypeCatalogLister().EventTypeCatalogs,
+		CRDLister: ls.GetCustomResourceDefinitionLister(),
+		Client:    fakeinjectionclient.Get(ctx).CatalogV1alpha1().EventTypeCatalogs,
+	}
+}
+
+// newTestAutoscalerReconciler returns a GenericAutoscalerReconciler initialized with test clients.
+func newTestAutoscalerReconciler(ctx context.Context, ls *Listers) *common.GenericAutoscalerReconciler {
+	return &common.GenericAutoscalerReconciler{
+		Lister: ls.GetHorizontalPodAutoscalerLister().HorizontalPodAutoscalers,
+		Client: fakek8sinjectionclient.Get(ctx).AutoscalingV2().HorizontalPodAutoscalers,
+	}
+}
+
+// newTestConfigHashReconciler returns a GenericConfigHashReconciler initialized with test listers.
+func newTestConfigHashReconciler(ls *Listers) *common.GenericConfigHashReconciler {
+	return &common.GenericConfigHashReconciler{
+		Tracker:         tracker.New(func(types.NamespacedName) {}, 0),
+		SecretLister:    ls.GetSecretMetadataLister(),
+		ConfigMapLister: ls.GetConfigMapMetadataLister(),
+	}
+}
+
+// newTestHealthReconciler returns a GenericHealthReconciler for tests.
+// Adapters never report a runtime status in tests.
+func newTestHealthReconciler() *common.GenericHealthReconciler {
+	return &common.GenericHealthReconciler{
+		HealthStore: noAdapterHealth{},
+	}
+}
+
+// noAdapterHealth is a common.AdapterHealthStore in which no adapter reports
+// a runtime status.
+type noAdapterHealth struct{}
+
+var _ common.AdapterHealthStore = noAdapterHealth{}
+
+// AdapterHealth implements common.AdapterHealthStore.
+func (noAdapterHealth) AdapterHealth(types.NamespacedName) (*health.Status, bool) {
+	return nil, true
+}
+
 // ToUnstructured takes a list of k8s resources and converts them to
 // Unstructured objects.
 // We must pass objects as Unstructured to the dynamic client fake, or it
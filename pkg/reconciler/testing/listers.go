@@ -20,13 +20,21 @@ import (
 	"fmt"
 
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	fakeapiextensionsclient "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	apiextensionslistersv1 "k8s.io/apiextensions-apiserver/pkg/client/listers/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	fakek8sclient "k8s.io/client-go/kubernetes/fake"
 	appslistersv1 "k8s.io/client-go/listers/apps/v1"
+	autoscalinglistersv2 "k8s.io/client-go/listers/autoscaling/v2"
 	corelistersv1 "k8s.io/client-go/listers/core/v1"
 	rbaclistersv1 "k8s.io/client-go/listers/rbac/v1"
+	"k8s.io/client-go/metadata/metadatalister"
 	"k8s.io/client-go/tools/cache"
 
 	fakeeventingclientset "knative.dev/eventing/pkg/client/clientset/versioned/fake"
@@ -35,11 +43,13 @@ import (
 	fakeservingclient "knative.dev/serving/pkg/client/clientset/versioned/fake"
 	servinglistersv1 "knative.dev/serving/pkg/client/listers/serving/v1"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	extensionsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/extensions/v1alpha1"
 	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
 	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	fakeclient "github.com/triggermesh/triggermesh/pkg/client/generated/clientset/internalclientset/fake"
+	cataloglistersv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/catalog/v1alpha1"
 	extensionslistersv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/extensions/v1alpha1"
 	flowlistersv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/flow/v1alpha1"
 	sourceslistersv1alpha1 "github.com/triggermesh/triggermesh/pkg/client/generated/listers/sources/v1alpha1"
@@ -50,6 +60,7 @@ var clientSetSchemes = []func(*runtime.Scheme) error{
 	fakeclient.AddToScheme,
 	fakek8sclient.AddToScheme,
 	fakeservingclient.AddToScheme,
+	fakeapiextensionsclient.AddToScheme,
 	// although our reconcilers do not handle eventing objects directly, we
 	// do need to register the eventing Scheme so that sink URI resolvers
 	// can recognize the Broker objects we use in tests
@@ -114,6 +125,35 @@ func (l *Listers) GetPodLister() corelistersv1.PodLister {
 	return corelistersv1.NewPodLister(l.IndexerFor(&corev1.Pod{}))
 }
 
+// GetSecretMetadataLister returns a lister for the metadata of Secret objects.
+func (l *Listers) GetSecretMetadataLister() metadatalister.Lister {
+	return metadatalister.New(l.metadataIndexerFor(&corev1.Secret{}), corev1.SchemeGroupVersion.WithResource("secrets"))
+}
+
+// GetConfigMapMetadataLister returns a lister for the metadata of ConfigMap objects.
+func (l *Listers) GetConfigMapMetadataLister() metadatalister.Lister {
+	return metadatalister.New(l.metadataIndexerFor(&corev1.ConfigMap{}), corev1.SchemeGroupVersion.WithResource("configmaps"))
+}
+
+// metadataIndexerFor returns an indexer containing the metadata of the objects
+// of the same type as the given object.
+func (l *Listers) metadataIndexerFor(obj runtime.Object) cache.Indexer {
+	idx := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+
+	for _, o := range l.IndexerFor(obj).List() {
+		if err := idx.Add(meta.AsPartialObjectMetadata(o.(metav1.Object))); err != nil {
+			panic(fmt.Errorf("error indexing object metadata: %s", err))
+		}
+	}
+
+	return idx
+}
+
+// GetHorizontalPodAutoscalerLister returns a lister for HorizontalPodAutoscaler objects.
+func (l *Listers) GetHorizontalPodAutoscalerLister() autoscalinglistersv2.HorizontalPodAutoscalerLister {
+	return autoscalinglistersv2.NewHorizontalPodAutoscalerLister(l.IndexerFor(&autoscalingv2.HorizontalPodAutoscaler{}))
+}
+
 // GetServiceLister returns a lister for Service objects.
 func (l *Listers) GetServiceLister() servinglistersv1.ServiceLister {
 	return servinglistersv1.NewServiceLister(l.IndexerFor(&servingv1.Service{}))
@@ -129,11 +169,31 @@ func (l *Listers) GetRoleBindingLister() rbaclistersv1.RoleBindingLister {
 	return rbaclistersv1.NewRoleBindingLister(l.IndexerFor(&rbacv1.RoleBinding{}))
 }
 
+// GetCustomResourceDefinitionLister returns a lister for CustomResourceDefinition objects.
+func (l *Listers) GetCustomResourceDefinitionLister() apiextensionslistersv1.CustomResourceDefinitionLister {
+	return apiextensionslistersv1.NewCustomResourceDefinitionLister(l.IndexerFor(&apiextensionsv1.CustomResourceDefinition{}))
+}
+
+// GetEventTypeCatalogLister returns a Lister for EventTypeCatalog objects.
+func (l *Listers) GetEventTypeCatalogLister() cataloglistersv1alpha1.EventTypeCatalogLister {
+	return cataloglistersv1alpha1.NewEventTypeCatalogLister(l.IndexerFor(&catalogv1alpha1.EventTypeCatalog{}))
+}
+
+// GetDeduplicatorLister returns a Lister for Deduplicator objects.
+func (l *Listers) GetDeduplicatorLister() flowlistersv1alpha1.DeduplicatorLister {
+	return flowlistersv1alpha1.NewDeduplicatorLister(l.IndexerFor(&flowv1alpha1.Deduplicator{}))
+}
+
 // GetJQTransformationLister returns a Lister for JQTransformation objects.
 func (l *Listers) GetJQTransformationLister() flowlistersv1alpha1.JQTransformationLister {
 	return flowlistersv1alpha1.NewJQTransformationLister(l.IndexerFor(&flowv1alpha1.JQTransformation{}))
 }
 
+// GetSchemaValidatorLister returns a Lister for SchemaValidator objects.
+func (l *Listers) GetSchemaValidatorLister() flowlistersv1alpha1.SchemaValidatorLister {
+	return flowlistersv1alpha1.NewSchemaValidatorLister(l.IndexerFor(&flowv1alpha1.SchemaValidator{}))
+}
+
 // GetSynchronizerLister returns a Lister for Synchronizer objects.
 func (l *Listers) GetSynchronizerLister() flowlistersv1alpha1.SynchronizerLister {
 	return flowlistersv1alpha1.NewSynchronizerLister(l.IndexerFor(&flowv1alpha1.Synchronizer{}))
@@ -43,6 +43,7 @@ import (
 	rt "knative.dev/pkg/reconciler/testing"
 	servingv1 "knative.dev/serving/pkg/apis/serving/v1"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/mturl"
 	common "github.com/triggermesh/triggermesh/pkg/reconciler"
@@ -90,6 +91,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 	newConfigWatchRoleBinding := NewConfigWatchRoleBinding(newServiceAccount())
 	newMTAdapterRoleBinding := NewMTAdapterRoleBinding(newServiceAccount())
 	newAdapter := mustAdapterCtor(t, ab, rcl)
+	newCatalog := NewEventTypeCatalog(rcl)
 
 	comp := newComponentInstance()
 	a := newAdapter()
@@ -113,13 +115,14 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 			},
 			WantCreates: func() []runtime.Object {
 				objs := []runtime.Object{
+					newCatalog(),
 					newServiceAccount(NoToken),
 					newConfigWatchRoleBinding(),
 					newAdapter(),
 				}
 				// only multi-tenant components expect a RoleBinding
 				if v1alpha1.IsMultiTenant(comp) {
-					return insertObject(objs, newMTAdapterRoleBinding(), 2)
+					return insertObject(objs, newMTAdapterRoleBinding(), 3)
 				}
 				return objs
 			}(),
@@ -131,6 +134,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 					createServiceAccountEvent(comp),
 					createConfigWatchRoleBindingEvent(comp),
 					createAdapterEvent(n, k),
+					createCatalogEvent(comp),
 				}
 				// only multi-tenant components expect a RoleBinding
 				if v1alpha1.IsMultiTenant(comp) {
@@ -160,6 +164,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready),
 			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -176,6 +181,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(notReady),
 			},
 			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
@@ -192,6 +198,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready, bumpImage),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -211,6 +218,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(noOwner),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -220,6 +228,26 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				updateServiceAccountEvent(comp),
 			},
 		},
+		{
+			Name: "Event type catalog is outdated",
+			Key:  tKey,
+			Ctx:  skipCtx,
+			Objects: []runtime.Object{
+				newAddressable(),
+				newComponentInstance(withSink, deployed(a)),
+				newServiceAccount(),
+				newConfigWatchRoleBinding(),
+				newMTAdapterRoleBinding(),
+				newCatalog(staleEventTypes),
+				newAdapter(ready),
+			},
+			WantUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: newCatalog(),
+			}},
+			WantEvents: []string{
+				updateCatalogEvent(comp),
+			},
+		},
 		{
 			Name: "Everything is up-to-date",
 			Key:  tKey,
@@ -230,6 +258,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready),
 			},
 			WantUpdates: nil,
@@ -245,6 +274,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready, rename),
 			},
 			WantUpdates: nil,
@@ -264,6 +294,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready),
 			},
 			WantStatusUpdates: func() []clientgotesting.UpdateActionImpl {
@@ -303,6 +334,119 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 			}(),
 		},
 
+		{
+			Name: "Suspension requested",
+			Key:  tKey,
+			Ctx:  skipCtx,
+			Objects: []runtime.Object{
+				newAddressable(),
+				newComponentInstance(withSink, deployed(a), suspended),
+				newServiceAccount(),
+				newConfigWatchRoleBinding(),
+				newMTAdapterRoleBinding(),
+				newCatalog(),
+				newAdapter(ready),
+			},
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: newComponentInstance(withSink, deployed(a), suspended, withSuspendedStatus(a)),
+			}},
+			WantUpdates: func() []clientgotesting.UpdateActionImpl {
+				// the adapter of multi-tenant components is shared,
+				// so it can't be suspended
+				if !isSuspendable(comp) || isServiceAdapter(a) {
+					return nil
+				}
+				return []clientgotesting.UpdateActionImpl{{
+					Object: newAdapter(ready, suspendedAdapter),
+				}}
+			}(),
+			WantDeletes: func() []clientgotesting.DeleteActionImpl {
+				// Knative Services are activated by requests, so
+				// they are deleted instead of being scaled to zero
+				if !isSuspendable(comp) || !isServiceAdapter(a) {
+					return nil
+				}
+				return []clientgotesting.DeleteActionImpl{
+					clientgotesting.NewDeleteAction(servingv1.SchemeGroupVersion.WithResource(r), tNs, n),
+				}
+			}(),
+			WantEvents: func() []string {
+				// see WantUpdates and WantDeletes
+				switch {
+				case !isSuspendable(comp):
+					return nil
+				case isServiceAdapter(a):
+					return []string{
+						deleteAdapterEvent(n, k),
+					}
+				}
+				return []string{
+					updateAdapterEvent(n, k),
+				}
+			}(),
+		},
+		{
+			Name: "Suspension lifted",
+			Key:  tKey,
+			Ctx:  skipCtx,
+			Objects: func() []runtime.Object {
+				objs := []runtime.Object{
+					newAddressable(),
+					newComponentInstance(withSink, deployed(a), withSuspendedStatus(a)),
+					newServiceAccount(),
+					newConfigWatchRoleBinding(),
+					newMTAdapterRoleBinding(),
+					newCatalog(),
+				}
+				switch {
+				case !isSuspendable(comp):
+					return append(objs, newAdapter(ready))
+				case isServiceAdapter(a):
+					// deleted upon suspension
+					return objs
+				}
+				return append(objs, newAdapter(ready, suspendedAdapter))
+			}(),
+			WantStatusUpdates: []clientgotesting.UpdateActionImpl{{
+				Object: func() runtime.Object {
+					// a re-created Service is not ready yet
+					if isSuspendable(comp) && isServiceAdapter(a) {
+						return newComponentInstance(withSink, notDeployed(a))
+					}
+					return newComponentInstance(withSink, deployed(a))
+				}(),
+			}},
+			WantCreates: func() []runtime.Object {
+				if !isSuspendable(comp) || !isServiceAdapter(a) {
+					return nil
+				}
+				return []runtime.Object{
+					newAdapter(),
+				}
+			}(),
+			WantUpdates: func() []clientgotesting.UpdateActionImpl {
+				if !isSuspendable(comp) || isServiceAdapter(a) {
+					return nil
+				}
+				return []clientgotesting.UpdateActionImpl{{
+					Object: newAdapter(ready),
+				}}
+			}(),
+			WantEvents: func() []string {
+				switch {
+				case !isSuspendable(comp):
+					return nil
+				case isServiceAdapter(a):
+					return []string{
+						createAdapterEvent(n, k),
+					}
+				}
+				return []string{
+					updateAdapterEvent(n, k),
+				}
+			}(),
+		},
+
 		// Errors
 
 		{
@@ -315,6 +459,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready),
 			},
 			WantStatusUpdates: func() []clientgotesting.UpdateActionImpl {
@@ -357,6 +502,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 			},
 			WantCreates: []runtime.Object{
 				newAdapter(),
@@ -382,6 +528,7 @@ func TestReconcileAdapter[T kmeta.Accessor](t *testing.T,
 				newServiceAccount(),
 				newConfigWatchRoleBinding(),
 				newMTAdapterRoleBinding(),
+				newCatalog(),
 				newAdapter(ready, bumpImage),
 			},
 			WantUpdates: []clientgotesting.UpdateActionImpl{{
@@ -498,6 +645,7 @@ func Populate(rclCpy v1alpha1.Reconcilable) {
 	if rcv, isEventReceiver := rclCpy.(v1alpha1.EventReceiver); isEventReceiver {
 		rclCpy.GetStatusManager().AcceptedEventTypes = rcv.AcceptedEventTypes()
 	}
+	rclCpy.GetStatusManager().SetEventTypeCatalog(common.EventTypeCatalogName(rclCpy))
 
 	// *reconcilerImpl.Reconcile calls this method before any reconciliation loop. Calling it here ensures that the
 	// object is initialized in the same manner, and prevents tests from wrongly reporting unexpected status updates.
@@ -526,11 +674,12 @@ func componentCtor(rcl v1alpha1.Reconcilable) componentCtorWithOptions {
 // componentOption is a functional option for a component instance.
 type componentOption func(v1alpha1.Reconcilable)
 
-// noCEAttributes sets empty CE attributes. Simulates the creation of a new
-// component instance.
+// noCEAttributes sets empty CE attributes and clears the reference to the
+// EventTypeCatalog. Simulates the creation of a new component instance.
 func noCEAttributes(rcl v1alpha1.Reconcilable) {
 	rcl.GetStatusManager().AcceptedEventTypes = nil
 	rcl.GetStatusManager().CloudEventAttributes = nil
+	rcl.GetStatusManager().Annotations = nil
 }
 
 // Sink: True
@@ -571,6 +720,42 @@ func withClearSink(rcl v1alpha1.Reconcilable) {
 	rcl.GetStatusManager().MarkSink(nil)
 }
 
+// suspended requests the suspension of the component instance.
+func suspended(rcl v1alpha1.Reconcilable) {
+	anns := rcl.GetAnnotations()
+	if anns == nil {
+		anns = make(map[string]string, 1)
+	}
+	anns[v1alpha1.AnnotationSuspended] = "true"
+	rcl.SetAnnotations(anns)
+}
+
+// Suspended: True, or False for components which can't be suspended
+func withSuspendedStatus(adapter kmeta.Accessor) componentOption {
+	return func(rcl v1alpha1.Reconcilable) {
+		switch {
+		case !isSuspendable(rcl):
+			rcl.GetStatusManager().MarkSuspendUnsupported(v1alpha1.SuspendUnsupportedMultiTenant)
+		case isServiceAdapter(adapter):
+			rcl.GetStatusManager().MarkServiceSuspended()
+		default:
+			rcl.GetStatusManager().MarkSuspended()
+		}
+	}
+}
+
+// isSuspendable returns whether the adapter of the given component instance
+// can be suspended.
+func isSuspendable(rcl v1alpha1.Reconcilable) bool {
+	return !v1alpha1.IsMultiTenant(rcl)
+}
+
+// isServiceAdapter returns whether the given adapter is a Knative Service.
+func isServiceAdapter(adapter kmeta.Accessor) bool {
+	_, isService := adapter.(*servingv1.Service)
+	return isService
+}
+
 // Deployed: True
 func deployed(adapter kmeta.Accessor) componentOption {
 	adapter = adapter.DeepCopyObject().(kmeta.Accessor)
@@ -722,6 +907,15 @@ func bumpImage(object kmeta.Accessor) {
 	}
 }
 
+// suspendedAdapter scales the adapter to zero.
+// Adapters backed by a Knative Service are deleted instead.
+func suspendedAdapter(object kmeta.Accessor) {
+	if d, ok := object.(*appsv1.Deployment); ok {
+		d.Spec.Replicas = ptr.Int32(0)
+		metav1.SetMetaDataAnnotation(&d.ObjectMeta, v1alpha1.AnnotationSuspended, "true")
+	}
+}
+
 // rename changes the name of the adapter.
 func rename(object kmeta.Accessor) {
 	switch o := object.(type) {
@@ -772,6 +966,30 @@ func newAddressable() *eventingv1.Broker {
 
 /* RBAC */
 
+// EventTypeCatalogCtorWithOptions returns an EventTypeCatalog constructor which accepts options.
+type EventTypeCatalogCtorWithOptions func(...func(*catalogv1alpha1.EventTypeCatalog)) *catalogv1alpha1.EventTypeCatalog
+
+// NewEventTypeCatalog returns an EventTypeCatalogCtorWithOptions for the
+// given component instance.
+func NewEventTypeCatalog(rcl v1alpha1.Reconcilable) EventTypeCatalogCtorWithOptions {
+	return func(opts ...func(*catalogv1alpha1.EventTypeCatalog)) *catalogv1alpha1.EventTypeCatalog {
+		cat := common.MakeEventTypeCatalog(rcl, nil)
+
+		for _, opt := range opts {
+			opt(cat)
+		}
+
+		return cat
+	}
+}
+
+// staleEventTypes lists an event type which isn't supported by the component.
+func staleEventTypes(cat *catalogv1alpha1.EventTypeCatalog) {
+	cat.Status.Accepts = append(cat.Status.Accepts, catalogv1alpha1.EventType{
+		Type: "io.triggermesh.stale",
+	})
+}
+
 // ServiceAccountCtorWithOptions returns a ServiceAccount constructor which accepts options.
 type ServiceAccountCtorWithOptions func(...resource.ServiceAccountOption) *corev1.ServiceAccount
 
@@ -927,12 +1145,23 @@ func createMTAdapterRoleBindingEvent(rcl v1alpha1.Reconcilable) string {
 		"Created RoleBinding %q due to the creation of a %s object",
 		common.MTAdapterObjectName(rcl), rcl.GetGroupVersionKind().Kind)
 }
+func createCatalogEvent(rcl v1alpha1.Reconcilable) string {
+	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonCatalogCreate,
+		"Created EventTypeCatalog %q", common.EventTypeCatalogName(rcl))
+}
+func updateCatalogEvent(rcl v1alpha1.Reconcilable) string {
+	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonCatalogUpdate,
+		"Updated EventTypeCatalog %q", common.EventTypeCatalogName(rcl))
+}
 func createAdapterEvent(name, kind string) string {
 	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonAdapterCreate, "Created adapter %s %q", kind, name)
 }
 func updateAdapterEvent(name, kind string) string {
 	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonAdapterUpdate, "Updated adapter %s %q", kind, name)
 }
+func deleteAdapterEvent(name, kind string) string {
+	return eventtesting.Eventf(corev1.EventTypeNormal, common.ReasonAdapterDelete, "Deleted adapter %s %q", kind, name)
+}
 func failCreateAdapterEvent(name, kind, resource string) string {
 	return eventtesting.Eventf(corev1.EventTypeWarning, common.ReasonFailedAdapterCreate, "Failed to create adapter %s %q: "+
 		"inducing failure for create %s", kind, name, resource)
@@ -36,6 +36,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
@@ -95,8 +96,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	a := common.MustParseARN(env.ARN)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
-		WithRegion(a.Region),
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
+		WithRegion(a.Region)),
 	))
 
 	config := &aws.Config{}
@@ -192,6 +193,9 @@ func (a *adapter) CollectLogs(ctx context.Context, priorTime *time.Time, current
 		LogGroupName: &a.logGroup,
 	}
 
+	// last error returned while retrieving the logs of a stream
+	var logsErr error
+
 	err := a.cwLogsClient.DescribeLogStreamsPages(&logStreams, func(output *cloudwatchlogs.DescribeLogStreamsOutput, b bool) bool {
 		var logRequest *cloudwatchlogs.GetLogEventsInput
 
@@ -227,6 +231,10 @@ func (a *adapter) CollectLogs(ctx context.Context, priorTime *time.Time, current
 					}
 				}
 
+				if len(trimmedLogOutput) > 0 {
+					health.ReportReceive()
+				}
+
 				for _, v := range trimmedLogOutput {
 					event := cloudevents.NewEvent(cloudevents.VersionV1)
 					event.SetType(v1alpha1.AWSEventType(a.arn.Service, v1alpha1.AWSCloudWatchLogsGenericEventType))
@@ -249,6 +257,7 @@ func (a *adapter) CollectLogs(ctx context.Context, priorTime *time.Time, current
 
 			if err != nil {
 				a.logger.Errorw("Error retrieving logs", zap.Error(err))
+				logsErr = err
 			}
 		}
 
@@ -257,7 +266,16 @@ func (a *adapter) CollectLogs(ctx context.Context, priorTime *time.Time, current
 
 	if err != nil {
 		a.logger.Errorw("Error retrieving log streams", zap.Error(err))
+		health.MarkDisconnected(err)
+		return
 	}
+
+	if logsErr != nil {
+		health.MarkDisconnected(logsErr)
+		return
+	}
+
+	health.MarkConnected()
 }
 
 // peekLogGroup verifies that a log group exists.
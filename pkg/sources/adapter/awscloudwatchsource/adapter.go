@@ -35,6 +35,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
@@ -93,8 +94,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	env := envAcc.(*envConfig)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
-		WithRegion(env.Region),
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
+		WithRegion(env.Region)),
 	))
 
 	config := &aws.Config{}
@@ -228,6 +229,10 @@ func (a *adapter) CollectMetrics(ctx context.Context, priorTime *time.Time, curr
 	}
 
 	err := a.cwClient.GetMetricDataPages(&metricInput, func(output *cloudwatch.GetMetricDataOutput, b bool) bool {
+		if len(output.MetricDataResults) > 0 {
+			health.ReportReceive()
+		}
+
 		err := a.SendMetricEvent(ctx, output)
 		if err != nil {
 			a.logger.Errorw("Error sending metrics", zap.Error(err))
@@ -239,8 +244,11 @@ func (a *adapter) CollectMetrics(ctx context.Context, priorTime *time.Time, curr
 	})
 	if err != nil {
 		a.logger.Errorw("Error retrieving metrics", zap.Error(err))
+		health.MarkDisconnected(err)
 		return
 	}
+
+	health.MarkConnected()
 }
 
 func (a *adapter) SendMetricEvent(ctx context.Context, metricOutput *cloudwatch.GetMetricDataOutput) error {
@@ -35,6 +35,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
@@ -103,9 +104,9 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	arn := common.MustParseARN(env.ARN)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(arn.Region).
-		WithMaxRetries(5),
+		WithMaxRetries(5)),
 	))
 
 	config := &aws.Config{}
@@ -36,6 +36,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
@@ -90,9 +91,9 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	arn := common.MustParseARN(env.ARN)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(arn.Region).
-		WithMaxRetries(5),
+		WithMaxRetries(5)),
 	))
 
 	config := &aws.Config{}
@@ -135,10 +136,16 @@ func (a *adapter) Start(ctx context.Context) error {
 		users, err := a.listUsers()
 		if err != nil {
 			a.logger.Errorw("Cognito ListUsers failed", zap.Error(err))
+			health.MarkDisconnected(err)
 			return resetBackoff, err
 		}
 
+		health.MarkConnected()
+
 		users, latestTimestamp = filterByTimestamp(users, latestTimestamp)
+		if len(users) > 0 {
+			health.ReportReceive()
+		}
 
 		for _, user := range users {
 			// we have new users - reset backoff duration
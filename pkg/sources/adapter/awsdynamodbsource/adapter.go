@@ -39,6 +39,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
@@ -112,8 +113,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	arn := common.MustParseARN(env.ARN)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
-		WithRegion(arn.Region),
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
+		WithRegion(arn.Region)),
 	))
 
 	config := &aws.Config{}
@@ -170,6 +171,9 @@ loop:
 
 			if err := a.recheckStream(ctx, streamARN); err != nil {
 				a.logger.Errorw("Error while re-checking stream "+*streamARN, zap.Error(err))
+				health.MarkDisconnected(err)
+			} else {
+				health.MarkConnected()
 			}
 
 			t.Reset(streamRecheckPeriod)
@@ -310,7 +314,9 @@ loop:
 				ShardIterator: currentShardIter,
 			})
 			if err != nil {
-				return fmt.Errorf("getting records from shard ID %s: %w", *shardID, err)
+				err = fmt.Errorf("getting records from shard ID %s: %w", *shardID, err)
+				health.MarkDisconnected(err)
+				return err
 			}
 
 			nextRequestDelay := getRecordsPeriod
@@ -319,6 +325,8 @@ loop:
 				// returned, so that bursts of new records are
 				// processed quickly
 				nextRequestDelay = 0
+
+				health.ReportReceive()
 			}
 
 			for _, r := range r.Records {
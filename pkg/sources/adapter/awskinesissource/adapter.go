@@ -37,6 +37,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
@@ -91,9 +92,9 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	arn := common.MustParseARN(env.ARN)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(arn.Region).
-		WithMaxRetries(5),
+		WithMaxRetries(5)),
 	))
 
 	config := &aws.Config{}
@@ -142,6 +143,13 @@ func (a *adapter) Start(ctx context.Context) error {
 		records, err := a.processInputs(inputs)
 		if err != nil {
 			a.logger.Errorw("There were errors during inputs processing", zap.Error(err))
+			health.MarkDisconnected(err)
+		} else {
+			health.MarkConnected()
+		}
+
+		if len(records) > 0 {
+			health.ReportReceive()
 		}
 
 		for _, record := range records {
@@ -36,6 +36,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
@@ -102,8 +103,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	a := common.MustParseARN(env.ARN)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
-		WithRegion(a.Region),
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
+		WithRegion(a.Region)),
 	))
 
 	config := &aws.Config{}
@@ -204,9 +205,12 @@ func (a *adapter) PollMetrics(ctx context.Context, priorTime time.Time, currentT
 
 	if err != nil {
 		a.logger.Errorw("Error retrieving resource metrics", zap.Error(err))
+		health.MarkDisconnected(err)
 		return
 	}
 
+	health.MarkConnected()
+
 	for _, d := range rm.MetricList {
 		for _, metric := range d.DataPoints {
 			if metric.Value != nil {
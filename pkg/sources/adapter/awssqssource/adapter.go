@@ -38,7 +38,9 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
@@ -68,6 +70,18 @@ type envConfig struct {
 	// Visibility timeout to set on all messages received by this event source.
 	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/sqs-visibility-timeout.html
 	VisibilityTimeout *time.Duration `envconfig:"SQS_VISIBILITY_TIMEOUT"`
+	// Periodically extend the visibility timeout of messages while they are
+	// being processed.
+	ExtendVisibilityTimeout bool `envconfig:"SQS_EXTEND_VISIBILITY_TIMEOUT"`
+
+	// Number of concurrent message receivers and processors. Zero values
+	// default to a number of instances proportional to available CPUs.
+	Receivers  int `envconfig:"SQS_RECEIVERS"`
+	Processors int `envconfig:"SQS_PROCESSORS"`
+
+	// Process messages which share a message group ID sequentially. Only
+	// applies to FIFO queues.
+	PreserveMessageGroupOrder bool `envconfig:"SQS_PRESERVE_MESSAGE_GROUP_ORDER" default:"true"`
 
 	// Allows overriding common CloudEvents attributes.
 	CEOverrideSource string `envconfig:"CE_SOURCE"`
@@ -84,8 +98,9 @@ type envConfig struct {
 type adapter struct {
 	logger *zap.SugaredLogger
 
-	mt *pkgadapter.MetricTag
-	sr *statsReporter
+	mt    *pkgadapter.MetricTag
+	sr    *statsReporter
+	lagSr *metrics.ConsumerLagStatsReporter
 
 	sqsClient sqsiface.SQSAPI
 	ceClient  cloudevents.Client
@@ -95,9 +110,18 @@ type adapter struct {
 	msgPrcsr MessageProcessor
 
 	visibilityTimeoutSeconds *int64
+	// tracks messages which visibility timeout is extended while they are
+	// being processed, if enabled
+	visibilityExt *visibilityExtender
+
+	receivers  int
+	processors int
 
 	processQueue chan *sqs.Message
-	deleteQueue  chan *sqs.Message
+	// receives groups of messages which share a message group ID when
+	// those must be processed in order, instead of processQueue
+	groupQueue  chan []*sqs.Message
+	deleteQueue chan *sqs.Message
 
 	deletePeriod time.Duration
 }
@@ -112,6 +136,7 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	logger := logging.FromContext(ctx)
 
 	mustRegisterStatsView()
+	metrics.MustRegisterConsumerLagStatsView()
 
 	mt := &pkgadapter.MetricTag{
 		ResourceGroup: sources.AWSSQSSourceResource.String(),
@@ -152,9 +177,19 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		}
 	}
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
-		WithRegion(arn.Region).
-		WithEndpointResolver(common.EndpointResolver(arn.Partition)),
+	var visibilityExt *visibilityExtender
+	if env.ExtendVisibilityTimeout {
+		// without a visibility timeout, messages are extended by the
+		// visibility timeout of the queue, which is read upon start
+		if visibilityTimeoutSeconds == nil || *visibilityTimeoutSeconds > 0 {
+			visibilityExt = newVisibilityExtender()
+		} else {
+			logger.Warn("Ignoring visibility timeout extension, which requires a visibility timeout of at least 1s")
+		}
+	}
+
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
+		WithRegion(arn.Region)),
 	))
 
 	config := &aws.Config{}
@@ -172,11 +207,19 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	sr.reportQueueCapacityProcess(queueBufferSizeProcess)
 	sr.reportQueueCapacityDelete(queueBufferSizeDelete)
 
+	processQueue := make(chan *sqs.Message, queueBufferSizeProcess)
+	var groupQueue chan []*sqs.Message
+	if isFIFOQueue(arn) && env.PreserveMessageGroupOrder {
+		processQueue = nil
+		groupQueue = make(chan []*sqs.Message, queueBufferSizeProcess)
+	}
+
 	return &adapter{
 		logger: logger,
 
-		mt: mt,
-		sr: sr,
+		mt:    mt,
+		sr:    sr,
+		lagSr: metrics.MustNewConsumerLagStatsReporter(mt),
 
 		sqsClient: sqs.New(sess, config),
 		ceClient:  ceClient,
@@ -186,8 +229,13 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		msgPrcsr: msgPrcsr,
 
 		visibilityTimeoutSeconds: visibilityTimeoutSeconds,
+		visibilityExt:            visibilityExt,
+
+		receivers:  env.Receivers,
+		processors: env.Processors,
 
-		processQueue: make(chan *sqs.Message, queueBufferSizeProcess),
+		processQueue: processQueue,
+		groupQueue:   groupQueue,
 		deleteQueue:  make(chan *sqs.Message, queueBufferSizeDelete),
 
 		deletePeriod: maxDeleteMsgPeriod,
@@ -204,9 +252,17 @@ func (a *adapter) Start(ctx context.Context) error {
 		return err
 	}
 
+	queueURL := *url.QueueUrl
+
+	if a.visibilityExt != nil && a.visibilityTimeoutSeconds == nil {
+		if err := a.defaultVisibilityTimeout(ctx, queueURL); err != nil {
+			a.logger.Errorw("Unable to read visibility timeout of SQS queue "+a.arn.Resource, zap.Error(err))
+			return err
+		}
+	}
+
 	health.MarkReady()
 
-	queueURL := *url.QueueUrl
 	a.logger.Infof("Listening to SQS queue at URL: %s", queueURL)
 
 	msgCtx, cancel := context.WithCancel(pkgadapter.ContextWithMetricTag(ctx, a.mt))
@@ -214,28 +270,47 @@ func (a *adapter) Start(ctx context.Context) error {
 
 	var wg sync.WaitGroup
 
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		a.lagSr.Run(msgCtx, metrics.DefaultConsumerLagPollInterval, a.queueLag(queueURL))
+	}()
+
+	if a.visibilityExt != nil {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			a.runVisibilityExtender(msgCtx, queueURL)
+		}()
+	}
+
 	// This event source spends most of its time waiting for the network,
 	// so we can run more than one of each receiver|processor|deleter for
 	// each available thread.
 	const instancesPerProc = 3
+	defaultInstances := runtime.GOMAXPROCS(-1) * instancesPerProc
 
-	for i := 0; i < runtime.GOMAXPROCS(-1)*instancesPerProc; i++ {
-		// TODO(antoineco): spawn and terminate receivers dynamically
-		// based on the current amount of messages being processed to
-		// optimize costs generated by ReceiveMessage API requests.
-		// https://github.com/triggermesh/triggermesh/issues/227
+	// TODO(antoineco): spawn and terminate receivers dynamically
+	// based on the current amount of messages being processed to
+	// optimize costs generated by ReceiveMessage API requests.
+	// https://github.com/triggermesh/triggermesh/issues/227
+	for i := 0; i < instancesOrDefault(a.receivers, defaultInstances); i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			a.runMessagesReceiver(msgCtx, queueURL)
 		}()
+	}
 
+	for i := 0; i < instancesOrDefault(a.processors, defaultInstances); i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
 			a.runMessagesProcessor(msgCtx)
 		}()
+	}
 
+	for i := 0; i < defaultInstances; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -260,6 +335,50 @@ func (a *adapter) queueLookup(queueName string) (*sqs.GetQueueUrlOutput, error)
 	})
 }
 
+// defaultVisibilityTimeout sets the visibility timeout of received messages to
+// the visibility timeout of the given queue, so that messages can be extended
+// by that value. The extension is disabled if the queue has no visibility
+// timeout.
+func (a *adapter) defaultVisibilityTimeout(ctx context.Context, queueURL string) error {
+	out, err := a.sqsClient.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       &queueURL,
+		AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameVisibilityTimeout}),
+	})
+	if err != nil {
+		return err
+	}
+
+	vts, err := strconv.ParseInt(aws.StringValue(out.Attributes[sqs.QueueAttributeNameVisibilityTimeout]), 10, 64)
+	if err != nil {
+		return err
+	}
+
+	if vts < 1 {
+		a.logger.Warn("Ignoring visibility timeout extension, which requires a visibility timeout of at least 1s")
+		a.visibilityExt = nil
+		return nil
+	}
+
+	a.visibilityTimeoutSeconds = &vts
+	return nil
+}
+
+// queueLag returns a metrics.ConsumerLagFunc which observes the approximate
+// number of messages available for retrieval from the given queue.
+func (a *adapter) queueLag(queueURL string) metrics.ConsumerLagFunc {
+	return func(ctx context.Context) (int64, error) {
+		out, err := a.sqsClient.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
+			QueueUrl:       &queueURL,
+			AttributeNames: aws.StringSlice([]string{sqs.QueueAttributeNameApproximateNumberOfMessages}),
+		})
+		if err != nil {
+			return 0, err
+		}
+
+		return strconv.ParseInt(aws.StringValue(out.Attributes[sqs.QueueAttributeNameApproximateNumberOfMessages]), 10, 64)
+	}
+}
+
 // prettifyBatchResultErrors returns a pretty string representing a list of
 // batch failures.
 func prettifyBatchResultErrors(errs []*sqs.BatchResultErrorEntry) string {
@@ -290,3 +409,17 @@ func durationInSeconds(d time.Duration) int64 {
 	// the fraction (truncation towards zero)
 	return int64(d.Seconds())
 }
+
+// instancesOrDefault returns the given number of instances if it is positive,
+// or the given default otherwise.
+func instancesOrDefault(n, def int) int {
+	if n > 0 {
+		return n
+	}
+	return def
+}
+
+// isFIFOQueue returns whether the given ARN represents a FIFO queue.
+func isFIFOQueue(arn arn.ARN) bool {
+	return strings.HasSuffix(arn.Resource, ".fifo")
+}
@@ -19,7 +19,9 @@ package awssqssource
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -27,6 +29,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/arn"
 	"github.com/aws/aws-sdk-go/aws/request"
@@ -36,6 +40,8 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
 	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
 
 const (
@@ -64,6 +70,7 @@ func TestAdapter(t *testing.T) {
 	testCases := map[string]struct {
 		numMsgs      int
 		queueBufSize int
+		fifo         bool
 	}{
 		// These test cases ensure the implementation isn't reliant on
 		// specific buffer sizes.
@@ -79,6 +86,11 @@ func TestAdapter(t *testing.T) {
 			numMsgs:      20,
 			queueBufSize: 100,
 		},
+		"ordered message groups": {
+			numMsgs:      20,
+			queueBufSize: 1,
+			fifo:         true,
+		},
 	}
 
 	for name, tc := range testCases {
@@ -95,8 +107,9 @@ func TestAdapter(t *testing.T) {
 			a := adapter{
 				logger: loggingtesting.TestLogger(t),
 
-				mt: mt,
-				sr: mustNewStatsReporter(mt),
+				mt:    mt,
+				sr:    mustNewStatsReporter(mt),
+				lagSr: metrics.MustNewConsumerLagStatsReporter(mt),
 
 				sqsClient: sqsCli,
 				ceClient:  ceCli,
@@ -107,12 +120,17 @@ func TestAdapter(t *testing.T) {
 
 				visibilityTimeoutSeconds: aws.Int64(tVisibilityTimeout),
 
-				processQueue: make(chan *sqs.Message, tc.queueBufSize),
-				deleteQueue:  make(chan *sqs.Message, tc.queueBufSize),
+				deleteQueue: make(chan *sqs.Message, tc.queueBufSize),
 
 				deletePeriod: 5 * time.Millisecond,
 			}
 
+			if tc.fifo {
+				a.groupQueue = make(chan []*sqs.Message, tc.queueBufSize)
+			} else {
+				a.processQueue = make(chan *sqs.Message, tc.queueBufSize)
+			}
+
 			testCtx, testCancel := context.WithTimeout(context.Background(), testTimeout)
 			defer testCancel()
 
@@ -254,6 +272,181 @@ func makeARN(resource string) arn.ARN {
 	}
 }
 
+func TestGroupMessages(t *testing.T) {
+	msgs := makeMockMessages(5)
+	for i, g := range []string{"a", "b", "a", "c", "b"} {
+		msgs[i].Attributes = map[string]*string{
+			sqs.MessageSystemAttributeNameMessageGroupId: aws.String(g),
+		}
+	}
+
+	expect := [][]*sqs.Message{
+		{msgs[0], msgs[2]},
+		{msgs[1], msgs[4]},
+		{msgs[3]},
+	}
+
+	assert.Equal(t, expect, groupMessages(msgs))
+}
+
+func TestProcessMessageGroup(t *testing.T) {
+	msgs := makeMockMessages(4)
+
+	ceCli := adaptertest.NewTestClient()
+	mt := &pkgadapter.MetricTag{}
+
+	a := adapter{
+		logger:   loggingtesting.TestLogger(t),
+		sr:       mustNewStatsReporter(mt),
+		ceClient: ceCli,
+		msgPrcsr: &failingMessageProcessor{
+			MessageProcessor: &defaultMessageProcessor{ceSource: "test"},
+			failID:           *msgs[1].MessageId,
+		},
+		visibilityExt: newVisibilityExtender(),
+		deleteQueue:   make(chan *sqs.Message, len(msgs)),
+	}
+
+	a.visibilityExt.track(msgs...)
+
+	a.processMessageGroup(context.Background(), msgs)
+
+	sentEvents := ceCli.Sent()
+	require.Len(t, sentEvents, 1, "Processing should stop at the first failed message")
+	assert.Equal(t, *msgs[0].MessageId, sentEvents[0].ID())
+
+	require.Len(t, a.deleteQueue, 1, "Only successfully processed messages should be deleted")
+	assert.Equal(t, msgs[0], <-a.deleteQueue)
+
+	assert.Empty(t, a.visibilityExt.snapshot(), "All messages of the group should be untracked")
+}
+
+func TestExtendVisibility(t *testing.T) {
+	const numMsgs = 25
+
+	msgs := makeMockMessages(numMsgs)
+	for i, msg := range msgs {
+		msg.ReceiptHandle = aws.String("handle" + strconv.Itoa(i))
+	}
+
+	sqsCli := &standardMockSQSClient{
+		failVisibilityChangeIDs: map[string]struct{}{
+			*msgs[3].MessageId: {},
+		},
+	}
+
+	a := adapter{
+		logger:                   loggingtesting.TestLogger(t),
+		sqsClient:                sqsCli,
+		visibilityTimeoutSeconds: aws.Int64(tVisibilityTimeout),
+		visibilityExt:            newVisibilityExtender(),
+	}
+
+	a.visibilityExt.track(msgs...)
+
+	a.extendVisibility(context.Background(), tQueueURL)
+
+	reqs := sqsCli.visibilityChangeRequests
+	require.Len(t, reqs, 3, "Expected entries to be split into batches of 10")
+
+	extended := make(map[string]string)
+	for _, req := range reqs {
+		assert.LessOrEqual(t, len(req.Entries), maxChangeVisibilityBatchSize)
+		assert.Equal(t, tQueueURL, *req.QueueUrl)
+
+		for _, e := range req.Entries {
+			assert.EqualValues(t, tVisibilityTimeout, *e.VisibilityTimeout)
+			extended[*e.Id] = *e.ReceiptHandle
+		}
+	}
+
+	assert.Len(t, extended, numMsgs)
+	assert.Equal(t, "handle3", extended[*msgs[3].MessageId])
+
+	tracked := a.visibilityExt.snapshot()
+	assert.Len(t, tracked, numMsgs-1)
+	assert.NotContains(t, tracked, *msgs[3].MessageId, "Failed entries should be untracked")
+}
+
+func TestDefaultVisibilityTimeout(t *testing.T) {
+	testCases := map[string]struct {
+		queueVisibilityTimeout int
+
+		expectTimeout  *int64
+		expectExtender bool
+	}{
+		"Queue with visibility timeout": {
+			queueVisibilityTimeout: 45,
+			expectTimeout:          aws.Int64(45),
+			expectExtender:         true,
+		},
+		"Queue without visibility timeout": {
+			queueVisibilityTimeout: 0,
+			expectTimeout:          nil,
+			expectExtender:         false,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			a := adapter{
+				logger: loggingtesting.TestLogger(t),
+				sqsClient: &standardMockSQSClient{
+					queueVisibilityTimeout: tc.queueVisibilityTimeout,
+				},
+				visibilityExt: newVisibilityExtender(),
+			}
+
+			err := a.defaultVisibilityTimeout(context.Background(), tQueueURL)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectTimeout, a.visibilityTimeoutSeconds)
+			assert.Equal(t, tc.expectExtender, a.visibilityExt != nil)
+		})
+	}
+}
+
+func TestSystemAttributesExtensions(t *testing.T) {
+	msg := &sqs.Message{
+		MessageId: aws.String(tMsgIDPrefix + "001"),
+		Body:      aws.String("test"),
+		Attributes: map[string]*string{
+			sqs.MessageSystemAttributeNameMessageGroupId:          aws.String("my-group"),
+			sqs.MessageSystemAttributeNameMessageDeduplicationId:  aws.String("my-dedup"),
+			sqs.MessageSystemAttributeNameSequenceNumber:          aws.String("18849496460467696128"),
+			sqs.MessageSystemAttributeNameApproximateReceiveCount: aws.String("2"),
+			sqs.MessageSystemAttributeNameSenderId:                aws.String("AIDASSYFHUBOBT7F4XT75"),
+		},
+	}
+
+	event, err := makeSQSEvent(msg, "test")
+	require.NoError(t, err)
+
+	expect := map[string]interface{}{
+		"sqsgroupid":        "my-group",
+		"sqsdedupid":        "my-dedup",
+		"sqssequencenumber": "18849496460467696128",
+		"sqsreceivecount":   "2",
+	}
+	assert.Equal(t, expect, event.Extensions())
+}
+
+// failingMessageProcessor is a MessageProcessor which fails to process the
+// message with the given ID.
+type failingMessageProcessor struct {
+	MessageProcessor
+	failID string
+}
+
+// Process implements MessageProcessor.
+func (p *failingMessageProcessor) Process(msg *sqs.Message) ([]*cloudevents.Event, error) {
+	if *msg.MessageId == p.failID {
+		return nil, errors.New("fake processing error")
+	}
+	return p.MessageProcessor.Process(msg)
+}
+
 // standardMockSQSClient is a mocked SQS client which returns a standard set of
 // responses and never errors.
 type standardMockSQSClient struct {
@@ -268,6 +461,14 @@ type standardMockSQSClient struct {
 	totalDeleted int
 
 	rcvMsgRecorder receiveMessageRequestRecorder
+
+	// default visibility timeout of the queue, in seconds
+	queueVisibilityTimeout int
+
+	// IDs of messages which visibility timeout fails to be changed
+	failVisibilityChangeIDs map[string]struct{}
+	// requests sent to ChangeMessageVisibilityBatch
+	visibilityChangeRequests []*sqs.ChangeMessageVisibilityBatchInput
 }
 
 func (*standardMockSQSClient) GetQueueUrl(*sqs.GetQueueUrlInput) (*sqs.GetQueueUrlOutput, error) { //nolint:golint,stylecheck
@@ -276,6 +477,20 @@ func (*standardMockSQSClient) GetQueueUrl(*sqs.GetQueueUrlInput) (*sqs.GetQueueU
 	}, nil
 }
 
+func (c *standardMockSQSClient) GetQueueAttributesWithContext(_ context.Context,
+	_ *sqs.GetQueueAttributesInput, _ ...request.Option) (*sqs.GetQueueAttributesOutput, error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	return &sqs.GetQueueAttributesOutput{
+		Attributes: map[string]*string{
+			sqs.QueueAttributeNameApproximateNumberOfMessages: aws.String(strconv.Itoa(len(c.availMsgs))),
+			sqs.QueueAttributeNameVisibilityTimeout:           aws.String(strconv.Itoa(c.queueVisibilityTimeout)),
+		},
+	}, nil
+}
+
 func (c *standardMockSQSClient) ReceiveMessageWithContext(_ context.Context,
 	in *sqs.ReceiveMessageInput, _ ...request.Option) (*sqs.ReceiveMessageOutput, error) {
 
@@ -330,6 +545,31 @@ func (c *standardMockSQSClient) DeleteMessageBatchWithContext(_ context.Context,
 	return &sqs.DeleteMessageBatchOutput{}, nil
 }
 
+func (c *standardMockSQSClient) ChangeMessageVisibilityBatchWithContext(_ context.Context,
+	in *sqs.ChangeMessageVisibilityBatchInput, _ ...request.Option) (*sqs.ChangeMessageVisibilityBatchOutput, error) {
+
+	c.Lock()
+	defer c.Unlock()
+
+	c.visibilityChangeRequests = append(c.visibilityChangeRequests, in)
+
+	out := &sqs.ChangeMessageVisibilityBatchOutput{}
+	for _, e := range in.Entries {
+		if _, fail := c.failVisibilityChangeIDs[*e.Id]; fail {
+			out.Failed = append(out.Failed, &sqs.BatchResultErrorEntry{
+				Id:          e.Id,
+				Code:        aws.String(sqs.ErrCodeReceiptHandleIsInvalid),
+				Message:     aws.String("fake error"),
+				SenderFault: aws.Bool(true),
+			})
+			continue
+		}
+		out.Successful = append(out.Successful, &sqs.ChangeMessageVisibilityBatchResultEntry{Id: e.Id})
+	}
+
+	return out, nil
+}
+
 // makeMockMessages returns a set of mocked Messages.
 func makeMockMessages(n int) []*sqs.Message {
 	const receiptHandle = "dHJpZ2dlcm1lc2g="
@@ -25,6 +25,17 @@ import (
 const sqsMgsAttrDataTypeBinary = "Binary"
 const ceExtensionSQSMessagePrefix = "sqsmsg"
 
+// ceExtensionsForSystemAttrs maps SQS message system attributes to the name
+// of the CloudEvents extension attribute they are translated to.
+//
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_Message.html
+var ceExtensionsForSystemAttrs = map[string]string{
+	sqs.MessageSystemAttributeNameMessageGroupId:          "sqsgroupid",
+	sqs.MessageSystemAttributeNameMessageDeduplicationId:  "sqsdedupid",
+	sqs.MessageSystemAttributeNameSequenceNumber:          "sqssequencenumber",
+	sqs.MessageSystemAttributeNameApproximateReceiveCount: "sqsreceivecount",
+}
+
 // ceExtensionAttrsForMessage returns a collection of CloudEvents extension
 // attributes translated from the message attributes of the given SQS message.
 //
@@ -33,14 +44,23 @@ const ceExtensionSQSMessagePrefix = "sqsmsg"
 // followed by the lowercase name of the SQS message attribute, from which all
 // non-alphanumeric characters have been removed (e.g. "sqsmsgmyattribute").
 //
+// Additionally, the system attributes listed in ceExtensionsForSystemAttrs are
+// translated to dedicated extension attributes when present.
+//
 // https://github.com/cloudevents/spec/blob/v1.0.1/spec.md#extension-context-attributes
 func ceExtensionAttrsForMessage(msg *sqs.Message) map[string]interface{} {
-	if len(msg.MessageAttributes) == 0 {
+	if len(msg.MessageAttributes) == 0 && !hasMappedSystemAttrs(msg) {
 		return nil
 	}
 
 	ceExtAttrs := make(map[string]interface{})
 
+	for attr, ext := range ceExtensionsForSystemAttrs {
+		if v, ok := msg.Attributes[attr]; ok && v != nil {
+			ceExtAttrs[ext] = *v
+		}
+	}
+
 	for name, attrVal := range msg.MessageAttributes {
 		if !strings.HasPrefix(*attrVal.DataType, sqsMgsAttrDataTypeBinary) {
 			ceExtAttrs[ceExtensionAttrForMessageAttr(name)] = *attrVal.StringValue
@@ -50,6 +70,17 @@ func ceExtensionAttrsForMessage(msg *sqs.Message) map[string]interface{} {
 	return ceExtAttrs
 }
 
+// hasMappedSystemAttrs returns whether the given SQS message has any system
+// attribute which is translated to a CloudEvent extension attribute.
+func hasMappedSystemAttrs(msg *sqs.Message) bool {
+	for attr := range ceExtensionsForSystemAttrs {
+		if _, ok := msg.Attributes[attr]; ok {
+			return true
+		}
+	}
+	return false
+}
+
 // ceExtensionAttrForMessageAttr sanitizes the name of a SQS message attribute
 // so that it can be used as a CloudEvent context attribute.
 //
@@ -35,7 +35,8 @@ import (
 )
 
 // A message processor processes SQS messages (sends as CloudEvent)
-// sequentially, as soon as they are written to processQueue.
+// sequentially, as soon as they are written to processQueue, or to groupQueue
+// when the order of messages within FIFO message groups is preserved.
 func (a *adapter) runMessagesProcessor(ctx context.Context) {
 	for {
 		select {
@@ -44,33 +45,73 @@ func (a *adapter) runMessagesProcessor(ctx context.Context) {
 
 		case msg := <-a.processQueue:
 			a.sr.reportMessageDequeuedProcessCount()
+			a.processMessage(ctx, msg)
 
-			a.logger.Debugw("Processing message", zap.String(logfieldMsgID, *msg.MessageId))
+		case group := <-a.groupQueue:
+			a.processMessageGroup(ctx, group)
+		}
+	}
+}
 
-			events, err := a.msgPrcsr.Process(msg)
-			if err != nil {
-				a.logger.Errorw("Failed to process SQS message", zap.Error(err),
-					zap.String(logfieldMsgID, *msg.MessageId))
-				continue
-			}
+// processMessageGroup processes the messages from a single message group
+// sequentially. If a message fails to be processed, the processing of the
+// group's remaining messages is skipped, so that SQS can deliver them again in
+// order once their visibility timeout expires.
+func (a *adapter) processMessageGroup(ctx context.Context, group []*sqs.Message) {
+	for i, msg := range group {
+		a.sr.reportMessageDequeuedProcessCount()
 
-			sendError := false
-			for _, event := range events {
-				if err := sendSQSEvent(ctx, a.ceClient, event); err != nil {
-					a.logger.Errorw("Failed to send event to the sink", zap.Error(err))
-					sendError = true
-					break
-				}
-			}
+		if a.processMessage(ctx, msg) {
+			continue
+		}
 
-			if sendError {
-				continue
-			}
+		remaining := group[i+1:]
+		if len(remaining) == 0 {
+			return
+		}
+
+		a.logger.Warnw("Skipping remaining messages of the message group to preserve ordering",
+			zap.Array(logfieldMsgIDs, messageList(remaining)))
+
+		for range remaining {
+			a.sr.reportMessageDequeuedProcessCount()
+		}
+		if a.visibilityExt != nil {
+			a.visibilityExt.untrack(remaining...)
+		}
+
+		return
+	}
+}
+
+// processMessage sends the given SQS message as CloudEvent(s) to the event
+// sink, and enqueues it for deletion upon success. Returns whether the message
+// was processed successfully.
+func (a *adapter) processMessage(ctx context.Context, msg *sqs.Message) bool {
+	if a.visibilityExt != nil {
+		defer a.visibilityExt.untrack(msg)
+	}
+
+	a.logger.Debugw("Processing message", zap.String(logfieldMsgID, *msg.MessageId))
 
-			a.deleteQueue <- msg
-			a.sr.reportMessageEnqueuedDeleteCount()
+	events, err := a.msgPrcsr.Process(msg)
+	if err != nil {
+		a.logger.Errorw("Failed to process SQS message", zap.Error(err),
+			zap.String(logfieldMsgID, *msg.MessageId))
+		return false
+	}
+
+	for _, event := range events {
+		if err := sendSQSEvent(ctx, a.ceClient, event); err != nil {
+			a.logger.Errorw("Failed to send event to the sink", zap.Error(err))
+			return false
 		}
 	}
+
+	a.deleteQueue <- msg
+	a.sr.reportMessageEnqueuedDeleteCount()
+
+	return true
 }
 
 // sendSQSEvent sends a single SQS message as a CloudEvent to the event sink.
@@ -27,6 +27,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/sqs"
 	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/common/health"
 )
 
 const (
@@ -56,10 +58,13 @@ func (a *adapter) runMessagesReceiver(ctx context.Context, queueURL string) {
 			messages, err := receiveMessages(ctx, a.sqsClient, queueURL, a.visibilityTimeoutSeconds)
 			if err != nil {
 				a.logger.Errorw("Failed to get messages from the SQS queue", zap.Error(err))
+				health.MarkDisconnected(err)
 				t.Reset(1 * time.Second)
 				continue
 			}
 
+			health.MarkConnected()
+
 			nextRequestDelay := receiveMsgPeriod
 			if l := len(messages); l > 0 {
 				// keep iterating immediately if any message was
@@ -67,13 +72,28 @@ func (a *adapter) runMessagesReceiver(ctx context.Context, queueURL string) {
 				// processed quickly
 				nextRequestDelay = 0
 
+				health.ReportReceive()
+
 				a.logger.Debugw("Received "+strconv.Itoa(l)+" message(s)",
 					zap.Array(logfieldMsgID, messageList(messages)))
 			}
 
-			for _, msg := range messages {
-				a.processQueue <- msg
-				a.sr.reportMessageEnqueuedProcessCount()
+			if a.visibilityExt != nil {
+				a.visibilityExt.track(messages...)
+			}
+
+			if a.groupQueue != nil {
+				for _, group := range groupMessages(messages) {
+					a.groupQueue <- group
+					for range group {
+						a.sr.reportMessageEnqueuedProcessCount()
+					}
+				}
+			} else {
+				for _, msg := range messages {
+					a.processQueue <- msg
+					a.sr.reportMessageEnqueuedProcessCount()
+				}
 			}
 
 			t.Reset(nextRequestDelay)
@@ -114,3 +134,31 @@ func receiveMessages(ctx context.Context, cli sqsiface.SQSAPI,
 
 	return resp.Messages, nil
 }
+
+// groupMessages partitions the given messages by message group ID, preserving
+// the order in which messages were received within each group and the order
+// in which groups were first encountered.
+//
+// Messages received from a FIFO queue within a single ReceiveMessage call are
+// ordered within their group, and no other receiver can obtain messages from
+// the same group until those become visible again or are deleted.
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/SQSDeveloperGuide/FIFO-queues-understanding-logic.html
+func groupMessages(msgs []*sqs.Message) [][]*sqs.Message {
+	var groups [][]*sqs.Message
+	groupIdx := make(map[string]int)
+
+	for _, msg := range msgs {
+		groupID := aws.StringValue(msg.Attributes[sqs.MessageSystemAttributeNameMessageGroupId])
+
+		i, ok := groupIdx[groupID]
+		if !ok {
+			i = len(groups)
+			groupIdx[groupID] = i
+			groups = append(groups, nil)
+		}
+
+		groups[i] = append(groups[i], msg)
+	}
+
+	return groups
+}
@@ -0,0 +1,183 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqssource
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+)
+
+const (
+	// Highest possible number of entries in a ChangeMessageVisibilityBatch request.
+	// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_ChangeMessageVisibilityBatch.html
+	maxChangeVisibilityBatchSize = 10
+
+	// Calls to ChangeMessageVisibilityBatch are cancelled when they exceed this duration.
+	changeVisibilityRequestTimeout = 10 * time.Second
+)
+
+// visibilityExtender keeps track of messages that were received but not yet
+// processed, in order to extend their visibility timeout periodically.
+// This prevents slow sinks from causing the re-delivery of messages which are
+// still being processed.
+type visibilityExtender struct {
+	mu   sync.Mutex
+	msgs map[ /*MessageId*/ string] /*ReceiptHandle*/ string
+}
+
+// newVisibilityExtender returns an initialized visibilityExtender.
+func newVisibilityExtender() *visibilityExtender {
+	return &visibilityExtender{
+		msgs: make(map[string]string),
+	}
+}
+
+// track registers the given messages for visibility timeout extensions.
+func (e *visibilityExtender) track(msgs ...*sqs.Message) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, msg := range msgs {
+		e.msgs[*msg.MessageId] = *msg.ReceiptHandle
+	}
+}
+
+// untrack stops extending the visibility timeout of the given messages.
+func (e *visibilityExtender) untrack(msgs ...*sqs.Message) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, msg := range msgs {
+		delete(e.msgs, *msg.MessageId)
+	}
+}
+
+// snapshot returns a copy of the currently tracked messages.
+func (e *visibilityExtender) snapshot() messageDeleteBuffer {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	msgs := make(messageDeleteBuffer, len(e.msgs))
+	for id, handle := range e.msgs {
+		msgs[id] = handle
+	}
+
+	return msgs
+}
+
+// untrackIDs stops extending the visibility timeout of the messages with the
+// given IDs.
+func (e *visibilityExtender) untrackIDs(ids ...string) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for _, id := range ids {
+		delete(e.msgs, id)
+	}
+}
+
+// runVisibilityExtender extends the visibility timeout of all tracked messages
+// at half of the configured visibility timeout, until the given context is
+// cancelled.
+func (a *adapter) runVisibilityExtender(ctx context.Context, queueURL string) {
+	vt := time.Duration(*a.visibilityTimeoutSeconds) * time.Second
+
+	t := time.NewTicker(vt / 2)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-t.C:
+			a.extendVisibility(ctx, queueURL)
+		}
+	}
+}
+
+// extendVisibility extends the visibility timeout of all tracked messages.
+func (a *adapter) extendVisibility(ctx context.Context, queueURL string) {
+	msgs := a.visibilityExt.snapshot()
+	if len(msgs) == 0 {
+		return
+	}
+
+	a.logger.Debugw("Extending visibility timeout of messages", zap.Array(logfieldMsgIDs, msgs))
+
+	entries := make([]*sqs.ChangeMessageVisibilityBatchRequestEntry, 0, len(msgs))
+	for id, handle := range msgs {
+		entries = append(entries, &sqs.ChangeMessageVisibilityBatchRequestEntry{
+			Id:                aws.String(id),
+			ReceiptHandle:     aws.String(handle),
+			VisibilityTimeout: a.visibilityTimeoutSeconds,
+		})
+	}
+
+	for len(entries) > 0 {
+		n := maxChangeVisibilityBatchSize
+		if len(entries) < n {
+			n = len(entries)
+		}
+
+		failed, err := changeMessagesVisibility(ctx, a.sqsClient, queueURL, entries[:n])
+		if err != nil {
+			a.logger.Errorw("Failed to extend the visibility timeout of messages", zap.Error(err))
+		}
+		if len(failed) > 0 {
+			// NOTE: Failures typically occur when the receipt
+			// handle of a message has expired, in which case the
+			// message is going to be re-delivered regardless.
+			a.logger.Warnw("Failed to extend the visibility timeout of some messages: " +
+				prettifyBatchResultErrors(failed))
+
+			ids := make([]string, 0, len(failed))
+			for _, f := range failed {
+				ids = append(ids, *f.Id)
+			}
+			a.visibilityExt.untrackIDs(ids...)
+		}
+
+		entries = entries[n:]
+	}
+}
+
+// changeMessagesVisibility changes the visibility timeout of the given
+// messages, and returns the entries which failed to be updated, if any.
+func changeMessagesVisibility(ctx context.Context, cli sqsiface.SQSAPI, queueURL string,
+	entries []*sqs.ChangeMessageVisibilityBatchRequestEntry) ([]*sqs.BatchResultErrorEntry, error) {
+
+	ctx, cancel := context.WithTimeout(ctx, changeVisibilityRequestTimeout)
+	defer cancel()
+
+	out, err := cli.ChangeMessageVisibilityBatchWithContext(ctx, &sqs.ChangeMessageVisibilityBatchInput{
+		QueueUrl: &queueURL,
+		Entries:  entries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Failed, nil
+}
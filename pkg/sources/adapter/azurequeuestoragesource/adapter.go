@@ -33,6 +33,7 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
 
 // envConfig is a set parameters sourced from the environment for the source's
@@ -57,18 +58,22 @@ const (
 
 // adapter implements the source's adapter.
 type adapter struct {
+	queueURL          azqueue.QueueURL
 	messagesURL       azqueue.MessagesURL
 	ceClient          cloudevents.Client
 	eventsource       string
 	visibilityTimeout time.Duration
 	logger            *zap.SugaredLogger
 	mt                *pkgadapter.MetricTag
+	lagSr             *metrics.ConsumerLagStatsReporter
 }
 
 // NewAdapter satisfies pkgadapter.AdapterConstructor.
 func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
 
+	metrics.MustRegisterConsumerLagStatsView()
+
 	mt := &pkgadapter.MetricTag{
 		ResourceGroup: sources.AzureQueueStorageSourceResource.String(),
 		Namespace:     envAcc.GetNamespace(),
@@ -106,12 +111,14 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	}
 
 	return &adapter{
+		queueURL:          queueURL,
 		messagesURL:       messagesURL,
 		ceClient:          ceClient,
 		eventsource:       queueURL.String(),
 		visibilityTimeout: *env.VisibilityTimeout,
 		logger:            logger,
 		mt:                mt,
+		lagSr:             metrics.MustNewConsumerLagStatsReporter(mt),
 	}
 }
 
@@ -123,6 +130,8 @@ func (h *adapter) Start(ctx context.Context) error {
 
 	msgCh := make(chan *azqueue.DequeuedMessage, concurrentMsgProcessing)
 
+	go h.lagSr.Run(ctx, metrics.DefaultConsumerLagPollInterval, h.queueLag)
+
 	h.logger.Info("Starting to process queue events")
 
 	h.processQueueEvents(ctx, msgCh)
@@ -178,6 +187,16 @@ func (h *adapter) processQueueEvents(ctx context.Context, msgCh chan *azqueue.De
 	}
 }
 
+// queueLag observes the approximate number of messages in the queue.
+// It satisfies metrics.ConsumerLagFunc.
+func (h *adapter) queueLag(ctx context.Context) (int64, error) {
+	props, err := h.queueURL.GetProperties(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return int64(props.ApproximateMessagesCount()), nil
+}
+
 func (h *adapter) sendCloudEvent(ctx context.Context, m *azqueue.DequeuedMessage) error {
 	event := cloudevents.NewEvent(cloudevents.VersionV1)
 	event.SetType(v1alpha1.AzureQueueStorageEventType)
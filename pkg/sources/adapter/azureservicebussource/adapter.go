@@ -40,10 +40,12 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus"
+	"github.com/Azure/azure-sdk-for-go/sdk/messaging/azservicebus/admin"
 	"github.com/Azure/go-autorest/autorest/azure"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/azureservicebussource/trace"
 )
 
@@ -101,6 +103,9 @@ type adapter struct {
 	msgRcvr  *azservicebus.Receiver
 	ceClient cloudevents.Client
 
+	lagSr     *metrics.ConsumerLagStatsReporter
+	entityLag metrics.ConsumerLagFunc
+
 	msgPrcsr      MessageProcessor
 	maxConcurrent int
 }
@@ -119,6 +124,8 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		Name:      envAcc.GetName(),
 	}
 
+	metrics.MustRegisterConsumerLagStatsView()
+
 	env := envAcc.(*envConfig)
 
 	entityID, err := parseServiceBusResourceID(env.EntityResourceID)
@@ -132,13 +139,21 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicw("Unable to obtain interface for Service Bus Namespace", zap.Error(err))
 	}
 
+	adminClient, err := adminClientFromEnvironment(entityID)
+	if err != nil {
+		logger.Panicw("Unable to obtain administration interface for Service Bus Namespace", zap.Error(err))
+	}
+
 	var rcvr *azservicebus.Receiver
+	var entityLag metrics.ConsumerLagFunc
 	switch entityID.ResourceType {
 	case resourceTypeQueues:
 		rcvr, err = client.NewReceiverForQueue(entityID.ResourceName, nil)
+		entityLag = queueLag(adminClient, entityID.ResourceName)
 		mt.ResourceGroup = sources.AzureServiceBusQueueSourceResource.String()
 	case resourceTypeSubscriptions, resourceTypeTopics:
 		rcvr, err = client.NewReceiverForSubscription(entityID.ResourceName, entityID.SubResourceName, nil)
+		entityLag = subscriptionLag(adminClient, entityID.ResourceName, entityID.SubResourceName)
 		mt.ResourceGroup = sources.AzureServiceBusTopicSourceResource.String()
 	}
 	if err != nil {
@@ -170,6 +185,9 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		msgRcvr:       rcvr,
 		msgPrcsr:      msgPrcsr,
 		maxConcurrent: env.MaxConcurrent,
+
+		lagSr:     metrics.MustNewConsumerLagStatsReporter(mt),
+		entityLag: entityLag,
 	}
 }
 
@@ -234,14 +252,45 @@ func clientFromEnvironment(entityID *v1alpha1.AzureResourceID, clientOptions *az
 		return nil, fmt.Errorf("unable to create Azure credentials: %w", err)
 	}
 
-	fqNamespace := entityID.Namespace + ".servicebus.windows.net"
-	client, err := azservicebus.NewClient(fqNamespace, cred, clientOptions)
+	client, err := azservicebus.NewClient(fqNamespace(entityID), cred, clientOptions)
 	if err != nil {
 		return nil, fmt.Errorf("creating client from service principal: %w", err)
 	}
 	return client, nil
 }
 
+// adminClientFromEnvironment returns an admin.Client that is suitable for the
+// authentication method selected via environment variables.
+func adminClientFromEnvironment(entityID *v1alpha1.AzureResourceID) (*admin.Client, error) {
+	// SAS authentication (token, connection string)
+	connStr := connectionStringFromEnvironment(entityID.Namespace, entityPath(entityID))
+	if connStr != "" {
+		client, err := admin.NewClientFromConnectionString(connStr, nil)
+		if err != nil {
+			return nil, fmt.Errorf("creating admin client from connection string: %w", err)
+		}
+		return client, nil
+	}
+
+	// AAD authentication (service principal)
+	cred, err := azidentity.NewDefaultAzureCredential(nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Azure credentials: %w", err)
+	}
+
+	client, err := admin.NewClient(fqNamespace(entityID), cred, nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating admin client from service principal: %w", err)
+	}
+	return client, nil
+}
+
+// fqNamespace returns the fully qualified name of the Service Bus Namespace
+// of the given entity.
+func fqNamespace(entityID *v1alpha1.AzureResourceID) string {
+	return entityID.Namespace + ".servicebus.windows.net"
+}
+
 // connectionStringFromEnvironment returns a Service Bus connection string
 // based on values read from the environment.
 func connectionStringFromEnvironment(namespace, entityPath string) string {
@@ -281,6 +330,12 @@ func (a *adapter) Start(ctx context.Context) error {
 	// returning from start.
 	wg := &sync.WaitGroup{}
 
+	wg.Add(1)
+	go func() {
+		a.lagSr.Run(cctx, metrics.DefaultConsumerLagPollInterval, a.entityLag)
+		wg.Done()
+	}()
+
 	// We are communicating with routines via channels.
 	// Create errChan with capacity to deal with the worst case,
 	// which would be one error returned from every routine.
@@ -340,6 +395,38 @@ func (a *adapter) Start(ctx context.Context) error {
 	return nil
 }
 
+// queueLag returns a function which observes the number of active messages
+// in the given queue.
+// The returned function satisfies metrics.ConsumerLagFunc.
+func queueLag(cli *admin.Client, queueName string) metrics.ConsumerLagFunc {
+	return func(ctx context.Context) (int64, error) {
+		resp, err := cli.GetQueueRuntimeProperties(ctx, queueName, nil)
+		if err != nil {
+			return 0, err
+		}
+		if resp == nil {
+			return 0, fmt.Errorf("queue %q not found", queueName)
+		}
+		return int64(resp.ActiveMessageCount), nil
+	}
+}
+
+// subscriptionLag returns a function which observes the number of active
+// messages in the given topic subscription.
+// The returned function satisfies metrics.ConsumerLagFunc.
+func subscriptionLag(cli *admin.Client, topicName, subsName string) metrics.ConsumerLagFunc {
+	return func(ctx context.Context) (int64, error) {
+		resp, err := cli.GetSubscriptionRuntimeProperties(ctx, topicName, subsName, nil)
+		if err != nil {
+			return 0, err
+		}
+		if resp == nil {
+			return 0, fmt.Errorf("subscription %q of topic %q not found", subsName, topicName)
+		}
+		return int64(resp.ActiveMessageCount), nil
+	}
+}
+
 // convenience structure for message processing.
 type fullMessage struct {
 	received     *azservicebus.ReceivedMessage
@@ -28,6 +28,9 @@ const envAWSEndpointURL = "AWS_ENDPOINT_URL"
 
 // EndpointResolver returns a custom endpoints.Resolver which allows users to
 // target API-compatible alternatives to the public AWS cloud.
+//
+// Deprecated: use awsendpoint.Resolver from pkg/adapter/awsendpoint instead,
+// which also supports endpoints declared per AWS service.
 func EndpointResolver(partition string, opts ...func(*endpoints.Options)) endpoints.Resolver {
 	rslvr := func(service, region string, opts ...func(*endpoints.Options)) (endpoints.ResolvedEndpoint, error) {
 		if endpointURLStr := os.Getenv(envAWSEndpointURL); endpointURLStr != "" {
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+)
+
+// Middleware wraps the given adapter constructor so that the outcome of each
+// delivery of an event by the CloudEvents client passed to the adapter is
+// reported in the application's runtime status, which is served by ServeStatus.
+func Middleware(ctor pkgadapter.AdapterConstructor) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, env pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+		ServeStatus(ctx)
+		return ctor(ctx, env, &client{Client: ceClient})
+	}
+}
+
+// client is a cloudevents.Client which reports the outcome of event
+// deliveries.
+type client struct {
+	cloudevents.Client
+}
+
+// Send implements cloudevents.Client.
+func (c *client) Send(ctx context.Context, event cloudevents.Event) protocol.Result {
+	res := c.Client.Send(ctx, event)
+	reportResult(res)
+	return res
+}
+
+// Request implements cloudevents.Client.
+func (c *client) Request(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+	resp, res := c.Client.Request(ctx, event)
+	reportResult(res)
+	return resp, res
+}
+
+// reportResult reports the result of an event delivery.
+func reportResult(res protocol.Result) {
+	if cloudevents.IsACK(res) {
+		ReportSend()
+		return
+	}
+	ReportSendError(res)
+}
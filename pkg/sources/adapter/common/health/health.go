@@ -31,8 +31,11 @@ import (
 
 const healthPath = "/health"
 
+// DefaultPort is the port the health server listens on.
+const DefaultPort = 8080
+
 // Use a var instead of a const to allow tests to override this value.
-var healthPort uint16 = 8080
+var healthPort uint16 = DefaultPort
 
 const gracefulHandlerShutdown = 3 * time.Second
 
@@ -70,9 +73,15 @@ func Start(ctx context.Context) {
 	mux := &http.ServeMux{}
 	mux.Handle(healthPath, &defaultHandler)
 
+	serve(ctx, "health", healthPort, mux)
+}
+
+// serve runs an HTTP server on the given port until the given context is
+// cancelled.
+func serve(ctx context.Context, name string, port uint16, h http.Handler) {
 	server := &http.Server{
-		Addr:    fmt.Sprintf(":%d", healthPort),
-		Handler: mux,
+		Addr:    fmt.Sprintf(":%d", port),
+		Handler: h,
 	}
 
 	errCh := make(chan error)
@@ -83,7 +92,7 @@ func Start(ctx context.Context) {
 
 	handleServerError := func(err error) {
 		if err != http.ErrServerClosed {
-			logging.FromContext(ctx).Errorw("Error during runtime of health server", zap.Error(err))
+			logging.FromContext(ctx).Errorw("Error during runtime of "+name+" server", zap.Error(err))
 		}
 	}
 
@@ -93,7 +102,7 @@ func Start(ctx context.Context) {
 		defer cancel()
 
 		if err := server.Shutdown(ctx); err != nil {
-			logging.FromContext(ctx).Errorw("Error during shutdown of health server", zap.Error(err))
+			logging.FromContext(ctx).Errorw("Error during shutdown of "+name+" server", zap.Error(err))
 		}
 
 		handleServerError(<-errCh)
@@ -0,0 +1,90 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/receiver"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+// ReceiverMiddleware wraps the given adapter constructor so that the outcome
+// of the processing of each event received by the adapter is reported in the
+// application's runtime status, which is served by ServeStatus.
+//
+// It is intended to be used by targets, for which processing an event means
+// delivering it to an external system. Processing fails when the adapter
+// either rejects the event or replies with an event of the error category.
+func ReceiverMiddleware(ctor pkgadapter.AdapterConstructor) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, env pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+		ServeStatus(ctx)
+		return ctor(ctx, env, &receiverClient{Client: ceClient})
+	}
+}
+
+// receiverClient is a cloudevents.Client which reports the outcome of the
+// processing of received events.
+type receiverClient struct {
+	cloudevents.Client
+}
+
+// StartReceiver implements cloudevents.Client.
+func (c *receiverClient) StartReceiver(ctx context.Context, fn interface{}) error {
+	receive, err := receiver.Normalize(fn)
+	if err != nil {
+		return err
+	}
+
+	return c.Client.StartReceiver(ctx, func(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+		resp, res := receive(ctx, e)
+		reportProcessingResult(resp, res)
+		return resp, res
+	})
+}
+
+// reportProcessingResult reports the result of the processing of an event.
+func reportProcessingResult(resp *cloudevents.Event, res protocol.Result) {
+	if !cloudevents.IsACK(res) {
+		ReportSendError(res)
+		return
+	}
+
+	if resp != nil {
+		if cat, _ := resp.Extensions()[targetce.ExtensionCategory].(string); cat == targetce.ExtensionCategoryValueError {
+			ReportSendError(responseError(resp))
+			return
+		}
+	}
+
+	ReportSend()
+}
+
+// responseError returns the error described by the given error response.
+func responseError(resp *cloudevents.Event) error {
+	evErr := &targetce.EventError{}
+	if err := resp.DataAs(evErr); err != nil || evErr.Description == "" {
+		return errors.New("the adapter replied with an error")
+	}
+	return errors.New(evErr.Description)
+}
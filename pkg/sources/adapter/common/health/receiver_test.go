@@ -0,0 +1,128 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/receiver"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+func TestReceiverMiddleware(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errResp := cloudevents.NewEvent()
+	errResp.SetExtension(targetce.ExtensionCategory, targetce.ExtensionCategoryValueError)
+	require.NoError(t, errResp.SetData(cloudevents.ApplicationJSON, &targetce.EventError{Description: "invalid payload"}))
+
+	okResp := cloudevents.NewEvent()
+	okResp.SetExtension(targetce.ExtensionCategory, targetce.ExtensionCategoryValueSuccess)
+
+	testCases := map[string]struct {
+		resp       *cloudevents.Event
+		res        protocol.Result
+		expectSent bool
+		expectErr  string
+	}{
+		"acknowledged without reply": {
+			res:        cloudevents.ResultACK,
+			expectSent: true,
+		},
+		"acknowledged with success reply": {
+			resp:       &okResp,
+			res:        cloudevents.ResultACK,
+			expectSent: true,
+		},
+		"acknowledged with error reply": {
+			resp:      &errResp,
+			res:       cloudevents.ResultACK,
+			expectErr: "invalid payload",
+		},
+		"rejected": {
+			res:       errors.New("service unavailable"),
+			expectErr: "service unavailable",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			defer func() {
+				// reset handler state for other test cases
+				defaultStatusHandler = statusHandler{}
+			}()
+
+			ceCli := &receivingClient{Client: adaptertest.NewTestClient()}
+
+			ctor := ReceiverMiddleware(func(_ context.Context, _ pkgadapter.EnvConfigAccessor, c cloudevents.Client) pkgadapter.Adapter {
+				err := c.StartReceiver(ctx, func(cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+					return tc.resp, tc.res
+				})
+				require.NoError(t, err)
+				return nil
+			})
+			ctor(ctx, nil, ceCli)
+
+			require.NoError(t, ceCli.receive(ctx, cloudevents.NewEvent()))
+
+			st := getStatus(t)
+			assert.Equal(t, tc.expectSent, st.LastSendTime != nil)
+			if tc.expectErr == "" {
+				assert.Nil(t, st.LastSendError)
+			} else {
+				require.NotNil(t, st.LastSendError)
+				assert.Equal(t, tc.expectErr, st.LastSendError.Message)
+			}
+		})
+	}
+}
+
+// receivingClient is a cloudevents.Client which records the receiver function
+// passed to StartReceiver instead of starting a receiver.
+type receivingClient struct {
+	cloudevents.Client
+	fn receiver.Func
+}
+
+// StartReceiver implements cloudevents.Client.
+func (c *receivingClient) StartReceiver(_ context.Context, fn interface{}) error {
+	var err error
+	c.fn, err = receiver.Normalize(fn)
+	return err
+}
+
+// receive passes the given event to the recorded receiver function.
+func (c *receivingClient) receive(ctx context.Context, e cloudevents.Event) error {
+	if c.fn == nil {
+		return errors.New("no receiver was started")
+	}
+	_, _ = c.fn(ctx, e)
+	return nil
+}
@@ -0,0 +1,159 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// StatusPath is the path at which the runtime status of the application is
+// served.
+const StatusPath = healthPath + "/status"
+
+// StatusPort is the port the runtime status of the application is served on.
+// It differs from DefaultPort, on which adapters backed by a Knative Service
+// receive events.
+const StatusPort = 8081
+
+// Use a var instead of a const to allow tests to override this value.
+var statusPort uint16 = StatusPort
+
+// ServeStatus runs the HTTP server which serves the runtime status of the
+// application in the background, unless it is already running.
+func ServeStatus(ctx context.Context) {
+	serveStatusOnce.Do(func() {
+		mux := &http.ServeMux{}
+		mux.Handle(StatusPath, &defaultStatusHandler)
+
+		go serve(ctx, "status", statusPort, mux)
+	})
+}
+
+var serveStatusOnce sync.Once
+
+// Status describes the runtime health of an adapter, as reported by the
+// adapter itself.
+type Status struct {
+	// Whether the adapter is connected to the external system it receives
+	// events from. Nil if the adapter doesn't report its connectivity.
+	Connected *bool `json:"connected,omitempty"`
+	// Last error which occurred while communicating with the external
+	// system.
+	LastError *Error `json:"lastError,omitempty"`
+	// Time at which the last event was received from the external system.
+	LastReceiveTime *time.Time `json:"lastReceiveTime,omitempty"`
+	// Time at which the last event was delivered to the adapter's sink.
+	LastSendTime *time.Time `json:"lastSendTime,omitempty"`
+	// Last error which occurred while delivering an event to the adapter's
+	// sink.
+	LastSendError *Error `json:"lastSendError,omitempty"`
+}
+
+// Error is an error reported by an adapter.
+type Error struct {
+	Message string    `json:"message"`
+	Time    time.Time `json:"time"`
+}
+
+// statusHandler records the runtime status of the application and serves it
+// in JSON format.
+type statusHandler struct {
+	sync.RWMutex
+	status Status
+}
+
+// Verify that statusHandler implements http.Handler.
+var _ http.Handler = (*statusHandler)(nil)
+
+// ServeHTTP implements http.Handler.
+func (h *statusHandler) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	h.RLock()
+	b, err := json.Marshal(h.status)
+	h.RUnlock()
+
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(b)
+}
+
+// update applies the given function to the recorded status.
+func (h *statusHandler) update(fn func(s *Status, now time.Time)) {
+	h.Lock()
+	defer h.Unlock()
+
+	fn(&h.status, now().UTC())
+}
+
+var defaultStatusHandler statusHandler
+
+// Use a var instead of a direct call to time.Now to allow tests to override
+// the current time.
+var now = time.Now
+
+// MarkConnected indicates that the application is connected to the external
+// system it receives events from.
+func MarkConnected() {
+	defaultStatusHandler.update(func(s *Status, _ time.Time) {
+		s.Connected = boolPtr(true)
+	})
+}
+
+// MarkDisconnected indicates that the application failed to communicate with
+// the external system it receives events from.
+func MarkDisconnected(err error) {
+	defaultStatusHandler.update(func(s *Status, t time.Time) {
+		s.Connected = boolPtr(false)
+		s.LastError = &Error{Message: err.Error(), Time: t}
+	})
+}
+
+// ReportReceive indicates that the application received an event from the
+// external system. Receiving an event implies that the application is
+// connected to that system.
+func ReportReceive() {
+	defaultStatusHandler.update(func(s *Status, t time.Time) {
+		s.Connected = boolPtr(true)
+		s.LastReceiveTime = &t
+	})
+}
+
+// ReportSend indicates that the application delivered an event to its sink.
+func ReportSend() {
+	defaultStatusHandler.update(func(s *Status, t time.Time) {
+		s.LastSendTime = &t
+	})
+}
+
+// ReportSendError indicates that the application failed to deliver an event
+// to its sink.
+func ReportSendError(err error) {
+	defaultStatusHandler.update(func(s *Status, t time.Time) {
+		s.LastSendError = &Error{Message: err.Error(), Time: t}
+	})
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
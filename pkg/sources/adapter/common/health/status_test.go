@@ -0,0 +1,144 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+)
+
+func TestStatusHandler(t *testing.T) {
+	currentNow := now
+	defer func() {
+		// reset clock and handler state for other tests, or in case
+		// this test is executed multiple times with -count
+		now = currentNow
+		defaultStatusHandler = statusHandler{}
+	}()
+
+	t0 := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	now = func() time.Time { return t0 }
+
+	assert.Equal(t, Status{}, getStatus(t))
+
+	MarkDisconnected(errors.New("access denied"))
+
+	assert.Equal(t, Status{
+		Connected: boolPtr(false),
+		LastError: &Error{Message: "access denied", Time: t0},
+	}, getStatus(t))
+
+	t1 := t0.Add(time.Minute)
+	now = func() time.Time { return t1 }
+
+	ReportReceive()
+	ReportSendError(errors.New("sink unavailable"))
+
+	assert.Equal(t, Status{
+		Connected:       boolPtr(true),
+		LastError:       &Error{Message: "access denied", Time: t0},
+		LastReceiveTime: &t1,
+		LastSendError:   &Error{Message: "sink unavailable", Time: t1},
+	}, getStatus(t))
+
+	t2 := t1.Add(time.Minute)
+	now = func() time.Time { return t2 }
+
+	ReportSend()
+
+	assert.Equal(t, &t2, getStatus(t).LastSendTime)
+}
+
+func TestMiddleware(t *testing.T) {
+	defer func() {
+		// reset handler state for other tests, or in case this test is
+		// executed multiple times with -count
+		defaultStatusHandler = statusHandler{}
+	}()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ceCli := &failingClient{Client: adaptertest.NewTestClient()}
+
+	var adapterCli cloudevents.Client
+	ctor := Middleware(func(_ context.Context, _ pkgadapter.EnvConfigAccessor, c cloudevents.Client) pkgadapter.Adapter {
+		adapterCli = c
+		return nil
+	})
+	ctor(ctx, nil, ceCli)
+
+	event := cloudevents.NewEvent()
+
+	res := adapterCli.Send(ctx, event)
+	require.True(t, cloudevents.IsACK(res))
+
+	st := getStatus(t)
+	assert.NotNil(t, st.LastSendTime)
+	assert.Nil(t, st.LastSendError)
+
+	ceCli.fail = true
+
+	res = adapterCli.Send(ctx, event)
+	require.False(t, cloudevents.IsACK(res))
+
+	st = getStatus(t)
+	require.NotNil(t, st.LastSendError)
+	assert.Equal(t, "fake delivery failure", st.LastSendError.Message)
+}
+
+// getStatus returns the status served by the default status handler.
+func getStatus(t *testing.T) Status {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	defaultStatusHandler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, StatusPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var st Status
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &st))
+
+	return st
+}
+
+// failingClient is a cloudevents.Client which fails to send events on demand.
+type failingClient struct {
+	cloudevents.Client
+	fail bool
+}
+
+// Send implements cloudevents.Client.
+func (c *failingClient) Send(ctx context.Context, event cloudevents.Event) protocol.Result {
+	if c.fail {
+		return errors.New("fake delivery failure")
+	}
+	return c.Client.Send(ctx, event)
+}
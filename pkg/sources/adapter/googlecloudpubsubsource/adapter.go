@@ -18,8 +18,10 @@ package googlecloudpubsubsource
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"go.uber.org/zap"
@@ -27,11 +29,16 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	monitoring "cloud.google.com/go/monitoring/apiv3/v2"
+	"cloud.google.com/go/monitoring/apiv3/v2/monitoringpb"
 	"cloud.google.com/go/pubsub"
+	"google.golang.org/api/iterator"
 	"google.golang.org/api/option"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
 
 // envConfig is a set parameters sourced from the environment for the source's
@@ -52,6 +59,15 @@ type envConfig struct {
 	//
 	// Supported values: [ default ]
 	MessageProcessor string `envconfig:"GCLOUD_PUBSUB_MESSAGE_PROCESSOR" default:"default"`
+
+	// Flow control settings of the message receiver. Zero values retain
+	// the defaults of the Pub/Sub client library.
+	MaxOutstandingMessages int `envconfig:"GCLOUD_PUBSUB_MAX_OUTSTANDING_MESSAGES"`
+	MaxOutstandingBytes    int `envconfig:"GCLOUD_PUBSUB_MAX_OUTSTANDING_BYTES"`
+
+	// Whether to observe the number of undelivered messages in the
+	// subscription through the Cloud Monitoring API.
+	ReportConsumerLag bool `envconfig:"GCLOUD_PUBSUB_REPORT_CONSUMER_LAG"`
 }
 
 // adapter implements the source's adapter.
@@ -61,6 +77,15 @@ type adapter struct {
 	ceClient cloudevents.Client
 	subs     *pubsub.Subscription
 	msgPrcsr MessageProcessor
+
+	// whether the subscription guarantees exactly-once delivery, in which
+	// case the outcome of acknowledgements is verified
+	exactlyOnce bool
+
+	// only set when the consumer lag is reported
+	subsName  GCloudResourceName
+	metricCli *monitoring.MetricClient
+	lagSr     *metrics.ConsumerLagStatsReporter
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
@@ -94,6 +119,13 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 
 	subsCli := psCli.Subscription(env.SubscriptionResourceName.Resource)
 
+	if env.MaxOutstandingMessages > 0 {
+		subsCli.ReceiveSettings.MaxOutstandingMessages = env.MaxOutstandingMessages
+	}
+	if env.MaxOutstandingBytes > 0 {
+		subsCli.ReceiveSettings.MaxOutstandingBytes = env.MaxOutstandingBytes
+	}
+
 	sub, err := subsCli.Config(ctx)
 	if err != nil {
 		logger.Panicw("Failed to read configuration of Pub/Sub Subscription "+
@@ -126,22 +158,44 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panic("Unsupported message processor " + strconv.Quote(env.MessageProcessor))
 	}
 
-	return &adapter{
+	a := &adapter{
 		logger:   logger,
 		mt:       mt,
 		ceClient: ceClient,
 		subs:     subsCli,
 		msgPrcsr: msgPrcsr,
+
+		exactlyOnce: sub.EnableExactlyOnceDelivery,
 	}
+
+	if env.ReportConsumerLag {
+		metrics.MustRegisterConsumerLagStatsView()
+
+		a.metricCli, err = monitoring.NewMetricClient(ctx, opts...)
+		if err != nil {
+			logger.Panicw("Failed to create Google Cloud Monitoring API client", zap.Error(err))
+		}
+		a.subsName = env.SubscriptionResourceName
+		a.lagSr = metrics.MustNewConsumerLagStatsReporter(mt)
+	}
+
+	return a
 }
 
 // Start implements adapter.Adapter.
 // Required permissions:
 // - pubsub.subscriptions.consume
+// - monitoring.timeSeries.list (only when the consumer lag is reported)
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting message receiver")
 
-	ctx = pkgadapter.ContextWithMetricTag(ctx, a.mt)
+	ctx, cancel := context.WithCancel(pkgadapter.ContextWithMetricTag(ctx, a.mt))
+	defer cancel()
+
+	if a.lagSr != nil {
+		defer a.metricCli.Close()
+		go a.lagSr.Run(ctx, consumerLagPollInterval, a.subscriptionLag)
+	}
 
 	if err := a.subs.Receive(ctx, a.handleMessage); err != nil {
 		return fmt.Errorf("during runtime of message receiver: %w", err)
@@ -156,7 +210,8 @@ func (a *adapter) handleMessage(ctx context.Context, msg *pubsub.Message) {
 	events, err := a.msgPrcsr.Process(msg)
 	if err != nil {
 		a.logger.Errorw("Failed to process Pub/Sub message", zap.Error(err))
-		msg.Nack()
+		a.nack(ctx, msg)
+		return
 	}
 
 	var sendErrs errList
@@ -172,8 +227,78 @@ func (a *adapter) handleMessage(ctx context.Context, msg *pubsub.Message) {
 
 	if len(sendErrs.errs) != 0 {
 		a.logger.Errorw("Failed to send CloudEvents", zap.Error(sendErrs))
+		a.nack(ctx, msg)
+		return
+	}
+
+	a.ack(ctx, msg)
+}
+
+// ack acknowledges the given message. With exactly-once delivery, failures
+// to acknowledge are logged since the message will be redelivered.
+func (a *adapter) ack(ctx context.Context, msg *pubsub.Message) {
+	if !a.exactlyOnce {
+		msg.Ack()
+		return
+	}
+
+	if _, err := msg.AckWithResult().Get(ctx); err != nil {
+		a.logger.Errorw("Failed to acknowledge Pub/Sub message "+strconv.Quote(msg.ID), zap.Error(err))
+	}
+}
+
+// nack negatively acknowledges the given message.
+func (a *adapter) nack(ctx context.Context, msg *pubsub.Message) {
+	if !a.exactlyOnce {
 		msg.Nack()
+		return
+	}
+
+	if _, err := msg.NackWithResult().Get(ctx); err != nil {
+		a.logger.Errorw("Failed to negatively acknowledge Pub/Sub message "+strconv.Quote(msg.ID), zap.Error(err))
+	}
+}
+
+// consumerLagPollInterval is the interval at which the consumer lag is
+// observed. Cloud Monitoring samples Pub/Sub metrics every 60 seconds, so
+// polling more frequently than that would only yield duplicate values.
+const consumerLagPollInterval = time.Minute
+
+// consumerLagLookback is the period within which the most recent sample of the
+// consumer lag is looked up. Samples may become visible in Cloud Monitoring
+// several minutes after being collected.
+const consumerLagLookback = 5 * time.Minute
+
+// metricTypeNumUndelivered is the Cloud Monitoring metric which conveys the
+// number of unacknowledged messages in a Pub/Sub subscription.
+const metricTypeNumUndelivered = "pubsub.googleapis.com/subscription/num_undelivered_messages"
+
+// subscriptionLag observes the number of undelivered messages in the
+// subscription, as reported by Cloud Monitoring.
+// It satisfies metrics.ConsumerLagFunc.
+func (a *adapter) subscriptionLag(ctx context.Context) (int64, error) {
+	now := time.Now()
+
+	it := a.metricCli.ListTimeSeries(ctx, &monitoringpb.ListTimeSeriesRequest{
+		Name: "projects/" + a.subsName.Project,
+		Filter: fmt.Sprintf(`metric.type = %q AND resource.type = "pubsub_subscription" AND `+
+			`resource.labels.subscription_id = %q`, metricTypeNumUndelivered, a.subsName.Resource),
+		Interval: &monitoringpb.TimeInterval{
+			StartTime: timestamppb.New(now.Add(-consumerLagLookback)),
+			EndTime:   timestamppb.New(now),
+		},
+		View: monitoringpb.ListTimeSeriesRequest_FULL,
+	})
+
+	ts, err := it.Next()
+	if err != nil && !errors.Is(err, iterator.Done) {
+		return 0, err
+	}
+
+	// points are returned in reverse time order
+	if points := ts.GetPoints(); len(points) > 0 {
+		return points[0].GetValue().GetInt64Value(), nil
 	}
 
-	msg.Ack()
+	return 0, fmt.Errorf("no sample of %s within the last %s", metricTypeNumUndelivered, consumerLagLookback)
 }
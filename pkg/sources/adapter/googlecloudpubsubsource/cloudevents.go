@@ -22,7 +22,11 @@ import (
 	"cloud.google.com/go/pubsub"
 )
 
-const ceExtensionPubSubMessagePrefix = "pubsubmsg"
+const (
+	ceExtensionPubSubMessagePrefix   = "pubsubmsg"
+	ceExtensionPubSubOrderingKey     = "pubsuborderingkey"
+	ceExtensionPubSubDeliveryAttempt = "pubsubdeliveryattempt"
+)
 
 // ceExtensionAttrsForMessage returns a collection of CloudEvents extension
 // attributes translated from the message attributes of the given Pub/Sub message.
@@ -31,9 +35,13 @@ const ceExtensionPubSubMessagePrefix = "pubsubmsg"
 // followed by the lowercase name of the Pub/Sub message attribute, from which all
 // non-alphanumeric characters have been removed (e.g. "pubsubmsgmyattribute").
 //
+// The ordering key and delivery attempt of the message, when set, are
+// translated to the 'pubsuborderingkey' and 'pubsubdeliveryattempt' extension
+// attributes respectively.
+//
 // https://github.com/cloudevents/spec/blob/v1.0.1/spec.md#extension-context-attributes
 func ceExtensionAttrsForMessage(msg *pubsub.Message) map[string]interface{} {
-	if len(msg.Attributes) == 0 {
+	if len(msg.Attributes) == 0 && msg.OrderingKey == "" && msg.DeliveryAttempt == nil {
 		return nil
 	}
 
@@ -43,6 +51,15 @@ func ceExtensionAttrsForMessage(msg *pubsub.Message) map[string]interface{} {
 		ceExtAttrs[ceExtensionAttrForMessageAttr(name)] = attrVal
 	}
 
+	if msg.OrderingKey != "" {
+		ceExtAttrs[ceExtensionPubSubOrderingKey] = msg.OrderingKey
+	}
+
+	// only set on subscriptions which have a dead-letter policy
+	if msg.DeliveryAttempt != nil {
+		ceExtAttrs[ceExtensionPubSubDeliveryAttempt] = *msg.DeliveryAttempt
+	}
+
 	return ceExtAttrs
 }
 
@@ -62,6 +62,29 @@ func TestProcessMessageDefault(t *testing.T) {
 	assert.NotContains(t, eventExts, "subject")
 }
 
+func TestProcessMessageDeliveryMetadata(t *testing.T) {
+	testData := fakePubSubMessage()
+	testData.OrderingKey = "customer-42"
+	deliveryAttempt := 3
+	testData.DeliveryAttempt = &deliveryAttempt
+
+	msgPrcsr := &defaultMessageProcessor{
+		ceSource: "fake.source",
+		ceType:   "fake.type",
+	}
+
+	events, err := msgPrcsr.Process(testData)
+
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+
+	eventExts := events[0].Extensions()
+
+	assert.Equal(t, "customer-42", eventExts["pubsuborderingkey"])
+	assert.EqualValues(t, 3, eventExts["pubsubdeliveryattempt"])
+	assert.Contains(t, eventExts, "pubsubmsgsomething")
+}
+
 // fakePubSubMessage returns a Pub/Sub message to be used in tests.
 func fakePubSubMessage() *pubsub.Message {
 	return &pubsub.Message{
@@ -33,6 +33,7 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/ibmmqsource/mq"
 )
 
@@ -42,6 +43,7 @@ type ibmmqsourceAdapter struct {
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 	mt       *pkgadapter.MetricTag
+	lagSr    *metrics.ConsumerLagStatsReporter
 
 	mqEnvs *SourceEnvAccessor
 }
@@ -50,6 +52,8 @@ type ibmmqsourceAdapter struct {
 func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
 
+	metrics.MustRegisterConsumerLagStatsView()
+
 	mt := &pkgadapter.MetricTag{
 		ResourceGroup: sources.IBMMQSourceResource.String(),
 		Namespace:     envAcc.GetNamespace(),
@@ -62,6 +66,7 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		ceClient: ceClient,
 		logger:   logger,
 		mt:       mt,
+		lagSr:    metrics.MustNewConsumerLagStatsReporter(mt),
 		mqEnvs:   env,
 	}
 }
@@ -96,7 +101,24 @@ func (a *ibmmqsourceAdapter) Start(ctx context.Context) error {
 	}
 	defer queue.StopCallback(conn)
 
-	<-ctx.Done()
+	// The queue depth is inquired on a dedicated connection, which is only
+	// closed once the lag reporter has returned.
+	depthConn, err := mq.NewConnection(a.mqEnvs.ConnectionConfig, a.mqEnvs.Auth)
+	if err != nil {
+		return fmt.Errorf("failed to create IBM MQ connection for inquiries: %w", err)
+	}
+	defer depthConn.Disc()
+
+	depth, err := mq.OpenQueueDepth(a.mqEnvs.ConnectionConfig.QueueName, depthConn)
+	if err != nil {
+		return fmt.Errorf("failed to open IBM MQ queue for inquiries: %w", err)
+	}
+	defer depth.Close()
+
+	a.lagSr.Run(ctx, metrics.DefaultConsumerLagPollInterval, func(context.Context) (int64, error) {
+		return depth.Current()
+	})
+
 	return nil
 }
 
@@ -19,6 +19,7 @@ limitations under the License.
 package mq
 
 import (
+	"fmt"
 	"strings"
 	"unicode"
 
@@ -43,6 +44,11 @@ type Object struct {
 	mqcbd *ibmmq.MQCBD
 }
 
+// Depth is a handle used to inquire the depth of a queue.
+type Depth struct {
+	queue *ibmmq.MQObject
+}
+
 // Handler is a function used as IBM MQ callback.
 type Handler func([]byte, string) error
 
@@ -139,6 +145,42 @@ func OpenQueue(queueName string, dlqName string, conn ibmmq.MQQueueManager) (Obj
 	return res, nil
 }
 
+// OpenQueueDepth opens IBM MQ queue for inquiring its depth.
+// No other MQI call is allowed on a connection which consumes messages
+// asynchronously, so the given connection must be dedicated to inquiries.
+func OpenQueueDepth(queueName string, conn ibmmq.MQQueueManager) (Depth, error) {
+	mqod := ibmmq.NewMQOD()
+	mqod.ObjectType = ibmmq.MQOT_Q
+	mqod.ObjectName = queueName
+
+	qObject, err := conn.Open(mqod, ibmmq.MQOO_INQUIRE)
+	if err != nil {
+		return Depth{}, err
+	}
+
+	return Depth{queue: &qObject}, nil
+}
+
+// Current returns the number of messages in the queue.
+func (d Depth) Current() (int64, error) {
+	attrs, err := d.queue.Inq([]int32{ibmmq.MQIA_CURRENT_Q_DEPTH})
+	if err != nil {
+		return 0, err
+	}
+
+	depth, ok := attrs[ibmmq.MQIA_CURRENT_Q_DEPTH].(int32)
+	if !ok {
+		return 0, fmt.Errorf("unexpected value of queue depth attribute: %v", attrs[ibmmq.MQIA_CURRENT_Q_DEPTH])
+	}
+
+	return int64(depth), nil
+}
+
+// Close closes the queue.
+func (d Depth) Close() error {
+	return d.queue.Close(0)
+}
+
 // RegisterCallback registers the callback function for the incoming messages in the target queue.
 func (q *Object) RegisterCallback(f Handler, delivery Delivery, logger *zap.SugaredLogger) error {
 	handler := func(
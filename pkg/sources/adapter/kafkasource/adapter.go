@@ -33,6 +33,7 @@ import (
 	"knative.dev/pkg/logging"
 
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
 
 const (
@@ -57,6 +58,10 @@ type kafkasourceAdapter struct {
 
 	kafkaClient sarama.ConsumerGroup
 	topic       string
+
+	lagSr    *metrics.ConsumerLagStatsReporter
+	lagFn    metrics.ConsumerLagFunc
+	lagAdmin sarama.ClusterAdmin
 }
 
 // NewAdapter satisfies pkgadapter.AdapterConstructor.
@@ -64,8 +69,10 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	logger := logging.FromContext(ctx)
 	sarama.Logger = zap.NewStdLog(logger.Named("sarama").Desugar())
 
+	metrics.MustRegisterConsumerLagStatsView()
+
 	mt := &pkgadapter.MetricTag{
-		ResourceGroup: sources.CloudEventsSourceResource.String(),
+		ResourceGroup: sources.KafkaSourceResource.String(),
 		Namespace:     envAcc.GetNamespace(),
 		Name:          envAcc.GetName(),
 	}
@@ -144,10 +151,25 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 		logger.Panicw("Error creating Kafka Consumer Group", zap.Error(err))
 	}
 
+	// Consumer groups don't expose their lag, so we observe it using a
+	// separate client.
+	lagCli, err := sarama.NewClient(env.BootstrapServers, config)
+	if err != nil {
+		logger.Panicw("Error creating Kafka client", zap.Error(err))
+	}
+	lagAdmin, err := sarama.NewClusterAdminFromClient(lagCli)
+	if err != nil {
+		logger.Panicw("Error creating Kafka cluster admin", zap.Error(err))
+	}
+
 	return &kafkasourceAdapter{
 		kafkaClient: kc,
 		topic:       env.Topic,
 
+		lagSr:    metrics.MustNewConsumerLagStatsReporter(mt),
+		lagFn:    consumerGroupLag(lagCli, lagAdmin, env.GroupID, env.Topic, config.Consumer.Offsets.Initial),
+		lagAdmin: lagAdmin,
+
 		ceClient: ceClient,
 		logger:   logger,
 		mt:       mt,
@@ -161,6 +183,22 @@ func (a *kafkasourceAdapter) Start(ctx context.Context) error {
 		adapter: a,
 	}
 
+	lagCtx, stopLag := context.WithCancel(ctx)
+	lagDone := make(chan struct{})
+	go func() {
+		defer close(lagDone)
+		a.lagSr.Run(lagCtx, metrics.DefaultConsumerLagPollInterval, a.lagFn)
+	}()
+
+	// The cluster admin also closes the client it was created from.
+	defer func() {
+		stopLag()
+		<-lagDone
+		if err := a.lagAdmin.Close(); err != nil {
+			a.logger.Errorw("Error closing Kafka cluster admin", zap.Error(err))
+		}
+	}()
+
 	errorList := NewStaleList(errorAccumulationTolerance)
 
 	// while the context is not done, run the loop.
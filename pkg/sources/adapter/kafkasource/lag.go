@@ -0,0 +1,76 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kafkasource
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+)
+
+// consumerGroupLag returns a metrics.ConsumerLagFunc which observes the number
+// of messages of the given topic that were not yet consumed by the given
+// consumer group, across all partitions.
+//
+// Partitions for which the consumer group has not committed any offset yet
+// are only accounted for when the consumer group starts consuming from the
+// oldest offset, since newer messages are otherwise never consumed.
+func consumerGroupLag(cli sarama.Client, admin sarama.ClusterAdmin, group, topic string, initial int64) metrics.ConsumerLagFunc {
+	return func(context.Context) (int64, error) {
+		partitions, err := cli.Partitions(topic)
+		if err != nil {
+			return 0, fmt.Errorf("listing partitions of topic %q: %w", topic, err)
+		}
+
+		offsets, err := admin.ListConsumerGroupOffsets(group, map[string][]int32{topic: partitions})
+		if err != nil {
+			return 0, fmt.Errorf("listing offsets of consumer group %q: %w", group, err)
+		}
+
+		var lag int64
+
+		for _, p := range partitions {
+			newest, err := cli.GetOffset(topic, p, sarama.OffsetNewest)
+			if err != nil {
+				return 0, fmt.Errorf("getting newest offset of partition %d: %w", p, err)
+			}
+
+			committed := int64(-1)
+			if b := offsets.GetBlock(topic, p); b != nil {
+				committed = b.Offset
+			}
+
+			if committed < 0 {
+				if initial != sarama.OffsetOldest {
+					continue
+				}
+				if committed, err = cli.GetOffset(topic, p, sarama.OffsetOldest); err != nil {
+					return 0, fmt.Errorf("getting oldest offset of partition %d: %w", p, err)
+				}
+			}
+
+			if newest > committed {
+				lag += newest - committed
+			}
+		}
+
+		return lag, nil
+	}
+}
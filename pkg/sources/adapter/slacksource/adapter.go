@@ -26,6 +26,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 )
 
@@ -50,7 +51,10 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	}
 }
 
-var _ pkgadapter.Adapter = (*slackAdapter)(nil)
+var (
+	_ pkgadapter.Adapter = (*slackAdapter)(nil)
+	_ secrets.Reloader   = (*slackAdapter)(nil)
+)
 
 type slackAdapter struct {
 	handler SlackEventAPIHandler
@@ -64,3 +68,8 @@ func (a *slackAdapter) Start(ctx context.Context) error {
 
 	return a.handler.Start(ctx)
 }
+
+// ReloadSecrets implements secrets.Reloader.
+func (a *slackAdapter) ReloadSecrets(ctx context.Context, changed map[string]string) error {
+	return a.handler.ReloadSecrets(ctx, changed)
+}
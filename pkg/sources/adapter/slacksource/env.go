@@ -25,6 +25,9 @@ func NewEnvConfig() adapter.EnvConfigAccessor {
 	return &envAccessor{}
 }
 
+// Name of the environment variable which contains the signing secret.
+const envSigningSecret = "SLACK_SIGNING_SECRET"
+
 type envAccessor struct {
 	adapter.EnvConfig
 	AppID         string `envconfig:"SLACK_APP_ID"`
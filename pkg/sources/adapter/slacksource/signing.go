@@ -46,9 +46,10 @@ func (standardTime) Now() time.Time {
 
 var _ timeWrap = (*standardTime)(nil)
 
-// verifySigning using signature headers and request body hash.
+// verifySigning using the given signing secret, signature headers and request
+// body hash.
 // see: https://api.slack.com/authentication/verifying-requests-from-slack
-func (h *slackEventAPIHandler) verifySigning(header http.Header, body []byte) error {
+func (h *slackEventAPIHandler) verifySigning(signingSecret string, header http.Header, body []byte) error {
 	signature := sanitizeUserInput(header.Get(signatureHeader))
 	if signature == "" {
 		return errors.New("empty signature header")
@@ -74,7 +75,7 @@ func (h *slackEventAPIHandler) verifySigning(header http.Header, body []byte) er
 	}
 
 	signString := "v0:" + timestamp + ":" + string(body)
-	hm := hmac.New(sha256.New, []byte(h.signingSecret))
+	hm := hmac.New(sha256.New, []byte(signingSecret))
 	if _, err := hm.Write([]byte(signString)); err != nil {
 		return fmt.Errorf("error writing signing string into hmac: %w", err)
 	}
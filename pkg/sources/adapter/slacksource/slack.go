@@ -25,11 +25,13 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
 	"go.uber.org/zap"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
 )
 
@@ -38,6 +40,7 @@ const apiAppIDCeExtension = "comslackapiappid"
 // SlackEventAPIHandler listen for Slack API Events
 type SlackEventAPIHandler interface {
 	Start(ctx context.Context) error
+	secrets.Reloader
 }
 
 type slackEventAPIHandler struct {
@@ -45,6 +48,9 @@ type slackEventAPIHandler struct {
 	signingSecret string
 	appID         string
 
+	// guards signingSecret, which can be reloaded
+	m sync.RWMutex
+
 	ceClient cloudevents.Client
 	srv      *http.Server
 
@@ -107,8 +113,12 @@ func (h *slackEventAPIHandler) handleAll(ctx context.Context) http.HandlerFunc {
 			return
 		}
 
-		if h.signingSecret != "" {
-			err = h.verifySigning(r.Header, body)
+		h.m.RLock()
+		signingSecret := h.signingSecret
+		h.m.RUnlock()
+
+		if signingSecret != "" {
+			err = h.verifySigning(signingSecret, r.Header, body)
 			if err != nil {
 				h.handleError(err, http.StatusUnauthorized, w)
 				return
@@ -149,6 +159,21 @@ func (h *slackEventAPIHandler) handleAll(ctx context.Context) http.HandlerFunc {
 	}
 }
 
+// ReloadSecrets implements secrets.Reloader.
+func (h *slackEventAPIHandler) ReloadSecrets(_ context.Context, changed map[string]string) error {
+	for name, val := range changed {
+		if name != envSigningSecret {
+			return fmt.Errorf("reloading the value of %s is not supported", name)
+		}
+
+		h.m.Lock()
+		h.signingSecret = val
+		h.m.Unlock()
+	}
+
+	return nil
+}
+
 func (h *slackEventAPIHandler) gracefulShutdown(stopCh <-chan struct{}, done chan<- bool) {
 	<-stopCh
 	h.logger.Debug("Server is shutting down...")
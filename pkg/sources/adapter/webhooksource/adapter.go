@@ -24,6 +24,7 @@ import (
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 	"knative.dev/pkg/logging"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 )
 
@@ -51,4 +52,7 @@ func NewAdapter(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClie
 	}
 }
 
-var _ pkgadapter.Adapter = (*webhookHandler)(nil)
+var (
+	_ pkgadapter.Adapter = (*webhookHandler)(nil)
+	_ secrets.Reloader   = (*webhookHandler)(nil)
+)
@@ -28,6 +28,10 @@ func NewEnvConfig() pkgadapter.EnvConfigAccessor {
 	return &envAccessor{}
 }
 
+// Name of the environment variable which contains the Basic Authentication
+// password.
+const envBasicAuthPassword = "WEBHOOK_BASICAUTH_PASSWORD"
+
 type envAccessor struct {
 	pkgadapter.EnvConfig
 
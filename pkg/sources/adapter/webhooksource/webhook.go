@@ -23,6 +23,7 @@ import (
 	"io"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -48,6 +49,9 @@ type webhookHandler struct {
 	password                string
 	corsAllowOrigin         string
 
+	// guards password, which can be reloaded
+	m sync.RWMutex
+
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 	mt       *pkgadapter.MetricTag
@@ -104,6 +108,21 @@ func runHandler(ctx context.Context, s *http.Server) error {
 	}
 }
 
+// ReloadSecrets implements secrets.Reloader.
+func (h *webhookHandler) ReloadSecrets(_ context.Context, changed map[string]string) error {
+	for name, val := range changed {
+		if name != envBasicAuthPassword {
+			return fmt.Errorf("reloading the value of %s is not supported", name)
+		}
+
+		h.m.Lock()
+		h.password = val
+		h.m.Unlock()
+	}
+
+	return nil
+}
+
 // handleAll receives all webhook events at a single resource, it
 // is up to this function to parse event wrapper and dispatch.
 func (h *webhookHandler) handleAll(ctx context.Context) http.HandlerFunc {
@@ -117,13 +136,17 @@ func (h *webhookHandler) handleAll(ctx context.Context) http.HandlerFunc {
 			return
 		}
 
-		if h.username != "" && h.password != "" {
+		h.m.RLock()
+		password := h.password
+		h.m.RUnlock()
+
+		if h.username != "" && password != "" {
 			us, ps, ok := r.BasicAuth()
 			if !ok {
 				h.handleError(errors.New("wrong authentication header"), http.StatusBadRequest, w)
 				return
 			}
-			if us != h.username || ps != h.password {
+			if us != h.username || ps != password {
 				h.handleError(errors.New("credentials are not valid"), http.StatusUnauthorized, w)
 				return
 			}
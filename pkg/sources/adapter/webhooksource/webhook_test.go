@@ -240,6 +240,47 @@ func TestWebhookEvent(t *testing.T) {
 	}
 }
 
+func TestWebhookReloadSecrets(t *testing.T) {
+	replierFn := func(inMessage event.Event) (*event.Event, protocol.Result) {
+		return nil, protocol.ResultACK
+	}
+	ceClient, _ := cloudeventst.NewMockRequesterClient(t, 10, replierFn, cloudevents.WithTimeNow(), cloudevents.WithUUIDs())
+
+	handler := &webhookHandler{
+		eventType:   tEventType,
+		eventSource: tEventSource,
+		username:    "foo",
+		password:    "bar",
+
+		ceClient: ceClient,
+		logger:   zapt.NewLogger(t).Sugar(),
+	}
+
+	th := http.HandlerFunc(handler.handleAll(context.Background()))
+
+	// serve returns the response code of a request authenticated with the
+	// given password.
+	serve := func(password string) int {
+		req, _ := http.NewRequest(http.MethodGet, "/", read(`{"test":"reload"}`))
+		req.Header.Add("Authorization", basicAuth("foo", password))
+
+		rr := httptest.NewRecorder()
+		th.ServeHTTP(rr, req)
+		return rr.Code
+	}
+
+	assert.Equal(t, http.StatusNoContent, serve("bar"))
+
+	err := handler.ReloadSecrets(context.Background(), map[string]string{envBasicAuthPassword: "baz"})
+	assert.NoError(t, err)
+
+	assert.Equal(t, http.StatusUnauthorized, serve("bar"), "Expected previous password to be rejected")
+	assert.Equal(t, http.StatusNoContent, serve("baz"), "Expected reloaded password to be accepted")
+
+	err = handler.ReloadSecrets(context.Background(), map[string]string{"WEBHOOK_UNKNOWN": "x"})
+	assert.Error(t, err, "Expected changes to other values to be rejected")
+}
+
 func read(s string) io.Reader {
 	return strings.NewReader(s)
 }
@@ -18,6 +18,7 @@ package aws
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +33,13 @@ import (
 // Credentials returns the AWS security credentials referenced in a source's
 // spec, using the provided Secrets client if necessary.
 func Credentials(cli coreclientv1.SecretInterface, creds *v1alpha1.AWSSecurityCredentials) (*credentials.Value, error) {
+	for _, vf := range []v1alpha1.ValueFromField{creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken} {
+		if vf.ValueFromSecret == nil && (vf.ValueFromFile != "" || vf.ValueFromProvider != nil) {
+			return nil, errors.New("AWS credentials read from files or secret providers are not supported " +
+				"by the controller, use a literal value or a reference to a Kubernetes Secret")
+		}
+	}
+
 	accessKeyID := creds.AccessKeyID.Value
 	secretAccessKey := creds.SecretAccessKey.Value
 	sessionToken := creds.SessionToken.Value
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aws
+
+import (
+	awscore "github.com/aws/aws-sdk-go/aws"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+)
+
+// WithEndpoint applies the AWS endpoint customizations set in a component's
+// spec to the given AWS client configuration. The URL of the endpoint applies
+// to the given primary AWS services of the component only, designated by
+// their endpoint ID.
+func WithEndpoint(config *awscore.Config, endpoint *v1alpha1.AWSEndpoint, primaryServices ...string) *awscore.Config {
+	if endpoint == nil {
+		return config
+	}
+
+	if urls := endpoint.URLsByService(primaryServices...); len(urls) > 0 {
+		config = config.WithEndpointResolver(awsendpoint.NewResolver(urls))
+	}
+	if endpoint.S3ForcePathStyle {
+		config = config.WithS3ForcePathStyle(true)
+	}
+
+	return config
+}
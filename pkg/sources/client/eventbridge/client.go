@@ -67,7 +67,7 @@ var _ ClientGetter = (*ClientGetterWithSecretGetter)(nil)
 // Get implements ClientGetter.
 func (g *ClientGetterWithSecretGetter) Get(src *v1alpha1.AWSEventBridgeSource) (Client, SQSClient, error) {
 	var sess *session.Session
-	config := &awscore.Config{}
+	config := aws.WithEndpoint(&awscore.Config{}, src.Spec.Endpoint, eventbridge.EndpointsID)
 
 	switch {
 	case src.Spec.Auth.Credentials != nil:
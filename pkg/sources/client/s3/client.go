@@ -73,7 +73,7 @@ var _ ClientGetter = (*ClientGetterWithSecretGetter)(nil)
 // Get implements ClientGetter.
 func (g *ClientGetterWithSecretGetter) Get(src *v1alpha1.AWSS3Source) (Client, SQSClient, error) {
 	var sess *session.Session
-	config := &awscore.Config{}
+	config := aws.WithEndpoint(&awscore.Config{}, src.Spec.Endpoint, s3.EndpointsID)
 
 	var creds *credentials.Value
 	var err error
@@ -62,7 +62,7 @@ var _ ClientGetter = (*ClientGetterWithSecretGetter)(nil)
 // Get implements ClientGetter.
 func (g *ClientGetterWithSecretGetter) Get(src *v1alpha1.AWSSNSSource) (Client, error) {
 	var sess *session.Session
-	config := &awscore.Config{}
+	config := aws.WithEndpoint(&awscore.Config{}, src.Spec.Endpoint, sns.EndpointsID)
 
 	switch {
 	case src.Spec.Auth.Credentials != nil:
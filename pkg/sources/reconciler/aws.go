@@ -43,22 +43,3 @@ func MakeAWSAuthEnvVars(auth v1alpha1.AWSAuth) []corev1.EnvVar {
 
 	return authEnvVars
 }
-
-// MakeAWSEndpointEnvVars returns environment variables for the given AWS
-// endpoint parameters.
-func MakeAWSEndpointEnvVars(endpoint *v1alpha1.AWSEndpoint) []corev1.EnvVar {
-	if endpoint == nil {
-		return nil
-	}
-
-	var endpointEnvVars []corev1.EnvVar
-
-	if url := endpoint.URL; url != nil {
-		endpointEnvVars = append(endpointEnvVars, corev1.EnvVar{
-			Name:  reconciler.EnvEndpointURL,
-			Value: url.String(),
-		})
-	}
-
-	return endpointEnvVars
-}
@@ -19,6 +19,8 @@ package awscloudwatchlogssource
 import (
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -73,7 +75,10 @@ func MakeAppEnv(o *v1alpha1.AWSCloudWatchLogsSource) []corev1.EnvVar {
 		pollingInterval = time.Duration(*f)
 	}
 
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, cloudwatchlogs.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/cloudwatch"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -93,7 +95,10 @@ func MakeAppEnv(o *v1alpha1.AWSCloudWatchSource) ([]corev1.EnvVar, error) {
 		pollingInterval = time.Duration(*f)
 	}
 
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, cloudwatch.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  envRegion,
@@ -19,6 +19,8 @@ package awscodecommitsource
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go/service/codecommit"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -69,7 +71,10 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSCodeCommitSource) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, codecommit.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -17,6 +17,9 @@ limitations under the License.
 package awscognitoidentitysource
 
 import (
+	"github.com/aws/aws-sdk-go/service/cognitoidentity"
+	"github.com/aws/aws-sdk-go/service/cognitosync"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -62,7 +65,10 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSCognitoIdentitySource) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, cognitoidentity.EndpointsID, cognitosync.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
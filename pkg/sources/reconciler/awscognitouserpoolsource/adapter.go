@@ -17,6 +17,8 @@ limitations under the License.
 package awscognitouserpoolsource
 
 import (
+	"github.com/aws/aws-sdk-go/service/cognitoidentityprovider"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -62,7 +64,10 @@ func (r *Reconciler) BuildAdapter(src commonv1alpha1.Reconcilable, sinkURI *apis
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSCognitoUserPoolSource) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, cognitoidentityprovider.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -17,6 +17,8 @@ limitations under the License.
 package awseventbridgesource
 
 import (
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -73,7 +75,10 @@ func MakeAppEnv(o *v1alpha1.AWSEventBridgeSource) []corev1.EnvVar {
 		queueARN = qa.String()
 	}
 
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, eventbridge.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
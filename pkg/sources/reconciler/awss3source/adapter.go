@@ -17,6 +17,8 @@ limitations under the License.
 package awss3source
 
 import (
+	"github.com/aws/aws-sdk-go/service/s3"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -72,7 +74,10 @@ func MakeAppEnv(o *v1alpha1.AWSS3Source) []corev1.EnvVar {
 	if qa := o.Status.QueueARN; qa != nil {
 		queueARN = qa.String()
 	}
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, s3.EndpointsID)...)
+
+	return append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
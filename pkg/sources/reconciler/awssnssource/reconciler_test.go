@@ -42,6 +42,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/service/sns"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
@@ -144,6 +145,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -168,6 +170,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -189,6 +192,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed, deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -214,6 +218,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed, deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -239,6 +244,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -264,6 +270,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -292,6 +299,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledConfigWatchRoleBinding(),
 				newReconciledMTAdapterRoleBinding(),
 				newReconciledAdapter(),
@@ -406,6 +414,12 @@ func newReconciledServiceAccount() *corev1.ServiceAccount {
 	return NewServiceAccount(newEventSource())()
 }
 
+// newReconciledEventTypeCatalog returns a test EventTypeCatalog object that is
+// identical to what ReconcileKind generates.
+func newReconciledEventTypeCatalog() *catalogv1alpha1.EventTypeCatalog {
+	return NewEventTypeCatalog(newEventSource())()
+}
+
 // newReconciledConfigWatchRoleBinding returns a test config watcher
 // RoleBinding object that is identical to what ReconcileKind generates.
 func newReconciledConfigWatchRoleBinding() *rbacv1.RoleBinding {
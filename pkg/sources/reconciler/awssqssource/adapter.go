@@ -17,6 +17,10 @@ limitations under the License.
 package awssqssource
 
 import (
+	"strconv"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -30,7 +34,14 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/sources/reconciler"
 )
 
-const envMessageProcessor = "SQS_MESSAGE_PROCESSOR"
+const (
+	envMessageProcessor          = "SQS_MESSAGE_PROCESSOR"
+	envVisibilityTimeout         = "SQS_VISIBILITY_TIMEOUT"
+	envExtendVisibilityTimeout   = "SQS_EXTEND_VISIBILITY_TIMEOUT"
+	envReceivers                 = "SQS_RECEIVERS"
+	envProcessors                = "SQS_PROCESSORS"
+	envPreserveMessageGroupOrder = "SQS_PRESERVE_MESSAGE_GROUP_ORDER"
+)
 
 const healthPortName = "health"
 
@@ -75,12 +86,60 @@ func maybeSetMessageProcessor(envs []corev1.EnvVar, src *v1alpha1.AWSSQSSource)
 	return envs
 }
 
+// makeReceiveOptionsEnvVars returns the environment variables which configure
+// the behavior of message receivers.
+func makeReceiveOptionsEnvVars(o *v1alpha1.AWSSQSSourceReceiveOptions) []corev1.EnvVar {
+	if o == nil {
+		return nil
+	}
+
+	var envs []corev1.EnvVar
+
+	if vt := o.VisibilityTimeout; vt != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envVisibilityTimeout,
+			Value: vt.String(),
+		})
+	}
+
+	if o.ExtendVisibilityTimeout {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envExtendVisibilityTimeout,
+			Value: strconv.FormatBool(o.ExtendVisibilityTimeout),
+		})
+	}
+
+	if o.Receivers != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envReceivers,
+			Value: strconv.Itoa(int(*o.Receivers)),
+		})
+	}
+
+	if o.Processors != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envProcessors,
+			Value: strconv.Itoa(int(*o.Processors)),
+		})
+	}
+
+	if o.PreserveMessageGroupOrder != nil {
+		envs = append(envs, corev1.EnvVar{
+			Name:  envPreserveMessageGroupOrder,
+			Value: strconv.FormatBool(*o.PreserveMessageGroupOrder),
+		})
+	}
+
+	return envs
+}
+
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSSQSSource) []corev1.EnvVar {
 	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
-		reconciler.MakeAWSEndpointEnvVars(o.Spec.Endpoint)...)
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, sqs.EndpointsID)...)
 	awsEnvs = maybeSetMessageProcessor(awsEnvs, o)
+	awsEnvs = append(awsEnvs, makeReceiveOptionsEnvVars(o.Spec.ReceiveOptions)...)
 
 	return append(awsEnvs, corev1.EnvVar{
 		Name:  common.EnvARN,
@@ -122,6 +122,7 @@ func TestReconcileWithIAMRoleAuth(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(iamRole),
 				newReconciledAdapter(),
+				NewEventTypeCatalog(s)(),
 			},
 			WantCreates: []runtime.Object{
 				newReconciledEksIAMServiceAccount(t)(NoToken),
@@ -43,6 +43,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/go-autorest/autorest"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
@@ -158,6 +159,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -179,6 +181,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -194,6 +197,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -215,6 +219,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed, deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -237,6 +242,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -361,6 +367,12 @@ func newReconciledServiceAccount() *corev1.ServiceAccount {
 	return NewServiceAccount(newEventSource())()
 }
 
+// newReconciledEventTypeCatalog returns a test EventTypeCatalog object that is
+// identical to what ReconcileKind generates.
+func newReconciledEventTypeCatalog() *catalogv1alpha1.EventTypeCatalog {
+	return NewEventTypeCatalog(newEventSource())()
+}
+
 // newReconciledRoleBinding returns a test RoleBinding object that is
 // identical to what ReconcileKind generates.
 func newReconciledRoleBinding() *rbacv1.RoleBinding {
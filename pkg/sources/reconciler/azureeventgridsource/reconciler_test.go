@@ -48,6 +48,7 @@ import (
 	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
@@ -175,6 +176,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -199,6 +201,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -222,6 +225,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -242,6 +246,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -265,6 +270,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -291,6 +297,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed, deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -317,6 +324,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -342,6 +350,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -371,6 +380,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -401,6 +411,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -549,6 +560,12 @@ func newReconciledServiceAccount() *corev1.ServiceAccount {
 	return NewServiceAccount(newEventSource())()
 }
 
+// newReconciledEventTypeCatalog returns a test EventTypeCatalog object that is
+// identical to what ReconcileKind generates.
+func newReconciledEventTypeCatalog() *catalogv1alpha1.EventTypeCatalog {
+	return NewEventTypeCatalog(newEventSource())()
+}
+
 // newReconciledRoleBinding returns a test RoleBinding object that is
 // identical to what ReconcileKind generates.
 func newReconciledRoleBinding() *rbacv1.RoleBinding {
@@ -42,6 +42,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
 	"github.com/Azure/go-autorest/autorest"
 
+	catalogv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/catalog/v1alpha1"
 	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources"
 	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
@@ -148,6 +149,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -169,6 +171,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -187,6 +190,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(subscribed, deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -209,6 +213,7 @@ func TestReconcileSubscription(t *testing.T) {
 			Objects: []runtime.Object{
 				newReconciledSource(deleted),
 				newReconciledServiceAccount(),
+				newReconciledEventTypeCatalog(),
 				newReconciledRoleBinding(),
 				newReconciledAdapter(),
 			},
@@ -327,6 +332,12 @@ func newReconciledServiceAccount() *corev1.ServiceAccount {
 	return NewServiceAccount(newEventSource())()
 }
 
+// newReconciledEventTypeCatalog returns a test EventTypeCatalog object that is
+// identical to what ReconcileKind generates.
+func newReconciledEventTypeCatalog() *catalogv1alpha1.EventTypeCatalog {
+	return NewEventTypeCatalog(newEventSource())()
+}
+
 // newReconciledRoleBinding returns a test RoleBinding object that is
 // identical to what ReconcileKind generates.
 func newReconciledRoleBinding() *rbacv1.RoleBinding {
@@ -17,6 +17,8 @@ limitations under the License.
 package googlecloudpubsubsource
 
 import (
+	"strconv"
+
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
@@ -29,6 +31,12 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/reconciler/resource"
 )
 
+const (
+	envPubSubMaxOutstandingMessages = "GCLOUD_PUBSUB_MAX_OUTSTANDING_MESSAGES"
+	envPubSubMaxOutstandingBytes    = "GCLOUD_PUBSUB_MAX_OUTSTANDING_BYTES"
+	envPubSubReportConsumerLag      = "GCLOUD_PUBSUB_REPORT_CONSUMER_LAG"
+)
+
 // adapterConfig contains properties used to configure the source's adapter.
 // These are automatically populated by envconfig.
 type adapterConfig struct {
@@ -73,5 +81,29 @@ func MakeAppEnv(o *v1alpha1.GoogleCloudPubSubSource) []corev1.EnvVar {
 		envVar = append(envVar, common.MaybeAppendValueFromEnvVar([]corev1.EnvVar{}, common.EnvGCloudSAKey, *o.Spec.Auth.ServiceAccountKey)...)
 	}
 
+	if fc := o.Spec.FlowControl; fc != nil {
+		if fc.MaxOutstandingMessages != nil {
+			envVar = append(envVar, corev1.EnvVar{
+				Name:  envPubSubMaxOutstandingMessages,
+				Value: strconv.Itoa(int(*fc.MaxOutstandingMessages)),
+			})
+		}
+		if fc.MaxOutstandingBytes != nil {
+			envVar = append(envVar, corev1.EnvVar{
+				Name:  envPubSubMaxOutstandingBytes,
+				Value: strconv.FormatInt(*fc.MaxOutstandingBytes, 10),
+			})
+		}
+	}
+
+	// The consumer lag is only observed for autoscaling purposes, because
+	// it requires permissions to read metrics from Cloud Monitoring.
+	if ao := o.Spec.AdapterOverrides; ao != nil && ao.Autoscaling != nil {
+		envVar = append(envVar, corev1.EnvVar{
+			Name:  envPubSubReportConsumerLag,
+			Value: "true",
+		})
+	}
+
 	return envVar
 }
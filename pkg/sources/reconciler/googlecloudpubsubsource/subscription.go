@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -52,6 +53,7 @@ const (
 // Required permissions:
 // - pubsub.subscriptions.get
 // - pubsub.subscriptions.create
+// - pubsub.subscriptions.update
 // - pubsub.topics.attachSubscription
 func EnsureSubscription(ctx context.Context, cli *pubsub.Client) error {
 	if skip.Skip(ctx) {
@@ -106,25 +108,13 @@ func EnsureSubscription(ctx context.Context, cli *pubsub.Client) error {
 	}
 
 	subsID := subscriptionID(src)
+	desiredCfg := subscriptionConfig(src, cli.Topic(src.Spec.Topic.Resource))
 
 	subs := cli.Subscription(subsID)
-	exists, err := subs.Exists(ctx)
+	currentCfg, err := subs.Config(ctx)
 	switch {
-	case isDenied(err):
-		status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Access denied to Pub/Sub API: "+toErrMsg(err))
-		return controller.NewPermanentError(failGetSubscriptionEvent(topicName, err))
-	case err != nil:
-		status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Cannot look up subscription: "+toErrMsg(err))
-		return fmt.Errorf("%w", failGetSubscriptionEvent(topicName, err))
-	}
-
-	if !exists {
-		cfg := pubsub.SubscriptionConfig{
-			Topic:  cli.Topic(src.Spec.Topic.Resource),
-			Labels: subscriptionLabels(src),
-		}
-
-		_, err := cli.CreateSubscription(ctx, subsID, cfg)
+	case isNotFound(err):
+		_, err := cli.CreateSubscription(ctx, subsID, desiredCfg)
 		switch {
 		case isDenied(err):
 			status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Access denied to Pub/Sub API: "+toErrMsg(err))
@@ -133,6 +123,27 @@ func EnsureSubscription(ctx context.Context, cli *pubsub.Client) error {
 			status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Cannot subscribe to topic: "+toErrMsg(err))
 			return fmt.Errorf("%w", failCreateSubscriptionEvent(topicName, err))
 		}
+	case isDenied(err):
+		status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Access denied to Pub/Sub API: "+toErrMsg(err))
+		return controller.NewPermanentError(failGetSubscriptionEvent(topicName, err))
+	case err != nil:
+		status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Cannot look up subscription: "+toErrMsg(err))
+		return fmt.Errorf("%w", failGetSubscriptionEvent(topicName, err))
+
+	default:
+		if cfgUpd, needsUpdate := subscriptionConfigUpdate(&currentCfg, &desiredCfg); needsUpdate {
+			_, err := subs.Update(ctx, cfgUpd)
+			switch {
+			case isDenied(err):
+				status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Access denied to Pub/Sub API: "+toErrMsg(err))
+				return controller.NewPermanentError(failUpdateSubscriptionEvent(topicName, err))
+			case err != nil:
+				status.MarkNotSubscribed(v1alpha1.GCloudReasonAPIError, "Cannot update subscription: "+toErrMsg(err))
+				return fmt.Errorf("%w", failUpdateSubscriptionEvent(topicName, err))
+			}
+
+			event.Normal(ctx, ReasonSubscribed, "Updated subscription to topic %q", topicName)
+		}
 	}
 
 	// it is essential that we propagate the subscription's name
@@ -205,6 +216,132 @@ func EnsureNoSubscription(ctx context.Context, cli *pubsub.Client) error {
 	return reconciler.NewEvent(corev1.EventTypeNormal, ReasonUnsubscribed, "Unsubscribed from topic %q", topicName)
 }
 
+// Default settings applied by Pub/Sub to subscriptions.
+const (
+	defaultAckDeadline         = 10 * time.Second
+	defaultMaxDeliveryAttempts = 5
+	defaultMinimumBackoff      = 10 * time.Second
+	defaultMaximumBackoff      = 600 * time.Second
+)
+
+// subscriptionConfig returns the desired configuration of the Pub/Sub
+// subscription managed by the given source.
+//
+// Defaults are set explicitly so that the returned configuration can be
+// compared with the one reported by Pub/Sub.
+func subscriptionConfig(src *v1alpha1.GoogleCloudPubSubSource, topic *pubsub.Topic) pubsub.SubscriptionConfig {
+	cfg := pubsub.SubscriptionConfig{
+		Topic:       topic,
+		Labels:      subscriptionLabels(src),
+		AckDeadline: defaultAckDeadline,
+	}
+
+	s := src.Spec.SubscriptionSettings
+	if s == nil {
+		return cfg
+	}
+
+	if s.AckDeadline != nil {
+		cfg.AckDeadline = time.Duration(*s.AckDeadline)
+	}
+
+	cfg.EnableExactlyOnceDelivery = s.EnableExactlyOnceDelivery
+	cfg.EnableMessageOrdering = s.EnableMessageOrdering
+
+	if s.Filter != nil {
+		cfg.Filter = *s.Filter
+	}
+
+	if dlp := s.DeadLetterPolicy; dlp != nil {
+		maxAttempts := defaultMaxDeliveryAttempts
+		if dlp.MaxDeliveryAttempts != nil {
+			maxAttempts = int(*dlp.MaxDeliveryAttempts)
+		}
+
+		cfg.DeadLetterPolicy = &pubsub.DeadLetterPolicy{
+			DeadLetterTopic:     dlp.DeadLetterTopic.String(),
+			MaxDeliveryAttempts: maxAttempts,
+		}
+	}
+
+	if rp := s.RetryPolicy; rp != nil {
+		minBackoff := defaultMinimumBackoff
+		if rp.MinimumBackoff != nil {
+			minBackoff = time.Duration(*rp.MinimumBackoff)
+		}
+		maxBackoff := defaultMaximumBackoff
+		if rp.MaximumBackoff != nil {
+			maxBackoff = time.Duration(*rp.MaximumBackoff)
+		}
+
+		cfg.RetryPolicy = &pubsub.RetryPolicy{
+			MinimumBackoff: minBackoff,
+			MaximumBackoff: maxBackoff,
+		}
+	}
+
+	return cfg
+}
+
+// subscriptionConfigUpdate returns the changes to apply to the current
+// configuration of a Pub/Sub subscription for it to match the desired
+// configuration, and whether any change is required.
+//
+// Only settings which can be changed after the creation of a subscription are
+// compared. The filter and message ordering of a subscription can not be
+// changed, which the webhook enforces.
+func subscriptionConfigUpdate(current, desired *pubsub.SubscriptionConfig) (pubsub.SubscriptionConfigToUpdate, bool) {
+	var cfgUpd pubsub.SubscriptionConfigToUpdate
+	var needsUpdate bool
+
+	if current.AckDeadline != desired.AckDeadline {
+		cfgUpd.AckDeadline = desired.AckDeadline
+		needsUpdate = true
+	}
+
+	if current.EnableExactlyOnceDelivery != desired.EnableExactlyOnceDelivery {
+		cfgUpd.EnableExactlyOnceDelivery = desired.EnableExactlyOnceDelivery
+		needsUpdate = true
+	}
+
+	if !deadLetterPoliciesEqual(current.DeadLetterPolicy, desired.DeadLetterPolicy) {
+		// the zero value removes the dead-letter policy
+		cfgUpd.DeadLetterPolicy = &pubsub.DeadLetterPolicy{}
+		if desired.DeadLetterPolicy != nil {
+			cfgUpd.DeadLetterPolicy = desired.DeadLetterPolicy
+		}
+		needsUpdate = true
+	}
+
+	if !retryPoliciesEqual(current.RetryPolicy, desired.RetryPolicy) {
+		// the zero value removes the retry policy
+		cfgUpd.RetryPolicy = &pubsub.RetryPolicy{}
+		if desired.RetryPolicy != nil {
+			cfgUpd.RetryPolicy = desired.RetryPolicy
+		}
+		needsUpdate = true
+	}
+
+	return cfgUpd, needsUpdate
+}
+
+// deadLetterPoliciesEqual returns whether the given dead-letter policies are equal.
+func deadLetterPoliciesEqual(a, b *pubsub.DeadLetterPolicy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+// retryPoliciesEqual returns whether the given retry policies are equal.
+func retryPoliciesEqual(a, b *pubsub.RetryPolicy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	// backoffs are time.Duration values wrapped in empty interfaces
+	return a.MinimumBackoff == b.MinimumBackoff && a.MaximumBackoff == b.MaximumBackoff
+}
+
 // belongsToTopic ensures that a Pub/Sub subscription belongs to the expected topic.
 func belongsToTopic(ctx context.Context, cli *pubsub.Client, subsID, topicID string) (bool, error) {
 	subs := cli.Subscription(subsID)
@@ -303,6 +440,13 @@ func failCreateSubscriptionEvent(topicName string, origErr error) reconciler.Eve
 		"Error creating subscription for topic %q: %s", topicName, toErrMsg(origErr))
 }
 
+// failUpdateSubscriptionEvent returns a reconciler event which indicates that a
+// Pub/Sub subscription could not be updated via the Google Cloud API.
+func failUpdateSubscriptionEvent(topicName string, origErr error) reconciler.Event {
+	return reconciler.NewEvent(corev1.EventTypeWarning, ReasonFailedSubscribe,
+		"Error updating subscription for topic %q: %s", topicName, toErrMsg(origErr))
+}
+
 // failDeleteSubscriptionEvent returns a reconciler event which indicates that
 // a Pub/Sub subscription could not be deleted via the Google Cloud API.
 func failDeleteSubscriptionEvent(topicName string, origErr error) reconciler.Event {
@@ -0,0 +1,150 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecloudpubsubsource
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/pstest"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	commonv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+)
+
+func TestEnsureSubscriptionSettings(t *testing.T) {
+	ctx := context.Background()
+
+	psCli := newTestPubSubClient(t, "my-project")
+
+	_, err := psCli.CreateTopic(ctx, "my-topic")
+	require.NoError(t, err)
+	_, err = psCli.CreateTopic(ctx, "my-dead-letter-topic")
+	require.NoError(t, err)
+
+	src := newEventSource()
+	src.Spec.SubscriptionSettings = &v1alpha1.GoogleCloudPubSubSubscriptionSettings{
+		AckDeadline:               durationPtr(30 * time.Second),
+		EnableExactlyOnceDelivery: true,
+		EnableMessageOrdering:     true,
+		Filter:                    strPtr(`attributes.tenant = "acme"`),
+		DeadLetterPolicy: &v1alpha1.GoogleCloudPubSubDeadLetterPolicy{
+			DeadLetterTopic: v1alpha1.GCloudResourceName{
+				Project:    "my-project",
+				Collection: pubsubCollectionTopics,
+				Resource:   "my-dead-letter-topic",
+			},
+		},
+	}
+
+	subs := psCli.Subscription(subscriptionID(src))
+
+	t.Run("Subscription is created with the given settings", func(t *testing.T) {
+		err := EnsureSubscription(commonv1alpha1.WithReconcilable(ctx, src), psCli)
+		require.NoError(t, err)
+
+		cfg, err := subs.Config(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, "my-topic", cfg.Topic.ID())
+		assert.Equal(t, 30*time.Second, cfg.AckDeadline)
+		assert.True(t, cfg.EnableExactlyOnceDelivery)
+		assert.True(t, cfg.EnableMessageOrdering)
+		assert.Equal(t, `attributes.tenant = "acme"`, cfg.Filter)
+		assert.Equal(t, &pubsub.DeadLetterPolicy{
+			DeadLetterTopic:     "projects/my-project/topics/my-dead-letter-topic",
+			MaxDeliveryAttempts: defaultMaxDeliveryAttempts,
+		}, cfg.DeadLetterPolicy)
+		assert.Nil(t, cfg.RetryPolicy)
+
+		assert.True(t, src.Status.GetCondition(v1alpha1.GoogleCloudPubSubConditionSubscribed).IsTrue())
+	})
+
+	t.Run("Subscription is updated with changed settings", func(t *testing.T) {
+		src.Spec.SubscriptionSettings.AckDeadline = nil
+		src.Spec.SubscriptionSettings.EnableExactlyOnceDelivery = false
+		src.Spec.SubscriptionSettings.DeadLetterPolicy = nil
+		src.Spec.SubscriptionSettings.RetryPolicy = &v1alpha1.GoogleCloudPubSubRetryPolicy{
+			MinimumBackoff: durationPtr(time.Second),
+		}
+
+		err := EnsureSubscription(commonv1alpha1.WithReconcilable(ctx, src), psCli)
+		require.NoError(t, err)
+
+		cfg, err := subs.Config(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, defaultAckDeadline, cfg.AckDeadline)
+		assert.False(t, cfg.EnableExactlyOnceDelivery)
+		assert.Nil(t, cfg.DeadLetterPolicy)
+		assert.Equal(t, &pubsub.RetryPolicy{
+			MinimumBackoff: time.Second,
+			MaximumBackoff: defaultMaximumBackoff,
+		}, cfg.RetryPolicy)
+
+		// immutable settings are left untouched
+		assert.True(t, cfg.EnableMessageOrdering)
+		assert.Equal(t, `attributes.tenant = "acme"`, cfg.Filter)
+	})
+
+	t.Run("Subscription which matches the settings is not updated", func(t *testing.T) {
+		cfg, err := subs.Config(ctx)
+		require.NoError(t, err)
+
+		desiredCfg := subscriptionConfig(src, psCli.Topic("my-topic"))
+
+		_, needsUpdate := subscriptionConfigUpdate(&cfg, &desiredCfg)
+		assert.False(t, needsUpdate)
+	})
+}
+
+// newTestPubSubClient returns a Pub/Sub client connected to an in-memory
+// Pub/Sub server.
+func newTestPubSubClient(t *testing.T, project string) *pubsub.Client {
+	t.Helper()
+
+	srv := pstest.NewServer()
+	t.Cleanup(func() { _ = srv.Close() })
+
+	conn, err := grpc.Dial(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	psCli, err := pubsub.NewClient(context.Background(), project, option.WithGRPCConn(conn))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = psCli.Close() })
+
+	return psCli
+}
+
+func durationPtr(d time.Duration) *apis.Duration {
+	ad := apis.Duration(d)
+	return &ad
+}
+
+func strPtr(s string) *string {
+	return &s
+}
@@ -20,6 +20,7 @@ package secret
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
@@ -29,6 +30,11 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
+// errUnsupportedValueSource is returned when a value references a source which
+// can only be resolved by adapters at runtime.
+var errUnsupportedValueSource = errors.New("values read from files or secret providers are not supported " +
+	"by the controller, use a literal value or a reference to a Kubernetes Secret")
+
 // Secrets is list of secret values.
 type Secrets []string
 
@@ -65,6 +71,10 @@ func (g *GetterWithClientset) Get(refs ...v1alpha1.ValueFromField) (Secrets, err
 	for _, ref := range refs {
 		val := ref.Value
 
+		if ref.ValueFromSecret == nil && (ref.ValueFromFile != "" || ref.ValueFromProvider != nil) {
+			return nil, errUnsupportedValueSource
+		}
+
 		if vfs := ref.ValueFromSecret; vfs != nil {
 			var secr *corev1.Secret
 			var err error
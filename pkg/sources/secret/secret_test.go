@@ -38,6 +38,7 @@ func TestGetter(t *testing.T) {
 		initSecrets []*corev1.Secret
 		input       []commonv1alpha1.ValueFromField
 		expect      Secrets
+		expectErr   bool
 		getRequests int
 	}{
 		{
@@ -154,6 +155,30 @@ func TestGetter(t *testing.T) {
 			},
 			getRequests: 2,
 		},
+		{
+			name: "From file",
+			input: []commonv1alpha1.ValueFromField{
+				{
+					ValueFromFile: "/mnt/secrets/key",
+				},
+			},
+			expectErr:   true,
+			getRequests: 0,
+		},
+		{
+			name: "From secret provider",
+			input: []commonv1alpha1.ValueFromField{
+				{
+					ValueFromProvider: &commonv1alpha1.ProviderSecretSelector{
+						Provider: commonv1alpha1.SecretProviderVault,
+						Path:     "secret/data/app",
+						Key:      "key",
+					},
+				},
+			},
+			expectErr:   true,
+			getRequests: 0,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -169,7 +194,11 @@ func TestGetter(t *testing.T) {
 			sg := NewGetter(cli.CoreV1().Secrets(ns))
 			output, err := sg.Get(tc.input...)
 
-			require.NoError(t, err)
+			if tc.expectErr {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
 
 			assert.Equal(t, tc.expect, output)
 			assert.Equal(t, tc.getRequests, len(cli.Actions()), "Unexpected number of API requests")
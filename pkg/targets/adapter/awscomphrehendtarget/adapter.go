@@ -32,6 +32,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/comprehend"
 	"github.com/aws/aws-sdk-go/service/comprehend/comprehendiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -53,9 +54,9 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	env := envAcc.(*envAccessor)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(env.Region).
-		WithMaxRetries(5),
+		WithMaxRetries(5)),
 	))
 
 	config := &aws.Config{}
@@ -33,8 +33,9 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/dynamodb"
-	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
@@ -56,9 +57,9 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(a.Region).
-		WithMaxRetries(5)))
+		WithMaxRetries(5))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
@@ -76,6 +77,7 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		awsDynamoDBTableName: dynamodbTable,
 		dynamoDBClient:       dynamodb.New(sess, config),
 
+		key:              env.Key,
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
 		logger:           logger,
@@ -90,8 +92,9 @@ type adapter struct {
 	awsArnString         string
 	awsArn               arn.ARN
 	awsDynamoDBTableName string
-	dynamoDBClient       *dynamodb.DynamoDB
+	dynamoDBClient       dynamodbiface.DynamoDBAPI
 
+	key              KeyMapping
 	discardCEContext bool
 	ceClient         cloudevents.Client
 	logger           *zap.SugaredLogger
@@ -106,11 +109,38 @@ func (a *adapter) Start(ctx context.Context) error {
 
 // Parse and send the aws event
 func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	var res *result
+	var err error
+
+	switch typ := event.Type(); typ {
+	case v1alpha1.EventTypeAWSDynamoDBPutItem:
+		res, err = a.putItem(&event)
+	case v1alpha1.EventTypeAWSDynamoDBUpdateItem:
+		res, err = a.updateItem(&event)
+	case v1alpha1.EventTypeAWSDynamoDBDeleteItem:
+		res, err = a.deleteItem(&event)
+	case v1alpha1.EventTypeAWSDynamoDBTransactWrite:
+		res, err = a.transactWrite(&event)
+	case v1alpha1.EventTypeAWSDynamoDBBatchWrite:
+		res, err = a.batchWrite(&event)
+	default:
+		return a.putEvent(&event)
+	}
+	if err != nil {
+		return a.reportError("Error processing event of type "+event.Type(), err)
+	}
+
+	return a.respond(v1alpha1.EventTypeAWSDynamoDBOperationResult, res)
+}
+
+// putEvent writes the whole event, or only its data, as a DynamoDB item, and
+// replies with the output of the PutItem operation.
+func (a *adapter) putEvent(event *cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var eventJSONMap map[string]interface{}
 
 	if a.discardCEContext {
 		if err := event.DataAs(&eventJSONMap); err != nil {
-			return a.reportError("Error deserializing event data to map", err)
+			return a.reportError("Error deserializing event data to map", badRequest(err))
 		}
 	} else {
 		b, err := json.Marshal(event)
@@ -122,10 +152,13 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		}
 	}
 
-	av, err := dynamodbattribute.MarshalMap(eventJSONMap)
+	av, err := marshalItem(eventJSONMap)
 	if err != nil {
 		return a.reportError("Error marshalling attribute", err)
 	}
+	if err := a.key.completeItem(event, av); err != nil {
+		return a.reportError("Error mapping item key", err)
+	}
 
 	input := &dynamodb.PutItemInput{
 		Item:      av,
@@ -137,18 +170,28 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		return a.reportError("Error invoking DynamoDB", err)
 	}
 
+	return a.respond(v1alpha1.EventTypeAWSDynamoDBResult, resp)
+}
+
+// respond returns a response event of the given type with the given data.
+func (a *adapter) respond(typ string, data interface{}) (*cloudevents.Event, cloudevents.Result) {
 	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
-	err = responseEvent.SetData(cloudevents.ApplicationJSON, resp)
-	if err != nil {
+	if err := responseEvent.SetData(cloudevents.ApplicationJSON, data); err != nil {
 		return a.reportError("error generating response event", err)
 	}
 
-	responseEvent.SetType(v1alpha1.EventTypeAWSDynamoDBResult)
+	responseEvent.SetType(typ)
 	responseEvent.SetSource(a.awsArnString)
 	return &responseEvent, cloudevents.ResultACK
 }
 
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
-	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
+
+	code := http.StatusInternalServerError
+	if isBadRequest(err) {
+		code = http.StatusBadRequest
+	}
+
+	return nil, cloudevents.NewHTTPResult(code, "%s: %s", msg, err)
 }
@@ -0,0 +1,429 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbtarget
+
+import (
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbiface"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+const (
+	tTable = "MyTable"
+	tARN   = "arn:aws:dynamodb:us-east-1:123456789012:table/" + tTable
+)
+
+func TestDispatch(t *testing.T) {
+	numberType := v1alpha1.AWSDynamoDBKeyAttributeTypeNumber
+
+	keyMapping := KeyMapping{{
+		Name:      "pk",
+		Attribute: aws.String("subject"),
+	}, {
+		Name:     "version",
+		Type:     &numberType,
+		DataPath: aws.String("meta.version"),
+	}}
+
+	testCases := map[string]struct {
+		key      KeyMapping
+		event    cloudevents.Event
+		client   *mockDynamoDBClient
+		expectFn func(*testing.T, *mockDynamoDBClient)
+
+		expectCode   int
+		expectResult *result
+	}{
+		"Put with unsatisfied condition": {
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBPutItem, map[string]interface{}{
+				"item":                map[string]interface{}{"id": "my-item"},
+				"conditionExpression": "attribute_not_exists(id)",
+			}),
+			client: &mockDynamoDBClient{
+				err: awserr.New(dynamodb.ErrCodeConditionalCheckFailedException, "The conditional request failed", nil),
+			},
+			expectFn: func(t *testing.T, c *mockDynamoDBClient) {
+				require.NotNil(t, c.putInput)
+				assert.Equal(t, "attribute_not_exists(id)", *c.putInput.ConditionExpression)
+			},
+			expectResult: &result{
+				Operation:              opPut,
+				ConditionalCheckFailed: true,
+			},
+		},
+		"Update with mapped key": {
+			key: keyMapping,
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBUpdateItem, map[string]interface{}{
+				"meta":                      map[string]interface{}{"version": 3},
+				"updateExpression":          "SET #s = :s",
+				"expressionAttributeNames":  map[string]interface{}{"#s": "status"},
+				"expressionAttributeValues": map[string]interface{}{":s": "shipped"},
+				"returnValues":              "UPDATED_NEW",
+			}),
+			client: &mockDynamoDBClient{
+				attributes: map[string]*dynamodb.AttributeValue{"status": {S: aws.String("shipped")}},
+			},
+			expectFn: func(t *testing.T, c *mockDynamoDBClient) {
+				require.NotNil(t, c.updateInput)
+				assert.Equal(t, "my-item", *c.updateInput.Key["pk"].S)
+				assert.Equal(t, "3", *c.updateInput.Key["version"].N)
+				assert.Equal(t, "SET #s = :s", *c.updateInput.UpdateExpression)
+				assert.Equal(t, "status", *c.updateInput.ExpressionAttributeNames["#s"])
+				assert.Equal(t, "shipped", *c.updateInput.ExpressionAttributeValues[":s"].S)
+			},
+			expectResult: &result{
+				Operation:        opUpdate,
+				Attributes:       map[string]interface{}{"status": "shipped"},
+				ConsumedCapacity: []consumedCapacity{{TableName: tTable, CapacityUnits: 1}},
+			},
+		},
+		"Update without expression": {
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBUpdateItem, map[string]interface{}{
+				"key": map[string]interface{}{"id": "my-item"},
+			}),
+			client:     &mockDynamoDBClient{},
+			expectCode: http.StatusBadRequest,
+		},
+		"Delete with explicit key": {
+			key: keyMapping,
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBDeleteItem, map[string]interface{}{
+				"key": map[string]interface{}{"pk": "other-item"},
+			}),
+			client: &mockDynamoDBClient{},
+			expectFn: func(t *testing.T, c *mockDynamoDBClient) {
+				require.NotNil(t, c.deleteInput)
+				assert.Len(t, c.deleteInput.Key, 1)
+				assert.Equal(t, "other-item", *c.deleteInput.Key["pk"].S)
+			},
+			expectResult: &result{
+				Operation:        opDelete,
+				ConsumedCapacity: []consumedCapacity{{TableName: tTable, CapacityUnits: 1}},
+			},
+		},
+		"Delete without key": {
+			event:      newEvent(t, v1alpha1.EventTypeAWSDynamoDBDeleteItem, nil),
+			client:     &mockDynamoDBClient{},
+			expectCode: http.StatusBadRequest,
+		},
+		"Delete with mapped key missing from event": {
+			key:        keyMapping,
+			event:      newEvent(t, v1alpha1.EventTypeAWSDynamoDBDeleteItem, nil),
+			client:     &mockDynamoDBClient{},
+			expectCode: http.StatusBadRequest,
+		},
+		"Transaction cancelled by unsatisfied condition": {
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBTransactWrite, map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"put": map[string]interface{}{
+						"item": map[string]interface{}{"id": "order"},
+					}},
+					map[string]interface{}{"conditionCheck": map[string]interface{}{
+						"key":                 map[string]interface{}{"id": "stock"},
+						"conditionExpression": "quantity > :zero",
+						"expressionAttributeValues": map[string]interface{}{
+							":zero": 0,
+						},
+					}},
+				},
+			}),
+			client: &mockDynamoDBClient{
+				err: &dynamodb.TransactionCanceledException{
+					CancellationReasons: []*dynamodb.CancellationReason{
+						{Code: aws.String("None")},
+						{Code: aws.String("ConditionalCheckFailed"), Message: aws.String("The conditional request failed")},
+					},
+				},
+			},
+			expectFn: func(t *testing.T, c *mockDynamoDBClient) {
+				require.NotNil(t, c.transactInput)
+				require.Len(t, c.transactInput.TransactItems, 2)
+				assert.NotNil(t, c.transactInput.TransactItems[0].Put)
+				assert.Equal(t, "0", *c.transactInput.TransactItems[1].ConditionCheck.ExpressionAttributeValues[":zero"].N)
+			},
+			expectResult: &result{
+				Operation:              opTransactWrite,
+				ConditionalCheckFailed: true,
+				CancellationReasons: []cancellationReason{
+					{Code: "None"},
+					{Code: "ConditionalCheckFailed", Message: "The conditional request failed"},
+				},
+			},
+		},
+		"Transaction cancelled by conflict": {
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBTransactWrite, map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{"delete": map[string]interface{}{
+						"key": map[string]interface{}{"id": "order"},
+					}},
+				},
+			}),
+			client: &mockDynamoDBClient{
+				err: &dynamodb.TransactionCanceledException{
+					CancellationReasons: []*dynamodb.CancellationReason{
+						{Code: aws.String("TransactionConflict")},
+					},
+				},
+			},
+			expectCode: http.StatusInternalServerError,
+		},
+		"Transaction item with multiple operations": {
+			event: newEvent(t, v1alpha1.EventTypeAWSDynamoDBTransactWrite, map[string]interface{}{
+				"items": []interface{}{
+					map[string]interface{}{
+						"put":    map[string]interface{}{"item": map[string]interface{}{"id": "order"}},
+						"delete": map[string]interface{}{"key": map[string]interface{}{"id": "order"}},
+					},
+				},
+			}),
+			client:     &mockDynamoDBClient{},
+			expectCode: http.StatusBadRequest,
+		},
+		"Batch split in chunks with unprocessed items": {
+			event:  newEvent(t, v1alpha1.EventTypeAWSDynamoDBBatchWrite, newBatch(maxBatchWriteItems+5)),
+			client: &mockDynamoDBClient{unprocessedItemID: "item-27"},
+			expectFn: func(t *testing.T, c *mockDynamoDBClient) {
+				require.Len(t, c.batchInputs, 1+maxBatchWriteAttempts)
+				assert.Len(t, c.batchInputs[0].RequestItems[tTable], maxBatchWriteItems)
+				assert.Len(t, c.batchInputs[1].RequestItems[tTable], 5)
+				for _, in := range c.batchInputs[2:] {
+					assert.Len(t, in.RequestItems[tTable], 1)
+				}
+			},
+			expectResult: &result{
+				Operation: opBatchWrite,
+				ConsumedCapacity: func() []consumedCapacity {
+					cc := make([]consumedCapacity, 1+maxBatchWriteAttempts)
+					for i := range cc {
+						cc[i] = consumedCapacity{TableName: tTable, CapacityUnits: 1}
+					}
+					return cc
+				}(),
+				UnprocessedItems: &batchWritePayload{
+					Put: []map[string]interface{}{{"id": "item-27"}},
+				},
+			},
+		},
+		"Batch without items": {
+			event:      newEvent(t, v1alpha1.EventTypeAWSDynamoDBBatchWrite, map[string]interface{}{}),
+			client:     &mockDynamoDBClient{},
+			expectCode: http.StatusBadRequest,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			a := &adapter{
+				awsArnString:         tARN,
+				awsDynamoDBTableName: tTable,
+				dynamoDBClient:       tc.client,
+				key:                  tc.key,
+				logger:               loggingtesting.TestLogger(t),
+			}
+
+			resp, res := a.dispatch(tc.event)
+
+			if tc.expectCode != 0 {
+				assert.Nil(t, resp)
+
+				var httpRes *cehttp.Result
+				require.True(t, cloudevents.ResultAs(res, &httpRes), "unexpected result: %v", res)
+				assert.Equal(t, tc.expectCode, httpRes.StatusCode)
+				return
+			}
+
+			require.True(t, cloudevents.IsACK(res), "unexpected result: %v", res)
+			require.NotNil(t, resp)
+			assert.Equal(t, v1alpha1.EventTypeAWSDynamoDBOperationResult, resp.Type())
+			assert.Equal(t, tARN, resp.Source())
+
+			gotResult := &result{}
+			require.NoError(t, resp.DataAs(gotResult))
+			assert.Equal(t, tc.expectResult, gotResult)
+
+			if tc.expectFn != nil {
+				tc.expectFn(t, tc.client)
+			}
+		})
+	}
+}
+
+func TestDispatchDefaultOperation(t *testing.T) {
+	numberType := v1alpha1.AWSDynamoDBKeyAttributeTypeNumber
+
+	client := &mockDynamoDBClient{}
+
+	a := &adapter{
+		awsArnString:         tARN,
+		awsDynamoDBTableName: tTable,
+		dynamoDBClient:       client,
+		key: KeyMapping{{
+			Name:      "pk",
+			Attribute: aws.String("subject"),
+		}, {
+			Name:     "version",
+			Type:     &numberType,
+			DataPath: aws.String("meta.version"),
+		}},
+		logger: loggingtesting.TestLogger(t),
+	}
+
+	resp, res := a.dispatch(newEvent(t, "io.triggermesh.aws.dynamodb.item.put",
+		map[string]interface{}{"meta": map[string]interface{}{"version": 3}}))
+
+	require.True(t, cloudevents.IsACK(res), "unexpected result: %v", res)
+	require.NotNil(t, resp)
+	assert.Equal(t, v1alpha1.EventTypeAWSDynamoDBResult, resp.Type())
+	assert.Equal(t, tARN, resp.Source())
+
+	gotOutput := &dynamodb.PutItemOutput{}
+	require.NoError(t, resp.DataAs(gotOutput))
+	assert.Equal(t, &dynamodb.PutItemOutput{
+		ConsumedCapacity: &dynamodb.ConsumedCapacity{TableName: aws.String(tTable), CapacityUnits: aws.Float64(1)},
+	}, gotOutput)
+
+	require.NotNil(t, client.putInput)
+	assert.Equal(t, tTable, *client.putInput.TableName)
+	assert.Nil(t, client.putInput.ReturnConsumedCapacity)
+	assert.Equal(t, "my-item", *client.putInput.Item["pk"].S)
+	assert.Equal(t, "0000", *client.putInput.Item["id"].S)
+	assert.Equal(t, "3", *client.putInput.Item["version"].N)
+	assert.Equal(t, "io.triggermesh.aws.dynamodb.item.put", *client.putInput.Item["type"].S)
+}
+
+func TestKeyMappingDecode(t *testing.T) {
+	var m KeyMapping
+	err := m.Decode(`[{"name":"id","attribute":"subject"},{"name":"ts","type":"N","dataPath":"created"}]`)
+	require.NoError(t, err)
+
+	require.Len(t, m, 2)
+	assert.Equal(t, "id", m[0].Name)
+	assert.Equal(t, "subject", *m[0].Attribute)
+	assert.Equal(t, v1alpha1.AWSDynamoDBKeyAttributeTypeNumber, *m[1].Type)
+	assert.Equal(t, "created", *m[1].DataPath)
+}
+
+// newEvent returns a CloudEvent of the given type with the given data.
+func newEvent(t *testing.T, typ string, data interface{}) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("0000")
+	e.SetSource("test.source")
+	e.SetSubject("my-item")
+	e.SetType(typ)
+
+	if data != nil {
+		require.NoError(t, e.SetData(cloudevents.ApplicationJSON, data))
+	}
+
+	return e
+}
+
+// newBatch returns the data of a batchwrite event containing n items.
+func newBatch(n int) map[string]interface{} {
+	items := make([]interface{}, n)
+	for i := range items {
+		items[i] = map[string]interface{}{"id": "item-" + strconv.Itoa(i)}
+	}
+	return map[string]interface{}{"put": items}
+}
+
+// mockDynamoDBClient is a mock implementation of the DynamoDB API which
+// records the input of calls.
+type mockDynamoDBClient struct {
+	dynamodbiface.DynamoDBAPI
+
+	// error returned by calls
+	err error
+	// attributes returned by UpdateItem and DeleteItem calls
+	attributes map[string]*dynamodb.AttributeValue
+	// id of an item which BatchWriteItem never processes
+	unprocessedItemID string
+
+	putInput      *dynamodb.PutItemInput
+	updateInput   *dynamodb.UpdateItemInput
+	deleteInput   *dynamodb.DeleteItemInput
+	transactInput *dynamodb.TransactWriteItemsInput
+	batchInputs   []*dynamodb.BatchWriteItemInput
+}
+
+var capacity = &dynamodb.ConsumedCapacity{
+	TableName:     aws.String(tTable),
+	CapacityUnits: aws.Float64(1),
+}
+
+func (c *mockDynamoDBClient) PutItem(in *dynamodb.PutItemInput) (*dynamodb.PutItemOutput, error) {
+	c.putInput = in
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &dynamodb.PutItemOutput{ConsumedCapacity: capacity}, nil
+}
+
+func (c *mockDynamoDBClient) UpdateItem(in *dynamodb.UpdateItemInput) (*dynamodb.UpdateItemOutput, error) {
+	c.updateInput = in
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &dynamodb.UpdateItemOutput{Attributes: c.attributes, ConsumedCapacity: capacity}, nil
+}
+
+func (c *mockDynamoDBClient) DeleteItem(in *dynamodb.DeleteItemInput) (*dynamodb.DeleteItemOutput, error) {
+	c.deleteInput = in
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &dynamodb.DeleteItemOutput{Attributes: c.attributes, ConsumedCapacity: capacity}, nil
+}
+
+func (c *mockDynamoDBClient) TransactWriteItems(in *dynamodb.TransactWriteItemsInput) (*dynamodb.TransactWriteItemsOutput, error) {
+	c.transactInput = in
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &dynamodb.TransactWriteItemsOutput{ConsumedCapacity: []*dynamodb.ConsumedCapacity{capacity}}, nil
+}
+
+func (c *mockDynamoDBClient) BatchWriteItem(in *dynamodb.BatchWriteItemInput) (*dynamodb.BatchWriteItemOutput, error) {
+	c.batchInputs = append(c.batchInputs, in)
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	out := &dynamodb.BatchWriteItemOutput{ConsumedCapacity: []*dynamodb.ConsumedCapacity{capacity}}
+	for _, r := range in.RequestItems[tTable] {
+		if r.PutRequest != nil && aws.StringValue(r.PutRequest.Item["id"].S) == c.unprocessedItemID {
+			out.UnprocessedItems = map[string][]*dynamodb.WriteRequest{tTable: {r}}
+		}
+	}
+	return out, nil
+}
@@ -35,6 +35,10 @@ type envAccessor struct {
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
+	// Attributes of the table's primary key, and the location of their
+	// values inside events.
+	Key KeyMapping `envconfig:"AWS_DYNAMODB_KEY"`
+
 	// The environment variables below aren't read from the envConfig struct
 	// by the AWS SDK, but rather directly using os.Getenv().
 	// They are nevertheless listed here for documentation purposes.
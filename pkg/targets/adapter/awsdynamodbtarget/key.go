@@ -0,0 +1,112 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbtarget
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
+)
+
+// KeyMapping is the JSON serialized mapping of the attributes of the table's
+// primary key to the location of their values inside events.
+type KeyMapping []v1alpha1.AWSDynamoDBKeyAttribute
+
+// Decode implements envconfig.Decoder.
+func (m *KeyMapping) Decode(value string) error {
+	return json.Unmarshal([]byte(value), (*[]v1alpha1.AWSDynamoDBKeyAttribute)(m))
+}
+
+// itemKey returns the key of the item designated by the given event. The
+// given key takes precedence over the configured key mapping.
+func (m KeyMapping) itemKey(e *cloudevents.Event, key map[string]interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	if len(key) > 0 {
+		av, err := dynamodbattribute.MarshalMap(key)
+		if err != nil {
+			return nil, badRequest(fmt.Errorf("marshaling key: %w", err))
+		}
+		return av, nil
+	}
+
+	if len(m) == 0 {
+		return nil, badRequest(errors.New("the event doesn't specify the key of the item, " +
+			"and no key mapping is configured"))
+	}
+
+	av := make(map[string]*dynamodb.AttributeValue, len(m))
+	for _, attr := range m {
+		v, err := keyAttributeValue(e, attr)
+		if err != nil {
+			return nil, err
+		}
+		av[attr.Name] = v
+	}
+
+	return av, nil
+}
+
+// completeItem sets the key attributes which are missing from the given item
+// to the values found in the given event.
+func (m KeyMapping) completeItem(e *cloudevents.Event, item map[string]*dynamodb.AttributeValue) error {
+	for _, attr := range m {
+		if _, isSet := item[attr.Name]; isSet {
+			continue
+		}
+
+		v, err := keyAttributeValue(e, attr)
+		if err != nil {
+			return err
+		}
+		item[attr.Name] = v
+	}
+
+	return nil
+}
+
+// keyAttributeValue returns the value of the given key attribute from the
+// given event.
+func keyAttributeValue(e *cloudevents.Event, attr v1alpha1.AWSDynamoDBKeyAttribute) (*dynamodb.AttributeValue, error) {
+	var val string
+	switch {
+	case attr.Attribute != nil:
+		val = dispatcher.AttributeKey(*attr.Attribute)(e)
+	case attr.DataPath != nil:
+		val = dispatcher.DataPathKey(*attr.DataPath)(e)
+	}
+
+	if val == "" {
+		return nil, badRequest(fmt.Errorf("the event contains no value for the key attribute %q", attr.Name))
+	}
+
+	if attr.Type != nil && *attr.Type == v1alpha1.AWSDynamoDBKeyAttributeTypeNumber {
+		if _, err := strconv.ParseFloat(val, 64); err != nil {
+			return nil, badRequest(fmt.Errorf("the value of the key attribute %q is not a number: %q", attr.Name, val))
+		}
+		return &dynamodb.AttributeValue{N: &val}, nil
+	}
+
+	return &dynamodb.AttributeValue{S: &val}, nil
+}
@@ -0,0 +1,632 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awsdynamodbtarget
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+	"github.com/aws/aws-sdk-go/service/dynamodb/dynamodbattribute"
+)
+
+// Names of the operations reported in results.
+const (
+	opPut           = "put"
+	opUpdate        = "update"
+	opDelete        = "delete"
+	opTransactWrite = "transactWrite"
+	opBatchWrite    = "batchWrite"
+)
+
+const (
+	// Maximum number of write requests in a single BatchWriteItem call.
+	maxBatchWriteItems = 25
+	// Maximum number of attempts at writing the items of a batch which
+	// were left unprocessed by DynamoDB, and initial delay between them.
+	maxBatchWriteAttempts = 5
+	batchWriteBackoff     = 50 * time.Millisecond
+)
+
+// Payloads of the accepted event types.
+//
+// Items, keys and expression attribute values are expressed in plain JSON,
+// and converted to DynamoDB attribute values by the adapter.
+type (
+	putItemPayload struct {
+		Item map[string]interface{} `json:"item"`
+		condition
+	}
+
+	updateItemPayload struct {
+		Key              map[string]interface{} `json:"key,omitempty"`
+		UpdateExpression string                 `json:"updateExpression"`
+		ReturnValues     *string                `json:"returnValues,omitempty"`
+		condition
+	}
+
+	deleteItemPayload struct {
+		Key          map[string]interface{} `json:"key,omitempty"`
+		ReturnValues *string                `json:"returnValues,omitempty"`
+		condition
+	}
+
+	conditionCheckPayload struct {
+		Key map[string]interface{} `json:"key"`
+		condition
+	}
+
+	transactWritePayload struct {
+		Items []transactWriteItem `json:"items"`
+	}
+
+	transactWriteItem struct {
+		Put            *putItemPayload        `json:"put,omitempty"`
+		Update         *updateItemPayload     `json:"update,omitempty"`
+		Delete         *deleteItemPayload     `json:"delete,omitempty"`
+		ConditionCheck *conditionCheckPayload `json:"conditionCheck,omitempty"`
+	}
+
+	batchWritePayload struct {
+		Put    []map[string]interface{} `json:"put,omitempty"`
+		Delete []map[string]interface{} `json:"delete,omitempty"`
+	}
+)
+
+// condition contains the optional condition of a write operation.
+type condition struct {
+	ConditionExpression       *string                `json:"conditionExpression,omitempty"`
+	ExpressionAttributeNames  map[string]*string     `json:"expressionAttributeNames,omitempty"`
+	ExpressionAttributeValues map[string]interface{} `json:"expressionAttributeValues,omitempty"`
+}
+
+// attributeValues returns the expression attribute values of the condition
+// as DynamoDB attribute values.
+func (c *condition) attributeValues() (map[string]*dynamodb.AttributeValue, error) {
+	if len(c.ExpressionAttributeValues) == 0 {
+		return nil, nil
+	}
+
+	av, err := dynamodbattribute.MarshalMap(c.ExpressionAttributeValues)
+	if err != nil {
+		return nil, badRequest(fmt.Errorf("marshaling expression attribute values: %w", err))
+	}
+	return av, nil
+}
+
+// result is the payload of the events returned by the target.
+type result struct {
+	Operation              string                 `json:"operation"`
+	ConditionalCheckFailed bool                   `json:"conditionalCheckFailed"`
+	Attributes             map[string]interface{} `json:"attributes,omitempty"`
+	ConsumedCapacity       []consumedCapacity     `json:"consumedCapacity,omitempty"`
+	CancellationReasons    []cancellationReason   `json:"cancellationReasons,omitempty"`
+	UnprocessedItems       *batchWritePayload     `json:"unprocessedItems,omitempty"`
+}
+
+// consumedCapacity is the number of capacity units consumed by an operation.
+type consumedCapacity struct {
+	TableName     string  `json:"tableName"`
+	CapacityUnits float64 `json:"capacityUnits"`
+}
+
+// cancellationReason is the reason why an item caused the cancellation of a
+// transaction.
+type cancellationReason struct {
+	Code    string `json:"code"`
+	Message string `json:"message,omitempty"`
+}
+
+// putItem creates or replaces the item contained in the given event.
+func (a *adapter) putItem(e *cloudevents.Event) (*result, error) {
+	p := &putItemPayload{}
+	if err := e.DataAs(p); err != nil {
+		return nil, badRequest(fmt.Errorf("deserializing event data: %w", err))
+	}
+
+	return a.doPutItem(e, p)
+}
+
+// doPutItem creates or replaces the item described by the given payload.
+func (a *adapter) doPutItem(e *cloudevents.Event, p *putItemPayload) (*result, error) {
+	item, err := marshalItem(p.Item)
+	if err != nil {
+		return nil, err
+	}
+	if err := a.key.completeItem(e, item); err != nil {
+		return nil, err
+	}
+
+	vals, err := p.attributeValues()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.dynamoDBClient.PutItem(&dynamodb.PutItemInput{
+		TableName:                 &a.awsDynamoDBTableName,
+		Item:                      item,
+		ConditionExpression:       p.ConditionExpression,
+		ExpressionAttributeNames:  p.ExpressionAttributeNames,
+		ExpressionAttributeValues: vals,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	})
+
+	res := &result{Operation: opPut}
+	if err != nil {
+		if isConditionalCheckFailed(err) {
+			res.ConditionalCheckFailed = true
+			return res, nil
+		}
+		return nil, awsError("PutItem", err)
+	}
+
+	res.ConsumedCapacity = toConsumedCapacity(out.ConsumedCapacity)
+	return res, nil
+}
+
+// updateItem updates the attributes of the item designated by the given event.
+func (a *adapter) updateItem(e *cloudevents.Event) (*result, error) {
+	p := &updateItemPayload{}
+	if err := e.DataAs(p); err != nil {
+		return nil, badRequest(fmt.Errorf("deserializing event data: %w", err))
+	}
+	if p.UpdateExpression == "" {
+		return nil, badRequest(errors.New("the update expression is empty"))
+	}
+
+	key, err := a.key.itemKey(e, p.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := p.attributeValues()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.dynamoDBClient.UpdateItem(&dynamodb.UpdateItemInput{
+		TableName:                 &a.awsDynamoDBTableName,
+		Key:                       key,
+		UpdateExpression:          &p.UpdateExpression,
+		ConditionExpression:       p.ConditionExpression,
+		ExpressionAttributeNames:  p.ExpressionAttributeNames,
+		ExpressionAttributeValues: vals,
+		ReturnValues:              p.ReturnValues,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	})
+
+	res := &result{Operation: opUpdate}
+	if err != nil {
+		if isConditionalCheckFailed(err) {
+			res.ConditionalCheckFailed = true
+			return res, nil
+		}
+		return nil, awsError("UpdateItem", err)
+	}
+
+	if res.Attributes, err = unmarshalItem(out.Attributes); err != nil {
+		return nil, err
+	}
+	res.ConsumedCapacity = toConsumedCapacity(out.ConsumedCapacity)
+	return res, nil
+}
+
+// deleteItem deletes the item designated by the given event.
+func (a *adapter) deleteItem(e *cloudevents.Event) (*result, error) {
+	p := &deleteItemPayload{}
+	// the payload of delete events is optional when the key of the item
+	// is mapped from the event
+	if len(e.Data()) > 0 {
+		if err := e.DataAs(p); err != nil {
+			return nil, badRequest(fmt.Errorf("deserializing event data: %w", err))
+		}
+	}
+
+	key, err := a.key.itemKey(e, p.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	vals, err := p.attributeValues()
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := a.dynamoDBClient.DeleteItem(&dynamodb.DeleteItemInput{
+		TableName:                 &a.awsDynamoDBTableName,
+		Key:                       key,
+		ConditionExpression:       p.ConditionExpression,
+		ExpressionAttributeNames:  p.ExpressionAttributeNames,
+		ExpressionAttributeValues: vals,
+		ReturnValues:              p.ReturnValues,
+		ReturnConsumedCapacity:    aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	})
+
+	res := &result{Operation: opDelete}
+	if err != nil {
+		if isConditionalCheckFailed(err) {
+			res.ConditionalCheckFailed = true
+			return res, nil
+		}
+		return nil, awsError("DeleteItem", err)
+	}
+
+	if res.Attributes, err = unmarshalItem(out.Attributes); err != nil {
+		return nil, err
+	}
+	res.ConsumedCapacity = toConsumedCapacity(out.ConsumedCapacity)
+	return res, nil
+}
+
+// transactWrite writes the items contained in the given event in a single
+// all-or-nothing transaction.
+func (a *adapter) transactWrite(e *cloudevents.Event) (*result, error) {
+	p := &transactWritePayload{}
+	if err := e.DataAs(p); err != nil {
+		return nil, badRequest(fmt.Errorf("deserializing event data: %w", err))
+	}
+	if len(p.Items) == 0 {
+		return nil, badRequest(errors.New("the transaction contains no item"))
+	}
+
+	items := make([]*dynamodb.TransactWriteItem, len(p.Items))
+	for i := range p.Items {
+		item, err := a.transactWriteItem(&p.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("items[%d]: %w", i, err)
+		}
+		items[i] = item
+	}
+
+	out, err := a.dynamoDBClient.TransactWriteItems(&dynamodb.TransactWriteItemsInput{
+		TransactItems:          items,
+		ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
+	})
+
+	res := &result{Operation: opTransactWrite}
+	if err != nil {
+		// Transactions cancelled due to unsatisfied conditions are
+		// reported to the sender. Transactions cancelled for any
+		// other reason, such as conflicts, are worth retrying.
+		var tce *dynamodb.TransactionCanceledException
+		if !errors.As(err, &tce) || !hasConditionalCheckFailed(tce.CancellationReasons) {
+			return nil, awsError("TransactWriteItems", err)
+		}
+
+		res.ConditionalCheckFailed = true
+		for _, r := range tce.CancellationReasons {
+			res.CancellationReasons = append(res.CancellationReasons, cancellationReason{
+				Code:    aws.StringValue(r.Code),
+				Message: aws.StringValue(r.Message),
+			})
+		}
+		return res, nil
+	}
+
+	res.ConsumedCapacity = toConsumedCapacity(out.ConsumedCapacity...)
+	return res, nil
+}
+
+// transactWriteItem converts the given item of a transaction to its
+// DynamoDB representation. The key of items is never mapped from the event,
+// since transactions typically involve distinct items.
+func (a *adapter) transactWriteItem(it *transactWriteItem) (*dynamodb.TransactWriteItem, error) {
+	var numOps int
+	for _, isSet := range []bool{it.Put != nil, it.Update != nil, it.Delete != nil, it.ConditionCheck != nil} {
+		if isSet {
+			numOps++
+		}
+	}
+	if numOps != 1 {
+		return nil, badRequest(errors.New("exactly one of put, update, delete or conditionCheck must be specified"))
+	}
+
+	switch {
+	case it.Put != nil:
+		item, err := marshalItem(it.Put.Item)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := it.Put.attributeValues()
+		if err != nil {
+			return nil, err
+		}
+		return &dynamodb.TransactWriteItem{Put: &dynamodb.Put{
+			TableName:                 &a.awsDynamoDBTableName,
+			Item:                      item,
+			ConditionExpression:       it.Put.ConditionExpression,
+			ExpressionAttributeNames:  it.Put.ExpressionAttributeNames,
+			ExpressionAttributeValues: vals,
+		}}, nil
+
+	case it.Update != nil:
+		if it.Update.UpdateExpression == "" {
+			return nil, badRequest(errors.New("the update expression is empty"))
+		}
+		key, err := marshalKey(it.Update.Key)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := it.Update.attributeValues()
+		if err != nil {
+			return nil, err
+		}
+		return &dynamodb.TransactWriteItem{Update: &dynamodb.Update{
+			TableName:                 &a.awsDynamoDBTableName,
+			Key:                       key,
+			UpdateExpression:          &it.Update.UpdateExpression,
+			ConditionExpression:       it.Update.ConditionExpression,
+			ExpressionAttributeNames:  it.Update.ExpressionAttributeNames,
+			ExpressionAttributeValues: vals,
+		}}, nil
+
+	case it.Delete != nil:
+		key, err := marshalKey(it.Delete.Key)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := it.Delete.attributeValues()
+		if err != nil {
+			return nil, err
+		}
+		return &dynamodb.TransactWriteItem{Delete: &dynamodb.Delete{
+			TableName:                 &a.awsDynamoDBTableName,
+			Key:                       key,
+			ConditionExpression:       it.Delete.ConditionExpression,
+			ExpressionAttributeNames:  it.Delete.ExpressionAttributeNames,
+			ExpressionAttributeValues: vals,
+		}}, nil
+
+	default:
+		if it.ConditionCheck.ConditionExpression == nil {
+			return nil, badRequest(errors.New("the condition expression of the condition check is empty"))
+		}
+		key, err := marshalKey(it.ConditionCheck.Key)
+		if err != nil {
+			return nil, err
+		}
+		vals, err := it.ConditionCheck.attributeValues()
+		if err != nil {
+			return nil, err
+		}
+		return &dynamodb.TransactWriteItem{ConditionCheck: &dynamodb.ConditionCheck{
+			TableName:                 &a.awsDynamoDBTableName,
+			Key:                       key,
+			ConditionExpression:       it.ConditionCheck.ConditionExpression,
+			ExpressionAttributeNames:  it.ConditionCheck.ExpressionAttributeNames,
+			ExpressionAttributeValues: vals,
+		}}, nil
+	}
+}
+
+// batchWrite puts and deletes the items contained in the given event. Unlike
+// transactions, batches aren't atomic.
+func (a *adapter) batchWrite(e *cloudevents.Event) (*result, error) {
+	p := &batchWritePayload{}
+	if err := e.DataAs(p); err != nil {
+		return nil, badRequest(fmt.Errorf("deserializing event data: %w", err))
+	}
+
+	reqs := make([]*dynamodb.WriteRequest, 0, len(p.Put)+len(p.Delete))
+	for i, it := range p.Put {
+		item, err := marshalItem(it)
+		if err != nil {
+			return nil, fmt.Errorf("put[%d]: %w", i, err)
+		}
+		reqs = append(reqs, &dynamodb.WriteRequest{PutRequest: &dynamodb.PutRequest{Item: item}})
+	}
+	for i, k := range p.Delete {
+		key, err := marshalKey(k)
+		if err != nil {
+			return nil, fmt.Errorf("delete[%d]: %w", i, err)
+		}
+		reqs = append(reqs, &dynamodb.WriteRequest{DeleteRequest: &dynamodb.DeleteRequest{Key: key}})
+	}
+	if len(reqs) == 0 {
+		return nil, badRequest(errors.New("the batch contains no item"))
+	}
+
+	res := &result{Operation: opBatchWrite}
+
+	var unprocessed []*dynamodb.WriteRequest
+	for len(reqs) > 0 {
+		n := len(reqs)
+		if n > maxBatchWriteItems {
+			n = maxBatchWriteItems
+		}
+
+		left, err := a.writeBatch(reqs[:n], res)
+		if err != nil {
+			return nil, err
+		}
+		unprocessed = append(unprocessed, left...)
+
+		reqs = reqs[n:]
+	}
+
+	if len(unprocessed) > 0 {
+		var err error
+		if res.UnprocessedItems, err = toBatchWritePayload(unprocessed); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// writeBatch writes the given batch of items, retrying the items which were
+// left unprocessed by DynamoDB with an exponential backoff. It returns the
+// items which remain unprocessed after the last attempt.
+func (a *adapter) writeBatch(reqs []*dynamodb.WriteRequest, res *result) ([]*dynamodb.WriteRequest, error) {
+	for attempt := 1; ; attempt++ {
+		out, err := a.dynamoDBClient.BatchWriteItem(&dynamodb.BatchWriteItemInput{
+			RequestItems: map[string][]*dynamodb.WriteRequest{
+				a.awsDynamoDBTableName: reqs,
+			},
+			ReturnConsumedCapacity: aws.String(dynamodb.ReturnConsumedCapacityTotal),
+		})
+		if err != nil {
+			return nil, awsError("BatchWriteItem", err)
+		}
+
+		res.ConsumedCapacity = append(res.ConsumedCapacity, toConsumedCapacity(out.ConsumedCapacity...)...)
+
+		reqs = out.UnprocessedItems[a.awsDynamoDBTableName]
+		if len(reqs) == 0 || attempt == maxBatchWriteAttempts {
+			return reqs, nil
+		}
+
+		time.Sleep(batchWriteBackoff << (attempt - 1))
+	}
+}
+
+// marshalItem converts the given JSON item to DynamoDB attribute values.
+func marshalItem(item map[string]interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	if len(item) == 0 {
+		return nil, badRequest(errors.New("the item is empty"))
+	}
+
+	av, err := dynamodbattribute.MarshalMap(item)
+	if err != nil {
+		return nil, badRequest(fmt.Errorf("marshaling item: %w", err))
+	}
+	return av, nil
+}
+
+// marshalKey converts the given JSON key to DynamoDB attribute values.
+func marshalKey(key map[string]interface{}) (map[string]*dynamodb.AttributeValue, error) {
+	if len(key) == 0 {
+		return nil, badRequest(errors.New("the key is empty"))
+	}
+
+	av, err := dynamodbattribute.MarshalMap(key)
+	if err != nil {
+		return nil, badRequest(fmt.Errorf("marshaling key: %w", err))
+	}
+	return av, nil
+}
+
+// unmarshalItem converts the given DynamoDB attribute values to JSON.
+func unmarshalItem(av map[string]*dynamodb.AttributeValue) (map[string]interface{}, error) {
+	if len(av) == 0 {
+		return nil, nil
+	}
+
+	var item map[string]interface{}
+	if err := dynamodbattribute.UnmarshalMap(av, &item); err != nil {
+		return nil, fmt.Errorf("unmarshaling item: %w", err)
+	}
+	return item, nil
+}
+
+// toBatchWritePayload converts the given write requests to their JSON
+// representation.
+func toBatchWritePayload(reqs []*dynamodb.WriteRequest) (*batchWritePayload, error) {
+	p := &batchWritePayload{}
+
+	for _, r := range reqs {
+		switch {
+		case r.PutRequest != nil:
+			item, err := unmarshalItem(r.PutRequest.Item)
+			if err != nil {
+				return nil, err
+			}
+			p.Put = append(p.Put, item)
+
+		case r.DeleteRequest != nil:
+			key, err := unmarshalItem(r.DeleteRequest.Key)
+			if err != nil {
+				return nil, err
+			}
+			p.Delete = append(p.Delete, key)
+		}
+	}
+
+	return p, nil
+}
+
+// toConsumedCapacity converts the given DynamoDB consumed capacities to
+// their JSON representation.
+func toConsumedCapacity(ccs ...*dynamodb.ConsumedCapacity) []consumedCapacity {
+	var out []consumedCapacity
+	for _, cc := range ccs {
+		if cc == nil {
+			continue
+		}
+		out = append(out, consumedCapacity{
+			TableName:     aws.StringValue(cc.TableName),
+			CapacityUnits: aws.Float64Value(cc.CapacityUnits),
+		})
+	}
+	return out
+}
+
+// isConditionalCheckFailed returns whether the given error was caused by an
+// unsatisfied condition expression.
+func isConditionalCheckFailed(err error) bool {
+	var awsErr awserr.Error
+	return errors.As(err, &awsErr) && awsErr.Code() == dynamodb.ErrCodeConditionalCheckFailedException
+}
+
+// hasConditionalCheckFailed returns whether any of the given reasons for the
+// cancellation of a transaction is an unsatisfied condition expression.
+func hasConditionalCheckFailed(reasons []*dynamodb.CancellationReason) bool {
+	for _, r := range reasons {
+		// https://docs.aws.amazon.com/amazondynamodb/latest/APIReference/API_TransactWriteItems.html
+		if aws.StringValue(r.Code) == "ConditionalCheckFailed" {
+			return true
+		}
+	}
+	return false
+}
+
+// awsError wraps an error returned by the DynamoDB API, and flags errors
+// caused by invalid requests.
+func awsError(op string, err error) error {
+	err = fmt.Errorf("invoking %s: %w", op, err)
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && awsErr.Code() == "ValidationException" {
+		return badRequest(err)
+	}
+	return err
+}
+
+// badRequestError is an error caused by an invalid event.
+type badRequestError struct {
+	error
+}
+
+// Unwrap returns the wrapped error.
+func (e badRequestError) Unwrap() error {
+	return e.error
+}
+
+// badRequest flags the given error as caused by an invalid event.
+func badRequest(err error) error {
+	return badRequestError{err}
+}
+
+// isBadRequest returns whether the given error was caused by an invalid event.
+func isBadRequest(err error) bool {
+	return errors.As(err, &badRequestError{})
+}
@@ -19,6 +19,7 @@ package awseventbridgetarget
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -33,9 +34,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
 )
 
 // NewTarget Adapter implementation
@@ -54,16 +58,16 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(a.Region).
-		WithMaxRetries(5)))
+		WithMaxRetries(5))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
 		config.Credentials = stscreds.NewCredentials(sess, env.AssumeIamRole)
 	}
 
-	return &adapter{
+	adapter := &adapter{
 		awsArnString:      env.AwsTargetArn,
 		awsArn:            a,
 		discardCEContext:  env.DiscardCEContext,
@@ -74,6 +78,23 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.Mapping.AWSEventBridgeMapping != nil {
+		var err error
+		if adapter.mapping, err = newEntryMapping(env.Mapping.AWSEventBridgeMapping); err != nil {
+			logger.Panicf("Invalid entry mapping: %v", err)
+		}
+	}
+
+	if env.BatchMaxEntries > 0 {
+		adapter.batcher = batcher.New(batcher.Limits{
+			MaxItems: env.BatchMaxEntries,
+			MaxBytes: maxPutEventsBytes,
+			MaxDelay: env.BatchMaxDelay,
+		}, entrySize, adapter.putEvents)
+	}
+
+	return adapter
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
@@ -81,7 +102,10 @@ var _ pkgadapter.Adapter = (*adapter)(nil)
 type adapter struct {
 	awsArnString      string
 	awsArn            arn.ARN
-	eventBridgeClient *eventbridge.EventBridge
+	eventBridgeClient eventbridgeiface.EventBridgeAPI
+
+	mapping *entryMapping
+	batcher *batcher.Batcher[*eventbridge.PutEventsRequestEntry, *eventbridge.PutEventsResultEntry]
 
 	discardCEContext bool
 	ceClient         cloudevents.Client
@@ -92,11 +116,16 @@ type adapter struct {
 
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting AWS EventBridge Target adapter")
+
+	if a.batcher != nil {
+		go a.batcher.Run(ctx)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var msg []byte
 
 	if a.discardCEContext {
@@ -109,21 +138,38 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		msg = jsonEvent
 	}
 
-	result, err := a.eventBridgeClient.PutEvents(&eventbridge.PutEventsInput{
-		Entries: []*eventbridge.PutEventsRequestEntry{
-			{
-				Detail:       aws.String(string(msg)),
-				DetailType:   aws.String(event.Type()),
-				EventBusName: &a.awsArnString,
-				Source:       aws.String(event.Source()),
-			},
-		},
-	})
+	entry := &eventbridge.PutEventsRequestEntry{
+		Detail:       aws.String(string(msg)),
+		DetailType:   aws.String(event.Type()),
+		EventBusName: &a.awsArnString,
+		Source:       aws.String(event.Source()),
+	}
 
+	if a.mapping != nil {
+		if err := a.mapping.apply(entry, &event); err != nil {
+			return a.reportErrorCode(http.StatusBadRequest, "error applying entry mapping", err)
+		}
+	}
+
+	res, err := a.putEntry(ctx, entry)
 	if err != nil {
 		return a.reportError("error publishing to eventbridge", err)
 	}
 
+	if res.ErrorCode != nil {
+		code := http.StatusInternalServerError
+		if isInvalidEntryError(res) {
+			code = http.StatusBadRequest
+		}
+		return a.reportErrorCode(code, "error publishing to eventbridge",
+			fmt.Errorf("%s: %s", *res.ErrorCode, aws.StringValue(res.ErrorMessage)))
+	}
+
+	result := &eventbridge.PutEventsOutput{
+		Entries:          []*eventbridge.PutEventsResultEntry{res},
+		FailedEntryCount: aws.Int64(0),
+	}
+
 	jsonResult, err := json.Marshal(result)
 	if err != nil {
 		return a.reportError("Error marshalling result", err)
@@ -141,7 +187,30 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 	return &responseEvent, cloudevents.ResultACK
 }
 
+// putEntry sends a single entry, as part of a batch when batching is enabled.
+func (a *adapter) putEntry(ctx context.Context,
+	entry *eventbridge.PutEventsRequestEntry) (*eventbridge.PutEventsResultEntry, error) {
+
+	if a.batcher != nil {
+		return a.batcher.Add(ctx, entry)
+	}
+
+	results, err := a.putEvents(ctx, []*eventbridge.PutEventsRequestEntry{entry})
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 1 {
+		return nil, fmt.Errorf("got %d results for a single entry", len(results))
+	}
+
+	return results[0], nil
+}
+
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
+	return a.reportErrorCode(http.StatusInternalServerError, msg, err)
+}
+
+func (a *adapter) reportErrorCode(code int, msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
-	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
+	return nil, cloudevents.NewHTTPResult(code, msg)
 }
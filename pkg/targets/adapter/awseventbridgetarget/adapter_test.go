@@ -0,0 +1,220 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awseventbridgetarget
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+const tARN = "arn:aws:events:us-east-1:123456789012:event-bus/my-bus"
+
+func TestEntryMapping(t *testing.T) {
+	testCases := map[string]struct {
+		mapping v1alpha1.AWSEventBridgeMapping
+
+		expectDetailType   string
+		expectSource       string
+		expectEventBusName string
+		expectResources    []*string
+	}{
+		"No template": {
+			expectDetailType:   "test.type",
+			expectSource:       "test.source",
+			expectEventBusName: tARN,
+		},
+		"Templates from attributes and data": {
+			mapping: v1alpha1.AWSEventBridgeMapping{
+				DetailType:   aws.String("Order {{ .data.status }}"),
+				Source:       aws.String("com.example.{{ .subject }}"),
+				EventBusName: aws.String("bus-{{ .data.region }}"),
+				Resources: []string{
+					"arn:aws:dynamodb:us-east-1:123456789012:table/{{ .data.table }}",
+				},
+			},
+			expectDetailType:   "Order created",
+			expectSource:       "com.example.orders",
+			expectEventBusName: "bus-eu",
+			expectResources: []*string{
+				aws.String("arn:aws:dynamodb:us-east-1:123456789012:table/orders"),
+			},
+		},
+		"Empty renders are ignored": {
+			mapping: v1alpha1.AWSEventBridgeMapping{
+				DetailType: aws.String("{{ with .data.nosuchfield }}{{ . }}{{ end }}"),
+				Resources: []string{
+					"{{ with .data.nosuchfield }}{{ . }}{{ end }}",
+					"arn:aws:s3:::my-bucket",
+				},
+			},
+			expectDetailType:   "test.type",
+			expectSource:       "test.source",
+			expectEventBusName: tARN,
+			expectResources: []*string{
+				aws.String("arn:aws:s3:::my-bucket"),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			m, err := newEntryMapping(&tc.mapping)
+			require.NoError(t, err)
+
+			e := newOrderEvent(t)
+			entry := &eventbridge.PutEventsRequestEntry{
+				DetailType:   aws.String(e.Type()),
+				EventBusName: aws.String(tARN),
+				Source:       aws.String(e.Source()),
+			}
+
+			require.NoError(t, m.apply(entry, &e))
+
+			assert.Equal(t, tc.expectDetailType, *entry.DetailType)
+			assert.Equal(t, tc.expectSource, *entry.Source)
+			assert.Equal(t, tc.expectEventBusName, *entry.EventBusName)
+			assert.Equal(t, tc.expectResources, entry.Resources)
+		})
+	}
+}
+
+func TestInvalidEntryMapping(t *testing.T) {
+	_, err := newEntryMapping(&v1alpha1.AWSEventBridgeMapping{
+		Resources: []string{"arn", "{{ .data"},
+	})
+	assert.ErrorContains(t, err, "resources[1]: ")
+}
+
+func TestDispatchFailure(t *testing.T) {
+	testCases := map[string]struct {
+		err        error
+		errCode    string
+		expectCode int
+	}{
+		"Failed request": {
+			err:        errors.New("fake error"),
+			expectCode: 500,
+		},
+		"Invalid entry": {
+			errCode:    "MalformedDetail",
+			expectCode: 400,
+		},
+		"Other failure": {
+			errCode:    "AccessDeniedException",
+			expectCode: 500,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			cli := &mockEventBridgeClient{err: tc.err, errCode: tc.errCode}
+
+			a := &adapter{
+				awsArnString:      tARN,
+				eventBridgeClient: cli,
+				logger:            loggingtesting.TestLogger(t),
+			}
+
+			_, res := a.dispatch(context.Background(), newOrderEvent(t))
+
+			var httpRes *cehttp.Result
+			require.True(t, protocol.ResultAs(res, &httpRes), "Expected an HTTP result")
+			assert.Equal(t, tc.expectCode, httpRes.StatusCode)
+		})
+	}
+}
+
+func TestEntrySize(t *testing.T) {
+	e := &eventbridge.PutEventsRequestEntry{
+		Detail:     aws.String(`{"a":1}`),
+		DetailType: aws.String("type"),
+		Source:     aws.String("src"),
+		Resources:  []*string{aws.String("arn")},
+		Time:       aws.Time(time.Now()),
+	}
+	assert.Equal(t, 7+4+3+3+14, entrySize(e))
+}
+
+// newOrderEvent returns a CloudEvent about an order.
+func newOrderEvent(t *testing.T) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("0000")
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	e.SetSubject("orders")
+
+	data := map[string]interface{}{
+		"status": "created",
+		"region": "eu",
+		"table":  "orders",
+	}
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, data))
+
+	return e
+}
+
+// mockEventBridgeClient is a mock implementation of the EventBridge API which
+// rejects all entries of PutEvents calls.
+type mockEventBridgeClient struct {
+	eventbridgeiface.EventBridgeAPI
+
+	// error returned by calls
+	err error
+	// code of the error reported for each entry
+	errCode string
+}
+
+func (c *mockEventBridgeClient) PutEventsWithContext(_ aws.Context, in *eventbridge.PutEventsInput,
+	_ ...request.Option) (*eventbridge.PutEventsOutput, error) {
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	out := &eventbridge.PutEventsOutput{
+		FailedEntryCount: aws.Int64(int64(len(in.Entries))),
+	}
+	for range in.Entries {
+		out.Entries = append(out.Entries, &eventbridge.PutEventsResultEntry{
+			ErrorCode:    aws.String(c.errCode),
+			ErrorMessage: aws.String("Entry rejected"),
+		})
+	}
+
+	return out, nil
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awseventbridgetarget
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
+)
+
+// EventBridge limits
+// https://docs.aws.amazon.com/eventbridge/latest/userguide/eb-putevent-size.html
+const (
+	maxPutEventsBytes = 256 * 1024
+	entryTimeBytes    = 14
+)
+
+// errCodeRequestFailed is reported for entries of a PutEvents request which
+// failed as a whole.
+const errCodeRequestFailed = "RequestFailed"
+
+// entrySize implements batcher.SizeFunc. The size of an entry is calculated
+// the way EventBridge does it.
+func entrySize(e *eventbridge.PutEventsRequestEntry) int {
+	size := len(aws.StringValue(e.Source)) +
+		len(aws.StringValue(e.DetailType)) +
+		len(aws.StringValue(e.Detail))
+
+	if e.Time != nil {
+		size += entryTimeBytes
+	}
+	for _, r := range e.Resources {
+		size += len(aws.StringValue(r))
+	}
+
+	return size
+}
+
+// putEventsRetry defines how the entries of a batch which failed to be sent
+// due to a transient error, such as throttling, are retried.
+var putEventsRetry = batcher.Retry[*eventbridge.PutEventsResultEntry]{
+	MaxAttempts: 5,
+	Backoff:     100 * time.Millisecond,
+	Retryable:   isRetryableEntryError,
+}
+
+// putEvents implements batcher.SendFunc. Entries which failed due to a
+// transient error are retried, and the failure of a request is reported in
+// the result of each of its entries.
+func (a *adapter) putEvents(ctx context.Context,
+	entries []*eventbridge.PutEventsRequestEntry) ([]*eventbridge.PutEventsResultEntry, error) {
+
+	results, err := batcher.WithRetries(a.putEventsOnce, putEventsRetry)(ctx, entries)
+	if err != nil {
+		res := failedEntryResult(err)
+		results = make([]*eventbridge.PutEventsResultEntry, len(entries))
+		for i := range results {
+			results[i] = res
+		}
+	}
+
+	return results, nil
+}
+
+// putEventsOnce sends the given entries in a single PutEvents request.
+func (a *adapter) putEventsOnce(ctx context.Context,
+	entries []*eventbridge.PutEventsRequestEntry) ([]*eventbridge.PutEventsResultEntry, error) {
+
+	out, err := a.eventBridgeClient.PutEventsWithContext(ctx, &eventbridge.PutEventsInput{
+		Entries: entries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Entries, nil
+}
+
+// isRetryableEntryError returns whether the given entry failed to be sent
+// due to a transient error.
+func isRetryableEntryError(r *eventbridge.PutEventsResultEntry) bool {
+	switch aws.StringValue(r.ErrorCode) {
+	case "InternalFailure", "ThrottlingException":
+		return true
+	}
+	return false
+}
+
+// isInvalidEntryError returns whether the given entry was rejected because
+// of its content, in which case sending it again can not succeed.
+func isInvalidEntryError(r *eventbridge.PutEventsResultEntry) bool {
+	switch aws.StringValue(r.ErrorCode) {
+	case "MalformedDetail", "InvalidArgument", "ValidationException":
+		return true
+	}
+	return false
+}
+
+// failedEntryResult returns the result of an entry which belongs to a
+// PutEvents request that failed with the given error.
+func failedEntryResult(err error) *eventbridge.PutEventsResultEntry {
+	code := errCodeRequestFailed
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		code = awsErr.Code()
+	}
+
+	return &eventbridge.PutEventsResultEntry{
+		ErrorCode:    &code,
+		ErrorMessage: aws.String(err.Error()),
+	}
+}
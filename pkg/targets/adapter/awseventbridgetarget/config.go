@@ -17,6 +17,8 @@ limitations under the License.
 package awseventbridgetarget
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -32,6 +34,14 @@ type envAccessor struct {
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
+	// Templates used to build entries from events.
+	Mapping EntryMapping `envconfig:"AWS_EVENTBRIDGE_MAPPING"`
+
+	// Batching of entries in PutEvents requests. Disabled when the
+	// maximum number of entries is zero.
+	BatchMaxEntries int           `envconfig:"AWS_EVENTBRIDGE_BATCH_MAX_ENTRIES"`
+	BatchMaxDelay   time.Duration `envconfig:"AWS_EVENTBRIDGE_BATCH_MAX_DELAY" default:"1s"`
+
 	// Assume this IAM Role when access keys provided.
 	AssumeIamRole string `envconfig:"AWS_ASSUME_ROLE_ARN"`
 
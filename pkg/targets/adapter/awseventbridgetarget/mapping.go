@@ -0,0 +1,150 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awseventbridgetarget
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+// EntryMapping is the JSON serialized mapping used to build entries.
+type EntryMapping struct {
+	*v1alpha1.AWSEventBridgeMapping
+}
+
+// Decode implements envconfig.Decoder.
+func (m *EntryMapping) Decode(value string) error {
+	m.AWSEventBridgeMapping = &v1alpha1.AWSEventBridgeMapping{}
+	return json.Unmarshal([]byte(value), m.AWSEventBridgeMapping)
+}
+
+// entryMapping holds the compiled templates used to build entries. Nil
+// templates leave the corresponding attribute of entries untouched.
+type entryMapping struct {
+	detailType   *template.Template
+	source       *template.Template
+	resources    []*template.Template
+	eventBusName *template.Template
+}
+
+func newEntryMapping(m *v1alpha1.AWSEventBridgeMapping) (*entryMapping, error) {
+	em := &entryMapping{}
+
+	var err error
+	if em.detailType, err = parseTemplate(m.DetailType); err != nil {
+		return nil, fmt.Errorf("detailType: %w", err)
+	}
+	if em.source, err = parseTemplate(m.Source); err != nil {
+		return nil, fmt.Errorf("source: %w", err)
+	}
+	if em.eventBusName, err = parseTemplate(m.EventBusName); err != nil {
+		return nil, fmt.Errorf("eventBusName: %w", err)
+	}
+
+	if len(m.Resources) != 0 {
+		em.resources = make([]*template.Template, len(m.Resources))
+		for i := range m.Resources {
+			if em.resources[i], err = parseTemplate(&m.Resources[i]); err != nil {
+				return nil, fmt.Errorf("resources[%d]: %w", i, err)
+			}
+		}
+	}
+
+	return em, nil
+}
+
+// parseTemplate parses the given Go template, if any.
+func parseTemplate(tpl *string) (*template.Template, error) {
+	if tpl == nil {
+		return nil, nil
+	}
+	return template.New("").Parse(*tpl)
+}
+
+// apply sets the attributes of the given entry by evaluating the templates
+// of the mapping against the given event. Templates which render an empty
+// string are ignored.
+func (m *entryMapping) apply(entry *eventbridge.PutEventsRequestEntry, event *cloudevents.Event) error {
+	in, err := eventInput(event)
+	if err != nil {
+		return err
+	}
+
+	if err := renderInto(&entry.DetailType, m.detailType, in); err != nil {
+		return fmt.Errorf("detailType: %w", err)
+	}
+	if err := renderInto(&entry.Source, m.source, in); err != nil {
+		return fmt.Errorf("source: %w", err)
+	}
+	if err := renderInto(&entry.EventBusName, m.eventBusName, in); err != nil {
+		return fmt.Errorf("eventBusName: %w", err)
+	}
+
+	for i, t := range m.resources {
+		var res *string
+		if err := renderInto(&res, t, in); err != nil {
+			return fmt.Errorf("resources[%d]: %w", i, err)
+		}
+		if res != nil {
+			entry.Resources = append(entry.Resources, res)
+		}
+	}
+
+	return nil
+}
+
+// renderInto renders the given template and assigns the result to dst,
+// unless the template is nil or renders an empty string.
+func renderInto(dst **string, tpl *template.Template, input interface{}) error {
+	if tpl == nil {
+		return nil
+	}
+
+	var b bytes.Buffer
+	if err := tpl.Execute(&b, input); err != nil {
+		return err
+	}
+
+	if b.Len() != 0 {
+		s := b.String()
+		*dst = &s
+	}
+	return nil
+}
+
+// eventInput returns the generic representation of the given event used as
+// the input of templates.
+func eventInput(event *cloudevents.Event) (interface{}, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var in interface{}
+	if err := json.Unmarshal(b, &in); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
@@ -19,6 +19,7 @@ package awskinesistarget
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"strings"
 
@@ -36,8 +37,11 @@ import (
 	"github.com/aws/aws-sdk-go/service/kinesis"
 	"github.com/aws/aws-sdk-go/service/kinesis/kinesisiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 // NewTarget Adapter implementation
@@ -56,27 +60,47 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(a.Region).
-		WithMaxRetries(5)))
+		WithMaxRetries(5))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
 		config.Credentials = stscreds.NewCredentials(sess, env.AssumeIamRole)
 	}
 
-	return &adapter{
+	// Stream name must be present, however the ARN encodes the resource as stream/<stream_name>
+	var streamName string
+	if res := strings.Split(a.Resource, "/"); len(res) == 2 {
+		streamName = res[1]
+	}
+
+	adapter := &adapter{
 		awsArnString:        env.AwsTargetArn,
 		awsArn:              a,
 		awsKinesisPartition: env.AwsKinesisPartition,
+		streamName:          streamName,
 		knsClient:           kinesis.New(sess, config),
 
+		partitionKey:    dispatcher.NewKeyFunc(env.PartitionKeyAttribute, env.PartitionKeyDataPath),
+		explicitHashKey: dispatcher.NewKeyFunc(env.ExplicitHashKeyAttribute, env.ExplicitHashKeyDataPath),
+
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
 		logger:           logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.BatchMaxRecords > 0 {
+		adapter.batcher = batcher.New(batcher.Limits{
+			MaxItems: env.BatchMaxRecords,
+			MaxBytes: maxPutRecordsBytes,
+			MaxDelay: env.BatchMaxDelay,
+		}, recordSize, adapter.putRecords)
+	}
+
+	return adapter
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
@@ -85,8 +109,13 @@ type adapter struct {
 	awsArnString        string
 	awsArn              arn.ARN
 	awsKinesisPartition string
+	streamName          string
 	knsClient           kinesisiface.KinesisAPI
 
+	partitionKey    dispatcher.KeyFunc
+	explicitHashKey dispatcher.KeyFunc
+	batcher         *batcher.Batcher[*kinesis.PutRecordsRequestEntry, *kinesis.PutRecordsResultEntry]
+
 	discardCEContext bool
 
 	ceClient cloudevents.Client
@@ -97,11 +126,16 @@ type adapter struct {
 
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting AWS Kinesis Target adapter")
+
+	if a.batcher != nil {
+		go a.batcher.Run(ctx)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var data []byte
 
 	if a.discardCEContext {
@@ -114,24 +148,48 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		data = jsonEvent
 	}
 
-	// Stream name must be present, however the ARN encodes the resource as stream/<stream_name>
-	streamName := strings.Split(a.awsArn.Resource, "/")
-	if len(streamName) != 2 {
+	if a.streamName == "" {
 		return a.reportError("unable to extract kinesis stream name from ARN", nil)
 	}
 
-	result, err := a.knsClient.PutRecord(&kinesis.PutRecordInput{
-		Data:         data,
-		PartitionKey: &a.awsKinesisPartition,
-		StreamName:   &streamName[1],
-	})
+	record := &kinesis.PutRecordsRequestEntry{
+		Data:            data,
+		PartitionKey:    aws.String(a.recordPartitionKey(&event)),
+		ExplicitHashKey: a.recordExplicitHashKey(&event),
+	}
 
-	if err != nil {
-		return a.reportError("error publishing to kinesis", err)
+	var result *kinesis.PutRecordOutput
+
+	if a.batcher != nil {
+		res, err := a.batcher.Add(ctx, record)
+		if err != nil {
+			return a.reportError("error publishing to kinesis", err)
+		}
+		if res.ErrorCode != nil {
+			return a.reportError("error publishing to kinesis",
+				fmt.Errorf("%s: %s", *res.ErrorCode, aws.StringValue(res.ErrorMessage)))
+		}
+
+		result = &kinesis.PutRecordOutput{
+			ShardId:        res.ShardId,
+			SequenceNumber: res.SequenceNumber,
+		}
+
+	} else {
+		var err error
+		result, err = a.knsClient.PutRecordWithContext(ctx, &kinesis.PutRecordInput{
+			Data:            record.Data,
+			PartitionKey:    record.PartitionKey,
+			ExplicitHashKey: record.ExplicitHashKey,
+			StreamName:      &a.streamName,
+		})
+		if err != nil {
+			return a.reportError("error publishing to kinesis", err)
+		}
 	}
 
 	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
-	err = responseEvent.SetData(cloudevents.ApplicationJSON, result.GoString())
+	err := responseEvent.SetData(cloudevents.ApplicationJSON, result.GoString())
 	if err != nil {
 		return a.reportError("error generating response event", err)
 	}
@@ -142,6 +200,36 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 	return &responseEvent, cloudevents.ResultACK
 }
 
+// recordPartitionKey returns the partition key of the record created from
+// the given event. Events which don't contain any value for the configured
+// partition key fall back to the static partition key, then to their ID.
+func (a *adapter) recordPartitionKey(e *cloudevents.Event) string {
+	if a.partitionKey != nil {
+		if k := a.partitionKey(e); k != "" {
+			return k
+		}
+	}
+
+	if a.awsKinesisPartition != "" {
+		return a.awsKinesisPartition
+	}
+
+	return e.ID()
+}
+
+// recordExplicitHashKey returns the explicit hash key of the record created
+// from the given event, if any.
+func (a *adapter) recordExplicitHashKey(e *cloudevents.Event) *string {
+	if a.explicitHashKey == nil {
+		return nil
+	}
+
+	if k := a.explicitHashKey(e); k != "" {
+		return &k
+	}
+	return nil
+}
+
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
 	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
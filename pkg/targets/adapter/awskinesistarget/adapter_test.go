@@ -0,0 +1,89 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awskinesistarget
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
+)
+
+func TestRecordKeys(t *testing.T) {
+	testCases := map[string]struct {
+		partition       string
+		partitionKey    [2]string
+		explicitHashKey [2]string
+
+		expectPartitionKey    string
+		expectExplicitHashKey *string
+	}{
+		"Partition key from data path": {
+			partition:          "static",
+			partitionKey:       [2]string{"", "customer.id"},
+			expectPartitionKey: "c-42",
+		},
+		"Partition key from attribute": {
+			partitionKey:       [2]string{"subject", ""},
+			expectPartitionKey: "my-subject",
+		},
+		"Missing partition key falls back to static partition": {
+			partition:          "static",
+			partitionKey:       [2]string{"", "missing"},
+			expectPartitionKey: "static",
+		},
+		"Missing partition key falls back to event ID": {
+			partitionKey:       [2]string{"nosuchext", ""},
+			expectPartitionKey: "0000",
+		},
+		"Explicit hash key": {
+			partition:             "static",
+			explicitHashKey:       [2]string{"", "hash"},
+			expectPartitionKey:    "static",
+			expectExplicitHashKey: aws.String("170141183460469231731687303715884105728"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			a := &adapter{
+				awsKinesisPartition: tc.partition,
+				partitionKey:        dispatcher.NewKeyFunc(tc.partitionKey[0], tc.partitionKey[1]),
+				explicitHashKey:     dispatcher.NewKeyFunc(tc.explicitHashKey[0], tc.explicitHashKey[1]),
+			}
+
+			e := cloudevents.NewEvent()
+			e.SetID("0000")
+			e.SetSubject("my-subject")
+			err := e.SetData(cloudevents.ApplicationJSON, map[string]interface{}{
+				"customer": map[string]interface{}{"id": "c-42"},
+				"hash":     "170141183460469231731687303715884105728",
+			})
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectPartitionKey, a.recordPartitionKey(&e))
+			assert.Equal(t, tc.expectExplicitHashKey, a.recordExplicitHashKey(&e))
+		})
+	}
+}
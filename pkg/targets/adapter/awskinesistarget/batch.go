@@ -0,0 +1,100 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awskinesistarget
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/kinesis"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
+)
+
+// Kinesis limits
+// https://docs.aws.amazon.com/kinesis/latest/APIReference/API_PutRecords.html
+const maxPutRecordsBytes = 5 * 1024 * 1024
+
+// errCodeRequestFailed is reported for records of a PutRecords request which
+// failed as a whole.
+const errCodeRequestFailed = "RequestFailed"
+
+// recordSize implements batcher.SizeFunc. The size of a record accounts for
+// both its data and its partition key.
+func recordSize(r *kinesis.PutRecordsRequestEntry) int {
+	return len(r.Data) + len(aws.StringValue(r.PartitionKey))
+}
+
+// putRecordsRetry defines how the records of a batch which were rejected by
+// Kinesis, for instance due to the throttling of a shard, are retried.
+var putRecordsRetry = batcher.Retry[*kinesis.PutRecordsResultEntry]{
+	MaxAttempts: 5,
+	Backoff:     100 * time.Millisecond,
+	Retryable:   func(r *kinesis.PutRecordsResultEntry) bool { return r.ErrorCode != nil },
+}
+
+// putRecords implements batcher.SendFunc. Records which were rejected by
+// Kinesis are retried, and the failure of a request is reported in the
+// result of each of its records.
+func (a *adapter) putRecords(ctx context.Context,
+	records []*kinesis.PutRecordsRequestEntry) ([]*kinesis.PutRecordsResultEntry, error) {
+
+	results, err := batcher.WithRetries(a.putRecordsOnce, putRecordsRetry)(ctx, records)
+	if err != nil {
+		res := failedRecordResult(err)
+		results = make([]*kinesis.PutRecordsResultEntry, len(records))
+		for i := range results {
+			results[i] = res
+		}
+	}
+
+	return results, nil
+}
+
+// putRecordsOnce writes the given records in a single PutRecords request.
+func (a *adapter) putRecordsOnce(ctx context.Context,
+	records []*kinesis.PutRecordsRequestEntry) ([]*kinesis.PutRecordsResultEntry, error) {
+
+	out, err := a.knsClient.PutRecordsWithContext(ctx, &kinesis.PutRecordsInput{
+		StreamName: &a.streamName,
+		Records:    records,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return out.Records, nil
+}
+
+// failedRecordResult returns the result of a record which belongs to a
+// PutRecords request that failed with the given error.
+func failedRecordResult(err error) *kinesis.PutRecordsResultEntry {
+	code := errCodeRequestFailed
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		code = awsErr.Code()
+	}
+
+	return &kinesis.PutRecordsResultEntry{
+		ErrorCode:    &code,
+		ErrorMessage: aws.String(err.Error()),
+	}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package awskinesistarget
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -31,6 +33,18 @@ type envAccessor struct {
 	AwsTargetArn        string `envconfig:"ARN" required:"true"`
 	AwsKinesisPartition string `envconfig:"AWS_KINESIS_PARTITION"`
 
+	// Location of the partition key and explicit hash key of records inside
+	// events. At most one of each may be set.
+	PartitionKeyAttribute    string `envconfig:"AWS_KINESIS_PARTITION_KEY_ATTRIBUTE"`
+	PartitionKeyDataPath     string `envconfig:"AWS_KINESIS_PARTITION_KEY_DATA_PATH"`
+	ExplicitHashKeyAttribute string `envconfig:"AWS_KINESIS_EXPLICIT_HASH_KEY_ATTRIBUTE"`
+	ExplicitHashKeyDataPath  string `envconfig:"AWS_KINESIS_EXPLICIT_HASH_KEY_DATA_PATH"`
+
+	// Batching of records in PutRecords requests. Disabled when the
+	// maximum number of records is zero.
+	BatchMaxRecords int           `envconfig:"AWS_KINESIS_BATCH_MAX_RECORDS"`
+	BatchMaxDelay   time.Duration `envconfig:"AWS_KINESIS_BATCH_MAX_DELAY" default:"1s"`
+
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
 	// Assume this IAM Role when access keys provided.
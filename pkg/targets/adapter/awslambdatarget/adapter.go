@@ -18,7 +18,9 @@ package awslambdatarget
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"go.uber.org/zap"
@@ -33,11 +35,19 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
 )
 
+// extensionLogTail is the CloudEvents extension which carries the
+// base64-encoded tail of the execution log of functions.
+const extensionLogTail = "logtail"
+
 // NewTarget Adapter implementation
 func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
@@ -54,26 +64,54 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(a.Region).
-		WithMaxRetries(5)))
+		WithMaxRetries(5))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
 		config.Credentials = stscreds.NewCredentials(sess, env.AssumeIamRole)
 	}
 
-	return &adapter{
+	replier, err := targetce.New(env.AwsTargetArn, logger.Named("replier"),
+		targetce.ReplierWithStaticResponseType(v1alpha1.EventTypeAWSLambdaResult),
+		targetce.ReplierWithStaticErrorResponseType(v1alpha1.EventTypeAWSLambdaError))
+	if err != nil {
+		logger.Panicf("Error creating CloudEvents replier: %v", err)
+	}
+
+	invocationType := v1alpha1.AWSLambdaInvocationTypeRequestResponse
+	if env.InvocationType != "" {
+		invocationType = v1alpha1.AWSLambdaInvocationType(env.InvocationType)
+	}
+
+	adapter := &adapter{
 		awsArnString:     env.AwsTargetArn,
 		awsArn:           a,
 		discardCEContext: env.DiscardCEContext,
 		lambdaClient:     lambda.New(sess, config),
-		ceClient:         ceClient,
+
+		invocationType: invocationType,
+		logTail:        env.LogTail && invocationType == v1alpha1.AWSLambdaInvocationTypeRequestResponse,
+
+		replier:  replier,
+		ceClient: ceClient,
 
 		logger: logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.Qualifier != "" {
+		adapter.qualifier = &env.Qualifier
+	}
+	if env.ClientContext != "" {
+		// Lambda expects a base64-encoded JSON document
+		cc := base64.StdEncoding.EncodeToString([]byte(env.ClientContext))
+		adapter.clientContext = &cc
+	}
+
+	return adapter
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
@@ -81,10 +119,16 @@ var _ pkgadapter.Adapter = (*adapter)(nil)
 type adapter struct {
 	awsArnString string
 	awsArn       arn.ARN
-	lambdaClient *lambda.Lambda
+	lambdaClient lambdaiface.LambdaAPI
+
+	invocationType v1alpha1.AWSLambdaInvocationType
+	qualifier      *string
+	clientContext  *string
+	logTail        bool
 
 	discardCEContext bool
 
+	replier  *targetce.Replier
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
 
@@ -97,7 +141,7 @@ func (a *adapter) Start(ctx context.Context) error {
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var fnPayload []byte
 
 	if a.discardCEContext {
@@ -111,24 +155,84 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 	}
 
 	input := &lambda.InvokeInput{
-		Payload:      fnPayload,
-		FunctionName: &a.awsArnString,
+		Payload:        fnPayload,
+		FunctionName:   &a.awsArnString,
+		InvocationType: aws.String(string(a.invocationType)),
+		Qualifier:      a.qualifier,
+		ClientContext:  a.clientContext,
+	}
+	if a.logTail {
+		input.LogType = aws.String(lambda.LogTypeTail)
 	}
-	out, err := a.lambdaClient.Invoke(input)
+
+	out, err := a.lambdaClient.InvokeWithContext(ctx, input)
 	if err != nil {
 		return a.reportError("error invoking lambda", err)
 	}
 
-	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
-	err = responseEvent.SetData(cloudevents.ApplicationJSON, out.Payload)
-	if err != nil {
-		return a.reportError("error generating response event", err)
+	var opts []targetce.EventResponseOption
+	if out.LogResult != nil {
+		opts = append(opts, responseWithLogTail(*out.LogResult))
 	}
 
-	responseEvent.SetType("io.triggermesh.targets.aws.lambda.result")
-	responseEvent.SetSource(a.awsArnString)
+	if out.FunctionError != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess,
+			functionError(*out.FunctionError, out.Payload), functionErrorDetails(*out.FunctionError, out.Payload),
+			opts...)
+	}
 
-	return &responseEvent, cloudevents.ResultACK
+	// Asynchronous and dry-run invocations don't return any response.
+	if a.invocationType != v1alpha1.AWSLambdaInvocationTypeRequestResponse {
+		return a.replier.Ack()
+	}
+
+	return a.replier.Ok(&event, out.Payload, opts...)
+}
+
+// functionErrorPayload is the payload returned by Lambda functions which
+// failed with an error.
+// https://docs.aws.amazon.com/lambda/latest/dg/invocation-retries.html
+type functionErrorPayload struct {
+	ErrorType    string `json:"errorType"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// functionError returns an error which describes the failure of a function,
+// based on its error payload when it has the expected format.
+func functionError(fnErr string, payload []byte) error {
+	p := &functionErrorPayload{}
+	if err := json.Unmarshal(payload, p); err != nil || p.ErrorMessage == "" {
+		return fmt.Errorf("function returned an error (%s)", fnErr)
+	}
+
+	if p.ErrorType != "" {
+		return fmt.Errorf("function returned an error (%s): %s: %s", fnErr, p.ErrorType, p.ErrorMessage)
+	}
+	return fmt.Errorf("function returned an error (%s): %s", fnErr, p.ErrorMessage)
+}
+
+// functionErrorDetails returns the details of error replies for the failure
+// of a function.
+func functionErrorDetails(fnErr string, payload []byte) interface{} {
+	details := map[string]interface{}{
+		"functionError": fnErr,
+	}
+
+	if json.Valid(payload) {
+		details["payload"] = json.RawMessage(payload)
+	} else if len(payload) != 0 {
+		details["payload"] = string(payload)
+	}
+
+	return details
+}
+
+// responseWithLogTail returns a response option which attaches the given
+// base64-encoded log tail to replies.
+func responseWithLogTail(logTail string) targetce.EventResponseOption {
+	return func(_, out *cloudevents.Event) error {
+		return out.Context.SetExtension(extensionLogTail, logTail)
+	}
 }
 
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
@@ -0,0 +1,200 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awslambdatarget
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/lambda"
+	"github.com/aws/aws-sdk-go/service/lambda/lambdaiface"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+)
+
+const tARN = "arn:aws:lambda:us-east-1:123456789012:function:my-function"
+
+func TestDispatch(t *testing.T) {
+	logTail := base64.StdEncoding.EncodeToString([]byte("START RequestId: 1234\nEND RequestId: 1234\n"))
+
+	testCases := map[string]struct {
+		invocationType v1alpha1.AWSLambdaInvocationType
+		logTail        bool
+		output         *lambda.InvokeOutput
+
+		expectLogType   *string
+		expectReply     bool
+		expectReplyType string
+		expectLogTail   interface{}
+		expectData      string
+	}{
+		"Synchronous invocation": {
+			invocationType: v1alpha1.AWSLambdaInvocationTypeRequestResponse,
+			output: &lambda.InvokeOutput{
+				StatusCode: aws.Int64(200),
+				Payload:    []byte(`{"greeting":"hello"}`),
+			},
+			expectReply:     true,
+			expectReplyType: v1alpha1.EventTypeAWSLambdaResult,
+			expectData:      `{"greeting":"hello"}`,
+		},
+		"Synchronous invocation with log tail": {
+			invocationType: v1alpha1.AWSLambdaInvocationTypeRequestResponse,
+			logTail:        true,
+			output: &lambda.InvokeOutput{
+				StatusCode: aws.Int64(200),
+				Payload:    []byte(`{"greeting":"hello"}`),
+				LogResult:  &logTail,
+			},
+			expectLogType:   aws.String(lambda.LogTypeTail),
+			expectReply:     true,
+			expectReplyType: v1alpha1.EventTypeAWSLambdaResult,
+			expectLogTail:   logTail,
+			expectData:      `{"greeting":"hello"}`,
+		},
+		"Function error": {
+			invocationType: v1alpha1.AWSLambdaInvocationTypeRequestResponse,
+			output: &lambda.InvokeOutput{
+				StatusCode:    aws.Int64(200),
+				FunctionError: aws.String("Unhandled"),
+				Payload:       []byte(`{"errorType":"TypeError","errorMessage":"oops"}`),
+			},
+			expectReply:     true,
+			expectReplyType: v1alpha1.EventTypeAWSLambdaError,
+			expectData: `{"Code":"adapter-process",` +
+				`"Description":"function returned an error (Unhandled): TypeError: oops",` +
+				`"Details":{"functionError":"Unhandled","payload":{"errorType":"TypeError","errorMessage":"oops"}}}`,
+		},
+		"Asynchronous invocation": {
+			invocationType: v1alpha1.AWSLambdaInvocationTypeEvent,
+			output: &lambda.InvokeOutput{
+				StatusCode: aws.Int64(202),
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			logger := loggingtesting.TestLogger(t)
+
+			replier, err := targetce.New(tARN, logger,
+				targetce.ReplierWithStaticResponseType(v1alpha1.EventTypeAWSLambdaResult),
+				targetce.ReplierWithStaticErrorResponseType(v1alpha1.EventTypeAWSLambdaError))
+			require.NoError(t, err)
+
+			cli := &mockLambdaClient{output: tc.output}
+
+			a := &adapter{
+				awsArnString:   tARN,
+				lambdaClient:   cli,
+				invocationType: tc.invocationType,
+				qualifier:      aws.String("live"),
+				logTail:        tc.logTail,
+				replier:        replier,
+				logger:         logger,
+			}
+
+			resp, res := a.dispatch(context.Background(), newEvent(t))
+			require.True(t, cloudevents.IsACK(res), "unexpected result: %v", res)
+
+			require.NotNil(t, cli.input)
+			assert.Equal(t, tARN, *cli.input.FunctionName)
+			assert.Equal(t, string(tc.invocationType), *cli.input.InvocationType)
+			assert.Equal(t, "live", *cli.input.Qualifier)
+			assert.Equal(t, tc.expectLogType, cli.input.LogType)
+
+			if !tc.expectReply {
+				assert.Nil(t, resp)
+				return
+			}
+
+			require.NotNil(t, resp)
+			assert.Equal(t, tc.expectReplyType, resp.Type())
+			assert.Equal(t, tARN, resp.Source())
+			assert.Equal(t, tc.expectLogTail, resp.Extensions()[extensionLogTail])
+			assert.JSONEq(t, tc.expectData, string(resp.Data()))
+		})
+	}
+}
+
+func TestFunctionError(t *testing.T) {
+	testCases := map[string]struct {
+		payload     []byte
+		expectError string
+	}{
+		"Error payload": {
+			payload:     []byte(`{"errorType":"TypeError","errorMessage":"oops"}`),
+			expectError: "function returned an error (Unhandled): TypeError: oops",
+		},
+		"Error payload without type": {
+			payload:     []byte(`{"errorMessage":"oops"}`),
+			expectError: "function returned an error (Unhandled): oops",
+		},
+		"Unexpected payload": {
+			payload:     []byte(`"oops"`),
+			expectError: "function returned an error (Unhandled)",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assert.EqualError(t, functionError("Unhandled", tc.payload), tc.expectError)
+		})
+	}
+}
+
+// newEvent returns a test CloudEvent.
+func newEvent(t *testing.T) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("0000")
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, json.RawMessage(`{"name":"world"}`)))
+
+	return e
+}
+
+// mockLambdaClient is a mock implementation of the Lambda API which records
+// the input of Invoke calls and returns a predefined output.
+type mockLambdaClient struct {
+	lambdaiface.LambdaAPI
+
+	output *lambda.InvokeOutput
+	input  *lambda.InvokeInput
+}
+
+func (c *mockLambdaClient) InvokeWithContext(_ aws.Context, in *lambda.InvokeInput,
+	_ ...request.Option) (*lambda.InvokeOutput, error) {
+
+	c.input = in
+	return c.output, nil
+}
@@ -32,6 +32,12 @@ type envAccessor struct {
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
+	// Parameters of the invocation of the function.
+	InvocationType string `envconfig:"AWS_LAMBDA_INVOCATION_TYPE"`
+	Qualifier      string `envconfig:"AWS_LAMBDA_QUALIFIER"`
+	ClientContext  string `envconfig:"AWS_LAMBDA_CLIENT_CONTEXT"`
+	LogTail        bool   `envconfig:"AWS_LAMBDA_LOG_TAIL"`
+
 	// Assume this IAM Role when access keys provided.
 	AssumeIamRole string `envconfig:"AWS_ASSUME_ROLE_ARN"`
 
@@ -21,6 +21,8 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/s3"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 )
 
 // Per AWS conventions, a bucket which does not explicitly specify its location
@@ -30,8 +32,8 @@ const defaultS3Region = "us-east-1"
 
 // getBucketRegion retrieves the region the provided bucket resides in.
 func getBucketRegion(bucketName string, env *envAccessor) (string, error) {
-	sess := session.Must(session.NewSession(aws.NewConfig().
-		WithRegion(defaultS3Region)))
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
+		WithRegion(defaultS3Region))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
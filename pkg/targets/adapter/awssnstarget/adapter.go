@@ -19,7 +19,10 @@ package awssnstarget
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 
 	"go.uber.org/zap"
 
@@ -33,9 +36,12 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 // NewTarget Adapter implementation
@@ -54,19 +60,37 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(a.Region).
-		WithMaxRetries(5)))
+		WithMaxRetries(5))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
 		config.Credentials = stscreds.NewCredentials(sess, env.AssumeIamRole)
 	}
 
+	protocolMessageKeys := make(map[string]dispatcher.KeyFunc, len(env.ProtocolMessages))
+	for p, k := range env.ProtocolMessages {
+		protocolMessageKeys[p] = dispatcher.NewKeyFunc(aws.StringValue(k.Attribute), aws.StringValue(k.DataPath))
+	}
+
 	return &adapter{
 		awsArnString: env.AwsTargetArn,
 		awsArn:       a,
 		snsClient:    sns.New(sess, config),
+		fifo:         strings.HasSuffix(a.Resource, ".fifo"),
+
+		messageGroupID:      env.MessageGroupID,
+		messageGroupIDKey:   dispatcher.NewKeyFunc(env.MessageGroupIDAttribute, env.MessageGroupIDDataPath),
+		deduplicationIDKey:  dispatcher.NewKeyFunc(env.DeduplicationIDAttribute, env.DeduplicationIDDataPath),
+		subjectKey:          dispatcher.NewKeyFunc(env.SubjectAttribute, env.SubjectDataPath),
+		messageAttributes:   env.MessageAttributes,
+		protocolMessageKeys: protocolMessageKeys,
+
+		smsPhoneNumberKey: dispatcher.NewKeyFunc(env.SMSPhoneNumberAttribute, env.SMSPhoneNumberDataPath),
+		smsMessageKey:     dispatcher.NewKeyFunc(env.SMSMessageAttribute, env.SMSMessageDataPath),
+		smsSenderID:       env.SMSSenderID,
+		smsType:           env.SMSType,
 
 		discardCEContext: env.DiscardCEContext,
 		ceClient:         ceClient,
@@ -81,7 +105,21 @@ var _ pkgadapter.Adapter = (*adapter)(nil)
 type adapter struct {
 	awsArnString string
 	awsArn       arn.ARN
-	snsClient    *sns.SNS
+	snsClient    snsiface.SNSAPI
+	fifo         bool
+
+	messageGroupID      string
+	messageGroupIDKey   dispatcher.KeyFunc
+	deduplicationIDKey  dispatcher.KeyFunc
+	subjectKey          dispatcher.KeyFunc
+	messageAttributes   []string
+	protocolMessageKeys map[string]dispatcher.KeyFunc
+
+	// SMS messages are published directly to phone numbers when set
+	smsPhoneNumberKey dispatcher.KeyFunc
+	smsMessageKey     dispatcher.KeyFunc
+	smsSenderID       string
+	smsType           string
 
 	discardCEContext bool
 	ceClient         cloudevents.Client
@@ -96,7 +134,7 @@ func (a *adapter) Start(ctx context.Context) error {
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var msg []byte
 
 	if a.discardCEContext {
@@ -109,11 +147,12 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		msg = jsonEvent
 	}
 
-	result, err := a.snsClient.Publish(&sns.PublishInput{
-		Message:  aws.String(string(msg)),
-		TopicArn: &a.awsArnString,
-	})
+	in, err := a.publishInput(&event, msg)
+	if err != nil {
+		return a.reportErrorCode(http.StatusBadRequest, "error processing incoming event", err)
+	}
 
+	result, err := a.snsClient.PublishWithContext(ctx, in)
 	if err != nil {
 		return a.reportError("error publishing to sns", err)
 	}
@@ -130,7 +169,138 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 	return &responseEvent, cloudevents.ResultACK
 }
 
+// publishInput returns the input of a Publish request created from the given
+// event and message.
+func (a *adapter) publishInput(e *cloudevents.Event, msg []byte) (*sns.PublishInput, error) {
+	in := &sns.PublishInput{
+		Message: aws.String(string(msg)),
+	}
+
+	for _, name := range a.messageAttributes {
+		// SNS rejects attributes with empty values
+		if v := dispatcher.AttributeKey(name)(e); v != "" {
+			setStringAttribute(in, name, v)
+		}
+	}
+
+	if a.subjectKey != nil {
+		if subject := a.subjectKey(e); subject != "" {
+			in.Subject = &subject
+		}
+	}
+
+	if err := a.setProtocolMessages(in, e); err != nil {
+		return nil, err
+	}
+
+	if a.smsPhoneNumberKey != nil {
+		phoneNumber := a.smsPhoneNumberKey(e)
+		if phoneNumber == "" {
+			return nil, errors.New("event doesn't contain any phone number")
+		}
+		in.PhoneNumber = &phoneNumber
+
+		if a.smsMessageKey != nil {
+			text := a.smsMessageKey(e)
+			if text == "" {
+				return nil, errors.New("event doesn't contain any SMS text")
+			}
+			in.Message = &text
+			in.MessageStructure = nil
+		}
+
+		if a.smsSenderID != "" {
+			setStringAttribute(in, smsSenderIDAttribute, a.smsSenderID)
+		}
+		if a.smsType != "" {
+			setStringAttribute(in, smsTypeAttribute, a.smsType)
+		}
+
+		return in, nil
+	}
+
+	in.TopicArn = &a.awsArnString
+
+	if a.fifo {
+		groupID := a.messageGroupID
+		if a.messageGroupIDKey != nil {
+			if k := a.messageGroupIDKey(e); k != "" {
+				groupID = k
+			}
+		}
+		in.MessageGroupId = &groupID
+
+		dedupID := e.ID() + ";" + e.Source()
+		if a.deduplicationIDKey != nil {
+			if k := a.deduplicationIDKey(e); k != "" {
+				dedupID = k
+			}
+		}
+		in.MessageDeduplicationId = &dedupID
+	}
+
+	return in, nil
+}
+
+// Reserved message attributes which configure the delivery of SMS messages.
+// https://docs.aws.amazon.com/sns/latest/dg/sms_publish-to-phone.html
+const (
+	smsSenderIDAttribute = "AWS.SNS.SMS.SenderID"
+	smsTypeAttribute     = "AWS.SNS.SMS.SMSType"
+)
+
+// setStringAttribute sets a message attribute of type String on the given
+// Publish input.
+func setStringAttribute(in *sns.PublishInput, name, value string) {
+	if in.MessageAttributes == nil {
+		in.MessageAttributes = make(map[string]*sns.MessageAttributeValue)
+	}
+	in.MessageAttributes[name] = &sns.MessageAttributeValue{
+		DataType:    aws.String("String"),
+		StringValue: aws.String(value),
+	}
+}
+
+// setProtocolMessages sets protocol-specific messages read from the given
+// event on the given Publish input, if the event contains any. The message of
+// the input becomes the default message, which is delivered to all other
+// protocols.
+// https://docs.aws.amazon.com/sns/latest/api/API_Publish.html
+func (a *adapter) setProtocolMessages(in *sns.PublishInput, e *cloudevents.Event) error {
+	var msgs map[string]string
+
+	for p, key := range a.protocolMessageKeys {
+		if key == nil {
+			continue
+		}
+		if v := key(e); v != "" {
+			if msgs == nil {
+				msgs = map[string]string{"default": *in.Message}
+			}
+			msgs[p] = v
+		}
+	}
+
+	if msgs == nil {
+		return nil
+	}
+
+	b, err := json.Marshal(msgs)
+	if err != nil {
+		return fmt.Errorf("serializing protocol-specific messages: %w", err)
+	}
+
+	in.Message = aws.String(string(b))
+	in.MessageStructure = aws.String("json")
+
+	return nil
+}
+
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
+	return a.reportErrorCode(http.StatusInternalServerError, msg, err)
+}
+
+func (a *adapter) reportErrorCode(code int, msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
-	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
+	return nil, cloudevents.NewHTTPResult(code, msg)
 }
@@ -0,0 +1,272 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssnstarget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sns"
+	"github.com/aws/aws-sdk-go/service/sns/snsiface"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
+)
+
+const tARN = "arn:aws:sns:us-east-1:123456789012:my-topic"
+
+func TestPublishInput(t *testing.T) {
+	testCases := map[string]struct {
+		adapter adapter
+
+		expectTopicARN    *string
+		expectPhoneNumber *string
+		expectAttrs       map[string]*sns.MessageAttributeValue
+		expectSubject     *string
+		expectGroupID     *string
+		expectDedupID     *string
+		expectStructure   *string
+		expectMessage     string
+		expectErr         bool
+	}{
+		"Standard topic without options": {
+			adapter:        adapter{},
+			expectTopicARN: aws.String(tARN),
+			expectMessage:  "body",
+		},
+		"Message attributes": {
+			adapter: adapter{
+				messageAttributes: []string{"type", "tenant", "nosuchext"},
+			},
+			expectTopicARN: aws.String(tARN),
+			expectAttrs: map[string]*sns.MessageAttributeValue{
+				"type":   {DataType: aws.String("String"), StringValue: aws.String("test.type")},
+				"tenant": {DataType: aws.String("String"), StringValue: aws.String("acme")},
+			},
+			expectMessage: "body",
+		},
+		"Subject from event": {
+			adapter: adapter{
+				subjectKey: dispatcher.NewKeyFunc("", "subject"),
+			},
+			expectTopicARN: aws.String(tARN),
+			expectSubject:  aws.String("Hello"),
+			expectMessage:  "body",
+		},
+		"FIFO topic defaults": {
+			adapter: adapter{
+				fifo:           true,
+				messageGroupID: "static",
+			},
+			expectTopicARN: aws.String(tARN),
+			expectGroupID:  aws.String("static"),
+			expectDedupID:  aws.String("0000;test.source"),
+			expectMessage:  "body",
+		},
+		"FIFO topic IDs from event": {
+			adapter: adapter{
+				fifo:               true,
+				messageGroupID:     "static",
+				messageGroupIDKey:  dispatcher.NewKeyFunc("", "customer.id"),
+				deduplicationIDKey: dispatcher.NewKeyFunc("tenant", ""),
+			},
+			expectTopicARN: aws.String(tARN),
+			expectGroupID:  aws.String("c-42"),
+			expectDedupID:  aws.String("acme"),
+			expectMessage:  "body",
+		},
+		"Protocol messages": {
+			adapter: adapter{
+				protocolMessageKeys: map[string]dispatcher.KeyFunc{
+					"email": dispatcher.NewKeyFunc("", "email"),
+					"sms":   dispatcher.NewKeyFunc("", "sms"),
+					"sqs":   dispatcher.NewKeyFunc("", "nosuchfield"),
+				},
+			},
+			expectTopicARN:  aws.String(tARN),
+			expectStructure: aws.String("json"),
+			expectMessage:   `{"default":"body","email":"Dear customer","sms":"Hi"}`,
+		},
+		"Protocol messages missing from event": {
+			adapter: adapter{
+				protocolMessageKeys: map[string]dispatcher.KeyFunc{
+					"sqs": dispatcher.NewKeyFunc("", "nosuchfield"),
+				},
+			},
+			expectTopicARN: aws.String(tARN),
+			expectMessage:  "body",
+		},
+		"SMS": {
+			adapter: adapter{
+				fifo:              true,
+				smsPhoneNumberKey: dispatcher.NewKeyFunc("", "customer.phone"),
+				smsSenderID:       "TriggerMesh",
+				smsType:           "Transactional",
+			},
+			expectPhoneNumber: aws.String("+15555550100"),
+			expectAttrs: map[string]*sns.MessageAttributeValue{
+				"AWS.SNS.SMS.SenderID": {DataType: aws.String("String"), StringValue: aws.String("TriggerMesh")},
+				"AWS.SNS.SMS.SMSType":  {DataType: aws.String("String"), StringValue: aws.String("Transactional")},
+			},
+			expectMessage: "body",
+		},
+		"SMS text from event": {
+			adapter: adapter{
+				protocolMessageKeys: map[string]dispatcher.KeyFunc{
+					"email": dispatcher.NewKeyFunc("", "email"),
+				},
+				smsPhoneNumberKey: dispatcher.NewKeyFunc("", "customer.phone"),
+				smsMessageKey:     dispatcher.NewKeyFunc("", "sms"),
+			},
+			expectPhoneNumber: aws.String("+15555550100"),
+			expectMessage:     "Hi",
+		},
+		"SMS without text": {
+			adapter: adapter{
+				smsPhoneNumberKey: dispatcher.NewKeyFunc("", "customer.phone"),
+				smsMessageKey:     dispatcher.NewKeyFunc("", "nosuchfield"),
+			},
+			expectErr: true,
+		},
+		"SMS without phone number": {
+			adapter: adapter{
+				smsPhoneNumberKey: dispatcher.NewKeyFunc("", "nosuchfield"),
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			tc.adapter.awsArnString = tARN
+
+			e := newEvent(t, "0000")
+
+			in, err := tc.adapter.publishInput(&e, []byte("body"))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.expectTopicARN, in.TopicArn)
+			assert.Equal(t, tc.expectPhoneNumber, in.PhoneNumber)
+			assert.Equal(t, tc.expectAttrs, in.MessageAttributes)
+			assert.Equal(t, tc.expectSubject, in.Subject)
+			assert.Equal(t, tc.expectGroupID, in.MessageGroupId)
+			assert.Equal(t, tc.expectDedupID, in.MessageDeduplicationId)
+			assert.Equal(t, tc.expectStructure, in.MessageStructure)
+
+			if tc.expectStructure != nil {
+				assert.JSONEq(t, tc.expectMessage, *in.Message)
+			} else {
+				assert.Equal(t, tc.expectMessage, *in.Message)
+			}
+		})
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	cli := &mockSNSClient{}
+
+	a := &adapter{
+		awsArnString:      tARN,
+		snsClient:         cli,
+		discardCEContext:  true,
+		messageAttributes: []string{"tenant"},
+		logger:            loggingtesting.TestLogger(t),
+	}
+
+	resp, res := a.dispatch(context.Background(), newEvent(t, "0000"))
+	require.True(t, cloudevents.IsACK(res), "unexpected result: %v", res)
+	assert.Equal(t, "io.triggermesh.targets.aws.sns.result", resp.Type())
+	assert.Equal(t, tARN, resp.Source())
+
+	require.Len(t, cli.publishInputs, 1)
+	in := cli.publishInputs[0]
+	assert.Equal(t, tARN, *in.TopicArn)
+	assert.Equal(t, "acme", *in.MessageAttributes["tenant"].StringValue)
+
+	var data map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(*in.Message), &data))
+	assert.Equal(t, "Hello", data["subject"], "Expected the event data to be published")
+}
+
+func TestDispatchRejectsInvalidEvent(t *testing.T) {
+	cli := &mockSNSClient{}
+
+	a := &adapter{
+		awsArnString:      tARN,
+		snsClient:         cli,
+		smsPhoneNumberKey: dispatcher.NewKeyFunc("", "nosuchfield"),
+		logger:            loggingtesting.TestLogger(t),
+	}
+
+	resp, res := a.dispatch(context.Background(), newEvent(t, "0000"))
+	assert.Nil(t, resp)
+
+	var httpRes *cehttp.Result
+	require.True(t, cloudevents.ResultAs(res, &httpRes), "unexpected result: %v", res)
+	assert.Equal(t, http.StatusBadRequest, httpRes.StatusCode)
+	assert.Empty(t, cli.publishInputs, "Invalid events shouldn't be published")
+}
+
+func newEvent(t *testing.T, id string) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	e.SetExtension("tenant", "acme")
+
+	data := map[string]interface{}{
+		"customer": map[string]interface{}{"id": "c-42", "phone": "+15555550100"},
+		"subject":  "Hello",
+		"email":    "Dear customer",
+		"sms":      "Hi",
+	}
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, data))
+
+	return e
+}
+
+// mockSNSClient is a mock implementation of the SNS API which records the
+// input of Publish calls.
+type mockSNSClient struct {
+	snsiface.SNSAPI
+
+	publishInputs []*sns.PublishInput
+}
+
+func (c *mockSNSClient) PublishWithContext(_ aws.Context, in *sns.PublishInput,
+	_ ...request.Option) (*sns.PublishOutput, error) {
+
+	c.publishInputs = append(c.publishInputs, in)
+	return &sns.PublishOutput{MessageId: aws.String("1")}, nil
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package awssnstarget
 
 import (
+	"encoding/json"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
 )
 
 // NewEnvConfig for configuration parameters
@@ -32,6 +36,32 @@ type envAccessor struct {
 
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
+	MessageGroupID string `envconfig:"AWS_SNS_MESSAGE_GROUP_ID"`
+
+	// Location of the message group ID, deduplication ID and subject of
+	// messages inside events. At most one of each may be set.
+	MessageGroupIDAttribute  string `envconfig:"AWS_SNS_MESSAGE_GROUP_ID_ATTRIBUTE"`
+	MessageGroupIDDataPath   string `envconfig:"AWS_SNS_MESSAGE_GROUP_ID_DATA_PATH"`
+	DeduplicationIDAttribute string `envconfig:"AWS_SNS_DEDUPLICATION_ID_ATTRIBUTE"`
+	DeduplicationIDDataPath  string `envconfig:"AWS_SNS_DEDUPLICATION_ID_DATA_PATH"`
+	SubjectAttribute         string `envconfig:"AWS_SNS_SUBJECT_ATTRIBUTE"`
+	SubjectDataPath          string `envconfig:"AWS_SNS_SUBJECT_DATA_PATH"`
+
+	// CloudEvents context attributes and extensions set as message attributes.
+	MessageAttributes []string `envconfig:"AWS_SNS_MESSAGE_ATTRIBUTES"`
+
+	// Locations of protocol-specific messages inside events.
+	ProtocolMessages ProtocolMessages `envconfig:"AWS_SNS_PROTOCOL_MESSAGES"`
+
+	// Publishing of SMS messages to phone numbers. Enabled when the
+	// location of the phone number is set.
+	SMSPhoneNumberAttribute string `envconfig:"AWS_SNS_SMS_PHONE_NUMBER_ATTRIBUTE"`
+	SMSPhoneNumberDataPath  string `envconfig:"AWS_SNS_SMS_PHONE_NUMBER_DATA_PATH"`
+	SMSMessageAttribute     string `envconfig:"AWS_SNS_SMS_MESSAGE_ATTRIBUTE"`
+	SMSMessageDataPath      string `envconfig:"AWS_SNS_SMS_MESSAGE_DATA_PATH"`
+	SMSSenderID             string `envconfig:"AWS_SNS_SMS_SENDER_ID"`
+	SMSType                 string `envconfig:"AWS_SNS_SMS_TYPE"`
+
 	// Assume this IAM Role when access keys provided.
 	AssumeIamRole string `envconfig:"AWS_ASSUME_ROLE_ARN"`
 
@@ -41,3 +71,12 @@ type envAccessor struct {
 	_ string `envconfig:"AWS_ACCESS_KEY_ID"`
 	_ string `envconfig:"AWS_SECRET_ACCESS_KEY"`
 }
+
+// ProtocolMessages is the JSON serialized set of locations of
+// protocol-specific messages inside events, indexed by protocol.
+type ProtocolMessages map[string]v1alpha1.EventKeySource
+
+// Decode implements envconfig.Decoder.
+func (m *ProtocolMessages) Decode(value string) error {
+	return json.Unmarshal([]byte(value), m)
+}
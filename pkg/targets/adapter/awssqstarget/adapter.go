@@ -19,7 +19,9 @@ package awssqstarget
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"go.uber.org/zap"
@@ -34,11 +36,19 @@ import (
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/awsendpoint"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
+// SQS limits
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SendMessage.html
+const maxDelaySeconds = 900
+
 // NewTarget Adapter implementation
 func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
@@ -55,36 +65,73 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 
 	a := MustParseARN(env.AwsTargetArn)
 
-	sess := session.Must(session.NewSession(aws.NewConfig().
+	sess := session.Must(session.NewSession(awsendpoint.WithOverrides(aws.NewConfig().
 		WithRegion(a.Region).
-		WithMaxRetries(5)))
+		WithMaxRetries(5))))
 
 	config := &aws.Config{}
 	if env.AssumeIamRole != "" {
 		config.Credentials = stscreds.NewCredentials(sess, env.AssumeIamRole)
 	}
 
-	return &adapter{
+	sqsClient := sqs.New(sess, config)
+
+	// The SendMessageInput only accepts a URL for publishing messages. This can be extracted from the ARN
+	queueURL := sqsClient.Endpoint + "/" + a.AccountID + "/" + a.Resource
+
+	adapter := &adapter{
 		awsArnString:     env.AwsTargetArn,
 		awsArn:           a,
 		discardCEContext: env.DiscardCEContext,
-		sqsClient:        sqs.New(sess, config),
-		messageGroupID:   env.MessageGroupID,
+		sqsClient:        sqsClient,
+		queueURL:         queueURL,
+		fifo:             strings.HasSuffix(queueURL, ".fifo"),
+
+		messageGroupID:     env.MessageGroupID,
+		messageGroupIDKey:  dispatcher.NewKeyFunc(env.MessageGroupIDAttribute, env.MessageGroupIDDataPath),
+		deduplicationIDKey: dispatcher.NewKeyFunc(env.DeduplicationIDAttribute, env.DeduplicationIDDataPath),
+		delaySeconds:       env.DelaySeconds,
+		delaySecondsKey:    dispatcher.NewKeyFunc(env.DelaySecondsAttribute, env.DelaySecondsDataPath),
+		messageAttributes:  env.MessageAttributes,
 
 		ceClient: ceClient,
 		logger:   logger,
 
 		sr: metrics.MustNewEventProcessingStatsReporter(mt),
 	}
+
+	if env.BatchMaxMessages > 0 {
+		adapter.batcher = batcher.New(batcher.Limits{
+			MaxItems: env.BatchMaxMessages,
+			MaxBytes: maxSendMessageBatchBytes,
+			MaxDelay: env.BatchMaxDelay,
+		}, messageSize, adapter.sendMessageBatch)
+
+		if adapter.fifo {
+			adapter.batcher.WithKeys(messageGroup)
+		}
+	}
+
+	return adapter
 }
 
 var _ pkgadapter.Adapter = (*adapter)(nil)
 
 type adapter struct {
-	awsArnString   string
-	awsArn         arn.ARN
-	sqsClient      *sqs.SQS
-	messageGroupID string
+	awsArnString string
+	awsArn       arn.ARN
+	sqsClient    sqsiface.SQSAPI
+	queueURL     string
+	fifo         bool
+
+	messageGroupID     string
+	messageGroupIDKey  dispatcher.KeyFunc
+	deduplicationIDKey dispatcher.KeyFunc
+	delaySeconds       int64
+	delaySecondsKey    dispatcher.KeyFunc
+	messageAttributes  []string
+
+	batcher *batcher.Batcher[*sqs.SendMessageBatchRequestEntry, *sendResult]
 
 	discardCEContext bool
 	ceClient         cloudevents.Client
@@ -95,11 +142,16 @@ type adapter struct {
 
 func (a *adapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting AWS SQS Target adapter")
+
+	if a.batcher != nil {
+		go a.batcher.Run(ctx)
+	}
+
 	return a.ceClient.StartReceiver(ctx, a.dispatch)
 }
 
 // Parse and send the aws event
-func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
 	var msg []byte
 
 	if a.discardCEContext {
@@ -112,28 +164,47 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 		msg = jsonEvent
 	}
 
-	// The SendMessageInput only accepts a URL for publishing messages. This can be extracted from the ARN
-	url := "https://" + a.awsArn.Service + "." + a.awsArn.Region + ".amazonaws.com/" + a.awsArn.AccountID + "/" + a.awsArn.Resource
+	entry, err := a.message(&event, msg)
+	if err != nil {
+		return a.reportErrorCode(http.StatusBadRequest, "error processing incoming event", err)
+	}
 
-	var err error
 	var result *sqs.SendMessageOutput
-	if strings.HasSuffix(url, ".fifo") {
-		dedupID := event.ID() + ";" + event.Source()
-		result, err = a.sqsClient.SendMessage(&sqs.SendMessageInput{
-			MessageBody:            aws.String(string(msg)),
-			QueueUrl:               &url,
-			MessageGroupId:         &a.messageGroupID,
-			MessageDeduplicationId: &dedupID,
-		})
+
+	if a.batcher != nil {
+		res, err := a.batcher.Add(ctx, entry)
+		if err != nil {
+			return a.reportError("error publishing to sqs", err)
+		}
+		if res.failure != nil {
+			code := http.StatusInternalServerError
+			if aws.BoolValue(res.failure.SenderFault) {
+				code = http.StatusBadRequest
+			}
+			return a.reportErrorCode(code, "error publishing to sqs",
+				fmt.Errorf("%s: %s", aws.StringValue(res.failure.Code), aws.StringValue(res.failure.Message)))
+		}
+
+		result = &sqs.SendMessageOutput{
+			MD5OfMessageAttributes:       res.success.MD5OfMessageAttributes,
+			MD5OfMessageBody:             res.success.MD5OfMessageBody,
+			MD5OfMessageSystemAttributes: res.success.MD5OfMessageSystemAttributes,
+			MessageId:                    res.success.MessageId,
+			SequenceNumber:               res.success.SequenceNumber,
+		}
+
 	} else {
-		result, err = a.sqsClient.SendMessage(&sqs.SendMessageInput{
-			MessageBody: aws.String(string(msg)),
-			QueueUrl:    &url,
+		result, err = a.sqsClient.SendMessageWithContext(ctx, &sqs.SendMessageInput{
+			QueueUrl:               &a.queueURL,
+			MessageBody:            entry.MessageBody,
+			MessageAttributes:      entry.MessageAttributes,
+			MessageGroupId:         entry.MessageGroupId,
+			MessageDeduplicationId: entry.MessageDeduplicationId,
+			DelaySeconds:           entry.DelaySeconds,
 		})
-	}
-
-	if err != nil {
-		return a.reportError("error publishing to sqs", err)
+		if err != nil {
+			return a.reportError("error publishing to sqs", err)
+		}
 	}
 
 	responseEvent := cloudevents.NewEvent(cloudevents.VersionV1)
@@ -148,7 +219,68 @@ func (a *adapter) dispatch(event cloudevents.Event) (*cloudevents.Event, cloudev
 	return &responseEvent, cloudevents.ResultACK
 }
 
+// message returns the message created from the given event and body. The ID
+// of the returned batch entry is left for the caller to set.
+func (a *adapter) message(e *cloudevents.Event, body []byte) (*sqs.SendMessageBatchRequestEntry, error) {
+	entry := &sqs.SendMessageBatchRequestEntry{
+		MessageBody: aws.String(string(body)),
+	}
+
+	for _, name := range a.messageAttributes {
+		// SQS rejects attributes with empty values
+		if v := dispatcher.AttributeKey(name)(e); v != "" {
+			if entry.MessageAttributes == nil {
+				entry.MessageAttributes = make(map[string]*sqs.MessageAttributeValue, len(a.messageAttributes))
+			}
+			entry.MessageAttributes[name] = &sqs.MessageAttributeValue{
+				DataType:    aws.String("String"),
+				StringValue: aws.String(v),
+			}
+		}
+	}
+
+	if a.fifo {
+		groupID := a.messageGroupID
+		if a.messageGroupIDKey != nil {
+			if k := a.messageGroupIDKey(e); k != "" {
+				groupID = k
+			}
+		}
+		entry.MessageGroupId = &groupID
+
+		dedupID := e.ID() + ";" + e.Source()
+		if a.deduplicationIDKey != nil {
+			if k := a.deduplicationIDKey(e); k != "" {
+				dedupID = k
+			}
+		}
+		entry.MessageDeduplicationId = &dedupID
+
+		return entry, nil
+	}
+
+	delay := a.delaySeconds
+	if a.delaySecondsKey != nil {
+		if k := a.delaySecondsKey(e); k != "" {
+			var err error
+			if delay, err = strconv.ParseInt(k, 10, 64); err != nil || delay < 0 || delay > maxDelaySeconds {
+				return nil, fmt.Errorf("invalid delay %q: must be an integer between 0 and %d",
+					k, maxDelaySeconds)
+			}
+		}
+	}
+	if delay >= 0 {
+		entry.DelaySeconds = &delay
+	}
+
+	return entry, nil
+}
+
 func (a *adapter) reportError(msg string, err error) (*cloudevents.Event, cloudevents.Result) {
+	return a.reportErrorCode(http.StatusInternalServerError, msg, err)
+}
+
+func (a *adapter) reportErrorCode(code int, msg string, err error) (*cloudevents.Event, cloudevents.Result) {
 	a.logger.Errorw(msg, zap.Error(err))
-	return nil, cloudevents.NewHTTPResult(http.StatusInternalServerError, msg)
+	return nil, cloudevents.NewHTTPResult(code, msg)
 }
@@ -0,0 +1,251 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqstarget
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/sqs"
+	"github.com/aws/aws-sdk-go/service/sqs/sqsiface"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
+)
+
+const tQueueURL = "https://sqs.us-east-1.amazonaws.com/123456789012/my-queue"
+
+func TestMessage(t *testing.T) {
+	testCases := map[string]struct {
+		adapter adapter
+
+		expectAttrs   map[string]*sqs.MessageAttributeValue
+		expectGroupID *string
+		expectDedupID *string
+		expectDelay   *int64
+		expectErr     bool
+	}{
+		"Standard queue without options": {
+			adapter: adapter{delaySeconds: -1},
+		},
+		"Message attributes": {
+			adapter: adapter{
+				delaySeconds:      -1,
+				messageAttributes: []string{"type", "tenant", "nosuchext"},
+			},
+			expectAttrs: map[string]*sqs.MessageAttributeValue{
+				"type":   {DataType: aws.String("String"), StringValue: aws.String("test.type")},
+				"tenant": {DataType: aws.String("String"), StringValue: aws.String("acme")},
+			},
+		},
+		"Static delay": {
+			adapter:     adapter{delaySeconds: 30},
+			expectDelay: aws.Int64(30),
+		},
+		"Delay from event": {
+			adapter: adapter{
+				delaySeconds:    30,
+				delaySecondsKey: dispatcher.NewKeyFunc("", "delay"),
+			},
+			expectDelay: aws.Int64(120),
+		},
+		"Missing delay falls back to static delay": {
+			adapter: adapter{
+				delaySeconds:    30,
+				delaySecondsKey: dispatcher.NewKeyFunc("", "nosuchfield"),
+			},
+			expectDelay: aws.Int64(30),
+		},
+		"Invalid delay": {
+			adapter: adapter{
+				delaySeconds:    -1,
+				delaySecondsKey: dispatcher.NewKeyFunc("", "customer.id"),
+			},
+			expectErr: true,
+		},
+		"FIFO queue defaults": {
+			adapter: adapter{
+				fifo:           true,
+				messageGroupID: "static",
+				delaySeconds:   30,
+			},
+			expectGroupID: aws.String("static"),
+			expectDedupID: aws.String("0000;test.source"),
+		},
+		"FIFO queue IDs from event": {
+			adapter: adapter{
+				fifo:               true,
+				messageGroupID:     "static",
+				messageGroupIDKey:  dispatcher.NewKeyFunc("", "customer.id"),
+				deduplicationIDKey: dispatcher.NewKeyFunc("tenant", ""),
+			},
+			expectGroupID: aws.String("c-42"),
+			expectDedupID: aws.String("acme"),
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			e := newEvent(t)
+
+			m, err := tc.adapter.message(&e, []byte("body"))
+			if tc.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, "body", *m.MessageBody)
+			assert.Equal(t, tc.expectAttrs, m.MessageAttributes)
+			assert.Equal(t, tc.expectGroupID, m.MessageGroupId)
+			assert.Equal(t, tc.expectDedupID, m.MessageDeduplicationId)
+			assert.Equal(t, tc.expectDelay, m.DelaySeconds)
+		})
+	}
+}
+
+func TestSendMessageBatchOnce(t *testing.T) {
+	a := &adapter{
+		sqsClient: &mockSQSClient{},
+		queueURL:  tQueueURL,
+	}
+
+	msgs := make([]*sqs.SendMessageBatchRequestEntry, 4)
+	for i := range msgs {
+		msgs[i] = &sqs.SendMessageBatchRequestEntry{
+			MessageBody: aws.String(strconv.Itoa(i)),
+		}
+	}
+
+	res, err := a.sendMessageBatchOnce(context.Background(), msgs)
+	require.NoError(t, err)
+	require.Len(t, res, 4)
+
+	require.NotNil(t, res[0].success)
+	assert.Equal(t, "msg-0", *res[0].success.MessageId)
+	assert.False(t, isRetryableResult(res[0]))
+
+	require.NotNil(t, res[1].failure)
+	assert.Equal(t, "InternalError", *res[1].failure.Code)
+	assert.True(t, isRetryableResult(res[1]), "Expected an error on the side of SQS to be retried")
+
+	require.NotNil(t, res[2].failure)
+	assert.Equal(t, "InvalidMessageContents", *res[2].failure.Code)
+	assert.False(t, isRetryableResult(res[2]), "Expected an invalid message not to be retried")
+
+	require.NotNil(t, res[3].failure)
+	assert.Equal(t, errCodeRequestFailed, *res[3].failure.Code)
+	assert.False(t, isRetryableResult(res[3]), "Expected a message without result not to be retried")
+}
+
+func TestSendMessageBatchRequestFailure(t *testing.T) {
+	a := &adapter{
+		sqsClient: &mockSQSClient{err: errors.New("fake error")},
+		queueURL:  tQueueURL,
+	}
+
+	res, err := a.sendMessageBatch(context.Background(), []*sqs.SendMessageBatchRequestEntry{{
+		MessageBody: aws.String("0"),
+	}})
+	require.NoError(t, err)
+
+	require.Len(t, res, 1)
+	require.NotNil(t, res[0].failure)
+	assert.Equal(t, errCodeRequestFailed, *res[0].failure.Code)
+	assert.Equal(t, "fake error", *res[0].failure.Message)
+}
+
+// newEvent returns a CloudEvent about a customer.
+func newEvent(t *testing.T) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("0000")
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	e.SetExtension("tenant", "acme")
+
+	data := map[string]interface{}{
+		"customer": map[string]interface{}{"id": "c-42"},
+		"delay":    120,
+	}
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, data))
+
+	return e
+}
+
+// mockSQSClient is a mock implementation of the SQS API. Results of
+// SendMessageBatch calls are returned in reverse order and depend on the
+// body of each message:
+//   - "1" fails on the side of SQS
+//   - "2" is rejected as invalid
+//   - "3" is omitted from the response
+//   - others succeed
+type mockSQSClient struct {
+	sqsiface.SQSAPI
+
+	// error returned by calls
+	err error
+}
+
+func (c *mockSQSClient) SendMessageBatchWithContext(_ aws.Context, in *sqs.SendMessageBatchInput,
+	_ ...request.Option) (*sqs.SendMessageBatchOutput, error) {
+
+	if c.err != nil {
+		return nil, c.err
+	}
+
+	out := &sqs.SendMessageBatchOutput{}
+	for i := len(in.Entries) - 1; i >= 0; i-- {
+		m := in.Entries[i]
+		body := aws.StringValue(m.MessageBody)
+
+		switch body {
+		case "1":
+			out.Failed = append(out.Failed, &sqs.BatchResultErrorEntry{
+				Id:          m.Id,
+				Code:        aws.String("InternalError"),
+				Message:     aws.String("Internal error"),
+				SenderFault: aws.Bool(false),
+			})
+		case "2":
+			out.Failed = append(out.Failed, &sqs.BatchResultErrorEntry{
+				Id:          m.Id,
+				Code:        aws.String("InvalidMessageContents"),
+				Message:     aws.String("Invalid message"),
+				SenderFault: aws.Bool(true),
+			})
+		case "3":
+		default:
+			out.Successful = append(out.Successful, &sqs.SendMessageBatchResultEntry{
+				Id:        m.Id,
+				MessageId: aws.String("msg-" + body),
+			})
+		}
+	}
+
+	return out, nil
+}
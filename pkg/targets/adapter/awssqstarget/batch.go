@@ -0,0 +1,168 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awssqstarget
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/sqs"
+
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
+)
+
+// SQS limits
+// https://docs.aws.amazon.com/AWSSimpleQueueService/latest/APIReference/API_SendMessageBatch.html
+const maxSendMessageBatchBytes = 256 * 1024
+
+// errCodeRequestFailed is reported for messages of a SendMessageBatch
+// request which failed as a whole.
+const errCodeRequestFailed = "RequestFailed"
+
+// sendResult is the outcome of sending a message as part of a batch. Exactly
+// one of its fields is set.
+type sendResult struct {
+	success *sqs.SendMessageBatchResultEntry
+	failure *sqs.BatchResultErrorEntry
+}
+
+// messageSize implements batcher.SizeFunc. The size of a message accounts for
+// both its body and its attributes.
+func messageSize(m *sqs.SendMessageBatchRequestEntry) int {
+	size := len(aws.StringValue(m.MessageBody))
+
+	for name, v := range m.MessageAttributes {
+		size += len(name) + len(aws.StringValue(v.DataType)) + len(aws.StringValue(v.StringValue))
+	}
+
+	return size
+}
+
+// messageGroup implements batcher.KeyFunc. Messages which belong to the same
+// group of a FIFO queue are sent in separate batches, in order, since only the
+// failed messages of a batch are retried.
+func messageGroup(m *sqs.SendMessageBatchRequestEntry) string {
+	return aws.StringValue(m.MessageGroupId)
+}
+
+// sendMessageBatchRetry defines how the messages of a batch which failed to
+// be sent due to an error on the side of SQS are retried.
+var sendMessageBatchRetry = batcher.Retry[*sendResult]{
+	MaxAttempts: 5,
+	Backoff:     100 * time.Millisecond,
+	Retryable:   isRetryableResult,
+}
+
+// sendMessageBatch implements batcher.SendFunc. Messages which failed due to
+// an error on the side of SQS are retried, and the failure of a request is
+// reported in the result of each of its messages.
+func (a *adapter) sendMessageBatch(ctx context.Context,
+	msgs []*sqs.SendMessageBatchRequestEntry) ([]*sendResult, error) {
+
+	results, err := batcher.WithRetries(a.sendMessageBatchOnce, sendMessageBatchRetry)(ctx, msgs)
+	if err != nil {
+		res := failedMessageResult(err)
+		results = make([]*sendResult, len(msgs))
+		for i := range results {
+			results[i] = res
+		}
+	}
+
+	return results, nil
+}
+
+// sendMessageBatchOnce sends the given messages in a single SendMessageBatch
+// request.
+func (a *adapter) sendMessageBatchOnce(ctx context.Context,
+	msgs []*sqs.SendMessageBatchRequestEntry) ([]*sendResult, error) {
+
+	// Entries are identified by their index in the request.
+	for i, m := range msgs {
+		m.Id = aws.String(strconv.Itoa(i))
+	}
+
+	out, err := a.sqsClient.SendMessageBatchWithContext(ctx, &sqs.SendMessageBatchInput{
+		QueueUrl: &a.queueURL,
+		Entries:  msgs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*sendResult, len(msgs))
+
+	for _, r := range out.Successful {
+		if idx, ok := entryIndex(r.Id, len(msgs)); ok {
+			results[idx] = &sendResult{success: r}
+		}
+	}
+	for _, r := range out.Failed {
+		if idx, ok := entryIndex(r.Id, len(msgs)); ok {
+			results[idx] = &sendResult{failure: r}
+		}
+	}
+
+	// guard against entries which SQS omitted from its response
+	for i, r := range results {
+		if r == nil {
+			results[i] = &sendResult{failure: &sqs.BatchResultErrorEntry{
+				Code:    aws.String(errCodeRequestFailed),
+				Message: aws.String("no result returned by SQS for this message"),
+			}}
+		}
+	}
+
+	return results, nil
+}
+
+// isRetryableResult returns whether the given message failed to be sent due
+// to an error on the side of SQS. Messages which SQS omitted from its response
+// are not retried, since they may have been sent.
+func isRetryableResult(r *sendResult) bool {
+	return r.failure != nil &&
+		!aws.BoolValue(r.failure.SenderFault) &&
+		aws.StringValue(r.failure.Code) != errCodeRequestFailed
+}
+
+// entryIndex returns the index in the batch of the entry with the given ID.
+func entryIndex(id *string, batchLen int) (int, bool) {
+	idx, err := strconv.Atoi(aws.StringValue(id))
+	if err != nil || idx < 0 || idx >= batchLen {
+		return 0, false
+	}
+	return idx, true
+}
+
+// failedMessageResult returns the result of a message which belongs to a
+// SendMessageBatch request that failed with the given error.
+func failedMessageResult(err error) *sendResult {
+	code := errCodeRequestFailed
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		code = awsErr.Code()
+	}
+
+	return &sendResult{failure: &sqs.BatchResultErrorEntry{
+		Code:    &code,
+		Message: aws.String(err.Error()),
+	}}
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package awssqstarget
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -31,6 +33,27 @@ type envAccessor struct {
 	AwsTargetArn   string `envconfig:"ARN" required:"true"`
 	MessageGroupID string `envconfig:"AWS_MESSAGE_GROUP_ID"`
 
+	// Location of the message group ID, deduplication ID and delay of
+	// messages inside events. At most one of each may be set.
+	MessageGroupIDAttribute  string `envconfig:"AWS_SQS_MESSAGE_GROUP_ID_ATTRIBUTE"`
+	MessageGroupIDDataPath   string `envconfig:"AWS_SQS_MESSAGE_GROUP_ID_DATA_PATH"`
+	DeduplicationIDAttribute string `envconfig:"AWS_SQS_DEDUPLICATION_ID_ATTRIBUTE"`
+	DeduplicationIDDataPath  string `envconfig:"AWS_SQS_DEDUPLICATION_ID_DATA_PATH"`
+	DelaySecondsAttribute    string `envconfig:"AWS_SQS_DELAY_SECONDS_ATTRIBUTE"`
+	DelaySecondsDataPath     string `envconfig:"AWS_SQS_DELAY_SECONDS_DATA_PATH"`
+
+	// Delay of messages, in seconds. The delay of the queue applies when
+	// negative.
+	DelaySeconds int64 `envconfig:"AWS_SQS_DELAY_SECONDS" default:"-1"`
+
+	// CloudEvents context attributes and extensions set as message attributes.
+	MessageAttributes []string `envconfig:"AWS_SQS_MESSAGE_ATTRIBUTES"`
+
+	// Batching of messages in SendMessageBatch requests. Disabled when the
+	// maximum number of messages is zero.
+	BatchMaxMessages int           `envconfig:"AWS_SQS_BATCH_MAX_MESSAGES"`
+	BatchMaxDelay    time.Duration `envconfig:"AWS_SQS_BATCH_MAX_DELAY" default:"1s"`
+
 	DiscardCEContext bool `envconfig:"AWS_DISCARD_CE_CONTEXT"`
 
 	// Assume this IAM Role when access keys provided.
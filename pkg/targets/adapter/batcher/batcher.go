@@ -0,0 +1,292 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package batcher groups the items processed concurrently by targets into
+// batches, for APIs which accept multiple items per request.
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// SendFunc sends a batch of items and returns the result of each item, in
+// the order of the batch. A non-nil error applies to all items of the batch.
+type SendFunc[T, R any] func(context.Context, []T) ([]R, error)
+
+// SizeFunc returns the size in bytes of an item.
+type SizeFunc[T any] func(T) int
+
+// KeyFunc returns the key of an item, or an empty string if the item has no
+// key.
+type KeyFunc[T any] func(T) string
+
+// Limits bounds the size of batches and the latency of their items.
+type Limits struct {
+	// Maximum number of items in a batch.
+	MaxItems int
+	// Maximum cumulated size of the items in a batch, in bytes. Unlimited
+	// when zero.
+	MaxBytes int
+	// Maximum amount of time an item waits for its batch to be sent.
+	MaxDelay time.Duration
+}
+
+// Batcher groups items added concurrently into batches which are sent either
+// when they reach one of their limits, or when their oldest item has been
+// waiting for the maximum delay.
+type Batcher[T, R any] struct {
+	limits Limits
+	size   SizeFunc[T]
+	key    KeyFunc[T]
+	send   SendFunc[T, R]
+
+	items chan *item[T, R]
+}
+
+// item is a value waiting to be sent as part of a batch.
+type item[T, R any] struct {
+	ctx   context.Context
+	val   T
+	size  int
+	key   string
+	reply chan reply[R]
+}
+
+// reply is the outcome of sending an item.
+type reply[R any] struct {
+	res R
+	err error
+}
+
+// batch is a group of items which are sent together.
+type batch[T, R any] struct {
+	items []*item[T, R]
+	size  int
+	keys  map[string]struct{}
+
+	// completion of the batches which must be sent before this one
+	after []<-chan struct{}
+	// closed once the batch was sent
+	done chan struct{}
+}
+
+// New returns a Batcher which sends batches using the given function. The
+// size function may be nil when batches aren't limited in size.
+func New[T, R any](l Limits, size SizeFunc[T], send SendFunc[T, R]) *Batcher[T, R] {
+	if l.MaxItems < 1 {
+		l.MaxItems = 1
+	}
+
+	return &Batcher[T, R]{
+		limits: l,
+		size:   size,
+		send:   send,
+		items:  make(chan *item[T, R]),
+	}
+}
+
+// WithKeys preserves the order of items which share a key: such items are
+// never sent in the same batch, and a batch is sent only once all previous
+// batches which contain one of its keys were sent. It must be called before
+// Run.
+func (b *Batcher[T, R]) WithKeys(key KeyFunc[T]) *Batcher[T, R] {
+	b.key = key
+	return b
+}
+
+// Add enqueues an item and blocks until the batch it belongs to was sent.
+func (b *Batcher[T, R]) Add(ctx context.Context, v T) (R, error) {
+	it := &item[T, R]{
+		ctx:   ctx,
+		val:   v,
+		reply: make(chan reply[R], 1),
+	}
+	if b.size != nil {
+		it.size = b.size(v)
+	}
+	if b.key != nil {
+		it.key = b.key(v)
+	}
+
+	var zero R
+
+	if b.limits.MaxBytes > 0 && it.size > b.limits.MaxBytes {
+		return zero, fmt.Errorf("item of %d bytes exceeds the maximum size of a batch (%d bytes)",
+			it.size, b.limits.MaxBytes)
+	}
+
+	select {
+	case b.items <- it:
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+
+	select {
+	case r := <-it.reply:
+		return r.res, r.err
+	case <-ctx.Done():
+		return zero, ctx.Err()
+	}
+}
+
+// Run collects items into batches until the context is cancelled.
+func (b *Batcher[T, R]) Run(ctx context.Context) {
+	var cur *batch[T, R]
+	var timer *time.Timer
+	var timeout <-chan time.Time
+
+	// last batch sent with each key, until it completes
+	sentKeys := make(map[string]*batch[T, R])
+	sent := make(chan *batch[T, R])
+
+	flush := func() {
+		if timer != nil {
+			timer.Stop()
+			timer, timeout = nil, nil
+		}
+
+		bt := cur
+		cur = nil
+
+		for k := range bt.keys {
+			if prev, ok := sentKeys[k]; ok {
+				bt.after = append(bt.after, prev.done)
+			}
+			sentKeys[k] = bt
+		}
+
+		go func() {
+			b.flush(bt)
+			if len(bt.keys) == 0 {
+				return
+			}
+			select {
+			case sent <- bt:
+			case <-ctx.Done():
+			}
+		}()
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			if cur != nil {
+				flush()
+			}
+			return
+
+		case bt := <-sent:
+			for k := range bt.keys {
+				if sentKeys[k] == bt {
+					delete(sentKeys, k)
+				}
+			}
+
+		case it := <-b.items:
+			if cur != nil && b.limits.MaxBytes > 0 && cur.size+it.size > b.limits.MaxBytes {
+				flush()
+			}
+			if cur != nil && it.key != "" {
+				if _, ok := cur.keys[it.key]; ok {
+					flush()
+				}
+			}
+
+			if cur == nil {
+				cur = &batch[T, R]{
+					keys: make(map[string]struct{}),
+					done: make(chan struct{}),
+				}
+				timer = time.NewTimer(b.limits.MaxDelay)
+				timeout = timer.C
+			}
+
+			cur.items = append(cur.items, it)
+			cur.size += it.size
+			if it.key != "" {
+				cur.keys[it.key] = struct{}{}
+			}
+
+			if len(cur.items) >= b.limits.MaxItems {
+				flush()
+			}
+
+		case <-timeout:
+			timer, timeout = nil, nil
+			flush()
+		}
+	}
+}
+
+// flush sends a batch and notifies all its items of their result.
+func (b *Batcher[T, R]) flush(bt *batch[T, R]) {
+	defer close(bt.done)
+
+	ctx, cancel := batchContext(bt.items)
+	defer cancel()
+
+	var res []R
+	var err error
+
+	for _, prev := range bt.after {
+		select {
+		case <-prev:
+			continue
+		case <-ctx.Done():
+			err = ctx.Err()
+		}
+		break
+	}
+
+	if err == nil {
+		vals := make([]T, len(bt.items))
+		for i, it := range bt.items {
+			vals[i] = it.val
+		}
+
+		res, err = sendChecked(ctx, b.send, vals)
+	}
+
+	for i, it := range bt.items {
+		if err != nil {
+			it.reply <- reply[R]{err: err}
+			continue
+		}
+		it.reply <- reply[R]{res: res[i]}
+	}
+}
+
+// batchContext returns a context for sending a batch on behalf of all its
+// items. The context is cancelled once the contexts of all items are done,
+// since none of them awaits the result of the batch anymore.
+func batchContext[T, R any](items []*item[T, R]) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	go func() {
+		for _, it := range items {
+			select {
+			case <-it.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+
+	return ctx, cancel
+}
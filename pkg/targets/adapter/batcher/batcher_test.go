@@ -0,0 +1,204 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatcher(t *testing.T) {
+	testCases := map[string]struct {
+		limits Limits
+		items  []string
+		err    error
+
+		expectBatches [][]string
+		expectErr     string
+	}{
+		"Full batch and batch flushed after the maximum delay": {
+			limits:        Limits{MaxItems: 2, MaxDelay: 50 * time.Millisecond},
+			items:         []string{"a", "b", "c"},
+			expectBatches: [][]string{{"a", "b"}, {"c"}},
+		},
+		"Batches limited in size": {
+			limits:        Limits{MaxItems: 10, MaxBytes: 4, MaxDelay: 50 * time.Millisecond},
+			items:         []string{"aa", "bb", "cc"},
+			expectBatches: [][]string{{"aa", "bb"}, {"cc"}},
+		},
+		"Failed batch": {
+			limits:        Limits{MaxItems: 2, MaxDelay: 50 * time.Millisecond},
+			items:         []string{"a", "b"},
+			err:           errors.New("fake error"),
+			expectBatches: [][]string{{"a", "b"}},
+			expectErr:     "fake error",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			var sent [][]string
+			var mu sync.Mutex
+
+			send := func(_ context.Context, vals []string) ([]string, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				sent = append(sent, vals)
+
+				if tc.err != nil {
+					return nil, tc.err
+				}
+
+				res := make([]string, len(vals))
+				for i, v := range vals {
+					res[i] = strings.ToUpper(v)
+				}
+				return res, nil
+			}
+
+			size := func(v string) int { return len(v) }
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b := New(tc.limits, size, send)
+			go b.Run(ctx)
+
+			// items are added sequentially, with a delay which is
+			// shorter than the maximum delay of batches, so that
+			// their order is deterministic
+			var wg sync.WaitGroup
+			for _, v := range tc.items {
+				wg.Add(1)
+				go func(v string) {
+					defer wg.Done()
+					res, err := b.Add(ctx, v)
+					if tc.expectErr != "" {
+						assert.EqualError(t, err, tc.expectErr)
+						return
+					}
+					assert.NoError(t, err)
+					assert.Equal(t, strings.ToUpper(v), res)
+				}(v)
+				time.Sleep(5 * time.Millisecond)
+			}
+			wg.Wait()
+
+			mu.Lock()
+			defer mu.Unlock()
+			require.Equal(t, tc.expectBatches, sent)
+		})
+	}
+}
+
+func TestBatcherItemTooLarge(t *testing.T) {
+	b := New(Limits{MaxItems: 10, MaxBytes: 1}, func(v string) int { return len(v) },
+		func(context.Context, []string) ([]string, error) {
+			t.Fatal("Unexpected batch")
+			return nil, nil
+		},
+	)
+
+	_, err := b.Add(context.Background(), "aa")
+	assert.EqualError(t, err, "item of 2 bytes exceeds the maximum size of a batch (1 bytes)")
+}
+
+func TestBatcherCancelledItems(t *testing.T) {
+	sending := make(chan struct{})
+	sendCtxDone := make(chan struct{})
+
+	b := New(Limits{MaxItems: 1}, nil,
+		func(ctx context.Context, vals []string) ([]string, error) {
+			close(sending)
+			<-ctx.Done()
+			close(sendCtxDone)
+			return vals, nil
+		},
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go b.Run(ctx)
+
+	itemCtx, cancelItem := context.WithCancel(context.Background())
+
+	go func() {
+		<-sending
+		cancelItem()
+	}()
+
+	_, err := b.Add(itemCtx, "a")
+	assert.ErrorIs(t, err, context.Canceled)
+
+	select {
+	case <-sendCtxDone:
+	case <-time.After(time.Second):
+		t.Fatal("The batch wasn't cancelled after its only item was")
+	}
+}
+
+func TestBatcherWithKeys(t *testing.T) {
+	var sent [][]string
+	var mu sync.Mutex
+
+	// the first batch is slow to be sent, so that the batches which
+	// follow it would overtake it if they were sent right away
+	send := func(_ context.Context, vals []string) ([]string, error) {
+		if vals[0] == "a1" {
+			time.Sleep(50 * time.Millisecond)
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, vals)
+
+		return vals, nil
+	}
+
+	key := func(v string) string { return v[:1] }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	b := New(Limits{MaxItems: 10, MaxDelay: 10 * time.Millisecond}, nil, send).WithKeys(key)
+	go b.Run(ctx)
+
+	var wg sync.WaitGroup
+	for _, v := range []string{"a1", "b1", "a2", "c1"} {
+		wg.Add(1)
+		go func(v string) {
+			defer wg.Done()
+			_, err := b.Add(ctx, v)
+			assert.NoError(t, err)
+		}(v)
+		time.Sleep(2 * time.Millisecond)
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Equal(t, [][]string{{"a1", "b1"}, {"a2", "c1"}}, sent,
+		"Expected items with the same key to be sent in separate batches, in order")
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Retry defines how the items of a batch which failed to be sent are retried.
+type Retry[R any] struct {
+	// Maximum number of attempts at sending an item, including the first
+	// one.
+	MaxAttempts int
+	// Delay before the first retry, doubled after each attempt.
+	Backoff time.Duration
+	// Returns whether the item which produced the given result should be
+	// sent again.
+	Retryable func(R) bool
+}
+
+// WithRetries returns a SendFunc which sends again, in a smaller batch, the
+// items of a batch which produced a retryable result, until they either
+// succeed or reach the maximum number of attempts.
+//
+// Retries stop as soon as the context is cancelled, in which case items keep
+// the result of their last attempt. Items also keep that result when a retry
+// fails as a whole, so that items which were already sent are never reported
+// as failed.
+func WithRetries[T, R any](send SendFunc[T, R], r Retry[R]) SendFunc[T, R] {
+	return func(ctx context.Context, vals []T) ([]R, error) {
+		results, err := sendChecked(ctx, send, vals)
+		if err != nil {
+			return nil, err
+		}
+
+		// indexes of the items which remain to be sent
+		var pending []int
+
+		for attempt := 1; attempt < r.MaxAttempts; attempt++ {
+			pending = pending[:0]
+			for i, res := range results {
+				if r.Retryable(res) {
+					pending = append(pending, i)
+				}
+			}
+			if len(pending) == 0 {
+				break
+			}
+
+			if !sleep(ctx, r.Backoff<<(attempt-1)) {
+				break
+			}
+
+			retryVals := make([]T, len(pending))
+			for i, idx := range pending {
+				retryVals[i] = vals[idx]
+			}
+
+			retryResults, err := sendChecked(ctx, send, retryVals)
+			if err != nil {
+				break
+			}
+			for i, idx := range pending {
+				results[idx] = retryResults[i]
+			}
+		}
+
+		return results, nil
+	}
+}
+
+// sendChecked sends a batch of items and ensures that exactly one result was
+// returned for each of them.
+func sendChecked[T, R any](ctx context.Context, send SendFunc[T, R], vals []T) ([]R, error) {
+	res, err := send(ctx, vals)
+	if err == nil && len(res) != len(vals) {
+		err = fmt.Errorf("got %d results for a batch of %d items", len(res), len(vals))
+	}
+	return res, err
+}
+
+// sleep pauses for the given duration and returns whether the context is
+// still active after that.
+func sleep(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
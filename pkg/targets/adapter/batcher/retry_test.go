@@ -0,0 +1,124 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package batcher
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithRetries(t *testing.T) {
+	const failed = "failed"
+
+	testCases := map[string]struct {
+		// number of attempts at which each item fails before succeeding
+		failures map[string]int
+		// attempt from which requests fail as a whole
+		errAttempt int
+		cancelled  bool
+
+		expectBatches [][]string
+		expectResults []string
+		expectErr     string
+	}{
+		"Failed items are retried until they succeed": {
+			failures:      map[string]int{"b": 2},
+			expectBatches: [][]string{{"a", "b", "c"}, {"b"}, {"b"}},
+			expectResults: []string{"A", "B", "C"},
+		},
+		"Failed items are retried up to the maximum number of attempts": {
+			failures:      map[string]int{"a": 5, "c": 1},
+			expectBatches: [][]string{{"a", "b", "c"}, {"a", "c"}, {"a"}},
+			expectResults: []string{failed, "B", "C"},
+		},
+		"Failed first attempt": {
+			errAttempt:    1,
+			expectBatches: [][]string{{"a", "b", "c"}},
+			expectErr:     "fake error",
+		},
+		"Failed retry keeps the results of the previous attempt": {
+			failures:      map[string]int{"b": 1},
+			errAttempt:    2,
+			expectBatches: [][]string{{"a", "b", "c"}, {"b"}},
+			expectResults: []string{"A", failed, "C"},
+		},
+		"No retry after the context is cancelled": {
+			failures:      map[string]int{"b": 1},
+			cancelled:     true,
+			expectBatches: [][]string{{"a", "b", "c"}},
+			expectResults: []string{"A", failed, "C"},
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			var sent [][]string
+			attempts := make(map[string]int)
+
+			send := func(_ context.Context, vals []string) ([]string, error) {
+				sent = append(sent, vals)
+
+				if tc.errAttempt != 0 && len(sent) >= tc.errAttempt {
+					return nil, errors.New("fake error")
+				}
+
+				res := make([]string, len(vals))
+				for i, v := range vals {
+					attempts[v]++
+					if attempts[v] <= tc.failures[v] {
+						res[i] = failed
+						continue
+					}
+					res[i] = strings.ToUpper(v)
+				}
+
+				if tc.cancelled {
+					cancel()
+				}
+
+				return res, nil
+			}
+
+			s := WithRetries(send, Retry[string]{
+				MaxAttempts: 3,
+				Backoff:     time.Millisecond,
+				Retryable:   func(r string) bool { return r == failed },
+			})
+
+			res, err := s(ctx, []string{"a", "b", "c"})
+
+			assert.Equal(t, tc.expectBatches, sent)
+
+			if tc.expectErr != "" {
+				assert.EqualError(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.expectResults, res)
+		})
+	}
+}
@@ -18,16 +18,23 @@ package cloudeventstarget
 
 import (
 	"context"
-	"encoding/base64"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strings"
 	"sync"
 	"time"
 
 	"go.uber.org/zap"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/binding"
 	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
@@ -35,9 +42,23 @@ import (
 
 	"github.com/triggermesh/triggermesh/pkg/adapter/fs"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/batcher"
 )
 
+// defaultRetryableStatusCodes are the HTTP status codes retried when no
+// explicit list is configured. They match the CloudEvents SDK defaults.
+var defaultRetryableStatusCodes = []int{
+	http.StatusNotFound,
+	http.StatusRequestEntityTooLarge,
+	http.StatusTooEarly,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
 // NewTarget adapter implementation
 func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, listenClient cloudevents.Client) pkgadapter.Adapter {
 	logger := logging.FromContext(ctx)
@@ -52,29 +73,65 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, listenC
 
 	env := envAcc.(*envAccessor)
 
-	if _, err := url.Parse(env.URL); err != nil {
+	u, err := url.Parse(env.URL)
+	if err != nil {
 		logger.Panicw("URL is not parseable", zap.Error(err))
 	}
+	if env.Path != "" {
+		u.Path = env.Path
+	}
 
 	fw, err := fs.NewWatcher(logger)
 	if err != nil {
 		logger.Panicw("Could not create a file watcher", zap.Error(err))
 	}
 
+	retryableCodes := env.RetryStatusCodes
+	if len(retryableCodes) == 0 {
+		retryableCodes = defaultRetryableStatusCodes
+	}
+
 	ceAdapter := &ceAdapter{
 		listenClient: listenClient,
-		logger:       logger,
-		m:            sync.RWMutex{},
-		sr:           metrics.MustNewEventProcessingStatsReporter(mt),
+		target:       u,
+
+		mode:               v1alpha1.CloudEventsMode(env.Mode),
+		retryCount:         env.RetryCount,
+		retryBackoffPolicy: v1alpha1.BackoffPolicyType(env.RetryBackoffPolicy),
+		retryBackoffDelay:  env.RetryBackoffDelay,
+		retryableCodes:     make(map[int]struct{}, len(retryableCodes)),
+
+		logger: logger,
+		m:      sync.RWMutex{},
+		sr:     metrics.MustNewEventProcessingStatsReporter(mt),
+	}
+
+	for _, c := range retryableCodes {
+		ceAdapter.retryableCodes[c] = struct{}{}
 	}
 
-	ceClientUpdater := ceAdapter.senderClientUpdater(env.URL, env.BasicAuthPasswordPath, env.BasicAuthUsername)
-	if env.BasicAuthUsername != "" {
+	if env.CircuitBreakerFailureThreshold > 0 {
+		ceAdapter.circuitBreaker = newCircuitBreaker(env.CircuitBreakerFailureThreshold, env.CircuitBreakerOpenDuration)
+	}
 
-		if err := fw.Add(env.BasicAuthPasswordPath, ceClientUpdater); err != nil {
-			logger.Panicw(
-				"Authentication secret could not be watched at the specific path",
-				zap.Error(err))
+	if ceAdapter.mode == v1alpha1.CloudEventsModeBatch {
+		ceAdapter.batcher = batcher.New(batcher.Limits{
+			MaxItems: env.BatchMaxSize,
+			MaxDelay: env.BatchMaxDelay,
+		}, nil, ceAdapter.sendBatch)
+	}
+
+	ceClientUpdater := ceAdapter.senderClientUpdater(env)
+
+	// Secrets are mounted as files that are watched so that the client
+	// is rebuilt when credentials are rotated.
+	if secretPaths := env.secretPaths(); len(secretPaths) != 0 {
+		for _, p := range secretPaths {
+			if err := fw.Add(p, ceClientUpdater); err != nil {
+				logger.Panicw(
+					"Authentication secret could not be watched at the specific path",
+					zap.String("path", p), zap.Error(err))
+			}
 		}
 		ceAdapter.fileWatcher = fw
 	}
@@ -90,59 +147,157 @@ var _ pkgadapter.Adapter = (*ceAdapter)(nil)
 type ceAdapter struct {
 	fileWatcher  fs.FileWatcher
 	senderClient cloudevents.Client
+	httpClient   *http.Client
 	listenClient cloudevents.Client
 
+	target *url.URL
+	mode   v1alpha1.CloudEventsMode
+
+	retryCount         int
+	retryBackoffPolicy v1alpha1.BackoffPolicyType
+	retryBackoffDelay  time.Duration
+	retryableCodes     map[int]struct{}
+
+	circuitBreaker *circuitBreaker
+	batcher        *batcher.Batcher[cloudevents.Event, cloudevents.Result]
+
 	logger *zap.SugaredLogger
 	m      sync.RWMutex
 	sr     *metrics.EventProcessingStatsReporter
 }
 
-func (a *ceAdapter) senderClientUpdater(url, path, username string) fs.WatchCallback {
+func (a *ceAdapter) senderClientUpdater(env *envAccessor) fs.WatchCallback {
 	return func() {
 		a.m.Lock()
 		defer a.m.Unlock()
 
+		httpClient, err := newHTTPClient(env)
+		if err != nil {
+			a.logger.Errorw("Could not build HTTP client from the mounted credentials", zap.Error(err))
+			return
+		}
+
 		opts := []cehttp.Option{
-			cehttp.WithTarget(url),
+			cehttp.WithTarget(a.target.String()),
+			cehttp.WithClient(*httpClient),
+			cehttp.WithIsRetriableFunc(a.isRetryable),
+		}
+
+		senderClient, err := cloudevents.NewClientHTTP(opts...)
+		if err != nil {
+			a.logger.Fatalw("Unable to create CloudEvent client", zap.Error(err))
 		}
 
-		if path != "" {
-			opts = append(opts, cehttp.WithPath(path))
+		a.senderClient = senderClient
+		a.httpClient = httpClient
+	}
+}
+
+// newHTTPClient returns an HTTP client that authenticates requests using the
+// credentials found in the adapter's configuration.
+func newHTTPClient(env *envAccessor) (*http.Client, error) {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+
+	if env.TLSCertificatePath != "" {
+		cert, err := tls.LoadX509KeyPair(env.TLSCertificatePath, env.TLSKeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("loading TLS client certificate: %w", err)
 		}
+		t.TLSClientConfig = &tls.Config{
+			Certificates: []tls.Certificate{cert},
+			MinVersion:   tls.VersionTLS12,
+		}
+	}
 
-		if username != "" {
-			password, err := os.ReadFile(path)
-			if err != nil {
-				a.logger.Errorw("Could not read the mounted password at the specific path", zap.Error(err))
-				return
-			}
+	if env.TLSCACertificatePath != "" {
+		ca, err := os.ReadFile(env.TLSCACertificatePath)
+		if err != nil {
+			return nil, fmt.Errorf("reading CA certificate: %w", err)
+		}
+		certPool := x509.NewCertPool()
+		if !certPool.AppendCertsFromPEM(ca) {
+			return nil, errors.New("failed adding CA certificate to pool")
+		}
+		if t.TLSClientConfig == nil {
+			t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+		}
+		t.TLSClientConfig.RootCAs = certPool
+	}
+
+	var rt http.RoundTripper = t
 
-			opts = append(opts, cehttp.WithHeader(
-				"Authorization",
-				"Basic "+base64.StdEncoding.EncodeToString(
-					append([]byte(username+":"), password...)),
-			))
+	switch {
+	case env.BasicAuthUsername != "":
+		password, err := readSecretFile(env.BasicAuthPasswordPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading basic authentication password: %w", err)
 		}
+		req := &http.Request{Header: http.Header{}}
+		req.SetBasicAuth(env.BasicAuthUsername, password)
+		rt = &authRoundTripper{base: rt, authorization: req.Header.Get("Authorization")}
 
-		senderClient, err := cloudevents.NewClientHTTP(opts...)
+	case env.BearerTokenPath != "":
+		token, err := readSecretFile(env.BearerTokenPath)
 		if err != nil {
-			a.logger.Fatalw("Unable to create CloudEvent client", zap.Error(err))
+			return nil, fmt.Errorf("reading bearer token: %w", err)
 		}
+		rt = &authRoundTripper{base: rt, authorization: "Bearer " + token}
 
-		a.senderClient = senderClient
+	case env.OAuth2ClientID != "":
+		secret, err := readSecretFile(env.OAuth2ClientSecretPath)
+		if err != nil {
+			return nil, fmt.Errorf("reading OAuth2 client secret: %w", err)
+		}
+		cfg := clientcredentials.Config{
+			ClientID:     env.OAuth2ClientID,
+			ClientSecret: secret,
+			TokenURL:     env.OAuth2TokenURL,
+			Scopes:       env.OAuth2Scopes,
+		}
+		ctx := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{Transport: rt})
+		return cfg.Client(ctx), nil
+	}
+
+	return &http.Client{Transport: rt}, nil
+}
+
+// readSecretFile returns the content of a file mounted from a Secret,
+// stripped from its trailing newline.
+func readSecretFile(path string) (string, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
 	}
+	return strings.TrimRight(string(b), "\r\n"), nil
+}
+
+// authRoundTripper sets the Authorization header on outgoing requests.
+type authRoundTripper struct {
+	base          http.RoundTripper
+	authorization string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (rt *authRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", rt.authorization)
+	return rt.base.RoundTrip(r)
 }
 
 // Returns if stopCh is closed or Send() returns an error.
 func (a *ceAdapter) Start(ctx context.Context) error {
 	a.logger.Info("Starting CloudEvents gateway adapter")
 
-	// If basic authentication credentials are used, start the filewatcher
-	// to update the password if changed.
+	// If credentials are mounted from Secrets, start the filewatcher
+	// to update the client if they change.
 	if a.fileWatcher != nil {
 		a.fileWatcher.Start(ctx)
 	}
 
+	if a.batcher != nil {
+		go a.batcher.Run(ctx)
+	}
+
 	return a.listenClient.StartReceiver(ctx, a.dispatch)
 }
 
@@ -155,17 +310,55 @@ func (a *ceAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 		a.sr.ReportProcessingLatency(time.Since(start), ceTypeTag, ceSrcTag)
 	}()
 
+	a.m.RLock()
+	senderClient := a.senderClient
+	a.m.RUnlock()
+
 	// When using authentication sender client is initialized using the file watcher.
 	// This check fails if the authentication secrets are not yet present and the
 	// client has not been built.
-	if a.senderClient == nil {
+	if senderClient == nil {
 		err := fmt.Errorf("CloudEvents client not intialized. Please, make sure that authentication secret is available")
 		a.logger.Errorw("Failed to send event", zap.Error(err))
 		a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
 		return nil, err
 	}
 
-	re, r := a.senderClient.Request(ctx, event)
+	var probe bool
+	if a.circuitBreaker != nil {
+		var allowed bool
+		if allowed, probe = a.circuitBreaker.allow(); !allowed {
+			a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
+			return nil, cloudevents.NewHTTPResult(http.StatusServiceUnavailable,
+				"circuit breaker is open, the destination is considered unavailable")
+		}
+	}
+
+	ctx = a.withRetries(ctx)
+
+	var re *cloudevents.Event
+	var r cloudevents.Result
+
+	switch a.mode {
+	case v1alpha1.CloudEventsModeBatch:
+		var err error
+		if r, err = a.batcher.Add(ctx, event); err != nil {
+			r = err
+		}
+	case v1alpha1.CloudEventsModeStructured:
+		re, r = senderClient.Request(binding.WithForceStructured(ctx), event)
+	default:
+		re, r = senderClient.Request(ctx, event)
+	}
+
+	if a.circuitBreaker != nil {
+		if aborted(ctx, r) {
+			a.circuitBreaker.release(probe)
+		} else {
+			a.circuitBreaker.record(probe, !a.isUnavailable(r))
+		}
+	}
+
 	if cloudevents.IsNACK(r) {
 		a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
 		a.logger.Errorw("Could not send event to destination", zap.Error(r))
@@ -175,3 +368,62 @@ func (a *ceAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*clo
 
 	return re, r
 }
+
+// withRetries returns a copy of the given context that carries the retry
+// parameters understood by the CloudEvents HTTP protocol.
+func (a *ceAdapter) withRetries(ctx context.Context) context.Context {
+	if a.retryCount <= 0 {
+		return ctx
+	}
+
+	if a.retryBackoffPolicy == v1alpha1.BackoffPolicyLinear {
+		return cloudevents.ContextWithRetriesLinearBackoff(ctx, a.retryBackoffDelay, a.retryCount)
+	}
+	return cloudevents.ContextWithRetriesExponentialBackoff(ctx, a.retryBackoffDelay, a.retryCount)
+}
+
+// isRetryable implements cehttp.IsRetriable.
+func (a *ceAdapter) isRetryable(statusCode int) bool {
+	_, ok := a.retryableCodes[statusCode]
+	return ok
+}
+
+// isUnavailable returns whether the given result indicates that the
+// destination could not be reached or was not able to process the request,
+// as opposed to a rejection of the event itself.
+func (a *ceAdapter) isUnavailable(r cloudevents.Result) bool {
+	if !cloudevents.IsNACK(r) {
+		return false
+	}
+
+	sc, ok := statusCode(r)
+	if !ok {
+		return true
+	}
+
+	return sc >= http.StatusInternalServerError || a.isRetryable(sc)
+}
+
+// aborted returns whether the request which produced the given result was
+// cancelled by the sender of the event, or timed out on its side, in which
+// case the result says nothing about the availability of the destination.
+func aborted(ctx context.Context, r cloudevents.Result) bool {
+	return ctx.Err() != nil ||
+		errors.Is(r, context.Canceled) ||
+		errors.Is(r, context.DeadlineExceeded)
+}
+
+// statusCode returns the HTTP status code contained in the given result, if any.
+func statusCode(r cloudevents.Result) (int, bool) {
+	var rr *cehttp.RetriesResult
+	if errors.As(r, &rr) {
+		r = rr.Result
+	}
+
+	var hr *cehttp.Result
+	if cloudevents.ResultAs(r, &hr) {
+		return hr.StatusCode, true
+	}
+
+	return 0, false
+}
@@ -19,6 +19,8 @@ package cloudeventstarget
 import (
 	"context"
 	"encoding/json"
+	"net/http"
+	"net/http/httptest"
 	"sync"
 	"testing"
 	"time"
@@ -168,3 +170,30 @@ func TestCloudEventsDispatch(t *testing.T) {
 		})
 	}
 }
+
+func TestAborted(t *testing.T) {
+	// the destination never answers before the sender gives up
+	unblock := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-unblock
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(unblock) })
+
+	c, err := cloudevents.NewClientHTTP(cloudevents.WithTarget(srv.URL))
+	require.NoError(t, err)
+
+	event := cloudevents.NewEvent()
+	event.SetType("type")
+	event.SetSource("source")
+	event.SetID("id")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, r := c.Request(ctx, event)
+	require.True(t, cloudevents.IsNACK(r))
+
+	assert.True(t, aborted(context.Background(), r), "Expected a timeout to be detected from the result")
+	assert.False(t, aborted(context.Background(), cloudevents.NewHTTPResult(http.StatusBadGateway, "bad gateway")))
+}
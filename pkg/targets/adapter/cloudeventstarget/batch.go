@@ -0,0 +1,93 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudeventstarget
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cecontext "github.com/cloudevents/sdk-go/v2/context"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+// sendBatch implements batcher.SendFunc. Events are sent in the JSON batch
+// format, retrying the request according to the adapter's retry parameters.
+// The result of the request applies to every event of the batch.
+func (a *ceAdapter) sendBatch(ctx context.Context, events []cloudevents.Event) ([]cloudevents.Result, error) {
+	r := a.postBatchWithRetries(a.withRetries(ctx), events)
+
+	results := make([]cloudevents.Result, len(events))
+	for i := range results {
+		results[i] = r
+	}
+	return results, nil
+}
+
+// postBatchWithRetries sends a batch of events, retrying the request
+// according to the parameters carried by the context.
+func (a *ceAdapter) postBatchWithRetries(ctx context.Context, events []cloudevents.Event) cloudevents.Result {
+	body, err := json.Marshal(events)
+	if err != nil {
+		return fmt.Errorf("serializing batch of events: %w", err)
+	}
+
+	a.m.RLock()
+	client := a.httpClient
+	a.m.RUnlock()
+
+	params := cecontext.RetriesFrom(ctx)
+
+	for try := 1; ; try++ {
+		r := a.postBatch(ctx, client, body)
+
+		if !cloudevents.IsNACK(r) {
+			return r
+		}
+		if sc, ok := statusCode(r); ok && !a.isRetryable(sc) {
+			return r
+		}
+		if params.Backoff(ctx, try) != nil {
+			return r
+		}
+	}
+}
+
+// postBatch sends a serialized batch of events to the destination once.
+func (a *ceAdapter) postBatch(ctx context.Context, client *http.Client, body []byte) cloudevents.Result {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.target.String(), bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("creating HTTP request: %w", err)
+	}
+	req.Header.Set("Content-Type", cloudevents.ApplicationCloudEventsBatchJSON)
+
+	res, err := client.Do(req)
+	if err != nil {
+		return cloudevents.NewReceipt(false, "%w", err)
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+
+	if res.StatusCode/100 != 2 {
+		return cehttp.NewResult(res.StatusCode, "%w", cloudevents.ResultNACK)
+	}
+	return cehttp.NewResult(res.StatusCode, "%w", cloudevents.ResultACK)
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudeventstarget
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	loggingtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestSendBatch(t *testing.T) {
+	var calls int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, cloudevents.ApplicationCloudEventsBatchJSON, r.Header.Get("Content-Type"))
+
+		var evs []cloudevents.Event
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&evs))
+		assert.Len(t, evs, 2)
+
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err)
+
+	a := &ceAdapter{
+		httpClient:        srv.Client(),
+		target:            u,
+		retryCount:        1,
+		retryBackoffDelay: time.Millisecond,
+		retryableCodes:    map[int]struct{}{http.StatusServiceUnavailable: {}},
+		logger:            loggingtesting.TestLogger(t),
+	}
+
+	res, err := a.sendBatch(context.Background(), []cloudevents.Event{newTestEvent("1"), newTestEvent("2")})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	for _, r := range res {
+		assert.True(t, cloudevents.IsACK(r), "Unexpected result: %v", r)
+	}
+	assert.EqualValues(t, 2, atomic.LoadInt32(&calls), "Expected the batch to be retried once")
+}
+
+func newTestEvent(id string) cloudevents.Event {
+	e := cloudevents.NewEvent(cloudevents.VersionV1)
+	e.SetID(id)
+	e.SetType("type")
+	e.SetSource("source")
+	return e
+}
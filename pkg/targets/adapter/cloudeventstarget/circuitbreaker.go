@@ -0,0 +1,104 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudeventstarget
+
+import (
+	"sync"
+	"time"
+)
+
+// circuitBreaker rejects requests for a period of time after a number of
+// consecutive failures, so that an unavailable destination does not tie up
+// all the adapter's workers. Once that period expires, a single request is
+// allowed to probe the destination and either closes the circuit on success
+// or opens it again on failure.
+type circuitBreaker struct {
+	threshold    int
+	openDuration time.Duration
+
+	mu       sync.Mutex
+	failures int
+	openedAt time.Time
+	probing  bool
+
+	// allows tests to control the clock
+	now func() time.Time
+}
+
+func newCircuitBreaker(threshold int, openDuration time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		threshold:    threshold,
+		openDuration: openDuration,
+		now:          time.Now,
+	}
+}
+
+// allow returns whether a request can be sent to the destination, and
+// whether that request is the probe of a half-open circuit.
+func (cb *circuitBreaker) allow() (allowed, probe bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if cb.failures < cb.threshold {
+		return true, false
+	}
+
+	if cb.probing || cb.now().Sub(cb.openedAt) < cb.openDuration {
+		return false, false
+	}
+
+	cb.probing = true
+	return true, true
+}
+
+// record updates the state of the circuit with the outcome of a request.
+// While the circuit is open, only the outcome of the probe is taken into
+// account, requests which were in flight when the circuit opened are ignored.
+func (cb *circuitBreaker) record(probe, success bool) {
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	if probe {
+		cb.probing = false
+	} else if cb.failures >= cb.threshold {
+		return
+	}
+
+	if success {
+		cb.failures = 0
+		return
+	}
+
+	cb.failures++
+	if cb.failures >= cb.threshold {
+		cb.openedAt = cb.now()
+	}
+}
+
+// release ends a request without taking its outcome into account, e.g.
+// because it was aborted before the destination could answer. A released
+// probe lets the next request probe the destination.
+func (cb *circuitBreaker) release(probe bool) {
+	if !probe {
+		return
+	}
+
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+
+	cb.probing = false
+}
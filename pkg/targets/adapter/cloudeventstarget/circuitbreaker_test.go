@@ -0,0 +1,108 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cloudeventstarget
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	cb := newCircuitBreaker(2, time.Minute)
+	cb.now = func() time.Time { return now }
+
+	allowed, probe := cb.allow()
+	assert.True(t, allowed, "Closed circuit should allow requests")
+	assert.False(t, probe, "Requests sent through a closed circuit are not probes")
+	cb.record(probe, false)
+	allowed, _ = cb.allow()
+	assert.True(t, allowed, "Circuit should remain closed under the failure threshold")
+	cb.record(false, false)
+	allowed, _ = cb.allow()
+	assert.False(t, allowed, "Circuit should open once the failure threshold is reached")
+
+	now = now.Add(time.Minute)
+	allowed, probe = cb.allow()
+	assert.True(t, allowed, "A probe should be allowed after the open duration")
+	assert.True(t, probe)
+	allowed, _ = cb.allow()
+	assert.False(t, allowed, "Only one probe should be allowed at a time")
+
+	cb.record(probe, false)
+	allowed, _ = cb.allow()
+	assert.False(t, allowed, "A failed probe should open the circuit again")
+
+	now = now.Add(time.Minute)
+	allowed, probe = cb.allow()
+	assert.True(t, allowed)
+	cb.record(probe, true)
+	allowed, _ = cb.allow()
+	assert.True(t, allowed, "A successful probe should close the circuit")
+}
+
+func TestCircuitBreakerSingleProbe(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	cb := newCircuitBreaker(1, time.Minute)
+	cb.now = func() time.Time { return now }
+
+	// two requests are in flight when the circuit opens
+	_, _ = cb.allow()
+	_, _ = cb.allow()
+	cb.record(false, false)
+
+	now = now.Add(time.Minute)
+	allowed, probe := cb.allow()
+	assert.True(t, allowed)
+	assert.True(t, probe)
+
+	// the outcome of the second in-flight request must neither end the
+	// probe nor close the circuit
+	cb.record(false, true)
+	allowed, _ = cb.allow()
+	assert.False(t, allowed, "Only one probe should be allowed at a time")
+
+	cb.record(probe, true)
+	allowed, _ = cb.allow()
+	assert.True(t, allowed, "A successful probe should close the circuit")
+}
+
+func TestCircuitBreakerReleasedProbe(t *testing.T) {
+	now := time.Unix(0, 0)
+
+	cb := newCircuitBreaker(1, time.Minute)
+	cb.now = func() time.Time { return now }
+
+	_, _ = cb.allow()
+	cb.record(false, false)
+
+	now = now.Add(time.Minute)
+	_, probe := cb.allow()
+	require.True(t, probe)
+
+	// the probe is aborted by the sender of the event
+	cb.release(probe)
+
+	allowed, probe := cb.allow()
+	assert.True(t, allowed, "A released probe should let another request probe the destination")
+	assert.True(t, probe)
+}
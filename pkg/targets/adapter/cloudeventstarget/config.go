@@ -17,6 +17,8 @@ limitations under the License.
 package cloudeventstarget
 
 import (
+	"time"
+
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
 
@@ -32,4 +34,44 @@ type envAccessor struct {
 	Path                  string `envconfig:"CLOUDEVENTS_PATH"`
 	BasicAuthUsername     string `envconfig:"CLOUDEVENTS_BASICAUTH_USERNAME"`
 	BasicAuthPasswordPath string `envconfig:"CLOUDEVENTS_BASICAUTH_PASSWORD_PATH"`
+
+	BearerTokenPath        string   `envconfig:"CLOUDEVENTS_BEARER_TOKEN_PATH"`
+	OAuth2ClientID         string   `envconfig:"CLOUDEVENTS_OAUTH2_CLIENT_ID"`
+	OAuth2ClientSecretPath string   `envconfig:"CLOUDEVENTS_OAUTH2_CLIENT_SECRET_PATH"`
+	OAuth2TokenURL         string   `envconfig:"CLOUDEVENTS_OAUTH2_TOKEN_URL"`
+	OAuth2Scopes           []string `envconfig:"CLOUDEVENTS_OAUTH2_SCOPES"`
+	TLSCertificatePath     string   `envconfig:"CLOUDEVENTS_TLS_CERTIFICATE_PATH"`
+	TLSKeyPath             string   `envconfig:"CLOUDEVENTS_TLS_KEY_PATH"`
+	TLSCACertificatePath   string   `envconfig:"CLOUDEVENTS_TLS_CA_CERTIFICATE_PATH"`
+
+	Mode          string        `envconfig:"CLOUDEVENTS_MODE" default:"binary"`
+	BatchMaxSize  int           `envconfig:"CLOUDEVENTS_BATCH_MAX_SIZE" default:"10"`
+	BatchMaxDelay time.Duration `envconfig:"CLOUDEVENTS_BATCH_MAX_DELAY" default:"1s"`
+
+	RetryCount         int           `envconfig:"CLOUDEVENTS_RETRY_COUNT"`
+	RetryBackoffPolicy string        `envconfig:"CLOUDEVENTS_RETRY_BACKOFF_POLICY" default:"exponential"`
+	RetryBackoffDelay  time.Duration `envconfig:"CLOUDEVENTS_RETRY_BACKOFF_DELAY" default:"1s"`
+	RetryStatusCodes   []int         `envconfig:"CLOUDEVENTS_RETRY_STATUS_CODES"`
+
+	CircuitBreakerFailureThreshold int           `envconfig:"CLOUDEVENTS_CIRCUIT_BREAKER_FAILURE_THRESHOLD"`
+	CircuitBreakerOpenDuration     time.Duration `envconfig:"CLOUDEVENTS_CIRCUIT_BREAKER_OPEN_DURATION" default:"30s"`
+}
+
+// secretPaths returns the paths of all files mounted from Secrets that the
+// adapter needs to watch for changes.
+func (e *envAccessor) secretPaths() []string {
+	var paths []string
+	for _, p := range []string{
+		e.BasicAuthPasswordPath,
+		e.BearerTokenPath,
+		e.OAuth2ClientSecretPath,
+		e.TLSCertificatePath,
+		e.TLSKeyPath,
+		e.TLSCACertificatePath,
+	} {
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+	return paths
 }
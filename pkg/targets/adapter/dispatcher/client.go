@@ -0,0 +1,95 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+
+	"github.com/kelseyhightower/envconfig"
+	"go.uber.org/zap"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	"knative.dev/pkg/logging"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/receiver"
+)
+
+// Middleware wraps the given adapter constructor so that the events received
+// by the adapter are processed by a Dispatcher, when dispatch is enabled via
+// the environment.
+func Middleware(ctor pkgadapter.AdapterConstructor) pkgadapter.AdapterConstructor {
+	return func(ctx context.Context, env pkgadapter.EnvConfigAccessor, ceClient cloudevents.Client) pkgadapter.Adapter {
+		logger := logging.FromContext(ctx)
+
+		cfg := &EnvConfig{}
+		if err := envconfig.Process("", cfg); err != nil {
+			logger.Panicw("Error processing dispatch configuration", zap.Error(err))
+		}
+
+		if !cfg.Enabled() {
+			return ctor(ctx, env, ceClient)
+		}
+
+		d, err := New(cfg)
+		if err != nil {
+			logger.Panicw("Error creating dispatcher", zap.Error(err))
+		}
+
+		logger.Infow("Partitioned dispatch of events enabled",
+			zap.Int("workers", cfg.Workers),
+			zap.Int("queueDepth", cfg.QueueDepth),
+		)
+
+		return ctor(ctx, env, NewClient(ceClient, d))
+	}
+}
+
+// client is a cloudevents.Client which processes received events using a
+// Dispatcher.
+type client struct {
+	cloudevents.Client
+
+	dispatcher *Dispatcher
+}
+
+var _ cloudevents.Client = (*client)(nil)
+
+// NewClient returns a cloudevents.Client which wraps the given client and
+// processes received events using the given Dispatcher.
+func NewClient(c cloudevents.Client, d *Dispatcher) cloudevents.Client {
+	return &client{
+		Client:     c,
+		dispatcher: d,
+	}
+}
+
+// StartReceiver implements cloudevents.Client.
+func (c *client) StartReceiver(ctx context.Context, fn interface{}) error {
+	receive, err := receiver.Normalize(fn)
+	if err != nil {
+		return err
+	}
+
+	c.dispatcher.Start(ctx)
+
+	return c.Client.StartReceiver(ctx, func(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+		return c.dispatcher.Dispatch(ctx, e, receive)
+	})
+}
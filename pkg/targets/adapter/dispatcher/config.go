@@ -0,0 +1,118 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"fmt"
+	"time"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/tidwall/gjson"
+)
+
+// EnvConfig contains the dispatch parameters. They are read from the
+// environment of any adapter wrapped with Middleware.
+type EnvConfig struct {
+	// Number of events processed concurrently. The dispatch of events is
+	// disabled when zero.
+	Workers int `envconfig:"DISPATCH_WORKERS"`
+	// Maximum number of events waiting to be processed by each worker.
+	QueueDepth int `envconfig:"DISPATCH_QUEUE_DEPTH" default:"100"`
+
+	// Source of the ordering key of events. At most one may be set.
+	OrderingAttribute string `envconfig:"DISPATCH_ORDERING_ATTRIBUTE"`
+	OrderingDataPath  string `envconfig:"DISPATCH_ORDERING_DATA_PATH"`
+}
+
+// Enabled returns whether the dispatch of events is enabled.
+func (c *EnvConfig) Enabled() bool {
+	return c.Workers > 0
+}
+
+// New returns a Dispatcher initialized from the given configuration.
+func New(cfg *EnvConfig) (*Dispatcher, error) {
+	if cfg.Workers < 1 {
+		return nil, fmt.Errorf("invalid number of workers %d: must be greater than zero", cfg.Workers)
+	}
+	if cfg.QueueDepth < 1 {
+		return nil, fmt.Errorf("invalid queue depth %d: must be greater than zero", cfg.QueueDepth)
+	}
+
+	if cfg.OrderingAttribute != "" && cfg.OrderingDataPath != "" {
+		return nil, fmt.Errorf("the ordering key can be either an attribute or a data path, not both")
+	}
+
+	return NewDispatcher(cfg.Workers, cfg.QueueDepth, NewKeyFunc(cfg.OrderingAttribute, cfg.OrderingDataPath)), nil
+}
+
+// KeyFunc returns the ordering key of an event. Events with an empty key are
+// not subject to any ordering.
+type KeyFunc func(*cloudevents.Event) string
+
+// NewKeyFunc returns a KeyFunc which reads a value from either the given
+// context attribute or the given path inside the event's data, or nil if
+// neither is set. The attribute takes precedence if both are set.
+func NewKeyFunc(attribute, dataPath string) KeyFunc {
+	switch {
+	case attribute != "":
+		return AttributeKey(attribute)
+	case dataPath != "":
+		return DataPathKey(dataPath)
+	}
+	return nil
+}
+
+// AttributeKey returns a KeyFunc which uses the value of the given context
+// attribute or extension as ordering key.
+func AttributeKey(name string) KeyFunc {
+	return func(e *cloudevents.Event) string {
+		switch name {
+		case "id":
+			return e.ID()
+		case "source":
+			return e.Source()
+		case "type":
+			return e.Type()
+		case "subject":
+			return e.Subject()
+		case "dataschema":
+			return e.DataSchema()
+		case "datacontenttype":
+			return e.DataContentType()
+		case "specversion":
+			return e.SpecVersion()
+		case "time":
+			if t := e.Time(); !t.IsZero() {
+				return t.UTC().Format(time.RFC3339Nano)
+			}
+			return ""
+		}
+
+		if v, ok := e.Extensions()[name]; ok {
+			return fmt.Sprint(v)
+		}
+		return ""
+	}
+}
+
+// DataPathKey returns a KeyFunc which uses the value located at the given
+// GJSON path inside the event's data as ordering key.
+func DataPathKey(path string) KeyFunc {
+	return func(e *cloudevents.Event) string {
+		return gjson.GetBytes(e.Data(), path).String()
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dispatcher dispatches the events received by targets to a pool of
+// workers, preserving the order of events which share an ordering key.
+package dispatcher
+
+import (
+	"context"
+	"hash/fnv"
+	"net/http"
+	"sync/atomic"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/receiver"
+)
+
+// Dispatcher processes events concurrently using a fixed number of workers.
+//
+// Events with identical ordering keys are always routed to the same worker,
+// and are therefore processed sequentially in the order they were received.
+// Events without ordering key are distributed evenly across workers.
+type Dispatcher struct {
+	key    KeyFunc
+	queues []chan *job
+
+	next uint32
+}
+
+// job is a unit of work processed by a worker.
+type job struct {
+	ctx   context.Context
+	event cloudevents.Event
+	fn    receiver.Func
+	res   chan result
+}
+
+// result is the outcome of a job.
+type result struct {
+	event *cloudevents.Event
+	res   protocol.Result
+}
+
+// NewDispatcher returns a Dispatcher with the given number of workers, each
+// queuing at most queueDepth events. A nil KeyFunc disables ordering.
+func NewDispatcher(workers, queueDepth int, key KeyFunc) *Dispatcher {
+	queues := make([]chan *job, workers)
+	for i := range queues {
+		queues[i] = make(chan *job, queueDepth)
+	}
+
+	return &Dispatcher{
+		key:    key,
+		queues: queues,
+	}
+}
+
+// Start runs the workers until the given context is cancelled.
+func (d *Dispatcher) Start(ctx context.Context) {
+	for _, q := range d.queues {
+		go work(ctx, q)
+	}
+}
+
+// Dispatch queues the given event for processing by fn, and blocks until it
+// was processed. When the queue of the selected worker is full, the event is
+// rejected with a 429 (Too Many Requests) status code so that the sender
+// backs off and retries.
+func (d *Dispatcher) Dispatch(ctx context.Context, e cloudevents.Event, fn receiver.Func) (*cloudevents.Event, protocol.Result) {
+	j := &job{
+		ctx:   ctx,
+		event: e,
+		fn:    fn,
+		res:   make(chan result, 1),
+	}
+
+	select {
+	case d.queues[d.worker(&e)] <- j:
+	default:
+		return nil, cehttp.NewResult(http.StatusTooManyRequests, "dispatch queue is full")
+	}
+
+	select {
+	case r := <-j.res:
+		return r.event, r.res
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// worker returns the index of the worker which should process the given event.
+func (d *Dispatcher) worker(e *cloudevents.Event) int {
+	n := uint32(len(d.queues))
+
+	var key string
+	if d.key != nil {
+		key = d.key(e)
+	}
+
+	if key == "" {
+		return int(atomic.AddUint32(&d.next, 1) % n)
+	}
+
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(key))
+	return int(h.Sum32() % n)
+}
+
+// work processes the jobs from the given queue until ctx is cancelled.
+func work(ctx context.Context, q <-chan *job) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case j := <-q:
+			// the sender has given up on this event, and will most
+			// likely redeliver it
+			if j.ctx.Err() != nil {
+				continue
+			}
+
+			resp, res := j.fn(j.ctx, j.event)
+			j.res <- result{event: resp, res: res}
+		}
+	}
+}
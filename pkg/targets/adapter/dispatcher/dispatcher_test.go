@@ -0,0 +1,180 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dispatcher
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/protocol"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
+)
+
+func TestDispatchOrdering(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewDispatcher(4, 100, AttributeKey("partition"))
+	d.Start(ctx)
+
+	const partitions = 3
+	const eventsPerPartition = 20
+
+	var mu sync.Mutex
+	processed := make(map[string][]int)
+
+	fn := func(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+		seq, _ := strconv.Atoi(e.ID())
+		p := e.Extensions()["partition"].(string)
+
+		mu.Lock()
+		processed[p] = append(processed[p], seq)
+		mu.Unlock()
+
+		return nil, nil
+	}
+
+	// Events are dispatched sequentially within each partition to
+	// guarantee the order in which they were received.
+	var wg sync.WaitGroup
+	for p := 0; p < partitions; p++ {
+		wg.Add(1)
+		go func(p int) {
+			defer wg.Done()
+			for i := 0; i < eventsPerPartition; i++ {
+				e := newEvent(strconv.Itoa(i))
+				e.SetExtension("partition", "p"+strconv.Itoa(p))
+				_, res := d.Dispatch(ctx, e, fn)
+				assert.True(t, cloudevents.IsACK(res))
+			}
+		}(p)
+	}
+	wg.Wait()
+
+	require.Len(t, processed, partitions)
+	for p, seqs := range processed {
+		require.Len(t, seqs, eventsPerPartition, "partition %s", p)
+		for i, seq := range seqs {
+			assert.Equal(t, i, seq, "partition %s", p)
+		}
+	}
+}
+
+func TestDispatchBackpressure(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	d := NewDispatcher(1, 1, DataPathKey("k"))
+	d.Start(ctx)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	block := func(ctx context.Context, e cloudevents.Event) (*cloudevents.Event, protocol.Result) {
+		started <- struct{}{}
+		<-release
+		return nil, nil
+	}
+
+	// occupies the worker
+	go d.Dispatch(ctx, newEvent("1"), block)
+	<-started
+
+	// fills the queue
+	queued := make(chan protocol.Result)
+	go func() {
+		_, res := d.Dispatch(ctx, newEvent("2"), block)
+		queued <- res
+	}()
+	require.Eventually(t, func() bool { return len(d.queues[0]) == 1 }, time.Second, time.Millisecond)
+
+	_, res := d.Dispatch(ctx, newEvent("3"), block)
+
+	var httpRes *cehttp.Result
+	require.True(t, protocol.ResultAs(res, &httpRes), "result is a HTTP result")
+	assert.Equal(t, http.StatusTooManyRequests, httpRes.StatusCode)
+
+	close(release)
+	<-started
+	assert.True(t, cloudevents.IsACK(<-queued))
+}
+
+func TestKeyFuncs(t *testing.T) {
+	e := newEvent("1")
+	e.SetExtension("tenant", "acme")
+	e.SetExtension("shard", 3)
+	e.SetTime(time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC))
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, map[string]interface{}{
+		"order": map[string]interface{}{"id": "o-42"},
+	}))
+
+	testCases := map[string]struct {
+		key    KeyFunc
+		expect string
+	}{
+		"context attribute": {
+			key:    AttributeKey("type"),
+			expect: "test.type",
+		},
+		"time attribute": {
+			key:    AttributeKey("time"),
+			expect: "2022-03-04T05:06:07Z",
+		},
+		"string extension": {
+			key:    AttributeKey("tenant"),
+			expect: "acme",
+		},
+		"integer extension": {
+			key:    AttributeKey("shard"),
+			expect: "3",
+		},
+		"missing extension": {
+			key:    AttributeKey("nope"),
+			expect: "",
+		},
+		"data path": {
+			key:    DataPathKey("order.id"),
+			expect: "o-42",
+		},
+		"missing data path": {
+			key:    DataPathKey("order.customer"),
+			expect: "",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tc.expect, tc.key(&e))
+		})
+	}
+}
+
+func newEvent(id string) cloudevents.Event {
+	e := cloudevents.NewEvent()
+	e.SetID(id)
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	return e
+}
@@ -18,7 +18,6 @@ package googlecloudpubsubtarget
 
 import (
 	"context"
-	"encoding/json"
 	"time"
 
 	"go.uber.org/zap"
@@ -34,6 +33,7 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
 )
 
 // NewTarget adapter implementation
@@ -65,9 +65,29 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 	}
 
 	t := psCli.Topic(env.TopicName.Resource)
+
+	orderingKey := dispatcher.NewKeyFunc(env.OrderingKeyAttribute, env.OrderingKeyDataPath)
+	// messages with an ordering key are rejected by the client unless
+	// ordering is explicitly enabled
+	t.EnableMessageOrdering = orderingKey != nil
+
+	if env.BatchMaxMessages > 0 {
+		t.PublishSettings.CountThreshold = env.BatchMaxMessages
+	}
+	if env.BatchMaxBytes > 0 {
+		t.PublishSettings.ByteThreshold = env.BatchMaxBytes
+	}
+	if env.BatchMaxDelay > 0 {
+		t.PublishSettings.DelayThreshold = env.BatchMaxDelay
+	}
+
 	return &adapter{
 		topic: t,
 
+		orderingKey:       orderingKey,
+		messageAttributes: env.MessageAttributes,
+		binding:           v1alpha1.GoogleCloudPubSubBindingMode(env.Binding),
+
 		replier:          replier,
 		ceClient:         ceClient,
 		logger:           logger,
@@ -82,6 +102,10 @@ var _ pkgadapter.Adapter = (*adapter)(nil)
 type adapter struct {
 	topic *pubsub.Topic
 
+	orderingKey       dispatcher.KeyFunc
+	messageAttributes []string
+	binding           v1alpha1.GoogleCloudPubSubBindingMode
+
 	replier          *targetce.Replier
 	ceClient         cloudevents.Client
 	logger           *zap.SugaredLogger
@@ -103,24 +127,25 @@ func (a *adapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloud
 		a.sr.ReportProcessingLatency(time.Since(start), ceTypeTag, ceSrcTag)
 	}()
 
-	var data []byte
-
-	if a.discardCEContext {
-		data = event.Data()
-	} else {
-		jsonEvent, err := json.Marshal(event)
-		if err != nil {
-			return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
-		}
-		data = jsonEvent
+	msg, err := a.message(&event)
+	if err != nil {
+		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
-	result := a.topic.Publish(ctx, &pubsub.Message{
-		Data: data,
-	})
+	result := a.topic.Publish(ctx, msg)
 	id, err := result.Get(ctx)
 	if err != nil {
 		a.sr.ReportProcessingError(true, ceTypeTag, ceSrcTag)
+
+		// The client pauses the publication of messages which share the
+		// ordering key of a failed message until it is explicitly resumed.
+		// The event is rejected so that it gets redelivered, instead of
+		// being skipped within its ordering key.
+		if msg.OrderingKey != "" {
+			a.topic.ResumePublish(msg.OrderingKey)
+			return a.replier.ErrorKnativeManaged(&event, err)
+		}
+
 		return a.replier.Error(&event, targetce.ErrorCodeAdapterProcess, err, nil)
 	}
 
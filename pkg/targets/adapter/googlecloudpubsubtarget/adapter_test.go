@@ -0,0 +1,254 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecloudpubsubtarget
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
+	loggingtesting "knative.dev/pkg/logging/testing"
+
+	"cloud.google.com/go/pubsub"
+	"cloud.google.com/go/pubsub/apiv1/pubsubpb"
+	"cloud.google.com/go/pubsub/pstest"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/metrics"
+	targetce "github.com/triggermesh/triggermesh/pkg/targets/adapter/cloudevents"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
+)
+
+const (
+	tProject = "test-project"
+	tTopic   = "test-topic"
+)
+
+func TestMessage(t *testing.T) {
+	testCases := map[string]struct {
+		adapter adapter
+
+		expectData        string
+		expectAttrs       map[string]string
+		expectOrderingKey string
+	}{
+		"Default encoding": {
+			adapter:    adapter{},
+			expectData: "structured",
+		},
+		"Discarded context": {
+			adapter:    adapter{discardCEContext: true},
+			expectData: `{"customer":{"id":"c-42"}}`,
+		},
+		"Binary binding": {
+			adapter:    adapter{binding: v1alpha1.GoogleCloudPubSubBindingModeBinary},
+			expectData: `{"customer":{"id":"c-42"}}`,
+			expectAttrs: map[string]string{
+				"ce-specversion": "1.0",
+				"ce-id":          "1234",
+				"ce-source":      "test.source",
+				"ce-type":        "test.type",
+				"ce-subject":     "test-subject",
+				"ce-time":        "2023-01-02T03:04:05Z",
+				"ce-tenant":      "acme",
+				"content-type":   "application/json",
+			},
+		},
+		"Structured binding": {
+			adapter:    adapter{binding: v1alpha1.GoogleCloudPubSubBindingModeStructured},
+			expectData: "structured",
+			expectAttrs: map[string]string{
+				"content-type": "application/cloudevents+json",
+			},
+		},
+		"Message attributes": {
+			adapter: adapter{
+				discardCEContext:  true,
+				messageAttributes: []string{"type", "tenant", "nosuchext"},
+			},
+			expectData: `{"customer":{"id":"c-42"}}`,
+			expectAttrs: map[string]string{
+				"type":   "test.type",
+				"tenant": "acme",
+			},
+		},
+		"Ordering key from attribute": {
+			adapter:           adapter{orderingKey: dispatcher.NewKeyFunc("tenant", "")},
+			expectData:        "structured",
+			expectOrderingKey: "acme",
+		},
+		"Ordering key from data": {
+			adapter:           adapter{orderingKey: dispatcher.NewKeyFunc("", "customer.id")},
+			expectData:        "structured",
+			expectOrderingKey: "c-42",
+		},
+	}
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			e := newEvent(t)
+
+			msg, err := tc.adapter.message(&e)
+			require.NoError(t, err)
+
+			if tc.expectData == "structured" {
+				got := cloudevents.NewEvent()
+				require.NoError(t, got.UnmarshalJSON(msg.Data))
+				assert.Equal(t, e.ID(), got.ID())
+				assert.Equal(t, e.Data(), got.Data())
+			} else {
+				assert.JSONEq(t, tc.expectData, string(msg.Data))
+			}
+
+			assert.Equal(t, tc.expectAttrs, msg.Attributes)
+			assert.Equal(t, tc.expectOrderingKey, msg.OrderingKey)
+		})
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	ctx := context.Background()
+
+	srv, topic := newTestTopic(t)
+	topic.PublishSettings.CountThreshold = 2
+	topic.PublishSettings.DelayThreshold = time.Hour
+
+	a := newTestAdapter(t, topic)
+
+	// Both events are published in a single request once the count
+	// threshold is reached, long before the delay threshold expires.
+	results := make(chan cloudevents.Result, 2)
+	for i := 0; i < 2; i++ {
+		e := newEvent(t)
+		go func() {
+			_, res := a.dispatch(ctx, e)
+			results <- res
+		}()
+	}
+	for i := 0; i < 2; i++ {
+		select {
+		case res := <-results:
+			assert.True(t, cloudevents.IsACK(res), "unexpected result: %v", res)
+		case <-time.After(10 * time.Second):
+			t.Fatal("Timed out waiting for messages to be published")
+		}
+	}
+
+	msgs := srv.Messages()
+	require.Len(t, msgs, 2)
+	for _, m := range msgs {
+		assert.JSONEq(t, `{"customer":{"id":"c-42"}}`, string(m.Data))
+		assert.Equal(t, "c-42", m.OrderingKey)
+		assert.Equal(t, "test.type", m.Attributes["type"])
+		assert.Equal(t, "test.type", m.Attributes["ce-type"])
+		assert.Equal(t, "application/json", m.Attributes["content-type"])
+	}
+}
+
+func TestDispatchOrderedPublishFailure(t *testing.T) {
+	ctx := context.Background()
+
+	srv, topic := newTestTopic(t)
+	topic.PublishSettings.CountThreshold = 1
+	srv.SetAutoPublishResponse(false)
+
+	a := newTestAdapter(t, topic)
+
+	srv.AddPublishResponse(nil, status.Error(codes.InvalidArgument, "fake error"))
+	_, res := a.dispatch(ctx, newEvent(t))
+	assert.False(t, cloudevents.IsACK(res), "Expected the event to be rejected for redelivery")
+
+	// publication resumes for the ordering key of the failed message
+	srv.AddPublishResponse(&pubsubpb.PublishResponse{MessageIds: []string{"m-1"}}, nil)
+	_, res = a.dispatch(ctx, newEvent(t))
+	assert.True(t, cloudevents.IsACK(res), "unexpected result: %v", res)
+}
+
+// newTestTopic returns a topic with message ordering enabled, backed by a
+// fake Pub/Sub server.
+func newTestTopic(t *testing.T) (*pstest.Server, *pubsub.Topic) {
+	t.Helper()
+
+	ctx := context.Background()
+
+	srv := pstest.NewServer()
+	t.Cleanup(func() { _ = srv.Close() })
+
+	conn, err := grpc.Dial(srv.Addr, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	psCli, err := pubsub.NewClient(ctx, tProject, option.WithGRPCConn(conn))
+	require.NoError(t, err)
+
+	topic, err := psCli.CreateTopic(ctx, tTopic)
+	require.NoError(t, err)
+	topic.EnableMessageOrdering = true
+	t.Cleanup(topic.Stop)
+
+	return srv, topic
+}
+
+// newTestAdapter returns an adapter which publishes to the given topic, with
+// ordering keys taken from the event data.
+func newTestAdapter(t *testing.T, topic *pubsub.Topic) *adapter {
+	t.Helper()
+
+	logger := loggingtesting.TestLogger(t)
+
+	replier, err := targetce.New("test", logger,
+		targetce.ReplierWithStaticResponseType(v1alpha1.GoogleCloudPubSubResponseEventType))
+	require.NoError(t, err)
+
+	return &adapter{
+		topic:             topic,
+		orderingKey:       dispatcher.NewKeyFunc("", "customer.id"),
+		messageAttributes: []string{"type"},
+		binding:           v1alpha1.GoogleCloudPubSubBindingModeBinary,
+		replier:           replier,
+		logger:            logger,
+		sr:                metrics.MustNewEventProcessingStatsReporter(&pkgadapter.MetricTag{}),
+	}
+}
+
+func newEvent(t *testing.T) cloudevents.Event {
+	t.Helper()
+
+	e := cloudevents.NewEvent()
+	e.SetID("1234")
+	e.SetSource("test.source")
+	e.SetType("test.type")
+	e.SetSubject("test-subject")
+	e.SetTime(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	e.SetExtension("tenant", "acme")
+	require.NoError(t, e.SetData(cloudevents.ApplicationJSON, map[string]any{
+		"customer": map[string]string{"id": "c-42"},
+	}))
+
+	return e
+}
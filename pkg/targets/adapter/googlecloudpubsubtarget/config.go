@@ -17,6 +17,8 @@ limitations under the License.
 package googlecloudpubsubtarget
 
 import (
+	"time"
+
 	"github.com/triggermesh/triggermesh/pkg/sources/adapter/googlecloudpubsubsource"
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
@@ -38,4 +40,18 @@ type envAccessor struct {
 	// CloudEvents responses parametrization
 	CloudEventPayloadPolicy string `envconfig:"EVENTS_PAYLOAD_POLICY" default:"error"`
 	DiscardCEContext        bool   `envconfig:"DISCARD_CE_CONTEXT" default:"false"`
+
+	// Location of the ordering key of messages inside events
+	OrderingKeyAttribute string `envconfig:"GCLOUD_PUBSUB_ORDERING_KEY_ATTRIBUTE"`
+	OrderingKeyDataPath  string `envconfig:"GCLOUD_PUBSUB_ORDERING_KEY_DATA_PATH"`
+	// CloudEvents attributes to set as message attributes
+	MessageAttributes []string `envconfig:"GCLOUD_PUBSUB_MESSAGE_ATTRIBUTES"`
+	// Mode of the CloudEvents Pub/Sub protocol binding, if any
+	Binding string `envconfig:"GCLOUD_PUBSUB_BINDING"`
+
+	// Publish batching thresholds. Zero values retain the defaults of the
+	// Pub/Sub client library.
+	BatchMaxMessages int           `envconfig:"GCLOUD_PUBSUB_BATCH_MAX_MESSAGES"`
+	BatchMaxBytes    int           `envconfig:"GCLOUD_PUBSUB_BATCH_MAX_BYTES"`
+	BatchMaxDelay    time.Duration `envconfig:"GCLOUD_PUBSUB_BATCH_MAX_DELAY"`
 }
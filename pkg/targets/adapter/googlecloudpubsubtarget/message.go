@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecloudpubsubtarget
+
+import (
+	"encoding/json"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	"github.com/cloudevents/sdk-go/v2/types"
+
+	"cloud.google.com/go/pubsub"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/dispatcher"
+)
+
+// Message attributes defined by the CloudEvents Pub/Sub protocol binding.
+// https://github.com/google/knative-gcp/blob/main/docs/spec/pubsub-protocol-binding.md
+const (
+	ceAttrPrefix    = "ce-"
+	attrContentType = "content-type"
+)
+
+// contextAttributes are the context attributes which are mapped to "ce-"
+// prefixed message attributes in binary content mode. The datacontenttype
+// attribute is mapped to the "content-type" message attribute instead.
+var contextAttributes = []string{
+	"specversion",
+	"id",
+	"source",
+	"type",
+	"subject",
+	"dataschema",
+	"time",
+}
+
+// message returns the Pub/Sub message created from the given event.
+func (a *adapter) message(e *cloudevents.Event) (*pubsub.Message, error) {
+	msg := &pubsub.Message{}
+
+	switch {
+	case a.binding == v1alpha1.GoogleCloudPubSubBindingModeBinary:
+		msg.Data = e.Data()
+		msg.Attributes = binaryAttributes(e)
+
+	case a.binding == v1alpha1.GoogleCloudPubSubBindingModeStructured:
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = data
+		msg.Attributes = map[string]string{
+			attrContentType: cloudevents.ApplicationCloudEventsJSON,
+		}
+
+	case a.discardCEContext:
+		msg.Data = e.Data()
+
+	default:
+		data, err := json.Marshal(e)
+		if err != nil {
+			return nil, err
+		}
+		msg.Data = data
+	}
+
+	for _, name := range a.messageAttributes {
+		if v := dispatcher.AttributeKey(name)(e); v != "" {
+			if msg.Attributes == nil {
+				msg.Attributes = make(map[string]string, len(a.messageAttributes))
+			}
+			msg.Attributes[name] = v
+		}
+	}
+
+	if a.orderingKey != nil {
+		msg.OrderingKey = a.orderingKey(e)
+	}
+
+	return msg, nil
+}
+
+// binaryAttributes returns the message attributes which carry the context of
+// the given event in binary content mode.
+func binaryAttributes(e *cloudevents.Event) map[string]string {
+	attrs := make(map[string]string, len(contextAttributes)+len(e.Extensions())+1)
+
+	for _, name := range contextAttributes {
+		if v := dispatcher.AttributeKey(name)(e); v != "" {
+			attrs[ceAttrPrefix+name] = v
+		}
+	}
+
+	for name, v := range e.Extensions() {
+		if s, err := types.Format(v); err == nil {
+			attrs[ceAttrPrefix+name] = s
+		}
+	}
+
+	if ct := e.DataContentType(); ct != "" {
+		attrs[attrContentType] = ct
+	}
+
+	return attrs
+}
@@ -21,6 +21,7 @@ import (
 	"context"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
@@ -83,6 +84,8 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panic(err)
 	}
 
+	var tokenSource *renewableTokenSource
+
 	if env.isOAuth() {
 		cfg := clientcredentials.Config{
 			ClientID:     env.OAuthClientID,
@@ -92,7 +95,25 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		}
 
 		ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
-		client = cfg.Client(ctx)
+		tokenSource = newRenewableTokenSource(func() oauth2.TokenSource {
+			return cfg.TokenSource(ctx)
+		})
+		client = oauth2.NewClient(ctx, tokenSource)
+	}
+
+	var reqTpl *requestTemplate
+	if env.RequestTemplate.HTTPRequestTemplate != nil {
+		if reqTpl, err = newRequestTemplate(env.RequestTemplate.HTTPRequestTemplate); err != nil {
+			logger.Panicf("Invalid request template: %v", err)
+		}
+	}
+
+	var resMapping *responseMapping
+	if env.ResponseMapping.HTTPResponseMapping != nil {
+		lang := templateLanguage(env.RequestTemplate.HTTPRequestTemplate)
+		if resMapping, err = newResponseMapping(lang, env.ResponseMapping.HTTPResponseMapping); err != nil {
+			logger.Panicf("Invalid response mapping: %v", err)
+		}
 	}
 
 	return &httpAdapter{
@@ -105,6 +126,13 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		basicAuthUsername: env.BasicAuthUsername,
 		basicAuthPassword: env.BasicAuthPassword,
 		client:            client,
+		tokenSource:       tokenSource,
+
+		delivery:             newDeliveryOptions(env),
+		idempotencyKeyHeader: env.IdempotencyKeyHeader,
+
+		requestTemplate: reqTpl,
+		responseMapping: resMapping,
 
 		ceClient: ceClient,
 		logger:   logger,
@@ -125,7 +153,14 @@ type httpAdapter struct {
 	basicAuthUsername string
 	basicAuthPassword string
 
-	client *http.Client
+	client      *http.Client
+	tokenSource *renewableTokenSource
+
+	delivery             *deliveryOptions
+	idempotencyKeyHeader string
+
+	requestTemplate *requestTemplate
+	responseMapping *responseMapping
 
 	ceClient cloudevents.Client
 	logger   *zap.SugaredLogger
@@ -141,6 +176,106 @@ func (a *httpAdapter) Start(ctx context.Context) error {
 }
 
 func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*cloudevents.Event, cloudevents.Result) {
+	var in interface{}
+	var req *http.Request
+	var r cloudevents.Result
+
+	if a.requestTemplate != nil || a.responseMapping != nil {
+		var err error
+		if in, err = eventInput(&event); err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error processing incoming event: %w", err)
+		}
+	}
+
+	if a.requestTemplate != nil {
+		req, r = a.templatedRequest(ctx, in)
+	} else {
+		req, r = a.request(ctx, &event)
+	}
+	if r != nil {
+		return nil, r
+	}
+
+	if a.basicAuthUsername != "" || a.basicAuthPassword != "" {
+		req.SetBasicAuth(a.basicAuthUsername, a.basicAuthPassword)
+	}
+
+	if a.idempotencyKeyHeader != "" {
+		req.Header.Set(a.idempotencyKeyHeader, event.ID())
+	}
+
+	res, err := a.do(req)
+	if err != nil {
+		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error sending request: %w", err)
+	}
+
+	defer res.Body.Close()
+	resb, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error reading response body: %w", err)
+	}
+
+	eventType := a.eventType
+	contentType := res.Header.Get("Content-Type")
+
+	var mapped bool
+	if a.responseMapping != nil {
+		var t string
+		if t, mapped = a.responseMapping.eventTypeFor(res.StatusCode); mapped {
+			eventType = t
+		}
+	}
+
+	if res.StatusCode >= 400 && !mapped {
+		return nil, a.errorHTTPResult(res.StatusCode, "Received code %d from HTTP endpoint: %s", res.StatusCode, string(resb))
+	}
+
+	if a.responseMapping != nil && a.responseMapping.data != nil {
+		if resb, err = a.responseMapping.data.Render(responseInput(res, resb, in)); err != nil {
+			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error rendering response data template: %w", err)
+		}
+		contentType = "text/plain"
+		if json.Valid(resb) {
+			contentType = cloudevents.ApplicationJSON
+		}
+	}
+
+	// build response event:
+	// - ID is a new generated UUID
+	// - content-type set to the one received at the HTTP response
+	// - raw response data stored at the event data
+	// - status code discarded since there will only be a response if the code is not an error,
+	//   or if it is mapped to an event type
+	// - Experimental: keeps the stateful headers if informed at the received event
+	// - subject not informed
+
+	out := cloudevents.NewEvent()
+	if err := out.SetData(contentType, resb); err != nil {
+		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting response event data: %w", err)
+	}
+
+	ext := event.Context.GetExtensions()
+	if stateID, ok := ext["statefulid"]; ok {
+		if err := out.Context.SetExtension("statefulid", stateID); err != nil {
+			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting stateful-id at event context: %w", err)
+		}
+	}
+
+	if stateStep, ok := ext["statestep"]; ok {
+		if err := out.Context.SetExtension("statestep", stateStep); err != nil {
+			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting statestep at event context: %w", err)
+		}
+	}
+
+	out.SetID(uuid.New().String())
+	out.SetType(eventType)
+	out.SetSource(a.eventSource)
+
+	return &out, cloudevents.ResultACK
+}
+
+// request builds an HTTP request from an event following the RequestData contract.
+func (a *httpAdapter) request(ctx context.Context, event *cloudevents.Event) (*http.Request, cloudevents.Result) {
 	rd := &RequestData{}
 	if event.Type() != v1alpha1.EventTypeHTTPTargetRequest {
 		rd.Body = event.Data()
@@ -167,7 +302,7 @@ func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*c
 		u.Path = path.Join(u.Path, rd.PathSuffix)
 	}
 
-	req, err := http.NewRequest(a.method, u.String(), bytes.NewBuffer(rd.Body))
+	req, err := http.NewRequestWithContext(ctx, a.method, u.String(), bytes.NewBuffer(rd.Body))
 	if err != nil {
 		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Could not create HTTP request: %w", err)
 	}
@@ -182,56 +317,62 @@ func (a *httpAdapter) dispatch(ctx context.Context, event cloudevents.Event) (*c
 		req.Header.Set(k, v)
 	}
 
-	if a.basicAuthUsername != "" || a.basicAuthPassword != "" {
-		req.SetBasicAuth(a.basicAuthUsername, a.basicAuthPassword)
-	}
+	return req, nil
+}
 
-	res, err := a.client.Do(req)
-	if err != nil {
-		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error sending request: %w", err)
-	}
+// templatedRequest builds an HTTP request by rendering the request template
+// against the given event input.
+func (a *httpAdapter) templatedRequest(ctx context.Context, in interface{}) (*http.Request, cloudevents.Result) {
+	t := a.requestTemplate
 
-	defer res.Body.Close()
-	resb, err := io.ReadAll(res.Body)
-	if err != nil {
-		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error reading response body: %w", err)
+	u := a.url
+	if t.url != nil {
+		rendered, err := t.url.Render(in)
+		if err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error rendering URL template: %w", err)
+		}
+		if u, err = a.url.Parse(string(rendered)); err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Rendered URL is not parseable: %w", err)
+		}
 	}
 
-	if res.StatusCode >= 400 {
-		return nil, a.errorHTTPResult(res.StatusCode, "Received code %d from HTTP endpoint: %s", res.StatusCode, string(resb))
+	method := a.method
+	if t.method != nil {
+		rendered, err := t.method.Render(in)
+		if err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error rendering method template: %w", err)
+		}
+		method = string(rendered)
 	}
 
-	// build response event:
-	// - ID is a new generated UUID
-	// - content-type set to the one received at the HTTP response
-	// - raw response data stored at the event data
-	// - status code discarded since there will only be a response if the code is not an error
-	// - Experimental: keeps the stateful headers if informed at the received event
-	// - subject not informed
+	var body []byte
+	if t.body != nil {
+		var err error
+		if body, err = t.body.Render(in); err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error rendering body template: %w", err)
+		}
+	}
 
-	out := cloudevents.NewEvent()
-	if err := out.SetData(res.Header.Get("Content-Type"), resb); err != nil {
-		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting response event data: %w", err)
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewBuffer(body))
+	if err != nil {
+		return nil, a.errorHTTPResult(http.StatusInternalServerError, "Could not create HTTP request: %w", err)
 	}
 
-	ext := event.Context.GetExtensions()
-	if stateID, ok := ext["statefulid"]; ok {
-		if err := out.Context.SetExtension("statefulid", stateID); err != nil {
-			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting stateful-id at event context: %w", err)
-		}
+	// apply spec headers to the request
+	for k, v := range a.headers {
+		req.Header.Set(k, v)
 	}
 
-	if stateStep, ok := ext["statestep"]; ok {
-		if err := out.Context.SetExtension("statestep", stateStep); err != nil {
-			return nil, a.errorHTTPResult(http.StatusInternalServerError, "Error setting statestep at event context: %w", err)
+	// apply templated headers to the request. Might overwrite spec headers
+	for k, r := range t.headers {
+		rendered, err := r.Render(in)
+		if err != nil {
+			return nil, a.errorHTTPResult(http.StatusBadRequest, "Error rendering header %s template: %w", k, err)
 		}
+		req.Header.Set(k, string(rendered))
 	}
 
-	out.SetID(uuid.New().String())
-	out.SetType(a.eventType)
-	out.SetSource(a.eventSource)
-
-	return &out, cloudevents.ResultACK
+	return req, nil
 }
 
 // errorResult given an error status code, writes an error log entry
@@ -27,11 +27,14 @@ import (
 	"testing"
 	"time"
 
+	cloudevents "github.com/cloudevents/sdk-go/v2"
 	cetest "github.com/cloudevents/sdk-go/v2/client/test"
 	ceevent "github.com/cloudevents/sdk-go/v2/event"
 	"github.com/stretchr/testify/assert"
 
 	logtesting "knative.dev/pkg/logging/testing"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
 )
 
 const (
@@ -443,3 +446,135 @@ func TestArbitraryEventTypeHTTPRequest(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplatedHTTPRequest(t *testing.T) {
+	type tResponse struct {
+		Method string
+		Path   string
+		Header string
+		Body   string
+	}
+
+	tServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			assert.FailNow(t, "mock service could not read body from request")
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/users/missing" {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+		res := &tResponse{
+			Method: r.Method,
+			Path:   r.URL.Path,
+			Header: r.Header.Get("X-Request-Id"),
+			Body:   string(body),
+		}
+		if err = json.NewEncoder(w).Encode(res); err != nil {
+			assert.FailNow(t, "mock service could not JSON encode response")
+		}
+	}))
+	defer tServer.Close()
+
+	strPtr := func(s string) *string { return &s }
+
+	testCases := map[string]struct {
+		template *v1alpha1.HTTPRequestTemplate
+		mapping  *v1alpha1.HTTPResponseMapping
+		user     string
+
+		expectType string
+		expectData string
+		expectErr  bool
+	}{
+		"Go templates": {
+			template: &v1alpha1.HTTPRequestTemplate{
+				URL:     strPtr("/users/{{ .data.user }}"),
+				Method:  strPtr("PUT"),
+				Headers: map[string]string{"X-Request-Id": "{{ .id }}"},
+				Body:    strPtr(`{"name":{{ toJSON .data.user }}}`),
+			},
+			user: "jane",
+
+			expectType: tEventType,
+			expectData: `{"Method":"PUT","Path":"/users/jane","Header":"abc123","Body":"{\"name\":\"jane\"}"}`,
+		},
+		"JQ templates and response mapping": {
+			template: &v1alpha1.HTTPRequestTemplate{
+				Language: (*v1alpha1.HTTPTemplateLanguage)(strPtr("jq")),
+				URL:      strPtr(`"/users/" + .data.user`),
+				Body:     strPtr(`{name: .data.user}`),
+			},
+			mapping: &v1alpha1.HTTPResponseMapping{
+				Data: strPtr(`{code: .statusCode, path: .body.Path, source: .event.source}`),
+				StatusCodeTypes: []v1alpha1.HTTPStatusCodeEventType{
+					{From: 200, To: 299, EventType: "user.found"},
+					{From: 404, To: 404, EventType: "user.missing"},
+				},
+			},
+			user: "missing",
+
+			expectType: "user.missing",
+			expectData: `{"code":404,"path":"/users/missing","source":"test.source"}`,
+		},
+		"Unmapped error status": {
+			template: &v1alpha1.HTTPRequestTemplate{
+				URL: strPtr("/users/{{ .data.user }}"),
+			},
+			user: "missing",
+
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			u, err := url.Parse(tServer.URL)
+			assert.NoError(t, err, "test URL is not valid")
+
+			reqTpl, err := newRequestTemplate(tc.template)
+			assert.NoError(t, err, "invalid request template")
+
+			var resMapping *responseMapping
+			if tc.mapping != nil {
+				resMapping, err = newResponseMapping(templateLanguage(tc.template), tc.mapping)
+				assert.NoError(t, err, "invalid response mapping")
+			}
+
+			adapter := &httpAdapter{
+				eventType:   tEventType,
+				eventSource: tEventSource,
+
+				url:    u,
+				method: "GET",
+				client: tServer.Client(),
+
+				requestTemplate: reqTpl,
+				responseMapping: resMapping,
+
+				logger: logtesting.TestLogger(t),
+			}
+
+			event := ceevent.New()
+			event.SetID(tID)
+			event.SetType(tCETypeArbitrary)
+			event.SetSource(tCESource)
+			if err = event.SetData(tContentType, map[string]string{"user": tc.user}); err != nil {
+				assert.Fail(t, "could not write test payload to CloudEvent")
+			}
+
+			out, r := adapter.dispatch(context.Background(), event)
+			if tc.expectErr {
+				assert.Nil(t, out)
+				assert.False(t, cloudevents.IsACK(r))
+				return
+			}
+
+			assert.True(t, cloudevents.IsACK(r), "unexpected result: %v", r)
+			assert.Equal(t, tc.expectType, out.Type())
+			assert.JSONEq(t, tc.expectData, string(out.Data()))
+		})
+	}
+}
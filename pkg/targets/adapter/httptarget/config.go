@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	pkgadapter "knative.dev/eventing/pkg/adapter/v2"
 )
@@ -47,6 +48,20 @@ type envAccessor struct {
 	OAuthClientSecret string   `envconfig:"HTTP_OAUTH_CLIENT_SECRET"`
 	OAuthAuthTokenURL string   `envconfig:"HTTP_OAUTH_TOKEN_URL"`
 	OAuthScopes       []string `envconfig:"HTTP_OAUTH_SCOPE"`
+
+	RequestTemplate RequestTemplate `envconfig:"HTTP_REQUEST_TEMPLATE"`
+	ResponseMapping ResponseMapping `envconfig:"HTTP_RESPONSE_MAPPING"`
+
+	RetryCount           int           `envconfig:"HTTP_RETRY_COUNT"`
+	RetryBackoffDelay    time.Duration `envconfig:"HTTP_RETRY_BACKOFF_DELAY" default:"1s"`
+	RetryMaxBackoffDelay time.Duration `envconfig:"HTTP_RETRY_MAX_BACKOFF_DELAY" default:"30s"`
+	RetryStatusCodes     []int         `envconfig:"HTTP_RETRY_STATUS_CODES"`
+
+	RateLimitRPS   int `envconfig:"HTTP_RATELIMIT_RPS"`
+	RateLimitBurst int `envconfig:"HTTP_RATELIMIT_BURST" default:"1"`
+
+	MaxConcurrentRequests int    `envconfig:"HTTP_MAX_CONCURRENT_REQUESTS"`
+	IdempotencyKeyHeader  string `envconfig:"HTTP_IDEMPOTENCY_KEY_HEADER"`
 }
 
 func (e *envAccessor) validateAuth() error {
@@ -0,0 +1,234 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/oauth2"
+	"golang.org/x/time/rate"
+)
+
+// defaultRetryableStatusCodes are the HTTP status codes retried when no
+// explicit list is configured.
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// deliveryOptions controls how requests are sent to the remote endpoint.
+type deliveryOptions struct {
+	retryCount      int
+	backoffDelay    time.Duration
+	maxBackoffDelay time.Duration
+	retryableCodes  map[int]struct{}
+
+	// nil when requests are not rate limited
+	limiter *rate.Limiter
+	// nil when the number of concurrent requests is not limited
+	sem chan struct{}
+}
+
+func newDeliveryOptions(env *envAccessor) *deliveryOptions {
+	codes := env.RetryStatusCodes
+	if len(codes) == 0 {
+		codes = defaultRetryableStatusCodes
+	}
+
+	o := &deliveryOptions{
+		retryCount:      env.RetryCount,
+		backoffDelay:    env.RetryBackoffDelay,
+		maxBackoffDelay: env.RetryMaxBackoffDelay,
+		retryableCodes:  make(map[int]struct{}, len(codes)),
+	}
+
+	for _, c := range codes {
+		o.retryableCodes[c] = struct{}{}
+	}
+
+	if env.RateLimitRPS > 0 {
+		o.limiter = rate.NewLimiter(rate.Limit(env.RateLimitRPS), env.RateLimitBurst)
+	}
+
+	if env.MaxConcurrentRequests > 0 {
+		o.sem = make(chan struct{}, env.MaxConcurrentRequests)
+	}
+
+	return o
+}
+
+// do sends the given request, retrying it according to the delivery options.
+// A 401 response triggers the renewal of the OAuth2 token, if any, followed
+// by a single new attempt which does not count as a retry.
+func (a *httpAdapter) do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	o := a.delivery
+	if o == nil {
+		// send the request once, without any rate or concurrency limit
+		o = &deliveryOptions{}
+	}
+
+	if o.sem != nil {
+		select {
+		case o.sem <- struct{}{}:
+			defer func() { <-o.sem }()
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	tokenRenewed := false
+
+	for retry := 0; ; {
+		if o.limiter != nil {
+			if err := o.limiter.Wait(ctx); err != nil {
+				return nil, err
+			}
+		}
+
+		res, err := a.client.Do(req)
+
+		var delay time.Duration
+
+		switch {
+		case err == nil && res.StatusCode == http.StatusUnauthorized && a.tokenSource != nil && !tokenRenewed:
+			a.logger.Debug("Received a 401 response, renewing OAuth2 token")
+			a.tokenSource.reset()
+			tokenRenewed = true
+
+		case retry >= o.retryCount:
+			return res, err
+
+		case err != nil:
+			delay = o.backoff(retry)
+			retry++
+
+		case o.isRetryable(res.StatusCode):
+			delay = o.backoff(retry)
+			if ra, ok := retryAfter(res, time.Now()); ok {
+				delay = ra
+			}
+			if delay > o.maxBackoffDelay {
+				delay = o.maxBackoffDelay
+			}
+			retry++
+
+		default:
+			return res, err
+		}
+
+		if res != nil {
+			_, _ = io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
+			}
+		}
+
+		if delay > 0 {
+			t := time.NewTimer(delay)
+			select {
+			case <-t.C:
+			case <-ctx.Done():
+				t.Stop()
+				return nil, ctx.Err()
+			}
+		}
+	}
+}
+
+// isRetryable returns whether a response with the given status code should be retried.
+func (o *deliveryOptions) isRetryable(statusCode int) bool {
+	_, ok := o.retryableCodes[statusCode]
+	return ok
+}
+
+// backoff returns the exponential delay to wait before the given retry.
+func (o *deliveryOptions) backoff(retry int) time.Duration {
+	d := o.backoffDelay << retry
+	if d > o.maxBackoffDelay || d <= 0 {
+		return o.maxBackoffDelay
+	}
+	return d
+}
+
+// retryAfter parses the Retry-After header of a response, which can contain
+// either a number of seconds or an HTTP date.
+func retryAfter(res *http.Response, now time.Time) (time.Duration, bool) {
+	v := res.Header.Get("Retry-After")
+	if v == "" {
+		return 0, false
+	}
+
+	if secs, err := strconv.Atoi(v); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, true
+	}
+
+	if t, err := http.ParseTime(v); err == nil {
+		if d := t.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// renewableTokenSource is an oauth2.TokenSource which caches tokens until
+// they expire or are explicitly discarded.
+type renewableTokenSource struct {
+	newSource func() oauth2.TokenSource
+
+	mu  sync.Mutex
+	src oauth2.TokenSource
+}
+
+var _ oauth2.TokenSource = (*renewableTokenSource)(nil)
+
+func newRenewableTokenSource(newSource func() oauth2.TokenSource) *renewableTokenSource {
+	return &renewableTokenSource{
+		newSource: newSource,
+		src:       oauth2.ReuseTokenSource(nil, newSource()),
+	}
+}
+
+// Token implements oauth2.TokenSource.
+func (s *renewableTokenSource) Token() (*oauth2.Token, error) {
+	s.mu.Lock()
+	src := s.src
+	s.mu.Unlock()
+
+	return src.Token()
+}
+
+// reset discards the cached token so that a new one is requested.
+func (s *renewableTokenSource) reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.src = oauth2.ReuseTokenSource(nil, s.newSource())
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+	logtesting "knative.dev/pkg/logging/testing"
+)
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	testCases := map[string]struct {
+		header string
+		expect time.Duration
+		ok     bool
+	}{
+		"no header":      {},
+		"seconds":        {header: "3", expect: 3 * time.Second, ok: true},
+		"http date":      {header: now.Add(time.Minute).Format(http.TimeFormat), expect: time.Minute, ok: true},
+		"past http date": {header: now.Add(-time.Minute).Format(http.TimeFormat), ok: true},
+		"invalid":        {header: "soon"},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			res := &http.Response{Header: http.Header{}}
+			if tc.header != "" {
+				res.Header.Set("Retry-After", tc.header)
+			}
+
+			d, ok := retryAfter(res, now)
+			assert.Equal(t, tc.ok, ok)
+			assert.Equal(t, tc.expect, d)
+		})
+	}
+}
+
+func TestDeliveryRetries(t *testing.T) {
+	var calls int32
+
+	tServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, tID, r.Header.Get("Idempotency-Key"), "wrong idempotency key")
+
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer tServer.Close()
+
+	env := &envAccessor{
+		RetryCount:            2,
+		RetryBackoffDelay:     time.Hour,
+		RetryMaxBackoffDelay:  time.Hour,
+		MaxConcurrentRequests: 1,
+	}
+
+	adapter := newTestDeliveryAdapter(t, tServer, env)
+
+	_, r := adapter.dispatch(context.Background(), newTestDeliveryEvent())
+	assert.True(t, cloudevents.IsACK(r), "unexpected result: %v", r)
+	assert.EqualValues(t, 3, atomic.LoadInt32(&calls), "expected the request to be retried twice")
+}
+
+func TestDeliveryTokenRenewal(t *testing.T) {
+	var tokens int32
+
+	tServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-2" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer tServer.Close()
+
+	adapter := newTestDeliveryAdapter(t, tServer, &envAccessor{})
+
+	adapter.tokenSource = newRenewableTokenSource(func() oauth2.TokenSource {
+		n := atomic.AddInt32(&tokens, 1)
+		return oauth2.StaticTokenSource(&oauth2.Token{AccessToken: fmt.Sprintf("token-%d", n)})
+	})
+	adapter.client = &http.Client{
+		Transport: &oauth2.Transport{Source: adapter.tokenSource, Base: tServer.Client().Transport},
+	}
+
+	_, r := adapter.dispatch(context.Background(), newTestDeliveryEvent())
+	assert.True(t, cloudevents.IsACK(r), "unexpected result: %v", r)
+	assert.EqualValues(t, 2, atomic.LoadInt32(&tokens), "expected the token to be renewed once")
+}
+
+func newTestDeliveryAdapter(t *testing.T, srv *httptest.Server, env *envAccessor) *httpAdapter {
+	u, err := url.Parse(srv.URL)
+	require.NoError(t, err, "test URL is not valid")
+
+	return &httpAdapter{
+		eventType:   tEventType,
+		eventSource: tEventSource,
+
+		url:    u,
+		method: http.MethodPost,
+		client: srv.Client(),
+
+		delivery:             newDeliveryOptions(env),
+		idempotencyKeyHeader: "Idempotency-Key",
+
+		logger: logtesting.TestLogger(t),
+	}
+}
+
+func newTestDeliveryEvent() cloudevents.Event {
+	event := cloudevents.NewEvent()
+	event.SetID(tID)
+	event.SetType(tCETypeArbitrary)
+	event.SetSource(tCESource)
+	return event
+}
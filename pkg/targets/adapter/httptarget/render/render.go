@@ -0,0 +1,112 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package render compiles and renders the templates of the HTTPTarget. It is
+// shared by the adapter and the API validation, so that invalid templates are
+// rejected by the webhook instead of failing at the adapter's startup.
+package render
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"text/template"
+
+	"github.com/itchyny/gojq"
+)
+
+// Supported template languages.
+const (
+	LanguageGo = "go"
+	LanguageJQ = "jq"
+)
+
+// Renderer produces an output from a template and some input data.
+type Renderer interface {
+	Render(input interface{}) ([]byte, error)
+}
+
+// New compiles the given template and returns a Renderer for it. Templates
+// are written in Go template syntax when the language is empty.
+func New(lang, tpl string) (Renderer, error) {
+	switch lang {
+	case LanguageJQ:
+		q, err := gojq.Parse(tpl)
+		if err != nil {
+			return nil, fmt.Errorf("parsing jq template: %w", err)
+		}
+		code, err := gojq.Compile(q)
+		if err != nil {
+			return nil, fmt.Errorf("compiling jq template: %w", err)
+		}
+		return &jqRenderer{code: code}, nil
+
+	case LanguageGo, "":
+		t, err := template.New("").Funcs(template.FuncMap{"toJSON": toJSON}).Parse(tpl)
+		if err != nil {
+			return nil, fmt.Errorf("parsing Go template: %w", err)
+		}
+		return &goRenderer{tpl: t}, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported template language %q", lang)
+	}
+}
+
+// goRenderer renders Go templates.
+type goRenderer struct {
+	tpl *template.Template
+}
+
+// Render implements Renderer.
+func (r *goRenderer) Render(input interface{}) ([]byte, error) {
+	var b bytes.Buffer
+	if err := r.tpl.Execute(&b, input); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// toJSON serializes a value to JSON inside Go templates.
+func toJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	return string(b), err
+}
+
+// jqRenderer renders JQ queries. String results are returned as is, other
+// results are serialized to JSON.
+type jqRenderer struct {
+	code *gojq.Code
+}
+
+// Render implements Renderer.
+func (r *jqRenderer) Render(input interface{}) ([]byte, error) {
+	v, ok := r.code.Run(input).Next()
+	if !ok {
+		return nil, nil
+	}
+
+	switch tv := v.(type) {
+	case error:
+		return nil, tv
+	case string:
+		return []byte(tv), nil
+	case nil:
+		return nil, nil
+	default:
+		return json.Marshal(tv)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package httptarget
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	cloudevents "github.com/cloudevents/sdk-go/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/targets/adapter/httptarget/render"
+)
+
+// RequestTemplate is the JSON serialized template used to build requests.
+type RequestTemplate struct {
+	*v1alpha1.HTTPRequestTemplate
+}
+
+// Decode implements envconfig.Decoder.
+func (t *RequestTemplate) Decode(value string) error {
+	t.HTTPRequestTemplate = &v1alpha1.HTTPRequestTemplate{}
+	return json.Unmarshal([]byte(value), t.HTTPRequestTemplate)
+}
+
+// ResponseMapping is the JSON serialized mapping used to build replies.
+type ResponseMapping struct {
+	*v1alpha1.HTTPResponseMapping
+}
+
+// Decode implements envconfig.Decoder.
+func (m *ResponseMapping) Decode(value string) error {
+	m.HTTPResponseMapping = &v1alpha1.HTTPResponseMapping{}
+	return json.Unmarshal([]byte(value), m.HTTPResponseMapping)
+}
+
+// requestTemplate holds the compiled templates used to build requests.
+type requestTemplate struct {
+	url     render.Renderer
+	method  render.Renderer
+	body    render.Renderer
+	headers map[string]render.Renderer
+}
+
+func newRequestTemplate(t *v1alpha1.HTTPRequestTemplate) (*requestTemplate, error) {
+	lang := templateLanguage(t)
+	rt := &requestTemplate{}
+
+	var err error
+	if t.URL != nil {
+		if rt.url, err = render.New(string(lang), *t.URL); err != nil {
+			return nil, fmt.Errorf("url: %w", err)
+		}
+	}
+	if t.Method != nil {
+		if rt.method, err = render.New(string(lang), *t.Method); err != nil {
+			return nil, fmt.Errorf("method: %w", err)
+		}
+	}
+	if t.Body != nil {
+		if rt.body, err = render.New(string(lang), *t.Body); err != nil {
+			return nil, fmt.Errorf("body: %w", err)
+		}
+	}
+	if len(t.Headers) != 0 {
+		rt.headers = make(map[string]render.Renderer, len(t.Headers))
+		for k, v := range t.Headers {
+			if rt.headers[k], err = render.New(string(lang), v); err != nil {
+				return nil, fmt.Errorf("header %s: %w", k, err)
+			}
+		}
+	}
+
+	return rt, nil
+}
+
+// responseMapping holds the compiled parameters used to build replies.
+type responseMapping struct {
+	data  render.Renderer
+	types []v1alpha1.HTTPStatusCodeEventType
+}
+
+func newResponseMapping(lang v1alpha1.HTTPTemplateLanguage, m *v1alpha1.HTTPResponseMapping) (*responseMapping, error) {
+	rm := &responseMapping{
+		types: m.StatusCodeTypes,
+	}
+
+	if m.Data != nil {
+		var err error
+		if rm.data, err = render.New(string(lang), *m.Data); err != nil {
+			return nil, fmt.Errorf("data: %w", err)
+		}
+	}
+
+	return rm, nil
+}
+
+// eventTypeFor returns the reply type matching the given status code, if any.
+func (m *responseMapping) eventTypeFor(statusCode int) (string, bool) {
+	for _, t := range m.types {
+		if statusCode >= t.From && statusCode <= t.To {
+			return t.EventType, true
+		}
+	}
+	return "", false
+}
+
+// templateLanguage returns the language of the given template, or the default
+// language if unset.
+func templateLanguage(t *v1alpha1.HTTPRequestTemplate) v1alpha1.HTTPTemplateLanguage {
+	if t == nil || t.Language == nil {
+		return v1alpha1.HTTPTemplateLanguageGo
+	}
+	return *t.Language
+}
+
+// eventInput returns the input of request templates, which is the structured
+// JSON representation of the event.
+func eventInput(event *cloudevents.Event) (interface{}, error) {
+	b, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+
+	var in interface{}
+	if err := json.Unmarshal(b, &in); err != nil {
+		return nil, err
+	}
+	return in, nil
+}
+
+// responseInput returns the input of response templates.
+func responseInput(res *http.Response, body []byte, event interface{}) interface{} {
+	headers := make(map[string]interface{}, len(res.Header))
+	for k := range res.Header {
+		headers[k] = res.Header.Get(k)
+	}
+
+	var b interface{}
+	if err := json.Unmarshal(body, &b); err != nil {
+		b = string(body)
+	}
+
+	return map[string]interface{}{
+		"statusCode": res.StatusCode,
+		"headers":    headers,
+		"body":       b,
+		"event":      event,
+	}
+}
@@ -20,8 +20,10 @@ import (
 	"context"
 	"crypto/tls"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
+	"sync"
 	"time"
 
 	"go.uber.org/zap"
@@ -33,6 +35,7 @@ import (
 
 	"github.com/ZachtimusPrime/Go-Splunk-HTTP/splunk/v2"
 
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 	"github.com/triggermesh/triggermesh/pkg/apis/targets"
 	"github.com/triggermesh/triggermesh/pkg/metrics"
 )
@@ -50,6 +53,10 @@ type adapter struct {
 
 	ceClient cloudevents.Client
 	spClient SplunkClient
+	// creates a Splunk HEC client which authenticates with the given
+	// token, used when the token is reloaded
+	newSpClient func(hecToken string) SplunkClient
+	m           sync.RWMutex
 
 	defaultIndex string
 
@@ -58,7 +65,10 @@ type adapter struct {
 	discardCEContext bool
 }
 
-var _ pkgadapter.Adapter = (*adapter)(nil)
+var (
+	_ pkgadapter.Adapter = (*adapter)(nil)
+	_ secrets.Reloader   = (*adapter)(nil)
+)
 
 // envConfig is a set parameters sourced from the environment for the target's adapter.
 type envConfig struct {
@@ -72,6 +82,9 @@ type envConfig struct {
 	DiscardCEContext bool `envconfig:"DISCARD_CE_CONTEXT" default:"false"`
 }
 
+// Name of the environment variable which contains the HEC token.
+const envHECToken = "SPLUNK_HEC_TOKEN"
+
 // NewEnvConfig returns an accessor for the source's adapter envConfig.
 func NewEnvConfig() pkgadapter.EnvConfigAccessor {
 	return &envConfig{}
@@ -101,11 +114,16 @@ func NewTarget(ctx context.Context, envAcc pkgadapter.EnvConfigAccessor, ceClien
 		logger.Panicw("Invalid HEC endpoint URL "+env.HECEndpoint, zap.Error(err))
 	}
 
+	newSpClient := func(hecToken string) SplunkClient {
+		return newClient(*hecURL, hecToken, env.Index, hostname(envAcc), env.SkipTLSVerify)
+	}
+
 	return &adapter{
 		logger: logger,
 
-		ceClient: ceClient,
-		spClient: newClient(*hecURL, env.HECToken, env.Index, hostname(envAcc), env.SkipTLSVerify),
+		ceClient:    ceClient,
+		spClient:    newSpClient(env.HECToken),
+		newSpClient: newSpClient,
 
 		defaultIndex: env.Index,
 
@@ -159,7 +177,11 @@ func (a *adapter) Start(ctx context.Context) error {
 func (a *adapter) receive(ctx context.Context, event cloudevents.Event) cloudevents.Result {
 	a.logger.Debugw("Processing event", zap.Any("event", event))
 
-	e := a.spClient.NewEventWithTime(
+	a.m.RLock()
+	spClient := a.spClient
+	a.m.RUnlock()
+
+	e := spClient.NewEventWithTime(
 		event.Time(),
 		event,
 		event.Source(),
@@ -173,7 +195,7 @@ func (a *adapter) receive(ctx context.Context, event cloudevents.Event) cloudeve
 		}
 	}
 
-	err := a.spClient.LogEvent(e)
+	err := spClient.LogEvent(e)
 	if err != nil {
 		a.logger.Errorw("Failed to send event to HEC", zap.Error(err))
 		return cloudevents.NewHTTPResult(a.extractHTTPStatus(err), "failed to send event to HEC: %s", err)
@@ -182,6 +204,21 @@ func (a *adapter) receive(ctx context.Context, event cloudevents.Event) cloudeve
 	return cloudevents.ResultACK
 }
 
+// ReloadSecrets implements secrets.Reloader.
+func (a *adapter) ReloadSecrets(_ context.Context, changed map[string]string) error {
+	for name, val := range changed {
+		if name != envHECToken {
+			return fmt.Errorf("reloading the value of %s is not supported", name)
+		}
+
+		a.m.Lock()
+		a.spClient = a.newSpClient(val)
+		a.m.Unlock()
+	}
+
+	return nil
+}
+
 // extractHTTPStatus attempts to extract the HTTP status code from the given
 // error, returns "400 Bad Request" otherwise.
 func (a *adapter) extractHTTPStatus(err error) int {
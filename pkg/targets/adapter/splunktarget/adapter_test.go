@@ -18,19 +18,29 @@ package splunktarget
 
 import (
 	"context"
+	"net"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/kelseyhightower/envconfig"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	cloudevents "github.com/cloudevents/sdk-go/v2"
+	cehttp "github.com/cloudevents/sdk-go/v2/protocol/http"
 
 	adaptertest "knative.dev/eventing/pkg/adapter/v2/test"
+	"knative.dev/pkg/logging"
 	logtesting "knative.dev/pkg/logging/testing"
 
 	"github.com/ZachtimusPrime/Go-Splunk-HTTP/splunk/v2"
+
+	"github.com/triggermesh/triggermesh/pkg/adapter/secrets"
 )
 
 const tDefaultIndex = "fake-index"
@@ -93,6 +103,71 @@ func TestReceive(t *testing.T) {
 	}
 }
 
+// TestReloadSecrets verifies that the adapter keeps receiving events after
+// its HEC token was reloaded.
+func TestReloadSecrets(t *testing.T) {
+	authHeaders := make(chan string, 10)
+	hec := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		authHeaders <- r.Header.Get("Authorization")
+	}))
+	t.Cleanup(hec.Close)
+
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	require.NoError(t, os.WriteFile(tokenPath, []byte("token1"), 0o600))
+
+	t.Setenv("NAMESPACE", "test")
+	t.Setenv("SPLUNK_HEC_ENDPOINT", hec.URL)
+	t.Setenv("SECRETS_REFERENCES", envHECToken)
+	t.Setenv(envHECToken+"_FROM_FILE", tokenPath)
+	t.Setenv(envHECToken, "")
+	t.Setenv("SECRETS_REFRESH_INTERVAL", "10ms")
+
+	env := secrets.WithResolvedEnv(NewEnvConfig)()
+	require.NoError(t, envconfig.Process("", env))
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	ceClient, err := cloudevents.NewClientHTTP(cehttp.WithListener(l))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(logging.WithLogger(context.Background(), logtesting.TestLogger(t)))
+	defer cancel()
+
+	a := secrets.Middleware(NewTarget)(ctx, env, ceClient)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- a.Start(ctx) }()
+
+	sender, err := cloudevents.NewClientHTTP(cehttp.WithTarget("http://" + l.Addr().String()))
+	require.NoError(t, err)
+
+	// send sends an event to the adapter and returns the authorization
+	// header of the resulting request to HEC.
+	send := func() string {
+		t.Helper()
+
+		var res cloudevents.Result
+		require.Eventually(t, func() bool {
+			res = sender.Send(ctx, newEvent(t))
+			return cloudevents.IsACK(res)
+		}, 5*time.Second, 10*time.Millisecond, "Expected adapter to receive events: %v", res)
+
+		return <-authHeaders
+	}
+
+	assert.Equal(t, "Splunk token1", send())
+
+	require.NoError(t, os.WriteFile(tokenPath, []byte("token2"), 0o600))
+
+	require.Eventually(t, func() bool {
+		return send() == "Splunk token2"
+	}, 5*time.Second, 20*time.Millisecond, "Expected requests to HEC to use the reloaded token")
+
+	cancel()
+	assert.NoError(t, <-errCh, "Expected adapter to keep running until its context is cancelled")
+}
+
 func newEvent(t *testing.T) cloudevents.Event {
 	t.Helper()
 
@@ -17,6 +17,8 @@ limitations under the License.
 package awscomprehendtarget
 
 import (
+	"github.com/aws/aws-sdk-go/service/comprehend"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -62,7 +64,10 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSComprehendTarget) []corev1.EnvVar {
-	env := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, comprehend.EndpointsID)...)
+
+	env := append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  envRegion,
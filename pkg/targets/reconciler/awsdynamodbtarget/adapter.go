@@ -17,6 +17,10 @@ limitations under the License.
 package awsdynamodbtarget
 
 import (
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/service/dynamodb"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -30,6 +34,8 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const envDynamoDBKey = "AWS_DYNAMODB_KEY"
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -56,9 +62,23 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSDynamoDBTarget) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, dynamodb.EndpointsID)...)
+
+	env := append(awsEnvs,
 		corev1.EnvVar{
 			Name:  common.EnvARN,
 			Value: o.Spec.ARN,
 		})
+
+	if len(o.Spec.Key) > 0 {
+		if key, err := json.Marshal(o.Spec.Key); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envDynamoDBKey,
+				Value: string(key),
+			})
+		}
+	}
+
+	return env
 }
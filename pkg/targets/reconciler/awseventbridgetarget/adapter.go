@@ -17,8 +17,11 @@ limitations under the License.
 package awseventbridgetarget
 
 import (
+	"encoding/json"
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -32,6 +35,15 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const (
+	envEventBridgeMapping         = "AWS_EVENTBRIDGE_MAPPING"
+	envEventBridgeBatchMaxEntries = "AWS_EVENTBRIDGE_BATCH_MAX_ENTRIES"
+	envEventBridgeBatchMaxDelay   = "AWS_EVENTBRIDGE_BATCH_MAX_DELAY"
+)
+
+// Maximum number of entries in a PutEvents request.
+const defaultBatchMaxEntries = 10
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -58,7 +70,10 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSEventBridgeTarget) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, eventbridge.EndpointsID)...)
+
+	env := append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -68,4 +83,33 @@ func MakeAppEnv(o *v1alpha1.AWSEventBridgeTarget) []corev1.EnvVar {
 				Value: strconv.FormatBool(o.Spec.DiscardCEContext),
 			},
 		}...)
+
+	if o.Spec.Mapping != nil {
+		if mapping, err := json.Marshal(o.Spec.Mapping); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envEventBridgeMapping,
+				Value: string(mapping),
+			})
+		}
+	}
+
+	if b := o.Spec.Batching; b != nil {
+		// batching is enabled in the adapter by a non-zero number of entries
+		maxEntries := defaultBatchMaxEntries
+		if b.MaxEntries != nil {
+			maxEntries = int(*b.MaxEntries)
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  envEventBridgeBatchMaxEntries,
+			Value: strconv.Itoa(maxEntries),
+		})
+		if b.MaxDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envEventBridgeBatchMaxDelay,
+				Value: b.MaxDelay.String(),
+			})
+		}
+	}
+
+	return env
 }
@@ -19,6 +19,8 @@ package awskinesistarget
 import (
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/service/kinesis"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -32,6 +34,18 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const (
+	envKinesisPartitionKeyAttribute    = "AWS_KINESIS_PARTITION_KEY_ATTRIBUTE"
+	envKinesisPartitionKeyDataPath     = "AWS_KINESIS_PARTITION_KEY_DATA_PATH"
+	envKinesisExplicitHashKeyAttribute = "AWS_KINESIS_EXPLICIT_HASH_KEY_ATTRIBUTE"
+	envKinesisExplicitHashKeyDataPath  = "AWS_KINESIS_EXPLICIT_HASH_KEY_DATA_PATH"
+	envKinesisBatchMaxRecords          = "AWS_KINESIS_BATCH_MAX_RECORDS"
+	envKinesisBatchMaxDelay            = "AWS_KINESIS_BATCH_MAX_DELAY"
+)
+
+// Maximum number of records in a PutRecords request.
+const defaultBatchMaxRecords = 500
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -58,7 +72,10 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSKinesisTarget) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, kinesis.EndpointsID)...)
+
+	env := append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -71,4 +88,29 @@ func MakeAppEnv(o *v1alpha1.AWSKinesisTarget) []corev1.EnvVar {
 				Value: strconv.FormatBool(o.Spec.DiscardCEContext),
 			},
 		}...)
+
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.PartitionKey,
+		envKinesisPartitionKeyAttribute, envKinesisPartitionKeyDataPath)...)
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.ExplicitHashKey,
+		envKinesisExplicitHashKeyAttribute, envKinesisExplicitHashKeyDataPath)...)
+
+	if b := o.Spec.Batching; b != nil {
+		// batching is enabled in the adapter by a non-zero number of records
+		maxRecords := defaultBatchMaxRecords
+		if b.MaxRecords != nil {
+			maxRecords = int(*b.MaxRecords)
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  envKinesisBatchMaxRecords,
+			Value: strconv.Itoa(maxRecords),
+		})
+		if b.MaxDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envKinesisBatchMaxDelay,
+				Value: b.MaxDelay.String(),
+			})
+		}
+	}
+
+	return env
 }
@@ -19,6 +19,8 @@ package awslambdatarget
 import (
 	"strconv"
 
+	"github.com/aws/aws-sdk-go/service/lambda"
+
 	corev1 "k8s.io/api/core/v1"
 
 	"knative.dev/eventing/pkg/reconciler/source"
@@ -32,6 +34,13 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const (
+	envLambdaInvocationType = "AWS_LAMBDA_INVOCATION_TYPE"
+	envLambdaQualifier      = "AWS_LAMBDA_QUALIFIER"
+	envLambdaClientContext  = "AWS_LAMBDA_CLIENT_CONTEXT"
+	envLambdaLogTail        = "AWS_LAMBDA_LOG_TAIL"
+)
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -58,7 +67,10 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSLambdaTarget) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, lambda.EndpointsID)...)
+
+	env := append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -68,4 +80,34 @@ func MakeAppEnv(o *v1alpha1.AWSLambdaTarget) []corev1.EnvVar {
 				Value: strconv.FormatBool(o.Spec.DiscardCEContext),
 			},
 		}...)
+
+	if o.Spec.InvocationType != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envLambdaInvocationType,
+			Value: string(*o.Spec.InvocationType),
+		})
+	}
+
+	if o.Spec.Qualifier != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envLambdaQualifier,
+			Value: *o.Spec.Qualifier,
+		})
+	}
+
+	if o.Spec.ClientContext != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envLambdaClientContext,
+			Value: *o.Spec.ClientContext,
+		})
+	}
+
+	if o.Spec.LogTail {
+		env = append(env, corev1.EnvVar{
+			Name:  envLambdaLogTail,
+			Value: strconv.FormatBool(o.Spec.LogTail),
+		})
+	}
+
+	return env
 }
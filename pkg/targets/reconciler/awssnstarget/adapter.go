@@ -17,7 +17,11 @@ limitations under the License.
 package awssnstarget
 
 import (
+	"encoding/json"
 	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/sns"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -32,6 +36,24 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const (
+	envSNSMessageGroupID           = "AWS_SNS_MESSAGE_GROUP_ID"
+	envSNSMessageGroupIDAttribute  = "AWS_SNS_MESSAGE_GROUP_ID_ATTRIBUTE"
+	envSNSMessageGroupIDDataPath   = "AWS_SNS_MESSAGE_GROUP_ID_DATA_PATH"
+	envSNSDeduplicationIDAttribute = "AWS_SNS_DEDUPLICATION_ID_ATTRIBUTE"
+	envSNSDeduplicationIDDataPath  = "AWS_SNS_DEDUPLICATION_ID_DATA_PATH"
+	envSNSMessageAttributes        = "AWS_SNS_MESSAGE_ATTRIBUTES"
+	envSNSSubjectAttribute         = "AWS_SNS_SUBJECT_ATTRIBUTE"
+	envSNSSubjectDataPath          = "AWS_SNS_SUBJECT_DATA_PATH"
+	envSNSProtocolMessages         = "AWS_SNS_PROTOCOL_MESSAGES"
+	envSNSSMSPhoneNumberAttribute  = "AWS_SNS_SMS_PHONE_NUMBER_ATTRIBUTE"
+	envSNSSMSPhoneNumberDataPath   = "AWS_SNS_SMS_PHONE_NUMBER_DATA_PATH"
+	envSNSSMSMessageAttribute      = "AWS_SNS_SMS_MESSAGE_ATTRIBUTE"
+	envSNSSMSMessageDataPath       = "AWS_SNS_SMS_MESSAGE_DATA_PATH"
+	envSNSSMSSenderID              = "AWS_SNS_SMS_SENDER_ID"
+	envSNSSMSType                  = "AWS_SNS_SMS_TYPE"
+)
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -58,7 +80,10 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSSNSTarget) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, sns.EndpointsID)...)
+
+	env := append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -68,4 +93,59 @@ func MakeAppEnv(o *v1alpha1.AWSSNSTarget) []corev1.EnvVar {
 				Value: strconv.FormatBool(o.Spec.DiscardCEContext),
 			},
 		}...)
+
+	if o.Spec.MessageGroupID != "" {
+		env = append(env, corev1.EnvVar{
+			Name:  envSNSMessageGroupID,
+			Value: o.Spec.MessageGroupID,
+		})
+	}
+
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.MessageGroupIDFrom,
+		envSNSMessageGroupIDAttribute, envSNSMessageGroupIDDataPath)...)
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.DeduplicationIDFrom,
+		envSNSDeduplicationIDAttribute, envSNSDeduplicationIDDataPath)...)
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.SubjectFrom,
+		envSNSSubjectAttribute, envSNSSubjectDataPath)...)
+
+	if len(o.Spec.MessageAttributes) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  envSNSMessageAttributes,
+			Value: strings.Join(o.Spec.MessageAttributes, ","),
+		})
+	}
+
+	if len(o.Spec.ProtocolMessages) > 0 {
+		if msgs, err := json.Marshal(o.Spec.ProtocolMessages); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envSNSProtocolMessages,
+				Value: string(msgs),
+			})
+		}
+	}
+
+	if sms := o.Spec.SMS; sms != nil {
+		env = append(env, common.MakeEventKeySourceEnvVars(&sms.PhoneNumberFrom,
+			envSNSSMSPhoneNumberAttribute, envSNSSMSPhoneNumberDataPath)...)
+
+		if sms.MessageFrom != nil {
+			env = append(env, common.MakeEventKeySourceEnvVars(sms.MessageFrom,
+				envSNSSMSMessageAttribute, envSNSSMSMessageDataPath)...)
+		}
+
+		if sms.SenderID != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envSNSSMSSenderID,
+				Value: *sms.SenderID,
+			})
+		}
+		if sms.Type != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envSNSSMSType,
+				Value: string(*sms.Type),
+			})
+		}
+	}
+
+	return env
 }
@@ -18,6 +18,9 @@ package awssqstarget
 
 import (
 	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/service/sqs"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -32,6 +35,22 @@ import (
 	"github.com/triggermesh/triggermesh/pkg/targets/reconciler"
 )
 
+const (
+	envSQSMessageGroupIDAttribute  = "AWS_SQS_MESSAGE_GROUP_ID_ATTRIBUTE"
+	envSQSMessageGroupIDDataPath   = "AWS_SQS_MESSAGE_GROUP_ID_DATA_PATH"
+	envSQSDeduplicationIDAttribute = "AWS_SQS_DEDUPLICATION_ID_ATTRIBUTE"
+	envSQSDeduplicationIDDataPath  = "AWS_SQS_DEDUPLICATION_ID_DATA_PATH"
+	envSQSMessageAttributes        = "AWS_SQS_MESSAGE_ATTRIBUTES"
+	envSQSDelaySeconds             = "AWS_SQS_DELAY_SECONDS"
+	envSQSDelaySecondsAttribute    = "AWS_SQS_DELAY_SECONDS_ATTRIBUTE"
+	envSQSDelaySecondsDataPath     = "AWS_SQS_DELAY_SECONDS_DATA_PATH"
+	envSQSBatchMaxMessages         = "AWS_SQS_BATCH_MAX_MESSAGES"
+	envSQSBatchMaxDelay            = "AWS_SQS_BATCH_MAX_DELAY"
+)
+
+// Maximum number of messages in a SendMessageBatch request.
+const defaultBatchMaxMessages = 10
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -58,7 +77,10 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.AWSSQSTarget) []corev1.EnvVar {
-	return append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+	awsEnvs := append(reconciler.MakeAWSAuthEnvVars(o.Spec.Auth),
+		common.MakeAWSEndpointEnvVars(o.Spec.Endpoint, sqs.EndpointsID)...)
+
+	env := append(awsEnvs,
 		[]corev1.EnvVar{
 			{
 				Name:  common.EnvARN,
@@ -71,4 +93,45 @@ func MakeAppEnv(o *v1alpha1.AWSSQSTarget) []corev1.EnvVar {
 				Value: o.Spec.MessageGroupID,
 			},
 		}...)
+
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.MessageGroupIDFrom,
+		envSQSMessageGroupIDAttribute, envSQSMessageGroupIDDataPath)...)
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.DeduplicationIDFrom,
+		envSQSDeduplicationIDAttribute, envSQSDeduplicationIDDataPath)...)
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.DelaySecondsFrom,
+		envSQSDelaySecondsAttribute, envSQSDelaySecondsDataPath)...)
+
+	if len(o.Spec.MessageAttributes) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  envSQSMessageAttributes,
+			Value: strings.Join(o.Spec.MessageAttributes, ","),
+		})
+	}
+
+	if o.Spec.DelaySeconds != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envSQSDelaySeconds,
+			Value: strconv.Itoa(int(*o.Spec.DelaySeconds)),
+		})
+	}
+
+	if b := o.Spec.Batching; b != nil {
+		// batching is enabled in the adapter by a non-zero number of messages
+		maxMessages := defaultBatchMaxMessages
+		if b.MaxMessages != nil {
+			maxMessages = int(*b.MaxMessages)
+		}
+		env = append(env, corev1.EnvVar{
+			Name:  envSQSBatchMaxMessages,
+			Value: strconv.Itoa(maxMessages),
+		})
+		if b.MaxDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envSQSBatchMaxDelay,
+				Value: b.MaxDelay.String(),
+			})
+		}
+	}
+
+	return env
 }
@@ -18,6 +18,8 @@ package cloudeventstarget
 
 import (
 	"path"
+	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -32,12 +34,34 @@ import (
 )
 
 const (
-	envCloudEventsPath                  = "CLOUDEVENTS_PATH"
-	envCloudEventsURL                   = "CLOUDEVENTS_URL"
-	envCloudEventsBasicAuthUsername     = "CLOUDEVENTS_BASICAUTH_USERNAME"
-	envCloudEventsBasicAuthPasswordPath = "CLOUDEVENTS_BASICAUTH_PASSWORD_PATH"
+	envCloudEventsPath                   = "CLOUDEVENTS_PATH"
+	envCloudEventsURL                    = "CLOUDEVENTS_URL"
+	envCloudEventsBasicAuthUsername      = "CLOUDEVENTS_BASICAUTH_USERNAME"
+	envCloudEventsBasicAuthPasswordPath  = "CLOUDEVENTS_BASICAUTH_PASSWORD_PATH"
+	envCloudEventsBearerTokenPath        = "CLOUDEVENTS_BEARER_TOKEN_PATH"
+	envCloudEventsOAuth2ClientID         = "CLOUDEVENTS_OAUTH2_CLIENT_ID"
+	envCloudEventsOAuth2ClientSecretPath = "CLOUDEVENTS_OAUTH2_CLIENT_SECRET_PATH"
+	envCloudEventsOAuth2TokenURL         = "CLOUDEVENTS_OAUTH2_TOKEN_URL"
+	envCloudEventsOAuth2Scopes           = "CLOUDEVENTS_OAUTH2_SCOPES"
+	envCloudEventsTLSCertificatePath     = "CLOUDEVENTS_TLS_CERTIFICATE_PATH"
+	envCloudEventsTLSKeyPath             = "CLOUDEVENTS_TLS_KEY_PATH"
+	envCloudEventsTLSCACertificatePath   = "CLOUDEVENTS_TLS_CA_CERTIFICATE_PATH"
+
+	envCloudEventsMode               = "CLOUDEVENTS_MODE"
+	envCloudEventsBatchMaxSize       = "CLOUDEVENTS_BATCH_MAX_SIZE"
+	envCloudEventsBatchMaxDelay      = "CLOUDEVENTS_BATCH_MAX_DELAY"
+	envCloudEventsRetryCount         = "CLOUDEVENTS_RETRY_COUNT"
+	envCloudEventsRetryBackoffPolicy = "CLOUDEVENTS_RETRY_BACKOFF_POLICY"
+	envCloudEventsRetryBackoffDelay  = "CLOUDEVENTS_RETRY_BACKOFF_DELAY"
+	envCloudEventsRetryStatusCodes   = "CLOUDEVENTS_RETRY_STATUS_CODES"
+	envCloudEventsCBFailureThreshold = "CLOUDEVENTS_CIRCUIT_BREAKER_FAILURE_THRESHOLD"
+	envCloudEventsCBOpenDuration     = "CLOUDEVENTS_CIRCUIT_BREAKER_OPEN_DURATION"
 )
 
+// secretsBasePath is the directory under which Secrets referenced by the
+// target's credentials are mounted.
+const secretsBasePath = "/opt"
+
 // adapterConfig contains properties used to configure the target's adapter.
 // Public fields are automatically populated by envconfig.
 type adapterConfig struct {
@@ -56,27 +80,39 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 
 	options := []resource.ObjectOption{}
 
-	if typedTrg.Spec.Credentials != nil {
-		secretName := "basicauths"
-		secretPath := "/opt/basicauths"
-		secretFileName := "cesource"
-
-		options = append(options, resource.EnvVar(envCloudEventsBasicAuthUsername, typedTrg.Spec.Credentials.BasicAuth.Username))
+	if creds := typedTrg.Spec.Credentials; creds != nil {
+		if creds.BasicAuth.Username != "" {
+			options = append(options, resource.EnvVar(envCloudEventsBasicAuthUsername, creds.BasicAuth.Username))
+			options = appendSecretFile(options, "basicauths", "cesource",
+				envCloudEventsBasicAuthPasswordPath, creds.BasicAuth.Password.ValueFromSecret)
+		}
 
-		if typedTrg.Spec.Credentials.BasicAuth.Password.ValueFromSecret != nil {
-			v, vm := secretVolumeAndMountAtPath(
-				secretName,
-				secretPath,
-				secretFileName,
-				typedTrg.Spec.Credentials.BasicAuth.Password.ValueFromSecret.Name,
-				typedTrg.Spec.Credentials.BasicAuth.Password.ValueFromSecret.Key,
-			)
+		if creds.BearerToken != nil {
+			options = appendSecretFile(options, "bearertoken", "token",
+				envCloudEventsBearerTokenPath, creds.BearerToken.ValueFromSecret)
+		}
 
+		if creds.OAuth2 != nil {
 			options = append(options,
-				resource.Volumes(v),
-				resource.VolumeMounts(vm),
-				resource.EnvVar(envCloudEventsBasicAuthPasswordPath, path.Join(secretPath, secretFileName)),
+				resource.EnvVar(envCloudEventsOAuth2ClientID, creds.OAuth2.ClientID),
+				resource.EnvVar(envCloudEventsOAuth2TokenURL, creds.OAuth2.TokenURL.String()),
 			)
+			if len(creds.OAuth2.Scopes) != 0 {
+				options = append(options, resource.EnvVar(envCloudEventsOAuth2Scopes, strings.Join(creds.OAuth2.Scopes, ",")))
+			}
+			options = appendSecretFile(options, "oauth2", "clientsecret",
+				envCloudEventsOAuth2ClientSecretPath, creds.OAuth2.ClientSecret.ValueFromSecret)
+		}
+
+		if creds.TLS != nil {
+			options = appendSecretFile(options, "tlscert", "tls.crt",
+				envCloudEventsTLSCertificatePath, creds.TLS.ClientCertificate.ValueFromSecret)
+			options = appendSecretFile(options, "tlskey", "tls.key",
+				envCloudEventsTLSKeyPath, creds.TLS.ClientKey.ValueFromSecret)
+			if creds.TLS.CACertificate != nil {
+				options = appendSecretFile(options, "tlsca", "ca.crt",
+					envCloudEventsTLSCACertificatePath, creds.TLS.CACertificate.ValueFromSecret)
+			}
 		}
 	}
 
@@ -109,9 +145,93 @@ func MakeAppEnv(o *v1alpha1.CloudEventsTarget) []corev1.EnvVar {
 		})
 	}
 
+	if o.Spec.Mode != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envCloudEventsMode,
+			Value: string(*o.Spec.Mode),
+		})
+	}
+
+	if b := o.Spec.Batch; b != nil {
+		if b.MaxSize != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envCloudEventsBatchMaxSize,
+				Value: strconv.Itoa(int(*b.MaxSize)),
+			})
+		}
+		if b.MaxDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envCloudEventsBatchMaxDelay,
+				Value: b.MaxDelay.String(),
+			})
+		}
+	}
+
+	if r := o.Spec.Retry; r != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envCloudEventsRetryCount,
+			Value: strconv.Itoa(int(r.Count)),
+		})
+		if r.BackoffPolicy != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envCloudEventsRetryBackoffPolicy,
+				Value: string(*r.BackoffPolicy),
+			})
+		}
+		if r.BackoffDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envCloudEventsRetryBackoffDelay,
+				Value: r.BackoffDelay.String(),
+			})
+		}
+		if len(r.RetryableStatusCodes) != 0 {
+			codes := make([]string, len(r.RetryableStatusCodes))
+			for i, c := range r.RetryableStatusCodes {
+				codes[i] = strconv.Itoa(c)
+			}
+			env = append(env, corev1.EnvVar{
+				Name:  envCloudEventsRetryStatusCodes,
+				Value: strings.Join(codes, ","),
+			})
+		}
+	}
+
+	if cb := o.Spec.CircuitBreaker; cb != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envCloudEventsCBFailureThreshold,
+			Value: strconv.Itoa(int(cb.FailureThreshold)),
+		})
+		if cb.OpenDuration != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envCloudEventsCBOpenDuration,
+				Value: cb.OpenDuration.String(),
+			})
+		}
+	}
+
 	return env
 }
 
+// appendSecretFile appends to the given options the volume and mount
+// required to expose a Secret key as a file, along with the environment
+// variable that informs the adapter about the location of that file.
+func appendSecretFile(opts []resource.ObjectOption, name, fileName, envName string,
+	sks *corev1.SecretKeySelector) []resource.ObjectOption {
+
+	if sks == nil {
+		return opts
+	}
+
+	mountPath := path.Join(secretsBasePath, name)
+	v, vm := secretVolumeAndMountAtPath(name, mountPath, fileName, sks.Name, sks.Key)
+
+	return append(opts,
+		resource.Volumes(v),
+		resource.VolumeMounts(vm),
+		resource.EnvVar(envName, path.Join(mountPath, fileName)),
+	)
+}
+
 // secretVolumeAndMountAtPath returns a Secret-based volume and corresponding
 // mount at the given path.
 func secretVolumeAndMountAtPath(name, mountPath, mountFile, secretName, secretKey string) (corev1.Volume, corev1.VolumeMount) {
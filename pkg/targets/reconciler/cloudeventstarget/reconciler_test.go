@@ -78,6 +78,13 @@ func newTarget() *v1alpha1.CloudEventsTarget {
 				Host:   "example.com",
 			},
 			Path: &path,
+			Retry: &v1alpha1.CloudEventsRetry{
+				Count:                3,
+				RetryableStatusCodes: []int{429, 503},
+			},
+			CircuitBreaker: &v1alpha1.CloudEventsCircuitBreaker{
+				FailureThreshold: 5,
+			},
 			Credentials: &v1alpha1.CloudEventsCredentials{
 				BasicAuth: v1alpha1.HTTPBasicAuth{
 					Username: "username",
@@ -61,14 +61,7 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.DatadogTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name: envDatadogAPIKey,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.DatadogAPIKey.SecretKeyRef,
-			},
-		},
-	}
+	env := common.MaybeAppendValueFromEnvVar(nil, envDatadogAPIKey, o.Spec.DatadogAPIKey.ValueFromField())
 
 	if o.Spec.DatadogSite != nil {
 		env = append(env, corev1.EnvVar{
@@ -94,19 +94,8 @@ func MakeAppEnv(o *v1alpha1.ElasticsearchTarget) []corev1.EnvVar {
 		})
 	}
 
-	if o.Spec.Connection.Password != nil && o.Spec.Connection.Password.SecretKeyRef != nil {
-		env = append(env, corev1.EnvVar{
-			Name:      "ELASTICSEARCH_PASSWORD",
-			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: o.Spec.Connection.Password.SecretKeyRef},
-		})
-	}
-
-	if o.Spec.Connection.APIKey != nil && o.Spec.Connection.APIKey.SecretKeyRef != nil {
-		env = append(env, corev1.EnvVar{
-			Name:      "ELASTICSEARCH_APIKEY",
-			ValueFrom: &corev1.EnvVarSource{SecretKeyRef: o.Spec.Connection.APIKey.SecretKeyRef},
-		})
-	}
+	env = common.MaybeAppendValueFromEnvVar(env, "ELASTICSEARCH_PASSWORD", o.Spec.Connection.Password.ValueFromField())
+	env = common.MaybeAppendValueFromEnvVar(env, "ELASTICSEARCH_APIKEY", o.Spec.Connection.APIKey.ValueFromField())
 
 	if o.Spec.Connection.CACert != nil {
 		env = append(env, corev1.EnvVar{
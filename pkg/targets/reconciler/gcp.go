@@ -26,20 +26,12 @@ import (
 
 // MakeGCPAuthEnvVars accepts both old credentials and new auth object and
 // returns adapter environment with configured authentication variables.
+// The service account key of the auth object takes precedence over the old
+// credentials.
 func MakeGCPAuthEnvVars(creds *targetsv1alpha1.SecretValueFromSource, auth *v1alpha1.GoogleCloudAuth) []corev1.EnvVar {
-	var env []corev1.EnvVar
-
-	if creds != nil && creds.SecretKeyRef != nil {
-		env = append(env, corev1.EnvVar{
-			Name: common.EnvGCloudSAKey,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: creds.SecretKeyRef,
-			},
-		})
-	}
 	if auth != nil && auth.ServiceAccountKey != nil {
-		env = common.MaybeAppendValueFromEnvVar(env, common.EnvGCloudSAKey, *auth.ServiceAccountKey)
+		return common.MaybeAppendValueFromEnvVar(nil, common.EnvGCloudSAKey, *auth.ServiceAccountKey)
 	}
 
-	return env
+	return common.MaybeAppendValueFromEnvVar(nil, common.EnvGCloudSAKey, creds.ValueFromField())
 }
@@ -18,6 +18,7 @@ package googlecloudpubsubtarget
 
 import (
 	"strconv"
+	"strings"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -35,6 +36,14 @@ import (
 const (
 	envEventsPayloadPolicy = "EVENTS_PAYLOAD_POLICY"
 	envDiscardCEContext    = "DISCARD_CE_CONTEXT"
+
+	envPubSubOrderingKeyAttribute = "GCLOUD_PUBSUB_ORDERING_KEY_ATTRIBUTE"
+	envPubSubOrderingKeyDataPath  = "GCLOUD_PUBSUB_ORDERING_KEY_DATA_PATH"
+	envPubSubMessageAttributes    = "GCLOUD_PUBSUB_MESSAGE_ATTRIBUTES"
+	envPubSubBinding              = "GCLOUD_PUBSUB_BINDING"
+	envPubSubBatchMaxMessages     = "GCLOUD_PUBSUB_BATCH_MAX_MESSAGES"
+	envPubSubBatchMaxBytes        = "GCLOUD_PUBSUB_BATCH_MAX_BYTES"
+	envPubSubBatchMaxDelay        = "GCLOUD_PUBSUB_BATCH_MAX_DELAY"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -80,5 +89,44 @@ func MakeAppEnv(o *v1alpha1.GoogleCloudPubSubTarget) []corev1.EnvVar {
 		})
 	}
 
+	env = append(env, common.MakeEventKeySourceEnvVars(o.Spec.OrderingKey,
+		envPubSubOrderingKeyAttribute, envPubSubOrderingKeyDataPath)...)
+
+	if len(o.Spec.MessageAttributes) > 0 {
+		env = append(env, corev1.EnvVar{
+			Name:  envPubSubMessageAttributes,
+			Value: strings.Join(o.Spec.MessageAttributes, ","),
+		})
+	}
+
+	if o.Spec.Binding != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envPubSubBinding,
+			Value: string(*o.Spec.Binding),
+		})
+	}
+
+	// unset thresholds retain the defaults of the Pub/Sub client library
+	if b := o.Spec.Batching; b != nil {
+		if b.MaxMessages != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envPubSubBatchMaxMessages,
+				Value: strconv.Itoa(int(*b.MaxMessages)),
+			})
+		}
+		if b.MaxBytes != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envPubSubBatchMaxBytes,
+				Value: strconv.Itoa(int(*b.MaxBytes)),
+			})
+		}
+		if b.MaxDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envPubSubBatchMaxDelay,
+				Value: b.MaxDelay.String(),
+			})
+		}
+	}
+
 	return env
 }
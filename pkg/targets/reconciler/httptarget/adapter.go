@@ -17,6 +17,7 @@ limitations under the License.
 package httptarget
 
 import (
+	"encoding/json"
 	"sort"
 	"strconv"
 	"strings"
@@ -47,6 +48,17 @@ const (
 	envHTTPOAuthClientSecret = "HTTP_OAUTH_CLIENT_SECRET"
 	envHTTPOAuthTokenURL     = "HTTP_OAUTH_TOKEN_URL"
 	envHTTPOAuthScopes       = "HTTP_OAUTH_SCOPE"
+	envHTTPRequestTemplate   = "HTTP_REQUEST_TEMPLATE"
+	envHTTPResponseMapping   = "HTTP_RESPONSE_MAPPING"
+
+	envHTTPRetryCount           = "HTTP_RETRY_COUNT"
+	envHTTPRetryBackoffDelay    = "HTTP_RETRY_BACKOFF_DELAY"
+	envHTTPRetryMaxBackoffDelay = "HTTP_RETRY_MAX_BACKOFF_DELAY"
+	envHTTPRetryStatusCodes     = "HTTP_RETRY_STATUS_CODES"
+	envHTTPRateLimitRPS         = "HTTP_RATELIMIT_RPS"
+	envHTTPRateLimitBurst       = "HTTP_RATELIMIT_BURST"
+	envHTTPMaxConcurrency       = "HTTP_MAX_CONCURRENT_REQUESTS"
+	envHTTPIdempotencyKeyHeader = "HTTP_IDEMPOTENCY_KEY_HEADER"
 )
 
 // adapterConfig contains properties used to configure the target's adapter.
@@ -139,14 +151,7 @@ func MakeAppEnv(o *v1alpha1.HTTPTarget) []corev1.EnvVar {
 		})
 	}
 
-	if o.Spec.BasicAuthPassword.SecretKeyRef != nil {
-		env = append(env, corev1.EnvVar{
-			Name: envHTTPBasicAuthPassword,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.BasicAuthPassword.SecretKeyRef,
-			},
-		})
-	}
+	env = common.MaybeAppendValueFromEnvVar(env, envHTTPBasicAuthPassword, o.Spec.BasicAuthPassword.ValueFromField())
 
 	if o.Spec.OAuthClientID != nil {
 		env = append(env, corev1.EnvVar{
@@ -155,14 +160,7 @@ func MakeAppEnv(o *v1alpha1.HTTPTarget) []corev1.EnvVar {
 		})
 	}
 
-	if o.Spec.OAuthClientSecret.SecretKeyRef != nil {
-		env = append(env, corev1.EnvVar{
-			Name: envHTTPOAuthClientSecret,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.OAuthClientSecret.SecretKeyRef,
-			},
-		})
-	}
+	env = common.MaybeAppendValueFromEnvVar(env, envHTTPOAuthClientSecret, o.Spec.OAuthClientSecret.ValueFromField())
 
 	if o.Spec.OAuthTokenURL != nil {
 		env = append(env, corev1.EnvVar{
@@ -178,5 +176,79 @@ func MakeAppEnv(o *v1alpha1.HTTPTarget) []corev1.EnvVar {
 		})
 	}
 
+	if r := o.Spec.Retry; r != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPRetryCount,
+			Value: strconv.Itoa(int(r.Count)),
+		})
+		if r.BackoffDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRetryBackoffDelay,
+				Value: r.BackoffDelay.String(),
+			})
+		}
+		if r.MaxBackoffDelay != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRetryMaxBackoffDelay,
+				Value: r.MaxBackoffDelay.String(),
+			})
+		}
+		if len(r.RetryableStatusCodes) != 0 {
+			codes := make([]string, len(r.RetryableStatusCodes))
+			for i, c := range r.RetryableStatusCodes {
+				codes[i] = strconv.Itoa(c)
+			}
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRetryStatusCodes,
+				Value: strings.Join(codes, ","),
+			})
+		}
+	}
+
+	if rl := o.Spec.RateLimit; rl != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPRateLimitRPS,
+			Value: strconv.Itoa(int(rl.RequestsPerSecond)),
+		})
+		if rl.Burst != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRateLimitBurst,
+				Value: strconv.Itoa(int(*rl.Burst)),
+			})
+		}
+	}
+
+	if o.Spec.MaxConcurrentRequests != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPMaxConcurrency,
+			Value: strconv.Itoa(int(*o.Spec.MaxConcurrentRequests)),
+		})
+	}
+
+	if o.Spec.IdempotencyKeyHeader != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  envHTTPIdempotencyKeyHeader,
+			Value: *o.Spec.IdempotencyKeyHeader,
+		})
+	}
+
+	if o.Spec.Template != nil {
+		if tpl, err := json.Marshal(o.Spec.Template); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPRequestTemplate,
+				Value: string(tpl),
+			})
+		}
+	}
+
+	if o.Spec.Response.Mapping != nil {
+		if m, err := json.Marshal(o.Spec.Response.Mapping); err == nil {
+			env = append(env, corev1.EnvVar{
+				Name:  envHTTPResponseMapping,
+				Value: string(m),
+			})
+		}
+	}
+
 	return env
 }
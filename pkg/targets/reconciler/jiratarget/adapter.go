@@ -61,17 +61,16 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.JiraTarget) []corev1.EnvVar {
-	return []corev1.EnvVar{
+	env := []corev1.EnvVar{
 		{
 			Name:  envJiraAuthUser,
 			Value: o.Spec.Auth.User,
 		},
-		{
-			Name: envJiraAuthToken,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Auth.Token.SecretKeyRef,
-			},
-		},
+	}
+
+	env = common.MaybeAppendValueFromEnvVar(env, envJiraAuthToken, o.Spec.Auth.Token.ValueFromField())
+
+	return append(env, []corev1.EnvVar{
 		{
 			Name:  envJiraURL,
 			Value: o.Spec.URL,
@@ -80,5 +79,5 @@ func MakeAppEnv(o *v1alpha1.JiraTarget) []corev1.EnvVar {
 			Name:  common.EnvBridgeID,
 			Value: common.GetStatefulBridgeID(o),
 		},
-	}
+	}...)
 }
@@ -66,20 +66,17 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.LogzMetricsTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
+	env := common.MaybeAppendValueFromEnvVar(nil, envCortexBearerToken, o.Spec.Connection.Token.ValueFromField())
+
+	env = append(env, []corev1.EnvVar{
 		{
-			Name: envCortexBearerToken,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Connection.Token.SecretKeyRef,
-			},
-		}, {
 			Name:  envCortexEndpoint,
 			Value: o.Spec.Connection.ListenerURL,
 		}, {
 			Name:  common.EnvBridgeID,
 			Value: common.GetStatefulBridgeID(o),
 		},
-	}
+	}...)
 
 	if instruments, err := json.Marshal(o.Spec.Instruments); err == nil {
 		env = append(env, corev1.EnvVar{
@@ -61,17 +61,12 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.LogzTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name: envShippingToken,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.ShippingToken.SecretKeyRef,
-			},
-		}, {
-			Name:  envLogsListenerURL,
-			Value: o.Spec.LogsListenerURL,
-		},
-	}
+	env := common.MaybeAppendValueFromEnvVar(nil, envShippingToken, o.Spec.ShippingToken.ValueFromField())
+
+	env = append(env, corev1.EnvVar{
+		Name:  envLogsListenerURL,
+		Value: o.Spec.LogsListenerURL,
+	})
 
 	if o.Spec.EventOptions != nil && o.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
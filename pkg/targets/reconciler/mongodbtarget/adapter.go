@@ -70,14 +70,10 @@ func MakeAppEnv(trg *v1alpha1.MongoDBTarget) []corev1.EnvVar {
 			Name:  envDefaultCollection,
 			Value: trg.Spec.Collection,
 		},
-		{
-			Name: envServerURL,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: trg.Spec.ConnectionString.ValueFromSecret,
-			},
-		},
 	}
 
+	env = common.MaybeAppendValueFromEnvVar(env, envServerURL, trg.Spec.ConnectionString)
+
 	if trg.Spec.EventOptions != nil && trg.Spec.EventOptions.PayloadPolicy != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envEventsPayloadPolicy,
@@ -55,23 +55,13 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.OracleTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
+	var env []corev1.EnvVar
+	env = common.MaybeAppendValueFromEnvVar(env, "ORACLE_API_PRIVATE_KEY", o.Spec.OracleAPIPrivateKey.ValueFromField())
+	env = common.MaybeAppendValueFromEnvVar(env, "ORACLE_API_PRIVATE_KEY_PASSPHRASE", o.Spec.OracleAPIPrivateKeyPassphrase.ValueFromField())
+	env = common.MaybeAppendValueFromEnvVar(env, "ORACLE_API_PRIVATE_KEY_FINGERPRINT", o.Spec.OracleAPIPrivateKeyFingerprint.ValueFromField())
+
+	env = append(env, []corev1.EnvVar{
 		{
-			Name: "ORACLE_API_PRIVATE_KEY",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.OracleAPIPrivateKey.SecretKeyRef,
-			},
-		}, {
-			Name: "ORACLE_API_PRIVATE_KEY_PASSPHRASE",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.OracleAPIPrivateKeyPassphrase.SecretKeyRef,
-			},
-		}, {
-			Name: "ORACLE_API_PRIVATE_KEY_FINGERPRINT",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.OracleAPIPrivateKeyFingerprint.SecretKeyRef,
-			},
-		}, {
 			Name:  "TENANT_OCID",
 			Value: o.Spec.Tenancy,
 		}, {
@@ -81,7 +71,7 @@ func MakeAppEnv(o *v1alpha1.OracleTarget) []corev1.EnvVar {
 			Name:  "USER_OCID",
 			Value: o.Spec.User,
 		},
-	}
+	}...)
 
 	if o.Spec.OracleFunctionSpec != nil {
 		env = append(env, corev1.EnvVar{
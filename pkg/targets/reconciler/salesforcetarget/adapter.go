@@ -77,18 +77,15 @@ func MakeAppEnv(o *v1alpha1.SalesforceTarget) []corev1.EnvVar {
 			Name:  envSalesforceAuthUser,
 			Value: o.Spec.Auth.User,
 		},
-		{
-			Name: envSalesforceAuthCertKey,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Auth.CertKey.SecretKeyRef,
-			},
-		},
-		{
-			Name:  common.EnvBridgeID,
-			Value: common.GetStatefulBridgeID(o),
-		},
 	}
 
+	env = common.MaybeAppendValueFromEnvVar(env, envSalesforceAuthCertKey, o.Spec.Auth.CertKey.ValueFromField())
+
+	env = append(env, corev1.EnvVar{
+		Name:  common.EnvBridgeID,
+		Value: common.GetStatefulBridgeID(o),
+	})
+
 	if o.Spec.APIVersion != nil {
 		env = append(env, corev1.EnvVar{
 			Name:  envSalesforceAPIVersion,
@@ -55,14 +55,7 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.SendGridTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name: "SENDGRID_API_KEY",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.APIKey.SecretKeyRef,
-			},
-		},
-	}
+	env := common.MaybeAppendValueFromEnvVar(nil, "SENDGRID_API_KEY", o.Spec.APIKey.ValueFromField())
 
 	if o.Spec.DefaultFromEmail != nil {
 		env = append(env, corev1.EnvVar{
@@ -53,12 +53,5 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 }
 
 func MakeAppEnv(o *v1alpha1.SlackTarget) []corev1.EnvVar {
-	return []corev1.EnvVar{
-		{
-			Name: "SLACK_TOKEN",
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Token.SecretKeyRef,
-			},
-		},
-	}
+	return common.MaybeAppendValueFromEnvVar(nil, "SLACK_TOKEN", o.Spec.Token.ValueFromField())
 }
@@ -63,22 +63,14 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.TwilioTarget) []corev1.EnvVar {
-	env := []corev1.EnvVar{
-		{
-			Name: envTwilioSID,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.AccountSID.SecretKeyRef,
-			},
-		}, {
-			Name: envTwilioToken,
-			ValueFrom: &corev1.EnvVarSource{
-				SecretKeyRef: o.Spec.Token.SecretKeyRef,
-			},
-		}, {
-			Name:  common.EnvBridgeID,
-			Value: common.GetStatefulBridgeID(o),
-		},
-	}
+	var env []corev1.EnvVar
+	env = common.MaybeAppendValueFromEnvVar(env, envTwilioSID, o.Spec.AccountSID.ValueFromField())
+	env = common.MaybeAppendValueFromEnvVar(env, envTwilioToken, o.Spec.Token.ValueFromField())
+
+	env = append(env, corev1.EnvVar{
+		Name:  common.EnvBridgeID,
+		Value: common.GetStatefulBridgeID(o),
+	})
 
 	if o.Spec.DefaultPhoneFrom != nil {
 		env = append(env, corev1.EnvVar{
@@ -55,12 +55,9 @@ func (r *Reconciler) BuildAdapter(trg commonv1alpha1.Reconcilable, _ *apis.URL)
 // MakeAppEnv extracts environment variables from the object.
 // Exported to be used in external tools for local test environments.
 func MakeAppEnv(o *v1alpha1.ZendeskTarget) []corev1.EnvVar {
-	return []corev1.EnvVar{{
-		Name: "TOKEN",
-		ValueFrom: &corev1.EnvVarSource{
-			SecretKeyRef: o.Spec.Token.SecretKeyRef,
-		},
-	}, {
+	env := common.MaybeAppendValueFromEnvVar(nil, "TOKEN", o.Spec.Token.ValueFromField())
+
+	return append(env, []corev1.EnvVar{{
 		Name:  "EMAIL",
 		Value: o.Spec.Email,
 	}, {
@@ -69,5 +66,5 @@ func MakeAppEnv(o *v1alpha1.ZendeskTarget) []corev1.EnvVar {
 	}, {
 		Name:  "SUBDOMAIN",
 		Value: o.Spec.Subdomain,
-	}}
+	}}...)
 }
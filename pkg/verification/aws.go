@@ -0,0 +1,135 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"context"
+	"fmt"
+
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	awscore "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sqs"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/aws"
+)
+
+// verifyAWSSQSSource reads the attributes of the queue consumed by the given
+// AWSSQSSource.
+func verifyAWSSQSSource(ctx context.Context, cli coreclientv1.SecretInterface, src *sourcesv1alpha1.AWSSQSSource) error {
+	sess, config, err := awsSession(cli, &src.Spec.Auth, src.Spec.ARN.Region)
+	if err != nil {
+		return err
+	}
+
+	config = aws.WithEndpoint(config, src.Spec.Endpoint, sqs.EndpointsID)
+
+	return verifySQSQueue(ctx, sqs.New(sess, config), src.Spec.ARN.Resource, src.Spec.ARN.AccountID)
+}
+
+// verifyAWSSQSTarget reads the attributes of the queue messages are sent to
+// by the given AWSSQSTarget.
+func verifyAWSSQSTarget(ctx context.Context, cli coreclientv1.SecretInterface, trg *targetsv1alpha1.AWSSQSTarget) error {
+	queueARN, err := arn.Parse(trg.Spec.ARN)
+	if err != nil {
+		return fmt.Errorf("parsing queue ARN: %w", err)
+	}
+
+	sess, config, err := awsSession(cli, &trg.Spec.Auth, queueARN.Region)
+	if err != nil {
+		return err
+	}
+
+	config = aws.WithEndpoint(config, trg.Spec.Endpoint, sqs.EndpointsID)
+
+	return verifySQSQueue(ctx, sqs.New(sess, config), queueARN.Resource, queueARN.AccountID)
+}
+
+// verifySQSQueue reads the attributes of the SQS queue with the given name.
+func verifySQSQueue(ctx context.Context, cli *sqs.SQS, name, accountID string) error {
+	urlIn := &sqs.GetQueueUrlInput{
+		QueueName: &name,
+	}
+	if accountID != "" {
+		urlIn.QueueOwnerAWSAccountId = &accountID
+	}
+
+	urlOut, err := cli.GetQueueUrlWithContext(ctx, urlIn)
+	if err != nil {
+		return fmt.Errorf("getting URL of queue %q: %w", name, err)
+	}
+
+	_, err = cli.GetQueueAttributesWithContext(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       urlOut.QueueUrl,
+		AttributeNames: awscore.StringSlice([]string{sqs.QueueAttributeNameQueueArn}),
+	})
+	if err != nil {
+		return fmt.Errorf("getting attributes of queue %q: %w", name, err)
+	}
+
+	return nil
+}
+
+// awsSession returns an AWS session and configuration for the given
+// authentication method. Only security credentials are supported, since IAM
+// roles for service accounts are only assumed by receive adapters.
+func awsSession(cli coreclientv1.SecretInterface, auth *v1alpha1.AWSAuth, region string) (*session.Session, *awscore.Config, error) {
+	switch {
+	case auth.Credentials != nil:
+		creds := auth.Credentials
+		for _, vf := range []v1alpha1.ValueFromField{creds.AccessKeyID, creds.SecretAccessKey, creds.SessionToken} {
+			if vf.ValueFromSecret == nil && (vf.ValueFromFile != "" || vf.ValueFromProvider != nil) {
+				return nil, nil, fmt.Errorf("%w: %s", v1alpha1.ErrVerificationUnsupported,
+					"AWS credentials read from files or secret providers can only be read by the adapter")
+			}
+		}
+
+	case auth.EksIAMRole != nil || auth.IAM != nil:
+		return nil, nil, fmt.Errorf("%w: %s", v1alpha1.ErrVerificationUnsupported,
+			"IAM roles for service accounts can only be assumed by the adapter")
+
+	default:
+		return nil, nil, fmt.Errorf("neither AWS security credentials nor IAM Role were specified")
+	}
+
+	creds, err := aws.Credentials(cli, auth.Credentials)
+	if err != nil {
+		return nil, nil, fmt.Errorf("retrieving AWS security credentials: %w", err)
+	}
+
+	sess, err := session.NewSession(awscore.NewConfig().
+		WithRegion(region).
+		WithCredentials(credentials.NewStaticCredentialsFromCreds(*creds)),
+	)
+	if err != nil {
+		return nil, nil, fmt.Errorf("creating AWS session: %w", err)
+	}
+
+	config := &awscore.Config{}
+	if assumeRole := auth.Credentials.AssumeIAMRole; assumeRole != nil {
+		config.Credentials = stscreds.NewCredentials(sess, assumeRole.String())
+	}
+
+	return sess, config, nil
+}
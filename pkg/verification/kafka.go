@@ -0,0 +1,230 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Shopify/sarama"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/adapter/kafkasource"
+	"github.com/triggermesh/triggermesh/pkg/sources/secret"
+)
+
+// kafkaAuth is a representation of the authentication settings of Kafka
+// components which is common to sources and targets.
+type kafkaAuth struct {
+	saslEnable bool
+	tlsEnable  bool
+	mechanism  string
+	username   string
+	password   *v1alpha1.ValueFromField
+	kerberos   bool
+
+	ca         *v1alpha1.ValueFromField
+	clientCert *v1alpha1.ValueFromField
+	clientKey  *v1alpha1.ValueFromField
+	skipVerify bool
+}
+
+// verifyKafkaSource fetches the metadata of the topic consumed by the given
+// KafkaSource.
+func verifyKafkaSource(ctx context.Context, sg secret.Getter, src *sourcesv1alpha1.KafkaSource) error {
+	a := src.Spec.Auth
+
+	auth := kafkaAuth{
+		saslEnable: a.SASLEnable,
+		tlsEnable:  a.TLSEnable != nil && *a.TLSEnable,
+		mechanism:  stringValue(a.SecurityMechanisms),
+		username:   stringValue(a.Username),
+		password:   a.Password,
+		kerberos:   a.Kerberos != nil,
+	}
+	if t := a.TLS; t != nil {
+		auth.ca, auth.clientCert, auth.clientKey = t.CA, t.ClientCert, t.ClientKey
+		auth.skipVerify = t.SkipVerify != nil && *t.SkipVerify
+	}
+
+	client, err := kafkaClient(ctx, sg, src.Spec.BootstrapServers, auth)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	if _, err := client.Partitions(src.Spec.Topic); err != nil {
+		return fmt.Errorf("getting partitions of topic %q: %w", src.Spec.Topic, err)
+	}
+
+	return nil
+}
+
+// verifyKafkaTarget fetches the metadata of the Kafka cluster the given
+// KafkaTarget produces messages to. The existence of the topic isn't verified,
+// since the adapter creates it when it doesn't exist.
+func verifyKafkaTarget(ctx context.Context, sg secret.Getter, trg *targetsv1alpha1.KafkaTarget) error {
+	var auth kafkaAuth
+
+	if a := trg.Spec.Auth; a != nil {
+		auth = kafkaAuth{
+			saslEnable: a.SASLEnable,
+			tlsEnable:  a.TLSEnable != nil && *a.TLSEnable,
+			mechanism:  stringValue(a.SecurityMechanisms),
+			username:   stringValue(a.Username),
+			password:   a.Password,
+			kerberos:   a.Kerberos != nil,
+		}
+		if t := a.TLS; t != nil {
+			auth.ca, auth.clientCert, auth.clientKey = t.CA, t.ClientCert, t.ClientKey
+			auth.skipVerify = t.SkipVerify != nil && *t.SkipVerify
+		}
+	}
+
+	client, err := kafkaClient(ctx, sg, trg.Spec.BootstrapServers, auth)
+	if err != nil {
+		return err
+	}
+
+	return client.Close()
+}
+
+// kafkaClient returns a Kafka client connected to the given bootstrap servers.
+// The metadata of the cluster is fetched upon connection.
+func kafkaClient(ctx context.Context, sg secret.Getter, servers []string, auth kafkaAuth) (sarama.Client, error) {
+	config, err := kafkaConfig(sg, auth)
+	if err != nil {
+		return nil, err
+	}
+
+	// The Kafka client doesn't accept a context, so its timeouts are set
+	// to the remaining time before the deadline of the verification.
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		config.Net.DialTimeout = timeout
+		config.Net.ReadTimeout = timeout
+		config.Net.WriteTimeout = timeout
+	}
+	config.Metadata.Retry.Max = 0
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid Kafka client configuration: %w", err)
+	}
+
+	client, err := sarama.NewClient(servers, config)
+	if err != nil {
+		return nil, fmt.Errorf("connecting to Kafka: %w", err)
+	}
+
+	return client, nil
+}
+
+// kafkaConfig returns a Kafka client configuration matching the configuration
+// of the adapters of Kafka components.
+func kafkaConfig(sg secret.Getter, auth kafkaAuth) (*sarama.Config, error) {
+	if auth.kerberos || auth.mechanism == sarama.SASLTypeGSSAPI {
+		return nil, fmt.Errorf("%w: %s", v1alpha1.ErrVerificationUnsupported,
+			"Kerberos configurations can only be read by the adapter")
+	}
+
+	config := sarama.NewConfig()
+
+	if auth.saslEnable {
+		mechanism := sarama.SASLMechanism(auth.mechanism)
+
+		switch mechanism {
+		case sarama.SASLTypeSCRAMSHA256:
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &kafkasource.XDGSCRAMClient{HashGeneratorFcn: sha256.New}
+			}
+		case sarama.SASLTypeSCRAMSHA512:
+			config.Net.SASL.SCRAMClientGeneratorFunc = func() sarama.SCRAMClient {
+				return &kafkasource.XDGSCRAMClient{HashGeneratorFcn: sha512.New}
+			}
+		}
+
+		config.Net.SASL.Enable = true
+		config.Net.SASL.Mechanism = mechanism
+		config.Net.SASL.User = auth.username
+
+		if auth.password != nil {
+			vals, err := resolveValues(sg, *auth.password)
+			if err != nil {
+				return nil, err
+			}
+			config.Net.SASL.Password = vals[0]
+		}
+	}
+
+	if auth.tlsEnable {
+		tlsCfg := &tls.Config{
+			InsecureSkipVerify: auth.skipVerify,
+		}
+
+		if auth.ca != nil {
+			vals, err := resolveValues(sg, *auth.ca)
+			if err != nil {
+				return nil, err
+			}
+
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM([]byte(vals[0])) {
+				return nil, errors.New("failed to parse the CA certificate")
+			}
+			tlsCfg.RootCAs = certPool
+		}
+
+		if auth.clientCert != nil || auth.clientKey != nil {
+			if auth.clientCert == nil || auth.clientKey == nil {
+				return nil, errors.New("TLS client authentication requires both a certificate and a key")
+			}
+
+			vals, err := resolveValues(sg, *auth.clientCert, *auth.clientKey)
+			if err != nil {
+				return nil, err
+			}
+
+			cert, err := tls.X509KeyPair([]byte(vals[0]), []byte(vals[1]))
+			if err != nil {
+				return nil, fmt.Errorf("parsing TLS client certificate: %w", err)
+			}
+			tlsCfg.Certificates = []tls.Certificate{cert}
+		}
+
+		config.Net.TLS.Enable = true
+		config.Net.TLS.Config = tlsCfg
+	}
+
+	return config, nil
+}
+
+// stringValue returns the value of the given string pointer, or an empty
+// string if the pointer is nil.
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"context"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/secret"
+)
+
+// verifyMongoDBSource pings the MongoDB server the given MongoDBSource reads
+// change streams from.
+func verifyMongoDBSource(ctx context.Context, src *sourcesv1alpha1.MongoDBSource) error {
+	return pingMongoDB(ctx, src.Spec.ConnectionString)
+}
+
+// verifyMongoDBTarget pings the MongoDB server the given MongoDBTarget writes
+// documents to.
+func verifyMongoDBTarget(ctx context.Context, sg secret.Getter, trg *targetsv1alpha1.MongoDBTarget) error {
+	vals, err := resolveValues(sg, trg.Spec.ConnectionString)
+	if err != nil {
+		return err
+	}
+
+	return pingMongoDB(ctx, vals[0])
+}
+
+// pingMongoDB pings the primary member of the MongoDB deployment identified
+// by the given connection string.
+func pingMongoDB(ctx context.Context, connStr string) error {
+	client, err := mongo.Connect(ctx, options.Client().ApplyURI(connStr))
+	if err != nil {
+		return fmt.Errorf("creating MongoDB client: %w", err)
+	}
+	defer func() {
+		_ = client.Disconnect(context.Background())
+	}()
+
+	if err := client.Ping(ctx, readpref.Primary()); err != nil {
+		return fmt.Errorf("pinging MongoDB server: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package verification verifies that component instances can communicate
+// with the external services they interact with.
+package verification
+
+import (
+	"context"
+	"fmt"
+
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+	"github.com/triggermesh/triggermesh/pkg/sources/secret"
+)
+
+// NewVerifier returns a Verifier which reads credentials from Kubernetes
+// Secrets using the given client interface.
+func NewVerifier(cli coreclientv1.SecretsGetter) *VerifierWithSecretsGetter {
+	return &VerifierWithSecretsGetter{
+		cli: cli,
+	}
+}
+
+// VerifierWithSecretsGetter verifies component instances using credentials
+// read from Kubernetes Secrets.
+type VerifierWithSecretsGetter struct {
+	cli coreclientv1.SecretsGetter
+}
+
+// VerifierWithSecretsGetter implements v1alpha1.Verifier.
+var _ v1alpha1.Verifier = (*VerifierWithSecretsGetter)(nil)
+
+// Verify implements v1alpha1.Verifier.
+func (v *VerifierWithSecretsGetter) Verify(ctx context.Context, r v1alpha1.Reconcilable) error {
+	sg := secret.NewGetter(v.cli.Secrets(r.GetNamespace()))
+
+	switch o := r.(type) {
+	case *sourcesv1alpha1.AWSSQSSource:
+		return verifyAWSSQSSource(ctx, v.cli.Secrets(o.Namespace), o)
+	case *targetsv1alpha1.AWSSQSTarget:
+		return verifyAWSSQSTarget(ctx, v.cli.Secrets(o.Namespace), o)
+	case *sourcesv1alpha1.KafkaSource:
+		return verifyKafkaSource(ctx, sg, o)
+	case *targetsv1alpha1.KafkaTarget:
+		return verifyKafkaTarget(ctx, sg, o)
+	case *sourcesv1alpha1.MongoDBSource:
+		return verifyMongoDBSource(ctx, o)
+	case *targetsv1alpha1.MongoDBTarget:
+		return verifyMongoDBTarget(ctx, sg, o)
+	default:
+		return fmt.Errorf("%w: %s", v1alpha1.ErrVerificationUnsupported,
+			"this kind of component doesn't support verification")
+	}
+}
+
+// resolveValues returns the values of the given fields. Values which can only
+// be resolved by adapters at runtime can't be verified.
+func resolveValues(sg secret.Getter, vfs ...v1alpha1.ValueFromField) (secret.Secrets, error) {
+	for _, vf := range vfs {
+		if vf.ValueFromSecret == nil && (vf.ValueFromFile != "" || vf.ValueFromProvider != nil) {
+			return nil, fmt.Errorf("%w: %s", v1alpha1.ErrVerificationUnsupported,
+				"values read from files or secret providers can only be read by the adapter")
+		}
+	}
+
+	return sg.Get(vfs...)
+}
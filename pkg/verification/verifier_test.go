@@ -0,0 +1,213 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package verification
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/triggermesh/triggermesh/pkg/apis"
+	"github.com/triggermesh/triggermesh/pkg/apis/common/v1alpha1"
+	flowv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/flow/v1alpha1"
+	sourcesv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/sources/v1alpha1"
+	targetsv1alpha1 "github.com/triggermesh/triggermesh/pkg/apis/targets/v1alpha1"
+)
+
+const tNs = "test-ns"
+
+func TestVerify(t *testing.T) {
+	testCases := map[string]struct {
+		obj v1alpha1.Reconcilable
+
+		expectUnsupported bool
+		expectErr         bool
+	}{
+		"Unsupported kind": {
+			obj:               &flowv1alpha1.Transformation{},
+			expectUnsupported: true,
+		},
+		"AWS IAM role": {
+			obj: &sourcesv1alpha1.AWSSQSSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: sourcesv1alpha1.AWSSQSSourceSpec{
+					ARN: apis.ARN{Region: "us-east-1", Resource: "queue"},
+					Auth: v1alpha1.AWSAuth{
+						IAM: &v1alpha1.EksIAM{},
+					},
+				},
+			},
+			expectUnsupported: true,
+		},
+		"AWS credentials from file": {
+			obj: &targetsv1alpha1.AWSSQSTarget{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: targetsv1alpha1.AWSSQSTargetSpec{
+					ARN: "arn:aws:sqs:us-east-1:123456789012:queue",
+					Auth: v1alpha1.AWSAuth{
+						Credentials: &v1alpha1.AWSSecurityCredentials{
+							AccessKeyID:     v1alpha1.ValueFromField{ValueFromFile: "/mnt/aws/key-id"},
+							SecretAccessKey: v1alpha1.ValueFromField{ValueFromFile: "/mnt/aws/secret"},
+						},
+					},
+				},
+			},
+			expectUnsupported: true,
+		},
+		"AWS invalid queue ARN": {
+			obj: &targetsv1alpha1.AWSSQSTarget{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: targetsv1alpha1.AWSSQSTargetSpec{
+					ARN: "queue",
+				},
+			},
+			expectErr: true,
+		},
+		"Kafka Kerberos": {
+			obj: &sourcesv1alpha1.KafkaSource{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: sourcesv1alpha1.KafkaSourceSpec{
+					BootstrapServers: []string{"localhost:9092"},
+					Auth: sourcesv1alpha1.KafkaSourceAuth{
+						SASLEnable: true,
+						Kerberos:   &sourcesv1alpha1.KafkaSourceKerberos{},
+					},
+				},
+			},
+			expectUnsupported: true,
+		},
+		"Kafka password from provider": {
+			obj: &targetsv1alpha1.KafkaTarget{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: targetsv1alpha1.KafkaTargetSpec{
+					BootstrapServers: []string{"localhost:9092"},
+					Auth: &targetsv1alpha1.KafkaTargetAuth{
+						SASLEnable: true,
+						Username:   ptrString("user"),
+						Password: &v1alpha1.ValueFromField{
+							ValueFromProvider: &v1alpha1.ProviderSecretSelector{
+								Provider: v1alpha1.SecretProviderVault,
+								Path:     "secret/data/kafka",
+								Key:      "password",
+							},
+						},
+					},
+				},
+			},
+			expectUnsupported: true,
+		},
+		"Kafka missing Secret": {
+			obj: &targetsv1alpha1.KafkaTarget{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: targetsv1alpha1.KafkaTargetSpec{
+					BootstrapServers: []string{"localhost:9092"},
+					Auth: &targetsv1alpha1.KafkaTargetAuth{
+						SASLEnable: true,
+						Username:   ptrString("user"),
+						Password: &v1alpha1.ValueFromField{
+							ValueFromSecret: &corev1.SecretKeySelector{
+								LocalObjectReference: corev1.LocalObjectReference{Name: "missing"},
+								Key:                  "password",
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"Kafka invalid CA certificate": {
+			obj: &targetsv1alpha1.KafkaTarget{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: targetsv1alpha1.KafkaTargetSpec{
+					BootstrapServers: []string{"localhost:9092"},
+					Auth: &targetsv1alpha1.KafkaTargetAuth{
+						TLSEnable: ptrBool(true),
+						TLS: &targetsv1alpha1.KafkaTargetTLSAuth{
+							CA: &v1alpha1.ValueFromField{
+								ValueFromSecret: &corev1.SecretKeySelector{
+									LocalObjectReference: corev1.LocalObjectReference{Name: "kafka"},
+									Key:                  "ca.crt",
+								},
+							},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		"MongoDB invalid connection string": {
+			obj: &targetsv1alpha1.MongoDBTarget{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tNs},
+				Spec: targetsv1alpha1.MongoDBTargetSpec{
+					ConnectionString: v1alpha1.ValueFromField{
+						ValueFromSecret: &corev1.SecretKeySelector{
+							LocalObjectReference: corev1.LocalObjectReference{Name: "mongodb"},
+							Key:                  "uri",
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	cli := fake.NewSimpleClientset(
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: tNs, Name: "kafka"},
+			Data:       map[string][]byte{"ca.crt": []byte("not a certificate")},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Namespace: tNs, Name: "mongodb"},
+			Data:       map[string][]byte{"uri": []byte("postgres://localhost")},
+		},
+	)
+
+	v := NewVerifier(cli.CoreV1())
+
+	for name, tc := range testCases {
+		//nolint:scopelint
+		t.Run(name, func(t *testing.T) {
+			err := v.Verify(context.Background(), tc.obj)
+
+			if tc.expectUnsupported {
+				assert.ErrorIs(t, err, v1alpha1.ErrVerificationUnsupported)
+				return
+			}
+
+			if tc.expectErr {
+				assert.Error(t, err)
+				assert.NotErrorIs(t, err, v1alpha1.ErrVerificationUnsupported)
+				return
+			}
+
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func ptrString(s string) *string {
+	return &s
+}
+
+func ptrBool(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schemas bundles the JSON schemas of the data of events produced and
+// consumed by TriggerMesh components.
+package schemas
+
+import (
+	"embed"
+	"path"
+	"strings"
+)
+
+//go:embed *.json
+var files embed.FS
+
+// eventTypes maps CloudEvents types to the name of the bundled schema which
+// describes the data of events of that type. It mirrors the schemas declared
+// in the event types annotations of the components' CRDs.
+var eventTypes = map[string]string{
+	"Microsoft.Storage.AsyncOperationInitiated":      "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.BlobCreated":                  "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.BlobDeleted":                  "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.BlobInventoryPolicyCompleted": "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.BlobRenamed":                  "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.BlobTierChanged":              "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.DirectoryCreated":             "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.DirectoryDeleted":             "com.microsoft.azure.blobstorage.event.json",
+	"Microsoft.Storage.DirectoryRenamed":             "com.microsoft.azure.blobstorage.event.json",
+	"com.amazon.cloudwatch.metrics.message":          "com.amazon.cloudwatch.metrics.message.json",
+	"com.amazon.cloudwatch.metrics.metric":           "com.amazon.cloudwatch.metrics.metric.json",
+	"com.amazon.codecommit.pull_request":             "com.amazon.codecommit.pull_request.json",
+	"com.amazon.codecommit.push":                     "com.amazon.codecommit.push.json",
+	"com.amazon.cognito-identity.sync_trigger":       "com.amazon.cognito-identity.sync_trigger.json",
+	"com.amazon.cognitouserpool.sync_trigger":        "com.amazon.cognitouserpool.sync_trigger.json",
+	"com.amazon.dynamodb.stream_record":              "com.amazon.dynamodb.stream_record.json",
+	"com.amazon.kinesis.stream_record":               "com.amazon.kinesis.stream_record.json",
+	"com.amazon.logs.log":                            "com.amazon.logs.log.json",
+	"com.amazon.rds.pi.metric":                       "com.amazon.performanceinsights.metric.json",
+	"com.amazon.s3.objectcreated":                    "com.amazon.s3.event.json",
+	"com.amazon.s3.objectremoved":                    "com.amazon.s3.event.json",
+	"com.amazon.s3.objectrestore":                    "com.amazon.s3.event.json",
+	"com.amazon.s3.reducedredundancylostobject":      "com.amazon.s3.event.json",
+	"com.amazon.s3.replication":                      "com.amazon.s3.event.json",
+	"com.amazon.s3.testevent":                        "com.amazon.s3.event.json",
+	"com.amazon.sns.notification":                    "com.amazon.sns.notification.json",
+	"com.amazon.sqs.message":                         "com.amazon.sqs.message.json",
+	"com.azure.eventhub.event":                       "com.microsoft.azure.eventhub.event.json",
+	"com.google.cloud.pubsub.message":                "com.google.cloud.pubsub.message.json",
+	"com.microsoft.azure.iothub.message":             "com.microsoft.azure.iothub.message.json",
+	"com.microsoft.azure.monitor.activity-log":       "com.microsoft.azure.monitor.activity-log.json",
+	"com.microsoft.azure.queuestorage":               "com.microsoft.azure.queuestorage.json",
+	"com.microsoft.azure.servicebus.message":         "com.microsoft.azure.servicebus.message.json",
+	"com.oracle.cloud.monitoring":                    "com.oracle.cloud.monitoring.json",
+	"com.salesforce.stream.message":                  "com.salesforce.stream.message.json",
+	"com.slack.events":                               "com.slack.events.json",
+	"com.slack.webapi.chat.postMessage":              "com.slack.webapi.chat.postMessage.json",
+	"com.slack.webapi.chat.scheduleMessage":          "com.slack.webapi.chat.scheduleMessage.json",
+	"com.slack.webapi.chat.update":                   "com.slack.webapi.chat.update.json",
+	"com.twilio.sms":                                 "com.twilio.sms.json",
+	"com.zendesk.ticket.create":                      "com.zendesk.ticket.create.json",
+	"com.zendesk.ticket.created":                     "com.zendesk.ticket.created.json",
+	"com.zendesk.ticket.tag.add":                     "com.zendesk.ticket.tag.add.json",
+	"io.trigermesh.google.workflows.run":             "io.trigermesh.google.workflows.run.json",
+	"io.triggermesh.awsdynamodb.item.delete":         "io.triggermesh.awsdynamodb.item.delete.json",
+	"io.triggermesh.awsdynamodb.item.put":            "io.triggermesh.awsdynamodb.item.put.json",
+	"io.triggermesh.awsdynamodb.item.update":         "io.triggermesh.awsdynamodb.item.update.json",
+	"io.triggermesh.awsdynamodb.items.batchwrite":    "io.triggermesh.awsdynamodb.items.batchwrite.json",
+	"io.triggermesh.awsdynamodb.items.transactwrite": "io.triggermesh.awsdynamodb.items.transactwrite.json",
+	"io.triggermesh.awsdynamodb.result":              "io.triggermesh.awsdynamodb.result.json",
+	"io.triggermesh.azure.sentinel.incident":         "io.triggermesh.azure.sentinel.incident.json",
+	"io.triggermesh.datadog.event.post":              "io.triggermesh.datadog.event.post.json",
+	"io.triggermesh.datadog.log.send":                "io.triggermesh.datadog.log.send.json",
+	"io.triggermesh.datadog.metric.submit":           "io.triggermesh.datadog.metric.submit.json",
+	"io.triggermesh.google.firestore.query.table":    "io.triggermesh.google.firestore.query.table.json",
+	"io.triggermesh.google.firestore.query.tables":   "io.triggermesh.google.firestore.query.tables.json",
+	"io.triggermesh.google.firestore.write":          "io.triggermesh.google.firestore.write.json",
+	"io.triggermesh.googlesheet.append":              "io.triggermesh.googlesheet.append.json",
+	"io.triggermesh.http.request":                    "io.triggermesh.http.request.json",
+	"io.triggermesh.jira.custom":                     "io.triggermesh.jira.custom.json",
+	"io.triggermesh.jira.issue":                      "io.triggermesh.jira.issue.json",
+	"io.triggermesh.jira.issue.get":                  "io.triggermesh.jira.issue.get.json",
+	"io.triggermesh.mongodb.insert":                  "io.triggermesh.mongodb.insert.json",
+	"io.triggermesh.mongodb.query.kv":                "io.triggermesh.mongodb.query.kv.json",
+	"io.triggermesh.mongodb.query.response":          "io.triggermesh.mongodb.query.response.json",
+	"io.triggermesh.mongodb.update":                  "io.triggermesh.mongodb.update.json",
+	"io.triggermesh.salesforce.apicall":              "io.triggermesh.salesforce.apicall.json",
+	"io.triggermesh.sendgrid.email.send":             "io.triggermesh.sendgrid.email.send.json",
+	"io.triggermesh.targets.aws.comprehend.result":   "io.triggermesh.targets.aws.comprehend.result.json",
+	"io.triggermesh.targets.aws.dynamodb.result":     "io.triggermesh.targets.aws.dynamodb.result.json",
+	"io.triggermesh.targets.aws.eventbridge.result":  "io.triggermesh.targets.aws.eventbridge.result.json",
+	"io.triggermesh.targets.aws.kinesis.result":      "io.triggermesh.targets.aws.kinesis.result.json",
+	"io.triggermesh.targets.aws.s3.result":           "io.triggermesh.targets.aws.s3.result.json",
+	"io.triggermesh.targets.aws.sns.result":          "io.triggermesh.targets.aws.sns.result.json",
+	"io.triggermesh.twilio.sms.send":                 "io.triggermesh.twilio.sms.send.json",
+}
+
+// ForEventType returns the bundled JSON schema describing the data of events
+// of the given type, if any.
+func ForEventType(typ string) ([]byte, bool) {
+	name, ok := eventTypes[typ]
+	if !ok {
+		return nil, false
+	}
+	return read(name)
+}
+
+// ForDataSchema returns the bundled JSON schema referenced by the given URI,
+// such as the value of a CloudEvent's "dataschema" attribute, if any. URIs
+// are matched on their last path element, so that bundled schemas can be
+// referenced regardless of the location they are published at.
+func ForDataSchema(uri string) ([]byte, bool) {
+	if uri == "" {
+		return nil, false
+	}
+	name := path.Base(uri)
+	if !strings.HasSuffix(name, ".json") {
+		return nil, false
+	}
+	return read(name)
+}
+
+// read returns the content of the bundled schema with the given file name.
+func read(name string) ([]byte, bool) {
+	b, err := files.ReadFile(name)
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 TriggerMesh Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schemas
+
+import (
+	"encoding/json"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"sigs.k8s.io/yaml"
+)
+
+// eventTypesAnnotations are the CRD annotations which list the types of
+// events produced or accepted by a component.
+var eventTypesAnnotations = []string{
+	"registry.knative.dev/eventTypes",
+	"registry.triggermesh.io/acceptedEventTypes",
+}
+
+// TestEventTypesMatchCRDs ensures that the bundled schemas are indexed
+// consistently with the schemas declared in the components' CRDs.
+func TestEventTypesMatchCRDs(t *testing.T) {
+	crds, err := filepath.Glob("../config/30*.yaml")
+	if err != nil {
+		t.Fatal("Error listing CRD manifests:", err)
+	}
+
+	declared := make(map[string]string)
+
+	for _, f := range crds {
+		b, err := os.ReadFile(f)
+		if err != nil {
+			t.Fatal("Error reading CRD manifest:", err)
+		}
+
+		crd := &struct {
+			Metadata struct {
+				Annotations map[string]string `json:"annotations"`
+			} `json:"metadata"`
+		}{}
+		if err := yaml.Unmarshal(b, crd); err != nil {
+			t.Fatalf("Error parsing CRD manifest %s: %s", f, err)
+		}
+
+		for _, a := range eventTypesAnnotations {
+			val, ok := crd.Metadata.Annotations[a]
+			if !ok {
+				continue
+			}
+
+			var types []struct {
+				Type   string `json:"type"`
+				Schema string `json:"schema"`
+			}
+			if err := json.Unmarshal([]byte(val), &types); err != nil {
+				t.Fatalf("Error parsing annotation %s of CRD manifest %s: %s", a, f, err)
+			}
+
+			for _, typ := range types {
+				if typ.Schema == "" {
+					continue
+				}
+				if _, err := os.Stat(path.Base(typ.Schema)); err != nil {
+					// schema declared but not bundled
+					continue
+				}
+				declared[typ.Type] = path.Base(typ.Schema)
+			}
+		}
+	}
+
+	for typ, name := range declared {
+		if got, ok := eventTypes[typ]; !ok || got != name {
+			t.Errorf("Expected event type %q to be indexed with schema %q, got %q", typ, name, got)
+		}
+	}
+	for typ := range eventTypes {
+		if _, ok := declared[typ]; !ok {
+			t.Errorf("Event type %q is indexed but not declared in any CRD", typ)
+		}
+	}
+}
+
+func TestLookup(t *testing.T) {
+	if _, ok := ForEventType("com.amazon.s3.objectcreated"); !ok {
+		t.Error("Expected a schema for a known event type")
+	}
+	if _, ok := ForEventType("com.example.unknown"); ok {
+		t.Error("Expected no schema for an unknown event type")
+	}
+
+	const uri = "https://raw.githubusercontent.com/triggermesh/triggermesh/main/schemas/com.amazon.sqs.message.json"
+	if _, ok := ForDataSchema(uri); !ok {
+		t.Error("Expected a schema for a bundled data schema")
+	}
+	if _, ok := ForDataSchema("https://example.com/schemas/unknown.json"); ok {
+		t.Error("Expected no schema for an unknown data schema")
+	}
+	if _, ok := ForDataSchema(strings.TrimSuffix(uri, ".json")); ok {
+		t.Error("Expected no schema for a data schema which isn't a JSON file")
+	}
+}